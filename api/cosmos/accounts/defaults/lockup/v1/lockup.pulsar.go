@@ -591,77 +591,81 @@ func (x *fastReflection_Period) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_UnbondingEntries_1_list)(nil)
+var _ protoreflect.List = (*_DenomLockingSchedule_3_list)(nil)
 
-type _UnbondingEntries_1_list struct {
-	list *[]*UnbondingEntry
+type _DenomLockingSchedule_3_list struct {
+	list *[]*Period
 }
 
-func (x *_UnbondingEntries_1_list) Len() int {
+func (x *_DenomLockingSchedule_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_UnbondingEntries_1_list) Get(i int) protoreflect.Value {
+func (x *_DenomLockingSchedule_3_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_UnbondingEntries_1_list) Set(i int, value protoreflect.Value) {
+func (x *_DenomLockingSchedule_3_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*UnbondingEntry)
+	concreteValue := valueUnwrapped.Interface().(*Period)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_UnbondingEntries_1_list) Append(value protoreflect.Value) {
+func (x *_DenomLockingSchedule_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*UnbondingEntry)
+	concreteValue := valueUnwrapped.Interface().(*Period)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_UnbondingEntries_1_list) AppendMutable() protoreflect.Value {
-	v := new(UnbondingEntry)
+func (x *_DenomLockingSchedule_3_list) AppendMutable() protoreflect.Value {
+	v := new(Period)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_UnbondingEntries_1_list) Truncate(n int) {
+func (x *_DenomLockingSchedule_3_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_UnbondingEntries_1_list) NewElement() protoreflect.Value {
-	v := new(UnbondingEntry)
+func (x *_DenomLockingSchedule_3_list) NewElement() protoreflect.Value {
+	v := new(Period)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_UnbondingEntries_1_list) IsValid() bool {
+func (x *_DenomLockingSchedule_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_UnbondingEntries         protoreflect.MessageDescriptor
-	fd_UnbondingEntries_entries protoreflect.FieldDescriptor
+	md_DenomLockingSchedule                 protoreflect.MessageDescriptor
+	fd_DenomLockingSchedule_denom           protoreflect.FieldDescriptor
+	fd_DenomLockingSchedule_start_time      protoreflect.FieldDescriptor
+	fd_DenomLockingSchedule_locking_periods protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init()
-	md_UnbondingEntries = File_cosmos_accounts_defaults_lockup_v1_lockup_proto.Messages().ByName("UnbondingEntries")
-	fd_UnbondingEntries_entries = md_UnbondingEntries.Fields().ByName("entries")
+	md_DenomLockingSchedule = File_cosmos_accounts_defaults_lockup_v1_lockup_proto.Messages().ByName("DenomLockingSchedule")
+	fd_DenomLockingSchedule_denom = md_DenomLockingSchedule.Fields().ByName("denom")
+	fd_DenomLockingSchedule_start_time = md_DenomLockingSchedule.Fields().ByName("start_time")
+	fd_DenomLockingSchedule_locking_periods = md_DenomLockingSchedule.Fields().ByName("locking_periods")
 }
 
-var _ protoreflect.Message = (*fastReflection_UnbondingEntries)(nil)
+var _ protoreflect.Message = (*fastReflection_DenomLockingSchedule)(nil)
 
-type fastReflection_UnbondingEntries UnbondingEntries
+type fastReflection_DenomLockingSchedule DenomLockingSchedule
 
-func (x *UnbondingEntries) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_UnbondingEntries)(x)
+func (x *DenomLockingSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomLockingSchedule)(x)
 }
 
-func (x *UnbondingEntries) slowProtoReflect() protoreflect.Message {
+func (x *DenomLockingSchedule) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -673,43 +677,43 @@ func (x *UnbondingEntries) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_UnbondingEntries_messageType fastReflection_UnbondingEntries_messageType
-var _ protoreflect.MessageType = fastReflection_UnbondingEntries_messageType{}
+var _fastReflection_DenomLockingSchedule_messageType fastReflection_DenomLockingSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_DenomLockingSchedule_messageType{}
 
-type fastReflection_UnbondingEntries_messageType struct{}
+type fastReflection_DenomLockingSchedule_messageType struct{}
 
-func (x fastReflection_UnbondingEntries_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_UnbondingEntries)(nil)
+func (x fastReflection_DenomLockingSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomLockingSchedule)(nil)
 }
-func (x fastReflection_UnbondingEntries_messageType) New() protoreflect.Message {
-	return new(fastReflection_UnbondingEntries)
+func (x fastReflection_DenomLockingSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomLockingSchedule)
 }
-func (x fastReflection_UnbondingEntries_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_UnbondingEntries
+func (x fastReflection_DenomLockingSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomLockingSchedule
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_UnbondingEntries) Descriptor() protoreflect.MessageDescriptor {
-	return md_UnbondingEntries
+func (x *fastReflection_DenomLockingSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomLockingSchedule
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_UnbondingEntries) Type() protoreflect.MessageType {
-	return _fastReflection_UnbondingEntries_messageType
+func (x *fastReflection_DenomLockingSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_DenomLockingSchedule_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_UnbondingEntries) New() protoreflect.Message {
-	return new(fastReflection_UnbondingEntries)
+func (x *fastReflection_DenomLockingSchedule) New() protoreflect.Message {
+	return new(fastReflection_DenomLockingSchedule)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_UnbondingEntries) Interface() protoreflect.ProtoMessage {
-	return (*UnbondingEntries)(x)
+func (x *fastReflection_DenomLockingSchedule) Interface() protoreflect.ProtoMessage {
+	return (*DenomLockingSchedule)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -717,10 +721,22 @@ func (x *fastReflection_UnbondingEntries) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_UnbondingEntries) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Entries) != 0 {
-		value := protoreflect.ValueOfList(&_UnbondingEntries_1_list{list: &x.Entries})
-		if !f(fd_UnbondingEntries_entries, value) {
+func (x *fastReflection_DenomLockingSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DenomLockingSchedule_denom, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_DenomLockingSchedule_start_time, value) {
+			return
+		}
+	}
+	if len(x.LockingPeriods) != 0 {
+		value := protoreflect.ValueOfList(&_DenomLockingSchedule_3_list{list: &x.LockingPeriods})
+		if !f(fd_DenomLockingSchedule_locking_periods, value) {
 			return
 		}
 	}
@@ -737,15 +753,19 @@ func (x *fastReflection_UnbondingEntries) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_UnbondingEntries) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DenomLockingSchedule) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
-		return len(x.Entries) != 0
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.denom":
+		return x.Denom != ""
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.start_time":
+		return x.StartTime != nil
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.locking_periods":
+		return len(x.LockingPeriods) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -755,15 +775,19 @@ func (x *fastReflection_UnbondingEntries) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingEntries) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DenomLockingSchedule) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
-		x.Entries = nil
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.denom":
+		x.Denom = ""
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.start_time":
+		x.StartTime = nil
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.locking_periods":
+		x.LockingPeriods = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -773,19 +797,25 @@ func (x *fastReflection_UnbondingEntries) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_UnbondingEntries) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DenomLockingSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
-		if len(x.Entries) == 0 {
-			return protoreflect.ValueOfList(&_UnbondingEntries_1_list{})
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.locking_periods":
+		if len(x.LockingPeriods) == 0 {
+			return protoreflect.ValueOfList(&_DenomLockingSchedule_3_list{})
 		}
-		listValue := &_UnbondingEntries_1_list{list: &x.Entries}
+		listValue := &_DenomLockingSchedule_3_list{list: &x.LockingPeriods}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -799,17 +829,21 @@ func (x *fastReflection_UnbondingEntries) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingEntries) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DenomLockingSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.locking_periods":
 		lv := value.List()
-		clv := lv.(*_UnbondingEntries_1_list)
-		x.Entries = *clv.list
+		clv := lv.(*_DenomLockingSchedule_3_list)
+		x.LockingPeriods = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -823,45 +857,57 @@ func (x *fastReflection_UnbondingEntries) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingEntries) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DenomLockingSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
-		if x.Entries == nil {
-			x.Entries = []*UnbondingEntry{}
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
 		}
-		value := &_UnbondingEntries_1_list{list: &x.Entries}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.locking_periods":
+		if x.LockingPeriods == nil {
+			x.LockingPeriods = []*Period{}
+		}
+		value := &_DenomLockingSchedule_3_list{list: &x.LockingPeriods}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.denom":
+		panic(fmt.Errorf("field denom of message cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_UnbondingEntries) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DenomLockingSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
-		list := []*UnbondingEntry{}
-		return protoreflect.ValueOfList(&_UnbondingEntries_1_list{list: &list})
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.locking_periods":
+		list := []*Period{}
+		return protoreflect.ValueOfList(&_DenomLockingSchedule_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_UnbondingEntries) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DenomLockingSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.UnbondingEntries", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -869,7 +915,7 @@ func (x *fastReflection_UnbondingEntries) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_UnbondingEntries) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DenomLockingSchedule) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -880,7 +926,7 @@ func (x *fastReflection_UnbondingEntries) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingEntries) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DenomLockingSchedule) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -892,7 +938,7 @@ func (x *fastReflection_UnbondingEntries) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_UnbondingEntries) IsValid() bool {
+func (x *fastReflection_DenomLockingSchedule) IsValid() bool {
 	return x != nil
 }
 
@@ -902,9 +948,9 @@ func (x *fastReflection_UnbondingEntries) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DenomLockingSchedule) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*UnbondingEntries)
+		x := input.Message.Interface().(*DenomLockingSchedule)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -916,8 +962,16 @@ func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Entries) > 0 {
-			for _, e := range x.Entries {
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.LockingPeriods) > 0 {
+			for _, e := range x.LockingPeriods {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -932,7 +986,7 @@ func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*UnbondingEntries)
+		x := input.Message.Interface().(*DenomLockingSchedule)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -951,9 +1005,9 @@ func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Entries) > 0 {
-			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Entries[iNdEx])
+		if len(x.LockingPeriods) > 0 {
+			for iNdEx := len(x.LockingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockingPeriods[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -964,9 +1018,30 @@ func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x1a
 			}
 		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -978,7 +1053,7 @@ func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*UnbondingEntries)
+		x := input.Message.Interface().(*DenomLockingSchedule)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1010,15 +1085,47 @@ func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingEntries: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomLockingSchedule: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomLockingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1045,8 +1152,44 @@ func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Entries = append(x.Entries, &UnbondingEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockingPeriods", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockingPeriods = append(x.LockingPeriods, &Period{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockingPeriods[len(x.LockingPeriods)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1085,80 +1228,2592 @@ func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_UnbondingEntry                   protoreflect.MessageDescriptor
-	fd_UnbondingEntry_creation_height   protoreflect.FieldDescriptor
-	fd_UnbondingEntry_end_time          protoreflect.FieldDescriptor
-	fd_UnbondingEntry_amount            protoreflect.FieldDescriptor
-	fd_UnbondingEntry_validator_address protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init()
-	md_UnbondingEntry = File_cosmos_accounts_defaults_lockup_v1_lockup_proto.Messages().ByName("UnbondingEntry")
-	fd_UnbondingEntry_creation_height = md_UnbondingEntry.Fields().ByName("creation_height")
-	fd_UnbondingEntry_end_time = md_UnbondingEntry.Fields().ByName("end_time")
-	fd_UnbondingEntry_amount = md_UnbondingEntry.Fields().ByName("amount")
-	fd_UnbondingEntry_validator_address = md_UnbondingEntry.Fields().ByName("validator_address")
-}
-
-var _ protoreflect.Message = (*fastReflection_UnbondingEntry)(nil)
-
-type fastReflection_UnbondingEntry UnbondingEntry
+var _ protoreflect.List = (*_UnbondingEntries_1_list)(nil)
 
-func (x *UnbondingEntry) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_UnbondingEntry)(x)
+type _UnbondingEntries_1_list struct {
+	list *[]*UnbondingEntry
 }
 
-func (x *UnbondingEntry) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *_UnbondingEntries_1_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return mi.MessageOf(x)
+	return len(*x.list)
 }
 
-var _fastReflection_UnbondingEntry_messageType fastReflection_UnbondingEntry_messageType
-var _ protoreflect.MessageType = fastReflection_UnbondingEntry_messageType{}
-
-type fastReflection_UnbondingEntry_messageType struct{}
-
-func (x fastReflection_UnbondingEntry_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_UnbondingEntry)(nil)
-}
-func (x fastReflection_UnbondingEntry_messageType) New() protoreflect.Message {
-	return new(fastReflection_UnbondingEntry)
-}
-func (x fastReflection_UnbondingEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_UnbondingEntry
+func (x *_UnbondingEntries_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_UnbondingEntry) Descriptor() protoreflect.MessageDescriptor {
-	return md_UnbondingEntry
+func (x *_UnbondingEntries_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_UnbondingEntries_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_UnbondingEntries_1_list) AppendMutable() protoreflect.Value {
+	v := new(UnbondingEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnbondingEntries_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_UnbondingEntries_1_list) NewElement() protoreflect.Value {
+	v := new(UnbondingEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnbondingEntries_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_UnbondingEntries         protoreflect.MessageDescriptor
+	fd_UnbondingEntries_entries protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init()
+	md_UnbondingEntries = File_cosmos_accounts_defaults_lockup_v1_lockup_proto.Messages().ByName("UnbondingEntries")
+	fd_UnbondingEntries_entries = md_UnbondingEntries.Fields().ByName("entries")
+}
+
+var _ protoreflect.Message = (*fastReflection_UnbondingEntries)(nil)
+
+type fastReflection_UnbondingEntries UnbondingEntries
+
+func (x *UnbondingEntries) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnbondingEntries)(x)
+}
+
+func (x *UnbondingEntries) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UnbondingEntries_messageType fastReflection_UnbondingEntries_messageType
+var _ protoreflect.MessageType = fastReflection_UnbondingEntries_messageType{}
+
+type fastReflection_UnbondingEntries_messageType struct{}
+
+func (x fastReflection_UnbondingEntries_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnbondingEntries)(nil)
+}
+func (x fastReflection_UnbondingEntries_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnbondingEntries)
+}
+func (x fastReflection_UnbondingEntries_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingEntries
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UnbondingEntries) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingEntries
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UnbondingEntries) Type() protoreflect.MessageType {
+	return _fastReflection_UnbondingEntries_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UnbondingEntries) New() protoreflect.Message {
+	return new(fastReflection_UnbondingEntries)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UnbondingEntries) Interface() protoreflect.ProtoMessage {
+	return (*UnbondingEntries)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UnbondingEntries) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_UnbondingEntries_1_list{list: &x.Entries})
+		if !f(fd_UnbondingEntries_entries, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UnbondingEntries) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
+		return len(x.Entries) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingEntries) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
+		x.Entries = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UnbondingEntries) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_UnbondingEntries_1_list{})
+		}
+		listValue := &_UnbondingEntries_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingEntries) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
+		lv := value.List()
+		clv := lv.(*_UnbondingEntries_1_list)
+		x.Entries = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingEntries) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
+		if x.Entries == nil {
+			x.Entries = []*UnbondingEntry{}
+		}
+		value := &_UnbondingEntries_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UnbondingEntries) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries":
+		list := []*UnbondingEntry{}
+		return protoreflect.ValueOfList(&_UnbondingEntries_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntries"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntries does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UnbondingEntries) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.UnbondingEntries", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UnbondingEntries) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingEntries) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UnbondingEntries) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UnbondingEntries) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UnbondingEntries)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingEntries)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingEntries)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingEntries: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &UnbondingEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_UnbondingEntry                   protoreflect.MessageDescriptor
+	fd_UnbondingEntry_creation_height   protoreflect.FieldDescriptor
+	fd_UnbondingEntry_end_time          protoreflect.FieldDescriptor
+	fd_UnbondingEntry_amount            protoreflect.FieldDescriptor
+	fd_UnbondingEntry_validator_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init()
+	md_UnbondingEntry = File_cosmos_accounts_defaults_lockup_v1_lockup_proto.Messages().ByName("UnbondingEntry")
+	fd_UnbondingEntry_creation_height = md_UnbondingEntry.Fields().ByName("creation_height")
+	fd_UnbondingEntry_end_time = md_UnbondingEntry.Fields().ByName("end_time")
+	fd_UnbondingEntry_amount = md_UnbondingEntry.Fields().ByName("amount")
+	fd_UnbondingEntry_validator_address = md_UnbondingEntry.Fields().ByName("validator_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_UnbondingEntry)(nil)
+
+type fastReflection_UnbondingEntry UnbondingEntry
+
+func (x *UnbondingEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnbondingEntry)(x)
+}
+
+func (x *UnbondingEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UnbondingEntry_messageType fastReflection_UnbondingEntry_messageType
+var _ protoreflect.MessageType = fastReflection_UnbondingEntry_messageType{}
+
+type fastReflection_UnbondingEntry_messageType struct{}
+
+func (x fastReflection_UnbondingEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnbondingEntry)(nil)
+}
+func (x fastReflection_UnbondingEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnbondingEntry)
+}
+func (x fastReflection_UnbondingEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UnbondingEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UnbondingEntry) Type() protoreflect.MessageType {
+	return _fastReflection_UnbondingEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UnbondingEntry) New() protoreflect.Message {
+	return new(fastReflection_UnbondingEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UnbondingEntry) Interface() protoreflect.ProtoMessage {
+	return (*UnbondingEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UnbondingEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CreationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreationHeight)
+		if !f(fd_UnbondingEntry_creation_height, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_UnbondingEntry_end_time, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_UnbondingEntry_amount, value) {
+			return
+		}
+	}
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_UnbondingEntry_validator_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UnbondingEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
+		return x.CreationHeight != int64(0)
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
+		return x.EndTime != nil
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
+		return x.Amount != nil
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
+		return x.ValidatorAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
+		x.CreationHeight = int64(0)
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
+		x.EndTime = nil
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
+		x.Amount = nil
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
+		x.ValidatorAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UnbondingEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
+		value := x.CreationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
+		x.CreationHeight = value.Int()
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
+		panic(fmt.Errorf("field creation_height of message cosmos.accounts.defaults.lockup.v1.UnbondingEntry is not mutable"))
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.accounts.defaults.lockup.v1.UnbondingEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UnbondingEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UnbondingEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.UnbondingEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UnbondingEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UnbondingEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UnbondingEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CreationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreationHeight))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CreationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreationHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+				}
+				x.CreationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_UnlockScheduleEntry_2_list)(nil)
+
+type _UnlockScheduleEntry_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_UnlockScheduleEntry_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_UnlockScheduleEntry_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_UnlockScheduleEntry_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_UnlockScheduleEntry_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_UnlockScheduleEntry_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnlockScheduleEntry_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_UnlockScheduleEntry_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnlockScheduleEntry_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_UnlockScheduleEntry                protoreflect.MessageDescriptor
+	fd_UnlockScheduleEntry_time           protoreflect.FieldDescriptor
+	fd_UnlockScheduleEntry_unlocked_coins protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init()
+	md_UnlockScheduleEntry = File_cosmos_accounts_defaults_lockup_v1_lockup_proto.Messages().ByName("UnlockScheduleEntry")
+	fd_UnlockScheduleEntry_time = md_UnlockScheduleEntry.Fields().ByName("time")
+	fd_UnlockScheduleEntry_unlocked_coins = md_UnlockScheduleEntry.Fields().ByName("unlocked_coins")
+}
+
+var _ protoreflect.Message = (*fastReflection_UnlockScheduleEntry)(nil)
+
+type fastReflection_UnlockScheduleEntry UnlockScheduleEntry
+
+func (x *UnlockScheduleEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnlockScheduleEntry)(x)
+}
+
+func (x *UnlockScheduleEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UnlockScheduleEntry_messageType fastReflection_UnlockScheduleEntry_messageType
+var _ protoreflect.MessageType = fastReflection_UnlockScheduleEntry_messageType{}
+
+type fastReflection_UnlockScheduleEntry_messageType struct{}
+
+func (x fastReflection_UnlockScheduleEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnlockScheduleEntry)(nil)
+}
+func (x fastReflection_UnlockScheduleEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnlockScheduleEntry)
+}
+func (x fastReflection_UnlockScheduleEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnlockScheduleEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UnlockScheduleEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnlockScheduleEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UnlockScheduleEntry) Type() protoreflect.MessageType {
+	return _fastReflection_UnlockScheduleEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UnlockScheduleEntry) New() protoreflect.Message {
+	return new(fastReflection_UnlockScheduleEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UnlockScheduleEntry) Interface() protoreflect.ProtoMessage {
+	return (*UnlockScheduleEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UnlockScheduleEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_UnlockScheduleEntry_time, value) {
+			return
+		}
+	}
+	if len(x.UnlockedCoins) != 0 {
+		value := protoreflect.ValueOfList(&_UnlockScheduleEntry_2_list{list: &x.UnlockedCoins})
+		if !f(fd_UnlockScheduleEntry_unlocked_coins, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UnlockScheduleEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.time":
+		return x.Time != nil
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.unlocked_coins":
+		return len(x.UnlockedCoins) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnlockScheduleEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.time":
+		x.Time = nil
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.unlocked_coins":
+		x.UnlockedCoins = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UnlockScheduleEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.unlocked_coins":
+		if len(x.UnlockedCoins) == 0 {
+			return protoreflect.ValueOfList(&_UnlockScheduleEntry_2_list{})
+		}
+		listValue := &_UnlockScheduleEntry_2_list{list: &x.UnlockedCoins}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnlockScheduleEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.unlocked_coins":
+		lv := value.List()
+		clv := lv.(*_UnlockScheduleEntry_2_list)
+		x.UnlockedCoins = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnlockScheduleEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.unlocked_coins":
+		if x.UnlockedCoins == nil {
+			x.UnlockedCoins = []*v1beta1.Coin{}
+		}
+		value := &_UnlockScheduleEntry_2_list{list: &x.UnlockedCoins}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UnlockScheduleEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.unlocked_coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_UnlockScheduleEntry_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UnlockScheduleEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UnlockScheduleEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnlockScheduleEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UnlockScheduleEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UnlockScheduleEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UnlockScheduleEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.UnlockedCoins) > 0 {
+			for _, e := range x.UnlockedCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UnlockScheduleEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UnlockedCoins) > 0 {
+			for iNdEx := len(x.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnlockedCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UnlockScheduleEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnlockScheduleEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnlockScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnlockedCoins = append(x.UnlockedCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnlockedCoins[len(x.UnlockedCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_LegacyVestingState_1_list)(nil)
+
+type _LegacyVestingState_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_LegacyVestingState_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LegacyVestingState_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LegacyVestingState_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LegacyVestingState_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LegacyVestingState_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LegacyVestingState_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LegacyVestingState_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LegacyVestingState_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LegacyVestingState_2_list)(nil)
+
+type _LegacyVestingState_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_LegacyVestingState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LegacyVestingState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LegacyVestingState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LegacyVestingState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LegacyVestingState_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LegacyVestingState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LegacyVestingState_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LegacyVestingState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LegacyVestingState_3_list)(nil)
+
+type _LegacyVestingState_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_LegacyVestingState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LegacyVestingState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LegacyVestingState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LegacyVestingState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LegacyVestingState_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LegacyVestingState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LegacyVestingState_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LegacyVestingState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LegacyVestingState                   protoreflect.MessageDescriptor
+	fd_LegacyVestingState_original_locking  protoreflect.FieldDescriptor
+	fd_LegacyVestingState_delegated_free    protoreflect.FieldDescriptor
+	fd_LegacyVestingState_delegated_locking protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init()
+	md_LegacyVestingState = File_cosmos_accounts_defaults_lockup_v1_lockup_proto.Messages().ByName("LegacyVestingState")
+	fd_LegacyVestingState_original_locking = md_LegacyVestingState.Fields().ByName("original_locking")
+	fd_LegacyVestingState_delegated_free = md_LegacyVestingState.Fields().ByName("delegated_free")
+	fd_LegacyVestingState_delegated_locking = md_LegacyVestingState.Fields().ByName("delegated_locking")
+}
+
+var _ protoreflect.Message = (*fastReflection_LegacyVestingState)(nil)
+
+type fastReflection_LegacyVestingState LegacyVestingState
+
+func (x *LegacyVestingState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LegacyVestingState)(x)
+}
+
+func (x *LegacyVestingState) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LegacyVestingState_messageType fastReflection_LegacyVestingState_messageType
+var _ protoreflect.MessageType = fastReflection_LegacyVestingState_messageType{}
+
+type fastReflection_LegacyVestingState_messageType struct{}
+
+func (x fastReflection_LegacyVestingState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LegacyVestingState)(nil)
+}
+func (x fastReflection_LegacyVestingState_messageType) New() protoreflect.Message {
+	return new(fastReflection_LegacyVestingState)
+}
+func (x fastReflection_LegacyVestingState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LegacyVestingState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LegacyVestingState) Descriptor() protoreflect.MessageDescriptor {
+	return md_LegacyVestingState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LegacyVestingState) Type() protoreflect.MessageType {
+	return _fastReflection_LegacyVestingState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LegacyVestingState) New() protoreflect.Message {
+	return new(fastReflection_LegacyVestingState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LegacyVestingState) Interface() protoreflect.ProtoMessage {
+	return (*LegacyVestingState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LegacyVestingState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.OriginalLocking) != 0 {
+		value := protoreflect.ValueOfList(&_LegacyVestingState_1_list{list: &x.OriginalLocking})
+		if !f(fd_LegacyVestingState_original_locking, value) {
+			return
+		}
+	}
+	if len(x.DelegatedFree) != 0 {
+		value := protoreflect.ValueOfList(&_LegacyVestingState_2_list{list: &x.DelegatedFree})
+		if !f(fd_LegacyVestingState_delegated_free, value) {
+			return
+		}
+	}
+	if len(x.DelegatedLocking) != 0 {
+		value := protoreflect.ValueOfList(&_LegacyVestingState_3_list{list: &x.DelegatedLocking})
+		if !f(fd_LegacyVestingState_delegated_locking, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LegacyVestingState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.original_locking":
+		return len(x.OriginalLocking) != 0
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_free":
+		return len(x.DelegatedFree) != 0
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_locking":
+		return len(x.DelegatedLocking) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.LegacyVestingState"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.LegacyVestingState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LegacyVestingState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.original_locking":
+		x.OriginalLocking = nil
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_free":
+		x.DelegatedFree = nil
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_locking":
+		x.DelegatedLocking = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.LegacyVestingState"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.LegacyVestingState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LegacyVestingState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.original_locking":
+		if len(x.OriginalLocking) == 0 {
+			return protoreflect.ValueOfList(&_LegacyVestingState_1_list{})
+		}
+		listValue := &_LegacyVestingState_1_list{list: &x.OriginalLocking}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_free":
+		if len(x.DelegatedFree) == 0 {
+			return protoreflect.ValueOfList(&_LegacyVestingState_2_list{})
+		}
+		listValue := &_LegacyVestingState_2_list{list: &x.DelegatedFree}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_locking":
+		if len(x.DelegatedLocking) == 0 {
+			return protoreflect.ValueOfList(&_LegacyVestingState_3_list{})
+		}
+		listValue := &_LegacyVestingState_3_list{list: &x.DelegatedLocking}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.LegacyVestingState"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.LegacyVestingState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LegacyVestingState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.original_locking":
+		lv := value.List()
+		clv := lv.(*_LegacyVestingState_1_list)
+		x.OriginalLocking = *clv.list
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_free":
+		lv := value.List()
+		clv := lv.(*_LegacyVestingState_2_list)
+		x.DelegatedFree = *clv.list
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_locking":
+		lv := value.List()
+		clv := lv.(*_LegacyVestingState_3_list)
+		x.DelegatedLocking = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.LegacyVestingState"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.LegacyVestingState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LegacyVestingState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.original_locking":
+		if x.OriginalLocking == nil {
+			x.OriginalLocking = []*v1beta1.Coin{}
+		}
+		value := &_LegacyVestingState_1_list{list: &x.OriginalLocking}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_free":
+		if x.DelegatedFree == nil {
+			x.DelegatedFree = []*v1beta1.Coin{}
+		}
+		value := &_LegacyVestingState_2_list{list: &x.DelegatedFree}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_locking":
+		if x.DelegatedLocking == nil {
+			x.DelegatedLocking = []*v1beta1.Coin{}
+		}
+		value := &_LegacyVestingState_3_list{list: &x.DelegatedLocking}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.LegacyVestingState"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.LegacyVestingState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LegacyVestingState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.original_locking":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_LegacyVestingState_1_list{list: &list})
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_free":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_LegacyVestingState_2_list{list: &list})
+	case "cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_locking":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_LegacyVestingState_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.LegacyVestingState"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.LegacyVestingState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LegacyVestingState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.LegacyVestingState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LegacyVestingState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LegacyVestingState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LegacyVestingState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LegacyVestingState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LegacyVestingState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.OriginalLocking) > 0 {
+			for _, e := range x.OriginalLocking {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DelegatedFree) > 0 {
+			for _, e := range x.DelegatedFree {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DelegatedLocking) > 0 {
+			for _, e := range x.DelegatedLocking {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LegacyVestingState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DelegatedLocking) > 0 {
+			for iNdEx := len(x.DelegatedLocking) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelegatedLocking[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.DelegatedFree) > 0 {
+			for iNdEx := len(x.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelegatedFree[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.OriginalLocking) > 0 {
+			for iNdEx := len(x.OriginalLocking) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OriginalLocking[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LegacyVestingState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LegacyVestingState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LegacyVestingState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginalLocking", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OriginalLocking = append(x.OriginalLocking, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OriginalLocking[len(x.OriginalLocking)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatedFree = append(x.DelegatedFree, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegatedFree[len(x.DelegatedFree)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatedLocking", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatedLocking = append(x.DelegatedLocking, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegatedLocking[len(x.DelegatedLocking)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SpendAllowance_2_list)(nil)
+
+type _SpendAllowance_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_SpendAllowance_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SpendAllowance_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SpendAllowance_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SpendAllowance_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SpendAllowance_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendAllowance_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SpendAllowance_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendAllowance_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SpendAllowance             protoreflect.MessageDescriptor
+	fd_SpendAllowance_grantee     protoreflect.FieldDescriptor
+	fd_SpendAllowance_spend_limit protoreflect.FieldDescriptor
+	fd_SpendAllowance_expiration  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init()
+	md_SpendAllowance = File_cosmos_accounts_defaults_lockup_v1_lockup_proto.Messages().ByName("SpendAllowance")
+	fd_SpendAllowance_grantee = md_SpendAllowance.Fields().ByName("grantee")
+	fd_SpendAllowance_spend_limit = md_SpendAllowance.Fields().ByName("spend_limit")
+	fd_SpendAllowance_expiration = md_SpendAllowance.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_SpendAllowance)(nil)
+
+type fastReflection_SpendAllowance SpendAllowance
+
+func (x *SpendAllowance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SpendAllowance)(x)
+}
+
+func (x *SpendAllowance) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SpendAllowance_messageType fastReflection_SpendAllowance_messageType
+var _ protoreflect.MessageType = fastReflection_SpendAllowance_messageType{}
+
+type fastReflection_SpendAllowance_messageType struct{}
+
+func (x fastReflection_SpendAllowance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SpendAllowance)(nil)
+}
+func (x fastReflection_SpendAllowance_messageType) New() protoreflect.Message {
+	return new(fastReflection_SpendAllowance)
+}
+func (x fastReflection_SpendAllowance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpendAllowance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SpendAllowance) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpendAllowance
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_UnbondingEntry) Type() protoreflect.MessageType {
-	return _fastReflection_UnbondingEntry_messageType
+func (x *fastReflection_SpendAllowance) Type() protoreflect.MessageType {
+	return _fastReflection_SpendAllowance_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_UnbondingEntry) New() protoreflect.Message {
-	return new(fastReflection_UnbondingEntry)
+func (x *fastReflection_SpendAllowance) New() protoreflect.Message {
+	return new(fastReflection_SpendAllowance)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_UnbondingEntry) Interface() protoreflect.ProtoMessage {
-	return (*UnbondingEntry)(x)
+func (x *fastReflection_SpendAllowance) Interface() protoreflect.ProtoMessage {
+	return (*SpendAllowance)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1166,28 +3821,22 @@ func (x *fastReflection_UnbondingEntry) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_UnbondingEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CreationHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.CreationHeight)
-		if !f(fd_UnbondingEntry_creation_height, value) {
-			return
-		}
-	}
-	if x.EndTime != nil {
-		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
-		if !f(fd_UnbondingEntry_end_time, value) {
+func (x *fastReflection_SpendAllowance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_SpendAllowance_grantee, value) {
 			return
 		}
 	}
-	if x.Amount != nil {
-		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-		if !f(fd_UnbondingEntry_amount, value) {
+	if len(x.SpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_SpendAllowance_2_list{list: &x.SpendLimit})
+		if !f(fd_SpendAllowance_spend_limit, value) {
 			return
 		}
 	}
-	if x.ValidatorAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_UnbondingEntry_validator_address, value) {
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_SpendAllowance_expiration, value) {
 			return
 		}
 	}
@@ -1204,21 +3853,19 @@ func (x *fastReflection_UnbondingEntry) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_UnbondingEntry) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SpendAllowance) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
-		return x.CreationHeight != int64(0)
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
-		return x.EndTime != nil
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
-		return x.Amount != nil
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
-		return x.ValidatorAddress != ""
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.grantee":
+		return x.Grantee != ""
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.spend_limit":
+		return len(x.SpendLimit) != 0
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.expiration":
+		return x.Expiration != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.SpendAllowance"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.SpendAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1228,21 +3875,19 @@ func (x *fastReflection_UnbondingEntry) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingEntry) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SpendAllowance) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
-		x.CreationHeight = int64(0)
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
-		x.EndTime = nil
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
-		x.Amount = nil
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
-		x.ValidatorAddress = ""
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.grantee":
+		x.Grantee = ""
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.spend_limit":
+		x.SpendLimit = nil
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.expiration":
+		x.Expiration = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.SpendAllowance"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.SpendAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1252,25 +3897,25 @@ func (x *fastReflection_UnbondingEntry) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_UnbondingEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SpendAllowance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
-		value := x.CreationHeight
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
-		value := x.EndTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
-		value := x.Amount
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
-		value := x.ValidatorAddress
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.grantee":
+		value := x.Grantee
 		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.spend_limit":
+		if len(x.SpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_SpendAllowance_2_list{})
+		}
+		listValue := &_SpendAllowance_2_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.SpendAllowance"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.SpendAllowance does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1284,21 +3929,21 @@ func (x *fastReflection_UnbondingEntry) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SpendAllowance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
-		x.CreationHeight = value.Int()
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
-		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
-		x.Amount = value.Message().Interface().(*v1beta1.Coin)
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
-		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.grantee":
+		x.Grantee = value.Interface().(string)
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.spend_limit":
+		lv := value.List()
+		clv := lv.(*_SpendAllowance_2_list)
+		x.SpendLimit = *clv.list
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.SpendAllowance"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.SpendAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1312,60 +3957,57 @@ func (x *fastReflection_UnbondingEntry) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SpendAllowance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
-		if x.EndTime == nil {
-			x.EndTime = new(timestamppb.Timestamp)
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.spend_limit":
+		if x.SpendLimit == nil {
+			x.SpendLimit = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
-		if x.Amount == nil {
-			x.Amount = new(v1beta1.Coin)
+		value := &_SpendAllowance_2_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
-		panic(fmt.Errorf("field creation_height of message cosmos.accounts.defaults.lockup.v1.UnbondingEntry is not mutable"))
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
-		panic(fmt.Errorf("field validator_address of message cosmos.accounts.defaults.lockup.v1.UnbondingEntry is not mutable"))
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.grantee":
+		panic(fmt.Errorf("field grantee of message cosmos.accounts.defaults.lockup.v1.SpendAllowance is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.SpendAllowance"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.SpendAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_UnbondingEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SpendAllowance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.creation_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time":
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.grantee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SpendAllowance_2_list{list: &list})
+	case "cosmos.accounts.defaults.lockup.v1.SpendAllowance.expiration":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.accounts.defaults.lockup.v1.UnbondingEntry.validator_address":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.UnbondingEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.SpendAllowance"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.UnbondingEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.SpendAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_UnbondingEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SpendAllowance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.UnbondingEntry", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.SpendAllowance", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1373,7 +4015,7 @@ func (x *fastReflection_UnbondingEntry) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_UnbondingEntry) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SpendAllowance) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1384,7 +4026,7 @@ func (x *fastReflection_UnbondingEntry) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UnbondingEntry) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SpendAllowance) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1396,7 +4038,7 @@ func (x *fastReflection_UnbondingEntry) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_UnbondingEntry) IsValid() bool {
+func (x *fastReflection_SpendAllowance) IsValid() bool {
 	return x != nil
 }
 
@@ -1406,9 +4048,9 @@ func (x *fastReflection_UnbondingEntry) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SpendAllowance) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*UnbondingEntry)
+		x := input.Message.Interface().(*SpendAllowance)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1420,19 +4062,18 @@ func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.CreationHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.CreationHeight))
-		}
-		if x.EndTime != nil {
-			l = options.Size(x.EndTime)
+		l = len(x.Grantee)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Amount != nil {
-			l = options.Size(x.Amount)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.SpendLimit) > 0 {
+			for _, e := range x.SpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.ValidatorAddress)
-		if l > 0 {
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1445,7 +4086,7 @@ func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*UnbondingEntry)
+		x := input.Message.Interface().(*SpendAllowance)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1464,15 +4105,8 @@ func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorAddress) > 0 {
-			i -= len(x.ValidatorAddress)
-			copy(dAtA[i:], x.ValidatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Amount != nil {
-			encoded, err := options.Marshal(x.Amount)
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1485,24 +4119,28 @@ func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.EndTime != nil {
-			encoded, err := options.Marshal(x.EndTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.SpendLimit) > 0 {
+			for iNdEx := len(x.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
 		}
-		if x.CreationHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreationHeight))
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1515,7 +4153,7 @@ func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*UnbondingEntry)
+		x := input.Message.Interface().(*SpendAllowance)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1547,36 +4185,17 @@ func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpendAllowance: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpendAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-				}
-				x.CreationHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.CreationHeight |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1586,31 +4205,27 @@ func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.EndTime == nil {
-					x.EndTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1637,18 +4252,16 @@ func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Amount == nil {
-					x.Amount = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+				x.SpendLimit = append(x.SpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit[len(x.SpendLimit)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1658,23 +4271,27 @@ func (x *fastReflection_UnbondingEntry) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1769,6 +4386,61 @@ func (x *Period) GetAmount() []*v1beta1.Coin {
 	return nil
 }
 
+// DenomLockingSchedule defines the locking periods of a single denom of a periodic lockup account, which
+// are independent of the locking periods of the other denoms.
+type DenomLockingSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the only denom of the amounts of the locking periods
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_time is start of the lockup of the denom
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	LockingPeriods []*Period              `protobuf:"bytes,3,rep,name=locking_periods,json=lockingPeriods,proto3" json:"locking_periods,omitempty"`
+}
+
+func (x *DenomLockingSchedule) Reset() {
+	*x = DenomLockingSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomLockingSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomLockingSchedule) ProtoMessage() {}
+
+// Deprecated: Use DenomLockingSchedule.ProtoReflect.Descriptor instead.
+func (*DenomLockingSchedule) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DenomLockingSchedule) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DenomLockingSchedule) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DenomLockingSchedule) GetLockingPeriods() []*Period {
+	if x != nil {
+		return x.LockingPeriods
+	}
+	return nil
+}
+
 type UnbondingEntries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1780,7 +4452,7 @@ type UnbondingEntries struct {
 func (x *UnbondingEntries) Reset() {
 	*x = UnbondingEntries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[1]
+		mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1794,7 +4466,7 @@ func (*UnbondingEntries) ProtoMessage() {}
 
 // Deprecated: Use UnbondingEntries.ProtoReflect.Descriptor instead.
 func (*UnbondingEntries) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UnbondingEntries) GetEntries() []*UnbondingEntry {
@@ -1822,7 +4494,7 @@ type UnbondingEntry struct {
 func (x *UnbondingEntry) Reset() {
 	*x = UnbondingEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[2]
+		mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1836,7 +4508,7 @@ func (*UnbondingEntry) ProtoMessage() {}
 
 // Deprecated: Use UnbondingEntry.ProtoReflect.Descriptor instead.
 func (*UnbondingEntry) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UnbondingEntry) GetCreationHeight() int64 {
@@ -1867,6 +4539,160 @@ func (x *UnbondingEntry) GetValidatorAddress() string {
 	return ""
 }
 
+// UnlockScheduleEntry defines the cumulative unlocked coins of a lockup account at a given time.
+type UnlockScheduleEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	UnlockedCoins []*v1beta1.Coin        `protobuf:"bytes,2,rep,name=unlocked_coins,json=unlockedCoins,proto3" json:"unlocked_coins,omitempty"`
+}
+
+func (x *UnlockScheduleEntry) Reset() {
+	*x = UnlockScheduleEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockScheduleEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockScheduleEntry) ProtoMessage() {}
+
+// Deprecated: Use UnlockScheduleEntry.ProtoReflect.Descriptor instead.
+func (*UnlockScheduleEntry) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnlockScheduleEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *UnlockScheduleEntry) GetUnlockedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.UnlockedCoins
+	}
+	return nil
+}
+
+// LegacyVestingState defines the state of a legacy x/auth vesting account migrated to a lockup account.
+type LegacyVestingState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// original_locking is the original vesting amount of the legacy account.
+	OriginalLocking []*v1beta1.Coin `protobuf:"bytes,1,rep,name=original_locking,json=originalLocking,proto3" json:"original_locking,omitempty"`
+	// delegated_free is the delegated vested amount of the legacy account.
+	DelegatedFree []*v1beta1.Coin `protobuf:"bytes,2,rep,name=delegated_free,json=delegatedFree,proto3" json:"delegated_free,omitempty"`
+	// delegated_locking is the delegated vesting amount of the legacy account.
+	DelegatedLocking []*v1beta1.Coin `protobuf:"bytes,3,rep,name=delegated_locking,json=delegatedLocking,proto3" json:"delegated_locking,omitempty"`
+}
+
+func (x *LegacyVestingState) Reset() {
+	*x = LegacyVestingState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegacyVestingState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyVestingState) ProtoMessage() {}
+
+// Deprecated: Use LegacyVestingState.ProtoReflect.Descriptor instead.
+func (*LegacyVestingState) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LegacyVestingState) GetOriginalLocking() []*v1beta1.Coin {
+	if x != nil {
+		return x.OriginalLocking
+	}
+	return nil
+}
+
+func (x *LegacyVestingState) GetDelegatedFree() []*v1beta1.Coin {
+	if x != nil {
+		return x.DelegatedFree
+	}
+	return nil
+}
+
+func (x *LegacyVestingState) GetDelegatedLocking() []*v1beta1.Coin {
+	if x != nil {
+		return x.DelegatedLocking
+	}
+	return nil
+}
+
+// SpendAllowance defines an allowance granted by the owner of a lockup account, which lets the grantee spend
+// the unlocked funds of the account.
+type SpendAllowance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// spend_limit is the remaining amount the grantee can spend, it is unlimited if empty.
+	SpendLimit []*v1beta1.Coin `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// expiration is the time after which the allowance cannot be spent, if any.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *SpendAllowance) Reset() {
+	*x = SpendAllowance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpendAllowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendAllowance) ProtoMessage() {}
+
+// Deprecated: Use SpendAllowance.ProtoReflect.Descriptor instead.
+func (*SpendAllowance) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SpendAllowance) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *SpendAllowance) GetSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return nil
+}
+
+func (x *SpendAllowance) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
 var File_cosmos_accounts_defaults_lockup_v1_lockup_proto protoreflect.FileDescriptor
 
 var file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDesc = []byte{
@@ -1898,48 +4724,121 @@ var file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
 	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
 	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x60, 0x0a, 0x10, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62,
-	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x22, 0x8d, 0x02, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x44, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65,
-	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x42, 0xa0, 0x02, 0x0a, 0x26, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x42,
-	0x0b, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
-	0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x04, 0x43,
-	0x41, 0x44, 0x4c, 0xaa, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x4c,
-	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2e,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x26, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x3a, 0x3a, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x3a, 0x4c, 0x6f, 0x63,
-	0x6b, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x48, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x60, 0x0a, 0x10, 0x55,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x4c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x8d, 0x02,
+	0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x3c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a,
+	0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xdf, 0x01,
+	0x0a, 0x13, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0e, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0d, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x22,
+	0xc5, 0x03, 0x0a, 0x12, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x88, 0x01, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c,
+	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x65, 0x65,
+	0x12, 0x8e, 0x01, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8b, 0x02, 0x0a, 0x0e, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x82,
+	0x01, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xa0, 0x02, 0x0a, 0x26, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x42, 0x0b, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x3c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
+	0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x04,
+	0x43, 0x41, 0x44, 0x4c, 0xaa, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x5c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x26, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x3a, 0x3a, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x3a, 0x4c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1954,26 +4853,39 @@ func file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescGZIP() []byte {
 	return file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDescData
 }
 
-var file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_cosmos_accounts_defaults_lockup_v1_lockup_proto_goTypes = []interface{}{
 	(*Period)(nil),                // 0: cosmos.accounts.defaults.lockup.v1.Period
-	(*UnbondingEntries)(nil),      // 1: cosmos.accounts.defaults.lockup.v1.UnbondingEntries
-	(*UnbondingEntry)(nil),        // 2: cosmos.accounts.defaults.lockup.v1.UnbondingEntry
-	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
-	(*v1beta1.Coin)(nil),          // 4: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*DenomLockingSchedule)(nil),  // 1: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule
+	(*UnbondingEntries)(nil),      // 2: cosmos.accounts.defaults.lockup.v1.UnbondingEntries
+	(*UnbondingEntry)(nil),        // 3: cosmos.accounts.defaults.lockup.v1.UnbondingEntry
+	(*UnlockScheduleEntry)(nil),   // 4: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry
+	(*LegacyVestingState)(nil),    // 5: cosmos.accounts.defaults.lockup.v1.LegacyVestingState
+	(*SpendAllowance)(nil),        // 6: cosmos.accounts.defaults.lockup.v1.SpendAllowance
+	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
+	(*v1beta1.Coin)(nil),          // 8: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
 }
 var file_cosmos_accounts_defaults_lockup_v1_lockup_proto_depIdxs = []int32{
-	3, // 0: cosmos.accounts.defaults.lockup.v1.Period.length:type_name -> google.protobuf.Duration
-	4, // 1: cosmos.accounts.defaults.lockup.v1.Period.amount:type_name -> cosmos.base.v1beta1.Coin
-	2, // 2: cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries:type_name -> cosmos.accounts.defaults.lockup.v1.UnbondingEntry
-	5, // 3: cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time:type_name -> google.protobuf.Timestamp
-	4, // 4: cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount:type_name -> cosmos.base.v1beta1.Coin
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	7,  // 0: cosmos.accounts.defaults.lockup.v1.Period.length:type_name -> google.protobuf.Duration
+	8,  // 1: cosmos.accounts.defaults.lockup.v1.Period.amount:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 2: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.start_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule.locking_periods:type_name -> cosmos.accounts.defaults.lockup.v1.Period
+	3,  // 4: cosmos.accounts.defaults.lockup.v1.UnbondingEntries.entries:type_name -> cosmos.accounts.defaults.lockup.v1.UnbondingEntry
+	9,  // 5: cosmos.accounts.defaults.lockup.v1.UnbondingEntry.end_time:type_name -> google.protobuf.Timestamp
+	8,  // 6: cosmos.accounts.defaults.lockup.v1.UnbondingEntry.amount:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 7: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.time:type_name -> google.protobuf.Timestamp
+	8,  // 8: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry.unlocked_coins:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 9: cosmos.accounts.defaults.lockup.v1.LegacyVestingState.original_locking:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 10: cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_free:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 11: cosmos.accounts.defaults.lockup.v1.LegacyVestingState.delegated_locking:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 12: cosmos.accounts.defaults.lockup.v1.SpendAllowance.spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 13: cosmos.accounts.defaults.lockup.v1.SpendAllowance.expiration:type_name -> google.protobuf.Timestamp
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init() }
@@ -1995,7 +4907,7 @@ func file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnbondingEntries); i {
+			switch v := v.(*DenomLockingSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2007,6 +4919,18 @@ func file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbondingEntries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnbondingEntry); i {
 			case 0:
 				return &v.state
@@ -2018,6 +4942,42 @@ func file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockScheduleEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegacyVestingState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_lockup_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendAllowance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2025,7 +4985,7 @@ func file_cosmos_accounts_defaults_lockup_v1_lockup_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_accounts_defaults_lockup_v1_lockup_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
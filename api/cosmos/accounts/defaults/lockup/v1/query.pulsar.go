@@ -627,6 +627,57 @@ func (x *_QueryLockupAccountInfoResponse_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_QueryLockupAccountInfoResponse_10_list)(nil)
+
+type _QueryLockupAccountInfoResponse_10_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_QueryLockupAccountInfoResponse_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLockupAccountInfoResponse_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryLockupAccountInfoResponse_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLockupAccountInfoResponse_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLockupAccountInfoResponse_10_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockupAccountInfoResponse_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLockupAccountInfoResponse_10_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockupAccountInfoResponse_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_QueryLockupAccountInfoResponse                   protoreflect.MessageDescriptor
 	fd_QueryLockupAccountInfoResponse_original_locking  protoreflect.FieldDescriptor
@@ -637,6 +688,12 @@ var (
 	fd_QueryLockupAccountInfoResponse_locked_coins      protoreflect.FieldDescriptor
 	fd_QueryLockupAccountInfoResponse_unlocked_coins    protoreflect.FieldDescriptor
 	fd_QueryLockupAccountInfoResponse_owner             protoreflect.FieldDescriptor
+	fd_QueryLockupAccountInfoResponse_admin             protoreflect.FieldDescriptor
+	fd_QueryLockupAccountInfoResponse_clawback_pending  protoreflect.FieldDescriptor
+	fd_QueryLockupAccountInfoResponse_cliff_time        protoreflect.FieldDescriptor
+	fd_QueryLockupAccountInfoResponse_pending_owner     protoreflect.FieldDescriptor
+	fd_QueryLockupAccountInfoResponse_auto_compound     protoreflect.FieldDescriptor
+	fd_QueryLockupAccountInfoResponse_frozen            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -650,6 +707,12 @@ func init() {
 	fd_QueryLockupAccountInfoResponse_locked_coins = md_QueryLockupAccountInfoResponse.Fields().ByName("locked_coins")
 	fd_QueryLockupAccountInfoResponse_unlocked_coins = md_QueryLockupAccountInfoResponse.Fields().ByName("unlocked_coins")
 	fd_QueryLockupAccountInfoResponse_owner = md_QueryLockupAccountInfoResponse.Fields().ByName("owner")
+	fd_QueryLockupAccountInfoResponse_admin = md_QueryLockupAccountInfoResponse.Fields().ByName("admin")
+	fd_QueryLockupAccountInfoResponse_clawback_pending = md_QueryLockupAccountInfoResponse.Fields().ByName("clawback_pending")
+	fd_QueryLockupAccountInfoResponse_cliff_time = md_QueryLockupAccountInfoResponse.Fields().ByName("cliff_time")
+	fd_QueryLockupAccountInfoResponse_pending_owner = md_QueryLockupAccountInfoResponse.Fields().ByName("pending_owner")
+	fd_QueryLockupAccountInfoResponse_auto_compound = md_QueryLockupAccountInfoResponse.Fields().ByName("auto_compound")
+	fd_QueryLockupAccountInfoResponse_frozen = md_QueryLockupAccountInfoResponse.Fields().ByName("frozen")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryLockupAccountInfoResponse)(nil)
@@ -765,6 +828,42 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) Range(f func(protoreflec
 			return
 		}
 	}
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_QueryLockupAccountInfoResponse_admin, value) {
+			return
+		}
+	}
+	if len(x.ClawbackPending) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLockupAccountInfoResponse_10_list{list: &x.ClawbackPending})
+		if !f(fd_QueryLockupAccountInfoResponse_clawback_pending, value) {
+			return
+		}
+	}
+	if x.CliffTime != nil {
+		value := protoreflect.ValueOfMessage(x.CliffTime.ProtoReflect())
+		if !f(fd_QueryLockupAccountInfoResponse_cliff_time, value) {
+			return
+		}
+	}
+	if x.PendingOwner != "" {
+		value := protoreflect.ValueOfString(x.PendingOwner)
+		if !f(fd_QueryLockupAccountInfoResponse_pending_owner, value) {
+			return
+		}
+	}
+	if x.AutoCompound != false {
+		value := protoreflect.ValueOfBool(x.AutoCompound)
+		if !f(fd_QueryLockupAccountInfoResponse_auto_compound, value) {
+			return
+		}
+	}
+	if x.Frozen != false {
+		value := protoreflect.ValueOfBool(x.Frozen)
+		if !f(fd_QueryLockupAccountInfoResponse_frozen, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -796,6 +895,18 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) Has(fd protoreflect.Fiel
 		return len(x.UnlockedCoins) != 0
 	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.owner":
 		return x.Owner != ""
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.admin":
+		return x.Admin != ""
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.clawback_pending":
+		return len(x.ClawbackPending) != 0
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.cliff_time":
+		return x.CliffTime != nil
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.pending_owner":
+		return x.PendingOwner != ""
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.auto_compound":
+		return x.AutoCompound != false
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.frozen":
+		return x.Frozen != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse"))
@@ -828,6 +939,18 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) Clear(fd protoreflect.Fi
 		x.UnlockedCoins = nil
 	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.owner":
 		x.Owner = ""
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.admin":
+		x.Admin = ""
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.clawback_pending":
+		x.ClawbackPending = nil
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.cliff_time":
+		x.CliffTime = nil
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.pending_owner":
+		x.PendingOwner = ""
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.auto_compound":
+		x.AutoCompound = false
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.frozen":
+		x.Frozen = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse"))
@@ -883,6 +1006,27 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) Get(descriptor protorefl
 	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.owner":
 		value := x.Owner
 		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.clawback_pending":
+		if len(x.ClawbackPending) == 0 {
+			return protoreflect.ValueOfList(&_QueryLockupAccountInfoResponse_10_list{})
+		}
+		listValue := &_QueryLockupAccountInfoResponse_10_list{list: &x.ClawbackPending}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.cliff_time":
+		value := x.CliffTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.pending_owner":
+		value := x.PendingOwner
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.auto_compound":
+		value := x.AutoCompound
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.frozen":
+		value := x.Frozen
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse"))
@@ -929,6 +1073,20 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) Set(fd protoreflect.Fiel
 		x.UnlockedCoins = *clv.list
 	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.owner":
 		x.Owner = value.Interface().(string)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.admin":
+		x.Admin = value.Interface().(string)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.clawback_pending":
+		lv := value.List()
+		clv := lv.(*_QueryLockupAccountInfoResponse_10_list)
+		x.ClawbackPending = *clv.list
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.cliff_time":
+		x.CliffTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.pending_owner":
+		x.PendingOwner = value.Interface().(string)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.auto_compound":
+		x.AutoCompound = value.Bool()
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.frozen":
+		x.Frozen = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse"))
@@ -989,8 +1147,27 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) Mutable(fd protoreflect.
 		}
 		value := &_QueryLockupAccountInfoResponse_7_list{list: &x.UnlockedCoins}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.clawback_pending":
+		if x.ClawbackPending == nil {
+			x.ClawbackPending = []*v1beta1.Coin{}
+		}
+		value := &_QueryLockupAccountInfoResponse_10_list{list: &x.ClawbackPending}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.cliff_time":
+		if x.CliffTime == nil {
+			x.CliffTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CliffTime.ProtoReflect())
 	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.owner":
 		panic(fmt.Errorf("field owner of message cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse is not mutable"))
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.admin":
+		panic(fmt.Errorf("field admin of message cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse is not mutable"))
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.pending_owner":
+		panic(fmt.Errorf("field pending_owner of message cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse is not mutable"))
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.auto_compound":
+		panic(fmt.Errorf("field auto_compound of message cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse is not mutable"))
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.frozen":
+		panic(fmt.Errorf("field frozen of message cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse"))
@@ -1027,6 +1204,20 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) NewField(fd protoreflect
 		return protoreflect.ValueOfList(&_QueryLockupAccountInfoResponse_7_list{list: &list})
 	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.owner":
 		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.admin":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.clawback_pending":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_QueryLockupAccountInfoResponse_10_list{list: &list})
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.cliff_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.pending_owner":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.auto_compound":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.frozen":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse"))
@@ -1138,6 +1329,30 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) ProtoMethods() *protoifa
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ClawbackPending) > 0 {
+			for _, e := range x.ClawbackPending {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CliffTime != nil {
+			l = options.Size(x.CliffTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoCompound {
+			n += 2
+		}
+		if x.Frozen {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1167,6 +1382,70 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Frozen {
+			i--
+			if x.Frozen {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.AutoCompound {
+			i--
+			if x.AutoCompound {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.PendingOwner) > 0 {
+			i -= len(x.PendingOwner)
+			copy(dAtA[i:], x.PendingOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingOwner)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.CliffTime != nil {
+			encoded, err := options.Marshal(x.CliffTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.ClawbackPending) > 0 {
+			for iNdEx := len(x.ClawbackPending) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ClawbackPending[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if len(x.Owner) > 0 {
 			i -= len(x.Owner)
 			copy(dAtA[i:], x.Owner)
@@ -1605,67 +1884,241 @@ func (x *fastReflection_QueryLockupAccountInfoResponse) ProtoMethods() *protoifa
 				}
 				x.Owner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClawbackPending", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClawbackPending = append(x.ClawbackPending, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClawbackPending[len(x.ClawbackPending)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.CliffTime == nil {
+					x.CliffTime = &timestamppb.Timestamp{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_QueryUnbondingEntriesRequest                   protoreflect.MessageDescriptor
-	fd_QueryUnbondingEntriesRequest_validator_address protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
-	md_QueryUnbondingEntriesRequest = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryUnbondingEntriesRequest")
-	fd_QueryUnbondingEntriesRequest_validator_address = md_QueryUnbondingEntriesRequest.Fields().ByName("validator_address")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryUnbondingEntriesRequest)(nil)
-
-type fastReflection_QueryUnbondingEntriesRequest QueryUnbondingEntriesRequest
-
-func (x *QueryUnbondingEntriesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryUnbondingEntriesRequest)(x)
-}
-
-func (x *QueryUnbondingEntriesRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CliffTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoCompound = bool(v != 0)
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Frozen = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryUnbondingEntriesRequest                   protoreflect.MessageDescriptor
+	fd_QueryUnbondingEntriesRequest_validator_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_QueryUnbondingEntriesRequest = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryUnbondingEntriesRequest")
+	fd_QueryUnbondingEntriesRequest_validator_address = md_QueryUnbondingEntriesRequest.Fields().ByName("validator_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryUnbondingEntriesRequest)(nil)
+
+type fastReflection_QueryUnbondingEntriesRequest QueryUnbondingEntriesRequest
+
+func (x *QueryUnbondingEntriesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryUnbondingEntriesRequest)(x)
+}
+
+func (x *QueryUnbondingEntriesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
 		return ms
 	}
 	return mi.MessageOf(x)
@@ -2961,15 +3414,68 @@ func (x *_QueryLockingPeriodsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_QueryLockingPeriodsResponse_2_list)(nil)
+
+type _QueryLockingPeriodsResponse_2_list struct {
+	list *[]*DenomLockingSchedule
+}
+
+func (x *_QueryLockingPeriodsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLockingPeriodsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryLockingPeriodsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomLockingSchedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLockingPeriodsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomLockingSchedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLockingPeriodsResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(DenomLockingSchedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockingPeriodsResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLockingPeriodsResponse_2_list) NewElement() protoreflect.Value {
+	v := new(DenomLockingSchedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockingPeriodsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_QueryLockingPeriodsResponse                 protoreflect.MessageDescriptor
 	fd_QueryLockingPeriodsResponse_locking_periods protoreflect.FieldDescriptor
+	fd_QueryLockingPeriodsResponse_denom_schedules protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
 	md_QueryLockingPeriodsResponse = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryLockingPeriodsResponse")
 	fd_QueryLockingPeriodsResponse_locking_periods = md_QueryLockingPeriodsResponse.Fields().ByName("locking_periods")
+	fd_QueryLockingPeriodsResponse_denom_schedules = md_QueryLockingPeriodsResponse.Fields().ByName("denom_schedules")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryLockingPeriodsResponse)(nil)
@@ -3043,6 +3549,12 @@ func (x *fastReflection_QueryLockingPeriodsResponse) Range(f func(protoreflect.F
 			return
 		}
 	}
+	if len(x.DenomSchedules) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLockingPeriodsResponse_2_list{list: &x.DenomSchedules})
+		if !f(fd_QueryLockingPeriodsResponse_denom_schedules, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3060,6 +3572,8 @@ func (x *fastReflection_QueryLockingPeriodsResponse) Has(fd protoreflect.FieldDe
 	switch fd.FullName() {
 	case "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.locking_periods":
 		return len(x.LockingPeriods) != 0
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.denom_schedules":
+		return len(x.DenomSchedules) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse"))
@@ -3078,6 +3592,8 @@ func (x *fastReflection_QueryLockingPeriodsResponse) Clear(fd protoreflect.Field
 	switch fd.FullName() {
 	case "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.locking_periods":
 		x.LockingPeriods = nil
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.denom_schedules":
+		x.DenomSchedules = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse"))
@@ -3100,6 +3616,12 @@ func (x *fastReflection_QueryLockingPeriodsResponse) Get(descriptor protoreflect
 		}
 		listValue := &_QueryLockingPeriodsResponse_1_list{list: &x.LockingPeriods}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.denom_schedules":
+		if len(x.DenomSchedules) == 0 {
+			return protoreflect.ValueOfList(&_QueryLockingPeriodsResponse_2_list{})
+		}
+		listValue := &_QueryLockingPeriodsResponse_2_list{list: &x.DenomSchedules}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse"))
@@ -3124,6 +3646,10 @@ func (x *fastReflection_QueryLockingPeriodsResponse) Set(fd protoreflect.FieldDe
 		lv := value.List()
 		clv := lv.(*_QueryLockingPeriodsResponse_1_list)
 		x.LockingPeriods = *clv.list
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.denom_schedules":
+		lv := value.List()
+		clv := lv.(*_QueryLockingPeriodsResponse_2_list)
+		x.DenomSchedules = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse"))
@@ -3150,6 +3676,12 @@ func (x *fastReflection_QueryLockingPeriodsResponse) Mutable(fd protoreflect.Fie
 		}
 		value := &_QueryLockingPeriodsResponse_1_list{list: &x.LockingPeriods}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.denom_schedules":
+		if x.DenomSchedules == nil {
+			x.DenomSchedules = []*DenomLockingSchedule{}
+		}
+		value := &_QueryLockingPeriodsResponse_2_list{list: &x.DenomSchedules}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse"))
@@ -3166,6 +3698,9 @@ func (x *fastReflection_QueryLockingPeriodsResponse) NewField(fd protoreflect.Fi
 	case "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.locking_periods":
 		list := []*Period{}
 		return protoreflect.ValueOfList(&_QueryLockingPeriodsResponse_1_list{list: &list})
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.denom_schedules":
+		list := []*DenomLockingSchedule{}
+		return protoreflect.ValueOfList(&_QueryLockingPeriodsResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse"))
@@ -3241,6 +3776,12 @@ func (x *fastReflection_QueryLockingPeriodsResponse) ProtoMethods() *protoiface.
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.DenomSchedules) > 0 {
+			for _, e := range x.DenomSchedules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3270,6 +3811,22 @@ func (x *fastReflection_QueryLockingPeriodsResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.DenomSchedules) > 0 {
+			for iNdEx := len(x.DenomSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomSchedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.LockingPeriods) > 0 {
 			for iNdEx := len(x.LockingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.LockingPeriods[iNdEx])
@@ -3369,6 +3926,40 @@ func (x *fastReflection_QueryLockingPeriodsResponse) ProtoMethods() *protoiface.
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomSchedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomSchedules = append(x.DenomSchedules, &DenomLockingSchedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomSchedules[len(x.DenomSchedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4254,44 +4845,4420 @@ func (x *fastReflection_QuerySpendableAmountResponse) ProtoMethods() *protoiface
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/accounts/defaults/lockup/v1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryUnlockScheduleRequest protoreflect.MessageDescriptor
 )
 
-// QueryLockupAccountInfoRequest get lockup account info
-type QueryLockupAccountInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_QueryUnlockScheduleRequest = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryUnlockScheduleRequest")
 }
 
-func (x *QueryLockupAccountInfoRequest) Reset() {
-	*x = QueryLockupAccountInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_QueryUnlockScheduleRequest)(nil)
+
+type fastReflection_QueryUnlockScheduleRequest QueryUnlockScheduleRequest
+
+func (x *QueryUnlockScheduleRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryUnlockScheduleRequest)(x)
+}
+
+func (x *QueryUnlockScheduleRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryLockupAccountInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_QueryUnlockScheduleRequest_messageType fastReflection_QueryUnlockScheduleRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryUnlockScheduleRequest_messageType{}
 
-func (*QueryLockupAccountInfoRequest) ProtoMessage() {}
+type fastReflection_QueryUnlockScheduleRequest_messageType struct{}
 
-// Deprecated: Use QueryLockupAccountInfoRequest.ProtoReflect.Descriptor instead.
-func (*QueryLockupAccountInfoRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_QueryUnlockScheduleRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryUnlockScheduleRequest)(nil)
+}
+func (x fastReflection_QueryUnlockScheduleRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryUnlockScheduleRequest)
+}
+func (x fastReflection_QueryUnlockScheduleRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryUnlockScheduleRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryUnlockScheduleRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryUnlockScheduleRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryUnlockScheduleRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryUnlockScheduleRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryUnlockScheduleRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryUnlockScheduleRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryUnlockScheduleRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryUnlockScheduleRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryUnlockScheduleRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryUnlockScheduleRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryUnlockScheduleRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryUnlockScheduleRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryUnlockScheduleRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryUnlockScheduleRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryUnlockScheduleRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryUnlockScheduleRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryUnlockScheduleRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryUnlockScheduleRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryUnlockScheduleRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryUnlockScheduleRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryUnlockScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryUnlockScheduleResponse_1_list)(nil)
+
+type _QueryUnlockScheduleResponse_1_list struct {
+	list *[]*UnlockScheduleEntry
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnlockScheduleEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnlockScheduleEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(UnlockScheduleEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) NewElement() protoreflect.Value {
+	v := new(UnlockScheduleEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryUnlockScheduleResponse             protoreflect.MessageDescriptor
+	fd_QueryUnlockScheduleResponse_schedule    protoreflect.FieldDescriptor
+	fd_QueryUnlockScheduleResponse_linear      protoreflect.FieldDescriptor
+	fd_QueryUnlockScheduleResponse_next_unlock protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_QueryUnlockScheduleResponse = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryUnlockScheduleResponse")
+	fd_QueryUnlockScheduleResponse_schedule = md_QueryUnlockScheduleResponse.Fields().ByName("schedule")
+	fd_QueryUnlockScheduleResponse_linear = md_QueryUnlockScheduleResponse.Fields().ByName("linear")
+	fd_QueryUnlockScheduleResponse_next_unlock = md_QueryUnlockScheduleResponse.Fields().ByName("next_unlock")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryUnlockScheduleResponse)(nil)
+
+type fastReflection_QueryUnlockScheduleResponse QueryUnlockScheduleResponse
+
+func (x *QueryUnlockScheduleResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryUnlockScheduleResponse)(x)
+}
+
+func (x *QueryUnlockScheduleResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryUnlockScheduleResponse_messageType fastReflection_QueryUnlockScheduleResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryUnlockScheduleResponse_messageType{}
+
+type fastReflection_QueryUnlockScheduleResponse_messageType struct{}
+
+func (x fastReflection_QueryUnlockScheduleResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryUnlockScheduleResponse)(nil)
+}
+func (x fastReflection_QueryUnlockScheduleResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryUnlockScheduleResponse)
+}
+func (x fastReflection_QueryUnlockScheduleResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryUnlockScheduleResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryUnlockScheduleResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryUnlockScheduleResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryUnlockScheduleResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryUnlockScheduleResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryUnlockScheduleResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryUnlockScheduleResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryUnlockScheduleResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryUnlockScheduleResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryUnlockScheduleResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Schedule) != 0 {
+		value := protoreflect.ValueOfList(&_QueryUnlockScheduleResponse_1_list{list: &x.Schedule})
+		if !f(fd_QueryUnlockScheduleResponse_schedule, value) {
+			return
+		}
+	}
+	if x.Linear != false {
+		value := protoreflect.ValueOfBool(x.Linear)
+		if !f(fd_QueryUnlockScheduleResponse_linear, value) {
+			return
+		}
+	}
+	if x.NextUnlock != nil {
+		value := protoreflect.ValueOfMessage(x.NextUnlock.ProtoReflect())
+		if !f(fd_QueryUnlockScheduleResponse_next_unlock, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryUnlockScheduleResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.schedule":
+		return len(x.Schedule) != 0
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.linear":
+		return x.Linear != false
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.next_unlock":
+		return x.NextUnlock != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.schedule":
+		x.Schedule = nil
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.linear":
+		x.Linear = false
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.next_unlock":
+		x.NextUnlock = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryUnlockScheduleResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.schedule":
+		if len(x.Schedule) == 0 {
+			return protoreflect.ValueOfList(&_QueryUnlockScheduleResponse_1_list{})
+		}
+		listValue := &_QueryUnlockScheduleResponse_1_list{list: &x.Schedule}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.linear":
+		value := x.Linear
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.next_unlock":
+		value := x.NextUnlock
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.schedule":
+		lv := value.List()
+		clv := lv.(*_QueryUnlockScheduleResponse_1_list)
+		x.Schedule = *clv.list
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.linear":
+		x.Linear = value.Bool()
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.next_unlock":
+		x.NextUnlock = value.Message().Interface().(*UnlockScheduleEntry)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.schedule":
+		if x.Schedule == nil {
+			x.Schedule = []*UnlockScheduleEntry{}
+		}
+		value := &_QueryUnlockScheduleResponse_1_list{list: &x.Schedule}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.next_unlock":
+		if x.NextUnlock == nil {
+			x.NextUnlock = new(UnlockScheduleEntry)
+		}
+		return protoreflect.ValueOfMessage(x.NextUnlock.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.linear":
+		panic(fmt.Errorf("field linear of message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryUnlockScheduleResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.schedule":
+		list := []*UnlockScheduleEntry{}
+		return protoreflect.ValueOfList(&_QueryUnlockScheduleResponse_1_list{list: &list})
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.linear":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.next_unlock":
+		m := new(UnlockScheduleEntry)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryUnlockScheduleResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryUnlockScheduleResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryUnlockScheduleResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryUnlockScheduleResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryUnlockScheduleResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Schedule) > 0 {
+			for _, e := range x.Schedule {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Linear {
+			n += 2
+		}
+		if x.NextUnlock != nil {
+			l = options.Size(x.NextUnlock)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryUnlockScheduleResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextUnlock != nil {
+			encoded, err := options.Marshal(x.NextUnlock)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Linear {
+			i--
+			if x.Linear {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Schedule) > 0 {
+			for iNdEx := len(x.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Schedule[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryUnlockScheduleResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryUnlockScheduleResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryUnlockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schedule = append(x.Schedule, &UnlockScheduleEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Schedule[len(x.Schedule)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Linear", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Linear = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextUnlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NextUnlock == nil {
+					x.NextUnlock = &UnlockScheduleEntry{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NextUnlock); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDelegationsRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_QueryDelegationsRequest = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryDelegationsRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegationsRequest)(nil)
+
+type fastReflection_QueryDelegationsRequest QueryDelegationsRequest
+
+func (x *QueryDelegationsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegationsRequest)(x)
+}
+
+func (x *QueryDelegationsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegationsRequest_messageType fastReflection_QueryDelegationsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegationsRequest_messageType{}
+
+type fastReflection_QueryDelegationsRequest_messageType struct{}
+
+func (x fastReflection_QueryDelegationsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegationsRequest)(nil)
+}
+func (x fastReflection_QueryDelegationsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationsRequest)
+}
+func (x fastReflection_QueryDelegationsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegationsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegationsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegationsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegationsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegationsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegationsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegationsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegationsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegationsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegationsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegationsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegationsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegationsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegationsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegationsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDelegationsResponse_1_list)(nil)
+
+type _QueryDelegationsResponse_1_list struct {
+	list *[]*DelegationLockingInfo
+}
+
+func (x *_QueryDelegationsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDelegationsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDelegationsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegationLockingInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDelegationsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegationLockingInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDelegationsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DelegationLockingInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegationsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDelegationsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DelegationLockingInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegationsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDelegationsResponse             protoreflect.MessageDescriptor
+	fd_QueryDelegationsResponse_delegations protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_QueryDelegationsResponse = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryDelegationsResponse")
+	fd_QueryDelegationsResponse_delegations = md_QueryDelegationsResponse.Fields().ByName("delegations")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegationsResponse)(nil)
+
+type fastReflection_QueryDelegationsResponse QueryDelegationsResponse
+
+func (x *QueryDelegationsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegationsResponse)(x)
+}
+
+func (x *QueryDelegationsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegationsResponse_messageType fastReflection_QueryDelegationsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegationsResponse_messageType{}
+
+type fastReflection_QueryDelegationsResponse_messageType struct{}
+
+func (x fastReflection_QueryDelegationsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegationsResponse)(nil)
+}
+func (x fastReflection_QueryDelegationsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationsResponse)
+}
+func (x fastReflection_QueryDelegationsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegationsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegationsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegationsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegationsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegationsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegationsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegationsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Delegations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDelegationsResponse_1_list{list: &x.Delegations})
+		if !f(fd_QueryDelegationsResponse_delegations, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegationsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse.delegations":
+		return len(x.Delegations) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse.delegations":
+		x.Delegations = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegationsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse.delegations":
+		if len(x.Delegations) == 0 {
+			return protoreflect.ValueOfList(&_QueryDelegationsResponse_1_list{})
+		}
+		listValue := &_QueryDelegationsResponse_1_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse.delegations":
+		lv := value.List()
+		clv := lv.(*_QueryDelegationsResponse_1_list)
+		x.Delegations = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse.delegations":
+		if x.Delegations == nil {
+			x.Delegations = []*DelegationLockingInfo{}
+		}
+		value := &_QueryDelegationsResponse_1_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegationsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse.delegations":
+		list := []*DelegationLockingInfo{}
+		return protoreflect.ValueOfList(&_QueryDelegationsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegationsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegationsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegationsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegationsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegationsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Delegations) > 0 {
+			for _, e := range x.Delegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Delegations) > 0 {
+			for iNdEx := len(x.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegations = append(x.Delegations, &DelegationLockingInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DelegationLockingInfo                   protoreflect.MessageDescriptor
+	fd_DelegationLockingInfo_validator_address protoreflect.FieldDescriptor
+	fd_DelegationLockingInfo_balance           protoreflect.FieldDescriptor
+	fd_DelegationLockingInfo_locked            protoreflect.FieldDescriptor
+	fd_DelegationLockingInfo_free              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_DelegationLockingInfo = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("DelegationLockingInfo")
+	fd_DelegationLockingInfo_validator_address = md_DelegationLockingInfo.Fields().ByName("validator_address")
+	fd_DelegationLockingInfo_balance = md_DelegationLockingInfo.Fields().ByName("balance")
+	fd_DelegationLockingInfo_locked = md_DelegationLockingInfo.Fields().ByName("locked")
+	fd_DelegationLockingInfo_free = md_DelegationLockingInfo.Fields().ByName("free")
+}
+
+var _ protoreflect.Message = (*fastReflection_DelegationLockingInfo)(nil)
+
+type fastReflection_DelegationLockingInfo DelegationLockingInfo
+
+func (x *DelegationLockingInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegationLockingInfo)(x)
+}
+
+func (x *DelegationLockingInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DelegationLockingInfo_messageType fastReflection_DelegationLockingInfo_messageType
+var _ protoreflect.MessageType = fastReflection_DelegationLockingInfo_messageType{}
+
+type fastReflection_DelegationLockingInfo_messageType struct{}
+
+func (x fastReflection_DelegationLockingInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegationLockingInfo)(nil)
+}
+func (x fastReflection_DelegationLockingInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegationLockingInfo)
+}
+func (x fastReflection_DelegationLockingInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationLockingInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DelegationLockingInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationLockingInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DelegationLockingInfo) Type() protoreflect.MessageType {
+	return _fastReflection_DelegationLockingInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DelegationLockingInfo) New() protoreflect.Message {
+	return new(fastReflection_DelegationLockingInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DelegationLockingInfo) Interface() protoreflect.ProtoMessage {
+	return (*DelegationLockingInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DelegationLockingInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_DelegationLockingInfo_validator_address, value) {
+			return
+		}
+	}
+	if x.Balance != nil {
+		value := protoreflect.ValueOfMessage(x.Balance.ProtoReflect())
+		if !f(fd_DelegationLockingInfo_balance, value) {
+			return
+		}
+	}
+	if x.Locked != nil {
+		value := protoreflect.ValueOfMessage(x.Locked.ProtoReflect())
+		if !f(fd_DelegationLockingInfo_locked, value) {
+			return
+		}
+	}
+	if x.Free != nil {
+		value := protoreflect.ValueOfMessage(x.Free.ProtoReflect())
+		if !f(fd_DelegationLockingInfo_free, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DelegationLockingInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.balance":
+		return x.Balance != nil
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.locked":
+		return x.Locked != nil
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.free":
+		return x.Free != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationLockingInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.balance":
+		x.Balance = nil
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.locked":
+		x.Locked = nil
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.free":
+		x.Free = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DelegationLockingInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.balance":
+		value := x.Balance
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.locked":
+		value := x.Locked
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.free":
+		value := x.Free
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationLockingInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.balance":
+		x.Balance = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.locked":
+		x.Locked = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.free":
+		x.Free = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationLockingInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.balance":
+		if x.Balance == nil {
+			x.Balance = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Balance.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.locked":
+		if x.Locked == nil {
+			x.Locked = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Locked.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.free":
+		if x.Free == nil {
+			x.Free = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Free.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DelegationLockingInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.validator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.balance":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.locked":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.free":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DelegationLockingInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DelegationLockingInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegationLockingInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DelegationLockingInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DelegationLockingInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DelegationLockingInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Balance != nil {
+			l = options.Size(x.Balance)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Locked != nil {
+			l = options.Size(x.Locked)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Free != nil {
+			l = options.Size(x.Free)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DelegationLockingInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Free != nil {
+			encoded, err := options.Marshal(x.Free)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Locked != nil {
+			encoded, err := options.Marshal(x.Locked)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Balance != nil {
+			encoded, err := options.Marshal(x.Balance)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DelegationLockingInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationLockingInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationLockingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Balance == nil {
+					x.Balance = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Locked == nil {
+					x.Locked = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locked); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Free", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Free == nil {
+					x.Free = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Free); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAllowancesRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_QueryAllowancesRequest = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryAllowancesRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllowancesRequest)(nil)
+
+type fastReflection_QueryAllowancesRequest QueryAllowancesRequest
+
+func (x *QueryAllowancesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesRequest)(x)
+}
+
+func (x *QueryAllowancesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllowancesRequest_messageType fastReflection_QueryAllowancesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowancesRequest_messageType{}
+
+type fastReflection_QueryAllowancesRequest_messageType struct{}
+
+func (x fastReflection_QueryAllowancesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesRequest)(nil)
+}
+func (x fastReflection_QueryAllowancesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesRequest)
+}
+func (x fastReflection_QueryAllowancesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllowancesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllowancesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowancesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllowancesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllowancesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowancesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllowancesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllowancesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllowancesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllowancesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllowancesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllowancesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllowancesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllowancesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllowancesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllowancesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllowancesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAllowancesResponse_1_list)(nil)
+
+type _QueryAllowancesResponse_1_list struct {
+	list *[]*SpendAllowance
+}
+
+func (x *_QueryAllowancesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllowancesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllowancesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SpendAllowance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllowancesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SpendAllowance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllowancesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(SpendAllowance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllowancesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllowancesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(SpendAllowance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllowancesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAllowancesResponse            protoreflect.MessageDescriptor
+	fd_QueryAllowancesResponse_allowances protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_QueryAllowancesResponse = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryAllowancesResponse")
+	fd_QueryAllowancesResponse_allowances = md_QueryAllowancesResponse.Fields().ByName("allowances")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllowancesResponse)(nil)
+
+type fastReflection_QueryAllowancesResponse QueryAllowancesResponse
+
+func (x *QueryAllowancesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesResponse)(x)
+}
+
+func (x *QueryAllowancesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllowancesResponse_messageType fastReflection_QueryAllowancesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllowancesResponse_messageType{}
+
+type fastReflection_QueryAllowancesResponse_messageType struct{}
+
+func (x fastReflection_QueryAllowancesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllowancesResponse)(nil)
+}
+func (x fastReflection_QueryAllowancesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesResponse)
+}
+func (x fastReflection_QueryAllowancesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllowancesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllowancesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllowancesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllowancesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllowancesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllowancesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllowancesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllowancesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllowancesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Allowances) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllowancesResponse_1_list{list: &x.Allowances})
+		if !f(fd_QueryAllowancesResponse_allowances, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllowancesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse.allowances":
+		return len(x.Allowances) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse.allowances":
+		x.Allowances = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllowancesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse.allowances":
+		if len(x.Allowances) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllowancesResponse_1_list{})
+		}
+		listValue := &_QueryAllowancesResponse_1_list{list: &x.Allowances}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse.allowances":
+		lv := value.List()
+		clv := lv.(*_QueryAllowancesResponse_1_list)
+		x.Allowances = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse.allowances":
+		if x.Allowances == nil {
+			x.Allowances = []*SpendAllowance{}
+		}
+		value := &_QueryAllowancesResponse_1_list{list: &x.Allowances}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllowancesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse.allowances":
+		list := []*SpendAllowance{}
+		return protoreflect.ValueOfList(&_QueryAllowancesResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllowancesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllowancesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllowancesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllowancesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllowancesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllowancesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Allowances) > 0 {
+			for _, e := range x.Allowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllowancesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Allowances) > 0 {
+			for iNdEx := len(x.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Allowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllowancesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowances = append(x.Allowances, &SpendAllowance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowances[len(x.Allowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLockupStatisticsRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_QueryLockupStatisticsRequest = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryLockupStatisticsRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLockupStatisticsRequest)(nil)
+
+type fastReflection_QueryLockupStatisticsRequest QueryLockupStatisticsRequest
+
+func (x *QueryLockupStatisticsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLockupStatisticsRequest)(x)
+}
+
+func (x *QueryLockupStatisticsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLockupStatisticsRequest_messageType fastReflection_QueryLockupStatisticsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLockupStatisticsRequest_messageType{}
+
+type fastReflection_QueryLockupStatisticsRequest_messageType struct{}
+
+func (x fastReflection_QueryLockupStatisticsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLockupStatisticsRequest)(nil)
+}
+func (x fastReflection_QueryLockupStatisticsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLockupStatisticsRequest)
+}
+func (x fastReflection_QueryLockupStatisticsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockupStatisticsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLockupStatisticsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockupStatisticsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLockupStatisticsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLockupStatisticsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLockupStatisticsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLockupStatisticsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLockupStatisticsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLockupStatisticsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLockupStatisticsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLockupStatisticsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockupStatisticsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLockupStatisticsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockupStatisticsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockupStatisticsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLockupStatisticsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLockupStatisticsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLockupStatisticsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockupStatisticsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLockupStatisticsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLockupStatisticsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLockupStatisticsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockupStatisticsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockupStatisticsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockupStatisticsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockupStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryLockupStatisticsResponse_2_list)(nil)
+
+type _QueryLockupStatisticsResponse_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_QueryLockupStatisticsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLockupStatisticsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryLockupStatisticsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLockupStatisticsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLockupStatisticsResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockupStatisticsResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLockupStatisticsResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockupStatisticsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryLockupStatisticsResponse_3_list)(nil)
+
+type _QueryLockupStatisticsResponse_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_QueryLockupStatisticsResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLockupStatisticsResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryLockupStatisticsResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLockupStatisticsResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLockupStatisticsResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockupStatisticsResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLockupStatisticsResponse_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockupStatisticsResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryLockupStatisticsResponse                protoreflect.MessageDescriptor
+	fd_QueryLockupStatisticsResponse_accounts       protoreflect.FieldDescriptor
+	fd_QueryLockupStatisticsResponse_locked_coins   protoreflect.FieldDescriptor
+	fd_QueryLockupStatisticsResponse_unlocked_coins protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_lockup_v1_query_proto_init()
+	md_QueryLockupStatisticsResponse = File_cosmos_accounts_defaults_lockup_v1_query_proto.Messages().ByName("QueryLockupStatisticsResponse")
+	fd_QueryLockupStatisticsResponse_accounts = md_QueryLockupStatisticsResponse.Fields().ByName("accounts")
+	fd_QueryLockupStatisticsResponse_locked_coins = md_QueryLockupStatisticsResponse.Fields().ByName("locked_coins")
+	fd_QueryLockupStatisticsResponse_unlocked_coins = md_QueryLockupStatisticsResponse.Fields().ByName("unlocked_coins")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLockupStatisticsResponse)(nil)
+
+type fastReflection_QueryLockupStatisticsResponse QueryLockupStatisticsResponse
+
+func (x *QueryLockupStatisticsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLockupStatisticsResponse)(x)
+}
+
+func (x *QueryLockupStatisticsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLockupStatisticsResponse_messageType fastReflection_QueryLockupStatisticsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLockupStatisticsResponse_messageType{}
+
+type fastReflection_QueryLockupStatisticsResponse_messageType struct{}
+
+func (x fastReflection_QueryLockupStatisticsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLockupStatisticsResponse)(nil)
+}
+func (x fastReflection_QueryLockupStatisticsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLockupStatisticsResponse)
+}
+func (x fastReflection_QueryLockupStatisticsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockupStatisticsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLockupStatisticsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockupStatisticsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLockupStatisticsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLockupStatisticsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLockupStatisticsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLockupStatisticsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLockupStatisticsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLockupStatisticsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLockupStatisticsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Accounts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Accounts)
+		if !f(fd_QueryLockupStatisticsResponse_accounts, value) {
+			return
+		}
+	}
+	if len(x.LockedCoins) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLockupStatisticsResponse_2_list{list: &x.LockedCoins})
+		if !f(fd_QueryLockupStatisticsResponse_locked_coins, value) {
+			return
+		}
+	}
+	if len(x.UnlockedCoins) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLockupStatisticsResponse_3_list{list: &x.UnlockedCoins})
+		if !f(fd_QueryLockupStatisticsResponse_unlocked_coins, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLockupStatisticsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.accounts":
+		return x.Accounts != uint64(0)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.locked_coins":
+		return len(x.LockedCoins) != 0
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.unlocked_coins":
+		return len(x.UnlockedCoins) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockupStatisticsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.accounts":
+		x.Accounts = uint64(0)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.locked_coins":
+		x.LockedCoins = nil
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.unlocked_coins":
+		x.UnlockedCoins = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLockupStatisticsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.accounts":
+		value := x.Accounts
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.locked_coins":
+		if len(x.LockedCoins) == 0 {
+			return protoreflect.ValueOfList(&_QueryLockupStatisticsResponse_2_list{})
+		}
+		listValue := &_QueryLockupStatisticsResponse_2_list{list: &x.LockedCoins}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.unlocked_coins":
+		if len(x.UnlockedCoins) == 0 {
+			return protoreflect.ValueOfList(&_QueryLockupStatisticsResponse_3_list{})
+		}
+		listValue := &_QueryLockupStatisticsResponse_3_list{list: &x.UnlockedCoins}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockupStatisticsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.accounts":
+		x.Accounts = value.Uint()
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.locked_coins":
+		lv := value.List()
+		clv := lv.(*_QueryLockupStatisticsResponse_2_list)
+		x.LockedCoins = *clv.list
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.unlocked_coins":
+		lv := value.List()
+		clv := lv.(*_QueryLockupStatisticsResponse_3_list)
+		x.UnlockedCoins = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockupStatisticsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.locked_coins":
+		if x.LockedCoins == nil {
+			x.LockedCoins = []*v1beta1.Coin{}
+		}
+		value := &_QueryLockupStatisticsResponse_2_list{list: &x.LockedCoins}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.unlocked_coins":
+		if x.UnlockedCoins == nil {
+			x.UnlockedCoins = []*v1beta1.Coin{}
+		}
+		value := &_QueryLockupStatisticsResponse_3_list{list: &x.UnlockedCoins}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.accounts":
+		panic(fmt.Errorf("field accounts of message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLockupStatisticsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.accounts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.locked_coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_QueryLockupStatisticsResponse_2_list{list: &list})
+	case "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.unlocked_coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_QueryLockupStatisticsResponse_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLockupStatisticsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLockupStatisticsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockupStatisticsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLockupStatisticsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLockupStatisticsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLockupStatisticsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Accounts != 0 {
+			n += 1 + runtime.Sov(uint64(x.Accounts))
+		}
+		if len(x.LockedCoins) > 0 {
+			for _, e := range x.LockedCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.UnlockedCoins) > 0 {
+			for _, e := range x.UnlockedCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockupStatisticsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UnlockedCoins) > 0 {
+			for iNdEx := len(x.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnlockedCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.LockedCoins) > 0 {
+			for iNdEx := len(x.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockedCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Accounts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Accounts))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockupStatisticsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockupStatisticsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockupStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				}
+				x.Accounts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Accounts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedCoins = append(x.LockedCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockedCoins[len(x.LockedCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnlockedCoins = append(x.UnlockedCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnlockedCoins[len(x.UnlockedCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/accounts/defaults/lockup/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryLockupAccountInfoRequest get lockup account info
+type QueryLockupAccountInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryLockupAccountInfoRequest) Reset() {
+	*x = QueryLockupAccountInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLockupAccountInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLockupAccountInfoRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLockupAccountInfoRequest.ProtoReflect.Descriptor instead.
+func (*QueryLockupAccountInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{0}
 }
 
 // QueryLockupAccountInfoResponse return lockup account info
@@ -4300,296 +9267,718 @@ type QueryLockupAccountInfoResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// original_locking defines the value of the account original locking coins.
-	OriginalLocking []*v1beta1.Coin `protobuf:"bytes,1,rep,name=original_locking,json=originalLocking,proto3" json:"original_locking,omitempty"`
-	// delegated_free defines the value of the account free delegated amount.
-	DelegatedFree []*v1beta1.Coin `protobuf:"bytes,2,rep,name=delegated_free,json=delegatedFree,proto3" json:"delegated_free,omitempty"`
-	// delegated_locking defines the value of the account locking delegated amount.
-	DelegatedLocking []*v1beta1.Coin `protobuf:"bytes,3,rep,name=delegated_locking,json=delegatedLocking,proto3" json:"delegated_locking,omitempty"`
-	// end_time defines the value of the account lockup start time.
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	// end_time defines the value of the account lockup end time.
-	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// locked_coins defines the value of the account locking coins.
-	LockedCoins []*v1beta1.Coin `protobuf:"bytes,6,rep,name=locked_coins,json=lockedCoins,proto3" json:"locked_coins,omitempty"`
-	// unlocked_coins defines the value of the account released coins from lockup.
-	UnlockedCoins []*v1beta1.Coin `protobuf:"bytes,7,rep,name=unlocked_coins,json=unlockedCoins,proto3" json:"unlocked_coins,omitempty"`
-	// owner defines the value of the owner of the lockup account.
-	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// original_locking defines the value of the account original locking coins.
+	OriginalLocking []*v1beta1.Coin `protobuf:"bytes,1,rep,name=original_locking,json=originalLocking,proto3" json:"original_locking,omitempty"`
+	// delegated_free defines the value of the account free delegated amount.
+	DelegatedFree []*v1beta1.Coin `protobuf:"bytes,2,rep,name=delegated_free,json=delegatedFree,proto3" json:"delegated_free,omitempty"`
+	// delegated_locking defines the value of the account locking delegated amount.
+	DelegatedLocking []*v1beta1.Coin `protobuf:"bytes,3,rep,name=delegated_locking,json=delegatedLocking,proto3" json:"delegated_locking,omitempty"`
+	// end_time defines the value of the account lockup start time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time defines the value of the account lockup end time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// locked_coins defines the value of the account locking coins.
+	LockedCoins []*v1beta1.Coin `protobuf:"bytes,6,rep,name=locked_coins,json=lockedCoins,proto3" json:"locked_coins,omitempty"`
+	// unlocked_coins defines the value of the account released coins from lockup.
+	UnlockedCoins []*v1beta1.Coin `protobuf:"bytes,7,rep,name=unlocked_coins,json=unlockedCoins,proto3" json:"unlocked_coins,omitempty"`
+	// owner defines the value of the owner of the lockup account.
+	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// admin defines the address allowed to claw back the locked funds, empty if clawback is disabled.
+	Admin string `protobuf:"bytes,9,opt,name=admin,proto3" json:"admin,omitempty"`
+	// clawback_pending defines the clawed back funds being undelegated, which are locked until they are
+	// sent to the admin.
+	ClawbackPending []*v1beta1.Coin `protobuf:"bytes,10,rep,name=clawback_pending,json=clawbackPending,proto3" json:"clawback_pending,omitempty"`
+	// cliff_time defines the time before which no coins are unlocked, only set for cliff locking accounts.
+	CliffTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+	// pending_owner defines the address the ownership is being transferred to, empty if there is no pending
+	// ownership transfer.
+	PendingOwner string `protobuf:"bytes,12,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	// auto_compound defines whether the withdrawn staking rewards are re-delegated to the same validator.
+	AutoCompound bool `protobuf:"varint,13,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
+	// frozen defines whether the sends of the lockup account are frozen by its owner.
+	Frozen bool `protobuf:"varint,14,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (x *QueryLockupAccountInfoResponse) Reset() {
+	*x = QueryLockupAccountInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLockupAccountInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLockupAccountInfoResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryLockupAccountInfoResponse.ProtoReflect.Descriptor instead.
+func (*QueryLockupAccountInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryLockupAccountInfoResponse) GetOriginalLocking() []*v1beta1.Coin {
+	if x != nil {
+		return x.OriginalLocking
+	}
+	return nil
+}
+
+func (x *QueryLockupAccountInfoResponse) GetDelegatedFree() []*v1beta1.Coin {
+	if x != nil {
+		return x.DelegatedFree
+	}
+	return nil
+}
+
+func (x *QueryLockupAccountInfoResponse) GetDelegatedLocking() []*v1beta1.Coin {
+	if x != nil {
+		return x.DelegatedLocking
+	}
+	return nil
+}
+
+func (x *QueryLockupAccountInfoResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *QueryLockupAccountInfoResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *QueryLockupAccountInfoResponse) GetLockedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.LockedCoins
+	}
+	return nil
+}
+
+func (x *QueryLockupAccountInfoResponse) GetUnlockedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.UnlockedCoins
+	}
+	return nil
+}
+
+func (x *QueryLockupAccountInfoResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *QueryLockupAccountInfoResponse) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *QueryLockupAccountInfoResponse) GetClawbackPending() []*v1beta1.Coin {
+	if x != nil {
+		return x.ClawbackPending
+	}
+	return nil
+}
+
+func (x *QueryLockupAccountInfoResponse) GetCliffTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CliffTime
+	}
+	return nil
+}
+
+func (x *QueryLockupAccountInfoResponse) GetPendingOwner() string {
+	if x != nil {
+		return x.PendingOwner
+	}
+	return ""
+}
+
+func (x *QueryLockupAccountInfoResponse) GetAutoCompound() bool {
+	if x != nil {
+		return x.AutoCompound
+	}
+	return false
+}
+
+func (x *QueryLockupAccountInfoResponse) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+// QueryUnbondingEntriesRequest is used to query the lockup account unbonding entries.
+type QueryUnbondingEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (x *QueryUnbondingEntriesRequest) Reset() {
+	*x = QueryUnbondingEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryUnbondingEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUnbondingEntriesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryUnbondingEntriesRequest.ProtoReflect.Descriptor instead.
+func (*QueryUnbondingEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryUnbondingEntriesRequest) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryUnbondingEntriesResponse returns the lockup account unbonding entries.
+type QueryUnbondingEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UnbondingEntry defines the list of unbonding entries.
+	UnbondingEntries []*UnbondingEntry `protobuf:"bytes,1,rep,name=unbonding_entries,json=unbondingEntries,proto3" json:"unbonding_entries,omitempty"`
+}
+
+func (x *QueryUnbondingEntriesResponse) Reset() {
+	*x = QueryUnbondingEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryUnbondingEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUnbondingEntriesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryUnbondingEntriesResponse.ProtoReflect.Descriptor instead.
+func (*QueryUnbondingEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryUnbondingEntriesResponse) GetUnbondingEntries() []*UnbondingEntry {
+	if x != nil {
+		return x.UnbondingEntries
+	}
+	return nil
+}
+
+// QueryLockingPeriodsRequest is used to query the periodic lockup account locking periods.
+type QueryLockingPeriodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryLockingPeriodsRequest) Reset() {
+	*x = QueryLockingPeriodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLockingPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLockingPeriodsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLockingPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*QueryLockingPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+// QueryLockingPeriodsResponse returns the periodic lockup account locking periods.
+type QueryLockingPeriodsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// lockup_periods defines the value of the periodic lockup account locking periods.
+	LockingPeriods []*Period `protobuf:"bytes,1,rep,name=locking_periods,json=lockingPeriods,proto3" json:"locking_periods,omitempty"`
+	// denom_schedules defines the locking schedules of the denoms which do not follow the locking periods.
+	DenomSchedules []*DenomLockingSchedule `protobuf:"bytes,2,rep,name=denom_schedules,json=denomSchedules,proto3" json:"denom_schedules,omitempty"`
+}
+
+func (x *QueryLockingPeriodsResponse) Reset() {
+	*x = QueryLockingPeriodsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLockingPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLockingPeriodsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryLockingPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*QueryLockingPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryLockingPeriodsResponse) GetLockingPeriods() []*Period {
+	if x != nil {
+		return x.LockingPeriods
+	}
+	return nil
+}
+
+func (x *QueryLockingPeriodsResponse) GetDenomSchedules() []*DenomLockingSchedule {
+	if x != nil {
+		return x.DenomSchedules
+	}
+	return nil
+}
+
+// QuerySpendableAmountRequest is used to query the lockup account total spendable tokens.
+type QuerySpendableAmountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QuerySpendableAmountRequest) Reset() {
+	*x = QuerySpendableAmountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySpendableAmountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySpendableAmountRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySpendableAmountRequest.ProtoReflect.Descriptor instead.
+func (*QuerySpendableAmountRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+// QuerySpendableAmountResponse returns lockup account total spendable tokens.
+type QuerySpendableAmountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpendableTokens []*v1beta1.Coin `protobuf:"bytes,1,rep,name=spendable_tokens,json=spendableTokens,proto3" json:"spendable_tokens,omitempty"`
+}
+
+func (x *QuerySpendableAmountResponse) Reset() {
+	*x = QuerySpendableAmountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySpendableAmountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySpendableAmountResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySpendableAmountResponse.ProtoReflect.Descriptor instead.
+func (*QuerySpendableAmountResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QuerySpendableAmountResponse) GetSpendableTokens() []*v1beta1.Coin {
+	if x != nil {
+		return x.SpendableTokens
+	}
+	return nil
+}
+
+// QueryUnlockScheduleRequest is used to query the lockup account unlock schedule.
+type QueryUnlockScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryUnlockScheduleRequest) Reset() {
+	*x = QueryUnlockScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryUnlockScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QueryLockupAccountInfoResponse) Reset() {
-	*x = QueryLockupAccountInfoResponse{}
+func (*QueryUnlockScheduleRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryUnlockScheduleRequest.ProtoReflect.Descriptor instead.
+func (*QueryUnlockScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+// QueryUnlockScheduleResponse returns the lockup account unlock schedule.
+type QueryUnlockScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// schedule defines the cumulative unlocked coins at each point of the unlock schedule, ordered by time. No
+	// coins are unlocked before the first entry, and all the coins are unlocked at the last one. It is empty if
+	// the coins are never unlocked.
+	Schedule []*UnlockScheduleEntry `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	// linear defines whether the coins unlock linearly between two consecutive entries of the schedule. Otherwise,
+	// the unlocked coins of an entry are released at once at its time.
+	Linear bool `protobuf:"varint,2,opt,name=linear,proto3" json:"linear,omitempty"`
+	// next_unlock defines the first entry of the schedule after the current block time, unset if all the coins
+	// are unlocked.
+	NextUnlock *UnlockScheduleEntry `protobuf:"bytes,3,opt,name=next_unlock,json=nextUnlock,proto3" json:"next_unlock,omitempty"`
+}
+
+func (x *QueryUnlockScheduleResponse) Reset() {
+	*x = QueryUnlockScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[1]
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLockupAccountInfoResponse) String() string {
+func (x *QueryUnlockScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLockupAccountInfoResponse) ProtoMessage() {}
+func (*QueryUnlockScheduleResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryLockupAccountInfoResponse.ProtoReflect.Descriptor instead.
-func (*QueryLockupAccountInfoResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryUnlockScheduleResponse.ProtoReflect.Descriptor instead.
+func (*QueryUnlockScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QueryLockupAccountInfoResponse) GetOriginalLocking() []*v1beta1.Coin {
+func (x *QueryUnlockScheduleResponse) GetSchedule() []*UnlockScheduleEntry {
 	if x != nil {
-		return x.OriginalLocking
+		return x.Schedule
 	}
 	return nil
 }
 
-func (x *QueryLockupAccountInfoResponse) GetDelegatedFree() []*v1beta1.Coin {
+func (x *QueryUnlockScheduleResponse) GetLinear() bool {
 	if x != nil {
-		return x.DelegatedFree
+		return x.Linear
 	}
-	return nil
+	return false
 }
 
-func (x *QueryLockupAccountInfoResponse) GetDelegatedLocking() []*v1beta1.Coin {
+func (x *QueryUnlockScheduleResponse) GetNextUnlock() *UnlockScheduleEntry {
 	if x != nil {
-		return x.DelegatedLocking
+		return x.NextUnlock
 	}
 	return nil
 }
 
-func (x *QueryLockupAccountInfoResponse) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+// QueryDelegationsRequest is used to query the lockup account delegations by validator.
+type QueryDelegationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *QueryLockupAccountInfoResponse) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
+func (x *QueryDelegationsRequest) Reset() {
+	*x = QueryDelegationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *QueryLockupAccountInfoResponse) GetLockedCoins() []*v1beta1.Coin {
-	if x != nil {
-		return x.LockedCoins
-	}
-	return nil
+func (x *QueryDelegationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QueryLockupAccountInfoResponse) GetUnlockedCoins() []*v1beta1.Coin {
-	if x != nil {
-		return x.UnlockedCoins
-	}
-	return nil
-}
+func (*QueryDelegationsRequest) ProtoMessage() {}
 
-func (x *QueryLockupAccountInfoResponse) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+// Deprecated: Use QueryDelegationsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDelegationsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
-// QueryUnbondingEntriesRequest is used to query the lockup account unbonding entries.
-type QueryUnbondingEntriesRequest struct {
+// QueryDelegationsResponse returns the lockup account delegations by validator.
+type QueryDelegationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Delegations []*DelegationLockingInfo `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
 }
 
-func (x *QueryUnbondingEntriesRequest) Reset() {
-	*x = QueryUnbondingEntriesRequest{}
+func (x *QueryDelegationsResponse) Reset() {
+	*x = QueryDelegationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[2]
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryUnbondingEntriesRequest) String() string {
+func (x *QueryDelegationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryUnbondingEntriesRequest) ProtoMessage() {}
+func (*QueryDelegationsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryUnbondingEntriesRequest.ProtoReflect.Descriptor instead.
-func (*QueryUnbondingEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryDelegationsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDelegationsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueryUnbondingEntriesRequest) GetValidatorAddress() string {
+func (x *QueryDelegationsResponse) GetDelegations() []*DelegationLockingInfo {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.Delegations
 	}
-	return ""
+	return nil
 }
 
-// QueryUnbondingEntriesResponse returns the lockup account unbonding entries.
-type QueryUnbondingEntriesResponse struct {
+// DelegationLockingInfo defines the locked and free parts of a delegation of the lockup account.
+type DelegationLockingInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// UnbondingEntry defines the list of unbonding entries.
-	UnbondingEntries []*UnbondingEntry `protobuf:"bytes,1,rep,name=unbonding_entries,json=unbondingEntries,proto3" json:"unbonding_entries,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// balance is the amount of the delegation.
+	Balance *v1beta1.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// locked is the part of the delegation which is still subject to the lockup.
+	Locked *v1beta1.Coin `protobuf:"bytes,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	// free is the part of the delegation which is unlocked.
+	Free *v1beta1.Coin `protobuf:"bytes,4,opt,name=free,proto3" json:"free,omitempty"`
 }
 
-func (x *QueryUnbondingEntriesResponse) Reset() {
-	*x = QueryUnbondingEntriesResponse{}
+func (x *DelegationLockingInfo) Reset() {
+	*x = DelegationLockingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[3]
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryUnbondingEntriesResponse) String() string {
+func (x *DelegationLockingInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryUnbondingEntriesResponse) ProtoMessage() {}
+func (*DelegationLockingInfo) ProtoMessage() {}
 
-// Deprecated: Use QueryUnbondingEntriesResponse.ProtoReflect.Descriptor instead.
-func (*QueryUnbondingEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DelegationLockingInfo.ProtoReflect.Descriptor instead.
+func (*DelegationLockingInfo) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryUnbondingEntriesResponse) GetUnbondingEntries() []*UnbondingEntry {
+func (x *DelegationLockingInfo) GetValidatorAddress() string {
 	if x != nil {
-		return x.UnbondingEntries
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *DelegationLockingInfo) GetBalance() *v1beta1.Coin {
+	if x != nil {
+		return x.Balance
 	}
 	return nil
 }
 
-// QueryLockingPeriodsRequest is used to query the periodic lockup account locking periods.
-type QueryLockingPeriodsRequest struct {
+func (x *DelegationLockingInfo) GetLocked() *v1beta1.Coin {
+	if x != nil {
+		return x.Locked
+	}
+	return nil
+}
+
+func (x *DelegationLockingInfo) GetFree() *v1beta1.Coin {
+	if x != nil {
+		return x.Free
+	}
+	return nil
+}
+
+// QueryAllowancesRequest is used to query the spend allowances granted by the lockup account owner.
+type QueryAllowancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *QueryLockingPeriodsRequest) Reset() {
-	*x = QueryLockingPeriodsRequest{}
+func (x *QueryAllowancesRequest) Reset() {
+	*x = QueryAllowancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[4]
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLockingPeriodsRequest) String() string {
+func (x *QueryAllowancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLockingPeriodsRequest) ProtoMessage() {}
+func (*QueryAllowancesRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryLockingPeriodsRequest.ProtoReflect.Descriptor instead.
-func (*QueryLockingPeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryAllowancesRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllowancesRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
-// QueryLockingPeriodsResponse returns the periodic lockup account locking periods.
-type QueryLockingPeriodsResponse struct {
+// QueryAllowancesResponse returns the spend allowances granted by the lockup account owner.
+type QueryAllowancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// lockup_periods defines the value of the periodic lockup account locking periods.
-	LockingPeriods []*Period `protobuf:"bytes,1,rep,name=locking_periods,json=lockingPeriods,proto3" json:"locking_periods,omitempty"`
+	Allowances []*SpendAllowance `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
 }
 
-func (x *QueryLockingPeriodsResponse) Reset() {
-	*x = QueryLockingPeriodsResponse{}
+func (x *QueryAllowancesResponse) Reset() {
+	*x = QueryAllowancesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[5]
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLockingPeriodsResponse) String() string {
+func (x *QueryAllowancesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLockingPeriodsResponse) ProtoMessage() {}
+func (*QueryAllowancesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryLockingPeriodsResponse.ProtoReflect.Descriptor instead.
-func (*QueryLockingPeriodsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryAllowancesResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllowancesResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryLockingPeriodsResponse) GetLockingPeriods() []*Period {
+func (x *QueryAllowancesResponse) GetAllowances() []*SpendAllowance {
 	if x != nil {
-		return x.LockingPeriods
+		return x.Allowances
 	}
 	return nil
 }
 
-// QuerySpendableAmountRequest is used to query the lockup account total spendable tokens.
-type QuerySpendableAmountRequest struct {
+// QueryLockupStatisticsRequest is used to query the aggregated balances of all the lockup accounts.
+type QueryLockupStatisticsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *QuerySpendableAmountRequest) Reset() {
-	*x = QuerySpendableAmountRequest{}
+func (x *QueryLockupStatisticsRequest) Reset() {
+	*x = QueryLockupStatisticsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[6]
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySpendableAmountRequest) String() string {
+func (x *QueryLockupStatisticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySpendableAmountRequest) ProtoMessage() {}
+func (*QueryLockupStatisticsRequest) ProtoMessage() {}
 
-// Deprecated: Use QuerySpendableAmountRequest.ProtoReflect.Descriptor instead.
-func (*QuerySpendableAmountRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryLockupStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*QueryLockupStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-// QuerySpendableAmountResponse returns lockup account total spendable tokens.
-type QuerySpendableAmountResponse struct {
+// QueryLockupStatisticsResponse returns the aggregated balances of all the lockup accounts.
+type QueryLockupStatisticsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SpendableTokens []*v1beta1.Coin `protobuf:"bytes,1,rep,name=spendable_tokens,json=spendableTokens,proto3" json:"spendable_tokens,omitempty"`
+	// accounts is the number of lockup accounts.
+	Accounts uint64 `protobuf:"varint,1,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	// locked_coins is the total locked supply, per denom.
+	LockedCoins []*v1beta1.Coin `protobuf:"bytes,2,rep,name=locked_coins,json=lockedCoins,proto3" json:"locked_coins,omitempty"`
+	// unlocked_coins is the total unlocked supply, per denom.
+	UnlockedCoins []*v1beta1.Coin `protobuf:"bytes,3,rep,name=unlocked_coins,json=unlockedCoins,proto3" json:"unlocked_coins,omitempty"`
 }
 
-func (x *QuerySpendableAmountResponse) Reset() {
-	*x = QuerySpendableAmountResponse{}
+func (x *QueryLockupStatisticsResponse) Reset() {
+	*x = QueryLockupStatisticsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[7]
+		mi := &file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySpendableAmountResponse) String() string {
+func (x *QueryLockupStatisticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySpendableAmountResponse) ProtoMessage() {}
+func (*QueryLockupStatisticsResponse) ProtoMessage() {}
 
-// Deprecated: Use QuerySpendableAmountResponse.ProtoReflect.Descriptor instead.
-func (*QuerySpendableAmountResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryLockupStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*QueryLockupStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QuerySpendableAmountResponse) GetSpendableTokens() []*v1beta1.Coin {
+func (x *QueryLockupStatisticsResponse) GetAccounts() uint64 {
 	if x != nil {
-		return x.SpendableTokens
+		return x.Accounts
+	}
+	return 0
+}
+
+func (x *QueryLockupStatisticsResponse) GetLockedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.LockedCoins
+	}
+	return nil
+}
+
+func (x *QueryLockupStatisticsResponse) GetUnlockedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.UnlockedCoins
 	}
 	return nil
 }
@@ -4614,7 +10003,7 @@ var file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfe, 0x05, 0x0a, 0x1e, 0x51, 0x75, 0x65,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xaf, 0x08, 0x0a, 0x1e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
 	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x10, 0x6f,
 	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18,
@@ -4662,61 +10051,160 @@ var file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
 	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x6f,
 	0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x6e, 0x0a, 0x1c, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x1d, 0x51, 0x75,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12,
+	0x76, 0x0a, 0x10, 0x63, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x66, 0x66,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x63,
+	0x6c, 0x69, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x22, 0x6e, 0x0a, 0x1c, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x11, 0x75,
-	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x75, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x1a,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x1d, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x11,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x75, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x1c, 0x0a,
+	0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x1b,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x72, 0x0a, 0x1b, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0f, 0x6c, 0x6f, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x12, 0x67, 0x0a, 0x0f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x10, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x52, 0x0f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xea, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69,
+	0x6e, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x65,
+	0x61, 0x72, 0x12, 0x58, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x19, 0x0a, 0x17,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7d, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d,
+	0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x39, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x73, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
+	0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6c, 0x6f,
-	0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x1d,
-	0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01,
-	0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76,
-	0x0a, 0x10, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x9f, 0x02, 0x0a, 0x26, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x3c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
-	0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x04,
-	0x43, 0x41, 0x44, 0x4c, 0xaa, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e,
-	0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x5c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x26, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x3a, 0x3a, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x3a, 0x4c, 0x6f,
-	0x63, 0x6b, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x02, 0x0a, 0x1d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x6e, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x72, 0x0a, 0x0e, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x75, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x42, 0x9f, 0x02, 0x0a, 0x26, 0x63,
+	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x76,
+	0x31, 0xa2, 0x02, 0x04, 0x43, 0x41, 0x44, 0x4c, 0xaa, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x22,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x5c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x26, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x3a, 0x3a, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4731,7 +10219,7 @@ func file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescGZIP() []byte {
 	return file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDescData
 }
 
-var file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_cosmos_accounts_defaults_lockup_v1_query_proto_goTypes = []interface{}{
 	(*QueryLockupAccountInfoRequest)(nil),  // 0: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoRequest
 	(*QueryLockupAccountInfoResponse)(nil), // 1: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse
@@ -4741,27 +10229,51 @@ var file_cosmos_accounts_defaults_lockup_v1_query_proto_goTypes = []interface{}{
 	(*QueryLockingPeriodsResponse)(nil),    // 5: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse
 	(*QuerySpendableAmountRequest)(nil),    // 6: cosmos.accounts.defaults.lockup.v1.QuerySpendableAmountRequest
 	(*QuerySpendableAmountResponse)(nil),   // 7: cosmos.accounts.defaults.lockup.v1.QuerySpendableAmountResponse
-	(*v1beta1.Coin)(nil),                   // 8: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),          // 9: google.protobuf.Timestamp
-	(*UnbondingEntry)(nil),                 // 10: cosmos.accounts.defaults.lockup.v1.UnbondingEntry
-	(*Period)(nil),                         // 11: cosmos.accounts.defaults.lockup.v1.Period
+	(*QueryUnlockScheduleRequest)(nil),     // 8: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest
+	(*QueryUnlockScheduleResponse)(nil),    // 9: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse
+	(*QueryDelegationsRequest)(nil),        // 10: cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest
+	(*QueryDelegationsResponse)(nil),       // 11: cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse
+	(*DelegationLockingInfo)(nil),          // 12: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo
+	(*QueryAllowancesRequest)(nil),         // 13: cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest
+	(*QueryAllowancesResponse)(nil),        // 14: cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse
+	(*QueryLockupStatisticsRequest)(nil),   // 15: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest
+	(*QueryLockupStatisticsResponse)(nil),  // 16: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse
+	(*v1beta1.Coin)(nil),                   // 17: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),          // 18: google.protobuf.Timestamp
+	(*UnbondingEntry)(nil),                 // 19: cosmos.accounts.defaults.lockup.v1.UnbondingEntry
+	(*Period)(nil),                         // 20: cosmos.accounts.defaults.lockup.v1.Period
+	(*DenomLockingSchedule)(nil),           // 21: cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule
+	(*UnlockScheduleEntry)(nil),            // 22: cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry
+	(*SpendAllowance)(nil),                 // 23: cosmos.accounts.defaults.lockup.v1.SpendAllowance
 }
 var file_cosmos_accounts_defaults_lockup_v1_query_proto_depIdxs = []int32{
-	8,  // 0: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.original_locking:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 1: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.delegated_free:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 2: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.delegated_locking:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 3: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 4: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.end_time:type_name -> google.protobuf.Timestamp
-	8,  // 5: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.locked_coins:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 6: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.unlocked_coins:type_name -> cosmos.base.v1beta1.Coin
-	10, // 7: cosmos.accounts.defaults.lockup.v1.QueryUnbondingEntriesResponse.unbonding_entries:type_name -> cosmos.accounts.defaults.lockup.v1.UnbondingEntry
-	11, // 8: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.locking_periods:type_name -> cosmos.accounts.defaults.lockup.v1.Period
-	8,  // 9: cosmos.accounts.defaults.lockup.v1.QuerySpendableAmountResponse.spendable_tokens:type_name -> cosmos.base.v1beta1.Coin
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	17, // 0: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.original_locking:type_name -> cosmos.base.v1beta1.Coin
+	17, // 1: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.delegated_free:type_name -> cosmos.base.v1beta1.Coin
+	17, // 2: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.delegated_locking:type_name -> cosmos.base.v1beta1.Coin
+	18, // 3: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.start_time:type_name -> google.protobuf.Timestamp
+	18, // 4: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.end_time:type_name -> google.protobuf.Timestamp
+	17, // 5: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.locked_coins:type_name -> cosmos.base.v1beta1.Coin
+	17, // 6: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.unlocked_coins:type_name -> cosmos.base.v1beta1.Coin
+	17, // 7: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.clawback_pending:type_name -> cosmos.base.v1beta1.Coin
+	18, // 8: cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse.cliff_time:type_name -> google.protobuf.Timestamp
+	19, // 9: cosmos.accounts.defaults.lockup.v1.QueryUnbondingEntriesResponse.unbonding_entries:type_name -> cosmos.accounts.defaults.lockup.v1.UnbondingEntry
+	20, // 10: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.locking_periods:type_name -> cosmos.accounts.defaults.lockup.v1.Period
+	21, // 11: cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse.denom_schedules:type_name -> cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule
+	17, // 12: cosmos.accounts.defaults.lockup.v1.QuerySpendableAmountResponse.spendable_tokens:type_name -> cosmos.base.v1beta1.Coin
+	22, // 13: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.schedule:type_name -> cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry
+	22, // 14: cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse.next_unlock:type_name -> cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry
+	12, // 15: cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse.delegations:type_name -> cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo
+	17, // 16: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.balance:type_name -> cosmos.base.v1beta1.Coin
+	17, // 17: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.locked:type_name -> cosmos.base.v1beta1.Coin
+	17, // 18: cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo.free:type_name -> cosmos.base.v1beta1.Coin
+	23, // 19: cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse.allowances:type_name -> cosmos.accounts.defaults.lockup.v1.SpendAllowance
+	17, // 20: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.locked_coins:type_name -> cosmos.base.v1beta1.Coin
+	17, // 21: cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse.unlocked_coins:type_name -> cosmos.base.v1beta1.Coin
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_accounts_defaults_lockup_v1_query_proto_init() }
@@ -4867,6 +10379,114 @@ func file_cosmos_accounts_defaults_lockup_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUnlockScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUnlockScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegationLockingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllowancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllowancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLockupStatisticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_lockup_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLockupStatisticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4874,7 +10494,7 @@ func file_cosmos_accounts_defaults_lockup_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_accounts_defaults_lockup_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
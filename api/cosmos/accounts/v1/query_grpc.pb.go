@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_AccountQuery_FullMethodName  = "/cosmos.accounts.v1.Query/AccountQuery"
-	Query_Schema_FullMethodName        = "/cosmos.accounts.v1.Query/Schema"
-	Query_AccountType_FullMethodName   = "/cosmos.accounts.v1.Query/AccountType"
-	Query_AccountNumber_FullMethodName = "/cosmos.accounts.v1.Query/AccountNumber"
+	Query_AccountQuery_FullMethodName    = "/cosmos.accounts.v1.Query/AccountQuery"
+	Query_Schema_FullMethodName          = "/cosmos.accounts.v1.Query/Schema"
+	Query_AccountType_FullMethodName     = "/cosmos.accounts.v1.Query/AccountType"
+	Query_AccountNumber_FullMethodName   = "/cosmos.accounts.v1.Query/AccountNumber"
+	Query_AccountsByType_FullMethodName  = "/cosmos.accounts.v1.Query/AccountsByType"
+	Query_AccountMetadata_FullMethodName = "/cosmos.accounts.v1.Query/AccountMetadata"
+	Query_AccountsByName_FullMethodName  = "/cosmos.accounts.v1.Query/AccountsByName"
+	Query_PredictAddress_FullMethodName  = "/cosmos.accounts.v1.Query/PredictAddress"
 )
 
 // QueryClient is the client API for Query service.
@@ -39,6 +43,14 @@ type QueryClient interface {
 	AccountType(ctx context.Context, in *AccountTypeRequest, opts ...grpc.CallOption) (*AccountTypeResponse, error)
 	// AccountNumber returns the account number given the account address.
 	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
+	// AccountsByType returns the addresses of the accounts of the given account type.
+	AccountsByType(ctx context.Context, in *AccountsByTypeRequest, opts ...grpc.CallOption) (*AccountsByTypeResponse, error)
+	// AccountMetadata returns the metadata of an account.
+	AccountMetadata(ctx context.Context, in *AccountMetadataRequest, opts ...grpc.CallOption) (*AccountMetadataResponse, error)
+	// AccountsByName returns the addresses of the accounts with the given metadata name.
+	AccountsByName(ctx context.Context, in *AccountsByNameRequest, opts ...grpc.CallOption) (*AccountsByNameResponse, error)
+	// PredictAddress returns the address of the account created with the given sender, account type and salt.
+	PredictAddress(ctx context.Context, in *PredictAddressRequest, opts ...grpc.CallOption) (*PredictAddressResponse, error)
 }
 
 type queryClient struct {
@@ -89,6 +101,46 @@ func (c *queryClient) AccountNumber(ctx context.Context, in *AccountNumberReques
 	return out, nil
 }
 
+func (c *queryClient) AccountsByType(ctx context.Context, in *AccountsByTypeRequest, opts ...grpc.CallOption) (*AccountsByTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountsByTypeResponse)
+	err := c.cc.Invoke(ctx, Query_AccountsByType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountMetadata(ctx context.Context, in *AccountMetadataRequest, opts ...grpc.CallOption) (*AccountMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountMetadataResponse)
+	err := c.cc.Invoke(ctx, Query_AccountMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByName(ctx context.Context, in *AccountsByNameRequest, opts ...grpc.CallOption) (*AccountsByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountsByNameResponse)
+	err := c.cc.Invoke(ctx, Query_AccountsByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PredictAddress(ctx context.Context, in *PredictAddressRequest, opts ...grpc.CallOption) (*PredictAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PredictAddressResponse)
+	err := c.cc.Invoke(ctx, Query_PredictAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -103,6 +155,14 @@ type QueryServer interface {
 	AccountType(context.Context, *AccountTypeRequest) (*AccountTypeResponse, error)
 	// AccountNumber returns the account number given the account address.
 	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
+	// AccountsByType returns the addresses of the accounts of the given account type.
+	AccountsByType(context.Context, *AccountsByTypeRequest) (*AccountsByTypeResponse, error)
+	// AccountMetadata returns the metadata of an account.
+	AccountMetadata(context.Context, *AccountMetadataRequest) (*AccountMetadataResponse, error)
+	// AccountsByName returns the addresses of the accounts with the given metadata name.
+	AccountsByName(context.Context, *AccountsByNameRequest) (*AccountsByNameResponse, error)
+	// PredictAddress returns the address of the account created with the given sender, account type and salt.
+	PredictAddress(context.Context, *PredictAddressRequest) (*PredictAddressResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -125,6 +185,18 @@ func (UnimplementedQueryServer) AccountType(context.Context, *AccountTypeRequest
 func (UnimplementedQueryServer) AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountNumber not implemented")
 }
+func (UnimplementedQueryServer) AccountsByType(context.Context, *AccountsByTypeRequest) (*AccountsByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByType not implemented")
+}
+func (UnimplementedQueryServer) AccountMetadata(context.Context, *AccountMetadataRequest) (*AccountMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountMetadata not implemented")
+}
+func (UnimplementedQueryServer) AccountsByName(context.Context, *AccountsByNameRequest) (*AccountsByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByName not implemented")
+}
+func (UnimplementedQueryServer) PredictAddress(context.Context, *PredictAddressRequest) (*PredictAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PredictAddress not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -218,6 +290,78 @@ func _Query_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountsByType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByType(ctx, req.(*AccountsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountMetadata(ctx, req.(*AccountMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountsByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByName(ctx, req.(*AccountsByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PredictAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PredictAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PredictAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PredictAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PredictAddress(ctx, req.(*PredictAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +385,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountNumber",
 			Handler:    _Query_AccountNumber_Handler,
 		},
+		{
+			MethodName: "AccountsByType",
+			Handler:    _Query_AccountsByType_Handler,
+		},
+		{
+			MethodName: "AccountMetadata",
+			Handler:    _Query_AccountMetadata_Handler,
+		},
+		{
+			MethodName: "AccountsByName",
+			Handler:    _Query_AccountsByName_Handler,
+		},
+		{
+			MethodName: "PredictAddress",
+			Handler:    _Query_PredictAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/accounts/v1/query.proto",
@@ -75,6 +75,7 @@ var (
 	fd_MsgInit_message      protoreflect.FieldDescriptor
 	fd_MsgInit_funds        protoreflect.FieldDescriptor
 	fd_MsgInit_address_seed protoreflect.FieldDescriptor
+	fd_MsgInit_salt         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -85,6 +86,7 @@ func init() {
 	fd_MsgInit_message = md_MsgInit.Fields().ByName("message")
 	fd_MsgInit_funds = md_MsgInit.Fields().ByName("funds")
 	fd_MsgInit_address_seed = md_MsgInit.Fields().ByName("address_seed")
+	fd_MsgInit_salt = md_MsgInit.Fields().ByName("salt")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgInit)(nil)
@@ -182,6 +184,12 @@ func (x *fastReflection_MsgInit) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if len(x.Salt) != 0 {
+		value := protoreflect.ValueOfBytes(x.Salt)
+		if !f(fd_MsgInit_salt, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -207,6 +215,8 @@ func (x *fastReflection_MsgInit) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Funds) != 0
 	case "cosmos.accounts.v1.MsgInit.address_seed":
 		return len(x.AddressSeed) != 0
+	case "cosmos.accounts.v1.MsgInit.salt":
+		return len(x.Salt) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInit"))
@@ -233,6 +243,8 @@ func (x *fastReflection_MsgInit) Clear(fd protoreflect.FieldDescriptor) {
 		x.Funds = nil
 	case "cosmos.accounts.v1.MsgInit.address_seed":
 		x.AddressSeed = nil
+	case "cosmos.accounts.v1.MsgInit.salt":
+		x.Salt = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInit"))
@@ -267,6 +279,9 @@ func (x *fastReflection_MsgInit) Get(descriptor protoreflect.FieldDescriptor) pr
 	case "cosmos.accounts.v1.MsgInit.address_seed":
 		value := x.AddressSeed
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.accounts.v1.MsgInit.salt":
+		value := x.Salt
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInit"))
@@ -299,6 +314,8 @@ func (x *fastReflection_MsgInit) Set(fd protoreflect.FieldDescriptor, value prot
 		x.Funds = *clv.list
 	case "cosmos.accounts.v1.MsgInit.address_seed":
 		x.AddressSeed = value.Bytes()
+	case "cosmos.accounts.v1.MsgInit.salt":
+		x.Salt = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInit"))
@@ -336,6 +353,8 @@ func (x *fastReflection_MsgInit) Mutable(fd protoreflect.FieldDescriptor) protor
 		panic(fmt.Errorf("field account_type of message cosmos.accounts.v1.MsgInit is not mutable"))
 	case "cosmos.accounts.v1.MsgInit.address_seed":
 		panic(fmt.Errorf("field address_seed of message cosmos.accounts.v1.MsgInit is not mutable"))
+	case "cosmos.accounts.v1.MsgInit.salt":
+		panic(fmt.Errorf("field salt of message cosmos.accounts.v1.MsgInit is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInit"))
@@ -361,6 +380,8 @@ func (x *fastReflection_MsgInit) NewField(fd protoreflect.FieldDescriptor) proto
 		return protoreflect.ValueOfList(&_MsgInit_4_list{list: &list})
 	case "cosmos.accounts.v1.MsgInit.address_seed":
 		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.accounts.v1.MsgInit.salt":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInit"))
@@ -452,6 +473,10 @@ func (x *fastReflection_MsgInit) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -481,6 +506,13 @@ func (x *fastReflection_MsgInit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.AddressSeed) > 0 {
 			i -= len(x.AddressSeed)
 			copy(dAtA[i:], x.AddressSeed)
@@ -749,6 +781,40 @@ func (x *fastReflection_MsgInit) ProtoMethods() *protoiface.Methods {
 					x.AddressSeed = []byte{}
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = append(x.Salt[:0], dAtA[iNdEx:postIndex]...)
+				if x.Salt == nil {
+					x.Salt = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1283,83 +1349,81 @@ func (x *fastReflection_MsgInitResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MsgExecute_4_list)(nil)
+var _ protoreflect.List = (*_MsgInitBatch_3_list)(nil)
 
-type _MsgExecute_4_list struct {
-	list *[]*v1beta1.Coin
+type _MsgInitBatch_3_list struct {
+	list *[]*InitBatchItem
 }
 
-func (x *_MsgExecute_4_list) Len() int {
+func (x *_MsgInitBatch_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgExecute_4_list) Get(i int) protoreflect.Value {
+func (x *_MsgInitBatch_3_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgExecute_4_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgInitBatch_3_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*InitBatchItem)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgExecute_4_list) Append(value protoreflect.Value) {
+func (x *_MsgInitBatch_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*InitBatchItem)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgExecute_4_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_MsgInitBatch_3_list) AppendMutable() protoreflect.Value {
+	v := new(InitBatchItem)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgExecute_4_list) Truncate(n int) {
+func (x *_MsgInitBatch_3_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgExecute_4_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_MsgInitBatch_3_list) NewElement() protoreflect.Value {
+	v := new(InitBatchItem)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgExecute_4_list) IsValid() bool {
+func (x *_MsgInitBatch_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgExecute         protoreflect.MessageDescriptor
-	fd_MsgExecute_sender  protoreflect.FieldDescriptor
-	fd_MsgExecute_target  protoreflect.FieldDescriptor
-	fd_MsgExecute_message protoreflect.FieldDescriptor
-	fd_MsgExecute_funds   protoreflect.FieldDescriptor
+	md_MsgInitBatch              protoreflect.MessageDescriptor
+	fd_MsgInitBatch_sender       protoreflect.FieldDescriptor
+	fd_MsgInitBatch_account_type protoreflect.FieldDescriptor
+	fd_MsgInitBatch_items        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_v1_tx_proto_init()
-	md_MsgExecute = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgExecute")
-	fd_MsgExecute_sender = md_MsgExecute.Fields().ByName("sender")
-	fd_MsgExecute_target = md_MsgExecute.Fields().ByName("target")
-	fd_MsgExecute_message = md_MsgExecute.Fields().ByName("message")
-	fd_MsgExecute_funds = md_MsgExecute.Fields().ByName("funds")
+	md_MsgInitBatch = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgInitBatch")
+	fd_MsgInitBatch_sender = md_MsgInitBatch.Fields().ByName("sender")
+	fd_MsgInitBatch_account_type = md_MsgInitBatch.Fields().ByName("account_type")
+	fd_MsgInitBatch_items = md_MsgInitBatch.Fields().ByName("items")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgExecute)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgInitBatch)(nil)
 
-type fastReflection_MsgExecute MsgExecute
+type fastReflection_MsgInitBatch MsgInitBatch
 
-func (x *MsgExecute) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgExecute)(x)
+func (x *MsgInitBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgInitBatch)(x)
 }
 
-func (x *MsgExecute) slowProtoReflect() protoreflect.Message {
+func (x *MsgInitBatch) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1371,43 +1435,43 @@ func (x *MsgExecute) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgExecute_messageType fastReflection_MsgExecute_messageType
-var _ protoreflect.MessageType = fastReflection_MsgExecute_messageType{}
+var _fastReflection_MsgInitBatch_messageType fastReflection_MsgInitBatch_messageType
+var _ protoreflect.MessageType = fastReflection_MsgInitBatch_messageType{}
 
-type fastReflection_MsgExecute_messageType struct{}
+type fastReflection_MsgInitBatch_messageType struct{}
 
-func (x fastReflection_MsgExecute_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgExecute)(nil)
+func (x fastReflection_MsgInitBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgInitBatch)(nil)
 }
-func (x fastReflection_MsgExecute_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgExecute)
+func (x fastReflection_MsgInitBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgInitBatch)
 }
-func (x fastReflection_MsgExecute_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecute
+func (x fastReflection_MsgInitBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitBatch
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgExecute) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecute
+func (x *fastReflection_MsgInitBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitBatch
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgExecute) Type() protoreflect.MessageType {
-	return _fastReflection_MsgExecute_messageType
+func (x *fastReflection_MsgInitBatch) Type() protoreflect.MessageType {
+	return _fastReflection_MsgInitBatch_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgExecute) New() protoreflect.Message {
-	return new(fastReflection_MsgExecute)
+func (x *fastReflection_MsgInitBatch) New() protoreflect.Message {
+	return new(fastReflection_MsgInitBatch)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgExecute) Interface() protoreflect.ProtoMessage {
-	return (*MsgExecute)(x)
+func (x *fastReflection_MsgInitBatch) Interface() protoreflect.ProtoMessage {
+	return (*MsgInitBatch)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1415,28 +1479,22 @@ func (x *fastReflection_MsgExecute) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgExecute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgInitBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Sender != "" {
 		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_MsgExecute_sender, value) {
-			return
-		}
-	}
-	if x.Target != "" {
-		value := protoreflect.ValueOfString(x.Target)
-		if !f(fd_MsgExecute_target, value) {
+		if !f(fd_MsgInitBatch_sender, value) {
 			return
 		}
 	}
-	if x.Message != nil {
-		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
-		if !f(fd_MsgExecute_message, value) {
+	if x.AccountType != "" {
+		value := protoreflect.ValueOfString(x.AccountType)
+		if !f(fd_MsgInitBatch_account_type, value) {
 			return
 		}
 	}
-	if len(x.Funds) != 0 {
-		value := protoreflect.ValueOfList(&_MsgExecute_4_list{list: &x.Funds})
-		if !f(fd_MsgExecute_funds, value) {
+	if len(x.Items) != 0 {
+		value := protoreflect.ValueOfList(&_MsgInitBatch_3_list{list: &x.Items})
+		if !f(fd_MsgInitBatch_items, value) {
 			return
 		}
 	}
@@ -1453,21 +1511,19 @@ func (x *fastReflection_MsgExecute) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgExecute) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgInitBatch) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecute.sender":
+	case "cosmos.accounts.v1.MsgInitBatch.sender":
 		return x.Sender != ""
-	case "cosmos.accounts.v1.MsgExecute.target":
-		return x.Target != ""
-	case "cosmos.accounts.v1.MsgExecute.message":
-		return x.Message != nil
-	case "cosmos.accounts.v1.MsgExecute.funds":
-		return len(x.Funds) != 0
+	case "cosmos.accounts.v1.MsgInitBatch.account_type":
+		return x.AccountType != ""
+	case "cosmos.accounts.v1.MsgInitBatch.items":
+		return len(x.Items) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatch"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1477,21 +1533,19 @@ func (x *fastReflection_MsgExecute) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecute) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgInitBatch) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecute.sender":
+	case "cosmos.accounts.v1.MsgInitBatch.sender":
 		x.Sender = ""
-	case "cosmos.accounts.v1.MsgExecute.target":
-		x.Target = ""
-	case "cosmos.accounts.v1.MsgExecute.message":
-		x.Message = nil
-	case "cosmos.accounts.v1.MsgExecute.funds":
-		x.Funds = nil
+	case "cosmos.accounts.v1.MsgInitBatch.account_type":
+		x.AccountType = ""
+	case "cosmos.accounts.v1.MsgInitBatch.items":
+		x.Items = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatch"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1501,28 +1555,25 @@ func (x *fastReflection_MsgExecute) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgExecute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.v1.MsgExecute.sender":
+	case "cosmos.accounts.v1.MsgInitBatch.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
-	case "cosmos.accounts.v1.MsgExecute.target":
-		value := x.Target
+	case "cosmos.accounts.v1.MsgInitBatch.account_type":
+		value := x.AccountType
 		return protoreflect.ValueOfString(value)
-	case "cosmos.accounts.v1.MsgExecute.message":
-		value := x.Message
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.accounts.v1.MsgExecute.funds":
-		if len(x.Funds) == 0 {
-			return protoreflect.ValueOfList(&_MsgExecute_4_list{})
+	case "cosmos.accounts.v1.MsgInitBatch.items":
+		if len(x.Items) == 0 {
+			return protoreflect.ValueOfList(&_MsgInitBatch_3_list{})
 		}
-		listValue := &_MsgExecute_4_list{list: &x.Funds}
+		listValue := &_MsgInitBatch_3_list{list: &x.Items}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatch"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatch does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1536,23 +1587,21 @@ func (x *fastReflection_MsgExecute) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgInitBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecute.sender":
+	case "cosmos.accounts.v1.MsgInitBatch.sender":
 		x.Sender = value.Interface().(string)
-	case "cosmos.accounts.v1.MsgExecute.target":
-		x.Target = value.Interface().(string)
-	case "cosmos.accounts.v1.MsgExecute.message":
-		x.Message = value.Message().Interface().(*anypb.Any)
-	case "cosmos.accounts.v1.MsgExecute.funds":
+	case "cosmos.accounts.v1.MsgInitBatch.account_type":
+		x.AccountType = value.Interface().(string)
+	case "cosmos.accounts.v1.MsgInitBatch.items":
 		lv := value.List()
-		clv := lv.(*_MsgExecute_4_list)
-		x.Funds = *clv.list
+		clv := lv.(*_MsgInitBatch_3_list)
+		x.Items = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatch"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1566,61 +1615,53 @@ func (x *fastReflection_MsgExecute) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecute.message":
-		if x.Message == nil {
-			x.Message = new(anypb.Any)
-		}
-		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
-	case "cosmos.accounts.v1.MsgExecute.funds":
-		if x.Funds == nil {
-			x.Funds = []*v1beta1.Coin{}
+	case "cosmos.accounts.v1.MsgInitBatch.items":
+		if x.Items == nil {
+			x.Items = []*InitBatchItem{}
 		}
-		value := &_MsgExecute_4_list{list: &x.Funds}
+		value := &_MsgInitBatch_3_list{list: &x.Items}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.accounts.v1.MsgExecute.sender":
-		panic(fmt.Errorf("field sender of message cosmos.accounts.v1.MsgExecute is not mutable"))
-	case "cosmos.accounts.v1.MsgExecute.target":
-		panic(fmt.Errorf("field target of message cosmos.accounts.v1.MsgExecute is not mutable"))
+	case "cosmos.accounts.v1.MsgInitBatch.sender":
+		panic(fmt.Errorf("field sender of message cosmos.accounts.v1.MsgInitBatch is not mutable"))
+	case "cosmos.accounts.v1.MsgInitBatch.account_type":
+		panic(fmt.Errorf("field account_type of message cosmos.accounts.v1.MsgInitBatch is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatch"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatch does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgExecute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecute.sender":
+	case "cosmos.accounts.v1.MsgInitBatch.sender":
 		return protoreflect.ValueOfString("")
-	case "cosmos.accounts.v1.MsgExecute.target":
+	case "cosmos.accounts.v1.MsgInitBatch.account_type":
 		return protoreflect.ValueOfString("")
-	case "cosmos.accounts.v1.MsgExecute.message":
-		m := new(anypb.Any)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.accounts.v1.MsgExecute.funds":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_MsgExecute_4_list{list: &list})
+	case "cosmos.accounts.v1.MsgInitBatch.items":
+		list := []*InitBatchItem{}
+		return protoreflect.ValueOfList(&_MsgInitBatch_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatch"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatch does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgExecute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgInitBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgExecute", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgInitBatch", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1628,7 +1669,7 @@ func (x *fastReflection_MsgExecute) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgExecute) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgInitBatch) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1639,7 +1680,7 @@ func (x *fastReflection_MsgExecute) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecute) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgInitBatch) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1651,7 +1692,7 @@ func (x *fastReflection_MsgExecute) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgExecute) IsValid() bool {
+func (x *fastReflection_MsgInitBatch) IsValid() bool {
 	return x != nil
 }
 
@@ -1661,9 +1702,9 @@ func (x *fastReflection_MsgExecute) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgInitBatch) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgExecute)
+		x := input.Message.Interface().(*MsgInitBatch)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1679,16 +1720,12 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Target)
+		l = len(x.AccountType)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Message != nil {
-			l = options.Size(x.Message)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Funds) > 0 {
-			for _, e := range x.Funds {
+		if len(x.Items) > 0 {
+			for _, e := range x.Items {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -1703,7 +1740,7 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecute)
+		x := input.Message.Interface().(*MsgInitBatch)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1722,9 +1759,9 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Funds) > 0 {
-			for iNdEx := len(x.Funds) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Funds[iNdEx])
+		if len(x.Items) > 0 {
+			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Items[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1735,27 +1772,13 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if x.Message != nil {
-			encoded, err := options.Marshal(x.Message)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+				dAtA[i] = 0x1a
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
 		}
-		if len(x.Target) > 0 {
-			i -= len(x.Target)
-			copy(dAtA[i:], x.Target)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+		if len(x.AccountType) > 0 {
+			i -= len(x.AccountType)
+			copy(dAtA[i:], x.AccountType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccountType)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -1777,7 +1800,7 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecute)
+		x := input.Message.Interface().(*MsgInitBatch)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1809,10 +1832,10 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecute: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitBatch: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecute: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1849,7 +1872,7 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1877,11 +1900,11 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Target = string(dAtA[iNdEx:postIndex])
+				x.AccountType = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1908,52 +1931,16 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Message == nil {
-					x.Message = &anypb.Any{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
+				x.Items = append(x.Items, &InitBatchItem{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Items[len(x.Items)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Funds = append(x.Funds, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Funds[len(x.Funds)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
@@ -1984,26 +1971,81 @@ func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_InitBatchItem_2_list)(nil)
+
+type _InitBatchItem_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_InitBatchItem_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InitBatchItem_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_InitBatchItem_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InitBatchItem_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InitBatchItem_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InitBatchItem_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InitBatchItem_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InitBatchItem_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgExecuteResponse          protoreflect.MessageDescriptor
-	fd_MsgExecuteResponse_response protoreflect.FieldDescriptor
+	md_InitBatchItem              protoreflect.MessageDescriptor
+	fd_InitBatchItem_message      protoreflect.FieldDescriptor
+	fd_InitBatchItem_funds        protoreflect.FieldDescriptor
+	fd_InitBatchItem_address_seed protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_v1_tx_proto_init()
-	md_MsgExecuteResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgExecuteResponse")
-	fd_MsgExecuteResponse_response = md_MsgExecuteResponse.Fields().ByName("response")
+	md_InitBatchItem = File_cosmos_accounts_v1_tx_proto.Messages().ByName("InitBatchItem")
+	fd_InitBatchItem_message = md_InitBatchItem.Fields().ByName("message")
+	fd_InitBatchItem_funds = md_InitBatchItem.Fields().ByName("funds")
+	fd_InitBatchItem_address_seed = md_InitBatchItem.Fields().ByName("address_seed")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgExecuteResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_InitBatchItem)(nil)
 
-type fastReflection_MsgExecuteResponse MsgExecuteResponse
+type fastReflection_InitBatchItem InitBatchItem
 
-func (x *MsgExecuteResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgExecuteResponse)(x)
+func (x *InitBatchItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InitBatchItem)(x)
 }
 
-func (x *MsgExecuteResponse) slowProtoReflect() protoreflect.Message {
+func (x *InitBatchItem) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2015,43 +2057,43 @@ func (x *MsgExecuteResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgExecuteResponse_messageType fastReflection_MsgExecuteResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgExecuteResponse_messageType{}
+var _fastReflection_InitBatchItem_messageType fastReflection_InitBatchItem_messageType
+var _ protoreflect.MessageType = fastReflection_InitBatchItem_messageType{}
 
-type fastReflection_MsgExecuteResponse_messageType struct{}
+type fastReflection_InitBatchItem_messageType struct{}
 
-func (x fastReflection_MsgExecuteResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgExecuteResponse)(nil)
+func (x fastReflection_InitBatchItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InitBatchItem)(nil)
 }
-func (x fastReflection_MsgExecuteResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgExecuteResponse)
+func (x fastReflection_InitBatchItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_InitBatchItem)
 }
-func (x fastReflection_MsgExecuteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecuteResponse
+func (x fastReflection_InitBatchItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InitBatchItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgExecuteResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecuteResponse
+func (x *fastReflection_InitBatchItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_InitBatchItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgExecuteResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgExecuteResponse_messageType
+func (x *fastReflection_InitBatchItem) Type() protoreflect.MessageType {
+	return _fastReflection_InitBatchItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgExecuteResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgExecuteResponse)
+func (x *fastReflection_InitBatchItem) New() protoreflect.Message {
+	return new(fastReflection_InitBatchItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgExecuteResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgExecuteResponse)(x)
+func (x *fastReflection_InitBatchItem) Interface() protoreflect.ProtoMessage {
+	return (*InitBatchItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2059,10 +2101,22 @@ func (x *fastReflection_MsgExecuteResponse) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgExecuteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Response != nil {
-		value := protoreflect.ValueOfMessage(x.Response.ProtoReflect())
-		if !f(fd_MsgExecuteResponse_response, value) {
+func (x *fastReflection_InitBatchItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Message != nil {
+		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+		if !f(fd_InitBatchItem_message, value) {
+			return
+		}
+	}
+	if len(x.Funds) != 0 {
+		value := protoreflect.ValueOfList(&_InitBatchItem_2_list{list: &x.Funds})
+		if !f(fd_InitBatchItem_funds, value) {
+			return
+		}
+	}
+	if len(x.AddressSeed) != 0 {
+		value := protoreflect.ValueOfBytes(x.AddressSeed)
+		if !f(fd_InitBatchItem_address_seed, value) {
 			return
 		}
 	}
@@ -2079,15 +2133,19 @@ func (x *fastReflection_MsgExecuteResponse) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgExecuteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_InitBatchItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteResponse.response":
-		return x.Response != nil
+	case "cosmos.accounts.v1.InitBatchItem.message":
+		return x.Message != nil
+	case "cosmos.accounts.v1.InitBatchItem.funds":
+		return len(x.Funds) != 0
+	case "cosmos.accounts.v1.InitBatchItem.address_seed":
+		return len(x.AddressSeed) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItem"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2097,15 +2155,19 @@ func (x *fastReflection_MsgExecuteResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_InitBatchItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteResponse.response":
-		x.Response = nil
+	case "cosmos.accounts.v1.InitBatchItem.message":
+		x.Message = nil
+	case "cosmos.accounts.v1.InitBatchItem.funds":
+		x.Funds = nil
+	case "cosmos.accounts.v1.InitBatchItem.address_seed":
+		x.AddressSeed = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItem"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2115,16 +2177,25 @@ func (x *fastReflection_MsgExecuteResponse) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgExecuteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InitBatchItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteResponse.response":
-		value := x.Response
+	case "cosmos.accounts.v1.InitBatchItem.message":
+		value := x.Message
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.v1.InitBatchItem.funds":
+		if len(x.Funds) == 0 {
+			return protoreflect.ValueOfList(&_InitBatchItem_2_list{})
+		}
+		listValue := &_InitBatchItem_2_list{list: &x.Funds}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.v1.InitBatchItem.address_seed":
+		value := x.AddressSeed
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItem"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItem does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2138,15 +2209,21 @@ func (x *fastReflection_MsgExecuteResponse) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_InitBatchItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteResponse.response":
-		x.Response = value.Message().Interface().(*anypb.Any)
+	case "cosmos.accounts.v1.InitBatchItem.message":
+		x.Message = value.Message().Interface().(*anypb.Any)
+	case "cosmos.accounts.v1.InitBatchItem.funds":
+		lv := value.List()
+		clv := lv.(*_InitBatchItem_2_list)
+		x.Funds = *clv.list
+	case "cosmos.accounts.v1.InitBatchItem.address_seed":
+		x.AddressSeed = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItem"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2160,44 +2237,57 @@ func (x *fastReflection_MsgExecuteResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InitBatchItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteResponse.response":
-		if x.Response == nil {
-			x.Response = new(anypb.Any)
+	case "cosmos.accounts.v1.InitBatchItem.message":
+		if x.Message == nil {
+			x.Message = new(anypb.Any)
 		}
-		return protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	case "cosmos.accounts.v1.InitBatchItem.funds":
+		if x.Funds == nil {
+			x.Funds = []*v1beta1.Coin{}
+		}
+		value := &_InitBatchItem_2_list{list: &x.Funds}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.v1.InitBatchItem.address_seed":
+		panic(fmt.Errorf("field address_seed of message cosmos.accounts.v1.InitBatchItem is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItem"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgExecuteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InitBatchItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteResponse.response":
+	case "cosmos.accounts.v1.InitBatchItem.message":
 		m := new(anypb.Any)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.v1.InitBatchItem.funds":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_InitBatchItem_2_list{list: &list})
+	case "cosmos.accounts.v1.InitBatchItem.address_seed":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItem"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgExecuteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_InitBatchItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgExecuteResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.InitBatchItem", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2205,7 +2295,7 @@ func (x *fastReflection_MsgExecuteResponse) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgExecuteResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_InitBatchItem) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2216,7 +2306,7 @@ func (x *fastReflection_MsgExecuteResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_InitBatchItem) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2228,7 +2318,7 @@ func (x *fastReflection_MsgExecuteResponse) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgExecuteResponse) IsValid() bool {
+func (x *fastReflection_InitBatchItem) IsValid() bool {
 	return x != nil
 }
 
@@ -2238,9 +2328,9 @@ func (x *fastReflection_MsgExecuteResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgExecuteResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_InitBatchItem) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgExecuteResponse)
+		x := input.Message.Interface().(*InitBatchItem)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2252,8 +2342,18 @@ func (x *fastReflection_MsgExecuteResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Response != nil {
-			l = options.Size(x.Response)
+		if x.Message != nil {
+			l = options.Size(x.Message)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Funds) > 0 {
+			for _, e := range x.Funds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.AddressSeed)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2266,7 +2366,7 @@ func (x *fastReflection_MsgExecuteResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecuteResponse)
+		x := input.Message.Interface().(*InitBatchItem)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2285,8 +2385,31 @@ func (x *fastReflection_MsgExecuteResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Response != nil {
-			encoded, err := options.Marshal(x.Response)
+		if len(x.AddressSeed) > 0 {
+			i -= len(x.AddressSeed)
+			copy(dAtA[i:], x.AddressSeed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressSeed)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Funds) > 0 {
+			for iNdEx := len(x.Funds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Funds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Message != nil {
+			encoded, err := options.Marshal(x.Message)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2310,7 +2433,7 @@ func (x *fastReflection_MsgExecuteResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecuteResponse)
+		x := input.Message.Interface().(*InitBatchItem)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2342,15 +2465,15 @@ func (x *fastReflection_MsgExecuteResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InitBatchItem: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InitBatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2377,27 +2500,95 @@ func (x *fastReflection_MsgExecuteResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Response == nil {
-					x.Response = &anypb.Any{}
+				if x.Message == nil {
+					x.Message = &anypb.Any{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Response); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Funds = append(x.Funds, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Funds[len(x.Funds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressSeed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddressSeed = append(x.AddressSeed[:0], dAtA[iNdEx:postIndex]...)
+				if x.AddressSeed == nil {
+					x.AddressSeed = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -2419,74 +2610,77 @@ func (x *fastReflection_MsgExecuteResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MsgExecuteBundle_2_list)(nil)
+var _ protoreflect.List = (*_MsgInitBatchResponse_1_list)(nil)
 
-type _MsgExecuteBundle_2_list struct {
-	list *[][]byte
+type _MsgInitBatchResponse_1_list struct {
+	list *[]*InitBatchItemResponse
 }
 
-func (x *_MsgExecuteBundle_2_list) Len() int {
+func (x *_MsgInitBatchResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgExecuteBundle_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfBytes((*x.list)[i])
+func (x *_MsgInitBatchResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgExecuteBundle_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Bytes()
-	concreteValue := valueUnwrapped
+func (x *_MsgInitBatchResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InitBatchItemResponse)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgExecuteBundle_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Bytes()
-	concreteValue := valueUnwrapped
+func (x *_MsgInitBatchResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InitBatchItemResponse)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgExecuteBundle_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgExecuteBundle at list field Txs as it is not of Message kind"))
+func (x *_MsgInitBatchResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(InitBatchItemResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgExecuteBundle_2_list) Truncate(n int) {
+func (x *_MsgInitBatchResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgExecuteBundle_2_list) NewElement() protoreflect.Value {
-	var v []byte
-	return protoreflect.ValueOfBytes(v)
+func (x *_MsgInitBatchResponse_1_list) NewElement() protoreflect.Value {
+	v := new(InitBatchItemResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgExecuteBundle_2_list) IsValid() bool {
+func (x *_MsgInitBatchResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgExecuteBundle         protoreflect.MessageDescriptor
-	fd_MsgExecuteBundle_bundler protoreflect.FieldDescriptor
-	fd_MsgExecuteBundle_txs     protoreflect.FieldDescriptor
+	md_MsgInitBatchResponse           protoreflect.MessageDescriptor
+	fd_MsgInitBatchResponse_responses protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_v1_tx_proto_init()
-	md_MsgExecuteBundle = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgExecuteBundle")
-	fd_MsgExecuteBundle_bundler = md_MsgExecuteBundle.Fields().ByName("bundler")
-	fd_MsgExecuteBundle_txs = md_MsgExecuteBundle.Fields().ByName("txs")
+	md_MsgInitBatchResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgInitBatchResponse")
+	fd_MsgInitBatchResponse_responses = md_MsgInitBatchResponse.Fields().ByName("responses")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgExecuteBundle)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgInitBatchResponse)(nil)
 
-type fastReflection_MsgExecuteBundle MsgExecuteBundle
+type fastReflection_MsgInitBatchResponse MsgInitBatchResponse
 
-func (x *MsgExecuteBundle) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgExecuteBundle)(x)
+func (x *MsgInitBatchResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgInitBatchResponse)(x)
 }
 
-func (x *MsgExecuteBundle) slowProtoReflect() protoreflect.Message {
+func (x *MsgInitBatchResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2498,43 +2692,43 @@ func (x *MsgExecuteBundle) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgExecuteBundle_messageType fastReflection_MsgExecuteBundle_messageType
-var _ protoreflect.MessageType = fastReflection_MsgExecuteBundle_messageType{}
+var _fastReflection_MsgInitBatchResponse_messageType fastReflection_MsgInitBatchResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgInitBatchResponse_messageType{}
 
-type fastReflection_MsgExecuteBundle_messageType struct{}
+type fastReflection_MsgInitBatchResponse_messageType struct{}
 
-func (x fastReflection_MsgExecuteBundle_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgExecuteBundle)(nil)
+func (x fastReflection_MsgInitBatchResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgInitBatchResponse)(nil)
 }
-func (x fastReflection_MsgExecuteBundle_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgExecuteBundle)
+func (x fastReflection_MsgInitBatchResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgInitBatchResponse)
 }
-func (x fastReflection_MsgExecuteBundle_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecuteBundle
+func (x fastReflection_MsgInitBatchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitBatchResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgExecuteBundle) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecuteBundle
+func (x *fastReflection_MsgInitBatchResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitBatchResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgExecuteBundle) Type() protoreflect.MessageType {
-	return _fastReflection_MsgExecuteBundle_messageType
+func (x *fastReflection_MsgInitBatchResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgInitBatchResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgExecuteBundle) New() protoreflect.Message {
-	return new(fastReflection_MsgExecuteBundle)
+func (x *fastReflection_MsgInitBatchResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgInitBatchResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgExecuteBundle) Interface() protoreflect.ProtoMessage {
-	return (*MsgExecuteBundle)(x)
+func (x *fastReflection_MsgInitBatchResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgInitBatchResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2542,16 +2736,10 @@ func (x *fastReflection_MsgExecuteBundle) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgExecuteBundle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Bundler != "" {
-		value := protoreflect.ValueOfString(x.Bundler)
-		if !f(fd_MsgExecuteBundle_bundler, value) {
-			return
-		}
-	}
-	if len(x.Txs) != 0 {
-		value := protoreflect.ValueOfList(&_MsgExecuteBundle_2_list{list: &x.Txs})
-		if !f(fd_MsgExecuteBundle_txs, value) {
+func (x *fastReflection_MsgInitBatchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Responses) != 0 {
+		value := protoreflect.ValueOfList(&_MsgInitBatchResponse_1_list{list: &x.Responses})
+		if !f(fd_MsgInitBatchResponse_responses, value) {
 			return
 		}
 	}
@@ -2568,17 +2756,15 @@ func (x *fastReflection_MsgExecuteBundle) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgExecuteBundle) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgInitBatchResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
-		return x.Bundler != ""
-	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
-		return len(x.Txs) != 0
+	case "cosmos.accounts.v1.MsgInitBatchResponse.responses":
+		return len(x.Responses) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatchResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2588,17 +2774,15 @@ func (x *fastReflection_MsgExecuteBundle) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteBundle) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgInitBatchResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
-		x.Bundler = ""
-	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
-		x.Txs = nil
+	case "cosmos.accounts.v1.MsgInitBatchResponse.responses":
+		x.Responses = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatchResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2608,22 +2792,19 @@ func (x *fastReflection_MsgExecuteBundle) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgExecuteBundle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitBatchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
-		value := x.Bundler
-		return protoreflect.ValueOfString(value)
-	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
-		if len(x.Txs) == 0 {
-			return protoreflect.ValueOfList(&_MsgExecuteBundle_2_list{})
+	case "cosmos.accounts.v1.MsgInitBatchResponse.responses":
+		if len(x.Responses) == 0 {
+			return protoreflect.ValueOfList(&_MsgInitBatchResponse_1_list{})
 		}
-		listValue := &_MsgExecuteBundle_2_list{list: &x.Txs}
+		listValue := &_MsgInitBatchResponse_1_list{list: &x.Responses}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatchResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatchResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2637,19 +2818,17 @@ func (x *fastReflection_MsgExecuteBundle) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteBundle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgInitBatchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
-		x.Bundler = value.Interface().(string)
-	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
+	case "cosmos.accounts.v1.MsgInitBatchResponse.responses":
 		lv := value.List()
-		clv := lv.(*_MsgExecuteBundle_2_list)
-		x.Txs = *clv.list
+		clv := lv.(*_MsgInitBatchResponse_1_list)
+		x.Responses = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatchResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2663,49 +2842,45 @@ func (x *fastReflection_MsgExecuteBundle) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteBundle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitBatchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
-		if x.Txs == nil {
-			x.Txs = [][]byte{}
+	case "cosmos.accounts.v1.MsgInitBatchResponse.responses":
+		if x.Responses == nil {
+			x.Responses = []*InitBatchItemResponse{}
 		}
-		value := &_MsgExecuteBundle_2_list{list: &x.Txs}
+		value := &_MsgInitBatchResponse_1_list{list: &x.Responses}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
-		panic(fmt.Errorf("field bundler of message cosmos.accounts.v1.MsgExecuteBundle is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatchResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgExecuteBundle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitBatchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
-		return protoreflect.ValueOfString("")
-	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
-		list := [][]byte{}
-		return protoreflect.ValueOfList(&_MsgExecuteBundle_2_list{list: &list})
+	case "cosmos.accounts.v1.MsgInitBatchResponse.responses":
+		list := []*InitBatchItemResponse{}
+		return protoreflect.ValueOfList(&_MsgInitBatchResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgInitBatchResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgInitBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgExecuteBundle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgInitBatchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgExecuteBundle", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgInitBatchResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2713,7 +2888,7 @@ func (x *fastReflection_MsgExecuteBundle) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgExecuteBundle) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgInitBatchResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2724,7 +2899,7 @@ func (x *fastReflection_MsgExecuteBundle) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteBundle) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgInitBatchResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2736,7 +2911,7 @@ func (x *fastReflection_MsgExecuteBundle) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgExecuteBundle) IsValid() bool {
+func (x *fastReflection_MsgInitBatchResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2746,9 +2921,9 @@ func (x *fastReflection_MsgExecuteBundle) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgInitBatchResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgExecuteBundle)
+		x := input.Message.Interface().(*MsgInitBatchResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2760,13 +2935,9 @@ func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Bundler)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Txs) > 0 {
-			for _, b := range x.Txs {
-				l = len(b)
+		if len(x.Responses) > 0 {
+			for _, e := range x.Responses {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -2780,7 +2951,7 @@ func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecuteBundle)
+		x := input.Message.Interface().(*MsgInitBatchResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2799,22 +2970,22 @@ func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Txs) > 0 {
-			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Txs[iNdEx])
-				copy(dAtA[i:], x.Txs[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txs[iNdEx])))
+		if len(x.Responses) > 0 {
+			for iNdEx := len(x.Responses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Responses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.Bundler) > 0 {
-			i -= len(x.Bundler)
-			copy(dAtA[i:], x.Bundler)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bundler)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2826,7 +2997,7 @@ func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecuteBundle)
+		x := input.Message.Interface().(*MsgInitBatchResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2858,17 +3029,17 @@ func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteBundle: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitBatchResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bundler", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2878,61 +3049,31 @@ func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Bundler = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				x.Responses = append(x.Responses, &InitBatchItemResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Responses[len(x.Responses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Txs = append(x.Txs, make([]byte, postIndex-iNdEx))
-				copy(x.Txs[len(x.Txs)-1], dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
@@ -2963,139 +3104,4604 @@ func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_BundledTxResponse_3_list)(nil)
+var (
+	md_InitBatchItemResponse                 protoreflect.MessageDescriptor
+	fd_InitBatchItemResponse_account_address protoreflect.FieldDescriptor
+	fd_InitBatchItemResponse_response        protoreflect.FieldDescriptor
+	fd_InitBatchItemResponse_error           protoreflect.FieldDescriptor
+)
 
-type _BundledTxResponse_3_list struct {
-	list *[]*anypb.Any
+func init() {
+	file_cosmos_accounts_v1_tx_proto_init()
+	md_InitBatchItemResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("InitBatchItemResponse")
+	fd_InitBatchItemResponse_account_address = md_InitBatchItemResponse.Fields().ByName("account_address")
+	fd_InitBatchItemResponse_response = md_InitBatchItemResponse.Fields().ByName("response")
+	fd_InitBatchItemResponse_error = md_InitBatchItemResponse.Fields().ByName("error")
 }
 
-func (x *_BundledTxResponse_3_list) Len() int {
-	if x.list == nil {
-		return 0
+var _ protoreflect.Message = (*fastReflection_InitBatchItemResponse)(nil)
+
+type fastReflection_InitBatchItemResponse InitBatchItemResponse
+
+func (x *InitBatchItemResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InitBatchItemResponse)(x)
+}
+
+func (x *InitBatchItemResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return len(*x.list)
+	return mi.MessageOf(x)
 }
 
-func (x *_BundledTxResponse_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+var _fastReflection_InitBatchItemResponse_messageType fastReflection_InitBatchItemResponse_messageType
+var _ protoreflect.MessageType = fastReflection_InitBatchItemResponse_messageType{}
+
+type fastReflection_InitBatchItemResponse_messageType struct{}
+
+func (x fastReflection_InitBatchItemResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InitBatchItemResponse)(nil)
+}
+func (x fastReflection_InitBatchItemResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_InitBatchItemResponse)
+}
+func (x fastReflection_InitBatchItemResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InitBatchItemResponse
 }
 
-func (x *_BundledTxResponse_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InitBatchItemResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_InitBatchItemResponse
 }
 
-func (x *_BundledTxResponse_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InitBatchItemResponse) Type() protoreflect.MessageType {
+	return _fastReflection_InitBatchItemResponse_messageType
 }
 
-func (x *_BundledTxResponse_3_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InitBatchItemResponse) New() protoreflect.Message {
+	return new(fastReflection_InitBatchItemResponse)
 }
 
-func (x *_BundledTxResponse_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InitBatchItemResponse) Interface() protoreflect.ProtoMessage {
+	return (*InitBatchItemResponse)(x)
 }
 
-func (x *_BundledTxResponse_3_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InitBatchItemResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AccountAddress != "" {
+		value := protoreflect.ValueOfString(x.AccountAddress)
+		if !f(fd_InitBatchItemResponse_account_address, value) {
+			return
+		}
+	}
+	if x.Response != nil {
+		value := protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+		if !f(fd_InitBatchItemResponse_response, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_InitBatchItemResponse_error, value) {
+			return
+		}
+	}
 }
 
-func (x *_BundledTxResponse_3_list) IsValid() bool {
-	return x.list != nil
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InitBatchItemResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.InitBatchItemResponse.account_address":
+		return x.AccountAddress != ""
+	case "cosmos.accounts.v1.InitBatchItemResponse.response":
+		return x.Response != nil
+	case "cosmos.accounts.v1.InitBatchItemResponse.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItemResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItemResponse does not contain field %s", fd.FullName()))
+	}
 }
 
-var _ protoreflect.List = (*_BundledTxResponse_5_list)(nil)
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InitBatchItemResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.InitBatchItemResponse.account_address":
+		x.AccountAddress = ""
+	case "cosmos.accounts.v1.InitBatchItemResponse.response":
+		x.Response = nil
+	case "cosmos.accounts.v1.InitBatchItemResponse.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItemResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItemResponse does not contain field %s", fd.FullName()))
+	}
+}
 
-type _BundledTxResponse_5_list struct {
-	list *[]*anypb.Any
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InitBatchItemResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.v1.InitBatchItemResponse.account_address":
+		value := x.AccountAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.v1.InitBatchItemResponse.response":
+		value := x.Response
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.v1.InitBatchItemResponse.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItemResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItemResponse does not contain field %s", descriptor.FullName()))
+	}
 }
 
-func (x *_BundledTxResponse_5_list) Len() int {
-	if x.list == nil {
-		return 0
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InitBatchItemResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.InitBatchItemResponse.account_address":
+		x.AccountAddress = value.Interface().(string)
+	case "cosmos.accounts.v1.InitBatchItemResponse.response":
+		x.Response = value.Message().Interface().(*anypb.Any)
+	case "cosmos.accounts.v1.InitBatchItemResponse.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItemResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItemResponse does not contain field %s", fd.FullName()))
 	}
-	return len(*x.list)
 }
 
-func (x *_BundledTxResponse_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InitBatchItemResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.InitBatchItemResponse.response":
+		if x.Response == nil {
+			x.Response = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+	case "cosmos.accounts.v1.InitBatchItemResponse.account_address":
+		panic(fmt.Errorf("field account_address of message cosmos.accounts.v1.InitBatchItemResponse is not mutable"))
+	case "cosmos.accounts.v1.InitBatchItemResponse.error":
+		panic(fmt.Errorf("field error of message cosmos.accounts.v1.InitBatchItemResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItemResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItemResponse does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *_BundledTxResponse_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InitBatchItemResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.InitBatchItemResponse.account_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.v1.InitBatchItemResponse.response":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.v1.InitBatchItemResponse.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.InitBatchItemResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.InitBatchItemResponse does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *_BundledTxResponse_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InitBatchItemResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.InitBatchItemResponse", d.FullName()))
+	}
+	panic("unreachable")
 }
 
-func (x *_BundledTxResponse_5_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InitBatchItemResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
 }
 
-func (x *_BundledTxResponse_5_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InitBatchItemResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
 }
 
-func (x *_BundledTxResponse_5_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InitBatchItemResponse) IsValid() bool {
+	return x != nil
 }
 
-func (x *_BundledTxResponse_5_list) IsValid() bool {
-	return x.list != nil
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InitBatchItemResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InitBatchItemResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AccountAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Response != nil {
+			l = options.Size(x.Response)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InitBatchItemResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Response != nil {
+			encoded, err := options.Marshal(x.Response)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AccountAddress) > 0 {
+			i -= len(x.AccountAddress)
+			copy(dAtA[i:], x.AccountAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccountAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InitBatchItemResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InitBatchItemResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InitBatchItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccountAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Response == nil {
+					x.Response = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Response); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgExecute_4_list)(nil)
+
+type _MsgExecute_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgExecute_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExecute_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgExecute_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExecute_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExecute_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecute_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExecute_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecute_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgExecute         protoreflect.MessageDescriptor
+	fd_MsgExecute_sender  protoreflect.FieldDescriptor
+	fd_MsgExecute_target  protoreflect.FieldDescriptor
+	fd_MsgExecute_message protoreflect.FieldDescriptor
+	fd_MsgExecute_funds   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_v1_tx_proto_init()
+	md_MsgExecute = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgExecute")
+	fd_MsgExecute_sender = md_MsgExecute.Fields().ByName("sender")
+	fd_MsgExecute_target = md_MsgExecute.Fields().ByName("target")
+	fd_MsgExecute_message = md_MsgExecute.Fields().ByName("message")
+	fd_MsgExecute_funds = md_MsgExecute.Fields().ByName("funds")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgExecute)(nil)
+
+type fastReflection_MsgExecute MsgExecute
+
+func (x *MsgExecute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExecute)(x)
+}
+
+func (x *MsgExecute) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgExecute_messageType fastReflection_MsgExecute_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExecute_messageType{}
+
+type fastReflection_MsgExecute_messageType struct{}
+
+func (x fastReflection_MsgExecute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExecute)(nil)
+}
+func (x fastReflection_MsgExecute_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExecute)
+}
+func (x fastReflection_MsgExecute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgExecute) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgExecute) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExecute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgExecute) New() protoreflect.Message {
+	return new(fastReflection_MsgExecute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgExecute) Interface() protoreflect.ProtoMessage {
+	return (*MsgExecute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgExecute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgExecute_sender, value) {
+			return
+		}
+	}
+	if x.Target != "" {
+		value := protoreflect.ValueOfString(x.Target)
+		if !f(fd_MsgExecute_target, value) {
+			return
+		}
+	}
+	if x.Message != nil {
+		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+		if !f(fd_MsgExecute_message, value) {
+			return
+		}
+	}
+	if len(x.Funds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExecute_4_list{list: &x.Funds})
+		if !f(fd_MsgExecute_funds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgExecute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecute.sender":
+		return x.Sender != ""
+	case "cosmos.accounts.v1.MsgExecute.target":
+		return x.Target != ""
+	case "cosmos.accounts.v1.MsgExecute.message":
+		return x.Message != nil
+	case "cosmos.accounts.v1.MsgExecute.funds":
+		return len(x.Funds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecute.sender":
+		x.Sender = ""
+	case "cosmos.accounts.v1.MsgExecute.target":
+		x.Target = ""
+	case "cosmos.accounts.v1.MsgExecute.message":
+		x.Message = nil
+	case "cosmos.accounts.v1.MsgExecute.funds":
+		x.Funds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgExecute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.v1.MsgExecute.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.v1.MsgExecute.target":
+		value := x.Target
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.v1.MsgExecute.message":
+		value := x.Message
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.v1.MsgExecute.funds":
+		if len(x.Funds) == 0 {
+			return protoreflect.ValueOfList(&_MsgExecute_4_list{})
+		}
+		listValue := &_MsgExecute_4_list{list: &x.Funds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecute.sender":
+		x.Sender = value.Interface().(string)
+	case "cosmos.accounts.v1.MsgExecute.target":
+		x.Target = value.Interface().(string)
+	case "cosmos.accounts.v1.MsgExecute.message":
+		x.Message = value.Message().Interface().(*anypb.Any)
+	case "cosmos.accounts.v1.MsgExecute.funds":
+		lv := value.List()
+		clv := lv.(*_MsgExecute_4_list)
+		x.Funds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecute.message":
+		if x.Message == nil {
+			x.Message = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	case "cosmos.accounts.v1.MsgExecute.funds":
+		if x.Funds == nil {
+			x.Funds = []*v1beta1.Coin{}
+		}
+		value := &_MsgExecute_4_list{list: &x.Funds}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.v1.MsgExecute.sender":
+		panic(fmt.Errorf("field sender of message cosmos.accounts.v1.MsgExecute is not mutable"))
+	case "cosmos.accounts.v1.MsgExecute.target":
+		panic(fmt.Errorf("field target of message cosmos.accounts.v1.MsgExecute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgExecute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecute.sender":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.v1.MsgExecute.target":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.v1.MsgExecute.message":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.v1.MsgExecute.funds":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgExecute_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecute"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgExecute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgExecute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgExecute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgExecute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgExecute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgExecute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Target)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Message != nil {
+			l = options.Size(x.Message)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Funds) > 0 {
+			for _, e := range x.Funds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Funds) > 0 {
+			for iNdEx := len(x.Funds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Funds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Message != nil {
+			encoded, err := options.Marshal(x.Message)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Target) > 0 {
+			i -= len(x.Target)
+			copy(dAtA[i:], x.Target)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Target = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Message == nil {
+					x.Message = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Funds = append(x.Funds, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Funds[len(x.Funds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgExecuteResponse          protoreflect.MessageDescriptor
+	fd_MsgExecuteResponse_response protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_v1_tx_proto_init()
+	md_MsgExecuteResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgExecuteResponse")
+	fd_MsgExecuteResponse_response = md_MsgExecuteResponse.Fields().ByName("response")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgExecuteResponse)(nil)
+
+type fastReflection_MsgExecuteResponse MsgExecuteResponse
+
+func (x *MsgExecuteResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExecuteResponse)(x)
+}
+
+func (x *MsgExecuteResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgExecuteResponse_messageType fastReflection_MsgExecuteResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExecuteResponse_messageType{}
+
+type fastReflection_MsgExecuteResponse_messageType struct{}
+
+func (x fastReflection_MsgExecuteResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExecuteResponse)(nil)
+}
+func (x fastReflection_MsgExecuteResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExecuteResponse)
+}
+func (x fastReflection_MsgExecuteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecuteResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgExecuteResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecuteResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgExecuteResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExecuteResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgExecuteResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgExecuteResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgExecuteResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgExecuteResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgExecuteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Response != nil {
+		value := protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+		if !f(fd_MsgExecuteResponse_response, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgExecuteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteResponse.response":
+		return x.Response != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteResponse.response":
+		x.Response = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgExecuteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteResponse.response":
+		value := x.Response
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteResponse.response":
+		x.Response = value.Message().Interface().(*anypb.Any)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteResponse.response":
+		if x.Response == nil {
+			x.Response = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgExecuteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteResponse.response":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgExecuteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgExecuteResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgExecuteResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgExecuteResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgExecuteResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgExecuteResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Response != nil {
+			l = options.Size(x.Response)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecuteResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Response != nil {
+			encoded, err := options.Marshal(x.Response)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecuteResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Response == nil {
+					x.Response = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Response); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgExecuteBundle_2_list)(nil)
+
+type _MsgExecuteBundle_2_list struct {
+	list *[][]byte
+}
+
+func (x *_MsgExecuteBundle_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExecuteBundle_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_MsgExecuteBundle_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExecuteBundle_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExecuteBundle_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgExecuteBundle at list field Txs as it is not of Message kind"))
+}
+
+func (x *_MsgExecuteBundle_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExecuteBundle_2_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_MsgExecuteBundle_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgExecuteBundle         protoreflect.MessageDescriptor
+	fd_MsgExecuteBundle_bundler protoreflect.FieldDescriptor
+	fd_MsgExecuteBundle_txs     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_v1_tx_proto_init()
+	md_MsgExecuteBundle = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgExecuteBundle")
+	fd_MsgExecuteBundle_bundler = md_MsgExecuteBundle.Fields().ByName("bundler")
+	fd_MsgExecuteBundle_txs = md_MsgExecuteBundle.Fields().ByName("txs")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgExecuteBundle)(nil)
+
+type fastReflection_MsgExecuteBundle MsgExecuteBundle
+
+func (x *MsgExecuteBundle) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExecuteBundle)(x)
+}
+
+func (x *MsgExecuteBundle) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgExecuteBundle_messageType fastReflection_MsgExecuteBundle_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExecuteBundle_messageType{}
+
+type fastReflection_MsgExecuteBundle_messageType struct{}
+
+func (x fastReflection_MsgExecuteBundle_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExecuteBundle)(nil)
+}
+func (x fastReflection_MsgExecuteBundle_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExecuteBundle)
+}
+func (x fastReflection_MsgExecuteBundle_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecuteBundle
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgExecuteBundle) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecuteBundle
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgExecuteBundle) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExecuteBundle_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgExecuteBundle) New() protoreflect.Message {
+	return new(fastReflection_MsgExecuteBundle)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgExecuteBundle) Interface() protoreflect.ProtoMessage {
+	return (*MsgExecuteBundle)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgExecuteBundle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bundler != "" {
+		value := protoreflect.ValueOfString(x.Bundler)
+		if !f(fd_MsgExecuteBundle_bundler, value) {
+			return
+		}
+	}
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExecuteBundle_2_list{list: &x.Txs})
+		if !f(fd_MsgExecuteBundle_txs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgExecuteBundle) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
+		return x.Bundler != ""
+	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
+		return len(x.Txs) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteBundle) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
+		x.Bundler = ""
+	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
+		x.Txs = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgExecuteBundle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
+		value := x.Bundler
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_MsgExecuteBundle_2_list{})
+		}
+		listValue := &_MsgExecuteBundle_2_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteBundle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
+		x.Bundler = value.Interface().(string)
+	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
+		lv := value.List()
+		clv := lv.(*_MsgExecuteBundle_2_list)
+		x.Txs = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteBundle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
+		if x.Txs == nil {
+			x.Txs = [][]byte{}
+		}
+		value := &_MsgExecuteBundle_2_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
+		panic(fmt.Errorf("field bundler of message cosmos.accounts.v1.MsgExecuteBundle is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgExecuteBundle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundle.bundler":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.v1.MsgExecuteBundle.txs":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_MsgExecuteBundle_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgExecuteBundle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgExecuteBundle", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgExecuteBundle) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteBundle) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgExecuteBundle) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgExecuteBundle)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Bundler)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Txs) > 0 {
+			for _, b := range x.Txs {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecuteBundle)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Txs[iNdEx])
+				copy(dAtA[i:], x.Txs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Txs[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Bundler) > 0 {
+			i -= len(x.Bundler)
+			copy(dAtA[i:], x.Bundler)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bundler)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecuteBundle)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteBundle: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bundler", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bundler = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txs = append(x.Txs, make([]byte, postIndex-iNdEx))
+				copy(x.Txs[len(x.Txs)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BundledTxResponse_3_list)(nil)
+
+type _BundledTxResponse_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_BundledTxResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BundledTxResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BundledTxResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BundledTxResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BundledTxResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BundledTxResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BundledTxResponse_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BundledTxResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_BundledTxResponse_5_list)(nil)
+
+type _BundledTxResponse_5_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_BundledTxResponse_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BundledTxResponse_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BundledTxResponse_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BundledTxResponse_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BundledTxResponse_5_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BundledTxResponse_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BundledTxResponse_5_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BundledTxResponse_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BundledTxResponse                           protoreflect.MessageDescriptor
+	fd_BundledTxResponse_authentication_gas_used   protoreflect.FieldDescriptor
+	fd_BundledTxResponse_bundler_payment_gas_used  protoreflect.FieldDescriptor
+	fd_BundledTxResponse_bundler_payment_responses protoreflect.FieldDescriptor
+	fd_BundledTxResponse_execution_gas_used        protoreflect.FieldDescriptor
+	fd_BundledTxResponse_execution_responses       protoreflect.FieldDescriptor
+	fd_BundledTxResponse_error                     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_v1_tx_proto_init()
+	md_BundledTxResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("BundledTxResponse")
+	fd_BundledTxResponse_authentication_gas_used = md_BundledTxResponse.Fields().ByName("authentication_gas_used")
+	fd_BundledTxResponse_bundler_payment_gas_used = md_BundledTxResponse.Fields().ByName("bundler_payment_gas_used")
+	fd_BundledTxResponse_bundler_payment_responses = md_BundledTxResponse.Fields().ByName("bundler_payment_responses")
+	fd_BundledTxResponse_execution_gas_used = md_BundledTxResponse.Fields().ByName("execution_gas_used")
+	fd_BundledTxResponse_execution_responses = md_BundledTxResponse.Fields().ByName("execution_responses")
+	fd_BundledTxResponse_error = md_BundledTxResponse.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_BundledTxResponse)(nil)
+
+type fastReflection_BundledTxResponse BundledTxResponse
+
+func (x *BundledTxResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BundledTxResponse)(x)
+}
+
+func (x *BundledTxResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BundledTxResponse_messageType fastReflection_BundledTxResponse_messageType
+var _ protoreflect.MessageType = fastReflection_BundledTxResponse_messageType{}
+
+type fastReflection_BundledTxResponse_messageType struct{}
+
+func (x fastReflection_BundledTxResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BundledTxResponse)(nil)
+}
+func (x fastReflection_BundledTxResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_BundledTxResponse)
+}
+func (x fastReflection_BundledTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BundledTxResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BundledTxResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_BundledTxResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BundledTxResponse) Type() protoreflect.MessageType {
+	return _fastReflection_BundledTxResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BundledTxResponse) New() protoreflect.Message {
+	return new(fastReflection_BundledTxResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BundledTxResponse) Interface() protoreflect.ProtoMessage {
+	return (*BundledTxResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BundledTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuthenticationGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuthenticationGasUsed)
+		if !f(fd_BundledTxResponse_authentication_gas_used, value) {
+			return
+		}
+	}
+	if x.BundlerPaymentGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BundlerPaymentGasUsed)
+		if !f(fd_BundledTxResponse_bundler_payment_gas_used, value) {
+			return
+		}
+	}
+	if len(x.BundlerPaymentResponses) != 0 {
+		value := protoreflect.ValueOfList(&_BundledTxResponse_3_list{list: &x.BundlerPaymentResponses})
+		if !f(fd_BundledTxResponse_bundler_payment_responses, value) {
+			return
+		}
+	}
+	if x.ExecutionGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutionGasUsed)
+		if !f(fd_BundledTxResponse_execution_gas_used, value) {
+			return
+		}
+	}
+	if len(x.ExecutionResponses) != 0 {
+		value := protoreflect.ValueOfList(&_BundledTxResponse_5_list{list: &x.ExecutionResponses})
+		if !f(fd_BundledTxResponse_execution_responses, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_BundledTxResponse_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BundledTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
+		return x.AuthenticationGasUsed != uint64(0)
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
+		return x.BundlerPaymentGasUsed != uint64(0)
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
+		return len(x.BundlerPaymentResponses) != 0
+	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
+		return x.ExecutionGasUsed != uint64(0)
+	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
+		return len(x.ExecutionResponses) != 0
+	case "cosmos.accounts.v1.BundledTxResponse.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BundledTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
+		x.AuthenticationGasUsed = uint64(0)
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
+		x.BundlerPaymentGasUsed = uint64(0)
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
+		x.BundlerPaymentResponses = nil
+	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
+		x.ExecutionGasUsed = uint64(0)
+	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
+		x.ExecutionResponses = nil
+	case "cosmos.accounts.v1.BundledTxResponse.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BundledTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
+		value := x.AuthenticationGasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
+		value := x.BundlerPaymentGasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
+		if len(x.BundlerPaymentResponses) == 0 {
+			return protoreflect.ValueOfList(&_BundledTxResponse_3_list{})
+		}
+		listValue := &_BundledTxResponse_3_list{list: &x.BundlerPaymentResponses}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
+		value := x.ExecutionGasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
+		if len(x.ExecutionResponses) == 0 {
+			return protoreflect.ValueOfList(&_BundledTxResponse_5_list{})
+		}
+		listValue := &_BundledTxResponse_5_list{list: &x.ExecutionResponses}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.v1.BundledTxResponse.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BundledTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
+		x.AuthenticationGasUsed = value.Uint()
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
+		x.BundlerPaymentGasUsed = value.Uint()
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
+		lv := value.List()
+		clv := lv.(*_BundledTxResponse_3_list)
+		x.BundlerPaymentResponses = *clv.list
+	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
+		x.ExecutionGasUsed = value.Uint()
+	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
+		lv := value.List()
+		clv := lv.(*_BundledTxResponse_5_list)
+		x.ExecutionResponses = *clv.list
+	case "cosmos.accounts.v1.BundledTxResponse.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BundledTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
+		if x.BundlerPaymentResponses == nil {
+			x.BundlerPaymentResponses = []*anypb.Any{}
+		}
+		value := &_BundledTxResponse_3_list{list: &x.BundlerPaymentResponses}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
+		if x.ExecutionResponses == nil {
+			x.ExecutionResponses = []*anypb.Any{}
+		}
+		value := &_BundledTxResponse_5_list{list: &x.ExecutionResponses}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
+		panic(fmt.Errorf("field authentication_gas_used of message cosmos.accounts.v1.BundledTxResponse is not mutable"))
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
+		panic(fmt.Errorf("field bundler_payment_gas_used of message cosmos.accounts.v1.BundledTxResponse is not mutable"))
+	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
+		panic(fmt.Errorf("field execution_gas_used of message cosmos.accounts.v1.BundledTxResponse is not mutable"))
+	case "cosmos.accounts.v1.BundledTxResponse.error":
+		panic(fmt.Errorf("field error of message cosmos.accounts.v1.BundledTxResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BundledTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_BundledTxResponse_3_list{list: &list})
+	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_BundledTxResponse_5_list{list: &list})
+	case "cosmos.accounts.v1.BundledTxResponse.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BundledTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.BundledTxResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BundledTxResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BundledTxResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BundledTxResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BundledTxResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuthenticationGasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuthenticationGasUsed))
+		}
+		if x.BundlerPaymentGasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.BundlerPaymentGasUsed))
+		}
+		if len(x.BundlerPaymentResponses) > 0 {
+			for _, e := range x.BundlerPaymentResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExecutionGasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionGasUsed))
+		}
+		if len(x.ExecutionResponses) > 0 {
+			for _, e := range x.ExecutionResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BundledTxResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ExecutionResponses) > 0 {
+			for iNdEx := len(x.ExecutionResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExecutionResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.ExecutionGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionGasUsed))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.BundlerPaymentResponses) > 0 {
+			for iNdEx := len(x.BundlerPaymentResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BundlerPaymentResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.BundlerPaymentGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BundlerPaymentGasUsed))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.AuthenticationGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuthenticationGasUsed))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BundledTxResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BundledTxResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BundledTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthenticationGasUsed", wireType)
+				}
+				x.AuthenticationGasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuthenticationGasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BundlerPaymentGasUsed", wireType)
+				}
+				x.BundlerPaymentGasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BundlerPaymentGasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BundlerPaymentResponses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BundlerPaymentResponses = append(x.BundlerPaymentResponses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BundlerPaymentResponses[len(x.BundlerPaymentResponses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasUsed", wireType)
+				}
+				x.ExecutionGasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionGasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionResponses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionResponses = append(x.ExecutionResponses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionResponses[len(x.ExecutionResponses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgExecuteBundleResponse_1_list)(nil)
+
+type _MsgExecuteBundleResponse_1_list struct {
+	list *[]*BundledTxResponse
+}
+
+func (x *_MsgExecuteBundleResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExecuteBundleResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgExecuteBundleResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BundledTxResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExecuteBundleResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BundledTxResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExecuteBundleResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(BundledTxResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecuteBundleResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExecuteBundleResponse_1_list) NewElement() protoreflect.Value {
+	v := new(BundledTxResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecuteBundleResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgExecuteBundleResponse           protoreflect.MessageDescriptor
+	fd_MsgExecuteBundleResponse_responses protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_v1_tx_proto_init()
+	md_MsgExecuteBundleResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgExecuteBundleResponse")
+	fd_MsgExecuteBundleResponse_responses = md_MsgExecuteBundleResponse.Fields().ByName("responses")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgExecuteBundleResponse)(nil)
+
+type fastReflection_MsgExecuteBundleResponse MsgExecuteBundleResponse
+
+func (x *MsgExecuteBundleResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExecuteBundleResponse)(x)
+}
+
+func (x *MsgExecuteBundleResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgExecuteBundleResponse_messageType fastReflection_MsgExecuteBundleResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExecuteBundleResponse_messageType{}
+
+type fastReflection_MsgExecuteBundleResponse_messageType struct{}
+
+func (x fastReflection_MsgExecuteBundleResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExecuteBundleResponse)(nil)
+}
+func (x fastReflection_MsgExecuteBundleResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExecuteBundleResponse)
+}
+func (x fastReflection_MsgExecuteBundleResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecuteBundleResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgExecuteBundleResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecuteBundleResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgExecuteBundleResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExecuteBundleResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgExecuteBundleResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgExecuteBundleResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgExecuteBundleResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgExecuteBundleResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgExecuteBundleResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Responses) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExecuteBundleResponse_1_list{list: &x.Responses})
+		if !f(fd_MsgExecuteBundleResponse_responses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgExecuteBundleResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
+		return len(x.Responses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteBundleResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
+		x.Responses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgExecuteBundleResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
+		if len(x.Responses) == 0 {
+			return protoreflect.ValueOfList(&_MsgExecuteBundleResponse_1_list{})
+		}
+		listValue := &_MsgExecuteBundleResponse_1_list{list: &x.Responses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteBundleResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
+		lv := value.List()
+		clv := lv.(*_MsgExecuteBundleResponse_1_list)
+		x.Responses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteBundleResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
+		if x.Responses == nil {
+			x.Responses = []*BundledTxResponse{}
+		}
+		value := &_MsgExecuteBundleResponse_1_list{list: &x.Responses}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgExecuteBundleResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
+		list := []*BundledTxResponse{}
+		return protoreflect.ValueOfList(&_MsgExecuteBundleResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgExecuteBundleResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgExecuteBundleResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgExecuteBundleResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecuteBundleResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgExecuteBundleResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgExecuteBundleResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgExecuteBundleResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Responses) > 0 {
+			for _, e := range x.Responses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecuteBundleResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Responses) > 0 {
+			for iNdEx := len(x.Responses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Responses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecuteBundleResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteBundleResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Responses = append(x.Responses, &BundledTxResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Responses[len(x.Responses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgMigrateAccountType                  protoreflect.MessageDescriptor
+	fd_MsgMigrateAccountType_sender           protoreflect.FieldDescriptor
+	fd_MsgMigrateAccountType_new_account_type protoreflect.FieldDescriptor
+	fd_MsgMigrateAccountType_message          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_v1_tx_proto_init()
+	md_MsgMigrateAccountType = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgMigrateAccountType")
+	fd_MsgMigrateAccountType_sender = md_MsgMigrateAccountType.Fields().ByName("sender")
+	fd_MsgMigrateAccountType_new_account_type = md_MsgMigrateAccountType.Fields().ByName("new_account_type")
+	fd_MsgMigrateAccountType_message = md_MsgMigrateAccountType.Fields().ByName("message")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgMigrateAccountType)(nil)
+
+type fastReflection_MsgMigrateAccountType MsgMigrateAccountType
+
+func (x *MsgMigrateAccountType) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgMigrateAccountType)(x)
+}
+
+func (x *MsgMigrateAccountType) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgMigrateAccountType_messageType fastReflection_MsgMigrateAccountType_messageType
+var _ protoreflect.MessageType = fastReflection_MsgMigrateAccountType_messageType{}
+
+type fastReflection_MsgMigrateAccountType_messageType struct{}
+
+func (x fastReflection_MsgMigrateAccountType_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgMigrateAccountType)(nil)
+}
+func (x fastReflection_MsgMigrateAccountType_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgMigrateAccountType)
+}
+func (x fastReflection_MsgMigrateAccountType_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMigrateAccountType
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgMigrateAccountType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMigrateAccountType
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgMigrateAccountType) Type() protoreflect.MessageType {
+	return _fastReflection_MsgMigrateAccountType_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgMigrateAccountType) New() protoreflect.Message {
+	return new(fastReflection_MsgMigrateAccountType)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgMigrateAccountType) Interface() protoreflect.ProtoMessage {
+	return (*MsgMigrateAccountType)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgMigrateAccountType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgMigrateAccountType_sender, value) {
+			return
+		}
+	}
+	if x.NewAccountType != "" {
+		value := protoreflect.ValueOfString(x.NewAccountType)
+		if !f(fd_MsgMigrateAccountType_new_account_type, value) {
+			return
+		}
+	}
+	if x.Message != nil {
+		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+		if !f(fd_MsgMigrateAccountType_message, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgMigrateAccountType) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountType.sender":
+		return x.Sender != ""
+	case "cosmos.accounts.v1.MsgMigrateAccountType.new_account_type":
+		return x.NewAccountType != ""
+	case "cosmos.accounts.v1.MsgMigrateAccountType.message":
+		return x.Message != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountType"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateAccountType) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountType.sender":
+		x.Sender = ""
+	case "cosmos.accounts.v1.MsgMigrateAccountType.new_account_type":
+		x.NewAccountType = ""
+	case "cosmos.accounts.v1.MsgMigrateAccountType.message":
+		x.Message = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountType"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgMigrateAccountType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountType.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.v1.MsgMigrateAccountType.new_account_type":
+		value := x.NewAccountType
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.v1.MsgMigrateAccountType.message":
+		value := x.Message
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountType"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountType does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateAccountType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountType.sender":
+		x.Sender = value.Interface().(string)
+	case "cosmos.accounts.v1.MsgMigrateAccountType.new_account_type":
+		x.NewAccountType = value.Interface().(string)
+	case "cosmos.accounts.v1.MsgMigrateAccountType.message":
+		x.Message = value.Message().Interface().(*anypb.Any)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountType"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateAccountType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountType.message":
+		if x.Message == nil {
+			x.Message = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	case "cosmos.accounts.v1.MsgMigrateAccountType.sender":
+		panic(fmt.Errorf("field sender of message cosmos.accounts.v1.MsgMigrateAccountType is not mutable"))
+	case "cosmos.accounts.v1.MsgMigrateAccountType.new_account_type":
+		panic(fmt.Errorf("field new_account_type of message cosmos.accounts.v1.MsgMigrateAccountType is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountType"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountType does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgMigrateAccountType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountType.sender":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.v1.MsgMigrateAccountType.new_account_type":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.v1.MsgMigrateAccountType.message":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountType"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountType does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgMigrateAccountType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgMigrateAccountType", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgMigrateAccountType) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateAccountType) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgMigrateAccountType) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgMigrateAccountType) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgMigrateAccountType)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewAccountType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Message != nil {
+			l = options.Size(x.Message)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMigrateAccountType)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Message != nil {
+			encoded, err := options.Marshal(x.Message)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.NewAccountType) > 0 {
+			i -= len(x.NewAccountType)
+			copy(dAtA[i:], x.NewAccountType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewAccountType)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMigrateAccountType)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMigrateAccountType: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMigrateAccountType: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewAccountType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewAccountType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Message == nil {
+					x.Message = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgMigrateAccountTypeResponse          protoreflect.MessageDescriptor
+	fd_MsgMigrateAccountTypeResponse_response protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_v1_tx_proto_init()
+	md_MsgMigrateAccountTypeResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgMigrateAccountTypeResponse")
+	fd_MsgMigrateAccountTypeResponse_response = md_MsgMigrateAccountTypeResponse.Fields().ByName("response")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgMigrateAccountTypeResponse)(nil)
+
+type fastReflection_MsgMigrateAccountTypeResponse MsgMigrateAccountTypeResponse
+
+func (x *MsgMigrateAccountTypeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgMigrateAccountTypeResponse)(x)
+}
+
+func (x *MsgMigrateAccountTypeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgMigrateAccountTypeResponse_messageType fastReflection_MsgMigrateAccountTypeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgMigrateAccountTypeResponse_messageType{}
+
+type fastReflection_MsgMigrateAccountTypeResponse_messageType struct{}
+
+func (x fastReflection_MsgMigrateAccountTypeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgMigrateAccountTypeResponse)(nil)
+}
+func (x fastReflection_MsgMigrateAccountTypeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgMigrateAccountTypeResponse)
+}
+func (x fastReflection_MsgMigrateAccountTypeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMigrateAccountTypeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMigrateAccountTypeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgMigrateAccountTypeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgMigrateAccountTypeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgMigrateAccountTypeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Response != nil {
+		value := protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+		if !f(fd_MsgMigrateAccountTypeResponse_response, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountTypeResponse.response":
+		return x.Response != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountTypeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountTypeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountTypeResponse.response":
+		x.Response = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountTypeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountTypeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountTypeResponse.response":
+		value := x.Response
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountTypeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountTypeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountTypeResponse.response":
+		x.Response = value.Message().Interface().(*anypb.Any)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountTypeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountTypeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountTypeResponse.response":
+		if x.Response == nil {
+			x.Response = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountTypeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountTypeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.MsgMigrateAccountTypeResponse.response":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgMigrateAccountTypeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgMigrateAccountTypeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgMigrateAccountTypeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgMigrateAccountTypeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgMigrateAccountTypeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Response != nil {
+			l = options.Size(x.Response)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMigrateAccountTypeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Response != nil {
+			encoded, err := options.Marshal(x.Response)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMigrateAccountTypeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMigrateAccountTypeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMigrateAccountTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Response == nil {
+					x.Response = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Response); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
 var (
-	md_BundledTxResponse                           protoreflect.MessageDescriptor
-	fd_BundledTxResponse_authentication_gas_used   protoreflect.FieldDescriptor
-	fd_BundledTxResponse_bundler_payment_gas_used  protoreflect.FieldDescriptor
-	fd_BundledTxResponse_bundler_payment_responses protoreflect.FieldDescriptor
-	fd_BundledTxResponse_execution_gas_used        protoreflect.FieldDescriptor
-	fd_BundledTxResponse_execution_responses       protoreflect.FieldDescriptor
-	fd_BundledTxResponse_error                     protoreflect.FieldDescriptor
+	md_MsgUpdateAccountMetadata          protoreflect.MessageDescriptor
+	fd_MsgUpdateAccountMetadata_sender   protoreflect.FieldDescriptor
+	fd_MsgUpdateAccountMetadata_metadata protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_v1_tx_proto_init()
-	md_BundledTxResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("BundledTxResponse")
-	fd_BundledTxResponse_authentication_gas_used = md_BundledTxResponse.Fields().ByName("authentication_gas_used")
-	fd_BundledTxResponse_bundler_payment_gas_used = md_BundledTxResponse.Fields().ByName("bundler_payment_gas_used")
-	fd_BundledTxResponse_bundler_payment_responses = md_BundledTxResponse.Fields().ByName("bundler_payment_responses")
-	fd_BundledTxResponse_execution_gas_used = md_BundledTxResponse.Fields().ByName("execution_gas_used")
-	fd_BundledTxResponse_execution_responses = md_BundledTxResponse.Fields().ByName("execution_responses")
-	fd_BundledTxResponse_error = md_BundledTxResponse.Fields().ByName("error")
+	md_MsgUpdateAccountMetadata = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgUpdateAccountMetadata")
+	fd_MsgUpdateAccountMetadata_sender = md_MsgUpdateAccountMetadata.Fields().ByName("sender")
+	fd_MsgUpdateAccountMetadata_metadata = md_MsgUpdateAccountMetadata.Fields().ByName("metadata")
 }
 
-var _ protoreflect.Message = (*fastReflection_BundledTxResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateAccountMetadata)(nil)
 
-type fastReflection_BundledTxResponse BundledTxResponse
+type fastReflection_MsgUpdateAccountMetadata MsgUpdateAccountMetadata
 
-func (x *BundledTxResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BundledTxResponse)(x)
+func (x *MsgUpdateAccountMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateAccountMetadata)(x)
 }
 
-func (x *BundledTxResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[5]
+func (x *MsgUpdateAccountMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3106,43 +7712,43 @@ func (x *BundledTxResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BundledTxResponse_messageType fastReflection_BundledTxResponse_messageType
-var _ protoreflect.MessageType = fastReflection_BundledTxResponse_messageType{}
+var _fastReflection_MsgUpdateAccountMetadata_messageType fastReflection_MsgUpdateAccountMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateAccountMetadata_messageType{}
 
-type fastReflection_BundledTxResponse_messageType struct{}
+type fastReflection_MsgUpdateAccountMetadata_messageType struct{}
 
-func (x fastReflection_BundledTxResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BundledTxResponse)(nil)
+func (x fastReflection_MsgUpdateAccountMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateAccountMetadata)(nil)
 }
-func (x fastReflection_BundledTxResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_BundledTxResponse)
+func (x fastReflection_MsgUpdateAccountMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateAccountMetadata)
 }
-func (x fastReflection_BundledTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BundledTxResponse
+func (x fastReflection_MsgUpdateAccountMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateAccountMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BundledTxResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_BundledTxResponse
+func (x *fastReflection_MsgUpdateAccountMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateAccountMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BundledTxResponse) Type() protoreflect.MessageType {
-	return _fastReflection_BundledTxResponse_messageType
+func (x *fastReflection_MsgUpdateAccountMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateAccountMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BundledTxResponse) New() protoreflect.Message {
-	return new(fastReflection_BundledTxResponse)
+func (x *fastReflection_MsgUpdateAccountMetadata) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateAccountMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BundledTxResponse) Interface() protoreflect.ProtoMessage {
-	return (*BundledTxResponse)(x)
+func (x *fastReflection_MsgUpdateAccountMetadata) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateAccountMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3150,40 +7756,16 @@ func (x *fastReflection_BundledTxResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BundledTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AuthenticationGasUsed != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AuthenticationGasUsed)
-		if !f(fd_BundledTxResponse_authentication_gas_used, value) {
-			return
-		}
-	}
-	if x.BundlerPaymentGasUsed != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BundlerPaymentGasUsed)
-		if !f(fd_BundledTxResponse_bundler_payment_gas_used, value) {
-			return
-		}
-	}
-	if len(x.BundlerPaymentResponses) != 0 {
-		value := protoreflect.ValueOfList(&_BundledTxResponse_3_list{list: &x.BundlerPaymentResponses})
-		if !f(fd_BundledTxResponse_bundler_payment_responses, value) {
-			return
-		}
-	}
-	if x.ExecutionGasUsed != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExecutionGasUsed)
-		if !f(fd_BundledTxResponse_execution_gas_used, value) {
-			return
-		}
-	}
-	if len(x.ExecutionResponses) != 0 {
-		value := protoreflect.ValueOfList(&_BundledTxResponse_5_list{list: &x.ExecutionResponses})
-		if !f(fd_BundledTxResponse_execution_responses, value) {
+func (x *fastReflection_MsgUpdateAccountMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgUpdateAccountMetadata_sender, value) {
 			return
 		}
 	}
-	if x.Error != "" {
-		value := protoreflect.ValueOfString(x.Error)
-		if !f(fd_BundledTxResponse_error, value) {
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_MsgUpdateAccountMetadata_metadata, value) {
 			return
 		}
 	}
@@ -3200,25 +7782,17 @@ func (x *fastReflection_BundledTxResponse) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BundledTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateAccountMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
-		return x.AuthenticationGasUsed != uint64(0)
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
-		return x.BundlerPaymentGasUsed != uint64(0)
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
-		return len(x.BundlerPaymentResponses) != 0
-	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
-		return x.ExecutionGasUsed != uint64(0)
-	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
-		return len(x.ExecutionResponses) != 0
-	case "cosmos.accounts.v1.BundledTxResponse.error":
-		return x.Error != ""
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.sender":
+		return x.Sender != ""
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.metadata":
+		return x.Metadata != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3228,25 +7802,17 @@ func (x *fastReflection_BundledTxResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BundledTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateAccountMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
-		x.AuthenticationGasUsed = uint64(0)
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
-		x.BundlerPaymentGasUsed = uint64(0)
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
-		x.BundlerPaymentResponses = nil
-	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
-		x.ExecutionGasUsed = uint64(0)
-	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
-		x.ExecutionResponses = nil
-	case "cosmos.accounts.v1.BundledTxResponse.error":
-		x.Error = ""
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.sender":
+		x.Sender = ""
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.metadata":
+		x.Metadata = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3256,37 +7822,19 @@ func (x *fastReflection_BundledTxResponse) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BundledTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateAccountMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
-		value := x.AuthenticationGasUsed
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
-		value := x.BundlerPaymentGasUsed
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
-		if len(x.BundlerPaymentResponses) == 0 {
-			return protoreflect.ValueOfList(&_BundledTxResponse_3_list{})
-		}
-		listValue := &_BundledTxResponse_3_list{list: &x.BundlerPaymentResponses}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
-		value := x.ExecutionGasUsed
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
-		if len(x.ExecutionResponses) == 0 {
-			return protoreflect.ValueOfList(&_BundledTxResponse_5_list{})
-		}
-		listValue := &_BundledTxResponse_5_list{list: &x.ExecutionResponses}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.accounts.v1.BundledTxResponse.error":
-		value := x.Error
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.sender":
+		value := x.Sender
 		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3300,29 +7848,17 @@ func (x *fastReflection_BundledTxResponse) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BundledTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateAccountMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
-		x.AuthenticationGasUsed = value.Uint()
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
-		x.BundlerPaymentGasUsed = value.Uint()
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
-		lv := value.List()
-		clv := lv.(*_BundledTxResponse_3_list)
-		x.BundlerPaymentResponses = *clv.list
-	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
-		x.ExecutionGasUsed = value.Uint()
-	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
-		lv := value.List()
-		clv := lv.(*_BundledTxResponse_5_list)
-		x.ExecutionResponses = *clv.list
-	case "cosmos.accounts.v1.BundledTxResponse.error":
-		x.Error = value.Interface().(string)
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.sender":
+		x.Sender = value.Interface().(string)
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.metadata":
+		x.Metadata = value.Message().Interface().(*AccountMetadata)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3336,70 +7872,48 @@ func (x *fastReflection_BundledTxResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BundledTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateAccountMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
-		if x.BundlerPaymentResponses == nil {
-			x.BundlerPaymentResponses = []*anypb.Any{}
-		}
-		value := &_BundledTxResponse_3_list{list: &x.BundlerPaymentResponses}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
-		if x.ExecutionResponses == nil {
-			x.ExecutionResponses = []*anypb.Any{}
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(AccountMetadata)
 		}
-		value := &_BundledTxResponse_5_list{list: &x.ExecutionResponses}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
-		panic(fmt.Errorf("field authentication_gas_used of message cosmos.accounts.v1.BundledTxResponse is not mutable"))
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
-		panic(fmt.Errorf("field bundler_payment_gas_used of message cosmos.accounts.v1.BundledTxResponse is not mutable"))
-	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
-		panic(fmt.Errorf("field execution_gas_used of message cosmos.accounts.v1.BundledTxResponse is not mutable"))
-	case "cosmos.accounts.v1.BundledTxResponse.error":
-		panic(fmt.Errorf("field error of message cosmos.accounts.v1.BundledTxResponse is not mutable"))
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.sender":
+		panic(fmt.Errorf("field sender of message cosmos.accounts.v1.MsgUpdateAccountMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BundledTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateAccountMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.BundledTxResponse.authentication_gas_used":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_gas_used":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_BundledTxResponse_3_list{list: &list})
-	case "cosmos.accounts.v1.BundledTxResponse.execution_gas_used":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.accounts.v1.BundledTxResponse.execution_responses":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_BundledTxResponse_5_list{list: &list})
-	case "cosmos.accounts.v1.BundledTxResponse.error":
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.sender":
 		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.v1.MsgUpdateAccountMetadata.metadata":
+		m := new(AccountMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.BundledTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.BundledTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BundledTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateAccountMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.BundledTxResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgUpdateAccountMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3407,7 +7921,7 @@ func (x *fastReflection_BundledTxResponse) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BundledTxResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateAccountMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3418,7 +7932,7 @@ func (x *fastReflection_BundledTxResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BundledTxResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateAccountMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3430,7 +7944,7 @@ func (x *fastReflection_BundledTxResponse) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BundledTxResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateAccountMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -3440,9 +7954,9 @@ func (x *fastReflection_BundledTxResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateAccountMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BundledTxResponse)
+		x := input.Message.Interface().(*MsgUpdateAccountMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3454,31 +7968,14 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.AuthenticationGasUsed != 0 {
-			n += 1 + runtime.Sov(uint64(x.AuthenticationGasUsed))
-		}
-		if x.BundlerPaymentGasUsed != 0 {
-			n += 1 + runtime.Sov(uint64(x.BundlerPaymentGasUsed))
-		}
-		if len(x.BundlerPaymentResponses) > 0 {
-			for _, e := range x.BundlerPaymentResponses {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.ExecutionGasUsed != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExecutionGasUsed))
-		}
-		if len(x.ExecutionResponses) > 0 {
-			for _, e := range x.ExecutionResponses {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Error)
+		l = len(x.Sender)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3489,7 +7986,7 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BundledTxResponse)
+		x := input.Message.Interface().(*MsgUpdateAccountMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3505,62 +8002,29 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 		var l int
 		_ = l
 		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Error) > 0 {
-			i -= len(x.Error)
-			copy(dAtA[i:], x.Error)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.ExecutionResponses) > 0 {
-			for iNdEx := len(x.ExecutionResponses) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ExecutionResponses[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if x.ExecutionGasUsed != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionGasUsed))
-			i--
-			dAtA[i] = 0x20
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BundlerPaymentResponses) > 0 {
-			for iNdEx := len(x.BundlerPaymentResponses) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.BundlerPaymentResponses[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-		}
-		if x.BundlerPaymentGasUsed != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BundlerPaymentGasUsed))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.AuthenticationGasUsed != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuthenticationGasUsed))
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3573,7 +8037,7 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BundledTxResponse)
+		x := input.Message.Interface().(*MsgUpdateAccountMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3605,55 +8069,17 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BundledTxResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateAccountMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BundledTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateAccountMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthenticationGasUsed", wireType)
-				}
-				x.AuthenticationGasUsed = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AuthenticationGasUsed |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BundlerPaymentGasUsed", wireType)
-				}
-				x.BundlerPaymentGasUsed = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BundlerPaymentGasUsed |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BundlerPaymentResponses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3663,48 +8089,27 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BundlerPaymentResponses = append(x.BundlerPaymentResponses, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BundlerPaymentResponses[len(x.BundlerPaymentResponses)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasUsed", wireType)
-				}
-				x.ExecutionGasUsed = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExecutionGasUsed |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionResponses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3731,42 +8136,12 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExecutionResponses = append(x.ExecutionResponses, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionResponses[len(x.ExecutionResponses)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Metadata == nil {
+					x.Metadata = &AccountMetadata{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Error = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3803,78 +8178,25 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MsgExecuteBundleResponse_1_list)(nil)
-
-type _MsgExecuteBundleResponse_1_list struct {
-	list *[]*BundledTxResponse
-}
-
-func (x *_MsgExecuteBundleResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgExecuteBundleResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgExecuteBundleResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BundledTxResponse)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgExecuteBundleResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BundledTxResponse)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgExecuteBundleResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(BundledTxResponse)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgExecuteBundleResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgExecuteBundleResponse_1_list) NewElement() protoreflect.Value {
-	v := new(BundledTxResponse)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgExecuteBundleResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgExecuteBundleResponse           protoreflect.MessageDescriptor
-	fd_MsgExecuteBundleResponse_responses protoreflect.FieldDescriptor
+	md_MsgUpdateAccountMetadataResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_v1_tx_proto_init()
-	md_MsgExecuteBundleResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgExecuteBundleResponse")
-	fd_MsgExecuteBundleResponse_responses = md_MsgExecuteBundleResponse.Fields().ByName("responses")
+	md_MsgUpdateAccountMetadataResponse = File_cosmos_accounts_v1_tx_proto.Messages().ByName("MsgUpdateAccountMetadataResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgExecuteBundleResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateAccountMetadataResponse)(nil)
 
-type fastReflection_MsgExecuteBundleResponse MsgExecuteBundleResponse
+type fastReflection_MsgUpdateAccountMetadataResponse MsgUpdateAccountMetadataResponse
 
-func (x *MsgExecuteBundleResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgExecuteBundleResponse)(x)
+func (x *MsgUpdateAccountMetadataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateAccountMetadataResponse)(x)
 }
 
-func (x *MsgExecuteBundleResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[6]
+func (x *MsgUpdateAccountMetadataResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3885,43 +8207,43 @@ func (x *MsgExecuteBundleResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgExecuteBundleResponse_messageType fastReflection_MsgExecuteBundleResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgExecuteBundleResponse_messageType{}
+var _fastReflection_MsgUpdateAccountMetadataResponse_messageType fastReflection_MsgUpdateAccountMetadataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateAccountMetadataResponse_messageType{}
 
-type fastReflection_MsgExecuteBundleResponse_messageType struct{}
+type fastReflection_MsgUpdateAccountMetadataResponse_messageType struct{}
 
-func (x fastReflection_MsgExecuteBundleResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgExecuteBundleResponse)(nil)
+func (x fastReflection_MsgUpdateAccountMetadataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateAccountMetadataResponse)(nil)
 }
-func (x fastReflection_MsgExecuteBundleResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgExecuteBundleResponse)
+func (x fastReflection_MsgUpdateAccountMetadataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateAccountMetadataResponse)
 }
-func (x fastReflection_MsgExecuteBundleResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecuteBundleResponse
+func (x fastReflection_MsgUpdateAccountMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateAccountMetadataResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgExecuteBundleResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecuteBundleResponse
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateAccountMetadataResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgExecuteBundleResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgExecuteBundleResponse_messageType
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateAccountMetadataResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgExecuteBundleResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgExecuteBundleResponse)
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateAccountMetadataResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgExecuteBundleResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgExecuteBundleResponse)(x)
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateAccountMetadataResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3929,13 +8251,7 @@ func (x *fastReflection_MsgExecuteBundleResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgExecuteBundleResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Responses) != 0 {
-		value := protoreflect.ValueOfList(&_MsgExecuteBundleResponse_1_list{list: &x.Responses})
-		if !f(fd_MsgExecuteBundleResponse_responses, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3949,15 +8265,13 @@ func (x *fastReflection_MsgExecuteBundleResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgExecuteBundleResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
-		return len(x.Responses) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3967,15 +8281,13 @@ func (x *fastReflection_MsgExecuteBundleResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteBundleResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
-		x.Responses = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3983,21 +8295,15 @@ func (x *fastReflection_MsgExecuteBundleResponse) Clear(fd protoreflect.FieldDes
 //
 // For unpopulated scalars, it returns the default value, where
 // the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgExecuteBundleResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
-		if len(x.Responses) == 0 {
-			return protoreflect.ValueOfList(&_MsgExecuteBundleResponse_1_list{})
-		}
-		listValue := &_MsgExecuteBundleResponse_1_list{list: &x.Responses}
-		return protoreflect.ValueOfList(listValue)
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadataResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4011,17 +8317,13 @@ func (x *fastReflection_MsgExecuteBundleResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteBundleResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
-		lv := value.List()
-		clv := lv.(*_MsgExecuteBundleResponse_1_list)
-		x.Responses = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4035,45 +8337,36 @@ func (x *fastReflection_MsgExecuteBundleResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteBundleResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
-		if x.Responses == nil {
-			x.Responses = []*BundledTxResponse{}
-		}
-		value := &_MsgExecuteBundleResponse_1_list{list: &x.Responses}
-		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgExecuteBundleResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.MsgExecuteBundleResponse.responses":
-		list := []*BundledTxResponse{}
-		return protoreflect.ValueOfList(&_MsgExecuteBundleResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgExecuteBundleResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.MsgUpdateAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.MsgExecuteBundleResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.MsgUpdateAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgExecuteBundleResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgExecuteBundleResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.MsgUpdateAccountMetadataResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4081,7 +8374,7 @@ func (x *fastReflection_MsgExecuteBundleResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgExecuteBundleResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4092,7 +8385,7 @@ func (x *fastReflection_MsgExecuteBundleResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecuteBundleResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4104,7 +8397,7 @@ func (x *fastReflection_MsgExecuteBundleResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgExecuteBundleResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4114,9 +8407,9 @@ func (x *fastReflection_MsgExecuteBundleResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgExecuteBundleResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateAccountMetadataResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgExecuteBundleResponse)
+		x := input.Message.Interface().(*MsgUpdateAccountMetadataResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4128,12 +8421,6 @@ func (x *fastReflection_MsgExecuteBundleResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.Responses) > 0 {
-			for _, e := range x.Responses {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4144,7 +8431,7 @@ func (x *fastReflection_MsgExecuteBundleResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecuteBundleResponse)
+		x := input.Message.Interface().(*MsgUpdateAccountMetadataResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4163,22 +8450,6 @@ func (x *fastReflection_MsgExecuteBundleResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Responses) > 0 {
-			for iNdEx := len(x.Responses) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Responses[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4190,7 +8461,7 @@ func (x *fastReflection_MsgExecuteBundleResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecuteBundleResponse)
+		x := input.Message.Interface().(*MsgUpdateAccountMetadataResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4222,46 +8493,12 @@ func (x *fastReflection_MsgExecuteBundleResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteBundleResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateAccountMetadataResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecuteBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateAccountMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Responses = append(x.Responses, &BundledTxResponse{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Responses[len(x.Responses)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4328,6 +8565,9 @@ type MsgInit struct {
 	// address_seed can be used to deterministically create the address of the account.
 	// If not present the address will be generated based on its associated account number.
 	AddressSeed []byte `protobuf:"bytes,5,opt,name=address_seed,json=addressSeed,proto3" json:"address_seed,omitempty"`
+	// salt can be used to derive the address of the account from the sender, the account type and the salt,
+	// so the address can be computed before the account is created. It cannot be set with address_seed.
+	Salt []byte `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (x *MsgInit) Reset() {
@@ -4361,76 +8601,287 @@ func (x *MsgInit) GetAccountType() string {
 	if x != nil {
 		return x.AccountType
 	}
-	return ""
+	return ""
+}
+
+func (x *MsgInit) GetMessage() *anypb.Any {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MsgInit) GetFunds() []*v1beta1.Coin {
+	if x != nil {
+		return x.Funds
+	}
+	return nil
+}
+
+func (x *MsgInit) GetAddressSeed() []byte {
+	if x != nil {
+		return x.AddressSeed
+	}
+	return nil
+}
+
+func (x *MsgInit) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+// MsgInitResponse defines the Create response type for the Msg/Create RPC method.
+type MsgInitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// account_address is the address of the newly created account.
+	AccountAddress string `protobuf:"bytes,1,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	// response is the response returned by the account implementation.
+	Response *anypb.Any `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *MsgInitResponse) Reset() {
+	*x = MsgInitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgInitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgInitResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgInitResponse.ProtoReflect.Descriptor instead.
+func (*MsgInitResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgInitResponse) GetAccountAddress() string {
+	if x != nil {
+		return x.AccountAddress
+	}
+	return ""
+}
+
+func (x *MsgInitResponse) GetResponse() *anypb.Any {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// MsgInitBatch defines the InitBatch request type for the Msg/InitBatch RPC method.
+type MsgInitBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender is the address of the sender of this message, which creates all the accounts.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account_type is the type of the accounts to be created.
+	AccountType string `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	// items are the accounts to be created.
+	Items []*InitBatchItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *MsgInitBatch) Reset() {
+	*x = MsgInitBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgInitBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgInitBatch) ProtoMessage() {}
+
+// Deprecated: Use MsgInitBatch.ProtoReflect.Descriptor instead.
+func (*MsgInitBatch) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgInitBatch) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgInitBatch) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *MsgInitBatch) GetItems() []*InitBatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// InitBatchItem defines an account to be created by a MsgInitBatch.
+type InitBatchItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// message is the init message to be sent to the account.
+	Message *anypb.Any `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// funds contains the coins that the sender wants to send to the account.
+	Funds []*v1beta1.Coin `protobuf:"bytes,2,rep,name=funds,proto3" json:"funds,omitempty"`
+	// address_seed can be used to deterministically create the address of the account.
+	// If not present the address will be generated based on its associated account number.
+	AddressSeed []byte `protobuf:"bytes,3,opt,name=address_seed,json=addressSeed,proto3" json:"address_seed,omitempty"`
+}
+
+func (x *InitBatchItem) Reset() {
+	*x = InitBatchItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitBatchItem) ProtoMessage() {}
+
+// Deprecated: Use InitBatchItem.ProtoReflect.Descriptor instead.
+func (*InitBatchItem) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InitBatchItem) GetMessage() *anypb.Any {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *InitBatchItem) GetFunds() []*v1beta1.Coin {
+	if x != nil {
+		return x.Funds
+	}
+	return nil
+}
+
+func (x *InitBatchItem) GetAddressSeed() []byte {
+	if x != nil {
+		return x.AddressSeed
+	}
+	return nil
+}
+
+// MsgInitBatchResponse defines the InitBatch response type for the Msg/InitBatch RPC method.
+type MsgInitBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// responses are the results of the items, in the same order.
+	Responses []*InitBatchItemResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *MsgInitBatchResponse) Reset() {
+	*x = MsgInitBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MsgInit) GetMessage() *anypb.Any {
-	if x != nil {
-		return x.Message
-	}
-	return nil
+func (x *MsgInitBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgInit) GetFunds() []*v1beta1.Coin {
-	if x != nil {
-		return x.Funds
-	}
-	return nil
+func (*MsgInitBatchResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgInitBatchResponse.ProtoReflect.Descriptor instead.
+func (*MsgInitBatchResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *MsgInit) GetAddressSeed() []byte {
+func (x *MsgInitBatchResponse) GetResponses() []*InitBatchItemResponse {
 	if x != nil {
-		return x.AddressSeed
+		return x.Responses
 	}
 	return nil
 }
 
-// MsgInitResponse defines the Create response type for the Msg/Create RPC method.
-type MsgInitResponse struct {
+// InitBatchItemResponse defines the result of the creation of an account of a MsgInitBatch.
+type InitBatchItemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// account_address is the address of the newly created account.
+	// account_address is the address of the newly created account, if it was created.
 	AccountAddress string `protobuf:"bytes,1,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
 	// response is the response returned by the account implementation.
 	Response *anypb.Any `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	// error defines the error that occurred during the creation of the account.
+	// If the error is not empty, the account was not created and its state changes were discarded.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *MsgInitResponse) Reset() {
-	*x = MsgInitResponse{}
+func (x *InitBatchItemResponse) Reset() {
+	*x = InitBatchItemResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[1]
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgInitResponse) String() string {
+func (x *InitBatchItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgInitResponse) ProtoMessage() {}
+func (*InitBatchItemResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgInitResponse.ProtoReflect.Descriptor instead.
-func (*MsgInitResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use InitBatchItemResponse.ProtoReflect.Descriptor instead.
+func (*InitBatchItemResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *MsgInitResponse) GetAccountAddress() string {
+func (x *InitBatchItemResponse) GetAccountAddress() string {
 	if x != nil {
 		return x.AccountAddress
 	}
 	return ""
 }
 
-func (x *MsgInitResponse) GetResponse() *anypb.Any {
+func (x *InitBatchItemResponse) GetResponse() *anypb.Any {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
+func (x *InitBatchItemResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // MsgExecute defines the Execute request type for the Msg/Execute RPC method.
 type MsgExecute struct {
 	state         protoimpl.MessageState
@@ -4451,7 +8902,7 @@ type MsgExecute struct {
 func (x *MsgExecute) Reset() {
 	*x = MsgExecute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[2]
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4465,7 +8916,7 @@ func (*MsgExecute) ProtoMessage() {}
 
 // Deprecated: Use MsgExecute.ProtoReflect.Descriptor instead.
 func (*MsgExecute) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MsgExecute) GetSender() string {
@@ -4509,7 +8960,7 @@ type MsgExecuteResponse struct {
 func (x *MsgExecuteResponse) Reset() {
 	*x = MsgExecuteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[3]
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4523,7 +8974,7 @@ func (*MsgExecuteResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgExecuteResponse.ProtoReflect.Descriptor instead.
 func (*MsgExecuteResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MsgExecuteResponse) GetResponse() *anypb.Any {
@@ -4549,7 +9000,7 @@ type MsgExecuteBundle struct {
 func (x *MsgExecuteBundle) Reset() {
 	*x = MsgExecuteBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[4]
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4563,7 +9014,7 @@ func (*MsgExecuteBundle) ProtoMessage() {}
 
 // Deprecated: Use MsgExecuteBundle.ProtoReflect.Descriptor instead.
 func (*MsgExecuteBundle) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MsgExecuteBundle) GetBundler() string {
@@ -4611,7 +9062,7 @@ type BundledTxResponse struct {
 func (x *BundledTxResponse) Reset() {
 	*x = BundledTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[5]
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4625,7 +9076,7 @@ func (*BundledTxResponse) ProtoMessage() {}
 
 // Deprecated: Use BundledTxResponse.ProtoReflect.Descriptor instead.
 func (*BundledTxResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BundledTxResponse) GetAuthenticationGasUsed() uint64 {
@@ -4683,7 +9134,7 @@ type MsgExecuteBundleResponse struct {
 func (x *MsgExecuteBundleResponse) Reset() {
 	*x = MsgExecuteBundleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[6]
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4697,7 +9148,7 @@ func (*MsgExecuteBundleResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgExecuteBundleResponse.ProtoReflect.Descriptor instead.
 func (*MsgExecuteBundleResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MsgExecuteBundleResponse) GetResponses() []*BundledTxResponse {
@@ -4707,6 +9158,174 @@ func (x *MsgExecuteBundleResponse) GetResponses() []*BundledTxResponse {
 	return nil
 }
 
+// MsgMigrateAccountType defines the MigrateAccountType request type for the Msg/MigrateAccountType RPC method.
+type MsgMigrateAccountType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender is the address of the account to migrate.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// new_account_type is the account type to migrate the account to.
+	NewAccountType string `protobuf:"bytes,2,opt,name=new_account_type,json=newAccountType,proto3" json:"new_account_type,omitempty"`
+	// message is the init message of the new account type.
+	Message *anypb.Any `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MsgMigrateAccountType) Reset() {
+	*x = MsgMigrateAccountType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgMigrateAccountType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgMigrateAccountType) ProtoMessage() {}
+
+// Deprecated: Use MsgMigrateAccountType.ProtoReflect.Descriptor instead.
+func (*MsgMigrateAccountType) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MsgMigrateAccountType) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgMigrateAccountType) GetNewAccountType() string {
+	if x != nil {
+		return x.NewAccountType
+	}
+	return ""
+}
+
+func (x *MsgMigrateAccountType) GetMessage() *anypb.Any {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// MsgMigrateAccountTypeResponse defines the MigrateAccountType response type for the Msg/MigrateAccountType
+// RPC method.
+type MsgMigrateAccountTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// response is the init response returned by the new account implementation.
+	Response *anypb.Any `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *MsgMigrateAccountTypeResponse) Reset() {
+	*x = MsgMigrateAccountTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgMigrateAccountTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgMigrateAccountTypeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgMigrateAccountTypeResponse.ProtoReflect.Descriptor instead.
+func (*MsgMigrateAccountTypeResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgMigrateAccountTypeResponse) GetResponse() *anypb.Any {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// MsgUpdateAccountMetadata defines the UpdateAccountMetadata request type for the Msg/UpdateAccountMetadata RPC
+// method.
+type MsgUpdateAccountMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender is the address of the account to update the metadata of.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// metadata is the new metadata of the account, an empty metadata removes it.
+	Metadata *AccountMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *MsgUpdateAccountMetadata) Reset() {
+	*x = MsgUpdateAccountMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateAccountMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateAccountMetadata) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateAccountMetadata.ProtoReflect.Descriptor instead.
+func (*MsgUpdateAccountMetadata) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MsgUpdateAccountMetadata) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgUpdateAccountMetadata) GetMetadata() *AccountMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// MsgUpdateAccountMetadataResponse defines the UpdateAccountMetadata response type for the
+// Msg/UpdateAccountMetadata RPC method.
+type MsgUpdateAccountMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateAccountMetadataResponse) Reset() {
+	*x = MsgUpdateAccountMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateAccountMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateAccountMetadataResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateAccountMetadataResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateAccountMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
 var File_cosmos_accounts_v1_tx_proto protoreflect.FileDescriptor
 
 var file_cosmos_accounts_v1_tx_proto_rawDesc = []byte{
@@ -4720,112 +9339,199 @@ var file_cosmos_accounts_v1_tx_proto_rawDesc = []byte{
 	0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78,
 	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x02, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x49,
-	0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x61,
-	0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
-	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x75, 0x6e, 0x64,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x65, 0x65, 0x64, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x22, 0x6c, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x6f, 0x1a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x02, 0x0a, 0x07, 0x4d,
+	0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x61, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x66,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x3a, 0x0b, 0x82, 0xe7, 0xb0,
+	0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x6c, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x49,
+	0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x49, 0x6e,
+	0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x74, 0x65, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xc5,
+	0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x61, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x65, 0x65, 0x64, 0x22, 0x65, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x88, 0x01,
+	0x0a, 0x15, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdc, 0x01, 0x0a, 0x0a, 0x4d, 0x73, 0x67,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xdc, 0x01, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e,
+	0x4c, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x3a,
+	0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22, 0xe1, 0x02,
+	0x0a, 0x11, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x67,
+	0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x19, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x17, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x5f, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e,
 	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x61,
-	0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
-	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x75, 0x6e, 0x64,
-	0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x46,
-	0x0a, 0x12, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x62, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x72, 0x22, 0xe1, 0x02, 0x0a, 0x11, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x64,
-	0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73,
-	0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x55, 0x73,
-	0x65, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x19, 0x62,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x0b,
+	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x51, 0x0a, 0x1d, 0x4d,
+	0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x17, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a,
-	0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75,
-	0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x13, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x12,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5f, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x32, 0x8e, 0x02, 0x0a, 0x03, 0x4d, 0x73,
-	0x67, 0x12, 0x48, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86,
+	0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd8, 0x04, 0x0a, 0x03,
+	0x4d, 0x73, 0x67, 0x12, 0x48, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x09, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x28, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0d, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72,
+	0x0a, 0x12, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x1a,
+	0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49,
-	0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x07, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63,
-	0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12,
-	0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xbb, 0x01, 0x0a, 0x16, 0x63,
-	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x1e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a,
+	0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xbb, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x76,
+	0x31, 0x3b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43,
+	0x41, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4840,39 +9546,62 @@ func file_cosmos_accounts_v1_tx_proto_rawDescGZIP() []byte {
 	return file_cosmos_accounts_v1_tx_proto_rawDescData
 }
 
-var file_cosmos_accounts_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cosmos_accounts_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_cosmos_accounts_v1_tx_proto_goTypes = []interface{}{
-	(*MsgInit)(nil),                  // 0: cosmos.accounts.v1.MsgInit
-	(*MsgInitResponse)(nil),          // 1: cosmos.accounts.v1.MsgInitResponse
-	(*MsgExecute)(nil),               // 2: cosmos.accounts.v1.MsgExecute
-	(*MsgExecuteResponse)(nil),       // 3: cosmos.accounts.v1.MsgExecuteResponse
-	(*MsgExecuteBundle)(nil),         // 4: cosmos.accounts.v1.MsgExecuteBundle
-	(*BundledTxResponse)(nil),        // 5: cosmos.accounts.v1.BundledTxResponse
-	(*MsgExecuteBundleResponse)(nil), // 6: cosmos.accounts.v1.MsgExecuteBundleResponse
-	(*anypb.Any)(nil),                // 7: google.protobuf.Any
-	(*v1beta1.Coin)(nil),             // 8: cosmos.base.v1beta1.Coin
+	(*MsgInit)(nil),                          // 0: cosmos.accounts.v1.MsgInit
+	(*MsgInitResponse)(nil),                  // 1: cosmos.accounts.v1.MsgInitResponse
+	(*MsgInitBatch)(nil),                     // 2: cosmos.accounts.v1.MsgInitBatch
+	(*InitBatchItem)(nil),                    // 3: cosmos.accounts.v1.InitBatchItem
+	(*MsgInitBatchResponse)(nil),             // 4: cosmos.accounts.v1.MsgInitBatchResponse
+	(*InitBatchItemResponse)(nil),            // 5: cosmos.accounts.v1.InitBatchItemResponse
+	(*MsgExecute)(nil),                       // 6: cosmos.accounts.v1.MsgExecute
+	(*MsgExecuteResponse)(nil),               // 7: cosmos.accounts.v1.MsgExecuteResponse
+	(*MsgExecuteBundle)(nil),                 // 8: cosmos.accounts.v1.MsgExecuteBundle
+	(*BundledTxResponse)(nil),                // 9: cosmos.accounts.v1.BundledTxResponse
+	(*MsgExecuteBundleResponse)(nil),         // 10: cosmos.accounts.v1.MsgExecuteBundleResponse
+	(*MsgMigrateAccountType)(nil),            // 11: cosmos.accounts.v1.MsgMigrateAccountType
+	(*MsgMigrateAccountTypeResponse)(nil),    // 12: cosmos.accounts.v1.MsgMigrateAccountTypeResponse
+	(*MsgUpdateAccountMetadata)(nil),         // 13: cosmos.accounts.v1.MsgUpdateAccountMetadata
+	(*MsgUpdateAccountMetadataResponse)(nil), // 14: cosmos.accounts.v1.MsgUpdateAccountMetadataResponse
+	(*anypb.Any)(nil),                        // 15: google.protobuf.Any
+	(*v1beta1.Coin)(nil),                     // 16: cosmos.base.v1beta1.Coin
+	(*AccountMetadata)(nil),                  // 17: cosmos.accounts.v1.AccountMetadata
 }
 var file_cosmos_accounts_v1_tx_proto_depIdxs = []int32{
-	7,  // 0: cosmos.accounts.v1.MsgInit.message:type_name -> google.protobuf.Any
-	8,  // 1: cosmos.accounts.v1.MsgInit.funds:type_name -> cosmos.base.v1beta1.Coin
-	7,  // 2: cosmos.accounts.v1.MsgInitResponse.response:type_name -> google.protobuf.Any
-	7,  // 3: cosmos.accounts.v1.MsgExecute.message:type_name -> google.protobuf.Any
-	8,  // 4: cosmos.accounts.v1.MsgExecute.funds:type_name -> cosmos.base.v1beta1.Coin
-	7,  // 5: cosmos.accounts.v1.MsgExecuteResponse.response:type_name -> google.protobuf.Any
-	7,  // 6: cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses:type_name -> google.protobuf.Any
-	7,  // 7: cosmos.accounts.v1.BundledTxResponse.execution_responses:type_name -> google.protobuf.Any
-	5,  // 8: cosmos.accounts.v1.MsgExecuteBundleResponse.responses:type_name -> cosmos.accounts.v1.BundledTxResponse
-	0,  // 9: cosmos.accounts.v1.Msg.Init:input_type -> cosmos.accounts.v1.MsgInit
-	2,  // 10: cosmos.accounts.v1.Msg.Execute:input_type -> cosmos.accounts.v1.MsgExecute
-	4,  // 11: cosmos.accounts.v1.Msg.ExecuteBundle:input_type -> cosmos.accounts.v1.MsgExecuteBundle
-	1,  // 12: cosmos.accounts.v1.Msg.Init:output_type -> cosmos.accounts.v1.MsgInitResponse
-	3,  // 13: cosmos.accounts.v1.Msg.Execute:output_type -> cosmos.accounts.v1.MsgExecuteResponse
-	6,  // 14: cosmos.accounts.v1.Msg.ExecuteBundle:output_type -> cosmos.accounts.v1.MsgExecuteBundleResponse
-	12, // [12:15] is the sub-list for method output_type
-	9,  // [9:12] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	15, // 0: cosmos.accounts.v1.MsgInit.message:type_name -> google.protobuf.Any
+	16, // 1: cosmos.accounts.v1.MsgInit.funds:type_name -> cosmos.base.v1beta1.Coin
+	15, // 2: cosmos.accounts.v1.MsgInitResponse.response:type_name -> google.protobuf.Any
+	3,  // 3: cosmos.accounts.v1.MsgInitBatch.items:type_name -> cosmos.accounts.v1.InitBatchItem
+	15, // 4: cosmos.accounts.v1.InitBatchItem.message:type_name -> google.protobuf.Any
+	16, // 5: cosmos.accounts.v1.InitBatchItem.funds:type_name -> cosmos.base.v1beta1.Coin
+	5,  // 6: cosmos.accounts.v1.MsgInitBatchResponse.responses:type_name -> cosmos.accounts.v1.InitBatchItemResponse
+	15, // 7: cosmos.accounts.v1.InitBatchItemResponse.response:type_name -> google.protobuf.Any
+	15, // 8: cosmos.accounts.v1.MsgExecute.message:type_name -> google.protobuf.Any
+	16, // 9: cosmos.accounts.v1.MsgExecute.funds:type_name -> cosmos.base.v1beta1.Coin
+	15, // 10: cosmos.accounts.v1.MsgExecuteResponse.response:type_name -> google.protobuf.Any
+	15, // 11: cosmos.accounts.v1.BundledTxResponse.bundler_payment_responses:type_name -> google.protobuf.Any
+	15, // 12: cosmos.accounts.v1.BundledTxResponse.execution_responses:type_name -> google.protobuf.Any
+	9,  // 13: cosmos.accounts.v1.MsgExecuteBundleResponse.responses:type_name -> cosmos.accounts.v1.BundledTxResponse
+	15, // 14: cosmos.accounts.v1.MsgMigrateAccountType.message:type_name -> google.protobuf.Any
+	15, // 15: cosmos.accounts.v1.MsgMigrateAccountTypeResponse.response:type_name -> google.protobuf.Any
+	17, // 16: cosmos.accounts.v1.MsgUpdateAccountMetadata.metadata:type_name -> cosmos.accounts.v1.AccountMetadata
+	0,  // 17: cosmos.accounts.v1.Msg.Init:input_type -> cosmos.accounts.v1.MsgInit
+	2,  // 18: cosmos.accounts.v1.Msg.InitBatch:input_type -> cosmos.accounts.v1.MsgInitBatch
+	6,  // 19: cosmos.accounts.v1.Msg.Execute:input_type -> cosmos.accounts.v1.MsgExecute
+	8,  // 20: cosmos.accounts.v1.Msg.ExecuteBundle:input_type -> cosmos.accounts.v1.MsgExecuteBundle
+	11, // 21: cosmos.accounts.v1.Msg.MigrateAccountType:input_type -> cosmos.accounts.v1.MsgMigrateAccountType
+	13, // 22: cosmos.accounts.v1.Msg.UpdateAccountMetadata:input_type -> cosmos.accounts.v1.MsgUpdateAccountMetadata
+	1,  // 23: cosmos.accounts.v1.Msg.Init:output_type -> cosmos.accounts.v1.MsgInitResponse
+	4,  // 24: cosmos.accounts.v1.Msg.InitBatch:output_type -> cosmos.accounts.v1.MsgInitBatchResponse
+	7,  // 25: cosmos.accounts.v1.Msg.Execute:output_type -> cosmos.accounts.v1.MsgExecuteResponse
+	10, // 26: cosmos.accounts.v1.Msg.ExecuteBundle:output_type -> cosmos.accounts.v1.MsgExecuteBundleResponse
+	12, // 27: cosmos.accounts.v1.Msg.MigrateAccountType:output_type -> cosmos.accounts.v1.MsgMigrateAccountTypeResponse
+	14, // 28: cosmos.accounts.v1.Msg.UpdateAccountMetadata:output_type -> cosmos.accounts.v1.MsgUpdateAccountMetadataResponse
+	23, // [23:29] is the sub-list for method output_type
+	17, // [17:23] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_accounts_v1_tx_proto_init() }
@@ -4880,6 +9609,7 @@ func file_cosmos_accounts_v1_tx_proto_init() {
 	if File_cosmos_accounts_v1_tx_proto != nil {
 		return
 	}
+	file_cosmos_accounts_v1_accounts_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_cosmos_accounts_v1_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgInit); i {
@@ -4906,7 +9636,7 @@ func file_cosmos_accounts_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_accounts_v1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExecute); i {
+			switch v := v.(*MsgInitBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4918,7 +9648,7 @@ func file_cosmos_accounts_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_accounts_v1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExecuteResponse); i {
+			switch v := v.(*InitBatchItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4930,7 +9660,7 @@ func file_cosmos_accounts_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_accounts_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExecuteBundle); i {
+			switch v := v.(*MsgInitBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4942,7 +9672,7 @@ func file_cosmos_accounts_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_accounts_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BundledTxResponse); i {
+			switch v := v.(*InitBatchItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4954,6 +9684,54 @@ func file_cosmos_accounts_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_accounts_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExecute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExecuteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExecuteBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundledTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgExecuteBundleResponse); i {
 			case 0:
 				return &v.state
@@ -4965,6 +9743,54 @@ func file_cosmos_accounts_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_accounts_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgMigrateAccountType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgMigrateAccountTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateAccountMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateAccountMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4972,7 +9798,7 @@ func file_cosmos_accounts_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_accounts_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
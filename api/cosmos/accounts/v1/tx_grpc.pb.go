@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Init_FullMethodName          = "/cosmos.accounts.v1.Msg/Init"
-	Msg_Execute_FullMethodName       = "/cosmos.accounts.v1.Msg/Execute"
-	Msg_ExecuteBundle_FullMethodName = "/cosmos.accounts.v1.Msg/ExecuteBundle"
+	Msg_Init_FullMethodName                  = "/cosmos.accounts.v1.Msg/Init"
+	Msg_InitBatch_FullMethodName             = "/cosmos.accounts.v1.Msg/InitBatch"
+	Msg_Execute_FullMethodName               = "/cosmos.accounts.v1.Msg/Execute"
+	Msg_ExecuteBundle_FullMethodName         = "/cosmos.accounts.v1.Msg/ExecuteBundle"
+	Msg_MigrateAccountType_FullMethodName    = "/cosmos.accounts.v1.Msg/MigrateAccountType"
+	Msg_UpdateAccountMetadata_FullMethodName = "/cosmos.accounts.v1.Msg/UpdateAccountMetadata"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,11 +35,18 @@ const (
 type MsgClient interface {
 	// Init creates a new account in the chain.
 	Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOption) (*MsgInitResponse, error)
+	// InitBatch creates many new accounts of the same type in the chain.
+	InitBatch(ctx context.Context, in *MsgInitBatch, opts ...grpc.CallOption) (*MsgInitBatchResponse, error)
 	// Execute executes a message to the target account.
 	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error)
+	// MigrateAccountType migrates the sender account to another account type, keeping its address and
+	// balances.
+	MigrateAccountType(ctx context.Context, in *MsgMigrateAccountType, opts ...grpc.CallOption) (*MsgMigrateAccountTypeResponse, error)
+	// UpdateAccountMetadata sets the metadata of the sender account.
+	UpdateAccountMetadata(ctx context.Context, in *MsgUpdateAccountMetadata, opts ...grpc.CallOption) (*MsgUpdateAccountMetadataResponse, error)
 }
 
 type msgClient struct {
@@ -57,6 +67,16 @@ func (c *msgClient) Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) InitBatch(ctx context.Context, in *MsgInitBatch, opts ...grpc.CallOption) (*MsgInitBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgInitBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_InitBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgExecuteResponse)
@@ -77,6 +97,26 @@ func (c *msgClient) ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opt
 	return out, nil
 }
 
+func (c *msgClient) MigrateAccountType(ctx context.Context, in *MsgMigrateAccountType, opts ...grpc.CallOption) (*MsgMigrateAccountTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgMigrateAccountTypeResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateAccountType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAccountMetadata(ctx context.Context, in *MsgUpdateAccountMetadata, opts ...grpc.CallOption) (*MsgUpdateAccountMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateAccountMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateAccountMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -85,11 +125,18 @@ func (c *msgClient) ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opt
 type MsgServer interface {
 	// Init creates a new account in the chain.
 	Init(context.Context, *MsgInit) (*MsgInitResponse, error)
+	// InitBatch creates many new accounts of the same type in the chain.
+	InitBatch(context.Context, *MsgInitBatch) (*MsgInitBatchResponse, error)
 	// Execute executes a message to the target account.
 	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error)
+	// MigrateAccountType migrates the sender account to another account type, keeping its address and
+	// balances.
+	MigrateAccountType(context.Context, *MsgMigrateAccountType) (*MsgMigrateAccountTypeResponse, error)
+	// UpdateAccountMetadata sets the metadata of the sender account.
+	UpdateAccountMetadata(context.Context, *MsgUpdateAccountMetadata) (*MsgUpdateAccountMetadataResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -103,12 +150,21 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) Init(context.Context, *MsgInit) (*MsgInitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
 }
+func (UnimplementedMsgServer) InitBatch(context.Context, *MsgInitBatch) (*MsgInitBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitBatch not implemented")
+}
 func (UnimplementedMsgServer) Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
 func (UnimplementedMsgServer) ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBundle not implemented")
 }
+func (UnimplementedMsgServer) MigrateAccountType(context.Context, *MsgMigrateAccountType) (*MsgMigrateAccountTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAccountType not implemented")
+}
+func (UnimplementedMsgServer) UpdateAccountMetadata(context.Context, *MsgUpdateAccountMetadata) (*MsgUpdateAccountMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountMetadata not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -148,6 +204,24 @@ func _Msg_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InitBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InitBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitBatch(ctx, req.(*MsgInitBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecute)
 	if err := dec(in); err != nil {
@@ -184,6 +258,42 @@ func _Msg_ExecuteBundle_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateAccountType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAccountType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAccountType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateAccountType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAccountType(ctx, req.(*MsgMigrateAccountType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAccountMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccountMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccountMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAccountMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccountMetadata(ctx, req.(*MsgUpdateAccountMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,6 +305,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Init",
 			Handler:    _Msg_Init_Handler,
 		},
+		{
+			MethodName: "InitBatch",
+			Handler:    _Msg_InitBatch_Handler,
+		},
 		{
 			MethodName: "Execute",
 			Handler:    _Msg_Execute_Handler,
@@ -203,6 +317,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteBundle",
 			Handler:    _Msg_ExecuteBundle_Handler,
 		},
+		{
+			MethodName: "MigrateAccountType",
+			Handler:    _Msg_MigrateAccountType_Handler,
+		},
+		{
+			MethodName: "UpdateAccountMetadata",
+			Handler:    _Msg_UpdateAccountMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/accounts/v1/tx.proto",
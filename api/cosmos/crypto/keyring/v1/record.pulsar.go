@@ -10,19 +10,74 @@ import (
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Record_8_list)(nil)
+
+type _Record_8_list struct {
+	list *[]*Record_RotatedKey
+}
+
+func (x *_Record_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Record_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Record_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Record_RotatedKey)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Record_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Record_RotatedKey)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Record_8_list) AppendMutable() protoreflect.Value {
+	v := new(Record_RotatedKey)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Record_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Record_8_list) NewElement() protoreflect.Value {
+	v := new(Record_RotatedKey)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Record_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Record         protoreflect.MessageDescriptor
-	fd_Record_name    protoreflect.FieldDescriptor
-	fd_Record_pub_key protoreflect.FieldDescriptor
-	fd_Record_local   protoreflect.FieldDescriptor
-	fd_Record_ledger  protoreflect.FieldDescriptor
-	fd_Record_multi   protoreflect.FieldDescriptor
-	fd_Record_offline protoreflect.FieldDescriptor
+	md_Record              protoreflect.MessageDescriptor
+	fd_Record_name         protoreflect.FieldDescriptor
+	fd_Record_pub_key      protoreflect.FieldDescriptor
+	fd_Record_local        protoreflect.FieldDescriptor
+	fd_Record_ledger       protoreflect.FieldDescriptor
+	fd_Record_multi        protoreflect.FieldDescriptor
+	fd_Record_offline      protoreflect.FieldDescriptor
+	fd_Record_kms          protoreflect.FieldDescriptor
+	fd_Record_key_history  protoreflect.FieldDescriptor
+	fd_Record_activated_at protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -34,6 +89,9 @@ func init() {
 	fd_Record_ledger = md_Record.Fields().ByName("ledger")
 	fd_Record_multi = md_Record.Fields().ByName("multi")
 	fd_Record_offline = md_Record.Fields().ByName("offline")
+	fd_Record_kms = md_Record.Fields().ByName("kms")
+	fd_Record_key_history = md_Record.Fields().ByName("key_history")
+	fd_Record_activated_at = md_Record.Fields().ByName("activated_at")
 }
 
 var _ protoreflect.Message = (*fastReflection_Record)(nil)
@@ -139,6 +197,24 @@ func (x *fastReflection_Record) Range(f func(protoreflect.FieldDescriptor, proto
 			if !f(fd_Record_offline, value) {
 				return
 			}
+		case *Record_Kms:
+			v := o.Kms
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_Record_kms, value) {
+				return
+			}
+		}
+	}
+	if len(x.KeyHistory) != 0 {
+		value := protoreflect.ValueOfList(&_Record_8_list{list: &x.KeyHistory})
+		if !f(fd_Record_key_history, value) {
+			return
+		}
+	}
+	if x.ActivatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.ActivatedAt.ProtoReflect())
+		if !f(fd_Record_activated_at, value) {
+			return
 		}
 	}
 }
@@ -192,6 +268,18 @@ func (x *fastReflection_Record) Has(fd protoreflect.FieldDescriptor) bool {
 		} else {
 			return false
 		}
+	case "cosmos.crypto.keyring.v1.Record.kms":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*Record_Kms); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.crypto.keyring.v1.Record.key_history":
+		return len(x.KeyHistory) != 0
+	case "cosmos.crypto.keyring.v1.Record.activated_at":
+		return x.ActivatedAt != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record"))
@@ -220,6 +308,12 @@ func (x *fastReflection_Record) Clear(fd protoreflect.FieldDescriptor) {
 		x.Item = nil
 	case "cosmos.crypto.keyring.v1.Record.offline":
 		x.Item = nil
+	case "cosmos.crypto.keyring.v1.Record.kms":
+		x.Item = nil
+	case "cosmos.crypto.keyring.v1.Record.key_history":
+		x.KeyHistory = nil
+	case "cosmos.crypto.keyring.v1.Record.activated_at":
+		x.ActivatedAt = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record"))
@@ -274,6 +368,23 @@ func (x *fastReflection_Record) Get(descriptor protoreflect.FieldDescriptor) pro
 		} else {
 			return protoreflect.ValueOfMessage((*Record_Offline)(nil).ProtoReflect())
 		}
+	case "cosmos.crypto.keyring.v1.Record.kms":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*Record_KMS)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*Record_Kms); ok {
+			return protoreflect.ValueOfMessage(v.Kms.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*Record_KMS)(nil).ProtoReflect())
+		}
+	case "cosmos.crypto.keyring.v1.Record.key_history":
+		if len(x.KeyHistory) == 0 {
+			return protoreflect.ValueOfList(&_Record_8_list{})
+		}
+		listValue := &_Record_8_list{list: &x.KeyHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.crypto.keyring.v1.Record.activated_at":
+		value := x.ActivatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record"))
@@ -310,6 +421,15 @@ func (x *fastReflection_Record) Set(fd protoreflect.FieldDescriptor, value proto
 	case "cosmos.crypto.keyring.v1.Record.offline":
 		cv := value.Message().Interface().(*Record_Offline)
 		x.Item = &Record_Offline_{Offline: cv}
+	case "cosmos.crypto.keyring.v1.Record.kms":
+		cv := value.Message().Interface().(*Record_KMS)
+		x.Item = &Record_Kms{Kms: cv}
+	case "cosmos.crypto.keyring.v1.Record.key_history":
+		lv := value.List()
+		clv := lv.(*_Record_8_list)
+		x.KeyHistory = *clv.list
+	case "cosmos.crypto.keyring.v1.Record.activated_at":
+		x.ActivatedAt = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record"))
@@ -399,6 +519,33 @@ func (x *fastReflection_Record) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.Item = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
+	case "cosmos.crypto.keyring.v1.Record.kms":
+		if x.Item == nil {
+			value := &Record_KMS{}
+			oneofValue := &Record_Kms{Kms: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *Record_Kms:
+			return protoreflect.ValueOfMessage(m.Kms.ProtoReflect())
+		default:
+			value := &Record_KMS{}
+			oneofValue := &Record_Kms{Kms: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.crypto.keyring.v1.Record.key_history":
+		if x.KeyHistory == nil {
+			x.KeyHistory = []*Record_RotatedKey{}
+		}
+		value := &_Record_8_list{list: &x.KeyHistory}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.crypto.keyring.v1.Record.activated_at":
+		if x.ActivatedAt == nil {
+			x.ActivatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ActivatedAt.ProtoReflect())
 	case "cosmos.crypto.keyring.v1.Record.name":
 		panic(fmt.Errorf("field name of message cosmos.crypto.keyring.v1.Record is not mutable"))
 	default:
@@ -431,6 +578,15 @@ func (x *fastReflection_Record) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cosmos.crypto.keyring.v1.Record.offline":
 		value := &Record_Offline{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.kms":
+		value := &Record_KMS{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.key_history":
+		list := []*Record_RotatedKey{}
+		return protoreflect.ValueOfList(&_Record_8_list{list: &list})
+	case "cosmos.crypto.keyring.v1.Record.activated_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record"))
@@ -457,6 +613,8 @@ func (x *fastReflection_Record) WhichOneof(d protoreflect.OneofDescriptor) proto
 			return x.Descriptor().Fields().ByName("multi")
 		case *Record_Offline_:
 			return x.Descriptor().Fields().ByName("offline")
+		case *Record_Kms:
+			return x.Descriptor().Fields().ByName("kms")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record", d.FullName()))
@@ -547,6 +705,22 @@ func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
 			}
 			l = options.Size(x.Offline)
 			n += 1 + l + runtime.Sov(uint64(l))
+		case *Record_Kms:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Kms)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.KeyHistory) > 0 {
+			for _, e := range x.KeyHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ActivatedAt != nil {
+			l = options.Size(x.ActivatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -630,6 +804,49 @@ func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x32
+		case *Record_Kms:
+			encoded, err := options.Marshal(x.Kms)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.ActivatedAt != nil {
+			encoded, err := options.Marshal(x.ActivatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.KeyHistory) > 0 {
+			for iNdEx := len(x.KeyHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KeyHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
 		}
 		if x.PubKey != nil {
 			encoded, err := options.Marshal(x.PubKey)
@@ -909,6 +1126,111 @@ func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
 				}
 				x.Item = &Record_Offline_{v}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &Record_KMS{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &Record_Kms{v}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyHistory = append(x.KeyHistory, &Record_RotatedKey{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyHistory[len(x.KeyHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ActivatedAt == nil {
+					x.ActivatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActivatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -945,25 +1267,29 @@ func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Record_Local          protoreflect.MessageDescriptor
-	fd_Record_Local_priv_key protoreflect.FieldDescriptor
+	md_Record_RotatedKey              protoreflect.MessageDescriptor
+	fd_Record_RotatedKey_pub_key      protoreflect.FieldDescriptor
+	fd_Record_RotatedKey_activated_at protoreflect.FieldDescriptor
+	fd_Record_RotatedKey_rotated_at   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_crypto_keyring_v1_record_proto_init()
-	md_Record_Local = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Local")
-	fd_Record_Local_priv_key = md_Record_Local.Fields().ByName("priv_key")
+	md_Record_RotatedKey = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("RotatedKey")
+	fd_Record_RotatedKey_pub_key = md_Record_RotatedKey.Fields().ByName("pub_key")
+	fd_Record_RotatedKey_activated_at = md_Record_RotatedKey.Fields().ByName("activated_at")
+	fd_Record_RotatedKey_rotated_at = md_Record_RotatedKey.Fields().ByName("rotated_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_Record_Local)(nil)
+var _ protoreflect.Message = (*fastReflection_Record_RotatedKey)(nil)
 
-type fastReflection_Record_Local Record_Local
+type fastReflection_Record_RotatedKey Record_RotatedKey
 
-func (x *Record_Local) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Record_Local)(x)
+func (x *Record_RotatedKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Record_RotatedKey)(x)
 }
 
-func (x *Record_Local) slowProtoReflect() protoreflect.Message {
+func (x *Record_RotatedKey) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -975,43 +1301,43 @@ func (x *Record_Local) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Record_Local_messageType fastReflection_Record_Local_messageType
-var _ protoreflect.MessageType = fastReflection_Record_Local_messageType{}
+var _fastReflection_Record_RotatedKey_messageType fastReflection_Record_RotatedKey_messageType
+var _ protoreflect.MessageType = fastReflection_Record_RotatedKey_messageType{}
 
-type fastReflection_Record_Local_messageType struct{}
+type fastReflection_Record_RotatedKey_messageType struct{}
 
-func (x fastReflection_Record_Local_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Record_Local)(nil)
+func (x fastReflection_Record_RotatedKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Record_RotatedKey)(nil)
 }
-func (x fastReflection_Record_Local_messageType) New() protoreflect.Message {
-	return new(fastReflection_Record_Local)
+func (x fastReflection_Record_RotatedKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_Record_RotatedKey)
 }
-func (x fastReflection_Record_Local_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Record_Local
+func (x fastReflection_Record_RotatedKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_RotatedKey
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Record_Local) Descriptor() protoreflect.MessageDescriptor {
-	return md_Record_Local
+func (x *fastReflection_Record_RotatedKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_RotatedKey
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Record_Local) Type() protoreflect.MessageType {
-	return _fastReflection_Record_Local_messageType
+func (x *fastReflection_Record_RotatedKey) Type() protoreflect.MessageType {
+	return _fastReflection_Record_RotatedKey_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Record_Local) New() protoreflect.Message {
-	return new(fastReflection_Record_Local)
+func (x *fastReflection_Record_RotatedKey) New() protoreflect.Message {
+	return new(fastReflection_Record_RotatedKey)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Record_Local) Interface() protoreflect.ProtoMessage {
-	return (*Record_Local)(x)
+func (x *fastReflection_Record_RotatedKey) Interface() protoreflect.ProtoMessage {
+	return (*Record_RotatedKey)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1019,10 +1345,22 @@ func (x *fastReflection_Record_Local) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Record_Local) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PrivKey != nil {
-		value := protoreflect.ValueOfMessage(x.PrivKey.ProtoReflect())
-		if !f(fd_Record_Local_priv_key, value) {
+func (x *fastReflection_Record_RotatedKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PubKey != nil {
+		value := protoreflect.ValueOfMessage(x.PubKey.ProtoReflect())
+		if !f(fd_Record_RotatedKey_pub_key, value) {
+			return
+		}
+	}
+	if x.ActivatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.ActivatedAt.ProtoReflect())
+		if !f(fd_Record_RotatedKey_activated_at, value) {
+			return
+		}
+	}
+	if x.RotatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.RotatedAt.ProtoReflect())
+		if !f(fd_Record_RotatedKey_rotated_at, value) {
 			return
 		}
 	}
@@ -1039,15 +1377,19 @@ func (x *fastReflection_Record_Local) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Record_Local) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Record_RotatedKey) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
-		return x.PrivKey != nil
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.pub_key":
+		return x.PubKey != nil
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.activated_at":
+		return x.ActivatedAt != nil
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.rotated_at":
+		return x.RotatedAt != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.RotatedKey"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.RotatedKey does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1057,15 +1399,19 @@ func (x *fastReflection_Record_Local) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Local) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Record_RotatedKey) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
-		x.PrivKey = nil
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.pub_key":
+		x.PubKey = nil
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.activated_at":
+		x.ActivatedAt = nil
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.rotated_at":
+		x.RotatedAt = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.RotatedKey"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.RotatedKey does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1075,16 +1421,22 @@ func (x *fastReflection_Record_Local) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Record_Local) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_RotatedKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
-		value := x.PrivKey
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.activated_at":
+		value := x.ActivatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.rotated_at":
+		value := x.RotatedAt
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.RotatedKey"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.RotatedKey does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1098,15 +1450,19 @@ func (x *fastReflection_Record_Local) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Local) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Record_RotatedKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
-		x.PrivKey = value.Message().Interface().(*anypb.Any)
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.pub_key":
+		x.PubKey = value.Message().Interface().(*anypb.Any)
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.activated_at":
+		x.ActivatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.rotated_at":
+		x.RotatedAt = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.RotatedKey"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.RotatedKey does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1120,44 +1476,60 @@ func (x *fastReflection_Record_Local) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Local) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_RotatedKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
-		if x.PrivKey == nil {
-			x.PrivKey = new(anypb.Any)
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.pub_key":
+		if x.PubKey == nil {
+			x.PubKey = new(anypb.Any)
 		}
-		return protoreflect.ValueOfMessage(x.PrivKey.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.PubKey.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.activated_at":
+		if x.ActivatedAt == nil {
+			x.ActivatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ActivatedAt.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.rotated_at":
+		if x.RotatedAt == nil {
+			x.RotatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RotatedAt.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.RotatedKey"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.RotatedKey does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Record_Local) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_RotatedKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.pub_key":
 		m := new(anypb.Any)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.activated_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.RotatedKey.rotated_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.RotatedKey"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.RotatedKey does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Record_Local) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Record_RotatedKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.Local", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.RotatedKey", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1165,7 +1537,7 @@ func (x *fastReflection_Record_Local) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Record_Local) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Record_RotatedKey) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1176,7 +1548,7 @@ func (x *fastReflection_Record_Local) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Local) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Record_RotatedKey) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1188,7 +1560,7 @@ func (x *fastReflection_Record_Local) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Record_Local) IsValid() bool {
+func (x *fastReflection_Record_RotatedKey) IsValid() bool {
 	return x != nil
 }
 
@@ -1198,9 +1570,9 @@ func (x *fastReflection_Record_Local) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Record_RotatedKey) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Record_Local)
+		x := input.Message.Interface().(*Record_RotatedKey)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1212,8 +1584,16 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.PrivKey != nil {
-			l = options.Size(x.PrivKey)
+		if x.PubKey != nil {
+			l = options.Size(x.PubKey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActivatedAt != nil {
+			l = options.Size(x.ActivatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RotatedAt != nil {
+			l = options.Size(x.RotatedAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1226,7 +1606,7 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Record_Local)
+		x := input.Message.Interface().(*Record_RotatedKey)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1245,8 +1625,36 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.PrivKey != nil {
-			encoded, err := options.Marshal(x.PrivKey)
+		if x.RotatedAt != nil {
+			encoded, err := options.Marshal(x.RotatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ActivatedAt != nil {
+			encoded, err := options.Marshal(x.ActivatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PubKey != nil {
+			encoded, err := options.Marshal(x.PubKey)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1270,7 +1678,7 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Record_Local)
+		x := input.Message.Interface().(*Record_RotatedKey)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1302,15 +1710,15 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Local: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_RotatedKey: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Local: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_RotatedKey: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrivKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1337,10 +1745,82 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.PrivKey == nil {
-					x.PrivKey = &anypb.Any{}
+				if x.PubKey == nil {
+					x.PubKey = &anypb.Any{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrivKey); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PubKey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ActivatedAt == nil {
+					x.ActivatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActivatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RotatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RotatedAt == nil {
+					x.RotatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RotatedAt); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1380,25 +1860,27 @@ func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Record_Ledger      protoreflect.MessageDescriptor
-	fd_Record_Ledger_path protoreflect.FieldDescriptor
+	md_Record_Local          protoreflect.MessageDescriptor
+	fd_Record_Local_priv_key protoreflect.FieldDescriptor
+	fd_Record_Local_hd_path  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_crypto_keyring_v1_record_proto_init()
-	md_Record_Ledger = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Ledger")
-	fd_Record_Ledger_path = md_Record_Ledger.Fields().ByName("path")
+	md_Record_Local = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Local")
+	fd_Record_Local_priv_key = md_Record_Local.Fields().ByName("priv_key")
+	fd_Record_Local_hd_path = md_Record_Local.Fields().ByName("hd_path")
 }
 
-var _ protoreflect.Message = (*fastReflection_Record_Ledger)(nil)
+var _ protoreflect.Message = (*fastReflection_Record_Local)(nil)
 
-type fastReflection_Record_Ledger Record_Ledger
+type fastReflection_Record_Local Record_Local
 
-func (x *Record_Ledger) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Record_Ledger)(x)
+func (x *Record_Local) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Record_Local)(x)
 }
 
-func (x *Record_Ledger) slowProtoReflect() protoreflect.Message {
+func (x *Record_Local) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1410,43 +1892,43 @@ func (x *Record_Ledger) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Record_Ledger_messageType fastReflection_Record_Ledger_messageType
-var _ protoreflect.MessageType = fastReflection_Record_Ledger_messageType{}
+var _fastReflection_Record_Local_messageType fastReflection_Record_Local_messageType
+var _ protoreflect.MessageType = fastReflection_Record_Local_messageType{}
 
-type fastReflection_Record_Ledger_messageType struct{}
+type fastReflection_Record_Local_messageType struct{}
 
-func (x fastReflection_Record_Ledger_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Record_Ledger)(nil)
+func (x fastReflection_Record_Local_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Record_Local)(nil)
 }
-func (x fastReflection_Record_Ledger_messageType) New() protoreflect.Message {
-	return new(fastReflection_Record_Ledger)
+func (x fastReflection_Record_Local_messageType) New() protoreflect.Message {
+	return new(fastReflection_Record_Local)
 }
-func (x fastReflection_Record_Ledger_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Record_Ledger
+func (x fastReflection_Record_Local_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_Local
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Record_Ledger) Descriptor() protoreflect.MessageDescriptor {
-	return md_Record_Ledger
+func (x *fastReflection_Record_Local) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_Local
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Record_Ledger) Type() protoreflect.MessageType {
-	return _fastReflection_Record_Ledger_messageType
+func (x *fastReflection_Record_Local) Type() protoreflect.MessageType {
+	return _fastReflection_Record_Local_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Record_Ledger) New() protoreflect.Message {
-	return new(fastReflection_Record_Ledger)
+func (x *fastReflection_Record_Local) New() protoreflect.Message {
+	return new(fastReflection_Record_Local)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Record_Ledger) Interface() protoreflect.ProtoMessage {
-	return (*Record_Ledger)(x)
+func (x *fastReflection_Record_Local) Interface() protoreflect.ProtoMessage {
+	return (*Record_Local)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1454,10 +1936,16 @@ func (x *fastReflection_Record_Ledger) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Record_Ledger) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Path != nil {
-		value := protoreflect.ValueOfMessage(x.Path.ProtoReflect())
-		if !f(fd_Record_Ledger_path, value) {
+func (x *fastReflection_Record_Local) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PrivKey != nil {
+		value := protoreflect.ValueOfMessage(x.PrivKey.ProtoReflect())
+		if !f(fd_Record_Local_priv_key, value) {
+			return
+		}
+	}
+	if x.HdPath != "" {
+		value := protoreflect.ValueOfString(x.HdPath)
+		if !f(fd_Record_Local_hd_path, value) {
 			return
 		}
 	}
@@ -1474,15 +1962,17 @@ func (x *fastReflection_Record_Ledger) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Record_Ledger) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Record_Local) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
-		return x.Path != nil
+	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
+		return x.PrivKey != nil
+	case "cosmos.crypto.keyring.v1.Record.Local.hd_path":
+		return x.HdPath != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1492,15 +1982,17 @@ func (x *fastReflection_Record_Ledger) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Ledger) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Record_Local) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
-		x.Path = nil
+	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
+		x.PrivKey = nil
+	case "cosmos.crypto.keyring.v1.Record.Local.hd_path":
+		x.HdPath = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1510,16 +2002,19 @@ func (x *fastReflection_Record_Ledger) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Record_Ledger) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_Local) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
-		value := x.Path
+	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
+		value := x.PrivKey
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.Local.hd_path":
+		value := x.HdPath
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1533,15 +2028,917 @@ func (x *fastReflection_Record_Ledger) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Ledger) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Record_Local) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
-		x.Path = value.Message().Interface().(*v1.BIP44Params)
+	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
+		x.PrivKey = value.Message().Interface().(*anypb.Any)
+	case "cosmos.crypto.keyring.v1.Record.Local.hd_path":
+		x.HdPath = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_Local) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
+		if x.PrivKey == nil {
+			x.PrivKey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.PrivKey.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.Local.hd_path":
+		panic(fmt.Errorf("field hd_path of message cosmos.crypto.keyring.v1.Record.Local is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Record_Local) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Local.priv_key":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.Record.Local.hd_path":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Local"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Local does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Record_Local) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.Local", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Record_Local) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_Local) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Record_Local) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Record_Local) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Record_Local)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PrivKey != nil {
+			l = options.Size(x.PrivKey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HdPath)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Record_Local)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.HdPath) > 0 {
+			i -= len(x.HdPath)
+			copy(dAtA[i:], x.HdPath)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HdPath)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PrivKey != nil {
+			encoded, err := options.Marshal(x.PrivKey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Record_Local)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Local: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Local: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrivKey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PrivKey == nil {
+					x.PrivKey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrivKey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HdPath", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HdPath = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Record_Ledger      protoreflect.MessageDescriptor
+	fd_Record_Ledger_path protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_keyring_v1_record_proto_init()
+	md_Record_Ledger = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Ledger")
+	fd_Record_Ledger_path = md_Record_Ledger.Fields().ByName("path")
+}
+
+var _ protoreflect.Message = (*fastReflection_Record_Ledger)(nil)
+
+type fastReflection_Record_Ledger Record_Ledger
+
+func (x *Record_Ledger) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Record_Ledger)(x)
+}
+
+func (x *Record_Ledger) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Record_Ledger_messageType fastReflection_Record_Ledger_messageType
+var _ protoreflect.MessageType = fastReflection_Record_Ledger_messageType{}
+
+type fastReflection_Record_Ledger_messageType struct{}
+
+func (x fastReflection_Record_Ledger_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Record_Ledger)(nil)
+}
+func (x fastReflection_Record_Ledger_messageType) New() protoreflect.Message {
+	return new(fastReflection_Record_Ledger)
+}
+func (x fastReflection_Record_Ledger_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_Ledger
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Record_Ledger) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_Ledger
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Record_Ledger) Type() protoreflect.MessageType {
+	return _fastReflection_Record_Ledger_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Record_Ledger) New() protoreflect.Message {
+	return new(fastReflection_Record_Ledger)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Record_Ledger) Interface() protoreflect.ProtoMessage {
+	return (*Record_Ledger)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Record_Ledger) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Path != nil {
+		value := protoreflect.ValueOfMessage(x.Path.ProtoReflect())
+		if !f(fd_Record_Ledger_path, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Record_Ledger) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
+		return x.Path != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_Ledger) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
+		x.Path = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Record_Ledger) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
+		value := x.Path
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_Ledger) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
+		x.Path = value.Message().Interface().(*v1.BIP44Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_Ledger) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
+		if x.Path == nil {
+			x.Path = new(v1.BIP44Params)
+		}
+		return protoreflect.ValueOfMessage(x.Path.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Record_Ledger) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
+		m := new(v1.BIP44Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Record_Ledger) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.Ledger", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Record_Ledger) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_Ledger) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Record_Ledger) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Record_Ledger) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Record_Ledger)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Path != nil {
+			l = options.Size(x.Path)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Record_Ledger)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Path != nil {
+			encoded, err := options.Marshal(x.Path)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Record_Ledger)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Ledger: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Ledger: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Path == nil {
+					x.Path = &v1.BIP44Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Path); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Record_Multi protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_keyring_v1_record_proto_init()
+	md_Record_Multi = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Multi")
+}
+
+var _ protoreflect.Message = (*fastReflection_Record_Multi)(nil)
+
+type fastReflection_Record_Multi Record_Multi
+
+func (x *Record_Multi) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Record_Multi)(x)
+}
+
+func (x *Record_Multi) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Record_Multi_messageType fastReflection_Record_Multi_messageType
+var _ protoreflect.MessageType = fastReflection_Record_Multi_messageType{}
+
+type fastReflection_Record_Multi_messageType struct{}
+
+func (x fastReflection_Record_Multi_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Record_Multi)(nil)
+}
+func (x fastReflection_Record_Multi_messageType) New() protoreflect.Message {
+	return new(fastReflection_Record_Multi)
+}
+func (x fastReflection_Record_Multi_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_Multi
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Record_Multi) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_Multi
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Record_Multi) Type() protoreflect.MessageType {
+	return _fastReflection_Record_Multi_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Record_Multi) New() protoreflect.Message {
+	return new(fastReflection_Record_Multi)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Record_Multi) Interface() protoreflect.ProtoMessage {
+	return (*Record_Multi)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Record_Multi) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Record_Multi) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_Multi) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Record_Multi) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record_Multi) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1555,44 +2952,36 @@ func (x *fastReflection_Record_Ledger) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Ledger) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_Multi) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
-		if x.Path == nil {
-			x.Path = new(v1.BIP44Params)
-		}
-		return protoreflect.ValueOfMessage(x.Path.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Record_Ledger) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_Multi) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.crypto.keyring.v1.Record.Ledger.path":
-		m := new(v1.BIP44Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Ledger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Ledger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Record_Ledger) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Record_Multi) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.Ledger", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.Multi", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1600,7 +2989,7 @@ func (x *fastReflection_Record_Ledger) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Record_Ledger) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Record_Multi) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1611,7 +3000,7 @@ func (x *fastReflection_Record_Ledger) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Ledger) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Record_Multi) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1623,7 +3012,7 @@ func (x *fastReflection_Record_Ledger) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Record_Ledger) IsValid() bool {
+func (x *fastReflection_Record_Multi) IsValid() bool {
 	return x != nil
 }
 
@@ -1633,9 +3022,9 @@ func (x *fastReflection_Record_Ledger) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Record_Ledger) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Record_Multi) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Record_Ledger)
+		x := input.Message.Interface().(*Record_Multi)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1647,10 +3036,6 @@ func (x *fastReflection_Record_Ledger) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Path != nil {
-			l = options.Size(x.Path)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1661,7 +3046,7 @@ func (x *fastReflection_Record_Ledger) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Record_Ledger)
+		x := input.Message.Interface().(*Record_Multi)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1680,20 +3065,6 @@ func (x *fastReflection_Record_Ledger) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Path != nil {
-			encoded, err := options.Marshal(x.Path)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1705,7 +3076,7 @@ func (x *fastReflection_Record_Ledger) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Record_Ledger)
+		x := input.Message.Interface().(*Record_Multi)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1737,48 +3108,12 @@ func (x *fastReflection_Record_Ledger) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Ledger: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Multi: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Ledger: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Multi: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Path == nil {
-					x.Path = &v1.BIP44Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Path); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1815,24 +3150,26 @@ func (x *fastReflection_Record_Ledger) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Record_Multi protoreflect.MessageDescriptor
+	md_Record_Offline         protoreflect.MessageDescriptor
+	fd_Record_Offline_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_crypto_keyring_v1_record_proto_init()
-	md_Record_Multi = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Multi")
+	md_Record_Offline = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Offline")
+	fd_Record_Offline_address = md_Record_Offline.Fields().ByName("address")
 }
 
-var _ protoreflect.Message = (*fastReflection_Record_Multi)(nil)
+var _ protoreflect.Message = (*fastReflection_Record_Offline)(nil)
 
-type fastReflection_Record_Multi Record_Multi
+type fastReflection_Record_Offline Record_Offline
 
-func (x *Record_Multi) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Record_Multi)(x)
+func (x *Record_Offline) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Record_Offline)(x)
 }
 
-func (x *Record_Multi) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3]
+func (x *Record_Offline) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,43 +3180,43 @@ func (x *Record_Multi) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Record_Multi_messageType fastReflection_Record_Multi_messageType
-var _ protoreflect.MessageType = fastReflection_Record_Multi_messageType{}
+var _fastReflection_Record_Offline_messageType fastReflection_Record_Offline_messageType
+var _ protoreflect.MessageType = fastReflection_Record_Offline_messageType{}
 
-type fastReflection_Record_Multi_messageType struct{}
+type fastReflection_Record_Offline_messageType struct{}
 
-func (x fastReflection_Record_Multi_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Record_Multi)(nil)
+func (x fastReflection_Record_Offline_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Record_Offline)(nil)
 }
-func (x fastReflection_Record_Multi_messageType) New() protoreflect.Message {
-	return new(fastReflection_Record_Multi)
+func (x fastReflection_Record_Offline_messageType) New() protoreflect.Message {
+	return new(fastReflection_Record_Offline)
 }
-func (x fastReflection_Record_Multi_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Record_Multi
+func (x fastReflection_Record_Offline_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_Offline
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Record_Multi) Descriptor() protoreflect.MessageDescriptor {
-	return md_Record_Multi
+func (x *fastReflection_Record_Offline) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_Offline
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Record_Multi) Type() protoreflect.MessageType {
-	return _fastReflection_Record_Multi_messageType
+func (x *fastReflection_Record_Offline) Type() protoreflect.MessageType {
+	return _fastReflection_Record_Offline_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Record_Multi) New() protoreflect.Message {
-	return new(fastReflection_Record_Multi)
+func (x *fastReflection_Record_Offline) New() protoreflect.Message {
+	return new(fastReflection_Record_Offline)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Record_Multi) Interface() protoreflect.ProtoMessage {
-	return (*Record_Multi)(x)
+func (x *fastReflection_Record_Offline) Interface() protoreflect.ProtoMessage {
+	return (*Record_Offline)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1887,7 +3224,13 @@ func (x *fastReflection_Record_Multi) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Record_Multi) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Record_Offline) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Address) != 0 {
+		value := protoreflect.ValueOfBytes(x.Address)
+		if !f(fd_Record_Offline_address, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1901,13 +3244,15 @@ func (x *fastReflection_Record_Multi) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Record_Multi) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Record_Offline) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.address":
+		return len(x.Address) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1917,13 +3262,15 @@ func (x *fastReflection_Record_Multi) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Multi) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Record_Offline) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.address":
+		x.Address = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1933,13 +3280,16 @@ func (x *fastReflection_Record_Multi) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Record_Multi) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_Offline) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.address":
+		value := x.Address
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1953,13 +3303,15 @@ func (x *fastReflection_Record_Multi) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Multi) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Record_Offline) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.address":
+		x.Address = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1973,36 +3325,40 @@ func (x *fastReflection_Record_Multi) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Multi) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_Offline) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.address":
+		panic(fmt.Errorf("field address of message cosmos.crypto.keyring.v1.Record.Offline is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Record_Multi) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_Offline) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.Offline.address":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Multi"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Multi does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Record_Multi) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Record_Offline) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.Multi", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.Offline", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2010,7 +3366,7 @@ func (x *fastReflection_Record_Multi) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Record_Multi) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Record_Offline) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2021,7 +3377,7 @@ func (x *fastReflection_Record_Multi) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Multi) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Record_Offline) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2033,7 +3389,7 @@ func (x *fastReflection_Record_Multi) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Record_Multi) IsValid() bool {
+func (x *fastReflection_Record_Offline) IsValid() bool {
 	return x != nil
 }
 
@@ -2043,9 +3399,9 @@ func (x *fastReflection_Record_Multi) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Record_Multi) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Record_Multi)
+		x := input.Message.Interface().(*Record_Offline)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2057,6 +3413,10 @@ func (x *fastReflection_Record_Multi) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2067,7 +3427,7 @@ func (x *fastReflection_Record_Multi) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Record_Multi)
+		x := input.Message.Interface().(*Record_Offline)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2086,6 +3446,13 @@ func (x *fastReflection_Record_Multi) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2097,7 +3464,7 @@ func (x *fastReflection_Record_Multi) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Record_Multi)
+		x := input.Message.Interface().(*Record_Offline)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2129,12 +3496,46 @@ func (x *fastReflection_Record_Multi) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Multi: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Offline: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Multi: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Offline: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = append(x.Address[:0], dAtA[iNdEx:postIndex]...)
+				if x.Address == nil {
+					x.Address = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2171,24 +3572,28 @@ func (x *fastReflection_Record_Multi) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Record_Offline protoreflect.MessageDescriptor
+	md_Record_KMS          protoreflect.MessageDescriptor
+	fd_Record_KMS_provider protoreflect.FieldDescriptor
+	fd_Record_KMS_key_id   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_crypto_keyring_v1_record_proto_init()
-	md_Record_Offline = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("Offline")
+	md_Record_KMS = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("Record").Messages().ByName("KMS")
+	fd_Record_KMS_provider = md_Record_KMS.Fields().ByName("provider")
+	fd_Record_KMS_key_id = md_Record_KMS.Fields().ByName("key_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_Record_Offline)(nil)
+var _ protoreflect.Message = (*fastReflection_Record_KMS)(nil)
 
-type fastReflection_Record_Offline Record_Offline
+type fastReflection_Record_KMS Record_KMS
 
-func (x *Record_Offline) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Record_Offline)(x)
+func (x *Record_KMS) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Record_KMS)(x)
 }
 
-func (x *Record_Offline) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4]
+func (x *Record_KMS) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2199,43 +3604,43 @@ func (x *Record_Offline) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Record_Offline_messageType fastReflection_Record_Offline_messageType
-var _ protoreflect.MessageType = fastReflection_Record_Offline_messageType{}
+var _fastReflection_Record_KMS_messageType fastReflection_Record_KMS_messageType
+var _ protoreflect.MessageType = fastReflection_Record_KMS_messageType{}
 
-type fastReflection_Record_Offline_messageType struct{}
+type fastReflection_Record_KMS_messageType struct{}
 
-func (x fastReflection_Record_Offline_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Record_Offline)(nil)
+func (x fastReflection_Record_KMS_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Record_KMS)(nil)
 }
-func (x fastReflection_Record_Offline_messageType) New() protoreflect.Message {
-	return new(fastReflection_Record_Offline)
+func (x fastReflection_Record_KMS_messageType) New() protoreflect.Message {
+	return new(fastReflection_Record_KMS)
 }
-func (x fastReflection_Record_Offline_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Record_Offline
+func (x fastReflection_Record_KMS_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_KMS
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Record_Offline) Descriptor() protoreflect.MessageDescriptor {
-	return md_Record_Offline
+func (x *fastReflection_Record_KMS) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record_KMS
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Record_Offline) Type() protoreflect.MessageType {
-	return _fastReflection_Record_Offline_messageType
+func (x *fastReflection_Record_KMS) Type() protoreflect.MessageType {
+	return _fastReflection_Record_KMS_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Record_Offline) New() protoreflect.Message {
-	return new(fastReflection_Record_Offline)
+func (x *fastReflection_Record_KMS) New() protoreflect.Message {
+	return new(fastReflection_Record_KMS)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Record_Offline) Interface() protoreflect.ProtoMessage {
-	return (*Record_Offline)(x)
+func (x *fastReflection_Record_KMS) Interface() protoreflect.ProtoMessage {
+	return (*Record_KMS)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2243,7 +3648,19 @@ func (x *fastReflection_Record_Offline) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Record_Offline) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Record_KMS) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_Record_KMS_provider, value) {
+			return
+		}
+	}
+	if x.KeyId != "" {
+		value := protoreflect.ValueOfString(x.KeyId)
+		if !f(fd_Record_KMS_key_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2257,13 +3674,17 @@ func (x *fastReflection_Record_Offline) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Record_Offline) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Record_KMS) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KMS.provider":
+		return x.Provider != ""
+	case "cosmos.crypto.keyring.v1.Record.KMS.key_id":
+		return x.KeyId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KMS"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KMS does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2273,13 +3694,17 @@ func (x *fastReflection_Record_Offline) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Offline) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Record_KMS) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KMS.provider":
+		x.Provider = ""
+	case "cosmos.crypto.keyring.v1.Record.KMS.key_id":
+		x.KeyId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KMS"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KMS does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2289,13 +3714,19 @@ func (x *fastReflection_Record_Offline) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Record_Offline) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_KMS) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KMS.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "cosmos.crypto.keyring.v1.Record.KMS.key_id":
+		value := x.KeyId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KMS"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KMS does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2309,13 +3740,17 @@ func (x *fastReflection_Record_Offline) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Offline) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Record_KMS) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KMS.provider":
+		x.Provider = value.Interface().(string)
+	case "cosmos.crypto.keyring.v1.Record.KMS.key_id":
+		x.KeyId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KMS"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KMS does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2329,36 +3764,44 @@ func (x *fastReflection_Record_Offline) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Offline) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_KMS) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KMS.provider":
+		panic(fmt.Errorf("field provider of message cosmos.crypto.keyring.v1.Record.KMS is not mutable"))
+	case "cosmos.crypto.keyring.v1.Record.KMS.key_id":
+		panic(fmt.Errorf("field key_id of message cosmos.crypto.keyring.v1.Record.KMS is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KMS"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KMS does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Record_Offline) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Record_KMS) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.Record.KMS.provider":
+		return protoreflect.ValueOfString("")
+	case "cosmos.crypto.keyring.v1.Record.KMS.key_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.Offline"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.Record.KMS"))
 		}
-		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.Offline does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.Record.KMS does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Record_Offline) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Record_KMS) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.Offline", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.Record.KMS", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2366,7 +3809,7 @@ func (x *fastReflection_Record_Offline) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Record_Offline) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Record_KMS) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2377,7 +3820,7 @@ func (x *fastReflection_Record_Offline) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Record_Offline) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Record_KMS) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2389,7 +3832,7 @@ func (x *fastReflection_Record_Offline) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Record_Offline) IsValid() bool {
+func (x *fastReflection_Record_KMS) IsValid() bool {
 	return x != nil
 }
 
@@ -2399,9 +3842,9 @@ func (x *fastReflection_Record_Offline) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Record_KMS) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Record_Offline)
+		x := input.Message.Interface().(*Record_KMS)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2413,6 +3856,14 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KeyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2423,7 +3874,7 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Record_Offline)
+		x := input.Message.Interface().(*Record_KMS)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2442,6 +3893,20 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.KeyId) > 0 {
+			i -= len(x.KeyId)
+			copy(dAtA[i:], x.KeyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KeyId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2453,7 +3918,7 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Record_Offline)
+		x := input.Message.Interface().(*Record_KMS)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2485,12 +3950,76 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Offline: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_KMS: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_Offline: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record_KMS: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2554,12 +4083,18 @@ type Record struct {
 	// Record contains one of the following items
 	//
 	// Types that are assignable to Item:
-	//
 	//	*Record_Local_
 	//	*Record_Ledger_
 	//	*Record_Multi_
 	//	*Record_Offline_
+	//	*Record_Kms
 	Item isRecord_Item `protobuf_oneof:"item"`
+	// key_history holds the public keys the record had before its key was rotated, from the oldest to the most
+	// recent. The current key of the record is pub_key.
+	KeyHistory []*Record_RotatedKey `protobuf:"bytes,8,rep,name=key_history,json=keyHistory,proto3" json:"key_history,omitempty"`
+	// activated_at is the time from which pub_key is the key of the record. It is unset if the key of the record
+	// was never rotated.
+	ActivatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
 }
 
 func (x *Record) Reset() {
@@ -2631,6 +4166,27 @@ func (x *Record) GetOffline() *Record_Offline {
 	return nil
 }
 
+func (x *Record) GetKms() *Record_KMS {
+	if x, ok := x.GetItem().(*Record_Kms); ok {
+		return x.Kms
+	}
+	return nil
+}
+
+func (x *Record) GetKeyHistory() []*Record_RotatedKey {
+	if x != nil {
+		return x.KeyHistory
+	}
+	return nil
+}
+
+func (x *Record) GetActivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return nil
+}
+
 type isRecord_Item interface {
 	isRecord_Item()
 }
@@ -2651,10 +4207,15 @@ type Record_Multi_ struct {
 }
 
 type Record_Offline_ struct {
-	// Offline does not store any other information.
+	// Offline stores the address of watch-only records which have no public key.
 	Offline *Record_Offline `protobuf:"bytes,6,opt,name=offline,proto3,oneof"`
 }
 
+type Record_Kms struct {
+	// kms stores the information about a key held by a key management service.
+	Kms *Record_KMS `protobuf:"bytes,7,opt,name=kms,proto3,oneof"`
+}
+
 func (*Record_Local_) isRecord_Item() {}
 
 func (*Record_Ledger_) isRecord_Item() {}
@@ -2663,6 +4224,64 @@ func (*Record_Multi_) isRecord_Item() {}
 
 func (*Record_Offline_) isRecord_Item() {}
 
+func (*Record_Kms) isRecord_Item() {}
+
+// RotatedKey is a public key a record had before its key was rotated.
+type Record_RotatedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pub_key is the rotated public key.
+	PubKey *anypb.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// activated_at is the time from which pub_key was the key of the record. It is unset for the original key
+	// of the record.
+	ActivatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	// rotated_at is the time at which pub_key was replaced by the next key of the record.
+	RotatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+}
+
+func (x *Record_RotatedKey) Reset() {
+	*x = Record_RotatedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record_RotatedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record_RotatedKey) ProtoMessage() {}
+
+// Deprecated: Use Record_RotatedKey.ProtoReflect.Descriptor instead.
+func (*Record_RotatedKey) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Record_RotatedKey) GetPubKey() *anypb.Any {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *Record_RotatedKey) GetActivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return nil
+}
+
+func (x *Record_RotatedKey) GetRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return nil
+}
+
 // Item is a keyring item stored in a keyring backend.
 // Local item
 type Record_Local struct {
@@ -2671,12 +4290,15 @@ type Record_Local struct {
 	unknownFields protoimpl.UnknownFields
 
 	PrivKey *anypb.Any `protobuf:"bytes,1,opt,name=priv_key,json=privKey,proto3" json:"priv_key,omitempty"`
+	// hd_path is the HD path the private key was derived along from its mnemonic, e.g. m/44'/60'/0'/0/0 for
+	// keys of other ecosystems. It is unset for imported private keys.
+	HdPath string `protobuf:"bytes,2,opt,name=hd_path,json=hdPath,proto3" json:"hd_path,omitempty"`
 }
 
 func (x *Record_Local) Reset() {
 	*x = Record_Local{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[1]
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2690,7 +4312,7 @@ func (*Record_Local) ProtoMessage() {}
 
 // Deprecated: Use Record_Local.ProtoReflect.Descriptor instead.
 func (*Record_Local) Descriptor() ([]byte, []int) {
-	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 0}
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 1}
 }
 
 func (x *Record_Local) GetPrivKey() *anypb.Any {
@@ -2700,6 +4322,13 @@ func (x *Record_Local) GetPrivKey() *anypb.Any {
 	return nil
 }
 
+func (x *Record_Local) GetHdPath() string {
+	if x != nil {
+		return x.HdPath
+	}
+	return ""
+}
+
 // Ledger item
 type Record_Ledger struct {
 	state         protoimpl.MessageState
@@ -2712,7 +4341,7 @@ type Record_Ledger struct {
 func (x *Record_Ledger) Reset() {
 	*x = Record_Ledger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[2]
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2726,7 +4355,7 @@ func (*Record_Ledger) ProtoMessage() {}
 
 // Deprecated: Use Record_Ledger.ProtoReflect.Descriptor instead.
 func (*Record_Ledger) Descriptor() ([]byte, []int) {
-	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 1}
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 2}
 }
 
 func (x *Record_Ledger) GetPath() *v1.BIP44Params {
@@ -2746,7 +4375,7 @@ type Record_Multi struct {
 func (x *Record_Multi) Reset() {
 	*x = Record_Multi{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3]
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2760,7 +4389,7 @@ func (*Record_Multi) ProtoMessage() {}
 
 // Deprecated: Use Record_Multi.ProtoReflect.Descriptor instead.
 func (*Record_Multi) Descriptor() ([]byte, []int) {
-	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 2}
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 3}
 }
 
 // Offline item
@@ -2768,12 +4397,15 @@ type Record_Offline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// address is the address of a watch-only record created from a bare address, whose pub_key is unset.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
 func (x *Record_Offline) Reset() {
 	*x = Record_Offline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4]
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2787,7 +4419,60 @@ func (*Record_Offline) ProtoMessage() {}
 
 // Deprecated: Use Record_Offline.ProtoReflect.Descriptor instead.
 func (*Record_Offline) Descriptor() ([]byte, []int) {
-	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 3}
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *Record_Offline) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// KMS item
+type Record_KMS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// provider is the name of the key management service holding the key.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// key_id is the identifier of the key in the key management service.
+	KeyId string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (x *Record_KMS) Reset() {
+	*x = Record_KMS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record_KMS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record_KMS) ProtoMessage() {}
+
+// Deprecated: Use Record_KMS.ProtoReflect.Descriptor instead.
+func (*Record_KMS) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *Record_KMS) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Record_KMS) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
 }
 
 var File_cosmos_crypto_keyring_v1_record_proto protoreflect.FileDescriptor
@@ -2800,55 +4485,89 @@ var file_cosmos_crypto_keyring_v1_record_proto_rawDesc = []byte{
 	0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x6f, 0x2f, 0x68, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xea, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x2d, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x3e,
-	0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65,
-	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e,
-	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x41,
-	0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x2f, 0x68, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xec, 0x07, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2d, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12,
+	0x3e, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b,
 	0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x72, 0x12, 0x3e, 0x0a, 0x05, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x12, 0x44, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x07,
-	0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x1a, 0x38, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x76, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b, 0x65,
-	0x79, 0x1a, 0x3e, 0x0a, 0x06, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x68, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x49, 0x50, 0x34, 0x34, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x1a, 0x07, 0x0a, 0x05, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x1a, 0x09, 0x0a, 0x07, 0x4f, 0x66,
-	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x42, 0xeb, 0x01,
-	0xc8, 0xe1, 0x1e, 0x00, 0x98, 0xe3, 0x1e, 0x00, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
-	0x3b, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x4b,
-	0xaa, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x2e, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
-	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x3a, 0x3a,
-	0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12,
+	0x41, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e,
+	0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x12, 0x3e, 0x0a, 0x05, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x12, 0x44, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x48, 0x00, 0x52,
+	0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x03, 0x6b, 0x6d, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4b, 0x4d, 0x53, 0x48, 0x00, 0x52, 0x03, 0x6b,
+	0x6d, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x43, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0xc5, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x51, 0x0a,
+	0x05, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x76, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x64, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x1a, 0x3e, 0x0a, 0x06, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x68, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x49, 0x50, 0x34, 0x34, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x1a, 0x07, 0x0a, 0x05, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x1a, 0x23, 0x0a, 0x07, 0x4f, 0x66, 0x66,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x38,
+	0x0a, 0x03, 0x4b, 0x4d, 0x53, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x42, 0xeb, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x98, 0xe3, 0x1e, 0x00, 0x0a, 0x1c, 0x63, 0x6f, 0x6d,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b,
+	0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x43, 0x43, 0x4b, 0xaa, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b,
+	0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2863,29 +4582,38 @@ func file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP() []byte {
 	return file_cosmos_crypto_keyring_v1_record_proto_rawDescData
 }
 
-var file_cosmos_crypto_keyring_v1_record_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cosmos_crypto_keyring_v1_record_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_cosmos_crypto_keyring_v1_record_proto_goTypes = []interface{}{
-	(*Record)(nil),         // 0: cosmos.crypto.keyring.v1.Record
-	(*Record_Local)(nil),   // 1: cosmos.crypto.keyring.v1.Record.Local
-	(*Record_Ledger)(nil),  // 2: cosmos.crypto.keyring.v1.Record.Ledger
-	(*Record_Multi)(nil),   // 3: cosmos.crypto.keyring.v1.Record.Multi
-	(*Record_Offline)(nil), // 4: cosmos.crypto.keyring.v1.Record.Offline
-	(*anypb.Any)(nil),      // 5: google.protobuf.Any
-	(*v1.BIP44Params)(nil), // 6: cosmos.crypto.hd.v1.BIP44Params
+	(*Record)(nil),                // 0: cosmos.crypto.keyring.v1.Record
+	(*Record_RotatedKey)(nil),     // 1: cosmos.crypto.keyring.v1.Record.RotatedKey
+	(*Record_Local)(nil),          // 2: cosmos.crypto.keyring.v1.Record.Local
+	(*Record_Ledger)(nil),         // 3: cosmos.crypto.keyring.v1.Record.Ledger
+	(*Record_Multi)(nil),          // 4: cosmos.crypto.keyring.v1.Record.Multi
+	(*Record_Offline)(nil),        // 5: cosmos.crypto.keyring.v1.Record.Offline
+	(*Record_KMS)(nil),            // 6: cosmos.crypto.keyring.v1.Record.KMS
+	(*anypb.Any)(nil),             // 7: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*v1.BIP44Params)(nil),        // 9: cosmos.crypto.hd.v1.BIP44Params
 }
 var file_cosmos_crypto_keyring_v1_record_proto_depIdxs = []int32{
-	5, // 0: cosmos.crypto.keyring.v1.Record.pub_key:type_name -> google.protobuf.Any
-	1, // 1: cosmos.crypto.keyring.v1.Record.local:type_name -> cosmos.crypto.keyring.v1.Record.Local
-	2, // 2: cosmos.crypto.keyring.v1.Record.ledger:type_name -> cosmos.crypto.keyring.v1.Record.Ledger
-	3, // 3: cosmos.crypto.keyring.v1.Record.multi:type_name -> cosmos.crypto.keyring.v1.Record.Multi
-	4, // 4: cosmos.crypto.keyring.v1.Record.offline:type_name -> cosmos.crypto.keyring.v1.Record.Offline
-	5, // 5: cosmos.crypto.keyring.v1.Record.Local.priv_key:type_name -> google.protobuf.Any
-	6, // 6: cosmos.crypto.keyring.v1.Record.Ledger.path:type_name -> cosmos.crypto.hd.v1.BIP44Params
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7,  // 0: cosmos.crypto.keyring.v1.Record.pub_key:type_name -> google.protobuf.Any
+	2,  // 1: cosmos.crypto.keyring.v1.Record.local:type_name -> cosmos.crypto.keyring.v1.Record.Local
+	3,  // 2: cosmos.crypto.keyring.v1.Record.ledger:type_name -> cosmos.crypto.keyring.v1.Record.Ledger
+	4,  // 3: cosmos.crypto.keyring.v1.Record.multi:type_name -> cosmos.crypto.keyring.v1.Record.Multi
+	5,  // 4: cosmos.crypto.keyring.v1.Record.offline:type_name -> cosmos.crypto.keyring.v1.Record.Offline
+	6,  // 5: cosmos.crypto.keyring.v1.Record.kms:type_name -> cosmos.crypto.keyring.v1.Record.KMS
+	1,  // 6: cosmos.crypto.keyring.v1.Record.key_history:type_name -> cosmos.crypto.keyring.v1.Record.RotatedKey
+	8,  // 7: cosmos.crypto.keyring.v1.Record.activated_at:type_name -> google.protobuf.Timestamp
+	7,  // 8: cosmos.crypto.keyring.v1.Record.RotatedKey.pub_key:type_name -> google.protobuf.Any
+	8,  // 9: cosmos.crypto.keyring.v1.Record.RotatedKey.activated_at:type_name -> google.protobuf.Timestamp
+	8,  // 10: cosmos.crypto.keyring.v1.Record.RotatedKey.rotated_at:type_name -> google.protobuf.Timestamp
+	7,  // 11: cosmos.crypto.keyring.v1.Record.Local.priv_key:type_name -> google.protobuf.Any
+	9,  // 12: cosmos.crypto.keyring.v1.Record.Ledger.path:type_name -> cosmos.crypto.hd.v1.BIP44Params
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_crypto_keyring_v1_record_proto_init() }
@@ -2907,7 +4635,7 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			}
 		}
 		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Record_Local); i {
+			switch v := v.(*Record_RotatedKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2919,7 +4647,7 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			}
 		}
 		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Record_Ledger); i {
+			switch v := v.(*Record_Local); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2931,7 +4659,7 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			}
 		}
 		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Record_Multi); i {
+			switch v := v.(*Record_Ledger); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2943,6 +4671,18 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			}
 		}
 		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record_Multi); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Record_Offline); i {
 			case 0:
 				return &v.state
@@ -2954,12 +4694,25 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record_KMS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_cosmos_crypto_keyring_v1_record_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Record_Local_)(nil),
 		(*Record_Ledger_)(nil),
 		(*Record_Multi_)(nil),
 		(*Record_Offline_)(nil),
+		(*Record_Kms)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2967,7 +4720,7 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_crypto_keyring_v1_record_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,6 +24,11 @@ const (
 	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
 	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
 	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_Params_FullMethodName              = "/cosmos.feegrant.v1beta1.Query/Params"
+	Query_GrantUsage_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/GrantUsage"
+	Query_GroupAllowance_FullMethodName      = "/cosmos.feegrant.v1beta1.Query/GroupAllowance"
+	Query_ExpiringAllowances_FullMethodName  = "/cosmos.feegrant.v1beta1.Query/ExpiringAllowances"
+	Query_SimulateFeeGrant_FullMethodName    = "/cosmos.feegrant.v1beta1.Query/SimulateFeeGrant"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +43,19 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// Params queries the parameters of the x/feegrant module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GrantUsage returns the usage statistics of the allowance granted to the grantee by the granter.
+	GrantUsage(ctx context.Context, in *QueryGrantUsageRequest, opts ...grpc.CallOption) (*QueryGrantUsageResponse, error)
+	// GroupAllowance returns a group allowance of the granter.
+	GroupAllowance(ctx context.Context, in *QueryGroupAllowanceRequest, opts ...grpc.CallOption) (*QueryGroupAllowanceResponse, error)
+	// ExpiringAllowances returns the grants which expire within the given duration from the
+	// current block time, ordered by expiration.
+	ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error)
+	// SimulateFeeGrant runs the Accept logic of the allowance granted to the grantee by the
+	// granter against the given fee and messages, without updating the allowance, and reports
+	// if the allowance would cover the fee of such a transaction.
+	SimulateFeeGrant(ctx context.Context, in *QuerySimulateFeeGrantRequest, opts ...grpc.CallOption) (*QuerySimulateFeeGrantResponse, error)
 }
 
 type queryClient struct {
@@ -78,6 +96,56 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GrantUsage(ctx context.Context, in *QueryGrantUsageRequest, opts ...grpc.CallOption) (*QueryGrantUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGrantUsageResponse)
+	err := c.cc.Invoke(ctx, Query_GrantUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupAllowance(ctx context.Context, in *QueryGroupAllowanceRequest, opts ...grpc.CallOption) (*QueryGroupAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_GroupAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryExpiringAllowancesResponse)
+	err := c.cc.Invoke(ctx, Query_ExpiringAllowances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateFeeGrant(ctx context.Context, in *QuerySimulateFeeGrantRequest, opts ...grpc.CallOption) (*QuerySimulateFeeGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySimulateFeeGrantResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateFeeGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -90,6 +158,19 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// Params queries the parameters of the x/feegrant module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GrantUsage returns the usage statistics of the allowance granted to the grantee by the granter.
+	GrantUsage(context.Context, *QueryGrantUsageRequest) (*QueryGrantUsageResponse, error)
+	// GroupAllowance returns a group allowance of the granter.
+	GroupAllowance(context.Context, *QueryGroupAllowanceRequest) (*QueryGroupAllowanceResponse, error)
+	// ExpiringAllowances returns the grants which expire within the given duration from the
+	// current block time, ordered by expiration.
+	ExpiringAllowances(context.Context, *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error)
+	// SimulateFeeGrant runs the Accept logic of the allowance granted to the grantee by the
+	// granter against the given fee and messages, without updating the allowance, and reports
+	// if the allowance would cover the fee of such a transaction.
+	SimulateFeeGrant(context.Context, *QuerySimulateFeeGrantRequest) (*QuerySimulateFeeGrantResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -109,6 +190,21 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) GrantUsage(context.Context, *QueryGrantUsageRequest) (*QueryGrantUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantUsage not implemented")
+}
+func (UnimplementedQueryServer) GroupAllowance(context.Context, *QueryGroupAllowanceRequest) (*QueryGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAllowance not implemented")
+}
+func (UnimplementedQueryServer) ExpiringAllowances(context.Context, *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringAllowances not implemented")
+}
+func (UnimplementedQueryServer) SimulateFeeGrant(context.Context, *QuerySimulateFeeGrantRequest) (*QuerySimulateFeeGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateFeeGrant not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -184,6 +280,96 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GrantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GrantUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantUsage(ctx, req.(*QueryGrantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GroupAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAllowance(ctx, req.(*QueryGroupAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpiringAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExpiringAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringAllowances(ctx, req.(*QueryExpiringAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateFeeGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateFeeGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateFeeGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateFeeGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateFeeGrant(ctx, req.(*QuerySimulateFeeGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +389,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GrantUsage",
+			Handler:    _Query_GrantUsage_Handler,
+		},
+		{
+			MethodName: "GroupAllowance",
+			Handler:    _Query_GroupAllowance_Handler,
+		},
+		{
+			MethodName: "ExpiringAllowances",
+			Handler:    _Query_ExpiringAllowances_Handler,
+		},
+		{
+			MethodName: "SimulateFeeGrant",
+			Handler:    _Query_SimulateFeeGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
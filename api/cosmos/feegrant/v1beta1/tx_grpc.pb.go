@@ -21,9 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
-	Msg_PruneAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantAllowance_FullMethodName        = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_RevokeAllAllowances_FullMethodName   = "/cosmos.feegrant.v1beta1.Msg/RevokeAllAllowances"
+	Msg_PruneAllowances_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantSubAllowance_FullMethodName     = "/cosmos.feegrant.v1beta1.Msg/GrantSubAllowance"
+	Msg_GrantGroupAllowance_FullMethodName   = "/cosmos.feegrant.v1beta1.Msg/GrantGroupAllowance"
+	Msg_RevokeGroupAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/RevokeGroupAllowance"
+	Msg_GrantModuleAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantModuleAllowance"
+	Msg_RevokeModuleAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeModuleAllowance"
+	Msg_UpdateParams_FullMethodName          = "/cosmos.feegrant.v1beta1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,8 +45,31 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// RevokeAllAllowances revokes all the fee allowances granted by the granter,
+	// including its group allowances. At most 100 allowances are revoked per
+	// message, the response reports if the message must be sent again to revoke
+	// the remaining ones.
+	RevokeAllAllowances(ctx context.Context, in *MsgRevokeAllAllowances, opts ...grpc.CallOption) (*MsgRevokeAllAllowancesResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// GrantSubAllowance grants a sub-allowance of the allowance granted to the
+	// parent grantee to another account. Fees paid with the sub-allowance are paid
+	// by the granter and also deducted from the parent allowance.
+	GrantSubAllowance(ctx context.Context, in *MsgGrantSubAllowance, opts ...grpc.CallOption) (*MsgGrantSubAllowanceResponse, error)
+	// GrantGroupAllowance grants an allowance which is shared by a group of grantees
+	// on the granter's account, with an optional spend limit per member.
+	GrantGroupAllowance(ctx context.Context, in *MsgGrantGroupAllowance, opts ...grpc.CallOption) (*MsgGrantGroupAllowanceResponse, error)
+	// RevokeGroupAllowance revokes a group allowance of the granter's account.
+	RevokeGroupAllowance(ctx context.Context, in *MsgRevokeGroupAllowance, opts ...grpc.CallOption) (*MsgRevokeGroupAllowanceResponse, error)
+	// GrantModuleAllowance defines a governance operation for granting a fee
+	// allowance on the funds of a module account, e.g. the community pool.
+	GrantModuleAllowance(ctx context.Context, in *MsgGrantModuleAllowance, opts ...grpc.CallOption) (*MsgGrantModuleAllowanceResponse, error)
+	// RevokeModuleAllowance defines a governance operation for revoking a fee
+	// allowance granted on the funds of a module account.
+	RevokeModuleAllowance(ctx context.Context, in *MsgRevokeModuleAllowance, opts ...grpc.CallOption) (*MsgRevokeModuleAllowanceResponse, error)
+	// UpdateParams defines a governance operation for updating the x/feegrant module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -70,6 +100,16 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) RevokeAllAllowances(ctx context.Context, in *MsgRevokeAllAllowances, opts ...grpc.CallOption) (*MsgRevokeAllAllowancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeAllAllowancesResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeAllAllowances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgPruneAllowancesResponse)
@@ -80,6 +120,66 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) GrantSubAllowance(ctx context.Context, in *MsgGrantSubAllowance, opts ...grpc.CallOption) (*MsgGrantSubAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantSubAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantSubAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantGroupAllowance(ctx context.Context, in *MsgGrantGroupAllowance, opts ...grpc.CallOption) (*MsgGrantGroupAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantGroupAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeGroupAllowance(ctx context.Context, in *MsgRevokeGroupAllowance, opts ...grpc.CallOption) (*MsgRevokeGroupAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeGroupAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantModuleAllowance(ctx context.Context, in *MsgGrantModuleAllowance, opts ...grpc.CallOption) (*MsgGrantModuleAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantModuleAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantModuleAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeModuleAllowance(ctx context.Context, in *MsgRevokeModuleAllowance, opts ...grpc.CallOption) (*MsgRevokeModuleAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeModuleAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeModuleAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -92,8 +192,31 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// RevokeAllAllowances revokes all the fee allowances granted by the granter,
+	// including its group allowances. At most 100 allowances are revoked per
+	// message, the response reports if the message must be sent again to revoke
+	// the remaining ones.
+	RevokeAllAllowances(context.Context, *MsgRevokeAllAllowances) (*MsgRevokeAllAllowancesResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// GrantSubAllowance grants a sub-allowance of the allowance granted to the
+	// parent grantee to another account. Fees paid with the sub-allowance are paid
+	// by the granter and also deducted from the parent allowance.
+	GrantSubAllowance(context.Context, *MsgGrantSubAllowance) (*MsgGrantSubAllowanceResponse, error)
+	// GrantGroupAllowance grants an allowance which is shared by a group of grantees
+	// on the granter's account, with an optional spend limit per member.
+	GrantGroupAllowance(context.Context, *MsgGrantGroupAllowance) (*MsgGrantGroupAllowanceResponse, error)
+	// RevokeGroupAllowance revokes a group allowance of the granter's account.
+	RevokeGroupAllowance(context.Context, *MsgRevokeGroupAllowance) (*MsgRevokeGroupAllowanceResponse, error)
+	// GrantModuleAllowance defines a governance operation for granting a fee
+	// allowance on the funds of a module account, e.g. the community pool.
+	GrantModuleAllowance(context.Context, *MsgGrantModuleAllowance) (*MsgGrantModuleAllowanceResponse, error)
+	// RevokeModuleAllowance defines a governance operation for revoking a fee
+	// allowance granted on the funds of a module account.
+	RevokeModuleAllowance(context.Context, *MsgRevokeModuleAllowance) (*MsgRevokeModuleAllowanceResponse, error)
+	// UpdateParams defines a governance operation for updating the x/feegrant module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -110,9 +233,30 @@ func (UnimplementedMsgServer) GrantAllowance(context.Context, *MsgGrantAllowance
 func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (UnimplementedMsgServer) RevokeAllAllowances(context.Context, *MsgRevokeAllAllowances) (*MsgRevokeAllAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllAllowances not implemented")
+}
 func (UnimplementedMsgServer) PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (UnimplementedMsgServer) GrantSubAllowance(context.Context, *MsgGrantSubAllowance) (*MsgGrantSubAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSubAllowance not implemented")
+}
+func (UnimplementedMsgServer) GrantGroupAllowance(context.Context, *MsgGrantGroupAllowance) (*MsgGrantGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantGroupAllowance not implemented")
+}
+func (UnimplementedMsgServer) RevokeGroupAllowance(context.Context, *MsgRevokeGroupAllowance) (*MsgRevokeGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGroupAllowance not implemented")
+}
+func (UnimplementedMsgServer) GrantModuleAllowance(context.Context, *MsgGrantModuleAllowance) (*MsgGrantModuleAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantModuleAllowance not implemented")
+}
+func (UnimplementedMsgServer) RevokeModuleAllowance(context.Context, *MsgRevokeModuleAllowance) (*MsgRevokeModuleAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeModuleAllowance not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -170,6 +314,24 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAllAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAllAllowances)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAllAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeAllAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAllAllowances(ctx, req.(*MsgRevokeAllAllowances))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgPruneAllowances)
 	if err := dec(in); err != nil {
@@ -188,6 +350,114 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantSubAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantSubAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantSubAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantSubAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantSubAllowance(ctx, req.(*MsgGrantSubAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantGroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantGroupAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantGroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantGroupAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantGroupAllowance(ctx, req.(*MsgGrantGroupAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeGroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeGroupAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeGroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeGroupAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeGroupAllowance(ctx, req.(*MsgRevokeGroupAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantModuleAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantModuleAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantModuleAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantModuleAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantModuleAllowance(ctx, req.(*MsgGrantModuleAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeModuleAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeModuleAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeModuleAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeModuleAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeModuleAllowance(ctx, req.(*MsgRevokeModuleAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,10 +473,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "RevokeAllAllowances",
+			Handler:    _Msg_RevokeAllAllowances_Handler,
+		},
 		{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "GrantSubAllowance",
+			Handler:    _Msg_GrantSubAllowance_Handler,
+		},
+		{
+			MethodName: "GrantGroupAllowance",
+			Handler:    _Msg_GrantGroupAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeGroupAllowance",
+			Handler:    _Msg_RevokeGroupAllowance_Handler,
+		},
+		{
+			MethodName: "GrantModuleAllowance",
+			Handler:    _Msg_GrantModuleAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeModuleAllowance",
+			Handler:    _Msg_RevokeModuleAllowance_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -13,6 +13,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/kms"
 )
 
 // DefaultConfig returns default config for the client.toml
@@ -39,6 +41,7 @@ type Config struct {
 	Node                  string     `mapstructure:"node" json:"node"`
 	BroadcastMode         string     `mapstructure:"broadcast-mode" json:"broadcast-mode"`
 	GRPC                  GRPCConfig `mapstructure:",squash"`
+	KMS                   KMSConfig  `mapstructure:",squash"`
 }
 
 // GRPCConfig holds the gRPC client configuration.
@@ -47,6 +50,14 @@ type GRPCConfig struct {
 	Insecure bool   `mapstructure:"grpc-insecure"  json:"grpc-insecure"`
 }
 
+// KMSConfig holds the configuration of the key management service holding the keys of the kms keyring
+// backend, or of the keys added with --kms-key-id. Its credentials are read from the environment.
+type KMSConfig struct {
+	Provider string `mapstructure:"kms-provider" json:"kms-provider"`
+	Endpoint string `mapstructure:"kms-endpoint" json:"kms-endpoint"`
+	Region   string `mapstructure:"kms-region" json:"kms-region"`
+}
+
 // ReadFromClientConfig reads values from client.toml file and updates them in client.Context
 // It uses CreateClientConfig internally with no custom template and custom config.
 // Deprecated: use CreateClientConfig instead.
@@ -114,6 +125,21 @@ func CreateClientConfig(ctx client.Context, customClientTemplate string, customC
 		WithKeyringDir(ctx.HomeDir).
 		WithKeyringDefaultKeyName(conf.KeyringDefaultKeyName)
 
+	if conf.KMS.Provider != "" {
+		keyManagementService, err := kms.New(kms.Config{
+			Provider: conf.KMS.Provider,
+			Endpoint: conf.KMS.Endpoint,
+			Region:   conf.KMS.Region,
+		})
+		if err != nil {
+			return ctx, fmt.Errorf("couldn't get key management service: %w", err)
+		}
+
+		ctx = ctx.WithKeyringOptions(append(ctx.KeyringOptions, func(options *keyring.Options) {
+			options.KMS = keyManagementService
+		})...)
+	}
+
 	keyring, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get keyring: %w", err)
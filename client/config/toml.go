@@ -18,7 +18,7 @@ const (
 
 # The network chain ID
 chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory|kms)
 keyring-backend = "{{ .KeyringBackend }}"
 # Default key name, if set, defines the default key to use for signing transaction when the --from flag is not specified
 keyring-default-keyname = "{{ .KeyringDefaultKeyName }}"
@@ -36,6 +36,16 @@ grpc-address = "{{ .GRPC.Address }}"
 # Allow the gRPC client to connect over insecure channels.
 # It can be overwritten by the --grpc-insecure flag in each command.
 grpc-insecure = {{ .GRPC.Insecure }}
+
+# The key management service holding the private keys of the kms keys (aws|gcp|vault), empty to disable.
+# Its credentials are read from the environment: AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN
+# for aws, GOOGLE_OAUTH_ACCESS_TOKEN for gcp and VAULT_TOKEN for vault.
+kms-provider = "{{ .KMS.Provider }}"
+# The URL of the API of the key management service, it defaults to the public endpoint of aws and gcp,
+# and to $VAULT_ADDR/v1/transit for vault.
+kms-endpoint = "{{ .KMS.Endpoint }}"
+# The region of the keys, only used by aws.
+kms-region = "{{ .KMS.Region }}"
 `
 )
 
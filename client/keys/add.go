@@ -41,6 +41,7 @@ const (
 	flagPubKeyBase64 = "pubkey-base64"
 	flagIndiscreet   = "indiscreet"
 	flagMnemonicSrc  = "source"
+	flagKMSKeyID     = "kms-key-id"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -63,6 +64,20 @@ local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
 multisig transactions.
 
+Use the --address flag to add a watch-only key from a bare address, for accounts whose public
+key is unknown. Transactions can be generated and simulated for watch-only keys, but they must
+be signed externally.
+Example:
+
+	keys add treasury --address cosmos1...
+
+Use the --kms-key-id flag to store a reference to a key held by the key management service
+configured in client.toml (AWS KMS, GCP Cloud KMS or HashiCorp Vault Transit), which then signs
+on behalf of the keyring.
+Example:
+
+	keys add service --kms-key-id alias/service-key
+
 Use the --source flag to import mnemonic from a file in recover or interactive mode. 
 Example:
 
@@ -84,8 +99,10 @@ Example:
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.String(flagPubKeyBase64, "", "Parse a public key in base64 format and saves key info.")
+	f.String(FlagAddress, "", "Parse an address and saves it as a watch-only key.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
+	f.String(flagKMSKeyID, "", "Store a local reference to a private key held by the key management service configured in client.toml")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	f.Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
@@ -264,6 +281,29 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
 	}
 
+	if address, _ := cmd.Flags().GetString(FlagAddress); address != "" {
+		addr, err := ctx.AddressCodec.StringToBytes(address)
+		if err != nil {
+			return err
+		}
+
+		k, err := kb.SaveWatchOnlyKey(name, addr)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
+	}
+
+	if kmsKeyID, _ := cmd.Flags().GetString(flagKMSKeyID); kmsKeyID != "" {
+		k, err := kb.SaveKMSKey(name, kmsKeyID)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
+	}
+
 	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
 	account, _ := cmd.Flags().GetUint32(flagAccount)
 	index, _ := cmd.Flags().GetUint32(flagIndex)
@@ -54,7 +54,7 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 					return err
 				}
 
-				if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline {
+				if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline || k.GetType() == keyring.TypeKMS {
 					cmd.PrintErrln("Public key reference deleted")
 					continue
 				}
@@ -4,13 +4,21 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagFromBackend  = "from"
+	flagToBackend    = "to"
+	flagDeleteSource = "delete-source"
 )
 
 // MigrateCommand migrates key information from legacy keybase to OS secret store.
 func MigrateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "migrate",
-		Short: "Migrate keys from amino to proto serialization format",
+		Short: "Migrate keys from amino to proto serialization format, or to another keyring backend",
 		Long: `Migrate keys from Amino to Protocol Buffers records.
 For each key material entry, the command will check if the key can be deserialized using proto.
 If this is the case, the key is already migrated. Therefore, we skip it and continue with a next one. 
@@ -18,11 +26,23 @@ Otherwise, we try to deserialize it using Amino into LegacyInfo. If this attempt
 LegacyInfo to Protobuf serialization format and overwrite the keyring entry. If any error occurred, it will be 
 outputted in CLI and migration will be continued until all keys in the keyring DB are exhausted.
 See https://github.com/cosmos/cosmos-sdk/pull/9695 for more details.
+
+When --to is set, the keys are instead copied from the --from keyring backend (which defaults to
+--keyring-backend) to the --to keyring backend, e.g. --from file --to os. Each copied key is read back
+from the destination backend and its public keys are verified. With --dry-run, the outcome of the
+migration of each key is only reported. With --delete-source, the keys are deleted from the source
+backend, once all keys are copied and verified.
 `,
 		Args: cobra.NoArgs,
 		RunE: runMigrateCmd,
 	}
 
+	f := cmd.Flags()
+	f.String(flagFromBackend, "", "Keyring backend to migrate the keys from (defaults to --keyring-backend)")
+	f.String(flagToBackend, "", "Keyring backend to migrate the keys to")
+	f.Bool(flags.FlagDryRun, false, "Report the outcome of the migration of each key, without migrating the keys")
+	f.Bool(flagDeleteSource, false, "Delete the keys from the source keyring backend once they are migrated and verified")
+
 	return cmd
 }
 
@@ -32,6 +52,10 @@ func runMigrateCmd(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if to, _ := cmd.Flags().GetString(flagToBackend); to != "" {
+		return runMigrateBackendCmd(cmd, clientCtx, to)
+	}
+
 	if _, err = clientCtx.Keyring.MigrateAll(); err != nil {
 		return err
 	}
@@ -39,3 +63,46 @@ func runMigrateCmd(cmd *cobra.Command, _ []string) error {
 	cmd.Println("Keys migration has been successfully executed.")
 	return nil
 }
+
+func runMigrateBackendCmd(cmd *cobra.Command, clientCtx client.Context, to string) error {
+	src := clientCtx.Keyring
+	if from, _ := cmd.Flags().GetString(flagFromBackend); from != "" {
+		var err error
+		if src, err = client.NewKeyringFromBackend(clientCtx, from); err != nil {
+			return err
+		}
+	}
+
+	dst, err := client.NewKeyringFromBackend(clientCtx, to)
+	if err != nil {
+		return err
+	}
+
+	dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+	deleteSource, _ := cmd.Flags().GetBool(flagDeleteSource)
+	migrations, err := keyring.MigrateBackend(src, dst, keyring.BackendMigrationOptions{
+		DryRun:       dryRun,
+		DeleteSource: deleteSource,
+	})
+
+	for _, m := range migrations {
+		switch {
+		case m.Err != nil:
+			cmd.Printf("%s (%s): cannot be migrated: %s\n", m.Name, m.Type, m.Err)
+		case m.Exists:
+			cmd.Printf("%s (%s): already exists in the %s backend\n", m.Name, m.Type, dst.Backend())
+		case dryRun:
+			cmd.Printf("%s (%s): would be migrated\n", m.Name, m.Type)
+		default:
+			cmd.Printf("%s (%s): migrated\n", m.Name, m.Type)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if !dryRun {
+		cmd.Printf("Keys migration from the %s backend to the %s backend has been successfully executed.\n", src.Backend(), dst.Backend())
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -158,3 +159,46 @@ func (s *MigrateTestSuite) Test_runMigrateCmdLegacyMultiInfo() {
 	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
 	s.Require().NoError(cmd.ExecuteContext(ctx))
 }
+
+func (s *MigrateTestSuite) Test_runMigrateBackendCmd() {
+	src := keyring.NewInMemory(s.cdc)
+	_, err := src.SaveOfflineKey("offline", s.pub)
+	s.Require().NoError(err)
+
+	dir := s.T().TempDir()
+	clientCtx := client.Context{}.
+		WithKeyring(src).
+		WithKeyringDir(dir).
+		WithCodec(s.cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd := MigrateCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	out := &strings.Builder{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flagToBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=true", flags.FlagDryRun),
+	})
+	s.Require().NoError(cmd.ExecuteContext(ctx))
+	s.Require().Equal("offline (offline): would be migrated\n", out.String())
+
+	dst, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, dir, nil, s.cdc)
+	s.Require().NoError(err)
+	_, err = dst.Key("offline")
+	s.Require().Error(err)
+
+	out.Reset()
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flagToBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=false", flags.FlagDryRun),
+		fmt.Sprintf("--%s=true", flagDeleteSource),
+	})
+	s.Require().NoError(cmd.ExecuteContext(ctx))
+	s.Require().Contains(out.String(), "offline (offline): migrated\n")
+
+	_, err = dst.Key("offline")
+	s.Require().NoError(err)
+	_, err = src.Key("offline")
+	s.Require().Error(err)
+}
@@ -19,6 +19,8 @@ type KeyOutput struct {
 	Address  string `json:"address" yaml:"address"`
 	PubKey   string `json:"pubkey" yaml:"pubkey"`
 	Mnemonic string `json:"mnemonic,omitempty" yaml:"mnemonic"`
+	// RotatedAddresses are the addresses of the previous keys of a rotated key, from the most recent.
+	RotatedAddresses []string `json:"rotated_addresses,omitempty" yaml:"rotated_addresses,omitempty"`
 }
 
 // NewKeyOutput creates a default KeyOutput instance without Mnemonic, Threshold and PubKeys
@@ -67,13 +69,43 @@ func MkValKeyOutput(k *keyring.Record, validatorAddressCodec address.Codec) (Key
 
 // MkAccKeyOutput create a KeyOutput in with "acc" Bech32 prefixes. If the
 // public key is a multisig public key, then the threshold and constituent
-// public keys will be added.
+// public keys will be added. The addresses of the previous keys of a rotated
+// key are added as well. Watch-only keys created from an address have no
+// public key.
 func MkAccKeyOutput(k *keyring.Record, addressCodec address.Codec) (KeyOutput, error) {
-	pk, err := k.GetPubKey()
+	if k.PubKey == nil {
+		addr, err := k.GetAddress()
+		if err != nil {
+			return KeyOutput{}, err
+		}
+
+		addrStr, err := addressCodec.BytesToString(addr)
+		if err != nil {
+			return KeyOutput{}, err
+		}
+
+		return KeyOutput{Name: k.Name, Type: k.GetType().String(), Address: addrStr}, nil
+	}
+
+	pks, err := k.PubKeys()
 	if err != nil {
 		return KeyOutput{}, err
 	}
-	return NewKeyOutput(k.Name, k.GetType(), pk.Address(), pk, addressCodec)
+
+	ko, err := NewKeyOutput(k.Name, k.GetType(), pks[0].Address(), pks[0], addressCodec)
+	if err != nil {
+		return KeyOutput{}, err
+	}
+
+	for _, pk := range pks[1:] {
+		addr, err := addressCodec.BytesToString(pk.Address())
+		if err != nil {
+			return KeyOutput{}, err
+		}
+		ko.RotatedAddresses = append(ko.RotatedAddresses, addr)
+	}
+
+	return ko, nil
 }
 
 // MkAccKeysOutput returns a slice of KeyOutput objects, each with the "acc"
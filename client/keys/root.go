@@ -47,6 +47,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		ShowKeysCmd(),
 		DeleteKeyCommand(),
 		RenameKeyCommand(),
+		RotateKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
 	)
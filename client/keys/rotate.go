@@ -0,0 +1,86 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RotateKeyCommand rotates the key of a local key in the key store.
+func RotateKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate <name>",
+		Short: "Rotate the private key of an existing key",
+		Long: `Replace the private key of a local key with a key derived from a newly generated mnemonic,
+without renaming the key. The previous public keys of the key are kept in its key history, so that
+their signatures can still be verified, and their addresses still resolve to the key. Only the new
+key can sign.
+
+Note that the account of the previous address is not migrated: funds and permissions must be moved
+to the new address.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			kb := clientCtx.Keyring
+			keyringAlgos, _ := kb.SupportedAlgorithms()
+			algoStr, _ := cmd.Flags().GetString(flags.FlagKeyType)
+			algo, err := keyring.NewSigningAlgoFromString(algoStr, keyringAlgos)
+			if err != nil {
+				return err
+			}
+
+			coinType, _ := cmd.Flags().GetUint32(flagCoinType)
+			account, _ := cmd.Flags().GetUint32(flagAccount)
+			index, _ := cmd.Flags().GetUint32(flagIndex)
+			hdPath, _ := cmd.Flags().GetString(flagHDPath)
+			if len(hdPath) == 0 {
+				hdPath = hd.CreateHDPath(coinType, account, index).String()
+			}
+
+			// confirm rotation, unless -y is passed
+			if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
+				prompt := fmt.Sprintf("The private key of %s will be replaced by a new key. Continue?", args[0])
+				if yes, err := input.GetConfirmation(prompt, buf, cmd.ErrOrStderr()); err != nil {
+					return err
+				} else if !yes {
+					return nil
+				}
+			}
+
+			k, mnemonic, err := kb.RotateKey(args[0], keyring.English, hdPath, keyring.DefaultBIP39Passphrase, algo)
+			if err != nil {
+				return err
+			}
+
+			noBackup, _ := cmd.Flags().GetBool(flagNoBackup)
+			showMnemonicIndiscreetly, _ := cmd.Flags().GetBool(flagIndiscreet)
+			return printCreate(clientCtx, cmd, k, !noBackup, showMnemonicIndiscreetly, mnemonic, clientCtx.OutputFormat)
+		},
+	}
+
+	f := cmd.Flags()
+	f.BoolP(flagYes, "y", false, "Skip confirmation prompt when rotating the key")
+	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
+	f.Bool(flagIndiscreet, false, "Print seed phrase directly on current terminal (only valid when --no-backup is false)")
+	f.String(flagHDPath, "", "Manual HD Path derivation (overrides BIP44 config)")
+	f.Uint32(flagCoinType, sdk.CoinType, "coin type number for HD derivation")
+	f.Uint32(flagAccount, 0, "Account number for HD derivation (less than equal 2147483647)")
+	f.Uint32(flagIndex, 0, "Address index number for HD derivation (less than equal 2147483647)")
+	f.String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+
+	return cmd
+}
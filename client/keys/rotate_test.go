@@ -0,0 +1,88 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func Test_runRotateCmd(t *testing.T) {
+	kbHome := t.TempDir()
+	cmd := RotateKeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	fakeKeyName := "runRotateCmd_Key"
+	oldKey, err := kb.NewAccount(fakeKeyName, testdata.TestMnemonic, "", sdk.GetFullBIP44Path(), hd.Secp256k1)
+	require.NoError(t, err)
+	oldAddr, err := oldKey.GetAddress()
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithCodec(cdc).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos"))
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	// rotate a key which doesn't exist
+	cmd.SetArgs([]string{
+		"blah",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=true", flagYes),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.EqualError(t, cmd.ExecuteContext(ctx), "blah.info: key not found")
+
+	// user confirmation missing
+	cmd.SetArgs([]string{
+		fakeKeyName,
+		fmt.Sprintf("--%s=false", flagYes),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.EqualError(t, cmd.ExecuteContext(ctx), "EOF")
+
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{
+		fakeKeyName,
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=true", flagYes),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatJSON),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	var ko KeyOutput
+	require.NoError(t, json.Unmarshal(out.Bytes(), &ko))
+	require.Equal(t, fakeKeyName, ko.Name)
+	require.NotEmpty(t, ko.Mnemonic)
+	require.Equal(t, []string{oldAddr.String()}, ko.RotatedAddresses)
+	require.NotEqual(t, oldAddr.String(), ko.Address)
+
+	rotatedKey, err := kb.Key(fakeKeyName)
+	require.NoError(t, err)
+	rotatedAddr, err := rotatedKey.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, ko.Address, rotatedAddr.String())
+	require.Len(t, rotatedKey.KeyHistory, 1)
+}
@@ -1,9 +1,14 @@
 package offchain
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/client/v2/autocli/config"
@@ -18,12 +23,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 const (
 	flagEncoding   = "encoding"
 	flagFileFormat = "file-format"
 	flagBech32     = "bech32"
+	flagSigner     = "signer"
+	flagDomain     = "domain"
+	flagNonce      = "nonce"
+	flagTTL        = "ttl"
+	flagMultisig   = "multisig"
 )
 
 // OffChain off-chain utilities.
@@ -37,6 +49,11 @@ func OffChain() *cobra.Command {
 	cmd.AddCommand(
 		SignFile(),
 		VerifyFile(),
+		SignMessage(),
+		VerifyMessage(),
+		MultisignMessage(),
+		IssueAuthToken(),
+		VerifyAuthToken(),
 	)
 
 	flags.AddKeyringFlags(cmd.PersistentFlags())
@@ -52,73 +69,187 @@ func SignFile() *cobra.Command {
 		Long:  "Sign a file using a given key.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ir := types.NewInterfaceRegistry()
-			cryptocodec.RegisterInterfaces(ir)
-			cdc := codec.NewProtoCodec(ir)
-
-			c, err := config.CreateClientConfigFromFlags(cmd.Flags())
+			bz, err := os.ReadFile(args[1])
 			if err != nil {
 				return err
 			}
 
-			keyringBackend := c.KeyringBackend
-			if !cmd.Flags().Changed(v2flags.FlagKeyringBackend) {
-				_ = cmd.Flags().Set(v2flags.FlagKeyringBackend, keyringBackend)
-			}
+			return runSign(cmd, args[0], bz)
+		},
+	}
 
-			bz, err := os.ReadFile(args[1])
-			if err != nil {
-				return err
+	addSignFlags(cmd)
+	return cmd
+}
+
+// SignMessage signs an arbitrary message with a key.
+func SignMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign <keyName> [message]",
+		Short: "Sign an arbitrary message.",
+		Long: `Sign an arbitrary message using a given key, as defined by ADR-036. The message is read from
+STDIN when it is not given as argument. The signed message can be used by applications to authenticate
+the signer, once verified with the verify command.`,
+		Example: fmt.Sprintf(`%[1]s off-chain sign alice "login to example.com with nonce 42"
+echo "login to example.com with nonce 42" | %[1]s off-chain sign alice --sign-mode textual`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var bz []byte
+			if len(args) == 2 {
+				bz = []byte(args[1])
+			} else {
+				var err error
+				bz, err = io.ReadAll(cmd.InOrStdin())
+				if err != nil {
+					return err
+				}
 			}
 
-			encoding, _ := cmd.Flags().GetString(flagEncoding)
-			outputFormat, _ := cmd.Flags().GetString(v2flags.FlagOutput)
-			outputFile, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
-			signMode, _ := cmd.Flags().GetString(flags.FlagSignMode)
-			bech32Prefix, _ := cmd.Flags().GetString(flagBech32)
+			return runSign(cmd, args[0], bz)
+		},
+	}
 
-			ac := address.NewBech32Codec(bech32Prefix)
-			k, err := keyring.NewKeyringFromFlags(cmd.Flags(), ac, cmd.InOrStdin(), cdc)
-			if err != nil {
-				return err
-			}
+	addSignFlags(cmd)
+	return cmd
+}
+
+func addSignFlags(cmd *cobra.Command) {
+	cmd.Flags().String(v2flags.FlagOutput, "json", "Choose an output format for the tx (json|text")
+	cmd.Flags().String(flagEncoding, "no-encoding", "Choose an encoding method for the content to be added as msg data (no-encoding|base64|hex)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	cmd.PersistentFlags().String(flags.FlagSignMode, "direct", "Choose sign mode (direct|amino-json|textual)")
+	cmd.Flags().String(flagMultisig, "", "Address of the multisig the key is a member of, to sign a partial signature to combine with the multisign command")
+}
+
+// newSignContext returns the client context and connection used to sign off-chain messages.
+func newSignContext(cmd *cobra.Command) (clientcontext.Context, gogogrpc.ClientConn, error) {
+	ir := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(ir)
+	cdc := codec.NewProtoCodec(ir)
+
+	c, err := config.CreateClientConfigFromFlags(cmd.Flags())
+	if err != nil {
+		return clientcontext.Context{}, nil, err
+	}
+
+	keyringBackend := c.KeyringBackend
+	if !cmd.Flags().Changed(v2flags.FlagKeyringBackend) {
+		_ = cmd.Flags().Set(v2flags.FlagKeyringBackend, keyringBackend)
+	}
+
+	bech32Prefix, _ := cmd.Flags().GetString(flagBech32)
+
+	ac := address.NewBech32Codec(bech32Prefix)
+	k, err := keyring.NewKeyringFromFlags(cmd.Flags(), ac, cmd.InOrStdin(), cdc)
+	if err != nil {
+		return clientcontext.Context{}, nil, err
+	}
+
+	// off-chain does not need to query any information
+	conn, err := comet.NewCometBFTBroadcaster("", comet.BroadcastSync, cdc)
+	if err != nil {
+		return clientcontext.Context{}, nil, err
+	}
 
-			// off-chain does not need to query any information
-			conn, err := comet.NewCometBFTBroadcaster("", comet.BroadcastSync, cdc)
+	ctx := clientcontext.Context{
+		Flags:                 cmd.Flags(),
+		AddressCodec:          ac,
+		ValidatorAddressCodec: address.NewBech32Codec(sdk.GetBech32PrefixValAddr(bech32Prefix)),
+		Cdc:                   cdc,
+		Keyring:               k,
+	}
+	return ctx, conn, nil
+}
+
+// runSign signs bz with the key of the given name, and prints the signed tx.
+func runSign(cmd *cobra.Command, keyName string, bz []byte) error {
+	ctx, conn, err := newSignContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	encoding, _ := cmd.Flags().GetString(flagEncoding)
+	outputFormat, _ := cmd.Flags().GetString(v2flags.FlagOutput)
+	signMode, _ := cmd.Flags().GetString(flags.FlagSignMode)
+	multisigAddress, _ := cmd.Flags().GetString(flagMultisig)
+
+	var signedTx string
+	if multisigAddress != "" {
+		if cmd.Flags().Changed(flags.FlagSignMode) && signMode != "amino-json" {
+			return fmt.Errorf("partial signatures of multisigs are signed in amino-json sign mode, not %s", signMode)
+		}
+		signedTx, err = SignMultisig(ctx, bz, conn, keyName, multisigAddress, encoding, outputFormat)
+	} else {
+		signedTx, err = Sign(ctx, bz, conn, keyName, encoding, signMode, outputFormat)
+	}
+	if err != nil {
+		return err
+	}
+
+	return printSigned(cmd, signedTx)
+}
+
+// printSigned prints a signed tx, to the --output-document file when set.
+func printSigned(cmd *cobra.Command, signedTx string) error {
+	outputFile, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if outputFile != "" {
+		fp, err := os.OpenFile(filepath.Clean(outputFile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+		if err != nil {
+			return err
+		}
+		defer fp.Close()
+		cmd.SetOut(fp)
+	}
+
+	cmd.Println(signedTx)
+	return nil
+}
+
+// MultisignMessage combines the partial signatures of the members of a multisig into a signed off-chain message.
+func MultisignMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign <multisigKeyName> <partialSignatureFile>...",
+		Short: "Combine the partial signatures of a multisig.",
+		Long: `Combine the partial signatures of the members of a multisig key, signed with the --multisig flag of
+the sign or sign-file command, into a message signed by the multisig. The partial signatures must sign the
+same message, and reach the threshold of the multisig. The signed message is verified with the verify command.`,
+		Example: fmt.Sprintf(`%[1]s off-chain sign alice "some message" --multisig <multisigAddress> --output-document alice.json
+%[1]s off-chain sign bob "some message" --multisig <multisigAddress> --output-document bob.json
+%[1]s off-chain multisign multi alice.json bob.json`, version.AppName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _, err := newSignContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			ctx := clientcontext.Context{
-				Flags:                 cmd.Flags(),
-				AddressCodec:          ac,
-				ValidatorAddressCodec: address.NewBech32Codec(sdk.GetBech32PrefixValAddr(bech32Prefix)),
-				Cdc:                   cdc,
-				Keyring:               k,
-			}
-
-			signedTx, err := Sign(ctx, bz, conn, args[0], encoding, signMode, outputFormat)
+			pubKey, err := ctx.Keyring.GetPubKey(args[0])
 			if err != nil {
 				return err
 			}
 
-			if outputFile != "" {
-				fp, err := os.OpenFile(filepath.Clean(outputFile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+			partials := make([][]byte, len(args)-1)
+			for i, name := range args[1:] {
+				partials[i], err = os.ReadFile(name)
 				if err != nil {
 					return err
 				}
-				cmd.SetOut(fp)
 			}
 
-			cmd.Println(signedTx)
-			return nil
+			fileFormat, _ := cmd.Flags().GetString(flagFileFormat)
+			outputFormat, _ := cmd.Flags().GetString(v2flags.FlagOutput)
+			signedTx, err := Multisign(ctx, pubKey, partials, fileFormat, outputFormat)
+			if err != nil {
+				return err
+			}
+
+			return printSigned(cmd, signedTx)
 		},
 	}
 
+	cmd.Flags().String(flagFileFormat, "json", "Choose what's the format of the partial signature files (json|text)")
 	cmd.Flags().String(v2flags.FlagOutput, "json", "Choose an output format for the tx (json|text")
-	cmd.Flags().String(flagEncoding, "no-encoding", "Choose an encoding method for the file content to be added as msg data (no-encoding|base64|hex)")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
-	cmd.PersistentFlags().String(flags.FlagSignMode, "direct", "Choose sign mode (direct|amino-json)")
 	return cmd
 }
 
@@ -161,3 +292,182 @@ func VerifyFile() *cobra.Command {
 	cmd.Flags().String(flagFileFormat, "json", "Choose what's the file format to be verified (json|text)")
 	return cmd
 }
+
+// VerifyMessage verifies a signed arbitrary message and prints its signer and data.
+func VerifyMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [signedFileName]",
+		Short: "Verify a signed arbitrary message.",
+		Long: `Verify a message previously signed with the sign or sign-file command, and print its signer and
+data. The signed message is read from STDIN when no file is given. Use --signer to also check that the
+message was signed by the given address.
+
+With --node, the message is verified against the public key registered on chain by the account of the
+--signer address, which must be given: it fails if the message is not signed by the current key of the
+account. Messages of multisig accounts are verified against the threshold of the account.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ir := types.NewInterfaceRegistry()
+			cdc := codec.NewProtoCodec(ir)
+
+			var (
+				bz  []byte
+				err error
+			)
+			if len(args) == 1 {
+				bz, err = os.ReadFile(args[0])
+			} else {
+				bz, err = io.ReadAll(cmd.InOrStdin())
+			}
+			if err != nil {
+				return err
+			}
+
+			fileFormat, _ := cmd.Flags().GetString(flagFileFormat)
+			bech32Prefix, _ := cmd.Flags().GetString(flagBech32)
+			expectedSigner, _ := cmd.Flags().GetString(flagSigner)
+
+			ac := address.NewBech32Codec(bech32Prefix)
+
+			ctx := clientcontext.Context{
+				Flags:                 cmd.Flags(),
+				AddressCodec:          ac,
+				ValidatorAddressCodec: address.NewBech32Codec(sdk.GetBech32PrefixValAddr(bech32Prefix)),
+				Cdc:                   cdc,
+			}
+
+			if node, _ := cmd.Flags().GetString(flags.FlagNode); node != "" {
+				return runVerifyAccount(cmd, ctx, node, expectedSigner, bz, fileFormat)
+			}
+
+			data, err := VerifyData(ctx, bz, fileFormat)
+			if err != nil {
+				return err
+			}
+			if expectedSigner != "" && data.Signer != expectedSigner {
+				return fmt.Errorf("message signed by %s, expected %s", data.Signer, expectedSigner)
+			}
+
+			cmd.Println("Verification OK!")
+			cmd.Printf("signer: %s\napp domain: %s\ndata: %s\n", data.Signer, data.AppDomain, data.Data)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagFileFormat, "json", "Choose what's the file format to be verified (json|text)")
+	cmd.Flags().String(flagSigner, "", "The address which must have signed the message")
+	cmd.Flags().String(flags.FlagNode, "", "<host>:<port> to CometBFT RPC interface, to verify the message against the account of --signer")
+	return cmd
+}
+
+// runVerifyAccount verifies bz against the account of signer, queried from the given node, and prints the
+// outcome of the verification.
+func runVerifyAccount(cmd *cobra.Command, ctx clientcontext.Context, node, signer string, bz []byte, fileFormat string) error {
+	if signer == "" {
+		return fmt.Errorf("--%s is required with --%s", flagSigner, flags.FlagNode)
+	}
+
+	ir := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(ir)
+	authtypes.RegisterInterfaces(ir)
+	ctx.Cdc = codec.NewProtoCodec(ir)
+
+	conn, err := comet.NewCometBFTBroadcaster(node, comet.BroadcastSync, ctx.Cdc)
+	if err != nil {
+		return err
+	}
+
+	res, err := VerifyAccount(ctx, conn, signer, bz, fileFormat)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println("Verification OK!")
+	cmd.Printf("signer: %s\naccount number: %d\napp domain: %s\ndata: %s\n", res.Signer, res.AccountNumber, res.AppDomain, res.Data)
+	if res.Threshold != 0 {
+		cmd.Printf("multisig threshold: %d\nsigned by: %s\n", res.Threshold, strings.Join(res.MultisigSigners, ", "))
+	}
+	return nil
+}
+
+// IssueAuthToken issues a sign-in-with-Cosmos authentication token with a key.
+func IssueAuthToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-token <keyName>",
+		Short: "Issue an authentication token.",
+		Long: `Issue a sign-in-with-Cosmos authentication token for the given domain, signed with the given key.
+The nonce must be the one issued by the backend of the domain. The token is printed encoded in base64, so
+that it can be sent in HTTP headers, and can be verified with the verify-token command.`,
+		Example: fmt.Sprintf(`%s off-chain issue-token alice --domain example.com --nonce 8f4b1c2a9d3e --ttl 1h`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, conn, err := newSignContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			domain, _ := cmd.Flags().GetString(flagDomain)
+			nonce, _ := cmd.Flags().GetString(flagNonce)
+			ttl, _ := cmd.Flags().GetDuration(flagTTL)
+			signMode, _ := cmd.Flags().GetString(flags.FlagSignMode)
+
+			token, err := IssueToken(ctx, conn, args[0], domain, nonce, ttl, signMode)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(token)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDomain, "", "The domain of the backend the token authenticates to")
+	cmd.Flags().String(flagNonce, "", "The nonce issued by the backend")
+	cmd.Flags().Duration(flagTTL, time.Hour, "The duration for which the token is valid")
+	cmd.Flags().String(flags.FlagSignMode, "direct", "Choose sign mode (direct|amino-json|textual)")
+	_ = cmd.MarkFlagRequired(flagDomain)
+	_ = cmd.MarkFlagRequired(flagNonce)
+	return cmd
+}
+
+// VerifyAuthToken verifies a sign-in-with-Cosmos authentication token and prints its claims.
+func VerifyAuthToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-token <token>",
+		Short: "Verify an authentication token.",
+		Long: `Verify a sign-in-with-Cosmos authentication token issued for the given domain, and print its claims.
+The token must be signed by the address of its claims, and not be expired.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ir := types.NewInterfaceRegistry()
+			cdc := codec.NewProtoCodec(ir)
+
+			bech32Prefix, _ := cmd.Flags().GetString(flagBech32)
+			domain, _ := cmd.Flags().GetString(flagDomain)
+
+			ac := address.NewBech32Codec(bech32Prefix)
+
+			ctx := clientcontext.Context{
+				Flags:                 cmd.Flags(),
+				AddressCodec:          ac,
+				ValidatorAddressCodec: address.NewBech32Codec(sdk.GetBech32PrefixValAddr(bech32Prefix)),
+				Cdc:                   cdc,
+			}
+
+			claims, err := VerifyToken(ctx, args[0], domain, time.Now())
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Verification OK!")
+			cmd.Printf("domain: %s\naddress: %s\nnonce: %s\nissued at: %s\nexpires at: %s\n",
+				claims.Domain, claims.Address, claims.Nonce,
+				claims.IssuedAt.Format(time.RFC3339), claims.ExpiresAt.Format(time.RFC3339))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDomain, "", "The domain the token must be issued to")
+	_ = cmd.MarkFlagRequired(flagDomain)
+	return cmd
+}
@@ -7,6 +7,8 @@ import (
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/client/v2/internal/account"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/testutil"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -32,3 +34,34 @@ func (c mockClientConn) Invoke(_ context.Context, _ string, _, _ interface{}, _
 func (c mockClientConn) NewStream(_ context.Context, _ *grpc.StreamDesc, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
 	return nil, errors.New("not implemented")
 }
+
+var _ account.AccountRetriever = mockAccountRetriever{}
+
+// mockAccountRetriever retrieves the accounts it holds, keyed by address.
+type mockAccountRetriever map[string]account.Account
+
+func (m mockAccountRetriever) GetAccount(_ context.Context, address []byte) (account.Account, error) {
+	acc, ok := m[string(address)]
+	if !ok {
+		return nil, errors.New("account not found")
+	}
+	return acc, nil
+}
+
+func (m mockAccountRetriever) GetAccountWithHeight(ctx context.Context, address []byte) (account.Account, int64, error) {
+	acc, err := m.GetAccount(ctx, address)
+	return acc, 1, err
+}
+
+func (m mockAccountRetriever) EnsureExists(ctx context.Context, address []byte) error {
+	_, err := m.GetAccount(ctx, address)
+	return err
+}
+
+func (m mockAccountRetriever) GetAccountNumberSequence(ctx context.Context, address []byte) (accNum, accSeq uint64, err error) {
+	acc, err := m.GetAccount(ctx, address)
+	if err != nil {
+		return 0, 0, err
+	}
+	return acc.GetAccountNumber(), acc.GetSequence(), nil
+}
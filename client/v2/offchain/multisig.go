@@ -0,0 +1,243 @@
+package offchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	_ "cosmossdk.io/api/cosmos/crypto/multisig" // registers the multisig public key of signed messages
+	apimultisig "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	clientcontext "cosmossdk.io/client/v2/context"
+	clitx "cosmossdk.io/client/v2/tx"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+)
+
+// SignMultisig signs given bytes with the key of the given name, a member of the multisig of the given address,
+// in an off-chain message of the multisig. The returned partial signature is combined with the ones of the other
+// members with Multisign. Partial signatures are signed in SIGN_MODE_LEGACY_AMINO_JSON, whose sign bytes do not
+// depend on the signers of the message, as for multisig transactions.
+func SignMultisig(
+	ctx clientcontext.Context,
+	rawBytes []byte,
+	conn gogogrpc.ClientConn,
+	fromName, multisigAddress, encoding, output string,
+) (string, error) {
+	if _, err := ctx.AddressCodec.StringToBytes(multisigAddress); err != nil {
+		return "", fmt.Errorf("invalid multisig address %s: %w", multisigAddress, err)
+	}
+
+	return signMessage(ctx, rawBytes, conn, fromName, multisigAddress, encoding, "amino-json", output)
+}
+
+// partialSignature is the signature of a member of a multisig, at its index in the keys of the multisig.
+type partialSignature struct {
+	index     int
+	signature []byte
+}
+
+// Multisign combines the partial signatures of the members of the given multisig public key, returned by
+// SignMultisig, into an off-chain message signed by the multisig. The partial signatures must sign the same
+// message, and reach the threshold of the multisig. The returned message is verified like any other off-chain
+// message.
+func Multisign(
+	ctx clientcontext.Context,
+	multisigPubKey cryptotypes.PubKey,
+	partials [][]byte,
+	fileFormat, output string,
+) (string, error) {
+	multiPK, ok := multisigPubKey.(multisig.PubKey)
+	if !ok {
+		return "", fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), multisigPubKey)
+	}
+	if len(partials) == 0 {
+		return "", errors.New("no partial signatures to combine")
+	}
+
+	txConfig, err := newTxConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	addr, err := ctx.AddressCodec.BytesToString(multiPK.Address())
+	if err != nil {
+		return "", err
+	}
+	anyPk, err := codectypes.NewAnyWithValue(multiPK)
+	if err != nil {
+		return "", err
+	}
+	pubKey := &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value}
+
+	signerData := txsigning.SignerData{
+		ChainID:       ExpectedChainID,
+		AccountNumber: ExpectedAccountNumber,
+		Sequence:      ExpectedSequence,
+		Address:       addr,
+		PubKey:        pubKey,
+	}
+
+	var (
+		txData txsigning.TxData
+		sigs   []partialSignature
+	)
+	for i, bz := range partials {
+		partialTx, err := unmarshal(fileFormat, bz, txConfig)
+		if err != nil {
+			return "", fmt.Errorf("partial signature %d: %w", i, err)
+		}
+
+		sig, partialData, err := partialSignatureOf(multiPK, addr, signerData, partialTx, txConfig)
+		if err != nil {
+			return "", fmt.Errorf("partial signature %d: %w", i, err)
+		}
+
+		if i == 0 {
+			txData = partialData
+		} else if !bytes.Equal(txData.BodyBytes, partialData.BodyBytes) {
+			return "", fmt.Errorf("partial signature %d: signs another message than partial signature 0", i)
+		}
+
+		for _, s := range sigs {
+			if s.index == sig.index {
+				return "", fmt.Errorf("partial signature %d: key %d of the multisig signed more than once", i, sig.index)
+			}
+		}
+		sigs = append(sigs, sig)
+	}
+
+	if len(sigs) < int(multiPK.GetThreshold()) {
+		return "", fmt.Errorf("not enough partial signatures: have %d, expected %d", len(sigs), multiPK.GetThreshold())
+	}
+
+	bz, err := multisignedTx(txData, pubKey, len(multiPK.GetPubKeys()), sigs)
+	if err != nil {
+		return "", err
+	}
+
+	signedTx, err := txConfig.TxDecoder()(bz)
+	if err != nil {
+		return "", err
+	}
+	if err := verify(ctx.AddressCodec, txConfig, signedTx); err != nil {
+		return "", err
+	}
+
+	encoded, err := encode(output, signedTx, txConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// partialSignatureOf verifies the partial signature of a member of the multisig of the given address, and
+// returns it along with the signed tx data.
+func partialSignatureOf(
+	multiPK multisig.PubKey,
+	multisigAddress string,
+	signerData txsigning.SignerData,
+	partialTx clitx.Tx,
+	txConfig clitx.TxConfig,
+) (partialSignature, txsigning.TxData, error) {
+	data, err := signedData(partialTx)
+	if err != nil {
+		return partialSignature{}, txsigning.TxData{}, err
+	}
+	if data.Signer != multisigAddress {
+		return partialSignature{}, txsigning.TxData{}, fmt.Errorf("message signed by %s, expected %s", data.Signer, multisigAddress)
+	}
+
+	sigs, err := partialTx.GetSignatures()
+	if err != nil {
+		return partialSignature{}, txsigning.TxData{}, err
+	}
+	if len(sigs) != 1 {
+		return partialSignature{}, txsigning.TxData{}, fmt.Errorf("expected a single signature, got %d", len(sigs))
+	}
+
+	sigData, ok := sigs[0].Data.(*clitx.SingleSignatureData)
+	if !ok || sigData.SignMode != apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return partialSignature{}, txsigning.TxData{}, errors.New("partial signatures must be signed in SIGN_MODE_LEGACY_AMINO_JSON")
+	}
+
+	index := -1
+	for i, pk := range multiPK.GetPubKeys() {
+		if pk.Equals(sigs[0].PubKey) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return partialSignature{}, txsigning.TxData{}, errors.New("signed by a key which is not a key of the multisig")
+	}
+
+	txData, err := partialTx.GetSigningTxData()
+	if err != nil {
+		return partialSignature{}, txsigning.TxData{}, err
+	}
+
+	// the sign bytes of SIGN_MODE_LEGACY_AMINO_JSON are the same for the multisig and its members
+	if err := verifySignature(context.Background(), sigs[0].PubKey, signerData, sigData, txConfig.SignModeHandler(), txData); err != nil {
+		return partialSignature{}, txsigning.TxData{}, err
+	}
+
+	return partialSignature{index: index, signature: sigData.Signature}, txData, nil
+}
+
+// multisignedTx returns the encoded tx of the given data signed by a multisig of n keys, with the given partial
+// signatures.
+func multisignedTx(txData txsigning.TxData, pubKey *anypb.Any, n int, sigs []partialSignature) ([]byte, error) {
+	sort.Slice(sigs, func(i, j int) bool { return sigs[i].index < sigs[j].index })
+
+	bitArray := cryptotypes.NewCompactBitArray(n)
+	modeInfos := make([]*apitx.ModeInfo, len(sigs))
+	signatures := make([][]byte, len(sigs))
+	for i, sig := range sigs {
+		bitArray.SetIndex(sig.index, true)
+		modeInfos[i] = &apitx.ModeInfo{Sum: &apitx.ModeInfo_Single_{
+			Single: &apitx.ModeInfo_Single{Mode: apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+		}}
+		signatures[i] = sig.signature
+	}
+
+	multiSig, err := proto.Marshal(&apimultisig.MultiSignature{Signatures: signatures})
+	if err != nil {
+		return nil, err
+	}
+
+	authInfo, err := proto.MarshalOptions{Deterministic: true}.Marshal(&apitx.AuthInfo{
+		SignerInfos: []*apitx.SignerInfo{{
+			PublicKey: pubKey,
+			ModeInfo: &apitx.ModeInfo{Sum: &apitx.ModeInfo_Multi_{Multi: &apitx.ModeInfo_Multi{
+				Bitarray: &apimultisig.CompactBitArray{
+					ExtraBitsStored: bitArray.ExtraBitsStored,
+					Elems:           bitArray.Elems,
+				},
+				ModeInfos: modeInfos,
+			}}},
+			Sequence: ExpectedSequence,
+		}},
+		Fee: txData.AuthInfo.Fee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.MarshalOptions{Deterministic: true}.Marshal(&apitx.TxRaw{
+		BodyBytes:     txData.BodyBytes,
+		AuthInfoBytes: authInfo,
+		Signatures:    [][]byte{multiSig},
+	})
+}
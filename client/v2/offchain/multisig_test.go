@@ -0,0 +1,105 @@
+package offchain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clientcontext "cosmossdk.io/client/v2/context"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func Test_SignMultisig(t *testing.T) {
+	ac := address.NewBech32Codec("cosmos")
+
+	k := keyring.NewInMemory(getCodec())
+	members := []string{"alice", "bob", "carol"}
+	pks := make([]cryptotypes.PubKey, len(members))
+	for i, name := range members {
+		record, err := k.NewAccount(name, mnemonic, "", fmt.Sprintf("m/44'/118'/0'/0/%d", i), hd.Secp256k1)
+		require.NoError(t, err)
+		pks[i], err = record.GetPubKey()
+		require.NoError(t, err)
+	}
+	multiPK := kmultisig.NewLegacyAminoPubKey(2, pks)
+	_, err := k.SaveMultisig("multi", multiPK)
+	require.NoError(t, err)
+	multisigAddr, err := ac.BytesToString(multiPK.Address())
+	require.NoError(t, err)
+
+	autoKeyring, err := keyring.NewAutoCLIKeyring(k, ac)
+	require.NoError(t, err)
+
+	ctx := clientcontext.Context{
+		AddressCodec:          ac,
+		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
+		Cdc:                   getCodec(),
+		Keyring:               autoKeyring,
+	}
+
+	partial := func(name, message string) []byte {
+		t.Helper()
+		tx, err := SignMultisig(ctx, []byte(message), mockClientConn{}, name, multisigAddr, "no-encoding", "json")
+		require.NoError(t, err)
+		return []byte(tx)
+	}
+	alice, carol := partial("alice", "some message"), partial("carol", "some message")
+
+	for _, output := range []string{"json", "text"} {
+		t.Run(output, func(t *testing.T) {
+			tx, err := Multisign(ctx, multiPK, [][]byte{carol, alice}, "json", output)
+			require.NoError(t, err)
+
+			data, err := VerifyData(ctx, []byte(tx), output)
+			require.NoError(t, err)
+			require.Equal(t, multisigAddr, data.Signer)
+			require.Equal(t, "some message", data.Data)
+
+			accounts := mockAccountRetriever{
+				string(multiPK.Address()): authtypes.NewBaseAccount(multiPK.Address(), multiPK, 3, 1),
+			}
+			res, err := verifyAccount(ctx, accounts, multisigAddr, []byte(tx), output)
+			require.NoError(t, err)
+			signers := make([]string, 0, 2)
+			for _, i := range []int{0, 2} {
+				signer, err := ac.BytesToString(pks[i].Address())
+				require.NoError(t, err)
+				signers = append(signers, signer)
+			}
+			require.Equal(t, signers, res.MultisigSigners)
+		})
+	}
+
+	// a partial signature does not verify as a message of the multisig
+	_, err = VerifyData(ctx, alice, "json")
+	require.ErrorContains(t, err, "signature does not match its respective signer")
+
+	_, err = Multisign(ctx, multiPK, [][]byte{alice}, "json", "json")
+	require.ErrorContains(t, err, "not enough partial signatures")
+
+	_, err = Multisign(ctx, multiPK, [][]byte{alice, alice}, "json", "json")
+	require.ErrorContains(t, err, "signed more than once")
+
+	_, err = Multisign(ctx, multiPK, [][]byte{alice, partial("bob", "another message")}, "json", "json")
+	require.ErrorContains(t, err, "signs another message")
+
+	direct, err := signMessage(ctx, []byte("some message"), mockClientConn{}, "bob", multisigAddr, "no-encoding", "direct", "json")
+	require.NoError(t, err)
+	_, err = Multisign(ctx, multiPK, [][]byte{alice, []byte(direct)}, "json", "json")
+	require.ErrorContains(t, err, "must be signed in SIGN_MODE_LEGACY_AMINO_JSON")
+
+	_, err = k.NewAccount("mallory", mnemonic, "", "m/44'/118'/0'/0/3", hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = Multisign(ctx, multiPK, [][]byte{alice, partial("mallory", "some message")}, "json", "json")
+	require.ErrorContains(t, err, "not a key of the multisig")
+
+	_, err = Multisign(ctx, pks[0], [][]byte{alice, carol}, "json", "json")
+	require.Error(t, err)
+}
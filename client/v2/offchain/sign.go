@@ -6,6 +6,7 @@ import (
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	clientcontext "cosmossdk.io/client/v2/context"
 	"cosmossdk.io/client/v2/internal/account"
@@ -27,26 +28,52 @@ const (
 var enabledSignModes = []apisigning.SignMode{
 	apisigning.SignMode_SIGN_MODE_DIRECT,
 	apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	apisigning.SignMode_SIGN_MODE_TEXTUAL,
 }
 
-// Sign signs given bytes using the specified encoder and SignMode.
+// newTxConfig returns the tx config used to sign and verify off-chain messages.
+func newTxConfig(ctx clientcontext.Context) (clitx.TxConfig, error) {
+	return clitx.NewTxConfig(clitx.ConfigOptions{
+		AddressCodec:               ctx.AddressCodec,
+		Cdc:                        ctx.Cdc,
+		ValidatorAddressCodec:      ctx.ValidatorAddressCodec,
+		EnabledSignModes:           enabledSignModes,
+		TextualCoinMetadataQueryFn: noCoinMetadata,
+	})
+}
+
+// noCoinMetadata is the coin metadata querier of SIGN_MODE_TEXTUAL. Off-chain messages do not contain coins,
+// and are signed and verified without connecting to a node.
+func noCoinMetadata(context.Context, string) (*bankv1beta1.Metadata, error) {
+	return nil, nil
+}
+
+// Sign signs given bytes using the specified encoder and SignMode, in an off-chain message as defined by
+// ADR-036: a tx holding a single MsgSignArbitraryData, without fee, for an empty chain id and a zero
+// account number and sequence.
 func Sign(
 	ctx clientcontext.Context,
 	rawBytes []byte,
 	conn gogogrpc.ClientConn,
 	fromName, encoding, signMode, output string,
+) (string, error) {
+	return signMessage(ctx, rawBytes, conn, fromName, "", encoding, signMode, output)
+}
+
+// signMessage signs given bytes with the key of the given name, in an off-chain message of the given signer,
+// which defaults to the address of the key.
+func signMessage(
+	ctx clientcontext.Context,
+	rawBytes []byte,
+	conn gogogrpc.ClientConn,
+	fromName, signer, encoding, signMode, output string,
 ) (string, error) {
 	digest, err := encodeDigest(encoding, rawBytes)
 	if err != nil {
 		return "", err
 	}
 
-	txConfig, err := clitx.NewTxConfig(clitx.ConfigOptions{
-		AddressCodec:          ctx.AddressCodec,
-		Cdc:                   ctx.Cdc,
-		ValidatorAddressCodec: ctx.ValidatorAddressCodec,
-		EnabledSignModes:      enabledSignModes,
-	})
+	txConfig, err := newTxConfig(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -77,14 +104,16 @@ func Sign(
 		return "", err
 	}
 
-	addr, err := ctx.AddressCodec.BytesToString(pubKey.Address())
-	if err != nil {
-		return "", err
+	if signer == "" {
+		signer, err = ctx.AddressCodec.BytesToString(pubKey.Address())
+		if err != nil {
+			return "", err
+		}
 	}
 
 	msg := &offchain.MsgSignArbitraryData{
 		AppDomain: version.AppName,
-		Signer:    addr,
+		Signer:    signer,
 		Data:      digest,
 	}
 
@@ -119,6 +148,8 @@ func getSignMode(mode string) (apisigning.SignMode, error) {
 		return apisigning.SignMode_SIGN_MODE_DIRECT, nil
 	case "amino-json":
 		return apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+	case "textual":
+		return apisigning.SignMode_SIGN_MODE_TEXTUAL, nil
 	}
 
 	return apisigning.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode: %s", mode)
@@ -48,10 +48,16 @@ func TestSign(t *testing.T) {
 			signMode: "amino-json",
 		},
 		{
-			name:     "not supported sign mode",
+			name:     "sign textual",
 			rawBytes: []byte("hello world"),
 			encoding: noEncoder,
 			signMode: "textual",
+		},
+		{
+			name:     "not supported sign mode",
+			rawBytes: []byte("hello world"),
+			encoding: noEncoder,
+			signMode: "direct-aux",
 			wantErr:  true,
 		},
 	}
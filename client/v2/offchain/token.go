@@ -0,0 +1,181 @@
+package offchain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	clientcontext "cosmossdk.io/client/v2/context"
+)
+
+const (
+	tokenHeaderSuffix    = " wants you to sign in with your Cosmos account:"
+	tokenNoncePrefix     = "Nonce: "
+	tokenIssuedAtPrefix  = "Issued At: "
+	tokenExpiresAtPrefix = "Expiration Time: "
+
+	// minTokenNonceLength is the minimum length of the nonce of an authentication token.
+	minTokenNonceLength = 8
+)
+
+// TokenClaims are the claims of a sign-in-with-Cosmos authentication token: the owner of Address signs in to
+// Domain, with a Nonce issued by Domain, until ExpiresAt.
+type TokenClaims struct {
+	// Domain is the domain of the backend the token authenticates to.
+	Domain string
+	// Address is the address of the owner of the token.
+	Address string
+	// Nonce is a random value issued by the backend, to prevent the replay of tokens.
+	Nonce string
+	// IssuedAt is the time at which the token was issued.
+	IssuedAt time.Time
+	// ExpiresAt is the time at which the token expires.
+	ExpiresAt time.Time
+}
+
+// NewTokenNonce returns a random nonce, to be issued by backends to the clients requesting a token.
+func NewTokenNonce() (string, error) {
+	bz := make([]byte, 16)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
+
+// IssueToken issues an authentication token to the given domain, valid for ttl, signed with the key of the
+// given name. The token is an off-chain message signing the claims of the token, encoded in base64 so that
+// it can be sent in HTTP headers.
+func IssueToken(
+	ctx clientcontext.Context,
+	conn gogogrpc.ClientConn,
+	fromName, domain, nonce string,
+	ttl time.Duration,
+	signMode string,
+) (string, error) {
+	pubKey, err := ctx.Keyring.GetPubKey(fromName)
+	if err != nil {
+		return "", err
+	}
+	addr, err := ctx.AddressCodec.BytesToString(pubKey.Address())
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	claims := TokenClaims{
+		Domain:    domain,
+		Address:   addr,
+		Nonce:     nonce,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(ttl),
+	}
+	if err := claims.validate(); err != nil {
+		return "", err
+	}
+
+	signedTx, err := Sign(ctx, []byte(claims.message()), conn, fromName, noEncoder, signMode, "json")
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(signedTx)), nil
+}
+
+// VerifyToken verifies an authentication token for the given domain at the given time, and returns its
+// claims. Backends must also check that the nonce of the token is one they issued, and that it was not used
+// before.
+func VerifyToken(ctx clientcontext.Context, token, domain string, now time.Time) (TokenClaims, error) {
+	bz, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return TokenClaims{}, fmt.Errorf("invalid token encoding: %w", err)
+	}
+
+	data, err := VerifyData(ctx, bz, "json")
+	if err != nil {
+		return TokenClaims{}, err
+	}
+
+	claims, err := parseTokenMessage(data.Data)
+	if err != nil {
+		return TokenClaims{}, err
+	}
+	if claims.Address != data.Signer {
+		return TokenClaims{}, fmt.Errorf("token of %s signed by %s", claims.Address, data.Signer)
+	}
+	if claims.Domain != domain {
+		return TokenClaims{}, fmt.Errorf("token issued to %s, expected %s", claims.Domain, domain)
+	}
+	if now.Before(claims.IssuedAt) {
+		return TokenClaims{}, fmt.Errorf("token issued in the future, at %s", claims.IssuedAt.Format(time.RFC3339))
+	}
+	if !now.Before(claims.ExpiresAt) {
+		return TokenClaims{}, fmt.Errorf("token expired at %s", claims.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return claims, nil
+}
+
+// message returns the message signed by a token, which follows the format of EIP-4361 (Sign-In with
+// Ethereum) so that wallets display it in a familiar way.
+func (c TokenClaims) message() string {
+	return c.Domain + tokenHeaderSuffix + "\n" +
+		c.Address + "\n" +
+		"\n" +
+		tokenNoncePrefix + c.Nonce + "\n" +
+		tokenIssuedAtPrefix + c.IssuedAt.UTC().Format(time.RFC3339) + "\n" +
+		tokenExpiresAtPrefix + c.ExpiresAt.UTC().Format(time.RFC3339)
+}
+
+func (c TokenClaims) validate() error {
+	if c.Domain == "" || strings.ContainsAny(c.Domain, " \n") {
+		return fmt.Errorf("invalid token domain %q", c.Domain)
+	}
+	if len(c.Nonce) < minTokenNonceLength || strings.ContainsAny(c.Nonce, " \n") {
+		return fmt.Errorf("invalid token nonce %q: it must be at least %d characters without spaces", c.Nonce, minTokenNonceLength)
+	}
+	if !c.ExpiresAt.After(c.IssuedAt) {
+		return errors.New("token must expire after it is issued")
+	}
+	return nil
+}
+
+// parseTokenMessage parses the message signed by a token.
+func parseTokenMessage(msg string) (TokenClaims, error) {
+	lines := strings.Split(msg, "\n")
+	if len(lines) != 6 || !strings.HasSuffix(lines[0], tokenHeaderSuffix) || lines[2] != "" {
+		return TokenClaims{}, errors.New("invalid token message")
+	}
+
+	fields := make([]string, 3)
+	for i, prefix := range []string{tokenNoncePrefix, tokenIssuedAtPrefix, tokenExpiresAtPrefix} {
+		field, ok := strings.CutPrefix(lines[3+i], prefix)
+		if !ok {
+			return TokenClaims{}, fmt.Errorf("invalid token message: expected %q", strings.TrimSpace(prefix))
+		}
+		fields[i] = field
+	}
+
+	issuedAt, err := time.Parse(time.RFC3339, fields[1])
+	if err != nil {
+		return TokenClaims{}, fmt.Errorf("invalid token issuance time: %w", err)
+	}
+	expiresAt, err := time.Parse(time.RFC3339, fields[2])
+	if err != nil {
+		return TokenClaims{}, fmt.Errorf("invalid token expiration time: %w", err)
+	}
+
+	claims := TokenClaims{
+		Domain:    strings.TrimSuffix(lines[0], tokenHeaderSuffix),
+		Address:   lines[1],
+		Nonce:     fields[0],
+		IssuedAt:  issuedAt,
+		ExpiresAt: expiresAt,
+	}
+	return claims, claims.validate()
+}
@@ -0,0 +1,112 @@
+package offchain
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	clientcontext "cosmossdk.io/client/v2/context"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+func Test_IssueVerifyToken(t *testing.T) {
+	ac := address.NewBech32Codec("cosmos")
+
+	k := keyring.NewInMemory(getCodec())
+	record, err := k.NewAccount("alice", mnemonic, "", "m/44'/118'/0'/0/0", hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	alice, err := ac.BytesToString(addr)
+	require.NoError(t, err)
+	_, _, err = k.NewMnemonic("bob", keyring.English, "m/44'/118'/0'/0/0", keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	autoKeyring, err := keyring.NewAutoCLIKeyring(k, ac)
+	require.NoError(t, err)
+
+	ctx := clientcontext.Context{
+		AddressCodec:          ac,
+		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
+		Cdc:                   getCodec(),
+		Keyring:               autoKeyring,
+	}
+
+	nonce, err := NewTokenNonce()
+	require.NoError(t, err)
+
+	token, err := IssueToken(ctx, mockClientConn{}, "alice", "example.com", nonce, time.Hour, "direct")
+	require.NoError(t, err)
+
+	now := time.Now()
+	claims, err := VerifyToken(ctx, token, "example.com", now)
+	require.NoError(t, err)
+	require.Equal(t, "example.com", claims.Domain)
+	require.Equal(t, alice, claims.Address)
+	require.Equal(t, nonce, claims.Nonce)
+	require.Equal(t, time.Hour, claims.ExpiresAt.Sub(claims.IssuedAt))
+
+	_, err = VerifyToken(ctx, token, "other.com", now)
+	require.ErrorContains(t, err, "token issued to example.com, expected other.com")
+
+	_, err = VerifyToken(ctx, token, "example.com", now.Add(2*time.Hour))
+	require.ErrorContains(t, err, "token expired")
+
+	_, err = VerifyToken(ctx, token, "example.com", now.Add(-time.Hour))
+	require.ErrorContains(t, err, "token issued in the future")
+
+	_, err = VerifyToken(ctx, "not a token", "example.com", now)
+	require.ErrorContains(t, err, "invalid token encoding")
+
+	// the claims of a token must be signed by their address
+	claims.IssuedAt = now.UTC().Truncate(time.Second)
+	signedTx, err := Sign(ctx, []byte(claims.message()), mockClientConn{}, "bob", noEncoder, "direct", "json")
+	require.NoError(t, err)
+	_, err = VerifyToken(ctx, base64.RawURLEncoding.EncodeToString([]byte(signedTx)), "example.com", now)
+	require.ErrorContains(t, err, "token of "+alice+" signed by")
+
+	// any other signed message is not a token
+	signedTx, err = Sign(ctx, []byte("login with nonce 42"), mockClientConn{}, "alice", noEncoder, "direct", "json")
+	require.NoError(t, err)
+	_, err = VerifyToken(ctx, base64.RawURLEncoding.EncodeToString([]byte(signedTx)), "example.com", now)
+	require.ErrorContains(t, err, "invalid token message")
+
+	_, err = IssueToken(ctx, mockClientConn{}, "alice", "example.com", "42", time.Hour, "direct")
+	require.ErrorContains(t, err, "invalid token nonce")
+
+	_, err = IssueToken(ctx, mockClientConn{}, "alice", "example.com", nonce, 0, "direct")
+	require.ErrorContains(t, err, "token must expire after it is issued")
+}
+
+func Test_parseTokenMessage(t *testing.T) {
+	issuedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	claims := TokenClaims{
+		Domain:    "example.com",
+		Address:   "cosmos16877zjk85kwlap3wclpmx34e0xllg2erc7u7m4",
+		Nonce:     "8f4b1c2a9d3e",
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(time.Hour),
+	}
+	require.Equal(t, `example.com wants you to sign in with your Cosmos account:
+cosmos16877zjk85kwlap3wclpmx34e0xllg2erc7u7m4
+
+Nonce: 8f4b1c2a9d3e
+Issued At: 2024-01-01T00:00:00Z
+Expiration Time: 2024-01-01T01:00:00Z`, claims.message())
+
+	parsed, err := parseTokenMessage(claims.message())
+	require.NoError(t, err)
+	require.Equal(t, claims, parsed)
+
+	_, err = parseTokenMessage(claims.message() + "\n")
+	require.ErrorContains(t, err, "invalid token message")
+
+	claims.ExpiresAt = claims.IssuedAt
+	_, err = parseTokenMessage(claims.message())
+	require.ErrorContains(t, err, "token must expire after it is issued")
+}
@@ -6,35 +6,209 @@ import (
 	"errors"
 	"fmt"
 
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	clientcontext "cosmossdk.io/client/v2/context"
+	"cosmossdk.io/client/v2/internal/account"
+	"cosmossdk.io/client/v2/internal/offchain"
 	clitx "cosmossdk.io/client/v2/tx"
 	"cosmossdk.io/core/address"
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
+// SignedData is the content of a verified off-chain message.
+type SignedData struct {
+	// Signer is the address of the signer of the message.
+	Signer string
+	// AppDomain is the application which signed the message.
+	AppDomain string
+	// Data is the signed data, as encoded by the signer.
+	Data string
+}
+
+// AccountVerification is the content of an off-chain message verified against the public key registered on
+// chain by the account of its signer.
+type AccountVerification struct {
+	SignedData
+	// PubKey is the public key registered on chain by the account of the signer.
+	PubKey cryptotypes.PubKey
+	// AccountNumber is the account number of the signer.
+	AccountNumber uint64
+	// Threshold is the number of signatures required by a multisig account, and 0 for other accounts.
+	Threshold uint
+	// MultisigSigners are the addresses of the keys of a multisig account which signed the message.
+	MultisigSigners []string
+}
+
 // Verify verifies a digest after unmarshalling it.
 func Verify(ctx clientcontext.Context, digest []byte, fileFormat string) error {
-	txConfig, err := clitx.NewTxConfig(clitx.ConfigOptions{
-		AddressCodec:          ctx.AddressCodec,
-		Cdc:                   ctx.Cdc,
-		ValidatorAddressCodec: ctx.ValidatorAddressCodec,
-		EnabledSignModes:      enabledSignModes,
-	})
+	_, err := VerifyData(ctx, digest, fileFormat)
+	return err
+}
+
+// VerifyData verifies a digest after unmarshalling it, and returns its signed data. Applications
+// authenticating users with off-chain messages must also check the returned signer and data, e.g. that the
+// data contains a challenge they issued.
+func VerifyData(ctx clientcontext.Context, digest []byte, fileFormat string) (SignedData, error) {
+	txConfig, err := newTxConfig(ctx)
 	if err != nil {
-		return err
+		return SignedData{}, err
 	}
 
 	dTx, err := unmarshal(fileFormat, digest, txConfig)
 	if err != nil {
-		return err
+		return SignedData{}, err
+	}
+
+	data, err := signedData(dTx)
+	if err != nil {
+		return SignedData{}, err
 	}
 
-	return verify(ctx.AddressCodec, txConfig, dTx)
+	return data, verify(ctx.AddressCodec, txConfig, dTx)
+}
+
+// VerifyAccount verifies a digest after unmarshalling it, against the public key registered on chain by the
+// account of the given address, which is queried through conn. Unlike VerifyData, it fails when the message
+// is not signed by the given address, or is signed with another key than the current key of its account,
+// e.g. a key rotated since. Messages of multisig accounts are verified against the threshold of the account.
+func VerifyAccount(
+	ctx clientcontext.Context,
+	conn gogogrpc.ClientConn,
+	address string,
+	digest []byte,
+	fileFormat string,
+) (AccountVerification, error) {
+	accRetriever := account.NewAccountRetriever(ctx.AddressCodec, conn, ctx.Cdc.InterfaceRegistry())
+	return verifyAccount(ctx, accRetriever, address, digest, fileFormat)
+}
+
+func verifyAccount(
+	ctx clientcontext.Context,
+	accRetriever account.AccountRetriever,
+	address string,
+	digest []byte,
+	fileFormat string,
+) (AccountVerification, error) {
+	txConfig, err := newTxConfig(ctx)
+	if err != nil {
+		return AccountVerification{}, err
+	}
+
+	dTx, err := unmarshal(fileFormat, digest, txConfig)
+	if err != nil {
+		return AccountVerification{}, err
+	}
+
+	data, err := signedData(dTx)
+	if err != nil {
+		return AccountVerification{}, err
+	}
+	if data.Signer != address {
+		return AccountVerification{}, fmt.Errorf("message signed by %s, expected %s", data.Signer, address)
+	}
+
+	addr, err := ctx.AddressCodec.StringToBytes(address)
+	if err != nil {
+		return AccountVerification{}, err
+	}
+	acc, err := accRetriever.GetAccount(context.Background(), addr)
+	if err != nil {
+		return AccountVerification{}, fmt.Errorf("cannot get the account of %s: %w", address, err)
+	}
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		return AccountVerification{}, fmt.Errorf("account %s has no public key registered on chain", address)
+	}
+
+	if err := verify(ctx.AddressCodec, txConfig, dTx); err != nil {
+		return AccountVerification{}, err
+	}
+
+	sigs, err := dTx.GetSignatures()
+	if err != nil {
+		return AccountVerification{}, err
+	}
+	if !sigs[0].PubKey.Equals(pubKey) {
+		return AccountVerification{}, fmt.Errorf("message not signed with the public key registered on chain by %s", address)
+	}
+
+	result := AccountVerification{
+		SignedData:    data,
+		PubKey:        pubKey,
+		AccountNumber: acc.GetAccountNumber(),
+	}
+	if multiPK, ok := pubKey.(multisig.PubKey); ok {
+		result.Threshold = multiPK.GetThreshold()
+		result.MultisigSigners, err = multisigSigners(ctx.AddressCodec, multiPK, sigs[0].Data)
+		if err != nil {
+			return AccountVerification{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// multisigSigners returns the addresses of the keys of a multisig public key which signed the signature data.
+func multisigSigners(addressCodec address.Codec, pubKey multisig.PubKey, signatureData clitx.SignatureData) ([]string, error) {
+	data, ok := signatureData.(*clitx.MultiSignatureData)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", (*clitx.MultiSignatureData)(nil), signatureData)
+	}
+	sigData, err := toMultiSignatureData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var signers []string
+	for i, pk := range pubKey.GetPubKeys() {
+		if !sigData.BitArray.GetIndex(i) {
+			continue
+		}
+		signer, err := addressCodec.BytesToString(pk.Address())
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+
+	return signers, nil
+}
+
+// signedData checks that a Tx is an off-chain message as defined by ADR-036, and returns its signed data.
+func signedData(dTx clitx.Tx) (SignedData, error) {
+	txData, err := dTx.GetSigningTxData()
+	if err != nil {
+		return SignedData{}, err
+	}
+
+	if len(txData.Body.Messages) != 1 {
+		return SignedData{}, fmt.Errorf("an off-chain message must contain exactly one message, got %d", len(txData.Body.Messages))
+	}
+	msg := &offchain.MsgSignArbitraryData{}
+	if err := txData.Body.Messages[0].UnmarshalTo(msg); err != nil {
+		return SignedData{}, fmt.Errorf("an off-chain message must contain a MsgSignArbitraryData: %w", err)
+	}
+
+	if txData.Body.Memo != "" || txData.Body.TimeoutHeight != 0 {
+		return SignedData{}, errors.New("an off-chain message must not have a memo or a timeout height")
+	}
+	if fee := txData.AuthInfo.Fee; fee != nil && (len(fee.Amount) != 0 || fee.GasLimit != 0 || fee.Payer != "" || fee.Granter != "") {
+		return SignedData{}, errors.New("an off-chain message must not have a fee")
+	}
+
+	return SignedData{
+		Signer:    msg.Signer,
+		AppDomain: msg.AppDomain,
+		Data:      msg.Data,
+	}, nil
 }
 
 // verify verifies given Tx.
@@ -126,7 +300,53 @@ func verifySignature(
 			return errors.New("unable to verify single signer signature")
 		}
 		return nil
+	case *clitx.MultiSignatureData:
+		multiPK, ok := pubKey.(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), pubKey)
+		}
+		sigData, err := toMultiSignatureData(data)
+		if err != nil {
+			return err
+		}
+		return multiPK.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
+			return handler.GetSignBytes(ctx, apisigning.SignMode(mode), signerData, txData)
+		}, sigData)
 	default:
 		return fmt.Errorf("unexpected SignatureData %T", signatureData)
 	}
 }
+
+// toMultiSignatureData converts multisig signature data to the signature data verified by multisig public keys.
+func toMultiSignatureData(data *clitx.MultiSignatureData) (*signing.MultiSignatureData, error) {
+	if data.BitArray == nil {
+		return nil, errors.New("multisig signature data has no bit array")
+	}
+
+	sigs := make([]signing.SignatureData, len(data.Signatures))
+	for i, sig := range data.Signatures {
+		switch sig := sig.(type) {
+		case *clitx.SingleSignatureData:
+			sigs[i] = &signing.SingleSignatureData{
+				SignMode:  signing.SignMode(sig.SignMode),
+				Signature: sig.Signature,
+			}
+		case *clitx.MultiSignatureData:
+			nested, err := toMultiSignatureData(sig)
+			if err != nil {
+				return nil, err
+			}
+			sigs[i] = nested
+		default:
+			return nil, fmt.Errorf("unexpected SignatureData %T", sig)
+		}
+	}
+
+	return &signing.MultiSignatureData{
+		BitArray: &cryptotypes.CompactBitArray{
+			ExtraBitsStored: data.BitArray.ExtraBitsStored,
+			Elems:           data.BitArray.Elems,
+		},
+		Signatures: sigs,
+	}, nil
+}
@@ -1,17 +1,33 @@
 package offchain
 
 import (
+	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 
+	_ "cosmossdk.io/api/cosmos/crypto/multisig"
+	apimultisig "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
 	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
 	clientcontext "cosmossdk.io/client/v2/context"
-	clitx "cosmossdk.io/client/v2/tx"
+	"cosmossdk.io/client/v2/internal/offchain"
+	txsigning "cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func Test_Verify(t *testing.T) {
@@ -86,12 +102,220 @@ func Test_SignVerify(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_SignVerifyData(t *testing.T) {
+	ac := address.NewBech32Codec("cosmos")
+
+	k := keyring.NewInMemory(getCodec())
+	record, err := k.NewAccount("signVerify", mnemonic, "", "m/44'/118'/0'/0/0", hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	signer, err := ac.BytesToString(addr)
+	require.NoError(t, err)
+
+	autoKeyring, err := keyring.NewAutoCLIKeyring(k, ac)
+	require.NoError(t, err)
+
+	ctx := clientcontext.Context{
+		AddressCodec:          ac,
+		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
+		Cdc:                   getCodec(),
+		Keyring:               autoKeyring,
+	}
+
+	for _, signMode := range []string{"direct", "amino-json", "textual"} {
+		for _, output := range []string{"json", "text"} {
+			t.Run(signMode+" "+output, func(t *testing.T) {
+				tx, err := Sign(ctx, []byte("login with nonce 42"), mockClientConn{}, "signVerify", "no-encoding", signMode, output)
+				require.NoError(t, err)
+
+				data, err := VerifyData(ctx, []byte(tx), output)
+				require.NoError(t, err)
+				require.Equal(t, signer, data.Signer)
+				require.Equal(t, "login with nonce 42", data.Data)
+			})
+		}
+	}
+
+	tx, err := Sign(ctx, []byte("login with nonce 42"), mockClientConn{}, "signVerify", "no-encoding", "direct", "json")
+	require.NoError(t, err)
+
+	// the signed data cannot be changed
+	tampered := strings.Replace(tx, "nonce 42", "nonce 43", 1)
+	_, err = VerifyData(ctx, []byte(tampered), "json")
+	require.ErrorContains(t, err, "unable to verify single signer signature")
+
+	// an off-chain message cannot have a memo or a fee
+	var signedTx map[string]any
+	require.NoError(t, json.Unmarshal([]byte(tx), &signedTx))
+	signedTx["body"].(map[string]any)["memo"] = "memo"
+	bz, err := json.Marshal(signedTx)
+	require.NoError(t, err)
+	_, err = VerifyData(ctx, bz, "json")
+	require.ErrorContains(t, err, "must not have a memo")
+
+	require.NoError(t, json.Unmarshal([]byte(tx), &signedTx))
+	signedTx["auth_info"].(map[string]any)["fee"] = map[string]any{"gas_limit": "200000"}
+	bz, err = json.Marshal(signedTx)
+	require.NoError(t, err)
+	_, err = VerifyData(ctx, bz, "json")
+	require.ErrorContains(t, err, "must not have a fee")
+}
+
+func Test_VerifyAccount(t *testing.T) {
+	ac := address.NewBech32Codec("cosmos")
+
+	k := keyring.NewInMemory(getCodec())
+	record, err := k.NewAccount("signVerify", mnemonic, "", "m/44'/118'/0'/0/0", hd.Secp256k1)
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	signer, err := ac.BytesToString(pubKey.Address())
+	require.NoError(t, err)
+
+	autoKeyring, err := keyring.NewAutoCLIKeyring(k, ac)
+	require.NoError(t, err)
+
+	ctx := clientcontext.Context{
+		AddressCodec:          ac,
+		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
+		Cdc:                   getCodec(),
+		Keyring:               autoKeyring,
+	}
+
+	tx, err := Sign(ctx, []byte("login with nonce 42"), mockClientConn{}, "signVerify", "no-encoding", "direct", "json")
+	require.NoError(t, err)
+
+	accounts := mockAccountRetriever{
+		string(pubKey.Address()): authtypes.NewBaseAccount(pubKey.Address(), pubKey, 7, 1),
+	}
+	res, err := verifyAccount(ctx, accounts, signer, []byte(tx), "json")
+	require.NoError(t, err)
+	require.Equal(t, signer, res.Signer)
+	require.Equal(t, "login with nonce 42", res.Data)
+	require.Equal(t, uint64(7), res.AccountNumber)
+	require.True(t, pubKey.Equals(res.PubKey))
+	require.Zero(t, res.Threshold)
+
+	// the message must be signed by the given address
+	other := secp256k1.GenPrivKey().PubKey()
+	otherAddr, err := ac.BytesToString(other.Address())
+	require.NoError(t, err)
+	_, err = verifyAccount(ctx, accounts, otherAddr, []byte(tx), "json")
+	require.ErrorContains(t, err, "expected "+otherAddr)
+
+	// the account must exist, with a public key
+	_, err = verifyAccount(ctx, mockAccountRetriever{}, signer, []byte(tx), "json")
+	require.ErrorContains(t, err, "account not found")
+	accounts[string(pubKey.Address())] = authtypes.NewBaseAccount(pubKey.Address(), nil, 7, 0)
+	_, err = verifyAccount(ctx, accounts, signer, []byte(tx), "json")
+	require.ErrorContains(t, err, "has no public key registered on chain")
+
+	// the message must be signed with the current key of the account
+	accounts[string(pubKey.Address())] = authtypes.NewBaseAccount(pubKey.Address(), other, 7, 1)
+	_, err = verifyAccount(ctx, accounts, signer, []byte(tx), "json")
+	require.ErrorContains(t, err, "not signed with the public key registered on chain")
+
+	// messages of multisig accounts are verified against the threshold of the account
+	keys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	multiPK, tx2 := signMultisig(t, ctx, keys, 2, 0, 2)
+	multisigAddr, err := ac.BytesToString(multiPK.Address())
+	require.NoError(t, err)
+	accounts[string(multiPK.Address())] = authtypes.NewBaseAccount(multiPK.Address(), multiPK, 8, 1)
+
+	res, err = verifyAccount(ctx, accounts, multisigAddr, tx2, "json")
+	require.NoError(t, err)
+	require.Equal(t, uint(2), res.Threshold)
+	signer0, err := ac.BytesToString(keys[0].PubKey().Address())
+	require.NoError(t, err)
+	signer2, err := ac.BytesToString(keys[2].PubKey().Address())
+	require.NoError(t, err)
+	require.Equal(t, []string{signer0, signer2}, res.MultisigSigners)
+
+	_, tx1 := signMultisig(t, ctx, keys, 2, 1)
+	_, err = verifyAccount(ctx, accounts, multisigAddr, tx1, "json")
+	require.ErrorContains(t, err, "signature size is incorrect")
+}
+
+// signMultisig returns the multisig public key of the given threshold over the public keys of keys, and an
+// off-chain message of the multisig signed in SIGN_MODE_DIRECT by the keys at the signers indexes.
+func signMultisig(t *testing.T, ctx clientcontext.Context, keys []cryptotypes.PrivKey, threshold int, signers ...int) (*kmultisig.LegacyAminoPubKey, []byte) {
+	t.Helper()
+
+	pks := make([]cryptotypes.PubKey, len(keys))
+	for i, key := range keys {
+		pks[i] = key.PubKey()
+	}
+	multiPK := kmultisig.NewLegacyAminoPubKey(threshold, pks)
+	signer, err := ctx.AddressCodec.BytesToString(multiPK.Address())
+	require.NoError(t, err)
+
+	msg, err := anypb.New(&offchain.MsgSignArbitraryData{AppDomain: "<appd>", Signer: signer, Data: "multisig"})
+	require.NoError(t, err)
+	pkAny, err := codectypes.NewAnyWithValue(multiPK)
+	require.NoError(t, err)
+	pubKey := &anypb.Any{TypeUrl: pkAny.TypeUrl, Value: pkAny.Value}
+
+	bitArray := cryptotypes.NewCompactBitArray(len(keys))
+	modeInfos := make([]*apitx.ModeInfo, len(signers))
+	for i, index := range signers {
+		bitArray.SetIndex(index, true)
+		modeInfos[i] = &apitx.ModeInfo{Sum: &apitx.ModeInfo_Single_{
+			Single: &apitx.ModeInfo_Single{Mode: apisigning.SignMode_SIGN_MODE_DIRECT},
+		}}
+	}
+
+	newTx := func(sigs [][]byte) []byte {
+		sig, err := proto.Marshal(&apimultisig.MultiSignature{Signatures: sigs})
+		require.NoError(t, err)
+		bz, err := protojson.Marshal(&apitx.Tx{
+			Body: &apitx.TxBody{Messages: []*anypb.Any{msg}},
+			AuthInfo: &apitx.AuthInfo{
+				SignerInfos: []*apitx.SignerInfo{{
+					PublicKey: pubKey,
+					ModeInfo: &apitx.ModeInfo{Sum: &apitx.ModeInfo_Multi_{Multi: &apitx.ModeInfo_Multi{
+						Bitarray:  &apimultisig.CompactBitArray{ExtraBitsStored: bitArray.ExtraBitsStored, Elems: bitArray.Elems},
+						ModeInfos: modeInfos,
+					}}},
+				}},
+				Fee: &apitx.Fee{},
+			},
+			Signatures: [][]byte{sig},
+		})
+		require.NoError(t, err)
+		return bz
+	}
+
+	// the sign bytes do not depend on the signatures
+	txConfig, err := newTxConfig(ctx)
+	require.NoError(t, err)
+	dTx, err := txConfig.TxJSONDecoder()(newTx(make([][]byte, len(signers))))
+	require.NoError(t, err)
+	txData, err := dTx.GetSigningTxData()
+	require.NoError(t, err)
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(context.Background(), apisigning.SignMode_SIGN_MODE_DIRECT, txsigning.SignerData{
+		ChainID:       ExpectedChainID,
+		AccountNumber: ExpectedAccountNumber,
+		Sequence:      ExpectedSequence,
+		Address:       signer,
+		PubKey:        pubKey,
+	}, txData)
+	require.NoError(t, err)
+
+	sigs := make([][]byte, len(signers))
+	for i, index := range signers {
+		sigs[i], err = keys[index].Sign(signBytes)
+		require.NoError(t, err)
+	}
+
+	return multiPK, newTx(sigs)
+}
+
 func Test_unmarshal(t *testing.T) {
-	txConfig, err := clitx.NewTxConfig(clitx.ConfigOptions{
+	txConfig, err := newTxConfig(clientcontext.Context{
 		AddressCodec:          address.NewBech32Codec("cosmos"),
 		Cdc:                   getCodec(),
 		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
-		EnabledSignModes:      enabledSignModes,
 	})
 	require.NoError(t, err)
 	tests := []struct {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/cosmos/go-bip39"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -44,6 +45,8 @@ type Factory struct {
 	txConfig         TxConfig
 	txParams         TxParameters
 
+	feeGrantRetriever FeeGrantRetriever
+
 	tx *txState
 }
 
@@ -65,6 +68,13 @@ func NewFactoryFromFlagSet(flags *pflag.FlagSet, keybase keyring.Keyring, cdc co
 		return Factory{}, err
 	}
 
+	if isLedgerKey(keybase, params.FromName) {
+		params.SignMode, err = ledgerSignMode(params.SignMode, txConfig.SignModeHandler().SupportedModes())
+		if err != nil {
+			return Factory{}, err
+		}
+	}
+
 	return NewFactory(keybase, cdc, accRetriever, txConfig, ac, conn, params)
 }
 
@@ -81,6 +91,8 @@ func NewFactory(keybase keyring.Keyring, cdc codec.BinaryCodec, accRetriever acc
 		txConfig:         txConfig,
 		txParams:         parameters,
 
+		feeGrantRetriever: NewFeeGrantRetriever(ac, conn),
+
 		tx: &txState{},
 	}, nil
 }
@@ -108,6 +120,11 @@ func validateFlagSet(flags *pflag.FlagSet, offline bool) error {
 		if gasSetting.Simulate {
 			return errors.New("simulate and offline flags cannot be set at the same time")
 		}
+
+		feeGranter, _ := flags.GetString(flags2.FlagFeeGranter)
+		if feeGranter == FeeGranterAuto {
+			return errors.New("fee granter cannot be selected automatically in offline mode")
+		}
 	} else if chainID == "" {
 		return errors.New("chain ID required but not specified")
 	}
@@ -192,7 +209,15 @@ func (f *Factory) BuildUnsignedTx(msgs ...transaction.Msg) error {
 	f.tx.unordered = f.txParams.unordered
 	f.tx.timeoutTimestamp = f.txParams.timeoutTimestamp
 
-	err = f.setFeeGranter(f.txParams.feeGranter)
+	feeGranter := f.txParams.feeGranter
+	if feeGranter == FeeGranterAuto {
+		feeGranter, err = f.selectFeeGranter(msgs, fees)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = f.setFeeGranter(feeGranter)
 	if err != nil {
 		return err
 	}
@@ -331,16 +356,71 @@ func (f *Factory) sign(ctx context.Context, overwriteSig bool) (Tx, error) {
 		f.txParams.SignMode = f.txConfig.SignModeHandler().DefaultMode()
 	}
 
+	// watch-only keys cannot sign, their sign bytes are signed externally
+	if isWatchOnlyKey(f.keybase, f.txParams.FromName) {
+		return nil, fmt.Errorf("%s is a watch-only key and cannot sign: generate the sign bytes of the transaction and sign them externally", f.txParams.FromName)
+	}
+
 	pubKey, err := f.keybase.GetPubKey(f.txParams.FromName)
 	if err != nil {
 		return nil, err
 	}
 
-	addr, err := f.ac.BytesToString(pubKey.Address())
+	bytesToSign, prevSignatures, err := f.signBytes(ctx, pubKey, overwriteSig)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.txParams.SignMode == apitxsigning.SignMode_SIGN_MODE_TEXTUAL && isLedgerKey(f.keybase, f.txParams.FromName) {
+		review, err := ledgerReview(bytesToSign)
+		if err != nil {
+			return nil, err
+		}
+		if f.txParams.LedgerReview != nil {
+			f.txParams.LedgerReview(review)
+		}
+	}
+
+	// Sign those bytes
+	sigBytes, err := f.keybase.Sign(f.txParams.FromName, bytesToSign, f.txParams.SignMode)
 	if err != nil {
 		return nil, err
 	}
 
+	// Construct the SignatureV2 struct
+	sigData := SingleSignatureData{
+		SignMode:  f.signMode(),
+		Signature: sigBytes,
+	}
+	sig := Signature{
+		PubKey:   pubKey,
+		Data:     &sigData,
+		Sequence: f.txParams.Sequence,
+	}
+
+	if overwriteSig {
+		err = f.setSignatures(sig)
+	} else {
+		prevSignatures = append(prevSignatures, sig)
+		err = f.setSignatures(prevSignatures...)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to set signatures on payload: %w", err)
+	}
+
+	return f.getTx()
+}
+
+// signBytes sets the signer info of the given public key on the transaction, with an empty signature, and
+// returns the bytes to sign along with the previous signatures of the transaction, which are kept unless
+// overwriteSig is set.
+func (f *Factory) signBytes(ctx context.Context, pubKey cryptotypes.PubKey, overwriteSig bool) ([]byte, []Signature, error) {
+	addr, err := f.ac.BytesToString(pubKey.Address())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	signerData := signing.SignerData{
 		ChainID:       f.txParams.ChainID,
 		AccountNumber: f.txParams.AccountNumber,
@@ -376,12 +456,12 @@ func (f *Factory) sign(ctx context.Context, overwriteSig bool) (Tx, error) {
 	if !overwriteSig {
 		tx, err := f.getTx()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		prevSignatures, err = tx.GetSignatures()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 	// Overwrite or append signer infos.
@@ -393,52 +473,24 @@ func (f *Factory) sign(ctx context.Context, overwriteSig bool) (Tx, error) {
 		sigs = append(sigs, sig)
 	}
 	if err := f.setSignatures(sigs...); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	tx, err := f.getTx()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err := checkMultipleSigners(tx); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	bytesToSign, err := f.getSignBytesAdapter(ctx, signerData)
 	if err != nil {
-		return nil, err
-	}
-
-	// Sign those bytes
-	sigBytes, err := f.keybase.Sign(f.txParams.FromName, bytesToSign, f.txParams.SignMode)
-	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Construct the SignatureV2 struct
-	sigData = SingleSignatureData{
-		SignMode:  f.signMode(),
-		Signature: sigBytes,
-	}
-	sig = Signature{
-		PubKey:   pubKey,
-		Data:     &sigData,
-		Sequence: f.txParams.Sequence,
-	}
-
-	if overwriteSig {
-		err = f.setSignatures(sig)
-	} else {
-		prevSignatures = append(prevSignatures, sig)
-		err = f.setSignatures(prevSignatures...)
-	}
-
-	if err != nil {
-		return nil, fmt.Errorf("unable to set signatures on payload: %w", err)
-	}
-
-	return f.getTx()
+	return bytesToSign, prevSignatures, nil
 }
 
 // getSignBytesAdapter returns the sign bytes for a given transaction and sign mode.
@@ -485,29 +537,27 @@ func (f *Factory) signMode() apitxsigning.SignMode { return f.txParams.SignMode
 // When using --dry-run, we are is simulation mode only and should not check the keybase.
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/11283
 func (f *Factory) getSimPK() (cryptotypes.PubKey, error) {
-	var (
-		err error
-		pk  cryptotypes.PubKey = &secp256k1.PubKey{}
-	)
-
 	if f.txParams.simulateAndExecute && f.keybase != nil {
-		pk, err = f.keybase.GetPubKey(f.txParams.FromName)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		// When in dry-run mode, attempt to retrieve the account using the provided address.
-		// If the account retrieval fails, the default public key is used.
-		acc, err := f.accountRetriever.GetAccount(context.Background(), f.txParams.Address)
-		if err != nil {
-			// If there is an error retrieving the account, return the default public key.
+		pk, err := f.keybase.GetPubKey(f.txParams.FromName)
+		if err == nil {
 			return pk, nil
 		}
-		// If the account is successfully retrieved, use its public key.
-		pk = acc.GetPubKey()
+		// watch-only keys created from an address have no public key, the one of their account is used instead
+		if !isWatchOnlyKey(f.keybase, f.txParams.FromName) {
+			return nil, err
+		}
+	}
+
+	// When in dry-run mode, attempt to retrieve the account using the provided address.
+	// If the account retrieval fails, the default public key is used.
+	acc, err := f.accountRetriever.GetAccount(context.Background(), f.txParams.Address)
+	if err != nil || acc.GetPubKey() == nil {
+		// If there is an error retrieving the account, or it has no public key yet, return the default public key.
+		return &secp256k1.PubKey{}, nil
 	}
 
-	return pk, nil
+	// If the account is successfully retrieved, use its public key.
+	return acc.GetPubKey(), nil
 }
 
 // getSimSignatureData based on the pubKey type gets the correct SignatureData type
@@ -703,6 +753,26 @@ func (f *Factory) setFeeGranter(feeGranter string) error {
 	return nil
 }
 
+// selectFeeGranter selects the granter of the grant of the fee payer which covers the fees and
+// has the largest remaining allowance. See SelectFeeGranter.
+func (f *Factory) selectFeeGranter(msgs []transaction.Msg, fees []*base.Coin) (string, error) {
+	grantee := f.txParams.Address
+	if f.txParams.feePayer != "" {
+		addr, err := f.ac.StringToBytes(f.txParams.feePayer)
+		if err != nil {
+			return "", err
+		}
+		grantee = addr
+	}
+
+	grants, err := f.feeGrantRetriever.GetAllowances(context.Background(), grantee)
+	if err != nil {
+		return "", fmt.Errorf("failed to query fee grants: %w", err)
+	}
+
+	return SelectFeeGranter(grants, fees, msgs, time.Now())
+}
+
 // msgsV1toAnyV2 converts a slice of transaction.Msg (v1) to a slice of anypb.Any (v2).
 // It first converts each transaction.Msg into a codectypes.Any and then converts
 // these into anypb.Any.
@@ -0,0 +1,243 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	base "cosmossdk.io/api/cosmos/base/v1beta1"
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// FeeGranterAuto is the fee granter value which makes the Factory select the fee granter
+// among the grants of the fee payer.
+const FeeGranterAuto = "auto"
+
+var _ FeeGrantRetriever = feeGrantRetriever{}
+
+// FeeGrantRetriever defines methods required to retrieve the fee grants of an account.
+type FeeGrantRetriever interface {
+	// GetAllowances returns all the grants given to the grantee.
+	GetAllowances(ctx context.Context, grantee []byte) ([]*feegrantv1beta1.Grant, error)
+}
+
+type feeGrantRetriever struct {
+	ac   address.Codec
+	conn gogogrpc.ClientConn
+}
+
+// NewFeeGrantRetriever creates a new FeeGrantRetriever querying the feegrant module.
+func NewFeeGrantRetriever(ac address.Codec, conn gogogrpc.ClientConn) FeeGrantRetriever {
+	return feeGrantRetriever{
+		ac:   ac,
+		conn: conn,
+	}
+}
+
+// GetAllowances queries all the pages of the grants given to the grantee.
+func (r feeGrantRetriever) GetAllowances(ctx context.Context, grantee []byte) ([]*feegrantv1beta1.Grant, error) {
+	granteeStr, err := r.ac.BytesToString(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	qc := feegrantv1beta1.NewQueryClient(r.conn)
+
+	var (
+		grants  []*feegrantv1beta1.Grant
+		nextKey []byte
+	)
+	for {
+		res, err := qc.Allowances(ctx, &feegrantv1beta1.QueryAllowancesRequest{
+			Grantee:    granteeStr,
+			Pagination: &queryv1beta1.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		grants = append(grants, res.Allowances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return grants, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// SelectFeeGranter returns the granter of the grant which covers the fees of a transaction with
+// the given messages and has the largest remaining allowance in the denom of the first fee coin.
+// Grants without a spend limit are preferred over any other grant.
+// Only BasicAllowance, PeriodicAllowance and AllowedMsgAllowance are evaluated, grants with
+// other allowances are skipped.
+func SelectFeeGranter(grants []*feegrantv1beta1.Grant, fees []*base.Coin, msgs []transaction.Msg, now time.Time) (string, error) {
+	msgTypeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypeURLs[i] = codectypes.MsgTypeURL(msg)
+	}
+
+	var denom string
+	if len(fees) > 0 {
+		denom = fees[0].Denom
+	}
+
+	var (
+		granter   string
+		bestLimit spendLimit
+		found     bool
+	)
+	for _, grant := range grants {
+		limit, ok := allowanceSpendLimit(grant.Allowance, msgTypeURLs, now)
+		if !ok || !limit.covers(fees) {
+			continue
+		}
+
+		if !found || limit.greater(bestLimit, denom) {
+			granter, bestLimit, found = grant.Granter, limit, true
+		}
+	}
+
+	if !found {
+		return "", errors.New("no fee grant covers the fees of the transaction")
+	}
+
+	return granter, nil
+}
+
+// spendLimit is the amount which can still be spent with an allowance per denom.
+// A nil spendLimit is unlimited.
+type spendLimit map[string]math.Int
+
+// newSpendLimit converts coins into a spendLimit, which is only unlimited if unlimited is set
+// and there are no coins.
+func newSpendLimit(coins []*base.Coin, unlimited bool) (spendLimit, bool) {
+	if len(coins) == 0 && unlimited {
+		return nil, true
+	}
+
+	limit := make(spendLimit, len(coins))
+	for _, coin := range coins {
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, false
+		}
+		limit[coin.Denom] = amount
+	}
+
+	return limit, true
+}
+
+// amountOf returns the amount which can still be spent in denom.
+func (l spendLimit) amountOf(denom string) math.Int {
+	if amount, ok := l[denom]; ok {
+		return amount
+	}
+	return math.ZeroInt()
+}
+
+// min returns the amounts which can be spent within both spend limits.
+func (l spendLimit) min(other spendLimit) spendLimit {
+	if l == nil {
+		return other
+	}
+	if other == nil {
+		return l
+	}
+
+	limit := make(spendLimit, len(l))
+	for denom, amount := range l {
+		limit[denom] = math.MinInt(amount, other.amountOf(denom))
+	}
+	return limit
+}
+
+// covers returns true if the fees can be paid within the spend limit.
+func (l spendLimit) covers(fees []*base.Coin) bool {
+	if l == nil {
+		return true
+	}
+
+	for _, fee := range fees {
+		amount, ok := math.NewIntFromString(fee.Amount)
+		if !ok || amount.GT(l.amountOf(fee.Denom)) {
+			return false
+		}
+	}
+	return true
+}
+
+// greater returns true if more can be spent in denom within the spend limit than within other.
+func (l spendLimit) greater(other spendLimit, denom string) bool {
+	if l == nil || other == nil {
+		return l == nil && other != nil
+	}
+	return l.amountOf(denom).GT(other.amountOf(denom))
+}
+
+// allowanceSpendLimit returns the spend limit of an allowance for a transaction with the given
+// message types, and false if the allowance cannot be used for such a transaction.
+func allowanceSpendLimit(allowanceAny *anypb.Any, msgTypeURLs []string, now time.Time) (spendLimit, bool) {
+	if allowanceAny == nil {
+		return nil, false
+	}
+
+	allowance, err := allowanceAny.UnmarshalNew()
+	if err != nil {
+		return nil, false
+	}
+
+	switch allowance := allowance.(type) {
+	case *feegrantv1beta1.BasicAllowance:
+		return basicSpendLimit(allowance, now)
+
+	case *feegrantv1beta1.PeriodicAllowance:
+		basic, ok := basicSpendLimit(allowance.Basic, now)
+		if !ok {
+			return nil, false
+		}
+
+		// the period is reset by the next transaction once the reset time is reached
+		periodCanSpend := allowance.PeriodCanSpend
+		if allowance.PeriodReset != nil && !now.Before(allowance.PeriodReset.AsTime()) {
+			periodCanSpend = allowance.PeriodSpendLimit
+		}
+
+		period, ok := newSpendLimit(periodCanSpend, false)
+		if !ok {
+			return nil, false
+		}
+		return basic.min(period), true
+
+	case *feegrantv1beta1.AllowedMsgAllowance:
+		for _, typeURL := range msgTypeURLs {
+			if !slices.Contains(allowance.AllowedMessages, typeURL) {
+				return nil, false
+			}
+		}
+		return allowanceSpendLimit(allowance.Allowance, msgTypeURLs, now)
+
+	default:
+		return nil, false
+	}
+}
+
+// basicSpendLimit returns the spend limit of a BasicAllowance, and false if it has expired.
+func basicSpendLimit(allowance *feegrantv1beta1.BasicAllowance, now time.Time) (spendLimit, bool) {
+	if allowance == nil {
+		return nil, true
+	}
+	if allowance.Expiration != nil && !now.Before(allowance.Expiration.AsTime()) {
+		return nil, false
+	}
+
+	return newSpendLimit(allowance.SpendLimit, true)
+}
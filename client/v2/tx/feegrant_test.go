@@ -0,0 +1,212 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	base "cosmossdk.io/api/cosmos/base/v1beta1"
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+	"cosmossdk.io/core/transaction"
+
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+)
+
+type mockFeeGrantRetriever struct {
+	grants []*feegrantv1beta1.Grant
+	err    error
+}
+
+func (m mockFeeGrantRetriever) GetAllowances(_ context.Context, _ []byte) ([]*feegrantv1beta1.Grant, error) {
+	return m.grants, m.err
+}
+
+func newGrant(t *testing.T, granter string, allowance proto.Message) *feegrantv1beta1.Grant {
+	t.Helper()
+	allowanceAny, err := anypb.New(allowance)
+	require.NoError(t, err)
+	return &feegrantv1beta1.Grant{Granter: granter, Grantee: signer, Allowance: allowanceAny}
+}
+
+func TestSelectFeeGranter(t *testing.T) {
+	now := time.Now()
+	past := timestamppb.New(now.Add(-time.Hour))
+	future := timestamppb.New(now.Add(time.Hour))
+	stake := func(amount string) []*base.Coin { return []*base.Coin{{Denom: "stake", Amount: amount}} }
+	msgs := []transaction.Msg{&countertypes.MsgIncreaseCounter{Signer: signer}}
+	counterURL := "/cosmos.counter.v1.MsgIncreaseCounter"
+
+	tests := []struct {
+		name    string
+		grants  []*feegrantv1beta1.Grant
+		fees    []*base.Coin
+		granter string
+		error   bool
+	}{
+		{
+			name:  "no grants",
+			fees:  stake("10"),
+			error: true,
+		},
+		{
+			name: "largest remaining spend limit",
+			grants: []*feegrantv1beta1.Grant{
+				newGrant(t, "granter1", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("100")}),
+				newGrant(t, "granter2", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("500")}),
+				newGrant(t, "granter3", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("200")}),
+			},
+			fees:    stake("10"),
+			granter: "granter2",
+		},
+		{
+			name: "unlimited grant is preferred",
+			grants: []*feegrantv1beta1.Grant{
+				newGrant(t, "granter1", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("500")}),
+				newGrant(t, "granter2", &feegrantv1beta1.BasicAllowance{}),
+			},
+			fees:    stake("10"),
+			granter: "granter2",
+		},
+		{
+			name: "matching denom",
+			grants: []*feegrantv1beta1.Grant{
+				newGrant(t, "granter1", &feegrantv1beta1.BasicAllowance{SpendLimit: []*base.Coin{{Denom: "atom", Amount: "500"}}}),
+				newGrant(t, "granter2", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("20")}),
+			},
+			fees:    stake("10"),
+			granter: "granter2",
+		},
+		{
+			name: "expired and too small grants are skipped",
+			grants: []*feegrantv1beta1.Grant{
+				newGrant(t, "granter1", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("500"), Expiration: past}),
+				newGrant(t, "granter2", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("5")}),
+				newGrant(t, "granter3", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("20"), Expiration: future}),
+			},
+			fees:    stake("10"),
+			granter: "granter3",
+		},
+		{
+			name: "periodic allowance limited by the current period",
+			grants: []*feegrantv1beta1.Grant{
+				newGrant(t, "granter1", &feegrantv1beta1.PeriodicAllowance{
+					Basic:            &feegrantv1beta1.BasicAllowance{SpendLimit: stake("1000")},
+					PeriodSpendLimit: stake("100"),
+					PeriodCanSpend:   stake("5"),
+					PeriodReset:      future,
+				}),
+				newGrant(t, "granter2", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("50")}),
+			},
+			fees:    stake("10"),
+			granter: "granter2",
+		},
+		{
+			name: "periodic allowance reset",
+			grants: []*feegrantv1beta1.Grant{
+				newGrant(t, "granter1", &feegrantv1beta1.PeriodicAllowance{
+					Basic:            &feegrantv1beta1.BasicAllowance{SpendLimit: stake("1000")},
+					PeriodSpendLimit: stake("100"),
+					PeriodCanSpend:   stake("5"),
+					PeriodReset:      past,
+				}),
+				newGrant(t, "granter2", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("50")}),
+			},
+			fees:    stake("10"),
+			granter: "granter1",
+		},
+		{
+			name: "allowed messages",
+			grants: []*feegrantv1beta1.Grant{
+				newGrant(t, "granter1", &feegrantv1beta1.AllowedMsgAllowance{
+					Allowance:       newGrant(t, "", &feegrantv1beta1.BasicAllowance{}).Allowance,
+					AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend"},
+				}),
+				newGrant(t, "granter2", &feegrantv1beta1.AllowedMsgAllowance{
+					Allowance:       newGrant(t, "", &feegrantv1beta1.BasicAllowance{SpendLimit: stake("50")}).Allowance,
+					AllowedMessages: []string{counterURL},
+				}),
+			},
+			fees:    stake("10"),
+			granter: "granter2",
+		},
+		{
+			name: "unsupported allowance is skipped",
+			grants: []*feegrantv1beta1.Grant{
+				newGrant(t, "granter1", &feegrantv1beta1.Grant{}),
+			},
+			fees:  stake("10"),
+			error: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			granter, err := SelectFeeGranter(tt.grants, tt.fees, msgs, now)
+			if tt.error {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.granter, granter)
+		})
+	}
+}
+
+func TestFactory_BuildUnsignedTxAutoFeeGranter(t *testing.T) {
+	granter := "cosmos1c8yy7vvrq24vlxs5sqgu7agvk2vn2rlw4z5pd2"
+	msgs := []transaction.Msg{&countertypes.MsgIncreaseCounter{Signer: signer}}
+	params := TxParameters{
+		ChainID:       "demo",
+		AccountConfig: AccountConfig{Address: addr},
+		FeeConfig: FeeConfig{
+			fees:       []*base.Coin{{Denom: "stake", Amount: "10"}},
+			feeGranter: FeeGranterAuto,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		retriever FeeGrantRetriever
+		error     bool
+	}{
+		{
+			name: "grant found",
+			retriever: mockFeeGrantRetriever{grants: []*feegrantv1beta1.Grant{
+				newGrant(t, granter, &feegrantv1beta1.BasicAllowance{}),
+			}},
+		},
+		{
+			name:      "no grant",
+			retriever: mockFeeGrantRetriever{},
+			error:     true,
+		},
+		{
+			name:      "query error",
+			retriever: mockFeeGrantRetriever{err: errors.New("unavailable")},
+			error:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFactory(keybase, cdc, mockAccountRetriever{}, txConf, ac, mockClientConn{}, params)
+			require.NoError(t, err)
+			f.feeGrantRetriever = tt.retriever
+
+			err = f.BuildUnsignedTx(msgs...)
+			if tt.error {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			fee, err := f.getFee()
+			require.NoError(t, err)
+			require.Equal(t, granter, fee.Granter)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package tx
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/client/v2/autocli/keyring"
+
+	sdkkeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	// ledgerChunkSize is the size of the chunks in which the Cosmos Ledger app receives the payload to sign.
+	ledgerChunkSize = 250
+	// ledgerMaxIndent is the maximum indentation of a SIGN_MODE_TEXTUAL screen which Ledger devices can render.
+	ledgerMaxIndent = 15
+)
+
+// isLedgerKey returns whether the key of the given name is a reference to a key of a Ledger device.
+func isLedgerKey(keybase keyring.Keyring, name string) bool {
+	if keybase == nil || name == "" {
+		return false
+	}
+
+	keyType, err := keybase.KeyType(name)
+	return err == nil && keyType == uint(sdkkeyring.TypeLedger)
+}
+
+// ledgerSignMode returns the sign mode with which a Ledger key signs. Ledger devices cannot sign in
+// SIGN_MODE_DIRECT, so Ledger keys sign in SIGN_MODE_TEXTUAL when it is enabled, and fall back to
+// SIGN_MODE_LEGACY_AMINO_JSON otherwise.
+func ledgerSignMode(signMode apitxsigning.SignMode, enabled []apitxsigning.SignMode) (apitxsigning.SignMode, error) {
+	textualEnabled := slices.Contains(enabled, apitxsigning.SignMode_SIGN_MODE_TEXTUAL)
+
+	switch signMode {
+	case apitxsigning.SignMode_SIGN_MODE_TEXTUAL:
+		if !textualEnabled {
+			return signMode, errors.New("SIGN_MODE_TEXTUAL is not available")
+		}
+		return signMode, nil
+	case apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+		return signMode, nil
+	case apitxsigning.SignMode_SIGN_MODE_UNSPECIFIED, apitxsigning.SignMode_SIGN_MODE_DIRECT:
+		if textualEnabled {
+			return apitxsigning.SignMode_SIGN_MODE_TEXTUAL, nil
+		}
+		return apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+	default:
+		return signMode, fmt.Errorf("%s is not supported by Ledger devices", signMode)
+	}
+}
+
+// ledgerReview returns the message shown before signing SIGN_MODE_TEXTUAL sign bytes with a Ledger device, so
+// that users know what they are about to review on the device. It fails if the device cannot render the screens.
+func ledgerReview(signBytes []byte) (string, error) {
+	screens, err := decodeTextualScreens(signBytes)
+	if err != nil {
+		return "", err
+	}
+
+	expert := 0
+	for i, s := range screens {
+		if s.Indent > ledgerMaxIndent {
+			return "", fmt.Errorf("screen %d cannot be rendered by Ledger devices: indentation %d exceeds %d", i, s.Indent, ledgerMaxIndent)
+		}
+		if s.Expert {
+			expert++
+		}
+	}
+
+	chunks := (len(signBytes) + ledgerChunkSize - 1) / ledgerChunkSize
+	return fmt.Sprintf("Review the transaction on your Ledger device: %d screens, %d more in expert mode (%d bytes sent in %d chunks).",
+		len(screens)-expert, expert, len(signBytes), chunks), nil
+}
+
+// textualScreen is a screen of a SIGN_MODE_TEXTUAL sign doc, as rendered by signing devices.
+type textualScreen struct {
+	Title   string
+	Content string
+	Indent  uint64
+	Expert  bool
+}
+
+// decodeTextualScreens decodes the screens of SIGN_MODE_TEXTUAL sign bytes, a CBOR map holding the screens
+// under key 1, each screen being a CBOR map of its title (1), content (2), indentation (3) and expert flag (4),
+// as specified by ADR-050.
+func decodeTextualScreens(bz []byte) ([]textualScreen, error) {
+	r := &cborReader{bz: bz}
+	n, err := r.head(cborMap)
+	if err != nil {
+		return nil, err
+	}
+
+	var screens []textualScreen
+	for i := uint64(0); i < n; i++ {
+		key, err := r.head(cborUint)
+		if err != nil {
+			return nil, err
+		}
+		if key != 1 {
+			return nil, fmt.Errorf("textual: unexpected sign doc key %d", key)
+		}
+
+		count, err := r.head(cborArray)
+		if err != nil {
+			return nil, err
+		}
+		for j := uint64(0); j < count; j++ {
+			screen, err := r.screen()
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, screen)
+		}
+	}
+
+	if r.pos != len(bz) {
+		return nil, errors.New("textual: trailing bytes after sign doc")
+	}
+
+	return screens, nil
+}
+
+// CBOR major types used by SIGN_MODE_TEXTUAL sign docs.
+const (
+	cborUint   = 0
+	cborText   = 3
+	cborArray  = 4
+	cborMap    = 5
+	cborSimple = 7
+
+	cborFalse = 20
+	cborTrue  = 21
+)
+
+// cborReader reads the subset of CBOR used by SIGN_MODE_TEXTUAL sign docs.
+type cborReader struct {
+	bz  []byte
+	pos int
+}
+
+// head reads the head of a data item of the given major type, and returns its argument.
+func (r *cborReader) head(major byte) (uint64, error) {
+	if r.pos >= len(r.bz) {
+		return 0, errors.New("textual: unexpected end of sign doc")
+	}
+
+	b := r.bz[r.pos]
+	r.pos++
+	if b>>5 != major {
+		return 0, fmt.Errorf("textual: expected cbor major type %d, got %d", major, b>>5)
+	}
+
+	info := b & 0x1f
+	if info < 24 {
+		return uint64(info), nil
+	}
+	if info > 27 {
+		return 0, fmt.Errorf("textual: unsupported cbor additional info %d", info)
+	}
+
+	size := 1 << (info - 24)
+	if r.pos+size > len(r.bz) {
+		return 0, errors.New("textual: unexpected end of sign doc")
+	}
+	buf := make([]byte, 8)
+	copy(buf[8-size:], r.bz[r.pos:r.pos+size])
+	r.pos += size
+	return binary.BigEndian.Uint64(buf), nil
+}
+
+func (r *cborReader) text() (string, error) {
+	n, err := r.head(cborText)
+	if err != nil {
+		return "", err
+	}
+	if n > uint64(len(r.bz)-r.pos) {
+		return "", errors.New("textual: unexpected end of sign doc")
+	}
+
+	s := string(r.bz[r.pos : r.pos+int(n)])
+	r.pos += int(n)
+	return s, nil
+}
+
+func (r *cborReader) screen() (textualScreen, error) {
+	var s textualScreen
+	n, err := r.head(cborMap)
+	if err != nil {
+		return s, err
+	}
+
+	for i := uint64(0); i < n; i++ {
+		key, err := r.head(cborUint)
+		if err != nil {
+			return s, err
+		}
+
+		switch key {
+		case 1:
+			s.Title, err = r.text()
+		case 2:
+			s.Content, err = r.text()
+		case 3:
+			s.Indent, err = r.head(cborUint)
+		case 4:
+			var v uint64
+			v, err = r.head(cborSimple)
+			if err == nil && v != cborFalse && v != cborTrue {
+				err = fmt.Errorf("textual: expected cbor bool, got simple value %d", v)
+			}
+			s.Expert = v == cborTrue
+		default:
+			err = fmt.Errorf("textual: unexpected screen key %d", key)
+		}
+		if err != nil {
+			return s, err
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,166 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+)
+
+// textualSignDoc is a sign doc of three screens, the last one being an expert screen:
+// {1: [{1: "Chain id", 2: "my-chain"}, {2: "Message", 3: 1}, {1: "Hash of raw bytes", 2: "x"*30, 4: true}]}
+const textualSignDoc = "a10183a20168436861696e20696402686d792d636861696ea202674d6573736167650301a3017148617368206f662072617720627974657302781e78787878787878787878787878787878787878787878787878787878787804f5"
+
+func Test_ledgerSignMode(t *testing.T) {
+	withTextual := []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_DIRECT, apitxsigning.SignMode_SIGN_MODE_TEXTUAL}
+	withoutTextual := []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_DIRECT, apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+
+	tests := []struct {
+		name     string
+		signMode apitxsigning.SignMode
+		enabled  []apitxsigning.SignMode
+		want     apitxsigning.SignMode
+		error    string
+	}{
+		{
+			name:     "default to textual",
+			signMode: apitxsigning.SignMode_SIGN_MODE_UNSPECIFIED,
+			enabled:  withTextual,
+			want:     apitxsigning.SignMode_SIGN_MODE_TEXTUAL,
+		},
+		{
+			name:     "direct to textual",
+			signMode: apitxsigning.SignMode_SIGN_MODE_DIRECT,
+			enabled:  withTextual,
+			want:     apitxsigning.SignMode_SIGN_MODE_TEXTUAL,
+		},
+		{
+			name:     "direct to amino-json without textual",
+			signMode: apitxsigning.SignMode_SIGN_MODE_DIRECT,
+			enabled:  withoutTextual,
+			want:     apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+		{
+			name:     "amino-json",
+			signMode: apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			enabled:  withTextual,
+			want:     apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+		{
+			name:     "textual not enabled",
+			signMode: apitxsigning.SignMode_SIGN_MODE_TEXTUAL,
+			enabled:  withoutTextual,
+			error:    "SIGN_MODE_TEXTUAL is not available",
+		},
+		{
+			name:     "direct-aux",
+			signMode: apitxsigning.SignMode_SIGN_MODE_DIRECT_AUX,
+			enabled:  withTextual,
+			error:    "SIGN_MODE_DIRECT_AUX is not supported by Ledger devices",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ledgerSignMode(tt.signMode, tt.enabled)
+			if tt.error != "" {
+				require.ErrorContains(t, err, tt.error)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_decodeTextualScreens(t *testing.T) {
+	bz, err := hex.DecodeString(textualSignDoc)
+	require.NoError(t, err)
+
+	screens, err := decodeTextualScreens(bz)
+	require.NoError(t, err)
+	require.Equal(t, []textualScreen{
+		{Title: "Chain id", Content: "my-chain"},
+		{Content: "Message", Indent: 1},
+		{Title: "Hash of raw bytes", Content: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxx", Expert: true},
+	}, screens)
+
+	_, err = decodeTextualScreens(bz[:len(bz)-1])
+	require.ErrorContains(t, err, "unexpected end of sign doc")
+
+	_, err = decodeTextualScreens(append(bz, 0))
+	require.ErrorContains(t, err, "trailing bytes")
+
+	_, err = decodeTextualScreens([]byte(`{"screens":[]}`))
+	require.ErrorContains(t, err, "expected cbor major type 5")
+
+	review, err := ledgerReview(bz)
+	require.NoError(t, err)
+	require.Equal(t, "Review the transaction on your Ledger device: 2 screens, 1 more in expert mode (91 bytes sent in 1 chunks).", review)
+
+	// {1: [{2: "Message", 3: 16}]}
+	bz, err = hex.DecodeString("a10181a202674d6573736167650310")
+	require.NoError(t, err)
+	_, err = ledgerReview(bz)
+	require.ErrorContains(t, err, "indentation 16 exceeds 15")
+}
+
+func Test_ledgerReviewTextualSignBytes(t *testing.T) {
+	conf, err := NewTxConfig(ConfigOptions{
+		AddressCodec:          ac,
+		Cdc:                   cdc,
+		ValidatorAddressCodec: valCodec,
+		EnabledSignModes:      []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_TEXTUAL},
+		TextualCoinMetadataQueryFn: func(context.Context, string) (*bankv1beta1.Metadata, error) {
+			return nil, nil
+		},
+	})
+	require.NoError(t, err)
+
+	f, err := NewFactory(setKeyring(), cdc, mockAccountRetriever{}, conf, ac, mockClientConn{}, TxParameters{
+		ChainID:  "demo",
+		SignMode: apitxsigning.SignMode_SIGN_MODE_TEXTUAL,
+		AccountConfig: AccountConfig{
+			Address: addr,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.BuildUnsignedTx([]transaction.Msg{
+		&countertypes.MsgIncreaseCounter{
+			Signer: signer,
+			Count:  0,
+		},
+	}...))
+
+	pk, err := f.keybase.GetPubKey("alice")
+	require.NoError(t, err)
+	signBytes, err := f.getSignBytesAdapter(context.Background(), signing.SignerData{
+		Address: signer,
+		ChainID: f.txParams.ChainID,
+		PubKey: &anypb.Any{
+			TypeUrl: codectypes.MsgTypeURL(pk),
+			Value:   pk.Bytes(),
+		},
+	})
+	require.NoError(t, err)
+
+	screens, err := decodeTextualScreens(signBytes)
+	require.NoError(t, err)
+	require.Equal(t, textualScreen{Title: "Chain id", Content: "demo"}, screens[0])
+
+	_, err = ledgerReview(signBytes)
+	require.NoError(t, err)
+
+	// local keys are not Ledger keys
+	require.False(t, isLedgerKey(f.keybase, "alice"))
+	require.False(t, isLedgerKey(f.keybase, ""))
+}
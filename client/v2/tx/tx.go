@@ -2,13 +2,18 @@ package tx
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	"cosmossdk.io/client/v2/broadcast"
 	"cosmossdk.io/client/v2/broadcast/comet"
@@ -16,6 +21,7 @@ import (
 	"cosmossdk.io/client/v2/internal/account"
 	"cosmossdk.io/client/v2/internal/flags"
 	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -101,6 +107,27 @@ func GenerateOnly(ctx context.Context, conn grpc.ClientConn, msgs ...transaction
 	return generateOnly(txf, msgs...)
 }
 
+// GenerateSignBytesFile generates an unsigned transaction without broadcasting it, along with the bytes its
+// signer signs, so that it can be signed externally, e.g. for watch-only keys.
+// Returns the JSON encoded SignBytesFile and any error encountered.
+func GenerateSignBytesFile(ctx context.Context, conn grpc.ClientConn, msgs ...transaction.Msg) ([]byte, error) {
+	txf, err := initFactory(ctx, conn, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := generateTx(txf, msgs...); err != nil {
+		return nil, err
+	}
+
+	file, err := txf.SignBytesFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(file)
+}
+
 // DryRun simulates a transaction without broadcasting it to the network.
 // It initializes a transaction factory using the provided context, connection and messages,
 // then performs a dry run simulation of the transaction.
@@ -152,6 +179,8 @@ func newFactory(ctx clientcontext.Context, conn grpc.ClientConn) (Factory, error
 		Cdc:                   ctx.Cdc,
 		ValidatorAddressCodec: ctx.ValidatorAddressCodec,
 		EnabledSignModes:      ctx.EnabledSignModes,
+		// SIGN_MODE_TEXTUAL renders coins with the denom metadata of the chain
+		TextualCoinMetadataQueryFn: newCoinMetadataQueryFn(conn),
 	})
 	if err != nil {
 		return Factory{}, err
@@ -164,9 +193,32 @@ func newFactory(ctx clientcontext.Context, conn grpc.ClientConn) (Factory, error
 		return Factory{}, err
 	}
 
+	// the summary of the sign bytes tells users what they are about to review on their Ledger device
+	txf.txParams.LedgerReview = func(review string) {
+		_, _ = fmt.Fprintln(os.Stderr, review)
+	}
+
 	return txf, nil
 }
 
+// newCoinMetadataQueryFn returns the coin metadata querier of SIGN_MODE_TEXTUAL, which queries the denom
+// metadata of the bank module. Denoms without metadata are rendered as is.
+func newCoinMetadataQueryFn(conn grpc.ClientConn) textual.CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		res, err := bankv1beta1.NewQueryClient(conn).DenomMetadata(ctx, &bankv1beta1.QueryDenomMetadataRequest{
+			Denom: denom,
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		return res.Metadata, nil
+	}
+}
+
 // validateMessages validates all msgs before generating or broadcasting the tx.
 // We were calling ValidateBasic separately in each CLI handler before.
 // Right now, we're factorizing that call inside this function.
@@ -32,6 +32,9 @@ type TxParameters struct {
 	ChainID          string                // ChainID specifies the unique identifier of the blockchain where the transaction will be processed.
 	memo             string                // memo contains any arbitrary memo to be attached to the transaction.
 	SignMode         apitxsigning.SignMode // signMode determines the signing mode to be used for the transaction.
+	// LedgerReview, if set, is called with the summary of the SIGN_MODE_TEXTUAL sign bytes before they are sent
+	// to a Ledger device, so that it can be displayed to the user.
+	LedgerReview func(review string)
 
 	AccountConfig    // AccountConfig includes information about the transaction originator's account.
 	GasConfig        // GasConfig specifies the gas settings for the transaction.
@@ -164,6 +167,13 @@ func txParamsFromFlagSet(flags *pflag.FlagSet, keybase keyring2.Keyring, ac addr
 	generateOnly, _ := flags.GetBool(FlagGenerateOnly)
 	if isDryRun || generateOnly {
 		addr, err = ac.StringToBytes(from)
+		// keys, watch-only keys included, may be referred to by name as well
+		if err != nil && keybase != nil {
+			var keyErr error
+			if fromName, fromAddress, _, keyErr = keybase.KeyInfo(from); keyErr == nil {
+				addr, err = ac.StringToBytes(fromAddress)
+			}
+		}
 	} else {
 		fromName, fromAddress, _, err = keybase.KeyInfo(from)
 		if err == nil {
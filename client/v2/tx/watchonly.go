@@ -0,0 +1,113 @@
+package tx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/client/v2/autocli/keyring"
+
+	sdkkeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// isWatchOnlyKey returns whether the key of the given name is a watch-only key, created from a public key or
+// a bare address, which cannot sign.
+func isWatchOnlyKey(keybase keyring.Keyring, name string) bool {
+	if keybase == nil || name == "" {
+		return false
+	}
+
+	keyType, err := keybase.KeyType(name)
+	return err == nil && keyType == uint(sdkkeyring.TypeOffline)
+}
+
+// SignBytesFile holds an unsigned transaction and the bytes its signer signs, so that the transaction can be
+// signed externally, e.g. for watch-only keys.
+type SignBytesFile struct {
+	// Tx is the JSON encoded unsigned transaction, holding the signer info of the signer.
+	Tx            json.RawMessage `json:"tx"`
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number,string"`
+	Sequence      uint64          `json:"sequence,string"`
+	Signer        string          `json:"signer"`
+	SignMode      string          `json:"sign_mode"`
+	// SignBytes are the bytes to sign in SignMode.
+	SignBytes []byte `json:"sign_bytes"`
+}
+
+// SignBytesFile returns the sign bytes file of the transaction built by the factory, for its signer to sign
+// externally. Unlike signing, it does not require the private key of the signer, so that the transactions of
+// watch-only keys can be signed. The public key of watch-only keys created from a bare address is the one of
+// their account.
+func (f *Factory) SignBytesFile(ctx context.Context) (SignBytesFile, error) {
+	if f.txParams.SignMode == apitxsigning.SignMode_SIGN_MODE_UNSPECIFIED {
+		f.txParams.SignMode = f.txConfig.SignModeHandler().DefaultMode()
+	}
+
+	pubKey, err := f.signerPubKey(ctx)
+	if err != nil {
+		return SignBytesFile{}, err
+	}
+
+	bytesToSign, _, err := f.signBytes(ctx, pubKey, true)
+	if err != nil {
+		return SignBytesFile{}, err
+	}
+
+	encoder := f.txConfig.TxJSONEncoder()
+	if encoder == nil {
+		return SignBytesFile{}, errors.New("cannot print sign bytes file: tx json encoder is nil")
+	}
+
+	tx, err := f.getTx()
+	if err != nil {
+		return SignBytesFile{}, err
+	}
+
+	bz, err := encoder(tx)
+	if err != nil {
+		return SignBytesFile{}, err
+	}
+
+	signer, err := f.ac.BytesToString(pubKey.Address())
+	if err != nil {
+		return SignBytesFile{}, err
+	}
+
+	return SignBytesFile{
+		Tx:            bz,
+		ChainID:       f.txParams.ChainID,
+		AccountNumber: f.txParams.AccountNumber,
+		Sequence:      f.txParams.Sequence,
+		Signer:        signer,
+		SignMode:      f.txParams.SignMode.String(),
+		SignBytes:     bytesToSign,
+	}, nil
+}
+
+// signerPubKey returns the public key of the signer of the transaction: the one of its key when it has one,
+// and the one of its account otherwise.
+func (f *Factory) signerPubKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	if f.keybase != nil && f.txParams.FromName != "" {
+		pk, err := f.keybase.GetPubKey(f.txParams.FromName)
+		if err == nil {
+			return pk, nil
+		}
+		if !isWatchOnlyKey(f.keybase, f.txParams.FromName) {
+			return nil, err
+		}
+	}
+
+	acc, err := f.accountRetriever.GetAccount(ctx, f.txParams.Address)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get the public key of the signer: %w", err)
+	}
+	if acc.GetPubKey() == nil {
+		return nil, errors.New("the public key of the signer is unknown: its account has no public key yet, and its key has none")
+	}
+
+	return acc.GetPubKey(), nil
+}
@@ -0,0 +1,109 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/client/v2/autocli/keyring"
+	"cosmossdk.io/core/transaction"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+)
+
+func setWatchOnlyKeyring(t *testing.T) keyring.Keyring {
+	t.Helper()
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	k := cryptokeyring.NewInMemory(codec.NewProtoCodec(registry))
+
+	_, err := k.SaveOfflineKey("pubkey", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = k.SaveWatchOnlyKey("address", addr)
+	require.NoError(t, err)
+
+	kb, err := cryptokeyring.NewAutoCLIKeyring(k, ac)
+	require.NoError(t, err)
+	return kb
+}
+
+func TestFactory_watchOnlyKeys(t *testing.T) {
+	kb := setWatchOnlyKeyring(t)
+
+	tests := []struct {
+		name          string
+		signBytesErr  string
+		wantSignBytes bool
+	}{
+		{
+			name:          "pubkey",
+			wantSignBytes: true,
+		},
+		{
+			// the account of mockAccountRetriever has no public key
+			name:         "address",
+			signBytesErr: "the public key of the signer is unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, isWatchOnlyKey(kb, tt.name))
+
+			_, fromAddress, _, err := kb.KeyInfo(tt.name)
+			require.NoError(t, err)
+			fromAddr, err := ac.StringToBytes(fromAddress)
+			require.NoError(t, err)
+
+			f, err := NewFactory(kb, cdc, mockAccountRetriever{}, txConf, ac, mockClientConn{}, TxParameters{
+				ChainID: "demo",
+				AccountConfig: AccountConfig{
+					FromName:    tt.name,
+					FromAddress: fromAddress,
+					Address:     fromAddr,
+				},
+				GasConfig: GasConfig{
+					gasAdjustment: 1,
+				},
+				ExecutionOptions: ExecutionOptions{
+					simulateAndExecute: true,
+				},
+			})
+			require.NoError(t, err)
+
+			msgs := []transaction.Msg{
+				&countertypes.MsgIncreaseCounter{
+					Signer: fromAddress,
+					Count:  0,
+				},
+			}
+
+			// transactions of watch-only keys can be simulated and built
+			require.NoError(t, f.calculateGas(msgs...))
+			require.NotZero(t, f.txParams.gas)
+			require.NoError(t, f.BuildUnsignedTx(msgs...))
+
+			file, err := f.SignBytesFile(context.Background())
+			if tt.signBytesErr != "" {
+				require.ErrorContains(t, err, tt.signBytesErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "demo", file.ChainID)
+				require.Equal(t, fromAddress, file.Signer)
+				require.NotEmpty(t, file.SignBytes)
+				require.NotEmpty(t, file.Tx)
+			}
+
+			// but they cannot sign
+			_, err = f.sign(context.Background(), true)
+			require.ErrorContains(t, err, tt.name+" is a watch-only key and cannot sign")
+		})
+	}
+
+	require.False(t, isWatchOnlyKey(setKeyring(), "alice"))
+}
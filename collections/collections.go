@@ -107,6 +107,8 @@ type collectionSchemaCodec struct {
 	objectType   schema.StateObjectType
 	keyDecoder   func([]byte) (any, error)
 	valueDecoder func([]byte) (any, error)
+	// keyPrefixDecoder decodes the key prefix of a prefix removal into the bound of a schema.KeyRange.
+	keyPrefixDecoder func([]byte) (any, error)
 }
 
 // Prefix defines a segregation bytes namespace for specific collections objects.
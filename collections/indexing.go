@@ -139,6 +139,25 @@ func (c collectionSchemaCodec) decodeKVPair(update schema.KVPairUpdate) ([]schem
 		}, err
 	}
 
+	if update.RemovePrefix {
+		if len(key) == 0 {
+			return []schema.StateObjectUpdate{
+				{TypeName: c.coll.GetName()},
+			}, fmt.Errorf("the removal of all the objects of %s cannot be represented as a key range", c.coll.GetName())
+		}
+
+		prefix, err := c.keyPrefixDecoder(key)
+		if err != nil {
+			return []schema.StateObjectUpdate{
+				{TypeName: c.coll.GetName()},
+			}, err
+		}
+
+		return []schema.StateObjectUpdate{
+			{TypeName: c.coll.GetName(), Delete: true, DeleteRange: &schema.KeyRange{Start: prefix, End: prefix}},
+		}, nil
+	}
+
 	if update.Remove {
 		return []schema.StateObjectUpdate{
 			{TypeName: c.coll.GetName(), Key: k, Delete: true},
@@ -178,6 +197,27 @@ func (c collectionImpl[K, V]) schemaCodec() (*collectionSchemaCodec, error) {
 		}
 		return keyDecoder.ToSchemaType(x)
 	}
+	res.keyPrefixDecoder = func(prefix []byte) (any, error) {
+		// a prefix of a multipart key holds its first parts
+		if pd, ok := c.m.kc.(schemaPrefixDecoder); ok {
+			if x, err := pd.decodeSchemaPrefix(prefix); err == nil {
+				return x, nil
+			}
+		}
+
+		// otherwise it must hold a whole key
+		read, x, err := c.m.kc.Decode(prefix)
+		if err != nil {
+			return nil, err
+		}
+		if read != len(prefix) {
+			return nil, fmt.Errorf("key prefix has %d trailing bytes", len(prefix)-read)
+		}
+		if keyDecoder.ToSchemaType == nil {
+			return x, nil
+		}
+		return keyDecoder.ToSchemaType(x)
+	}
 	ensureFieldNames(c.m.kc, "key", res.objectType.KeyFields)
 
 	valueDecoder, err := codec.ValueSchemaCodec(c.m.vc)
@@ -202,6 +242,12 @@ func (c collectionImpl[K, V]) schemaCodec() (*collectionSchemaCodec, error) {
 	return res, nil
 }
 
+// schemaPrefixDecoder is implemented by the codecs of multipart keys whose prefixes, holding the first
+// parts of the keys, can be decoded into the bound of a schema.KeyRange.
+type schemaPrefixDecoder interface {
+	decodeSchemaPrefix(prefix []byte) (any, error)
+}
+
 // ensureFieldNames makes sure that all fields have valid names - either the
 // names were specified by user or they get filled
 func ensureFieldNames(x any, defaultName string, cols []schema.Field) {
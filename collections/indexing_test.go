@@ -0,0 +1,56 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+)
+
+func TestModuleCodec_RemovePrefix(t *testing.T) {
+	sk, _ := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	balancesPrefix, countersPrefix := NewPrefix(1), NewPrefix(2)
+	balancesKeyCodec := PairKeyCodec(StringKey, StringKey)
+	NewMap(schemaBuilder, balancesPrefix, "balances", balancesKeyCodec, Uint64Value)
+	NewMap(schemaBuilder, countersPrefix, "counters", Uint64Key, Uint64Value)
+	sch, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	cdc, err := sch.ModuleCodec(IndexingOptions{})
+	require.NoError(t, err)
+
+	decode := func(key []byte) ([]schema.StateObjectUpdate, error) {
+		return cdc.KVDecoder(schema.KVPairUpdate{Key: key, Remove: true, RemovePrefix: true})
+	}
+
+	// the removal of a pair prefix deletes the objects sharing the first part of their key
+	key, err := EncodeKeyWithPrefix(balancesPrefix, balancesKeyCodec, PairPrefix[string, string]("alice"))
+	require.NoError(t, err)
+	updates, err := decode(key)
+	require.NoError(t, err)
+	require.Equal(t, []schema.StateObjectUpdate{{
+		TypeName:    "balances",
+		Delete:      true,
+		DeleteRange: &schema.KeyRange{Start: []interface{}{"alice"}, End: []interface{}{"alice"}},
+	}}, updates)
+	require.NoError(t, cdc.Schema.ValidateObjectUpdate(updates[0]))
+
+	// a prefix holding a whole key deletes that object
+	key, err = EncodeKeyWithPrefix(balancesPrefix, balancesKeyCodec, Join("alice", "atom"))
+	require.NoError(t, err)
+	updates, err = decode(key)
+	require.NoError(t, err)
+	require.Equal(t, &schema.KeyRange{Start: []interface{}{"alice", "atom"}, End: []interface{}{"alice", "atom"}}, updates[0].DeleteRange)
+
+	key, err = EncodeKeyWithPrefix(countersPrefix, Uint64Key, 7)
+	require.NoError(t, err)
+	updates, err = decode(key)
+	require.NoError(t, err)
+	require.Equal(t, &schema.KeyRange{Start: uint64(7), End: uint64(7)}, updates[0].DeleteRange)
+
+	// the removal of a whole collection has no key to range over
+	_, err = decode(countersPrefix.Bytes())
+	require.ErrorContains(t, err, "cannot be represented as a key range")
+}
@@ -195,6 +195,19 @@ func (p pairKeyCodec[K1, K2]) SizeNonTerminal(key Pair[K1, K2]) int {
 	return size
 }
 
+// decodeSchemaPrefix decodes a key prefix holding only the first part of the pair into the bound of a
+// schema.KeyRange.
+func (p pairKeyCodec[K1, K2]) decodeSchemaPrefix(prefix []byte) (any, error) {
+	read, key1, err := p.keyCodec1.DecodeNonTerminal(prefix)
+	if err != nil {
+		return nil, err
+	}
+	if read != len(prefix) {
+		return nil, fmt.Errorf("pair key prefix has %d trailing bytes", len(prefix)-read)
+	}
+	return []interface{}{key1}, nil
+}
+
 // GENESIS
 
 type jsonPairKey [2]json.RawMessage
@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 	"golang.org/x/crypto/argon2"
@@ -33,9 +36,20 @@ const (
 )
 
 var (
-	kdfHeader = "kdf"
-	kdfBcrypt = "bcrypt"
-	kdfArgon2 = "argon2"
+	kdfHeader   = "kdf"
+	kdfBcrypt   = "bcrypt"
+	kdfArgon2   = "argon2"
+	kdfArgon2id = "argon2id"
+)
+
+const (
+	privKeyArmorVersion2 = "2"
+
+	headerSalt      = "salt"
+	headerNonce     = "nonce"
+	headerAddress   = "address"
+	headerCreatedAt = "created-at"
+	headerChecksum  = "checksum"
 )
 
 const (
@@ -177,8 +191,22 @@ func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes,
 	return saltBytes, encBytes
 }
 
-// UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error
+// UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error.
+// Both the version 2 and the legacy formats are supported.
 func UnarmorDecryptPrivKey(armorStr, passphrase string) (privKey cryptotypes.PrivKey, algo string, err error) {
+	_, header, _, err := DecodeArmor(armorStr)
+	if err != nil {
+		return privKey, "", err
+	}
+	if header[headerVersion] == privKeyArmorVersion2 {
+		privKey, metadata, err := unarmorDecryptPrivKeyV2(armorStr, passphrase)
+		return privKey, metadata.Algo, err
+	}
+
+	return unarmorDecryptLegacyPrivKey(armorStr, passphrase)
+}
+
+func unarmorDecryptLegacyPrivKey(armorStr, passphrase string) (privKey cryptotypes.PrivKey, algo string, err error) {
 	blockType, header, encBytes, err := DecodeArmor(armorStr)
 	if err != nil {
 		return privKey, "", err
@@ -255,6 +283,153 @@ func decryptPrivKey(saltBytes, encBytes []byte, passphrase, kdf string) (privKey
 	return legacy.PrivKeyFromBytes(privKeyBytes)
 }
 
+//-----------------------------------------------------------------
+// encrypt/decrypt with armor, version 2
+
+// PrivKeyArmorMetadata is the metadata of an armored private key.
+type PrivKeyArmorMetadata struct {
+	// Algo is the signing algorithm of the key.
+	Algo string
+	// Address is the address of the public key of the key.
+	Address cryptotypes.Address
+	// CreatedAt is the time at which the key was armored, it is zero for keys armored with the legacy format.
+	CreatedAt time.Time
+}
+
+// EncryptArmorPrivKeyV2 encrypts and armors the private key with the version 2 format: the key is encrypted
+// with XChaCha20-Poly1305 under a key derived from the passphrase with argon2id, and the armor embeds the
+// algo, the address and the creation time of the key, which are authenticated along with the key, and a
+// checksum of the whole armor.
+func EncryptArmorPrivKeyV2(privKey cryptotypes.PrivKey, passphrase, algo string) string {
+	saltBytes := crypto.CRandBytes(16)
+	key := argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize)
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error generating cypher from key"))
+	}
+	nonce := crypto.CRandBytes(aead.NonceSize())
+
+	if algo == "" {
+		algo = defaultAlgo
+	}
+	header := map[string]string{
+		headerVersion:   privKeyArmorVersion2,
+		kdfHeader:       kdfArgon2id,
+		headerSalt:      fmt.Sprintf("%X", saltBytes),
+		headerNonce:     fmt.Sprintf("%X", nonce),
+		headerType:      algo,
+		headerAddress:   privKey.PubKey().Address().String(),
+		headerCreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	encBytes := aead.Seal(nil, nonce, legacy.Cdc.MustMarshal(privKey), armorHeaderBytes(header))
+	header[headerChecksum] = armorChecksum(header, encBytes)
+
+	return EncodeArmor(blockTypePrivKey, header, encBytes)
+}
+
+// UnarmorDecryptPrivKeyWithMetadata returns the private key of an armor and its metadata. Both the version 2
+// and the legacy formats are supported.
+func UnarmorDecryptPrivKeyWithMetadata(armorStr, passphrase string) (cryptotypes.PrivKey, PrivKeyArmorMetadata, error) {
+	_, header, _, err := DecodeArmor(armorStr)
+	if err != nil {
+		return nil, PrivKeyArmorMetadata{}, err
+	}
+	if header[headerVersion] == privKeyArmorVersion2 {
+		return unarmorDecryptPrivKeyV2(armorStr, passphrase)
+	}
+
+	privKey, algo, err := unarmorDecryptLegacyPrivKey(armorStr, passphrase)
+	if err != nil {
+		return nil, PrivKeyArmorMetadata{}, err
+	}
+	return privKey, PrivKeyArmorMetadata{Algo: algo, Address: privKey.PubKey().Address()}, nil
+}
+
+func unarmorDecryptPrivKeyV2(armorStr, passphrase string) (cryptotypes.PrivKey, PrivKeyArmorMetadata, error) {
+	blockType, header, encBytes, err := DecodeArmor(armorStr)
+	if err != nil {
+		return nil, PrivKeyArmorMetadata{}, err
+	}
+	if blockType != blockTypePrivKey {
+		return nil, PrivKeyArmorMetadata{}, fmt.Errorf("unrecognized armor type: %v", blockType)
+	}
+	if header[kdfHeader] != kdfArgon2id {
+		return nil, PrivKeyArmorMetadata{}, fmt.Errorf("unrecognized KDF type: %v", header[kdfHeader])
+	}
+
+	checksum := header[headerChecksum]
+	delete(header, headerChecksum)
+	if checksum == "" {
+		return nil, PrivKeyArmorMetadata{}, errors.New("missing checksum")
+	}
+	if checksum != armorChecksum(header, encBytes) {
+		return nil, PrivKeyArmorMetadata{}, errors.New("checksum mismatch: the armor is corrupted")
+	}
+
+	saltBytes, err := hex.DecodeString(header[headerSalt])
+	if err != nil || len(saltBytes) == 0 {
+		return nil, PrivKeyArmorMetadata{}, errors.New("invalid salt bytes")
+	}
+	nonce, err := hex.DecodeString(header[headerNonce])
+	if err != nil || len(nonce) != chacha20poly1305.NonceSizeX {
+		return nil, PrivKeyArmorMetadata{}, errors.New("invalid nonce bytes")
+	}
+	address, err := hex.DecodeString(header[headerAddress])
+	if err != nil {
+		return nil, PrivKeyArmorMetadata{}, fmt.Errorf("error decoding address: %w", err)
+	}
+	createdAt, err := time.Parse(time.RFC3339, header[headerCreatedAt])
+	if err != nil {
+		return nil, PrivKeyArmorMetadata{}, fmt.Errorf("error decoding creation time: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize)
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, PrivKeyArmorMetadata{}, errorsmod.Wrap(err, "error generating aead cypher for key")
+	}
+	privKeyBytes, err := aead.Open(nil, nonce, encBytes, armorHeaderBytes(header))
+	if err != nil {
+		return nil, PrivKeyArmorMetadata{}, sdkerrors.ErrWrongPassword
+	}
+	privKey, err := legacy.PrivKeyFromBytes(privKeyBytes)
+	if err != nil {
+		return nil, PrivKeyArmorMetadata{}, err
+	}
+	if !bytes.Equal(privKey.PubKey().Address(), address) {
+		return nil, PrivKeyArmorMetadata{}, errors.New("address mismatch: the armor does not match its key")
+	}
+
+	return privKey, PrivKeyArmorMetadata{
+		Algo:      header[headerType],
+		Address:   address,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// armorHeaderBytes returns a deterministic encoding of the armor headers.
+func armorHeaderBytes(header map[string]string) []byte {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(header[k])
+		sb.WriteByte('\n')
+	}
+	return []byte(sb.String())
+}
+
+// armorChecksum returns the checksum of the armor headers, except the checksum itself, and data.
+func armorChecksum(header map[string]string, data []byte) string {
+	return fmt.Sprintf("%X", crypto.Sha256(append(armorHeaderBytes(header), data...)))
+}
+
 //-----------------------------------------------------------------
 // encode/decode with armor
 
@@ -28,6 +28,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/configurator"
 	"github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestArmorUnarmorPrivKey(t *testing.T) {
@@ -76,6 +77,55 @@ func TestArmorUnarmorPrivKey(t *testing.T) {
 	require.Equal(t, "unrecognized KDF type: wrong", err.Error())
 }
 
+func TestArmorUnarmorPrivKeyV2(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	armored := crypto.EncryptArmorPrivKeyV2(priv, "passphrase", "")
+	_, _, err := crypto.UnarmorDecryptPrivKeyWithMetadata(armored, "wrongpassphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	decrypted, metadata, err := crypto.UnarmorDecryptPrivKeyWithMetadata(armored, "passphrase")
+	require.NoError(t, err)
+	require.True(t, priv.Equals(decrypted))
+	require.Equal(t, string(hd.Secp256k1Type), metadata.Algo)
+	require.Equal(t, priv.PubKey().Address(), metadata.Address)
+	require.False(t, metadata.CreatedAt.IsZero())
+
+	// the legacy function supports the version 2 format
+	decrypted, algo, err := crypto.UnarmorDecryptPrivKey(armored, "passphrase")
+	require.NoError(t, err)
+	require.True(t, priv.Equals(decrypted))
+	require.Equal(t, string(hd.Secp256k1Type), algo)
+
+	// the legacy format is still supported
+	decrypted, metadata, err = crypto.UnarmorDecryptPrivKeyWithMetadata(crypto.EncryptArmorPrivKey(priv, "passphrase", ""), "passphrase")
+	require.NoError(t, err)
+	require.True(t, priv.Equals(decrypted))
+	require.Equal(t, string(hd.Secp256k1Type), metadata.Algo)
+	require.Equal(t, priv.PubKey().Address(), metadata.Address)
+	require.True(t, metadata.CreatedAt.IsZero())
+
+	// tampered metadata
+	_, header, encBytes, err := crypto.DecodeArmor(armored)
+	require.NoError(t, err)
+	header["type"] = "ed25519"
+	_, _, err = crypto.UnarmorDecryptPrivKeyWithMetadata(crypto.EncodeArmor("TENDERMINT PRIVATE KEY", header, encBytes), "passphrase")
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	// missing checksum
+	_, header, encBytes, err = crypto.DecodeArmor(armored)
+	require.NoError(t, err)
+	delete(header, "checksum")
+	_, _, err = crypto.UnarmorDecryptPrivKeyWithMetadata(crypto.EncodeArmor("TENDERMINT PRIVATE KEY", header, encBytes), "passphrase")
+	require.ErrorContains(t, err, "missing checksum")
+
+	// tampered key
+	_, header, encBytes, err = crypto.DecodeArmor(armored)
+	require.NoError(t, err)
+	encBytes[0] ^= 0xFF
+	_, _, err = crypto.UnarmorDecryptPrivKeyWithMetadata(crypto.EncodeArmor("TENDERMINT PRIVATE KEY", header, encBytes), "passphrase")
+	require.ErrorContains(t, err, "checksum mismatch")
+}
+
 func TestArmorUnarmorPubKey(t *testing.T) {
 	// Select the encryption and storage for your cryptostore
 	var cdc codec.Codec
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -21,6 +22,8 @@ func RegisterCrypto(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(&secp256k1.PubKey{},
 		secp256k1.PubKeyName)
 	registrar.RegisterConcrete(&bls12_381.PubKey{}, bls12381.PubKeyName)
+	registrar.RegisterConcrete(&secp256r1.PubKey{},
+		secp256r1.PubKeyName)
 	registrar.RegisterConcrete(&kmultisig.LegacyAminoPubKey{},
 		kmultisig.PubKeyAminoRoute)
 	registrar.RegisterInterface((*cryptotypes.PrivKey)(nil), nil)
@@ -29,4 +32,6 @@ func RegisterCrypto(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(&secp256k1.PrivKey{},
 		secp256k1.PrivKeyName)
 	registrar.RegisterConcrete(&bls12_381.PrivKey{}, bls12381.PrivKeyName)
+	registrar.RegisterConcrete(&secp256r1.PrivKey{},
+		secp256r1.PrivKeyName)
 }
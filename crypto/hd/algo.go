@@ -4,7 +4,9 @@ import (
 	"github.com/cosmos/go-bip39"
 	"gitlab.com/yawning/secp256k1-voi/secec"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -16,18 +18,28 @@ const (
 	MultiType = PubKeyType("multi")
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters, as passkeys do.
+	// It is currently not supported for ledgers.
+	Secp256r1Type = PubKeyType("secp256r1")
 	// Ed25519Type represents the Ed25519Type signature system.
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
 	// Bls12_381Type represents the Bls12_381Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// It is currently not supported for ledgers.
 	Bls12_381Type = PubKeyType("bls12_381")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// Secp256r1 uses the NIST P-256 ECDSA parameters.
+	Secp256r1 = secp256r1Algo{}
+	// Bls12_381 uses BLS12-381 keys, whose signatures can be aggregated.
+	// It requires the bls12381 build tag.
+	Bls12_381 = bls12_381Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
@@ -77,3 +89,51 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: privKeyObj.Bytes()}
 	}
 }
+
+type secp256r1Algo struct{}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secp256r1 private key for the given seed and HD path. It follows the same
+// derivation as secp256k1 keys, the derived bytes being used as the secret number of the key.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates a secp256r1 private key from the given bytes.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		privKey, err := secp256r1.NewPrivKeyFromBytes(bz)
+		if err != nil {
+			panic(err)
+		}
+
+		return privKey
+	}
+}
+
+type bls12_381Algo struct{}
+
+func (s bls12_381Algo) Name() PubKeyType {
+	return Bls12_381Type
+}
+
+// Derive derives the secret of the bls12_381 private key for the given seed and HD path. It follows the same
+// derivation as secp256k1 keys, the derived bytes being used as the secret of the key.
+func (s bls12_381Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates a bls12_381 private key from the given secret.
+func (s bls12_381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		privKey, err := bls12_381.GenPrivKeyFromSecret(bz)
+		if err != nil {
+			panic(err)
+		}
+
+		return &privKey
+	}
+}
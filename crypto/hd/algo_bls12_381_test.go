@@ -0,0 +1,30 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package hd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+)
+
+func TestBls12_381Algo(t *testing.T) {
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+
+	bz, err := hd.Bls12_381.Derive()(mnemonic, "", hd.CreateHDPath(118, 0, 0).String())
+	require.NoError(t, err)
+	privKey := hd.Bls12_381.Generate()(bz)
+	require.Equal(t, string(hd.Bls12_381Type), privKey.Type())
+
+	// the derivation is deterministic
+	bz2, err := hd.Bls12_381.Derive()(mnemonic, "", hd.CreateHDPath(118, 0, 0).String())
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(hd.Bls12_381.Generate()(bz2)))
+
+	msg := []byte("hello")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+}
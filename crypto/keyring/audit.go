@@ -0,0 +1,72 @@
+package keyring
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// AuditOperation is an operation on a key of a keyring, reported to the audit hook of the keyring.
+type AuditOperation string
+
+const (
+	// AuditCreate reports the creation of a key: a new, imported, rotated or migrated key.
+	AuditCreate AuditOperation = "create"
+	// AuditExport reports the export of the private key of a key.
+	AuditExport AuditOperation = "export"
+	// AuditDelete reports the deletion of a key.
+	AuditDelete AuditOperation = "delete"
+	// AuditSign reports a Sign call with a key.
+	AuditSign AuditOperation = "sign"
+)
+
+// AuditRecord is the structured record of an operation on a key of a keyring.
+type AuditRecord struct {
+	Operation AuditOperation
+	// Name is the name of the key.
+	Name string
+	// Type is the type of the record of the key.
+	Type KeyType
+	// Address is the address of the key.
+	Address sdk.AccAddress
+	// SignMode is the sign mode of AuditSign operations.
+	SignMode signing.SignMode
+	// Digest is the SHA-256 digest of the message signed by AuditSign operations.
+	Digest []byte
+	// Err is the error of the operation when it failed, nil otherwise.
+	Err error
+}
+
+// AuditHook receives the records of the operations on the keys of a keyring, e.g. to ship them to audit logs.
+// It is called synchronously once an operation is done, failed operations included. Renaming a key is
+// reported as the export, deletion and creation of its key.
+type AuditHook interface {
+	OnKeyOperation(record AuditRecord)
+}
+
+// audit reports the operation r on the key of the record k to the audit hook of the keyring, if any.
+func (ks keystore) audit(r AuditRecord, k *Record, err error) {
+	if ks.options.AuditHook == nil {
+		return
+	}
+
+	r.Name = k.Name
+	r.Type = k.GetType()
+	if addr, addrErr := k.GetAddress(); addrErr == nil {
+		r.Address = addr
+	}
+	r.Err = err
+
+	ks.options.AuditHook.OnKeyOperation(r)
+}
+
+// auditSign reports the signature of msg in signMode with the key of the record k.
+func (ks keystore) auditSign(k *Record, msg []byte, signMode signing.SignMode, err error) {
+	if ks.options.AuditHook == nil {
+		return
+	}
+
+	digest := sha256.Sum256(msg)
+	ks.audit(AuditRecord{Operation: AuditSign, SignMode: signMode, Digest: digest[:]}, k, err)
+}
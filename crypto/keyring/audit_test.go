@@ -0,0 +1,92 @@
+package keyring
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type auditRecorder struct {
+	records []AuditRecord
+}
+
+func (r *auditRecorder) OnKeyOperation(record AuditRecord) {
+	r.records = append(r.records, record)
+}
+
+func (r *auditRecorder) last(t *testing.T) AuditRecord {
+	t.Helper()
+	require.NotEmpty(t, r.records)
+	return r.records[len(r.records)-1]
+}
+
+func TestAuditHook(t *testing.T) {
+	hook := &auditRecorder{}
+	kr := NewInMemory(getCodec(), func(options *Options) { options.AuditHook = hook })
+
+	k, _, err := kr.NewMnemonic(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, AuditRecord{Operation: AuditCreate, Name: someKey, Type: TypeLocal, Address: addr}, hook.last(t))
+
+	msg := []byte("some message")
+	_, _, err = kr.Sign(someKey, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	digest := sha256.Sum256(msg)
+	require.Equal(t, AuditRecord{
+		Operation: AuditSign,
+		Name:      someKey,
+		Type:      TypeLocal,
+		Address:   addr,
+		SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+		Digest:    digest[:],
+	}, hook.last(t))
+
+	// signing by address is reported as well
+	_, _, err = kr.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.NoError(t, err)
+	require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, hook.last(t).SignMode)
+
+	_, err = kr.ExportPrivKeyArmor(someKey, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, AuditExport, hook.last(t).Operation)
+
+	// failed operations are reported with their error
+	_, err = kr.SaveOfflineKey(theID, secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, _, err = kr.Sign(theID, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrOfflineSign)
+	require.Equal(t, AuditSign, hook.last(t).Operation)
+	require.Equal(t, TypeOffline, hook.last(t).Type)
+	require.ErrorIs(t, hook.last(t).Err, ErrOfflineSign)
+
+	_, err = kr.SaveOfflineKey(theID, secp256k1.GenPrivKey().PubKey())
+	require.ErrorIs(t, err, ErrKeyAlreadyExists)
+	require.Equal(t, AuditCreate, hook.last(t).Operation)
+	require.Equal(t, theID, hook.last(t).Name)
+	require.ErrorIs(t, hook.last(t).Err, ErrKeyAlreadyExists)
+
+	_, _, err = kr.RotateKey(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, AuditCreate, hook.last(t).Operation)
+	require.NotEqual(t, addr, hook.last(t).Address)
+
+	require.NoError(t, kr.Delete(someKey))
+	require.Equal(t, AuditDelete, hook.last(t).Operation)
+	require.Equal(t, someKey, hook.last(t).Name)
+
+	// reading keys is not reported
+	n := len(hook.records)
+	_, err = kr.List()
+	require.NoError(t, err)
+	_, err = kr.Key(theID)
+	require.NoError(t, err)
+	require.Len(t, hook.records, n)
+}
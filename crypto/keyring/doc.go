@@ -39,4 +39,12 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	kms	This backend only stores references to keys held by a key management service, such as
+//		AWS KMS, GCP Cloud KMS or HashiCorp Vault Transit (see the kms package), which signs
+//		on behalf of the keyring. It cannot store private keys.
+//
+// # Auditing
+//
+// The AuditHook option receives a record of each creation, private key export, deletion and signature
+// of the keys of a keyring, whatever its backend, e.g. to ship audit logs in custodial deployments.
 package keyring
@@ -35,4 +35,14 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrKMSNotConfigured is raised when using a kms key without a key management service in the keyring options.
+	ErrKMSNotConfigured = errors.New("no key management service configured")
+	// ErrKMSInvalidSignature is raised when a key management service generates an invalid signature.
+	ErrKMSInvalidSignature = errors.New("key management service generated an invalid signature")
+	// ErrLocalKeyKMSBackend is raised when trying to store a private key in the kms backend.
+	ErrLocalKeyKMSBackend = errors.New("cannot store private keys in the kms keyring backend")
+	// ErrKeyRotationNotSupported is raised when rotating the key of a record which is not a local key.
+	ErrKeyRotationNotSupported = errors.New("key rotation is only supported for local keys")
+	// ErrKeyRotated is raised when signing with the address of a key which was rotated.
+	ErrKeyRotated = errors.New("key of the given address was rotated")
 )
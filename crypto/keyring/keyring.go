@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/99designs/keyring"
 	"github.com/cosmos/go-bip39"
@@ -36,11 +37,13 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendKMS     = "kms"
 )
 
 const (
 	keyringFileDirName = "keyring-file"
 	keyringTestDirName = "keyring-test"
+	keyringKMSDirName  = "keyring-kms"
 	passKeyringPrefix  = "keyring-%s"
 
 	// temporary pass phrase for exporting a key during a key rename
@@ -56,7 +59,8 @@ var (
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
 type Keyring interface {
-	// Backend get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory".
+	// Backend get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory",
+	// "kms".
 	Backend() string
 
 	// DB get the db keyring used in the keystore.
@@ -87,16 +91,35 @@ type Keyring interface {
 	// A passphrase set to the empty string will set the passphrase to the DefaultBIP39Passphrase value.
 	NewMnemonic(uid string, language Language, hdPath, bip39Passphrase string, algo SignatureAlgo) (*Record, string, error)
 
-	// NewAccount converts a mnemonic to a private key and BIP-39 HD Path and persists it.
+	// NewAccount converts a mnemonic to a private key and BIP-39 HD Path and persists it, along with the HD path.
 	// It fails if there is an existing key Info with the same address.
 	NewAccount(uid, mnemonic, bip39Passphrase, hdPath string, algo SignatureAlgo) (*Record, error)
 
+	// NewAccounts converts a mnemonic to a private key along each of the given HD paths, e.g. the BIP-44 paths
+	// of several coin types, and persists them under their names, along with their HD paths. No key is persisted
+	// if any of them conflicts with an existing key Info or with another of the keys.
+	NewAccounts(mnemonic, bip39Passphrase string, keys []HDPathKey, algo SignatureAlgo) ([]*Record, error)
+
+	// RotateKey generates a new mnemonic, derives a hierarchical deterministic key from it, and replaces the
+	// key of an existing local key record with it. The previous public key is kept in the key history of the
+	// record, so that its signatures can still be verified, and its address still resolves to the record.
+	// Returns the updated record and the generated mnemonic.
+	RotateKey(uid string, language Language, hdPath, bip39Passphrase string, algo SignatureAlgo) (*Record, string, error)
+
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SaveKMSKey retrieves the public key of a key held by the key management service of the keyring options
+	// and persists a reference to it.
+	SaveKMSKey(uid, keyID string) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
+	// SaveWatchOnlyKey stores a bare address as a watch-only (offline) key, for accounts whose public key is
+	// unknown, and returns the persisted Info structure. The record has no public key.
+	SaveWatchOnlyKey(uid string, address sdk.AccAddress) (*Record, error)
+
 	// SaveMultisig stores and returns a new multsig (offline) key reference.
 	SaveMultisig(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -162,7 +185,7 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "kms".
 func newKeyringGeneric(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -184,6 +207,8 @@ func newKeyringGeneric(
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
 		db, err = keyring.Open(newPassBackendKeyringConfig(appName, rootDir, userInput))
+	case BackendKMS:
+		db, err = keyring.Open(newKMSBackendKeyringConfig(appName, rootDir))
 	default:
 		return nil, errorsmod.Wrap(ErrUnknownBacked, backend)
 	}
@@ -276,14 +301,14 @@ func (ks keystore) ExportPubKeyArmorByAddress(address []byte) (string, error) {
 	return ks.ExportPubKeyArmor(k.Name)
 }
 
-// ExportPrivKeyArmor exports encrypted privKey
+// ExportPrivKeyArmor exports encrypted privKey, armored with the version 2 format.
 func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error) {
 	priv, err := ks.ExportPrivateKeyObject(uid)
 	if err != nil {
 		return "", err
 	}
 
-	return crypto.EncryptArmorPrivKey(priv, encryptPassphrase, priv.Type()), nil
+	return crypto.EncryptArmorPrivKeyV2(priv, encryptPassphrase, priv.Type()), nil
 }
 
 // ExportPrivateKeyObject exports an armored private key object.
@@ -294,6 +319,7 @@ func (ks keystore) ExportPrivateKeyObject(uid string) (types.PrivKey, error) {
 	}
 
 	priv, err := extractPrivKeyFromRecord(k)
+	ks.audit(AuditRecord{Operation: AuditExport}, k, err)
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +348,7 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 		return errorsmod.Wrap(err, "failed to decrypt private key")
 	}
 
-	_, err = ks.writeLocalKey(uid, privKey)
+	_, err = ks.writeLocalKey(uid, privKey, "")
 	if err != nil {
 		return err
 	}
@@ -346,7 +372,7 @@ func (ks keystore) ImportPrivKeyHex(uid, privKey, algoStr string) error {
 		return err
 	}
 	priv := algo.Generate()(decodedPriv)
-	_, err = ks.writeLocalKey(uid, priv)
+	_, err = ks.writeLocalKey(uid, priv, "")
 	if err != nil {
 		return err
 	}
@@ -387,11 +413,12 @@ func (ks keystore) ImportPubKey(uid, armor string) error {
 // - []byte: The generated signature.
 // - types.PubKey: The public key corresponding to the private key used for signing.
 // - error: Any error encountered during the signing process.
-func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) (_ []byte, _ types.PubKey, err error) {
 	k, err := ks.Key(uid)
 	if err != nil {
 		return nil, nil, err
 	}
+	defer func() { ks.auditSign(k, msg, signMode, err) }()
 
 	switch {
 	case k.GetLocal() != nil:
@@ -410,8 +437,16 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetKms() != nil:
+		return ks.signWithKMS(k, msg)
+
 		// multi or offline record
 	default:
+		// watch-only records created from an address have no public key
+		if k.PubKey == nil {
+			return nil, nil, ErrOfflineSign
+		}
+
 		pub, err := k.GetPubKey()
 		if err != nil {
 			return nil, nil, err
@@ -426,6 +461,15 @@ func (ks keystore) SignByAddress(address, msg []byte, signMode signing.SignMode)
 		return nil, nil, err
 	}
 
+	// the addresses of rotated keys resolve to their record, but cannot sign anymore
+	addr, err := k.GetAddress()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !addr.Equals(sdk.AccAddress(address)) {
+		return nil, nil, ErrKeyRotated
+	}
+
 	return ks.Sign(k.Name, msg, signMode)
 }
 
@@ -461,6 +505,15 @@ func (ks keystore) SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, err
 	return ks.writeOfflineKey(uid, pubkey)
 }
 
+func (ks keystore) SaveWatchOnlyKey(uid string, address sdk.AccAddress) (*Record, error) {
+	k, err := NewWatchOnlyRecord(uid, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
 func (ks keystore) DeleteByAddress(address []byte) error {
 	k, err := ks.KeyByAddress(address)
 	if err != nil {
@@ -481,6 +534,11 @@ func (ks keystore) Rename(oldName, newName string) error {
 		return errorsmod.Wrap(ErrKeyAlreadyExists, fmt.Sprintf("rename failed, %s", newName))
 	}
 
+	k, err := ks.Key(oldName)
+	if err != nil {
+		return err
+	}
+
 	armor, err := ks.ExportPrivKeyArmor(oldName, passPhrase)
 	if err != nil {
 		return err
@@ -494,16 +552,31 @@ func (ks keystore) Rename(oldName, newName string) error {
 		return err
 	}
 
+	// the key history and the HD path of the record are not part of the exported private key
+	if len(k.KeyHistory) > 0 || k.GetLocal().HdPath != "" {
+		renamed, err := ks.Key(newName)
+		if err != nil {
+			return err
+		}
+		renamed.KeyHistory = k.KeyHistory
+		renamed.ActivatedAt = k.ActivatedAt
+		renamed.GetLocal().HdPath = k.GetLocal().HdPath
+		if err := ks.updateRecord(renamed); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // Delete deletes a key in the keyring. `uid` represents the key name, without
 // the `.info` suffix.
-func (ks keystore) Delete(uid string) error {
+func (ks keystore) Delete(uid string) (err error) {
 	k, err := ks.Key(uid)
 	if err != nil {
 		return err
 	}
+	defer func() { ks.audit(AuditRecord{Operation: AuditDelete}, k, err) }()
 
 	addr, err := k.GetAddress()
 	if err != nil {
@@ -515,6 +588,17 @@ func (ks keystore) Delete(uid string) error {
 		return err
 	}
 
+	for _, rk := range k.KeyHistory {
+		pk, err := rk.GetPubKey()
+		if err != nil {
+			return err
+		}
+		err = ks.db.Remove(addrHexKeyAsString(pk.Address()))
+		if err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+			return err
+		}
+	}
+
 	err = ks.db.Remove(infoKey(uid))
 	if err != nil {
 		return err
@@ -569,14 +653,7 @@ func (ks keystore) NewMnemonic(uid string, language Language, hdPath, bip39Passp
 		return nil, "", ErrUnsupportedSigningAlgo
 	}
 
-	// Default number of words (24): This generates a mnemonic directly from the
-	// number of words by reading system entropy.
-	entropy, err := bip39.NewEntropy(defaultEntropySize)
-	if err != nil {
-		return nil, "", err
-	}
-
-	mnemonic, err := bip39.NewMnemonic(entropy)
+	mnemonic, err := newMnemonic()
 	if err != nil {
 		return nil, "", err
 	}
@@ -593,19 +670,28 @@ func (ks keystore) NewMnemonic(uid string, language Language, hdPath, bip39Passp
 	return k, mnemonic, nil
 }
 
+// newMnemonic generates a new mnemonic of the default number of words (24), directly from the number of
+// words by reading system entropy.
+func newMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(defaultEntropySize)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
 func (ks keystore) NewAccount(name, mnemonic, bip39Passphrase, hdPath string, algo SignatureAlgo) (*Record, error) {
 	if !ks.isSupportedSigningAlgo(algo) {
 		return nil, ErrUnsupportedSigningAlgo
 	}
 
 	// create master key and derive first key for keyring
-	derivedPriv, err := algo.Derive()(mnemonic, bip39Passphrase, hdPath)
+	privKey, err := derivePrivKey(mnemonic, bip39Passphrase, hdPath, algo)
 	if err != nil {
 		return nil, err
 	}
 
-	privKey := algo.Generate()(derivedPriv)
-
 	// check if the key already exists with the same address and return an error
 	// if found
 	address := sdk.AccAddress(privKey.PubKey().Address())
@@ -613,7 +699,130 @@ func (ks keystore) NewAccount(name, mnemonic, bip39Passphrase, hdPath string, al
 		return nil, ErrDuplicatedAddress
 	}
 
-	return ks.writeLocalKey(name, privKey)
+	return ks.writeLocalKey(name, privKey, hdPath)
+}
+
+// HDPathKey is a key to derive from a mnemonic along an HD path, and to persist under a name.
+type HDPathKey struct {
+	Name   string
+	HDPath string
+}
+
+func (ks keystore) NewAccounts(mnemonic, bip39Passphrase string, keys []HDPathKey, algo SignatureAlgo) ([]*Record, error) {
+	if !ks.isSupportedSigningAlgo(algo) {
+		return nil, ErrUnsupportedSigningAlgo
+	}
+
+	if ks.backend == BackendKMS {
+		return nil, ErrLocalKeyKMSBackend
+	}
+
+	records := make([]*Record, len(keys))
+	names := make(map[string]bool, len(keys))
+	addresses := make(map[string]bool, len(keys))
+	for i, key := range keys {
+		privKey, err := derivePrivKey(mnemonic, bip39Passphrase, key.HDPath, algo)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "key %s", key.Name)
+		}
+
+		if _, err := ks.Key(key.Name); err == nil || names[key.Name] {
+			return nil, errorsmod.Wrap(ErrKeyAlreadyExists, infoKey(key.Name))
+		}
+		names[key.Name] = true
+
+		address := privKey.PubKey().Address()
+		if _, err := ks.KeyByAddress(address); err == nil || addresses[string(address)] {
+			return nil, errorsmod.Wrapf(ErrDuplicatedAddress, "key %s", key.Name)
+		}
+		addresses[string(address)] = true
+
+		records[i], err = NewLocalRecord(key.Name, privKey, privKey.PubKey())
+		if err != nil {
+			return nil, err
+		}
+		records[i].GetLocal().HdPath = key.HDPath
+	}
+
+	for _, k := range records {
+		if err := ks.writeRecord(k); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// derivePrivKey derives the private key of the given algo from a mnemonic along an HD path.
+func derivePrivKey(mnemonic, bip39Passphrase, hdPath string, algo SignatureAlgo) (types.PrivKey, error) {
+	derivedPriv, err := algo.Derive()(mnemonic, bip39Passphrase, hdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return algo.Generate()(derivedPriv), nil
+}
+
+// RotateKey replaces the key of a local key record with a key derived from a new mnemonic, and keeps the
+// previous public key in the key history of the record.
+func (ks keystore) RotateKey(uid string, language Language, hdPath, bip39Passphrase string, algo SignatureAlgo) (*Record, string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if k.GetLocal() == nil {
+		return nil, "", errorsmod.Wrap(ErrKeyRotationNotSupported, k.GetType().String())
+	}
+
+	if language != English {
+		return nil, "", ErrUnsupportedLanguage
+	}
+
+	if !ks.isSupportedSigningAlgo(algo) {
+		return nil, "", ErrUnsupportedSigningAlgo
+	}
+
+	mnemonic, err := newMnemonic()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if bip39Passphrase == "" {
+		bip39Passphrase = DefaultBIP39Passphrase
+	}
+
+	privKey, err := derivePrivKey(mnemonic, bip39Passphrase, hdPath, algo)
+	if err != nil {
+		return nil, "", err
+	}
+
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	if _, err := ks.KeyByAddress(address); err == nil {
+		return nil, "", ErrDuplicatedAddress
+	}
+
+	rotated, err := NewLocalRecord(uid, privKey, privKey.PubKey())
+	if err != nil {
+		return nil, "", err
+	}
+	rotated.GetLocal().HdPath = hdPath
+
+	now := time.Now().UTC()
+	rotated.KeyHistory = append(k.KeyHistory, &Record_RotatedKey{
+		PubKey:      k.PubKey,
+		ActivatedAt: k.ActivatedAt,
+		RotatedAt:   now,
+	})
+	rotated.ActivatedAt = &now
+
+	err = ks.updateRecord(rotated)
+	ks.audit(AuditRecord{Operation: AuditCreate}, rotated, err)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return rotated, mnemonic, nil
 }
 
 func (ks keystore) isSupportedSigningAlgo(algo SignatureAlgo) bool {
@@ -701,6 +910,19 @@ func newTestBackendKeyringConfig(appName, dir string) keyring.Config {
 	}
 }
 
+// newKMSBackendKeyringConfig stores the records of the kms backend like the test backend does, as they only
+// hold references to the keys of a key management service.
+func newKMSBackendKeyringConfig(appName, dir string) keyring.Config {
+	return keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.FileBackend},
+		ServiceName:     appName,
+		FileDir:         filepath.Join(dir, keyringKMSDirName),
+		FilePasswordFunc: func(_ string) (string, error) {
+			return "kms", nil
+		},
+	}
+}
+
 func newKWalletBackendKeyringConfig(appName, _ string, _ io.Reader) keyring.Config {
 	return keyring.Config{
 		AllowedBackends: []keyring.BackendType{keyring.KWalletBackend},
@@ -823,11 +1045,17 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 	}
 }
 
-func (ks keystore) writeLocalKey(name string, privKey types.PrivKey) (*Record, error) {
+// writeLocalKey persists a local key, along with the HD path it was derived along, if any.
+func (ks keystore) writeLocalKey(name string, privKey types.PrivKey, hdPath string) (*Record, error) {
+	if ks.backend == BackendKMS {
+		return nil, ErrLocalKeyKMSBackend
+	}
+
 	k, err := NewLocalRecord(name, privKey, privKey.PubKey())
 	if err != nil {
 		return nil, err
 	}
+	k.GetLocal().HdPath = hdPath
 
 	return k, ks.writeRecord(k)
 }
@@ -837,7 +1065,9 @@ func (ks keystore) writeLocalKey(name string, privKey types.PrivKey) (*Record, e
 // - one with key `<uid>.info`, with Data = the serialized protobuf key
 // - another with key `<addr_as_hex>.address`, with Data = the uid (i.e. the key name)
 // This is to be able to query keys both by name and by address.
-func (ks keystore) writeRecord(k *Record) error {
+func (ks keystore) writeRecord(k *Record) (err error) {
+	defer func() { ks.audit(AuditRecord{Operation: AuditCreate}, k, err) }()
+
 	addr, err := k.GetAddress()
 	if err != nil {
 		return err
@@ -853,6 +1083,14 @@ func (ks keystore) writeRecord(k *Record) error {
 		return errorsmod.Wrap(ErrKeyAlreadyExists, key)
 	}
 
+	return ks.updateRecord(k)
+}
+
+// updateRecord persists a keyring item in keystore, overwriting it if it exists there, along with the address
+// items of its current key and of the keys of its key history.
+func (ks keystore) updateRecord(k *Record) error {
+	key := infoKey(k.Name)
+
 	serializedRecord, err := ks.cdc.Marshal(k)
 	if err != nil {
 		return errorsmod.Wrap(ErrUnableToSerialize, err.Error())
@@ -867,13 +1105,20 @@ func (ks keystore) writeRecord(k *Record) error {
 		return err
 	}
 
-	item = keyring.Item{
-		Key:  addrHexKeyAsString(addr),
-		Data: []byte(key),
+	addrs, err := k.addresses()
+	if err != nil {
+		return err
 	}
 
-	if err := ks.SetItem(item); err != nil {
-		return err
+	for _, addr := range addrs {
+		item = keyring.Item{
+			Key:  addrHexKeyAsString(addr),
+			Data: []byte(key),
+		}
+
+		if err := ks.SetItem(item); err != nil {
+			return err
+		}
 	}
 
 	return nil
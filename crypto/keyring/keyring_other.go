@@ -26,6 +26,10 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// KMS is the key management service holding the private keys of the kms keys
+	KMS KMS
+	// AuditHook receives the records of the operations on the keys of the keyring
+	AuditHook AuditHook
 }
 
 func New(
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/99designs/keyring"
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
@@ -26,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -360,6 +362,47 @@ func TestOfflineKey(t *testing.T) {
 	}
 }
 
+func TestWatchOnlyKey(t *testing.T) {
+	cdc := getCodec()
+	kb, err := New("keybasename", BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err = kb.SaveWatchOnlyKey(someKey, nil)
+	require.EqualError(t, err, "empty address")
+
+	k, err := kb.SaveWatchOnlyKey(someKey, addr)
+	require.NoError(t, err)
+	require.Equal(t, TypeOffline, k.GetType())
+	require.Nil(t, k.PubKey)
+
+	_, err = kb.SaveWatchOnlyKey(theID, addr)
+	require.ErrorIs(t, err, ErrKeyAlreadyExists)
+
+	k, err = kb.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, someKey, k.Name)
+	kAddr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, addr, kAddr)
+
+	_, err = k.GetPubKey()
+	require.ErrorIs(t, err, ErrNoPubKey)
+	_, err = kb.ExportPubKeyArmor(someKey)
+	require.ErrorIs(t, err, ErrNoPubKey)
+
+	_, _, err = kb.Sign(someKey, []byte("msg"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrOfflineSign)
+
+	keys, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	require.NoError(t, kb.Delete(someKey))
+	_, err = kb.KeyByAddress(addr)
+	require.Error(t, err)
+}
+
 func TestSignVerifyKeyRing(t *testing.T) {
 	dir := t.TempDir()
 	cdc := getCodec()
@@ -1940,6 +1983,181 @@ func TestAltKeyring_ConstructorSupportedAlgos(t *testing.T) {
 	}
 }
 
+func TestAltKeyring_Secp256r1(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc, func(options *Options) {
+		options.SupportedAlgos = SigningAlgoList{hd.Secp256k1, hd.Secp256r1}
+	})
+	require.NoError(t, err)
+
+	record, _, err := kr.NewMnemonic(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256r1)
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, string(hd.Secp256r1Type), pubKey.Type())
+
+	msg := []byte("some message")
+	sig, key, err := kr.Sign(someKey, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, key.Equals(pubKey))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// the key can be exported and imported back
+	armor, err := kr.ExportPrivKeyArmor(someKey, "passphrase")
+	require.NoError(t, err)
+	require.NoError(t, kr.Delete(someKey))
+	require.NoError(t, kr.ImportPrivKey(someKey, armor, "passphrase"))
+	record, err = kr.Key(someKey)
+	require.NoError(t, err)
+	importedPubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(importedPubKey))
+
+	privKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	require.NoError(t, kr.ImportPrivKeyHex(theID, hex.EncodeToString(privKey.Bytes()), string(hd.Secp256r1Type)))
+	record, err = kr.Key(theID)
+	require.NoError(t, err)
+	importedPubKey, err = record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().Equals(importedPubKey))
+}
+
+func TestNewAccounts(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	_, mnemonic, err := kr.NewMnemonic(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	k, err := kr.Key(someKey)
+	require.NoError(t, err)
+	require.Equal(t, sdk.FullFundraiserPath, k.GetLocal().HdPath)
+
+	ethPath := hd.CreateHDPath(60, 0, 0).String()
+	keys := []HDPathKey{
+		{Name: theID, HDPath: ethPath},
+		{Name: otherID, HDPath: "m/44'/60'/1'/0/7"},
+	}
+
+	// keys conflicting with existing ones or with each other are not persisted
+	_, err = kr.NewAccounts(mnemonic, DefaultBIP39Passphrase, append(keys, HDPathKey{Name: someKey, HDPath: "m/0"}), hd.Secp256k1)
+	require.ErrorIs(t, err, ErrKeyAlreadyExists)
+	_, err = kr.NewAccounts(mnemonic, DefaultBIP39Passphrase, append(keys, HDPathKey{Name: "fundraiser", HDPath: sdk.FullFundraiserPath}), hd.Secp256k1)
+	require.ErrorIs(t, err, ErrDuplicatedAddress)
+	_, err = kr.NewAccounts(mnemonic, DefaultBIP39Passphrase, append(keys, HDPathKey{Name: theID, HDPath: "m/1"}), hd.Secp256k1)
+	require.ErrorIs(t, err, ErrKeyAlreadyExists)
+	_, err = kr.NewAccounts(mnemonic, DefaultBIP39Passphrase, append(keys, HDPathKey{Name: "invalid", HDPath: "m/44'/x'"}), hd.Secp256k1)
+	require.ErrorContains(t, err, "key invalid")
+	_, err = kr.Key(theID)
+	require.Error(t, err)
+
+	records, err := kr.NewAccounts(mnemonic, DefaultBIP39Passphrase, keys, hd.Secp256k1)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	for i, key := range keys {
+		k, err := kr.Key(key.Name)
+		require.NoError(t, err)
+		require.Equal(t, key.HDPath, k.GetLocal().HdPath)
+
+		priv, err := derivePrivKey(mnemonic, DefaultBIP39Passphrase, key.HDPath, hd.Secp256k1)
+		require.NoError(t, err)
+		pub, err := records[i].GetPubKey()
+		require.NoError(t, err)
+		require.True(t, priv.PubKey().Equals(pub))
+	}
+
+	// the HD path is kept when renaming and rotating keys
+	require.NoError(t, kr.Rename(theID, "eth"))
+	k, err = kr.Key("eth")
+	require.NoError(t, err)
+	require.Equal(t, ethPath, k.GetLocal().HdPath)
+
+	k, _, err = kr.RotateKey("eth", English, "m/44'/60'/0'/0/1", DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/60'/0'/0/1", k.GetLocal().HdPath)
+}
+
+func TestRotateKey(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	original, _, err := kr.NewMnemonic(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	originalPubKey, err := original.GetPubKey()
+	require.NoError(t, err)
+	originalAddr, err := original.GetAddress()
+	require.NoError(t, err)
+
+	msg := []byte("some message")
+	oldSig, _, err := kr.Sign(someKey, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	beforeRotation := time.Now()
+	rotated, mnemonic, err := kr.RotateKey(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	require.NotEmpty(t, mnemonic)
+	require.Equal(t, someKey, rotated.Name)
+	require.Len(t, rotated.KeyHistory, 1)
+	require.Nil(t, rotated.KeyHistory[0].ActivatedAt)
+	require.Equal(t, *rotated.ActivatedAt, rotated.KeyHistory[0].RotatedAt)
+
+	record, err := kr.Key(someKey)
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.False(t, pubKey.Equals(originalPubKey))
+
+	// signatures of the previous key can still be verified
+	pks, err := record.PubKeys()
+	require.NoError(t, err)
+	require.Len(t, pks, 2)
+	require.True(t, pks[0].Equals(pubKey))
+	require.True(t, pks[1].VerifySignature(msg, oldSig))
+
+	pk, err := record.PubKeyAt(beforeRotation)
+	require.NoError(t, err)
+	require.True(t, pk.Equals(originalPubKey))
+	pk, err = record.PubKeyAt(time.Now())
+	require.NoError(t, err)
+	require.True(t, pk.Equals(pubKey))
+
+	sig, key, err := kr.Sign(someKey, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, key.Equals(pubKey))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// the address of the previous key still resolves to the record, but cannot sign anymore
+	record, err = kr.KeyByAddress(originalAddr)
+	require.NoError(t, err)
+	require.Equal(t, someKey, record.Name)
+	_, _, err = kr.SignByAddress(originalAddr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrKeyRotated)
+
+	// the key history is kept across rotations and renames
+	_, _, err = kr.RotateKey(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	require.NoError(t, kr.Rename(someKey, theID))
+	record, err = kr.Key(theID)
+	require.NoError(t, err)
+	require.Len(t, record.KeyHistory, 2)
+	require.Equal(t, rotated.ActivatedAt.UTC(), record.KeyHistory[1].ActivatedAt.UTC())
+	record, err = kr.KeyByAddress(originalAddr)
+	require.NoError(t, err)
+	require.Equal(t, theID, record.Name)
+
+	// deleting the record deletes the addresses of all its keys
+	require.NoError(t, kr.Delete(theID))
+	_, err = kr.KeyByAddress(originalAddr)
+	require.True(t, errors.Is(err, sdkerrors.ErrKeyNotFound))
+
+	_, err = kr.SaveOfflineKey(someKey, originalPubKey)
+	require.NoError(t, err)
+	_, _, err = kr.RotateKey(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrKeyRotationNotSupported)
+}
+
 // TODO: review it
 func TestBackendConfigConstructors(t *testing.T) {
 	backend := newKWalletBackendKeyringConfig("test", "", nil)
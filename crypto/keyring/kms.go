@@ -0,0 +1,71 @@
+package keyring
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// KMS is a key management service, such as a cloud KMS or HashiCorp Vault, which holds private keys and signs
+// with them, so that they are never stored by the keyring.
+type KMS interface {
+	// Name returns the name of the key management service, which is stored in the records of its keys.
+	Name() string
+	// PubKey returns the public key of the given key.
+	PubKey(ctx context.Context, keyID string) (types.PubKey, error)
+	// Sign signs msg with the given key. The signature must be verifiable by the public key of the key.
+	Sign(ctx context.Context, keyID string, msg []byte) ([]byte, error)
+}
+
+// SaveKMSKey retrieves the public key of a key held by the key management service of the keyring options and
+// persists a reference to it.
+func (ks keystore) SaveKMSKey(uid, keyID string) (*Record, error) {
+	if ks.options.KMS == nil {
+		return nil, ErrKMSNotConfigured
+	}
+
+	pk, err := ks.options.KMS.PubKey(context.Background(), keyID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := NewSigningAlgoFromString(pk.Type(), ks.options.SupportedAlgos); err != nil {
+		return nil, err
+	}
+
+	k, err := NewKMSRecord(uid, pk, ks.options.KMS.Name(), keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
+// signWithKMS signs a message with the key management service holding the key of a kms record.
+func (ks keystore) signWithKMS(k *Record, msg []byte) ([]byte, types.PubKey, error) {
+	if ks.options.KMS == nil {
+		return nil, nil, ErrKMSNotConfigured
+	}
+
+	item := k.GetKms()
+	if item.Provider != ks.options.KMS.Name() {
+		return nil, nil, errorsmod.Wrap(ErrKMSNotConfigured, fmt.Sprintf("key %s is held by %s, not %s", k.Name, item.Provider, ks.options.KMS.Name()))
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := ks.options.KMS.Sign(context.Background(), item.KeyId, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !pub.VerifySignature(msg, sig) {
+		return nil, nil, ErrKMSInvalidSignature
+	}
+
+	return sig, pub, nil
+}
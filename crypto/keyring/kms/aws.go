@@ -0,0 +1,181 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	awsService    = "kms"
+	awsAlgorithm  = "AWS4-HMAC-SHA256"
+	awsTimeFormat = "20060102T150405Z"
+	awsDateFormat = "20060102"
+)
+
+var _ keyring.KMS = &aws{}
+
+// aws signs with the keys of AWS KMS. Both ECC_SECG_P256K1 and ECC_NIST_P256 keys are supported.
+type aws struct {
+	pubKeyCache
+
+	endpoint        string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	client          *http.Client
+	now             func() time.Time
+}
+
+// NewAWS returns a key management service signing with the keys of AWS KMS in the given region, the key ids
+// being the ids, ARNs or aliases of the keys. An empty endpoint defaults to the public endpoint of the region.
+func NewAWS(endpoint, region, accessKeyID, secretAccessKey, sessionToken string) (keyring.KMS, error) {
+	if region == "" {
+		return nil, errors.New("aws: region is required")
+	}
+	if accessKeyID == "" || secretAccessKey == "" {
+		return nil, errors.New("aws: access key id and secret access key are required")
+	}
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://kms.%s.amazonaws.com", region)
+	}
+
+	a := &aws{
+		endpoint:        strings.TrimSuffix(endpoint, "/"),
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		sessionToken:    sessionToken,
+		client:          http.DefaultClient,
+		now:             time.Now,
+	}
+	a.fetch = a.fetchPubKey
+	return a, nil
+}
+
+func (a *aws) Name() string {
+	return ProviderAWS
+}
+
+func (a *aws) fetchPubKey(ctx context.Context, keyID string) (cryptotypes.PubKey, error) {
+	var resp struct {
+		PublicKey []byte `json:"PublicKey"`
+	}
+	if err := a.do(ctx, "GetPublicKey", map[string]any{"KeyId": keyID}, &resp); err != nil {
+		return nil, err
+	}
+
+	return pubKeyFromPKIX(resp.PublicKey)
+}
+
+func (a *aws) Sign(ctx context.Context, keyID string, msg []byte) ([]byte, error) {
+	pk, err := a.PubKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(msg)
+	req := map[string]any{
+		"KeyId":            keyID,
+		"Message":          digest[:],
+		"MessageType":      "DIGEST",
+		"SigningAlgorithm": "ECDSA_SHA_256",
+	}
+	var resp struct {
+		Signature []byte `json:"Signature"`
+	}
+	if err := a.do(ctx, "Sign", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return signatureFromDER(pk, resp.Signature)
+}
+
+// do calls an action of the AWS KMS JSON API, signing the request with AWS Signature Version 4.
+func (a *aws) do(ctx context.Context, action string, body, out any) error {
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint+"/", bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+action)
+	a.sign(req, reqBody)
+
+	return doJSON(a.client, req, out)
+}
+
+// sign adds the AWS Signature Version 4 authorization header to the request.
+func (a *aws) sign(req *http.Request, body []byte) {
+	now := a.now().UTC()
+	amzDate := now.Format(awsTimeFormat)
+	date := now.Format(awsDateFormat)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if a.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", a.sessionToken)
+	}
+
+	// the signed headers must be sorted
+	headers := [][2]string{
+		{"content-type", req.Header.Get("Content-Type")},
+		{"host", req.URL.Host},
+		{"x-amz-date", amzDate},
+	}
+	if a.sessionToken != "" {
+		headers = append(headers, [2]string{"x-amz-security-token", a.sessionToken})
+	}
+	headers = append(headers, [2]string{"x-amz-target", req.Header.Get("X-Amz-Target")})
+
+	var canonicalHeaders strings.Builder
+	signedHeaders := make([]string, len(headers))
+	for i, h := range headers {
+		canonicalHeaders.WriteString(h[0] + ":" + strings.TrimSpace(h[1]) + "\n")
+		signedHeaders[i] = h[0]
+	}
+
+	bodyHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		"/",
+		"",
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+
+	scope := strings.Join([]string{date, a.region, awsService, "aws4_request"}, "/")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{awsAlgorithm, amzDate, scope, hex.EncodeToString(canonicalRequestHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+a.secretAccessKey), []byte(date))
+	key = hmacSHA256(key, []byte(a.region))
+	key = hmacSHA256(key, []byte(awsService))
+	key = hmacSHA256(key, []byte("aws4_request"))
+	signature := hex.EncodeToString(hmacSHA256(key, []byte(stringToSign)))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsAlgorithm, a.accessKeyID, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,123 @@
+package kms
+
+import (
+	"crypto/elliptic"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+
+	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var (
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidCurveP256      = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+	oidCurveSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// subjectPublicKeyInfo is the PKIX encoding of a public key.
+type subjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// pubKeyFromPEM returns the public key of a PEM encoded PKIX public key.
+func pubKeyFromPEM(bz []byte) (cryptotypes.PubKey, error) {
+	block, _ := pem.Decode(bz)
+	if block == nil {
+		return nil, errors.New("invalid PEM public key")
+	}
+
+	return pubKeyFromPKIX(block.Bytes)
+}
+
+// pubKeyFromPKIX returns the public key of a DER encoded PKIX public key. Only secp256k1 and secp256r1 ECDSA
+// keys are supported.
+func pubKeyFromPKIX(der []byte) (cryptotypes.PubKey, error) {
+	var spki subjectPublicKeyInfo
+	rest, err := asn1.Unmarshal(der, &spki)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("invalid public key: trailing data")
+	}
+	if !spki.Algorithm.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, fmt.Errorf("unsupported public key algorithm %s, only ECDSA keys are supported", spki.Algorithm.Algorithm)
+	}
+
+	var curve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(spki.Algorithm.Parameters.FullBytes, &curve); err != nil {
+		return nil, fmt.Errorf("invalid public key curve: %w", err)
+	}
+
+	point := spki.PublicKey.RightAlign()
+	switch {
+	case curve.Equal(oidCurveSecp256k1):
+		pk, err := secp256k1dcrd.ParsePubKey(point)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secp256k1 public key: %w", err)
+		}
+		return &secp256k1.PubKey{Key: pk.SerializeCompressed()}, nil
+
+	case curve.Equal(oidCurveP256):
+		if len(point) != 65 || point[0] != 4 {
+			return nil, errors.New("invalid secp256r1 public key: expected an uncompressed point")
+		}
+		// compress the point, its validity is checked when decompressing it
+		compressed := make([]byte, 33)
+		compressed[0] = 2 | point[64]&1
+		copy(compressed[1:], point[1:33])
+
+		pk := &secp256r1.PubKey{}
+		if err := pk.UnmarshalAmino(compressed); err != nil {
+			return nil, fmt.Errorf("invalid secp256r1 public key: %w", err)
+		}
+		return pk, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported public key curve %s, only secp256k1 and secp256r1 are supported", curve)
+	}
+}
+
+// signatureFromDER converts a DER encoded ECDSA signature of a key to the R || S encoding verified by the
+// key, whose S is lower than half the order of the curve.
+func signatureFromDER(pk cryptotypes.PubKey, der []byte) ([]byte, error) {
+	var n *big.Int
+	switch pk.(type) {
+	case *secp256k1.PubKey:
+		n = secp256k1dcrd.S256().N
+	case *secp256r1.PubKey:
+		n = elliptic.P256().Params().N
+	default:
+		return nil, fmt.Errorf("unsupported public key type %s", pk.Type())
+	}
+
+	var sig struct{ R, S *big.Int }
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("invalid signature: trailing data")
+	}
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.Cmp(n) >= 0 || sig.S.Cmp(n) >= 0 {
+		return nil, errors.New("invalid signature: values out of range")
+	}
+
+	if sig.S.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		sig.S = new(big.Int).Sub(n, sig.S)
+	}
+
+	bz := make([]byte, 64)
+	sig.R.FillBytes(bz[:32])
+	sig.S.FillBytes(bz[32:])
+	return bz, nil
+}
@@ -0,0 +1,105 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// defaultGCPEndpoint is the public endpoint of GCP Cloud KMS.
+const defaultGCPEndpoint = "https://cloudkms.googleapis.com/v1"
+
+var _ keyring.KMS = &gcp{}
+
+// gcp signs with the keys of GCP Cloud KMS. Both EC_SIGN_SECP256K1_SHA256 and EC_SIGN_P256_SHA256 keys are
+// supported.
+type gcp struct {
+	pubKeyCache
+
+	endpoint    string
+	accessToken string
+	client      *http.Client
+}
+
+// NewGCP returns a key management service signing with the keys of GCP Cloud KMS, the key ids being the
+// resource names of the key versions, e.g.
+// projects/<project>/locations/<location>/keyRings/<keyring>/cryptoKeys/<key>/cryptoKeyVersions/<version>.
+// An empty endpoint defaults to the public endpoint of GCP Cloud KMS.
+func NewGCP(endpoint, accessToken string) (keyring.KMS, error) {
+	if endpoint == "" {
+		endpoint = defaultGCPEndpoint
+	}
+	if accessToken == "" {
+		return nil, errors.New("gcp: access token is required")
+	}
+
+	g := &gcp{
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		accessToken: accessToken,
+		client:      http.DefaultClient,
+	}
+	g.fetch = g.fetchPubKey
+	return g, nil
+}
+
+func (g *gcp) Name() string {
+	return ProviderGCP
+}
+
+func (g *gcp) fetchPubKey(ctx context.Context, keyID string) (cryptotypes.PubKey, error) {
+	var resp struct {
+		Pem string `json:"pem"`
+	}
+	if err := g.do(ctx, http.MethodGet, "/"+keyID+"/publicKey", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return pubKeyFromPEM([]byte(resp.Pem))
+}
+
+func (g *gcp) Sign(ctx context.Context, keyID string, msg []byte) ([]byte, error) {
+	pk, err := g.PubKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(msg)
+	req := map[string]any{
+		"digest": map[string][]byte{"sha256": digest[:]},
+	}
+	var resp struct {
+		Signature []byte `json:"signature"`
+	}
+	if err := g.do(ctx, http.MethodPost, "/"+keyID+":asymmetricSign", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return signatureFromDER(pk, resp.Signature)
+}
+
+func (g *gcp) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, g.endpoint+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+g.accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	return doJSON(g.client, req, out)
+}
@@ -0,0 +1,100 @@
+// Package kms implements key management services holding the private keys of a keyring: AWS KMS, GCP Cloud
+// KMS and HashiCorp Vault Transit. The keyring stores references to their keys and delegates signing to them,
+// so that the private keys are never stored on disk.
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Names of the supported key management services.
+const (
+	ProviderAWS   = "aws"
+	ProviderGCP   = "gcp"
+	ProviderVault = "vault"
+)
+
+// maxResponseSize is the maximum size of the responses of the key management services.
+const maxResponseSize = 1 << 20
+
+// Config is the configuration of a key management service. The credentials of the service are not part of
+// it, they are read from the environment.
+type Config struct {
+	// Provider is the key management service: "aws", "gcp" or "vault".
+	Provider string
+	// Endpoint is the URL of the API of the key management service. For aws and gcp, it defaults to the
+	// public endpoint of the provider. For vault, it is the URL of the transit secrets engine, and defaults
+	// to $VAULT_ADDR/v1/transit.
+	Endpoint string
+	// Region is the region of the keys, only used by aws.
+	Region string
+}
+
+// New returns the key management service of the config, whose credentials are read from the environment:
+//   - aws: AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and the optional AWS_SESSION_TOKEN,
+//   - gcp: GOOGLE_OAUTH_ACCESS_TOKEN,
+//   - vault: VAULT_TOKEN.
+func New(cfg Config) (keyring.KMS, error) {
+	switch cfg.Provider {
+	case ProviderAWS:
+		return NewAWS(cfg.Endpoint, cfg.Region, os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY"), os.Getenv("AWS_SESSION_TOKEN"))
+	case ProviderGCP:
+		return NewGCP(cfg.Endpoint, os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"))
+	case ProviderVault:
+		endpoint := cfg.Endpoint
+		if endpoint == "" && os.Getenv("VAULT_ADDR") != "" {
+			endpoint = strings.TrimSuffix(os.Getenv("VAULT_ADDR"), "/") + "/v1/transit"
+		}
+		return NewVault(endpoint, os.Getenv("VAULT_TOKEN"))
+	default:
+		return nil, fmt.Errorf("unknown key management service provider %q, expected one of: %s, %s, %s", cfg.Provider, ProviderAWS, ProviderGCP, ProviderVault)
+	}
+}
+
+// pubKeyCache caches the public keys of a key management service, which are needed to encode its signatures.
+type pubKeyCache struct {
+	pubKeys sync.Map // key id -> cryptotypes.PubKey
+	fetch   func(ctx context.Context, keyID string) (cryptotypes.PubKey, error)
+}
+
+func (c *pubKeyCache) PubKey(ctx context.Context, keyID string) (cryptotypes.PubKey, error) {
+	if pk, ok := c.pubKeys.Load(keyID); ok {
+		return pk.(cryptotypes.PubKey), nil
+	}
+
+	pk, err := c.fetch(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	c.pubKeys.Store(keyID, pk)
+	return pk, nil
+}
+
+// doJSON sends the request and decodes the JSON response into out.
+func doJSON(client *http.Client, req *http.Request, out any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %s: %s", req.Method, req.URL, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,267 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	ecdsadcrd "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// testKey is a key held by a fake key management service.
+type testKey struct {
+	pkix []byte
+	sign func(digest []byte) []byte
+}
+
+func newSecp256k1TestKey(t *testing.T) testKey {
+	t.Helper()
+	priv, err := secp256k1dcrd.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	curve, err := asn1.Marshal(oidCurveSecp256k1)
+	require.NoError(t, err)
+	point := priv.PubKey().SerializeUncompressed()
+	der, err := asn1.Marshal(subjectPublicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: curve}},
+		PublicKey: asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	})
+	require.NoError(t, err)
+
+	return testKey{
+		pkix: der,
+		sign: func(digest []byte) []byte {
+			return ecdsadcrd.Sign(priv, digest).Serialize()
+		},
+	}
+}
+
+func newSecp256r1TestKey(t *testing.T) testKey {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	require.NoError(t, err)
+
+	return testKey{
+		pkix: der,
+		sign: func(digest []byte) []byte {
+			sig, err := ecdsa.SignASN1(rand.Reader, priv, digest)
+			require.NoError(t, err)
+			return sig
+		},
+	}
+}
+
+func (k testKey) pem() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: k.pkix}))
+}
+
+func requireValidSignature(t *testing.T, pk cryptotypes.PubKey, sig, msg []byte) {
+	t.Helper()
+	require.True(t, pk.VerifySignature(msg, sig))
+	require.False(t, pk.VerifySignature([]byte("other message"), sig))
+}
+
+func TestVault(t *testing.T) {
+	key := newSecp256r1TestKey(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "token", r.Header.Get("X-Vault-Token"))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/transit/keys/service":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"type":           "ecdsa-p256",
+				"latest_version": 2,
+				"keys":           map[string]any{"2": map[string]string{"public_key": key.pem()}},
+			}}))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/transit/sign/service":
+			var req struct {
+				Input     string `json:"input"`
+				Prehashed bool   `json:"prehashed"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.True(t, req.Prehashed)
+			digest, err := base64.StdEncoding.DecodeString(req.Input)
+			require.NoError(t, err)
+			sig := "vault:v2:" + base64.StdEncoding.EncodeToString(key.sign(digest))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]string{"signature": sig}}))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	_, err := NewVault(server.URL+"/v1/transit", "")
+	require.ErrorContains(t, err, "token is required")
+
+	kms, err := NewVault(server.URL+"/v1/transit/", "token")
+	require.NoError(t, err)
+	require.Equal(t, ProviderVault, kms.Name())
+
+	pk, err := kms.PubKey(context.Background(), "service")
+	require.NoError(t, err)
+	require.IsType(t, &secp256r1.PubKey{}, pk)
+
+	msg := []byte("sign bytes")
+	sig, err := kms.Sign(context.Background(), "service", msg)
+	require.NoError(t, err)
+	requireValidSignature(t, pk, sig, msg)
+
+	_, err = kms.Sign(context.Background(), "unknown", msg)
+	require.ErrorContains(t, err, "404 Not Found")
+}
+
+func TestGCP(t *testing.T) {
+	const keyID = "projects/p/locations/global/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1"
+	key := newSecp256k1TestKey(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/"+keyID+"/publicKey":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]string{"pem": key.pem()}))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/"+keyID+":asymmetricSign":
+			var req struct {
+				Digest struct {
+					Sha256 []byte `json:"sha256"`
+				} `json:"digest"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string][]byte{"signature": key.sign(req.Digest.Sha256)}))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	kms, err := NewGCP(server.URL+"/v1", "token")
+	require.NoError(t, err)
+	require.Equal(t, ProviderGCP, kms.Name())
+
+	pk, err := kms.PubKey(context.Background(), keyID)
+	require.NoError(t, err)
+	require.IsType(t, &secp256k1.PubKey{}, pk)
+
+	msg := []byte("sign bytes")
+	sig, err := kms.Sign(context.Background(), keyID, msg)
+	require.NoError(t, err)
+	requireValidSignature(t, pk, sig, msg)
+}
+
+func TestAWS(t *testing.T) {
+	keys := map[string]testKey{
+		"alias/k1": newSecp256k1TestKey(t),
+		"alias/r1": newSecp256r1TestKey(t),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=akid/"))
+		require.Contains(t, r.Header.Get("Authorization"), "/us-east-1/kms/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-security-token;x-amz-target, Signature=")
+		require.Equal(t, "session", r.Header.Get("X-Amz-Security-Token"))
+
+		var req struct {
+			KeyID       string `json:"KeyId"`
+			Message     []byte `json:"Message"`
+			MessageType string `json:"MessageType"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		key, ok := keys[req.KeyID]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"NotFoundException"}`))
+			return
+		}
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.GetPublicKey":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string][]byte{"PublicKey": key.pkix}))
+		case "TrentService.Sign":
+			require.Equal(t, "DIGEST", req.MessageType)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string][]byte{"Signature": key.sign(req.Message)}))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	_, err := NewAWS(server.URL, "", "akid", "secret", "")
+	require.ErrorContains(t, err, "region is required")
+
+	kms, err := NewAWS(server.URL, "us-east-1", "akid", "secret", "session")
+	require.NoError(t, err)
+	require.Equal(t, ProviderAWS, kms.Name())
+
+	msg := []byte("sign bytes")
+	for keyID := range keys {
+		pk, err := kms.PubKey(context.Background(), keyID)
+		require.NoError(t, err)
+
+		sig, err := kms.Sign(context.Background(), keyID, msg)
+		require.NoError(t, err)
+		requireValidSignature(t, pk, sig, msg)
+	}
+
+	_, err = kms.Sign(context.Background(), "alias/unknown", msg)
+	require.ErrorContains(t, err, "NotFoundException")
+}
+
+func TestNew(t *testing.T) {
+	t.Setenv("VAULT_ADDR", "https://vault.example.com:8200/")
+	t.Setenv("VAULT_TOKEN", "token")
+	kms, err := New(Config{Provider: ProviderVault})
+	require.NoError(t, err)
+	require.Equal(t, "https://vault.example.com:8200/v1/transit", kms.(*vault).endpoint)
+
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "")
+	_, err = New(Config{Provider: ProviderGCP})
+	require.ErrorContains(t, err, "access token is required")
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "akid")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	kms, err = New(Config{Provider: ProviderAWS, Region: "eu-west-1"})
+	require.NoError(t, err)
+	require.Equal(t, "https://kms.eu-west-1.amazonaws.com", kms.(*aws).endpoint)
+
+	_, err = New(Config{Provider: "unknown"})
+	require.ErrorContains(t, err, "unknown key management service provider")
+}
+
+func TestSignatureFromDER(t *testing.T) {
+	pk := &secp256k1.PubKey{Key: make([]byte, 33)}
+	n := secp256k1dcrd.S256().N
+
+	// S is normalized to the lower half of the curve order
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{big.NewInt(1), new(big.Int).Sub(n, big.NewInt(2))})
+	require.NoError(t, err)
+	sig, err := signatureFromDER(pk, der)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), new(big.Int).SetBytes(sig[:32]))
+	require.Equal(t, big.NewInt(2), new(big.Int).SetBytes(sig[32:]))
+
+	der, err = asn1.Marshal(struct{ R, S *big.Int }{big.NewInt(1), n})
+	require.NoError(t, err)
+	_, err = signatureFromDER(pk, der)
+	require.ErrorContains(t, err, "out of range")
+
+	digest := sha256.Sum256([]byte("msg"))
+	_, err = signatureFromDER(pk, digest[:])
+	require.ErrorContains(t, err, "invalid signature")
+}
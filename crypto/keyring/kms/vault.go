@@ -0,0 +1,133 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ keyring.KMS = &vault{}
+
+// vault signs with the keys of the transit secrets engine of HashiCorp Vault. Only ecdsa-p256 keys, which are
+// secp256r1 keys, are supported, as Vault does not support secp256k1.
+type vault struct {
+	pubKeyCache
+
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+// NewVault returns a key management service signing with the keys of the HashiCorp Vault transit secrets
+// engine at endpoint, e.g. https://vault.example.com:8200/v1/transit, the key ids being the names of the keys.
+func NewVault(endpoint, token string) (keyring.KMS, error) {
+	if endpoint == "" {
+		return nil, errors.New("vault: endpoint is required")
+	}
+	if token == "" {
+		return nil, errors.New("vault: token is required")
+	}
+
+	v := &vault{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		token:    token,
+		client:   http.DefaultClient,
+	}
+	v.fetch = v.fetchPubKey
+	return v, nil
+}
+
+func (v *vault) Name() string {
+	return ProviderVault
+}
+
+func (v *vault) fetchPubKey(ctx context.Context, keyID string) (cryptotypes.PubKey, error) {
+	var resp struct {
+		Data struct {
+			Type          string `json:"type"`
+			LatestVersion int    `json:"latest_version"`
+			Keys          map[string]struct {
+				PublicKey string `json:"public_key"`
+			} `json:"keys"`
+		} `json:"data"`
+	}
+	if err := v.do(ctx, http.MethodGet, "/keys/"+url.PathEscape(keyID), nil, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.Type != "ecdsa-p256" {
+		return nil, fmt.Errorf("vault: unsupported key type %q, only ecdsa-p256 keys are supported", resp.Data.Type)
+	}
+
+	key, ok := resp.Data.Keys[strconv.Itoa(resp.Data.LatestVersion)]
+	if !ok {
+		return nil, fmt.Errorf("vault: missing public key of version %d of key %s", resp.Data.LatestVersion, keyID)
+	}
+
+	return pubKeyFromPEM([]byte(key.PublicKey))
+}
+
+func (v *vault) Sign(ctx context.Context, keyID string, msg []byte) ([]byte, error) {
+	pk, err := v.PubKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(msg)
+	req := map[string]any{
+		"input":                base64.StdEncoding.EncodeToString(digest[:]),
+		"prehashed":            true,
+		"hash_algorithm":       "sha2-256",
+		"marshaling_algorithm": "asn1",
+	}
+	var resp struct {
+		Data struct {
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+	if err := v.do(ctx, http.MethodPost, "/sign/"+url.PathEscape(keyID), req, &resp); err != nil {
+		return nil, err
+	}
+
+	// signatures are formatted as vault:v<version>:<base64 signature>
+	parts := strings.Split(resp.Data.Signature, ":")
+	if len(parts) != 3 || parts[0] != "vault" {
+		return nil, fmt.Errorf("vault: invalid signature %q", resp.Data.Signature)
+	}
+	der, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("vault: invalid signature: %w", err)
+	}
+
+	return signatureFromDER(pk, der)
+}
+
+func (v *vault) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, v.endpoint+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	return doJSON(v.client, req, out)
+}
@@ -0,0 +1,94 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// testKMS is a key management service holding private keys in memory.
+type testKMS struct {
+	keys map[string]types.PrivKey
+}
+
+func (k testKMS) Name() string {
+	return "test"
+}
+
+func (k testKMS) PubKey(_ context.Context, keyID string) (types.PubKey, error) {
+	priv, ok := k.keys[keyID]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return priv.PubKey(), nil
+}
+
+func (k testKMS) Sign(_ context.Context, keyID string, msg []byte) ([]byte, error) {
+	priv, ok := k.keys[keyID]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return priv.Sign(msg)
+}
+
+func TestKMSKeys(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	kms := testKMS{keys: map[string]types.PrivKey{"service": priv}}
+	withKMS := func(options *Options) { options.KMS = kms }
+
+	cdc := getCodec()
+	dir := t.TempDir()
+	kr, err := New(t.Name(), BackendKMS, dir, nil, cdc, withKMS)
+	require.NoError(t, err)
+	require.Equal(t, BackendKMS, kr.Backend())
+
+	_, err = kr.SaveKMSKey(someKey, "unknown")
+	require.ErrorContains(t, err, "key not found")
+
+	record, err := kr.SaveKMSKey(someKey, "service")
+	require.NoError(t, err)
+	require.Equal(t, TypeKMS, record.GetType())
+	require.Equal(t, "test", record.GetKms().Provider)
+	require.Equal(t, "service", record.GetKms().KeyId)
+
+	record, err = kr.Key(someKey)
+	require.NoError(t, err)
+	pub, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, priv.PubKey().Equals(pub))
+
+	msg := []byte("some message")
+	sig, signPub, err := kr.Sign(someKey, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(signPub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// private keys cannot be stored in the kms backend
+	_, _, err = kr.NewMnemonic(theID, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrLocalKeyKMSBackend)
+
+	// the key management service of the record is required to sign
+	kr, err = New(t.Name(), BackendKMS, dir, nil, cdc)
+	require.NoError(t, err)
+	_, _, err = kr.Sign(someKey, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrKMSNotConfigured)
+
+	// kms keys can be stored in other backends
+	kr = NewInMemory(cdc, withKMS)
+	_, err = kr.SaveKMSKey(someKey, "service")
+	require.NoError(t, err)
+	sig, _, err = kr.Sign(someKey, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	_, err = NewInMemory(cdc).SaveKMSKey(someKey, "service")
+	require.ErrorIs(t, err, ErrKMSNotConfigured)
+}
@@ -0,0 +1,208 @@
+package keyring
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// BackendMigrationOptions configures the migration of the records of a keyring to another keyring backend.
+type BackendMigrationOptions struct {
+	// DryRun reports the outcome of the migration of each record, without writing to the destination keyring.
+	DryRun bool
+	// DeleteSource deletes the migrated records from the source keyring, once all records are migrated and
+	// verified in the destination keyring.
+	DeleteSource bool
+}
+
+// BackendMigration is the outcome of the migration of a record to another keyring backend.
+type BackendMigration struct {
+	Name string
+	Type KeyType
+	// Exists is true when the destination keyring already holds the record, with the same keys.
+	Exists bool
+	// Migrated is true when the record was written to the destination keyring and verified there.
+	Migrated bool
+	// Err is the reason why the record cannot be migrated, if any.
+	Err error
+}
+
+// recordWriter is implemented by keyrings which can persist records as they are, key history included.
+type recordWriter interface {
+	writeRecord(k *Record) error
+}
+
+// MigrateBackend copies the records of the src keyring into the dst keyring, e.g. from the file backend to the
+// os backend. Each migrated record is read back from dst and its public keys are checked against the source
+// record. Records of src are only deleted, when requested, once every record is migrated and verified, so that
+// a failed migration always leaves the source keyring untouched.
+//
+// The returned migrations report the outcome for each record of src. The returned error is non-nil if at least
+// one record cannot be migrated, dry runs included.
+func MigrateBackend(src, dst Keyring, opts BackendMigrationOptions) ([]BackendMigration, error) {
+	w, ok := dst.(recordWriter)
+	if !ok {
+		return nil, fmt.Errorf("keyring backend %s does not support migrations", dst.Backend())
+	}
+
+	records, err := src.List()
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]BackendMigration, len(records))
+	for i, k := range records {
+		migrations[i] = BackendMigration{Name: k.Name, Type: k.GetType()}
+		migrations[i].Exists, migrations[i].Err = checkBackendMigration(k, dst)
+	}
+
+	if opts.DryRun {
+		return migrations, backendMigrationErr(migrations)
+	}
+
+	for i, k := range records {
+		m := &migrations[i]
+		if m.Err != nil || m.Exists {
+			continue
+		}
+
+		if m.Err = w.writeRecord(k); m.Err != nil {
+			continue
+		}
+		if m.Err = verifyBackendMigration(k, dst); m.Err != nil {
+			continue
+		}
+		m.Migrated = true
+	}
+
+	if err := backendMigrationErr(migrations); err != nil {
+		return migrations, err
+	}
+
+	if opts.DeleteSource {
+		// records which already existed in dst are kept, as src and dst may be the same keyring
+		for _, m := range migrations {
+			if !m.Migrated {
+				continue
+			}
+			if err := src.Delete(m.Name); err != nil {
+				return migrations, err
+			}
+		}
+	}
+
+	return migrations, nil
+}
+
+// checkBackendMigration returns whether the record k already exists in dst with the same keys, or an error if
+// it cannot be written to dst.
+func checkBackendMigration(k *Record, dst Keyring) (bool, error) {
+	if k.GetLocal() != nil && dst.Backend() == BackendKMS {
+		return false, ErrLocalKeyKMSBackend
+	}
+
+	if existing, err := dst.Key(k.Name); err == nil {
+		if err := equalRecordKeys(k, existing); err != nil {
+			return false, errorsmod.Wrap(ErrKeyAlreadyExists, err.Error())
+		}
+		return true, nil
+	}
+
+	addrs, err := k.addresses()
+	if err != nil {
+		return false, err
+	}
+	for _, addr := range addrs {
+		if existing, err := dst.KeyByAddress(addr); err == nil {
+			return false, errorsmod.Wrapf(ErrDuplicatedAddress, "address %X is held by key %s", []byte(addr), existing.Name)
+		}
+	}
+
+	return false, nil
+}
+
+// verifyBackendMigration reads the record k back from dst, and checks that it holds the same keys.
+func verifyBackendMigration(k *Record, dst Keyring) error {
+	migrated, err := dst.Key(k.Name)
+	if err != nil {
+		return err
+	}
+	if err := equalRecordKeys(k, migrated); err != nil {
+		return fmt.Errorf("verification failed: %w", err)
+	}
+
+	if k.GetLocal() == nil {
+		return nil
+	}
+
+	priv, err := extractPrivKeyFromRecord(migrated)
+	if err != nil {
+		return fmt.Errorf("verification failed: %w", err)
+	}
+	pk, err := migrated.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if !priv.PubKey().Equals(pk) {
+		return errors.New("verification failed: private key does not match public key")
+	}
+
+	return nil
+}
+
+// equalRecordKeys returns an error if the records a and b do not have the same type and keys, key history
+// included.
+func equalRecordKeys(a, b *Record) error {
+	if a.GetType() != b.GetType() {
+		return fmt.Errorf("key type %s differs from %s", b.GetType(), a.GetType())
+	}
+
+	// watch-only records created from an address have no public key
+	if a.PubKey == nil || b.PubKey == nil {
+		aAddr, err := a.GetAddress()
+		if err != nil {
+			return err
+		}
+		bAddr, err := b.GetAddress()
+		if err != nil {
+			return err
+		}
+		if a.PubKey != nil || b.PubKey != nil || !aAddr.Equals(bAddr) {
+			return fmt.Errorf("watch-only key %X differs from %X", []byte(bAddr), []byte(aAddr))
+		}
+		return nil
+	}
+
+	aKeys, err := a.PubKeys()
+	if err != nil {
+		return err
+	}
+	bKeys, err := b.PubKeys()
+	if err != nil {
+		return err
+	}
+
+	if len(aKeys) != len(bKeys) {
+		return fmt.Errorf("%d public keys differ from %d", len(bKeys), len(aKeys))
+	}
+	for i := range aKeys {
+		if !aKeys[i].Equals(bKeys[i]) {
+			return fmt.Errorf("public key %s differs from %s", bKeys[i].Address(), aKeys[i].Address())
+		}
+	}
+
+	return nil
+}
+
+// backendMigrationErr joins the errors of the migrations.
+func backendMigrationErr(migrations []BackendMigration) error {
+	var errs []error
+	for _, m := range migrations {
+		if m.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", m.Name, m.Err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,118 @@
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestMigrateBackend(t *testing.T) {
+	cdc := getCodec()
+	src := NewInMemory(cdc)
+
+	_, _, err := src.NewMnemonic(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = src.RotateKey(someKey, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	offline := secp256k1.GenPrivKey().PubKey()
+	_, err = src.SaveOfflineKey(theID, offline)
+	require.NoError(t, err)
+	_, err = src.SaveMultisig(otherID, multisig.NewLegacyAminoPubKey(1, []types.PubKey{offline}))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	dst, err := New(t.Name(), BackendTest, dir, nil, cdc)
+	require.NoError(t, err)
+
+	// dry runs do not write to the destination keyring
+	migrations, err := MigrateBackend(src, dst, BackendMigrationOptions{DryRun: true, DeleteSource: true})
+	require.NoError(t, err)
+	require.Len(t, migrations, 3)
+	for _, m := range migrations {
+		require.False(t, m.Exists)
+		require.False(t, m.Migrated)
+	}
+	records, err := dst.List()
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	migrations, err = MigrateBackend(src, dst, BackendMigrationOptions{})
+	require.NoError(t, err)
+	for _, m := range migrations {
+		require.True(t, m.Migrated, m.Name)
+	}
+
+	srcRecord, err := src.Key(someKey)
+	require.NoError(t, err)
+	dstRecord, err := dst.Key(someKey)
+	require.NoError(t, err)
+	require.NoError(t, equalRecordKeys(srcRecord, dstRecord))
+	require.Len(t, dstRecord.KeyHistory, 1)
+
+	// the key history is migrated along with the record
+	pks, err := dstRecord.PubKeys()
+	require.NoError(t, err)
+	_, err = dst.KeyByAddress(pks[1].Address())
+	require.NoError(t, err)
+
+	msg := []byte("some message")
+	sig, pub, err := dst.Sign(someKey, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// migrating again is a no-op, and does not delete the source records
+	migrations, err = MigrateBackend(src, dst, BackendMigrationOptions{DeleteSource: true})
+	require.NoError(t, err)
+	for _, m := range migrations {
+		require.True(t, m.Exists, m.Name)
+	}
+	records, err = src.List()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	// conflicting records fail the migration, and the source keyring is left untouched
+	require.NoError(t, dst.Delete(theID))
+	_, err = dst.SaveOfflineKey(theID, secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	require.NoError(t, dst.Delete(otherID))
+
+	migrations, err = MigrateBackend(src, dst, BackendMigrationOptions{DeleteSource: true})
+	require.ErrorIs(t, err, ErrKeyAlreadyExists)
+	require.ErrorContains(t, err, theID)
+	for _, m := range migrations {
+		require.Equal(t, m.Name == otherID, m.Migrated, m.Name)
+	}
+	records, err = src.List()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	require.NoError(t, dst.Delete(theID))
+	require.NoError(t, dst.Delete(otherID))
+	_, err = dst.SaveOfflineKey("duplicate", offline)
+	require.NoError(t, err)
+
+	_, err = MigrateBackend(src, dst, BackendMigrationOptions{DryRun: true})
+	require.ErrorIs(t, err, ErrDuplicatedAddress)
+
+	// source records are deleted once all records are migrated and verified
+	require.NoError(t, dst.Delete("duplicate"))
+	_, err = MigrateBackend(src, dst, BackendMigrationOptions{DeleteSource: true})
+	require.NoError(t, err)
+	records, err = src.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, someKey, records[0].Name)
+
+	// private keys cannot be migrated to the kms backend
+	kms, err := New(t.Name(), BackendKMS, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	_, err = MigrateBackend(src, kms, BackendMigrationOptions{})
+	require.ErrorIs(t, err, ErrLocalKeyKMSBackend)
+}
@@ -2,6 +2,7 @@ package keyring
 
 import (
 	"errors"
+	"time"
 
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 
@@ -20,6 +21,8 @@ var (
 	ErrPrivKeyNotAvailable = errors.New("private key is not available")
 	// ErrCastAny is used to output an error if cast from types.Any fails.
 	ErrCastAny = errors.New("unable to cast to cryptotypes")
+	// ErrNoPubKey is used when fetching the public key of a watch-only record created from an address.
+	ErrNoPubKey = errors.New("watch-only key created from an address has no public key")
 )
 
 func newRecord(name string, pk cryptotypes.PubKey, item isRecord_Item) (*Record, error) {
@@ -28,7 +31,7 @@ func newRecord(name string, pk cryptotypes.PubKey, item isRecord_Item) (*Record,
 		return nil, err
 	}
 
-	return &Record{name, any, item}, nil
+	return &Record{Name: name, PubKey: any, Item: item}, nil
 }
 
 // NewLocalRecord creates a new Record with local key item
@@ -38,7 +41,7 @@ func NewLocalRecord(name string, priv cryptotypes.PrivKey, pk cryptotypes.PubKey
 		return nil, err
 	}
 
-	recordLocal := &Record_Local{any}
+	recordLocal := &Record_Local{PrivKey: any}
 	recordLocalItem := &Record_Local_{recordLocal}
 
 	return newRecord(name, pk, recordLocalItem)
@@ -55,6 +58,13 @@ func (rl *Record_Ledger) GetPath() *hd.BIP44Params {
 	return rl.Path
 }
 
+// NewKMSRecord creates a new Record with kms item
+func NewKMSRecord(name string, pk cryptotypes.PubKey, provider, keyID string) (*Record, error) {
+	recordKMS := &Record_KMS{Provider: provider, KeyId: keyID}
+	recordKMSItem := &Record_Kms{recordKMS}
+	return newRecord(name, pk, recordKMSItem)
+}
+
 // NewOfflineRecord creates a new Record with offline item
 func NewOfflineRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	recordOffline := &Record_Offline{}
@@ -62,6 +72,18 @@ func NewOfflineRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordOfflineItem)
 }
 
+// NewWatchOnlyRecord creates a new Record with offline item from a bare address, for accounts whose public key
+// is unknown. Such records have no public key.
+func NewWatchOnlyRecord(name string, addr types.AccAddress) (*Record, error) {
+	if len(addr) == 0 {
+		return nil, errors.New("empty address")
+	}
+
+	recordOffline := &Record_Offline{Address: addr}
+	recordOfflineItem := &Record_Offline_{recordOffline}
+	return &Record{Name: name, Item: recordOfflineItem}, nil
+}
+
 // NewMultiRecord creates a new Record with multi item
 func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	recordMulti := &Record_Multi{}
@@ -71,6 +93,10 @@ func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 
 // GetPubKey fetches a public key of the record
 func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
+	if k.PubKey == nil {
+		return nil, errorsmod.Wrap(ErrNoPubKey, k.Name)
+	}
+
 	pk, ok := k.PubKey.GetCachedValue().(cryptotypes.PubKey)
 	if !ok {
 		return nil, errorsmod.Wrap(ErrCastAny, "PubKey")
@@ -79,8 +105,78 @@ func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
 	return pk, nil
 }
 
+// PubKeys fetches the public keys the record ever had, from the current one to the oldest one. Signatures
+// made before the key of the record was rotated can be verified with its previous public keys.
+func (k *Record) PubKeys() ([]cryptotypes.PubKey, error) {
+	pk, err := k.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	pks := []cryptotypes.PubKey{pk}
+	for i := len(k.KeyHistory) - 1; i >= 0; i-- {
+		pk, err := k.KeyHistory[i].GetPubKey()
+		if err != nil {
+			return nil, err
+		}
+		pks = append(pks, pk)
+	}
+
+	return pks, nil
+}
+
+// PubKeyAt fetches the public key which was the key of the record at the given time.
+func (k *Record) PubKeyAt(t time.Time) (cryptotypes.PubKey, error) {
+	// the key history is ordered by rotation time, the first key rotated after t was the key at t
+	for _, rk := range k.KeyHistory {
+		if t.Before(rk.RotatedAt) {
+			return rk.GetPubKey()
+		}
+	}
+
+	return k.GetPubKey()
+}
+
+// addresses fetches the addresses of the keys the record ever had, from the current one to the oldest one.
+func (k *Record) addresses() ([]types.AccAddress, error) {
+	if k.PubKey == nil {
+		addr, err := k.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+		return []types.AccAddress{addr}, nil
+	}
+
+	pks, err := k.PubKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]types.AccAddress, len(pks))
+	for i, pk := range pks {
+		addrs[i] = pk.Address().Bytes()
+	}
+
+	return addrs, nil
+}
+
+// GetPubKey fetches the public key of the rotated key
+func (rk *Record_RotatedKey) GetPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := rk.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrCastAny, "PubKey")
+	}
+
+	return pk, nil
+}
+
 // GetAddress fetches an address of the record
 func (k Record) GetAddress() (types.AccAddress, error) {
+	// watch-only records created from an address have no public key
+	if o := k.GetOffline(); o != nil && k.PubKey == nil {
+		return o.Address, nil
+	}
+
 	pk, err := k.GetPubKey()
 	if err != nil {
 		return nil, err
@@ -99,6 +195,8 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetKms() != nil:
+		return TypeKMS
 	default:
 		panic("unrecognized record type")
 	}
@@ -111,6 +209,13 @@ func (k *Record) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 		return err
 	}
 
+	for _, rk := range k.KeyHistory {
+		var rotated cryptotypes.PubKey
+		if err := unpacker.UnpackAny(rk.PubKey, &rotated); err != nil {
+			return err
+		}
+	}
+
 	if l := k.GetLocal(); l != nil {
 		var priv cryptotypes.PrivKey
 		return unpacker.UnpackAny(l.PrivKey, &priv)
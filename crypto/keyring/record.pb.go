@@ -7,17 +7,21 @@ import (
 	fmt "fmt"
 	hd "github.com/cosmos/cosmos-sdk/crypto/hd"
 	_ "github.com/cosmos/gogoproto/gogoproto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,7 +42,14 @@ type Record struct {
 	//	*Record_Ledger_
 	//	*Record_Multi_
 	//	*Record_Offline_
+	//	*Record_Kms
 	Item isRecord_Item `protobuf_oneof:"item"`
+	// key_history holds the public keys the record had before its key was rotated, from the oldest to the most
+	// recent. The current key of the record is pub_key.
+	KeyHistory []*Record_RotatedKey `protobuf:"bytes,8,rep,name=key_history,json=keyHistory,proto3" json:"key_history,omitempty"`
+	// activated_at is the time from which pub_key is the key of the record. It is unset if the key of the record
+	// was never rotated.
+	ActivatedAt *time.Time `protobuf:"bytes,9,opt,name=activated_at,json=activatedAt,proto3,stdtime" json:"activated_at,omitempty"`
 }
 
 func (m *Record) Reset()         { *m = Record{} }
@@ -92,11 +103,15 @@ type Record_Multi_ struct {
 type Record_Offline_ struct {
 	Offline *Record_Offline `protobuf:"bytes,6,opt,name=offline,proto3,oneof" json:"offline,omitempty"`
 }
+type Record_Kms struct {
+	Kms *Record_KMS `protobuf:"bytes,7,opt,name=kms,proto3,oneof" json:"kms,omitempty"`
+}
 
 func (*Record_Local_) isRecord_Item()   {}
 func (*Record_Ledger_) isRecord_Item()  {}
 func (*Record_Multi_) isRecord_Item()   {}
 func (*Record_Offline_) isRecord_Item() {}
+func (*Record_Kms) isRecord_Item()      {}
 
 func (m *Record) GetItem() isRecord_Item {
 	if m != nil {
@@ -133,6 +148,13 @@ func (m *Record) GetOffline() *Record_Offline {
 	return nil
 }
 
+func (m *Record) GetKms() *Record_KMS {
+	if x, ok := m.GetItem().(*Record_Kms); ok {
+		return x.Kms
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Record) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -140,20 +162,68 @@ func (*Record) XXX_OneofWrappers() []interface{} {
 		(*Record_Ledger_)(nil),
 		(*Record_Multi_)(nil),
 		(*Record_Offline_)(nil),
+		(*Record_Kms)(nil),
+	}
+}
+
+// RotatedKey is a public key a record had before its key was rotated.
+type Record_RotatedKey struct {
+	// pub_key is the rotated public key.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// activated_at is the time from which pub_key was the key of the record. It is unset for the original key
+	// of the record.
+	ActivatedAt *time.Time `protobuf:"bytes,2,opt,name=activated_at,json=activatedAt,proto3,stdtime" json:"activated_at,omitempty"`
+	// rotated_at is the time at which pub_key was replaced by the next key of the record.
+	RotatedAt time.Time `protobuf:"bytes,3,opt,name=rotated_at,json=rotatedAt,proto3,stdtime" json:"rotated_at"`
+}
+
+func (m *Record_RotatedKey) Reset()         { *m = Record_RotatedKey{} }
+func (m *Record_RotatedKey) String() string { return proto.CompactTextString(m) }
+func (*Record_RotatedKey) ProtoMessage()    {}
+func (*Record_RotatedKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{0, 0}
+}
+func (m *Record_RotatedKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Record_RotatedKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Record_RotatedKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
+func (m *Record_RotatedKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record_RotatedKey.Merge(m, src)
+}
+func (m *Record_RotatedKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *Record_RotatedKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record_RotatedKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record_RotatedKey proto.InternalMessageInfo
 
 // Item is a keyring item stored in a keyring backend.
 // Local item
 type Record_Local struct {
 	PrivKey *any.Any `protobuf:"bytes,1,opt,name=priv_key,json=privKey,proto3" json:"priv_key,omitempty"`
+	// hd_path is the HD path the private key was derived along from its mnemonic, e.g. m/44'/60'/0'/0/0 for
+	// keys of other ecosystems. It is unset for imported private keys.
+	HdPath string `protobuf:"bytes,2,opt,name=hd_path,json=hdPath,proto3" json:"hd_path,omitempty"`
 }
 
 func (m *Record_Local) Reset()         { *m = Record_Local{} }
 func (m *Record_Local) String() string { return proto.CompactTextString(m) }
 func (*Record_Local) ProtoMessage()    {}
 func (*Record_Local) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36d640103edea005, []int{0, 0}
+	return fileDescriptor_36d640103edea005, []int{0, 1}
 }
 func (m *Record_Local) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +261,7 @@ func (m *Record_Ledger) Reset()         { *m = Record_Ledger{} }
 func (m *Record_Ledger) String() string { return proto.CompactTextString(m) }
 func (*Record_Ledger) ProtoMessage()    {}
 func (*Record_Ledger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36d640103edea005, []int{0, 1}
+	return fileDescriptor_36d640103edea005, []int{0, 2}
 }
 func (m *Record_Ledger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,7 +298,7 @@ func (m *Record_Multi) Reset()         { *m = Record_Multi{} }
 func (m *Record_Multi) String() string { return proto.CompactTextString(m) }
 func (*Record_Multi) ProtoMessage()    {}
 func (*Record_Multi) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36d640103edea005, []int{0, 2}
+	return fileDescriptor_36d640103edea005, []int{0, 3}
 }
 func (m *Record_Multi) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,13 +329,15 @@ var xxx_messageInfo_Record_Multi proto.InternalMessageInfo
 
 // Offline item
 type Record_Offline struct {
+	// address is the address of a watch-only record created from a bare address, whose pub_key is unset.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
 func (m *Record_Offline) Reset()         { *m = Record_Offline{} }
 func (m *Record_Offline) String() string { return proto.CompactTextString(m) }
 func (*Record_Offline) ProtoMessage()    {}
 func (*Record_Offline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36d640103edea005, []int{0, 3}
+	return fileDescriptor_36d640103edea005, []int{0, 4}
 }
 func (m *Record_Offline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,12 +366,55 @@ func (m *Record_Offline) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Record_Offline proto.InternalMessageInfo
 
+// KMS item
+type Record_KMS struct {
+	// provider is the name of the key management service holding the key.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// key_id is the identifier of the key in the key management service.
+	KeyId string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (m *Record_KMS) Reset()         { *m = Record_KMS{} }
+func (m *Record_KMS) String() string { return proto.CompactTextString(m) }
+func (*Record_KMS) ProtoMessage()    {}
+func (*Record_KMS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{0, 5}
+}
+func (m *Record_KMS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Record_KMS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Record_KMS.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Record_KMS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record_KMS.Merge(m, src)
+}
+func (m *Record_KMS) XXX_Size() int {
+	return m.Size()
+}
+func (m *Record_KMS) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record_KMS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record_KMS proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Record)(nil), "cosmos.crypto.keyring.v1.Record")
+	proto.RegisterType((*Record_RotatedKey)(nil), "cosmos.crypto.keyring.v1.Record.RotatedKey")
 	proto.RegisterType((*Record_Local)(nil), "cosmos.crypto.keyring.v1.Record.Local")
 	proto.RegisterType((*Record_Ledger)(nil), "cosmos.crypto.keyring.v1.Record.Ledger")
 	proto.RegisterType((*Record_Multi)(nil), "cosmos.crypto.keyring.v1.Record.Multi")
 	proto.RegisterType((*Record_Offline)(nil), "cosmos.crypto.keyring.v1.Record.Offline")
+	proto.RegisterType((*Record_KMS)(nil), "cosmos.crypto.keyring.v1.Record.KMS")
 }
 
 func init() {
@@ -307,33 +422,46 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xda, 0x4c,
+	0x18, 0x85, 0xed, 0x00, 0x76, 0x78, 0xc9, 0x6a, 0x94, 0x4f, 0x9f, 0x6b, 0x55, 0x0e, 0xea, 0x2f,
+	0x52, 0x14, 0x5b, 0x49, 0xb3, 0xc8, 0x2a, 0x12, 0xa4, 0x0b, 0x22, 0x82, 0x9a, 0x4e, 0xba, 0xea,
+	0x06, 0x19, 0x66, 0x62, 0x5b, 0xc6, 0x8c, 0x35, 0x1e, 0x90, 0x7c, 0x17, 0x59, 0xf6, 0x86, 0x2a,
+	0x65, 0x99, 0x65, 0x57, 0xfd, 0x81, 0x6d, 0x2f, 0xa2, 0x9a, 0xb1, 0x49, 0x5b, 0xaa, 0xca, 0x51,
+	0x57, 0x78, 0xf4, 0x9e, 0x73, 0xe6, 0x19, 0xe6, 0xd8, 0xf0, 0x7c, 0xc2, 0xb2, 0x84, 0x65, 0xde,
+	0x84, 0xe7, 0xa9, 0x60, 0x5e, 0x4c, 0x73, 0x1e, 0xcd, 0x02, 0x6f, 0x71, 0xe8, 0x71, 0x3a, 0x61,
+	0x9c, 0xb8, 0x29, 0x67, 0x82, 0x21, 0xab, 0x90, 0xb9, 0x85, 0xcc, 0x2d, 0x65, 0xee, 0xe2, 0xd0,
+	0xde, 0x0d, 0x58, 0xc0, 0x94, 0xc8, 0x93, 0x4f, 0x85, 0xde, 0x7e, 0x14, 0x30, 0x16, 0x4c, 0xa9,
+	0xa7, 0x56, 0xe3, 0xf9, 0xb5, 0xe7, 0xcf, 0xf2, 0x72, 0xb4, 0xb7, 0x39, 0x12, 0x51, 0x42, 0x33,
+	0xe1, 0x27, 0x69, 0x29, 0x78, 0xfc, 0x3b, 0x52, 0x48, 0x24, 0x4d, 0x58, 0x92, 0x3c, 0xf9, 0x6e,
+	0x82, 0x81, 0x15, 0x1a, 0x42, 0x50, 0x9f, 0xf9, 0x09, 0xb5, 0xf4, 0xb6, 0xde, 0x69, 0x62, 0xf5,
+	0x8c, 0x0e, 0xc0, 0x4c, 0xe7, 0xe3, 0x51, 0x4c, 0x73, 0x6b, 0xab, 0xad, 0x77, 0x5a, 0x47, 0xbb,
+	0x6e, 0xb1, 0x9f, 0xbb, 0xde, 0xcf, 0xed, 0xce, 0x72, 0x6c, 0xa4, 0xf3, 0xf1, 0x80, 0xe6, 0xe8,
+	0x14, 0x1a, 0x53, 0x36, 0xf1, 0xa7, 0x56, 0x4d, 0x89, 0x5f, 0xb8, 0x7f, 0x3b, 0xa7, 0x5b, 0xec,
+	0xe9, 0x5e, 0x48, 0x75, 0x5f, 0xc3, 0x85, 0x0d, 0x75, 0xc1, 0x98, 0x52, 0x12, 0x50, 0x6e, 0xd5,
+	0x55, 0xc0, 0xcb, 0xea, 0x00, 0x25, 0xef, 0x6b, 0xb8, 0x34, 0x4a, 0x84, 0x64, 0x3e, 0x15, 0x91,
+	0xd5, 0x78, 0x20, 0xc2, 0x50, 0xaa, 0x25, 0x82, 0xb2, 0xa1, 0xd7, 0x60, 0xb2, 0xeb, 0xeb, 0x69,
+	0x34, 0xa3, 0x96, 0xa1, 0x12, 0x3a, 0x95, 0x09, 0x6f, 0x0a, 0x7d, 0x5f, 0xc3, 0x6b, 0x2b, 0x3a,
+	0x81, 0x5a, 0x9c, 0x64, 0x96, 0xa9, 0x12, 0x9e, 0x55, 0x26, 0x0c, 0x86, 0x57, 0x7d, 0x0d, 0x4b,
+	0x0b, 0xba, 0x80, 0x56, 0x4c, 0xf3, 0x51, 0x18, 0x65, 0x82, 0xf1, 0xdc, 0xda, 0x6e, 0xd7, 0x3a,
+	0xad, 0xa3, 0xfd, 0xca, 0x04, 0xcc, 0x84, 0x2f, 0x28, 0x19, 0xd0, 0x1c, 0x43, 0x4c, 0xf3, 0x7e,
+	0x61, 0x47, 0x67, 0xb0, 0xe3, 0x4f, 0x44, 0xb4, 0x90, 0xb3, 0x91, 0x2f, 0xac, 0xa6, 0x02, 0xb2,
+	0xff, 0xb8, 0xc4, 0x77, 0xeb, 0xd2, 0xf4, 0xea, 0x37, 0x5f, 0xf6, 0x74, 0xdc, 0xba, 0x77, 0x75,
+	0x85, 0xfd, 0x51, 0x07, 0xf8, 0x99, 0xff, 0x6b, 0x27, 0xf4, 0x07, 0x74, 0x62, 0x13, 0x61, 0xeb,
+	0x1f, 0x10, 0xd0, 0x19, 0x00, 0x67, 0x62, 0x1d, 0x51, 0xab, 0x8c, 0xd8, 0xbe, 0xfd, 0xbc, 0xa7,
+	0xa9, 0x98, 0x66, 0xe9, 0xeb, 0x0a, 0xfb, 0x2d, 0x34, 0x54, 0xdf, 0x90, 0x07, 0xdb, 0x29, 0x8f,
+	0x16, 0x95, 0x47, 0x30, 0xa5, 0x4a, 0x9e, 0xe1, 0x7f, 0x30, 0x43, 0x32, 0x4a, 0x7d, 0x11, 0x2a,
+	0xfc, 0x26, 0x36, 0x42, 0x72, 0xe9, 0x8b, 0xd0, 0x3e, 0x05, 0xa3, 0x68, 0x20, 0x3a, 0x86, 0xba,
+	0x9a, 0x17, 0x79, 0xed, 0x8d, 0x0b, 0x0b, 0x89, 0xbc, 0xab, 0xde, 0xf9, 0xe5, 0xf1, 0xf1, 0xa5,
+	0xcf, 0xfd, 0x24, 0xc3, 0x4a, 0x6d, 0x9b, 0xd0, 0x50, 0xfd, 0xb3, 0x9f, 0x82, 0x59, 0xd6, 0x08,
+	0x59, 0x60, 0xfa, 0x84, 0x70, 0x9a, 0x65, 0x2a, 0x6c, 0x07, 0xaf, 0x97, 0xf6, 0x09, 0xd4, 0x06,
+	0xc3, 0x2b, 0x64, 0x4b, 0x7c, 0xb6, 0x88, 0x08, 0xe5, 0xe5, 0xcb, 0x7a, 0xbf, 0x46, 0xff, 0x81,
+	0x21, 0xeb, 0x13, 0x91, 0x12, 0xb4, 0x11, 0xd3, 0xfc, 0x9c, 0xf4, 0x0c, 0xa8, 0x47, 0x82, 0x26,
+	0xbd, 0xe1, 0xed, 0x37, 0x47, 0xbb, 0x5d, 0x3a, 0xfa, 0xdd, 0xd2, 0xd1, 0xbf, 0x2e, 0x1d, 0xfd,
+	0x66, 0xe5, 0x68, 0x1f, 0x56, 0x8e, 0x76, 0xb7, 0x72, 0xb4, 0x4f, 0x2b, 0x47, 0x7b, 0xbf, 0x1f,
+	0x44, 0x22, 0x9c, 0x8f, 0xdd, 0x09, 0x4b, 0xbc, 0xf5, 0x97, 0x43, 0xfd, 0x1c, 0x64, 0x24, 0xde,
+	0xf8, 0xae, 0x8d, 0x0d, 0xf5, 0x77, 0xbd, 0xfa, 0x31, 0x00, 0x6b, 0xf1, 0x9d, 0x73, 0xf7, 0x04,
+	0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -356,6 +484,30 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActivatedAt != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ActivatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivatedAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintRecord(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.KeyHistory) > 0 {
+		for iNdEx := len(m.KeyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Item != nil {
 		{
 			size := m.Item.Size()
@@ -471,6 +623,80 @@ func (m *Record_Offline_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Record_Kms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_Kms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Kms != nil {
+		{
+			size, err := m.Kms.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Record_RotatedKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record_RotatedKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_RotatedKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RotatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RotatedAt):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintRecord(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.ActivatedAt != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ActivatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivatedAt):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintRecord(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Record_Local) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -491,6 +717,13 @@ func (m *Record_Local) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HdPath) > 0 {
+		i -= len(m.HdPath)
+		copy(dAtA[i:], m.HdPath)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.HdPath)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PrivKey != nil {
 		{
 			size, err := m.PrivKey.MarshalToSizedBuffer(dAtA[:i])
@@ -584,6 +817,50 @@ func (m *Record_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Record_KMS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record_KMS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_KMS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -615,6 +892,16 @@ func (m *Record) Size() (n int) {
 	if m.Item != nil {
 		n += m.Item.Size()
 	}
+	if len(m.KeyHistory) > 0 {
+		for _, e := range m.KeyHistory {
+			l = e.Size()
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	if m.ActivatedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivatedAt)
+		n += 1 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -666,6 +953,37 @@ func (m *Record_Offline_) Size() (n int) {
 	}
 	return n
 }
+func (m *Record_Kms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kms != nil {
+		l = m.Kms.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+func (m *Record_RotatedKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.ActivatedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivatedAt)
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RotatedAt)
+	n += 1 + l + sovRecord(uint64(l))
+	return n
+}
+
 func (m *Record_Local) Size() (n int) {
 	if m == nil {
 		return 0
@@ -676,6 +994,10 @@ func (m *Record_Local) Size() (n int) {
 		l = m.PrivKey.Size()
 		n += 1 + l + sovRecord(uint64(l))
 	}
+	l = len(m.HdPath)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -707,6 +1029,27 @@ func (m *Record_Offline) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *Record_KMS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -953,59 +1296,9 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &Record_Offline_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRecord(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRecord
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Record_Local) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRecord
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Local: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Local: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1032,18 +1325,360 @@ func (m *Record_Local) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrivKey == nil {
-				m.PrivKey = &any.Any{}
-			}
-			if err := m.PrivKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &Record_KMS{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Item = &Record_Kms{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRecord(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyHistory = append(m.KeyHistory, &Record_RotatedKey{})
+			if err := m.KeyHistory[len(m.KeyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivatedAt == nil {
+				m.ActivatedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ActivatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Record_RotatedKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotatedKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotatedKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivatedAt == nil {
+				m.ActivatedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ActivatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RotatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Record_Local) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Local: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Local: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrivKey == nil {
+				m.PrivKey = &any.Any{}
+			}
+			if err := m.PrivKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HdPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HdPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRecord
@@ -1225,6 +1860,154 @@ func (m *Record_Offline) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Offline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Record_KMS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KMS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KMS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
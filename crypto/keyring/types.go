@@ -49,6 +49,7 @@ const (
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
 	TypeMulti   KeyType = 3
+	TypeKMS     KeyType = 4
 )
 
 var keyTypes = map[KeyType]string{
@@ -56,6 +57,7 @@ var keyTypes = map[KeyType]string{
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
 	TypeMulti:   "multi",
+	TypeKMS:     "kms",
 }
 
 // String implements the stringer interface for KeyType.
@@ -37,6 +37,12 @@ func GenPrivKey() (PrivKey, error) {
 	panic("not implemented, build flags are required to use bls12_381 keys")
 }
 
+// GenPrivKeyFromSecret deterministically generates a new key from the given secret, e.g. a seed derived
+// from a mnemonic.
+func GenPrivKeyFromSecret(secret []byte) (PrivKey, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
 // Bytes returns the byte representation of the Key.
 func (privKey PrivKey) Bytes() []byte {
 	panic("not implemented, build flags are required to use bls12_381 keys")
@@ -47,6 +47,18 @@ func GenPrivKey() (PrivKey, error) {
 	}, err
 }
 
+// GenPrivKeyFromSecret deterministically generates a new key from the given secret, e.g. a seed derived
+// from a mnemonic.
+func GenPrivKeyFromSecret(secret []byte) (PrivKey, error) {
+	secretKey, err := bls12381.GenPrivKeyFromSecret(secret)
+	if err != nil {
+		return PrivKey{}, err
+	}
+	return PrivKey{
+		Key: secretKey.Bytes(),
+	}, nil
+}
+
 // Bytes returns the byte representation of the Key.
 func (privKey PrivKey) Bytes() []byte {
 	return privKey.Key
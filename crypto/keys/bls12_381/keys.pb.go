@@ -24,11 +24,8 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// PubKey is an ed25519 public key for handling Tendermint keys in SDK.
-// It's needed for Any serialization and SDK compatibility.
-// It must not be used in a non Tendermint key context because it doesn't implement
-// ADR-28. Nevertheless, you will like to use ed25519 in app user level
-// then you must create a new proto message and follow ADR-28 for Address construction.
+// PubKey is a BLS12-381 public key, which can be used for validator consensus keys and for account keys
+// of chains experimenting with aggregated signatures.
 type PubKey struct {
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
@@ -72,8 +69,7 @@ func (m *PubKey) GetKey() []byte {
 	return nil
 }
 
-// PrivKey defines a ed25519 private key.
-// NOTE: ed25519 keys must not be used in SDK apps except in a tendermint validator context.
+// PrivKey defines a BLS12-381 private key.
 type PrivKey struct {
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
@@ -128,7 +124,7 @@ func init() {
 }
 
 var fileDescriptor_afa2b84d543bb80f = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
+	// 241 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xaa, 0x2c, 0x28, 0xc9, 0xd7, 0x4f, 0xca, 0x29, 0x36, 0x34, 0x8a,
 	0x37, 0xb6, 0x30, 0xd4, 0xcf, 0x4e, 0xad, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
@@ -143,8 +139,8 @@ var fileDescriptor_afa2b84d543bb80f = []byte{
 	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
 	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x28, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
 	0x57, 0x1f, 0x16, 0x44, 0x60, 0x4a, 0xb7, 0x38, 0x25, 0x1b, 0x16, 0x5a, 0xa0, 0x30, 0x42, 0x04,
-	0x59, 0x12, 0x1b, 0xd8, 0xb3, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x05, 0x13, 0x8a,
-	0x54, 0x01, 0x00, 0x00,
+	0x59, 0x12, 0x1b, 0xd8, 0xb3, 0xc6, 0x80, 0x01, 0x00, 0xc1, 0x05, 0x13, 0x8a, 0x54, 0x01, 0x00,
+	0x00,
 }
 
 func (m *PubKey) Marshal() (dAtA []byte, err error) {
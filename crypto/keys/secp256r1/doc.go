@@ -17,6 +17,11 @@ const (
 	pubKeySize = fieldSize + 1
 
 	name = "secp256r1"
+
+	// PrivKeyName is the amino name of the secp256r1 private key.
+	PrivKeyName = "cosmos/PrivKeySecp256r1"
+	// PubKeyName is the amino name of the secp256r1 public key.
+	PubKeyName = "cosmos/PubKeySecp256r1"
 )
 
 var secp256r1 elliptic.Curve
@@ -30,7 +35,8 @@ func init() {
 	}
 }
 
-// RegisterInterfaces adds secp256r1 PubKey to pubkey registry
+// RegisterInterfaces adds secp256r1 PubKey and PrivKey to the pubkey and privkey registries
 func RegisterInterfaces(registry registry.InterfaceRegistrar) {
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
 }
@@ -2,12 +2,17 @@ package secp256r1
 
 import (
 	"encoding/base64"
+	"errors"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
-var _ customProtobufType = (*ecdsaSK)(nil)
+var (
+	_ customProtobufType   = (*ecdsaSK)(nil)
+	_ codec.AminoMarshaler = &PrivKey{}
+)
 
 // GenPrivKey generates a new secp256r1 private key. It uses operating system randomness.
 func GenPrivKey() (*PrivKey, error) {
@@ -15,6 +20,19 @@ func GenPrivKey() (*PrivKey, error) {
 	return &PrivKey{&ecdsaSK{key}}, err
 }
 
+// NewPrivKeyFromBytes creates a secp256r1 private key from its secret number serialized using big-endian
+// encoding, as returned by Bytes.
+func NewPrivKeyFromBytes(bz []byte) (*PrivKey, error) {
+	sk := &ecdsaSK{}
+	if err := sk.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	if sk.D.Sign() == 0 || sk.D.Cmp(secp256r1.Params().N) >= 0 {
+		return nil, errors.New("invalid secp256r1 secret: must be in the range [1, N-1]")
+	}
+	return &PrivKey{sk}, nil
+}
+
 // PubKey implements SDK PrivKey interface.
 func (m *PrivKey) PubKey() cryptotypes.PubKey {
 	return &PubKey{&ecdsaPK{m.Secret.PubKey()}}
@@ -52,6 +70,31 @@ func (m *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
 	return m.Secret.Equal(&sk2.Secret.PrivateKey)
 }
 
+// MarshalAmino overrides Amino binary marshaling.
+func (m PrivKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (m *PrivKey) UnmarshalAmino(bz []byte) error {
+	sk, err := NewPrivKeyFromBytes(bz)
+	if err != nil {
+		return err
+	}
+	*m = *sk
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (m PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (m *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaSK struct {
 	ecdsa.PrivKey
 }
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+
+	"cosmossdk.io/schema"
+)
+
+// bech32PrefixRegex matches the bech32 human-readable prefixes which are supported for generated address columns.
+var bech32PrefixRegex = regexp.MustCompile(`^[a-z0-9]{1,83}$`)
+
+// validateBech32AddressPrefix checks that the prefix can be used for generated address columns with the dialect.
+func validateBech32AddressPrefix(d dialect, prefix string) error {
+	if d.name() != "postgres" {
+		return fmt.Errorf("bech32 address columns are not supported by the %s dialect", d.name())
+	}
+
+	if !bech32PrefixRegex.MatchString(prefix) {
+		return fmt.Errorf("invalid bech32 address prefix %q, only lowercase letters and digits are allowed", prefix)
+	}
+
+	return nil
+}
+
+// hasAddressBytesColumn returns true if the field is an address field which is stored as raw bytes in a
+// _bytes suffixed column together with a bech32 string generated column.
+func (tm *objectIndexer) hasAddressBytesColumn(field schema.Field) bool {
+	return field.Kind == schema.AddressKind && tm.options.bech32AddressPrefix != ""
+}
+
+// createAddressColumnDefinition writes the column definitions for an address field stored as raw bytes.
+// Like time fields, we generate two columns:
+// - one with the raw address bytes, suffixed with _bytes
+// - one with the bech32 string computed with the configured prefix, that is GENERATED
+func (tm *objectIndexer) createAddressColumnDefinition(writer io.Writer, field schema.Field) error {
+	bytesColName := tm.options.dialect.quoteIdentifier(fmt.Sprintf("%s_bytes", field.Name))
+	_, err := fmt.Fprintf(writer, "TEXT GENERATED ALWAYS AS (bech32_encode('%s', %s)) STORED,\n\t",
+		tm.options.bech32AddressPrefix, bytesColName)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "%s BYTEA", bytesColName)
+	return err
+}
+
+// bech32EncodeSQL defines the bech32_encode function used by generated address columns.
+// It implements the bech32 encoding defined in BIP-173 and is IMMUTABLE so that it can be used
+// in generated columns and indexes.
+const bech32EncodeSQL = `
+CREATE OR REPLACE FUNCTION bech32_encode(hrp TEXT, data BYTEA) RETURNS TEXT AS $$
+DECLARE
+    charset   CONSTANT TEXT     := 'qpzry9x8gf2tvdw0s3jn54khce6mua7l';
+    generator CONSTANT BIGINT[] := ARRAY [996825010, 642813549, 513874426, 1027748829, 705979059];
+    words     INTEGER[]         := ARRAY []::INTEGER[];
+    checked   INTEGER[]         := ARRAY []::INTEGER[];
+    acc       INTEGER           := 0;
+    bits      INTEGER           := 0;
+    chk       BIGINT            := 1;
+    top       BIGINT;
+    v         INTEGER;
+    result    TEXT;
+BEGIN
+    IF data IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    -- regroup the 8-bit bytes into 5-bit words
+    FOR i IN 0 .. length(data) - 1 LOOP
+        acc := ((acc << 8) | get_byte(data, i)) & 4095;
+        bits := bits + 8;
+        WHILE bits >= 5 LOOP
+            bits := bits - 5;
+            words := words || ((acc >> bits) & 31);
+        END LOOP;
+    END LOOP;
+    IF bits > 0 THEN
+        words := words || ((acc << (5 - bits)) & 31);
+    END IF;
+
+    -- the checksum covers the expanded human-readable part, the data words and six zero words
+    FOR i IN 1 .. length(hrp) LOOP
+        checked := checked || (ascii(substr(hrp, i, 1)) >> 5);
+    END LOOP;
+    checked := checked || 0;
+    FOR i IN 1 .. length(hrp) LOOP
+        checked := checked || (ascii(substr(hrp, i, 1)) & 31);
+    END LOOP;
+    checked := checked || words || ARRAY [0, 0, 0, 0, 0, 0];
+
+    FOREACH v IN ARRAY checked LOOP
+        top := chk >> 25;
+        chk := ((chk & 33554431) << 5) # v;
+        FOR j IN 0 .. 4 LOOP
+            IF ((top >> j) & 1) = 1 THEN
+                chk := chk # generator[j + 1];
+            END IF;
+        END LOOP;
+    END LOOP;
+    chk := chk # 1;
+
+    result := hrp || '1';
+    FOREACH v IN ARRAY words LOOP
+        result := result || substr(charset, v + 1, 1);
+    END LOOP;
+    FOR i IN 0 .. 5 LOOP
+        result := result || substr(charset, ((chk >> (5 * (5 - i))) & 31)::INTEGER + 1, 1);
+    END LOOP;
+
+    RETURN result;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+`
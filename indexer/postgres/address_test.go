@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_createTableSql_vote_bech32() {
+	tm := newBech32ObjectIndexer()
+	err := tm.createTableSql(os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" BIGINT NOT NULL,
+	// 	"address" TEXT GENERATED ALWAYS AS (bech32_encode('cosmos', "address_bytes")) STORED,
+	// 	"address_bytes" BYTEA NOT NULL,
+	// 	"vote" "test_vote_type" NOT NULL,
+	// 	_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	// 	PRIMARY KEY ("proposal", "address_bytes")
+	// );
+	// GRANT SELECT ON TABLE "test_vote" TO PUBLIC;
+}
+
+func Example_objectIndexer_insertSql_bech32() {
+	tm := newBech32ObjectIndexer()
+	buf := new(strings.Builder)
+	params, err := tm.insertSql(buf, []interface{}{int64(1), []byte{0x01, 0x02}}, "yes", nil)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(buf.String())
+	fmt.Println(params)
+	// Output:
+	// INSERT INTO "test_vote" ("proposal", "address_bytes", "vote") VALUES ($1, $2, $3);
+	// [1 [1 2] yes]
+}
+
+func Example_validateBech32AddressPrefix() {
+	fmt.Println(validateBech32AddressPrefix(postgresDialect{}, "cosmos"))
+	fmt.Println(validateBech32AddressPrefix(postgresDialect{}, "cosmos'); DROP TABLE block; --"))
+	fmt.Println(validateBech32AddressPrefix(sqliteDialect{}, "cosmos"))
+	// Output:
+	// <nil>
+	// invalid bech32 address prefix "cosmos'); DROP TABLE block; --", only lowercase letters and digits are allowed
+	// bech32 address columns are not supported by the sqlite dialect
+}
+
+func newBech32ObjectIndexer() *objectIndexer {
+	return newObjectIndexer("test", testdata.ExampleSchema, testdata.VoteObject, options{
+		logger:              logutil.NoopLogger{},
+		addressCodec:        addressutil.HexAddressCodec{},
+		bech32AddressPrefix: "cosmos",
+		dialect:             postgresDialect{},
+	})
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/schema"
+)
+
+// changeMetadata is the change data capture (CDC) metadata which is written to the _block_number
+// and _tx_ordinal columns of every inserted, updated or retained deleted row when enabled.
+// Together with the block_commit table it allows downstream CDC pipelines which consume logical
+// decoding output (Debezium, logical replication, etc.) to order changes correctly.
+type changeMetadata struct {
+	// blockNumber is the number of the block in which the change occurred.
+	blockNumber uint64
+
+	// ordinal is the 1-based position of the change among all changes written in the block's
+	// database transaction.
+	ordinal int64
+}
+
+// blockCommitSQL creates the block_commit table, which receives exactly one row as the last write
+// of each block's database transaction. CDC consumers can use it as an explicit block boundary
+// and to verify that they have received all changes of the block.
+const blockCommitSQL = `
+CREATE TABLE IF NOT EXISTS block_commit
+(
+    number       BIGINT NOT NULL PRIMARY KEY REFERENCES block (number),
+    change_count BIGINT NOT NULL
+);
+`
+
+// createCDCColumnDefinitions writes the CDC metadata column definitions within a CREATE TABLE statement.
+func (tm *objectIndexer) createCDCColumnDefinitions(writer io.Writer) error {
+	colType := tm.options.dialect.columnType(schema.Int64Kind, false)
+	_, err := fmt.Fprintf(writer, "_block_number %s NULL,\n\t_tx_ordinal %s NULL,\n\t", colType, colType)
+	return err
+}
+
+// cdcParams returns the CDC metadata columns and parameters which should be written with the change
+// or nil if CDC metadata is disabled.
+func (tm *objectIndexer) cdcParams(meta *changeMetadata) ([]interface{}, []string) {
+	if !tm.options.cdcMetadata || meta == nil {
+		return nil, nil
+	}
+
+	return []interface{}{meta.blockNumber, meta.ordinal}, []string{"_block_number", "_tx_ordinal"}
+}
+
+// nextChangeMetadata returns the CDC metadata for the next change in the current block or nil if
+// CDC metadata is disabled.
+func (i *indexerImpl) nextChangeMetadata() *changeMetadata {
+	if !i.opts.cdcMetadata {
+		return nil
+	}
+
+	i.changeCount++
+	return &changeMetadata{
+		blockNumber: i.blockNumber,
+		ordinal:     i.changeCount,
+	}
+}
+
+// commitBlockBoundary writes the block_commit row for the current block, which marks the end of its changes.
+func (i *indexerImpl) commitBlockBoundary(ctx context.Context, conn dbConn) error {
+	if !i.opts.cdcMetadata || !i.inBlock {
+		return nil
+	}
+
+	_, err := conn.ExecContext(ctx,
+		fmt.Sprintf("INSERT INTO block_commit (number, change_count) VALUES (%s)", bindVarList(i.opts.dialect, 2)),
+		i.blockNumber, i.changeCount)
+	return err
+}
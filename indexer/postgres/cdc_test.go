@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_createTableSql_vote_cdc() {
+	tm := newCDCObjectIndexer()
+	err := tm.createTableSql(os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" BIGINT NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"vote" "test_vote_type" NOT NULL,
+	// 	_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	// 	_block_number BIGINT NULL,
+	// 	_tx_ordinal BIGINT NULL,
+	// 	PRIMARY KEY ("proposal", "address")
+	// );
+	// GRANT SELECT ON TABLE "test_vote" TO PUBLIC;
+}
+
+func Example_objectIndexer_insertSql_cdc() {
+	tm := newCDCObjectIndexer()
+	buf := new(strings.Builder)
+	params, err := tm.insertSql(buf, []interface{}{int64(1), []byte{0x01}}, "yes", &changeMetadata{blockNumber: 10, ordinal: 3})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(buf.String())
+	fmt.Println(params)
+	// Output:
+	// INSERT INTO "test_vote" ("proposal", "address", "vote", _block_number, _tx_ordinal) VALUES ($1, $2, $3, $4, $5);
+	// [1 0x01 yes 10 3]
+}
+
+func Example_objectIndexer_updateSql_cdc() {
+	tm := newCDCObjectIndexer()
+	buf := new(strings.Builder)
+	params, err := tm.updateSql(buf, []interface{}{int64(1), []byte{0x01}}, "no", &changeMetadata{blockNumber: 10, ordinal: 4})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(buf.String())
+	fmt.Println(params)
+	// Output:
+	// UPDATE "test_vote" SET "vote" = $1, _block_number = $2, _tx_ordinal = $3, _deleted = FALSE WHERE "proposal" = $4 AND "address" = $5;
+	// [no 10 4 1 0x01]
+}
+
+func Example_objectIndexer_retainDeleteSqlAndParams_cdc() {
+	tm := newCDCObjectIndexer()
+	buf := new(strings.Builder)
+	params, err := tm.retainDeleteSqlAndParams(buf, []interface{}{int64(1), []byte{0x01}}, &changeMetadata{blockNumber: 11, ordinal: 1})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(buf.String())
+	fmt.Println(params)
+	// Output:
+	// UPDATE "test_vote" SET _deleted = TRUE, _block_number = $1, _tx_ordinal = $2 WHERE "proposal" = $3 AND "address" = $4;
+	// [11 1 1 0x01]
+}
+
+func newCDCObjectIndexer() *objectIndexer {
+	return newObjectIndexer("test", testdata.ExampleSchema, testdata.VoteObject, options{
+		logger:       logutil.NoopLogger{},
+		addressCodec: addressutil.HexAddressCodec{},
+		cdcMetadata:  true,
+		dialect:      postgresDialect{},
+	})
+}
@@ -8,13 +8,24 @@ import (
 )
 
 // createColumnDefinition writes a column definition within a CREATE TABLE statement for the field.
-func (tm *objectIndexer) createColumnDefinition(writer io.Writer, field schema.Field) error {
-	_, err := fmt.Fprintf(writer, "%q ", field.Name)
+// key indicates whether the field is part of the primary key.
+func (tm *objectIndexer) createColumnDefinition(writer io.Writer, field schema.Field, key bool) error {
+	d := tm.options.dialect
+	_, err := fmt.Fprintf(writer, "%s ", d.quoteIdentifier(field.Name))
 	if err != nil {
 		return err
 	}
 
-	simple := simpleColumnType(field.Kind)
+	if tm.hasAddressBytesColumn(field) {
+		err = tm.createAddressColumnDefinition(writer, field)
+		if err != nil {
+			return err
+		}
+
+		return writeNullability(writer, field.Nullable)
+	}
+
+	simple := d.columnType(field.Kind, key)
 	if simple != "" {
 		_, err = fmt.Fprintf(writer, "%s", simple)
 		if err != nil {
@@ -25,21 +36,26 @@ func (tm *objectIndexer) createColumnDefinition(writer io.Writer, field schema.F
 	} else {
 		switch field.Kind {
 		case schema.EnumKind:
-			_, err = fmt.Fprintf(writer, "%q", enumTypeName(tm.moduleName, field.ReferencedType))
+			enumType, ok := tm.modSchema.LookupEnumType(field.ReferencedType)
+			if !ok {
+				return fmt.Errorf("enum type %q not found in schema for module %s", field.ReferencedType, tm.moduleName)
+			}
+
+			_, err = fmt.Fprintf(writer, "%s", d.enumColumnType(tm.moduleName, field.Name, enumType))
 			if err != nil {
 				return err
 			}
 		case schema.TimeKind:
 			// for time fields, we generate two columns:
 			// - one with nanoseconds precision for lossless storage, suffixed with _nanos
-			// - one as a timestamptz (microsecond precision) for ease of use, that is GENERATED
-			nanosColName := fmt.Sprintf("%s_nanos", field.Name)
-			_, err = fmt.Fprintf(writer, "TIMESTAMPTZ GENERATED ALWAYS AS (nanos_to_timestamptz(%q)) STORED,\n\t", nanosColName)
+			// - one as a timestamp (microsecond precision) for ease of use, that is GENERATED
+			nanosColName := d.quoteIdentifier(fmt.Sprintf("%s_nanos", field.Name))
+			_, err = fmt.Fprintf(writer, "%s,\n\t", d.timeColumnType(nanosColName))
 			if err != nil {
 				return err
 			}
 
-			_, err = fmt.Fprintf(writer, `%q BIGINT`, nanosColName)
+			_, err = fmt.Fprintf(writer, "%s %s", nanosColName, d.columnType(schema.Int64Kind, key))
 			if err != nil {
 				return err
 			}
@@ -63,6 +79,7 @@ func writeNullability(writer io.Writer, nullable bool) error {
 }
 
 // simpleColumnType returns the postgres column type for the kind for simple types.
+// Other dialects define their own mappings in dialect.columnType.
 func simpleColumnType(kind schema.Kind) string {
 	//nolint:goconst // adding constants for these postgres type names would impede readability
 	switch kind {
@@ -109,12 +126,15 @@ func simpleColumnType(kind schema.Kind) string {
 
 // updatableColumnName is the name of the insertable/updatable column name for the field.
 // This is the field name in most cases, except for time columns which are stored as nanos
-// and then converted to timestamp generated columns.
+// and then converted to timestamp generated columns, and address columns which are stored
+// as raw bytes and converted to bech32 generated columns when enabled.
 func (tm *objectIndexer) updatableColumnName(field schema.Field) (name string, err error) {
 	name = field.Name
 	if field.Kind == schema.TimeKind {
 		name = fmt.Sprintf("%s_nanos", name)
+	} else if tm.hasAddressBytesColumn(field) {
+		name = fmt.Sprintf("%s_bytes", name)
 	}
-	name = fmt.Sprintf("%q", name)
+	name = tm.options.dialect.quoteIdentifier(name)
 	return
 }
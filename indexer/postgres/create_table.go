@@ -25,7 +25,7 @@ func (tm *objectIndexer) createTable(ctx context.Context, conn dbConn) error {
 
 // createTableSql generates a CREATE TABLE statement for the object type.
 func (tm *objectIndexer) createTableSql(writer io.Writer) error {
-	_, err := fmt.Fprintf(writer, "CREATE TABLE IF NOT EXISTS %q (\n\t", tm.tableName())
+	_, err := fmt.Fprintf(writer, "CREATE TABLE IF NOT EXISTS %s (\n\t", tm.quotedTableName())
 	if err != nil {
 		return err
 	}
@@ -38,7 +38,7 @@ func (tm *objectIndexer) createTableSql(writer io.Writer) error {
 		}
 	} else {
 		for _, field := range tm.typ.KeyFields {
-			err = tm.createColumnDefinition(writer, field)
+			err = tm.createColumnDefinition(writer, field, true)
 			if err != nil {
 				return err
 			}
@@ -46,7 +46,7 @@ func (tm *objectIndexer) createTableSql(writer io.Writer) error {
 	}
 
 	for _, field := range tm.typ.ValueFields {
-		err = tm.createColumnDefinition(writer, field)
+		err = tm.createColumnDefinition(writer, field, false)
 		if err != nil {
 			return err
 		}
@@ -60,6 +60,14 @@ func (tm *objectIndexer) createTableSql(writer io.Writer) error {
 		}
 	}
 
+	// add CDC metadata columns when enabled
+	if tm.options.cdcMetadata {
+		err = tm.createCDCColumnDefinitions(writer)
+		if err != nil {
+			return err
+		}
+	}
+
 	var pKeys []string
 	if !isSingleton {
 		for _, field := range tm.typ.KeyFields {
@@ -79,17 +87,16 @@ func (tm *objectIndexer) createTableSql(writer io.Writer) error {
 		return err
 	}
 
-	_, err = fmt.Fprintf(writer, "\n);\n")
+	_, err = fmt.Fprintf(writer, "\n);")
 	if err != nil {
 		return err
 	}
 
-	// we GRANT SELECT on the table to PUBLIC so that the table is automatically available
-	// for querying using off-the-shelf tools like pg_graphql, Postgrest, Postgraphile, etc.
-	// without any login permissions
-	_, err = fmt.Fprintf(writer, "GRANT SELECT ON TABLE %q TO PUBLIC;", tm.tableName())
-	if err != nil {
-		return err
+	if grant := tm.options.dialect.grantSelectSql(tm.tableName()); grant != "" {
+		_, err = fmt.Fprintf(writer, "\n%s", grant)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -84,9 +84,14 @@ func exampleCreateTable(objectType schema.StateObjectType) {
 }
 
 func exampleCreateTableOpt(objectType schema.StateObjectType, noRetainDelete bool) {
-	tm := newObjectIndexer("test", objectType, options{
+	exampleCreateTableDialect(objectType, noRetainDelete, postgresDialect{})
+}
+
+func exampleCreateTableDialect(objectType schema.StateObjectType, noRetainDelete bool, d dialect) {
+	tm := newObjectIndexer("test", testdata.ExampleSchema, objectType, options{
 		logger:                 logutil.NoopLogger{},
 		disableRetainDeletions: noRetainDelete,
+		dialect:                d,
 	})
 	err := tm.createTableSql(os.Stdout)
 	if err != nil {
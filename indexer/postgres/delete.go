@@ -8,12 +8,13 @@ import (
 )
 
 // delete deletes the row with the provided key from the table.
-func (tm *objectIndexer) delete(ctx context.Context, conn dbConn, key interface{}) error {
+// meta is only written when the table retains deletions and may be nil.
+func (tm *objectIndexer) delete(ctx context.Context, conn dbConn, key interface{}, meta *changeMetadata) error {
 	buf := new(strings.Builder)
 	var params []interface{}
 	var err error
 	if !tm.options.disableRetainDeletions && tm.typ.RetainDeletions {
-		params, err = tm.retainDeleteSqlAndParams(buf, key)
+		params, err = tm.retainDeleteSqlAndParams(buf, key, meta)
 	} else {
 		params, err = tm.deleteSqlAndParams(buf, key)
 	}
@@ -29,7 +30,7 @@ func (tm *objectIndexer) delete(ctx context.Context, conn dbConn, key interface{
 
 // deleteSqlAndParams generates a DELETE statement and binding parameters for the provided key.
 func (tm *objectIndexer) deleteSqlAndParams(w io.Writer, key interface{}) ([]interface{}, error) {
-	_, err := fmt.Fprintf(w, "DELETE FROM %q", tm.tableName())
+	_, err := fmt.Fprintf(w, "DELETE FROM %s", tm.quotedTableName())
 	if err != nil {
 		return nil, err
 	}
@@ -45,17 +46,27 @@ func (tm *objectIndexer) deleteSqlAndParams(w io.Writer, key interface{}) ([]int
 
 // retainDeleteSqlAndParams generates an UPDATE statement to set the _deleted column to true for the provided key
 // which is used when the table is set to retain deletions mode.
-func (tm *objectIndexer) retainDeleteSqlAndParams(w io.Writer, key interface{}) ([]interface{}, error) {
-	_, err := fmt.Fprintf(w, "UPDATE %q SET _deleted = TRUE", tm.tableName())
+func (tm *objectIndexer) retainDeleteSqlAndParams(w io.Writer, key interface{}, meta *changeMetadata) ([]interface{}, error) {
+	_, err := fmt.Fprintf(w, "UPDATE %s SET _deleted = TRUE", tm.quotedTableName())
 	if err != nil {
 		return nil, err
 	}
 
-	_, keyParams, err := tm.whereSqlAndParams(w, key, 1)
+	paramIdx := 1
+	cdcParams, cdcCols := tm.cdcParams(meta)
+	for _, col := range cdcCols {
+		_, err = fmt.Fprintf(w, ", %s = %s", col, tm.options.dialect.bindVar(paramIdx))
+		if err != nil {
+			return nil, err
+		}
+		paramIdx++
+	}
+
+	_, keyParams, err := tm.whereSqlAndParams(w, key, paramIdx)
 	if err != nil {
 		return nil, err
 	}
 
 	_, err = fmt.Fprintf(w, ";")
-	return keyParams, err
+	return append(cdcParams, keyParams...), err
 }
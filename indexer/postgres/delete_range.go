@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// deleteRange deletes all rows with keys in the provided range from the table using a single statement.
+// meta is only written when the table retains deletions and may be nil.
+func (tm *objectIndexer) deleteRange(ctx context.Context, conn dbConn, keyRange schema.KeyRange, meta *changeMetadata) error {
+	buf := new(strings.Builder)
+	var params []interface{}
+	var err error
+	if !tm.options.disableRetainDeletions && tm.typ.RetainDeletions {
+		params, err = tm.retainDeleteRangeSqlAndParams(buf, keyRange, meta)
+	} else {
+		params, err = tm.deleteRangeSqlAndParams(buf, keyRange)
+	}
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.logger != nil {
+		tm.options.logger.Debug("Delete range", "sql", sqlStr, "params", params)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// deleteRangeSqlAndParams generates a DELETE statement and binding parameters for the provided key range.
+func (tm *objectIndexer) deleteRangeSqlAndParams(w io.Writer, keyRange schema.KeyRange) ([]interface{}, error) {
+	_, err := fmt.Fprintf(w, "DELETE FROM %s", tm.quotedTableName())
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := tm.whereRangeSql(w, keyRange, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return params, err
+}
+
+// retainDeleteRangeSqlAndParams generates an UPDATE statement to set the _deleted column to true for all rows
+// in the provided key range which is used when the table is set to retain deletions mode.
+func (tm *objectIndexer) retainDeleteRangeSqlAndParams(w io.Writer, keyRange schema.KeyRange, meta *changeMetadata) ([]interface{}, error) {
+	_, err := fmt.Fprintf(w, "UPDATE %s SET _deleted = TRUE", tm.quotedTableName())
+	if err != nil {
+		return nil, err
+	}
+
+	paramIdx := 1
+	cdcParams, cdcCols := tm.cdcParams(meta)
+	for _, col := range cdcCols {
+		_, err = fmt.Fprintf(w, ", %s = %s", col, tm.options.dialect.bindVar(paramIdx))
+		if err != nil {
+			return nil, err
+		}
+		paramIdx++
+	}
+
+	rangeParams, err := tm.whereRangeSql(w, keyRange, paramIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return append(cdcParams, rangeParams...), err
+}
+
+// whereRangeSql generates a WHERE clause matching all rows in the key range and returns the parameters.
+// Ranges over a single column use BETWEEN, ranges over multiple columns use row value comparisons
+// and ranges where both bounds are equal, i.e. prefix deletions, use plain equality so that the
+// primary key index can always be used.
+func (tm *objectIndexer) whereRangeSql(w io.Writer, keyRange schema.KeyRange, startParamIdx int) ([]interface{}, error) {
+	startParams, cols, err := tm.bindKeyPrefixParams(keyRange.Start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range start: %w", err)
+	}
+
+	endParams, endCols, err := tm.bindKeyPrefixParams(keyRange.End)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range end: %w", err)
+	}
+
+	if len(cols) != len(endCols) {
+		return nil, fmt.Errorf("range start has %d key fields but range end has %d", len(cols), len(endCols))
+	}
+
+	if reflect.DeepEqual(startParams, endParams) {
+		_, params, err := tm.whereSql(w, startParams, cols, startParamIdx)
+		return params, err
+	}
+
+	d := tm.options.dialect
+	if len(cols) == 1 {
+		_, err = fmt.Fprintf(w, " WHERE %s BETWEEN %s AND %s", cols[0], d.bindVar(startParamIdx), d.bindVar(startParamIdx+1))
+		return append(startParams, endParams...), err
+	}
+
+	startVars := make([]string, 0, len(cols))
+	endVars := make([]string, 0, len(cols))
+	for i := range cols {
+		startVars = append(startVars, d.bindVar(startParamIdx+i))
+		endVars = append(endVars, d.bindVar(startParamIdx+len(cols)+i))
+	}
+
+	colList := strings.Join(cols, ", ")
+	_, err = fmt.Fprintf(w, " WHERE (%s) >= (%s) AND (%s) <= (%s)",
+		colList, strings.Join(startVars, ", "), colList, strings.Join(endVars, ", "))
+	return append(startParams, endParams...), err
+}
+
+// bindKeyPrefixParams binds a key prefix as described by schema.KeyRange to the first key columns.
+func (tm *objectIndexer) bindKeyPrefixParams(prefix interface{}) ([]interface{}, []string, error) {
+	n := len(tm.typ.KeyFields)
+	if n == 0 {
+		return nil, nil, errors.New("key ranges are not supported for singleton objects")
+	}
+
+	values := []interface{}{prefix}
+	if n > 1 {
+		var ok bool
+		values, ok = prefix.([]interface{})
+		if !ok {
+			return nil, nil, errors.New("expected key prefix to be a slice")
+		}
+
+		if len(values) == 0 || len(values) > n {
+			return nil, nil, fmt.Errorf("expected between 1 and %d key prefix values, got %d", n, len(values))
+		}
+	}
+
+	for _, value := range values {
+		if value == nil {
+			return nil, nil, errors.New("null values are not supported in key ranges")
+		}
+	}
+
+	return tm.bindParams(tm.typ.KeyFields[:len(values)], values)
+}
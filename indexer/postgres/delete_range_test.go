@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_deleteRangeSqlAndParams_singleColumn() {
+	exampleDeleteRangeSql(testdata.VoteObject, true, schema.KeyRange{
+		Start: []interface{}{int64(1)},
+		End:   []interface{}{int64(5)},
+	})
+	// Output:
+	// DELETE FROM "test_vote" WHERE "proposal" BETWEEN $1 AND $2;
+	// [1 5]
+}
+
+func Example_objectIndexer_deleteRangeSqlAndParams_prefix() {
+	exampleDeleteRangeSql(testdata.VoteObject, true, schema.KeyRange{
+		Start: []interface{}{int64(3)},
+		End:   []interface{}{int64(3)},
+	})
+	// Output:
+	// DELETE FROM "test_vote" WHERE "proposal" = $1;
+	// [3]
+}
+
+func Example_objectIndexer_deleteRangeSqlAndParams_multiColumn() {
+	exampleDeleteRangeSql(testdata.VoteObject, true, schema.KeyRange{
+		Start: []interface{}{int64(1), []byte{0x01}},
+		End:   []interface{}{int64(1), []byte{0x0f}},
+	})
+	// Output:
+	// DELETE FROM "test_vote" WHERE ("proposal", "address") >= ($1, $2) AND ("proposal", "address") <= ($3, $4);
+	// [1 0x01 1 0x0f]
+}
+
+func Example_objectIndexer_retainDeleteRangeSqlAndParams() {
+	exampleDeleteRangeSql(testdata.VoteObject, false, schema.KeyRange{
+		Start: []interface{}{int64(1)},
+		End:   []interface{}{int64(5)},
+	})
+	// Output:
+	// UPDATE "test_vote" SET _deleted = TRUE WHERE "proposal" BETWEEN $1 AND $2;
+	// [1 5]
+}
+
+func Example_objectIndexer_deleteRangeSqlAndParams_mismatchedBounds() {
+	exampleDeleteRangeSql(testdata.VoteObject, true, schema.KeyRange{
+		Start: []interface{}{int64(1)},
+		End:   []interface{}{int64(1), []byte{0x0f}},
+	})
+	// Output:
+	// range start has 1 key fields but range end has 2
+}
+
+func exampleDeleteRangeSql(objectType schema.StateObjectType, noRetainDelete bool, keyRange schema.KeyRange) {
+	tm := newObjectIndexer("test", testdata.ExampleSchema, objectType, options{
+		logger:                 logutil.NoopLogger{},
+		addressCodec:           addressutil.HexAddressCodec{},
+		disableRetainDeletions: noRetainDelete,
+		dialect:                postgresDialect{},
+	})
+	buf := new(strings.Builder)
+	var params []interface{}
+	var err error
+	if noRetainDelete {
+		params, err = tm.deleteRangeSqlAndParams(buf, keyRange)
+	} else {
+		params, err = tm.retainDeleteRangeSqlAndParams(buf, keyRange, nil)
+	}
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(buf.String())
+	fmt.Println(params)
+}
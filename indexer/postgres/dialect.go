@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// dialect abstracts the parts of DDL and DML generation which differ between SQL database engines.
+// PostgreSQL is the reference dialect and the only one which supports all indexer features natively,
+// other dialects approximate them as closely as the target database allows.
+type dialect interface {
+	// name returns the name of the dialect as used in Config.Dialect.
+	name() string
+
+	// defaultDriver returns the database/sql driver name which is used when Config.DatabaseDriver is empty.
+	defaultDriver() string
+
+	// baseSQL returns the base SQL that is always included in the schema.
+	baseSQL() string
+
+	// quoteIdentifier quotes a table, column or type name so that it is case-sensitive
+	// and won't clash with reserved names.
+	quoteIdentifier(name string) string
+
+	// bindVar returns the placeholder for the query parameter at the 1-based index idx.
+	bindVar(idx int) string
+
+	// columnType returns the column type for simple kinds or an empty string if the kind requires
+	// special handling. key indicates whether the column is part of the primary key which matters
+	// for databases that cannot index unbounded column types.
+	columnType(kind schema.Kind, key bool) string
+
+	// timeColumnType returns the type definition of the generated column which presents the
+	// already quoted nanos column nanosCol as a timestamp.
+	timeColumnType(nanosCol string) string
+
+	// enumColumnType returns the column type for a column named col which references enum.
+	enumColumnType(moduleName, col string, enum schema.EnumType) string
+
+	// namedEnumTypes returns true if the dialect requires enum types to be created with CREATE TYPE before
+	// they can be referenced by tables.
+	namedEnumTypes() bool
+
+	// grantSelectSql returns a statement granting read access on the table to all users or an empty string
+	// if the dialect does not support such grants.
+	grantSelectSql(table string) string
+}
+
+// dialects are all the supported dialects keyed by name.
+var dialects = map[string]dialect{
+	"postgres": postgresDialect{},
+	"sqlite":   sqliteDialect{},
+	"mysql":    mysqlDialect{},
+}
+
+// getDialect returns the dialect with the given name, defaulting to postgres when name is empty.
+func getDialect(name string) (dialect, error) {
+	if name == "" {
+		return postgresDialect{}, nil
+	}
+
+	d, ok := dialects[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported SQL dialect %q", name)
+	}
+
+	return d, nil
+}
+
+// bindVarList returns a comma separated list of n placeholders for the dialect starting at index 1.
+func bindVarList(d dialect, n int) string {
+	vars := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		vars = append(vars, d.bindVar(i))
+	}
+	return strings.Join(vars, ", ")
+}
+
+// quoteLiteral quotes value as an SQL string literal, escaping single quotes by doubling them, which all
+// supported dialects accept.
+func quoteLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
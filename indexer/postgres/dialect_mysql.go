@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// mysqlDialect is the MySQL dialect for deployments where PostgreSQL isn't available.
+// It requires MySQL 8.0.16 or later for generated columns and enforced CHECK constraints.
+type mysqlDialect struct{}
+
+var _ dialect = mysqlDialect{}
+
+func (mysqlDialect) name() string { return "mysql" }
+
+func (mysqlDialect) defaultDriver() string { return "mysql" }
+
+func (mysqlDialect) baseSQL() string { return mysqlBaseSQL }
+
+func (mysqlDialect) quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func (mysqlDialect) bindVar(int) string {
+	return "?"
+}
+
+func (mysqlDialect) columnType(kind schema.Kind, key bool) string {
+	//nolint:goconst // adding constants for these mysql type names would impede readability
+	switch kind {
+	case schema.StringKind, schema.AddressKind:
+		// MySQL can't index TEXT columns without a prefix length, so key columns use VARCHAR
+		if key {
+			return "VARCHAR(255)"
+		}
+		return "TEXT"
+	case schema.BoolKind:
+		return "BOOLEAN"
+	case schema.BytesKind:
+		if key {
+			return "VARBINARY(255)"
+		}
+		return "LONGBLOB"
+	case schema.Int8Kind:
+		return "TINYINT"
+	case schema.Int16Kind:
+		return "SMALLINT"
+	case schema.Int32Kind:
+		return "INT"
+	case schema.Int64Kind:
+		return "BIGINT"
+	case schema.Uint8Kind:
+		return "TINYINT UNSIGNED"
+	case schema.Uint16Kind:
+		return "SMALLINT UNSIGNED"
+	case schema.Uint32Kind:
+		return "INT UNSIGNED"
+	case schema.Uint64Kind:
+		return "BIGINT UNSIGNED"
+	case schema.IntegerKind:
+		return "DECIMAL(65, 0)"
+	case schema.DecimalKind:
+		return "DECIMAL(65, 30)"
+	case schema.Float32Kind:
+		return "FLOAT"
+	case schema.Float64Kind:
+		return "DOUBLE"
+	case schema.JSONKind:
+		return "JSON"
+	case schema.DurationKind:
+		return "BIGINT"
+	default:
+		return ""
+	}
+}
+
+func (mysqlDialect) timeColumnType(nanosCol string) string {
+	// FROM_UNIXTIME depends on the session time zone and is therefore not allowed in generated columns
+	return fmt.Sprintf("DATETIME(6) GENERATED ALWAYS AS (TIMESTAMPADD(MICROSECOND, %s DIV 1000, '1970-01-01 00:00:00')) STORED", nanosCol)
+}
+
+func (mysqlDialect) enumColumnType(_, _ string, enum schema.EnumType) string {
+	return fmt.Sprintf("ENUM(%s)", enumValueList(enum))
+}
+
+func (mysqlDialect) namedEnumTypes() bool { return false }
+
+func (mysqlDialect) grantSelectSql(string) string { return "" }
+
+// mysqlBaseSQL is the MySQL version of baseSQL.
+const mysqlBaseSQL = `
+CREATE TABLE IF NOT EXISTS block
+(
+    number BIGINT NOT NULL PRIMARY KEY,
+    header JSON   NULL
+);
+
+CREATE TABLE IF NOT EXISTS tx
+(
+    id             BIGINT   NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    block_number   BIGINT   NOT NULL REFERENCES block (number),
+    index_in_block BIGINT   NOT NULL,
+    data           JSON     NULL,
+    bytes          LONGBLOB NULL
+);
+
+CREATE TABLE IF NOT EXISTS event
+(
+    id           BIGINT  NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    block_number BIGINT  NOT NULL REFERENCES block (number),
+    block_stage  INTEGER NOT NULL,
+    tx_index     BIGINT  NOT NULL,
+    msg_index    BIGINT  NOT NULL,
+    event_index  BIGINT  NOT NULL,
+    type         TEXT    NULL,
+    data         JSON    NULL
+);
+`
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"fmt"
+
+	"cosmossdk.io/schema"
+)
+
+// postgresDialect is the PostgreSQL dialect.
+type postgresDialect struct{}
+
+var _ dialect = postgresDialect{}
+
+func (postgresDialect) name() string { return "postgres" }
+
+func (postgresDialect) defaultDriver() string { return "pgx" }
+
+func (postgresDialect) baseSQL() string { return baseSQL }
+
+func (postgresDialect) quoteIdentifier(name string) string {
+	return fmt.Sprintf("%q", name)
+}
+
+func (postgresDialect) bindVar(idx int) string {
+	return fmt.Sprintf("$%d", idx)
+}
+
+func (postgresDialect) columnType(kind schema.Kind, _ bool) string {
+	return simpleColumnType(kind)
+}
+
+func (postgresDialect) timeColumnType(nanosCol string) string {
+	return fmt.Sprintf("TIMESTAMPTZ GENERATED ALWAYS AS (nanos_to_timestamptz(%s)) STORED", nanosCol)
+}
+
+func (d postgresDialect) enumColumnType(moduleName, _ string, enum schema.EnumType) string {
+	return d.quoteIdentifier(enumTypeName(moduleName, enum.Name))
+}
+
+func (postgresDialect) namedEnumTypes() bool { return true }
+
+func (d postgresDialect) grantSelectSql(table string) string {
+	// we GRANT SELECT on the table to PUBLIC so that the table is automatically available
+	// for querying using off-the-shelf tools like pg_graphql, Postgrest, Postgraphile, etc.
+	// without any login permissions
+	return fmt.Sprintf("GRANT SELECT ON TABLE %s TO PUBLIC;", d.quoteIdentifier(table))
+}
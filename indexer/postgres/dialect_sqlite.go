@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// sqliteDialect is the SQLite dialect which is mainly intended for local development.
+// SQLite has no enum types or grants, so enums are stored as TEXT with a CHECK constraint
+// and tables are readable by anyone with access to the database file.
+type sqliteDialect struct{}
+
+var _ dialect = sqliteDialect{}
+
+func (sqliteDialect) name() string { return "sqlite" }
+
+func (sqliteDialect) defaultDriver() string { return "sqlite3" }
+
+func (sqliteDialect) baseSQL() string { return sqliteBaseSQL }
+
+func (sqliteDialect) quoteIdentifier(name string) string {
+	return fmt.Sprintf("%q", name)
+}
+
+func (sqliteDialect) bindVar(int) string {
+	return "?"
+}
+
+func (sqliteDialect) columnType(kind schema.Kind, _ bool) string {
+	switch kind {
+	case schema.StringKind, schema.AddressKind, schema.JSONKind:
+		return "TEXT"
+	case schema.BoolKind:
+		return "BOOLEAN"
+	case schema.BytesKind:
+		return "BLOB"
+	case schema.Int8Kind, schema.Int16Kind, schema.Int32Kind, schema.Int64Kind,
+		schema.Uint8Kind, schema.Uint16Kind, schema.Uint32Kind, schema.DurationKind:
+		return "INTEGER"
+	case schema.Uint64Kind, schema.IntegerKind, schema.DecimalKind:
+		// SQLite integers are signed 64-bit and NUMERIC affinity falls back to lossy REAL
+		// for larger values, so these are stored as TEXT to preserve their exact value
+		return "TEXT"
+	case schema.Float32Kind, schema.Float64Kind:
+		return "REAL"
+	default:
+		return ""
+	}
+}
+
+func (sqliteDialect) timeColumnType(nanosCol string) string {
+	return fmt.Sprintf("TEXT GENERATED ALWAYS AS (strftime('%%Y-%%m-%%dT%%H:%%M:%%fZ', %s / 1000000000.0, 'unixepoch')) VIRTUAL", nanosCol)
+}
+
+func (d sqliteDialect) enumColumnType(_, col string, enum schema.EnumType) string {
+	return fmt.Sprintf("TEXT CHECK (%s IN (%s))", d.quoteIdentifier(col), enumValueList(enum))
+}
+
+func (sqliteDialect) namedEnumTypes() bool { return false }
+
+func (sqliteDialect) grantSelectSql(string) string { return "" }
+
+// enumValueList returns the enum values as a comma separated list of SQL string literals.
+func enumValueList(enum schema.EnumType) string {
+	values := make([]string, 0, len(enum.Values))
+	for _, value := range enum.Values {
+		values = append(values, quoteLiteral(value.Name))
+	}
+	return strings.Join(values, ", ")
+}
+
+// sqliteBaseSQL is the SQLite version of baseSQL.
+const sqliteBaseSQL = `
+CREATE TABLE IF NOT EXISTS block
+(
+    number INTEGER NOT NULL PRIMARY KEY,
+    header TEXT    NULL
+);
+
+CREATE TABLE IF NOT EXISTS tx
+(
+    id             INTEGER PRIMARY KEY AUTOINCREMENT,
+    block_number   INTEGER NOT NULL REFERENCES block (number),
+    index_in_block INTEGER NOT NULL,
+    data           TEXT    NULL,
+    bytes          BLOB    NULL
+);
+
+CREATE TABLE IF NOT EXISTS event
+(
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    block_number INTEGER NOT NULL REFERENCES block (number),
+    block_stage  INTEGER NOT NULL,
+    tx_index     INTEGER NOT NULL,
+    msg_index    INTEGER NOT NULL,
+    event_index  INTEGER NOT NULL,
+    type         TEXT    NULL,
+    data         TEXT    NULL
+);
+`
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"fmt"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+)
+
+func Example_objectIndexer_createTableSql_allKinds_sqlite() {
+	exampleCreateTableDialect(testdata.AllKindsObject, false, sqliteDialect{})
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_all_kinds" (
+	// 	"id" INTEGER NOT NULL,
+	// 	"ts" TEXT GENERATED ALWAYS AS (strftime('%Y-%m-%dT%H:%M:%fZ', "ts_nanos" / 1000000000.0, 'unixepoch')) VIRTUAL,
+	// 	"ts_nanos" INTEGER NOT NULL,
+	// 	"string" TEXT NOT NULL,
+	// 	"bytes" BLOB NOT NULL,
+	// 	"int8" INTEGER NOT NULL,
+	// 	"uint8" INTEGER NOT NULL,
+	// 	"int16" INTEGER NOT NULL,
+	// 	"uint16" INTEGER NOT NULL,
+	// 	"int32" INTEGER NOT NULL,
+	// 	"uint32" INTEGER NOT NULL,
+	// 	"int64" INTEGER NOT NULL,
+	// 	"uint64" TEXT NOT NULL,
+	// 	"integer" TEXT NOT NULL,
+	// 	"decimal" TEXT NOT NULL,
+	// 	"bool" BOOLEAN NOT NULL,
+	// 	"time" TEXT GENERATED ALWAYS AS (strftime('%Y-%m-%dT%H:%M:%fZ', "time_nanos" / 1000000000.0, 'unixepoch')) VIRTUAL,
+	// 	"time_nanos" INTEGER NOT NULL,
+	// 	"duration" INTEGER NOT NULL,
+	// 	"float32" REAL NOT NULL,
+	// 	"float64" REAL NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"enum" TEXT CHECK ("enum" IN ('a', 'b', 'c')) NOT NULL,
+	// 	"json" TEXT NOT NULL,
+	// 	PRIMARY KEY ("id", "ts_nanos")
+	// );
+}
+
+func Example_objectIndexer_createTableSql_vote_sqlite() {
+	exampleCreateTableDialect(testdata.VoteObject, false, sqliteDialect{})
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" INTEGER NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"vote" TEXT CHECK ("vote" IN ('yes', 'no', 'abstain')) NOT NULL,
+	// 	_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	// 	PRIMARY KEY ("proposal", "address")
+	// );
+}
+
+func Example_objectIndexer_createTableSql_allKinds_mysql() {
+	exampleCreateTableDialect(testdata.AllKindsObject, false, mysqlDialect{})
+	// Output:
+	// CREATE TABLE IF NOT EXISTS `test_all_kinds` (
+	// 	`id` BIGINT NOT NULL,
+	// 	`ts` DATETIME(6) GENERATED ALWAYS AS (TIMESTAMPADD(MICROSECOND, `ts_nanos` DIV 1000, '1970-01-01 00:00:00')) STORED,
+	// 	`ts_nanos` BIGINT NOT NULL,
+	// 	`string` TEXT NOT NULL,
+	// 	`bytes` LONGBLOB NOT NULL,
+	// 	`int8` TINYINT NOT NULL,
+	// 	`uint8` TINYINT UNSIGNED NOT NULL,
+	// 	`int16` SMALLINT NOT NULL,
+	// 	`uint16` SMALLINT UNSIGNED NOT NULL,
+	// 	`int32` INT NOT NULL,
+	// 	`uint32` INT UNSIGNED NOT NULL,
+	// 	`int64` BIGINT NOT NULL,
+	// 	`uint64` BIGINT UNSIGNED NOT NULL,
+	// 	`integer` DECIMAL(65, 0) NOT NULL,
+	// 	`decimal` DECIMAL(65, 30) NOT NULL,
+	// 	`bool` BOOLEAN NOT NULL,
+	// 	`time` DATETIME(6) GENERATED ALWAYS AS (TIMESTAMPADD(MICROSECOND, `time_nanos` DIV 1000, '1970-01-01 00:00:00')) STORED,
+	// 	`time_nanos` BIGINT NOT NULL,
+	// 	`duration` BIGINT NOT NULL,
+	// 	`float32` FLOAT NOT NULL,
+	// 	`float64` DOUBLE NOT NULL,
+	// 	`address` TEXT NOT NULL,
+	// 	`enum` ENUM('a', 'b', 'c') NOT NULL,
+	// 	`json` JSON NOT NULL,
+	// 	PRIMARY KEY (`id`, `ts_nanos`)
+	// );
+}
+
+func Example_objectIndexer_createTableSql_vote_mysql() {
+	exampleCreateTableDialect(testdata.VoteObject, false, mysqlDialect{})
+	// Output:
+	// CREATE TABLE IF NOT EXISTS `test_vote` (
+	// 	`proposal` BIGINT NOT NULL,
+	// 	`address` VARCHAR(255) NOT NULL,
+	// 	`vote` ENUM('yes', 'no', 'abstain') NOT NULL,
+	// 	_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	// 	PRIMARY KEY (`proposal`, `address`)
+	// );
+}
+
+func Example_bindVarList() {
+	for _, d := range []dialect{postgresDialect{}, sqliteDialect{}, mysqlDialect{}} {
+		fmt.Printf("%s: %s\n", d.name(), bindVarList(d, 3))
+	}
+	// Output:
+	// postgres: $1, $2, $3
+	// sqlite: ?, ?, ?
+	// mysql: ?, ?, ?
+}
+
+func Example_enumValueList() {
+	enum := schema.EnumType{
+		Name:   "quoted",
+		Values: []schema.EnumValueDefinition{{Name: "it's", Value: 1}, {Name: "plain", Value: 2}},
+	}
+	fmt.Println(sqliteDialect{}.enumColumnType("test", "quoted", enum))
+	fmt.Println(mysqlDialect{}.enumColumnType("test", "quoted", enum))
+	// Output:
+	// TEXT CHECK ("quoted" IN ('it''s', 'plain'))
+	// ENUM('it''s', 'plain')
+}
@@ -53,7 +53,7 @@ func createEnumTypeSql(writer io.Writer, moduleName string, enum schema.EnumType
 				return err
 			}
 		}
-		_, err = fmt.Fprintf(writer, "'%s'", value.Name)
+		_, err = fmt.Fprint(writer, quoteLiteral(value.Name))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,781 @@
+package postgres
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxGraphQLRequestSize is the maximum size of the body of a GraphQL POST request.
+const maxGraphQLRequestSize = 1 << 20
+
+// graphQLRequest is a GraphQL request as sent in the body of a POST request or the parameters of a GET request.
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// graphQLError is a single error of a GraphQL response.
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+// serveGraphQL serves read-only GraphQL queries over the indexed object types.
+//
+// Queries are sent either as the query, variables and operationName parameters of a GET request or as a
+// JSON object with the same fields in the body of a POST request. The query type has a field for each
+// indexed module, each module has a field for each of its object types which returns a list of objects,
+// and each object has a field for each of its key and value fields as well as a _deleted field:
+//
+//	query Votes($proposal: Int) {
+//	  gov {
+//	    vote(proposal: $proposal, limit: 10) { address vote }
+//	  }
+//	}
+//
+// Object type fields accept the same key field filters and limit, offset and include_deleted arguments
+// as the REST endpoints, with field values represented as in query parameters. Aliases, variables and
+// __typename are supported, fragments, directives, mutations, subscriptions and introspection are not.
+func (qs *queryServer) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	req, err := readGraphQLRequest(r)
+	if err != nil {
+		writeGraphQLError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	qs.mu.RLock()
+	defer qs.mu.RUnlock()
+
+	plan, err := qs.planGraphQL(req)
+	if err != nil {
+		writeGraphQLError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data := make(gqlObject, 0, len(plan))
+	for _, mod := range plan {
+		if mod.objects == nil {
+			data = append(data, gqlEntry{mod.key, mod.typename})
+			continue
+		}
+
+		modData := make(gqlObject, 0, len(mod.objects))
+		for _, obj := range mod.objects {
+			if obj.tm == nil {
+				modData = append(modData, gqlEntry{obj.key, obj.typename})
+				continue
+			}
+
+			objects, err := qs.executeGraphQLObjectQuery(r, obj)
+			if err != nil {
+				if qs.opts.logger != nil {
+					qs.opts.logger.Error("query failed", "module", obj.tm.moduleName, "type", obj.tm.typ.Name, "error", err)
+				}
+				writeGraphQLError(w, http.StatusInternalServerError, "query failed")
+				return
+			}
+			modData = append(modData, gqlEntry{obj.key, objects})
+		}
+		data = append(data, gqlEntry{mod.key, modData})
+	}
+
+	writeQueryJSON(w, struct {
+		Data gqlObject `json:"data"`
+	}{data})
+}
+
+// readGraphQLRequest reads a GraphQL request from the parameters of a GET request or the body of a POST request.
+func readGraphQLRequest(r *http.Request) (graphQLRequest, error) {
+	var req graphQLRequest
+	switch r.Method {
+	case http.MethodGet:
+		values := r.URL.Query()
+		req.Query = values.Get("query")
+		req.OperationName = values.Get("operationName")
+		if vars := values.Get("variables"); vars != "" {
+			if err := decodeGraphQLJSON(strings.NewReader(vars), &req.Variables); err != nil {
+				return graphQLRequest{}, fmt.Errorf("invalid variables: %w", err)
+			}
+		}
+	case http.MethodPost:
+		if err := decodeGraphQLJSON(io.LimitReader(r.Body, maxGraphQLRequestSize), &req); err != nil {
+			return graphQLRequest{}, fmt.Errorf("invalid request body: %w", err)
+		}
+	default:
+		return graphQLRequest{}, errors.New("only GET and POST requests are supported")
+	}
+
+	if req.Query == "" {
+		return graphQLRequest{}, errors.New("missing query")
+	}
+
+	return req, nil
+}
+
+// decodeGraphQLJSON decodes JSON keeping numbers in their original representation.
+func decodeGraphQLJSON(r io.Reader, v interface{}) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// executeGraphQLObjectQuery queries the objects of an object type and selects the requested fields.
+func (qs *queryServer) executeGraphQLObjectQuery(r *http.Request, obj gqlObjectQuery) ([]gqlObject, error) {
+	updates, err := obj.tm.query(r.Context(), qs.conn, obj.params)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]gqlObject, 0, len(updates))
+	for _, update := range updates {
+		o, err := obj.tm.queryObject(update)
+		if err != nil {
+			return nil, err
+		}
+
+		selected := make(gqlObject, 0, len(obj.fields))
+		for _, field := range obj.fields {
+			var value interface{}
+			switch field.name {
+			case "__typename":
+				value = obj.tm.typ.Name
+			case "_deleted":
+				value = o.Deleted
+			default:
+				var ok bool
+				if value, ok = o.Key[field.name]; !ok {
+					value = o.Value[field.name]
+				}
+			}
+			selected = append(selected, gqlEntry{field.key, value})
+		}
+		res = append(res, selected)
+	}
+
+	return res, nil
+}
+
+// writeGraphQLError writes a GraphQL error response.
+func writeGraphQLError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Errors []graphQLError `json:"errors"`
+	}{[]graphQLError{{Message: msg}}})
+}
+
+// gqlModuleQuery is the planned selection of a module field, or of __typename if objects is nil.
+type gqlModuleQuery struct {
+	key      string
+	typename string
+	objects  []gqlObjectQuery
+}
+
+// gqlObjectQuery is the planned selection of an object type field, or of __typename if tm is nil.
+type gqlObjectQuery struct {
+	key      string
+	typename string
+	tm       *objectIndexer
+	params   queryParams
+	fields   []gqlFieldSelection
+}
+
+// gqlFieldSelection is the selection of a field of an object.
+type gqlFieldSelection struct {
+	key  string
+	name string
+}
+
+// planGraphQL parses and validates a GraphQL request against the indexed modules.
+func (qs *queryServer) planGraphQL(req graphQLRequest) ([]gqlModuleQuery, error) {
+	p := &gqlParser{src: req.Query}
+	op, err := p.document(req.OperationName)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]gqlValue, len(op.variables))
+	for name, def := range op.variables {
+		value, ok := req.Variables[name]
+		if !ok {
+			vars[name] = def
+			continue
+		}
+		switch value := value.(type) {
+		case nil:
+			vars[name] = gqlValue{kind: gqlNull}
+		case string:
+			vars[name] = gqlValue{kind: gqlLiteral, raw: value}
+		case json.Number:
+			vars[name] = gqlValue{kind: gqlLiteral, raw: value.String()}
+		case bool:
+			vars[name] = gqlValue{kind: gqlLiteral, raw: strconv.FormatBool(value)}
+		default:
+			return nil, fmt.Errorf("variable $%s must be a string, number, boolean or null", name)
+		}
+	}
+
+	keys := map[string]bool{}
+	plan := make([]gqlModuleQuery, 0, len(op.selections))
+	for _, sel := range op.selections {
+		if err := checkGraphQLResponseKey(keys, sel.key()); err != nil {
+			return nil, err
+		}
+
+		if sel.name == "__typename" {
+			if err := sel.checkLeaf(); err != nil {
+				return nil, err
+			}
+			plan = append(plan, gqlModuleQuery{key: sel.key(), typename: "Query"})
+			continue
+		}
+
+		mod, ok := qs.modules[sel.name]
+		if !ok {
+			return nil, fmt.Errorf("module %q not found", sel.name)
+		}
+		if len(sel.args) != 0 {
+			return nil, fmt.Errorf("module field %q does not take arguments", sel.name)
+		}
+		if sel.selections == nil {
+			return nil, fmt.Errorf("module field %q must have a selection of object types", sel.name)
+		}
+
+		modQuery, err := planGraphQLModule(mod, sel, vars)
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, modQuery)
+	}
+
+	return plan, nil
+}
+
+// planGraphQLModule plans the selection of the object types of a module.
+func planGraphQLModule(mod *moduleIndexer, sel gqlSelection, vars map[string]gqlValue) (gqlModuleQuery, error) {
+	keys := map[string]bool{}
+	modQuery := gqlModuleQuery{key: sel.key(), objects: make([]gqlObjectQuery, 0, len(sel.selections))}
+	for _, objSel := range sel.selections {
+		if err := checkGraphQLResponseKey(keys, objSel.key()); err != nil {
+			return gqlModuleQuery{}, err
+		}
+
+		if objSel.name == "__typename" {
+			if err := objSel.checkLeaf(); err != nil {
+				return gqlModuleQuery{}, err
+			}
+			modQuery.objects = append(modQuery.objects, gqlObjectQuery{key: objSel.key(), typename: mod.moduleName})
+			continue
+		}
+
+		tm, ok := mod.tables[objSel.name]
+		if !ok {
+			return gqlModuleQuery{}, fmt.Errorf("object type %q not found in module %q", objSel.name, mod.moduleName)
+		}
+		if objSel.selections == nil {
+			return gqlModuleQuery{}, fmt.Errorf("object type field %q must have a selection of fields", objSel.name)
+		}
+
+		objQuery := gqlObjectQuery{key: objSel.key(), tm: tm, params: newQueryParams()}
+		for _, arg := range objSel.args {
+			value := arg.value
+			if value.kind == gqlVariable {
+				var ok bool
+				if value, ok = vars[value.raw]; !ok {
+					return gqlModuleQuery{}, fmt.Errorf("variable $%s is not defined", arg.value.raw)
+				}
+			}
+			if value.kind == gqlNull {
+				continue
+			}
+			if err := tm.setQueryParam(&objQuery.params, "argument", arg.name, value.raw); err != nil {
+				return gqlModuleQuery{}, err
+			}
+		}
+
+		fieldKeys := map[string]bool{}
+		for _, fieldSel := range objSel.selections {
+			if err := checkGraphQLResponseKey(fieldKeys, fieldSel.key()); err != nil {
+				return gqlModuleQuery{}, err
+			}
+			if _, ok := tm.allFields[fieldSel.name]; !ok && fieldSel.name != "_deleted" && fieldSel.name != "__typename" {
+				return gqlModuleQuery{}, fmt.Errorf("field %q not found in object type %q", fieldSel.name, tm.typ.Name)
+			}
+			if err := fieldSel.checkLeaf(); err != nil {
+				return gqlModuleQuery{}, err
+			}
+			objQuery.fields = append(objQuery.fields, gqlFieldSelection{key: fieldSel.key(), name: fieldSel.name})
+		}
+
+		modQuery.objects = append(modQuery.objects, objQuery)
+	}
+
+	return modQuery, nil
+}
+
+// checkGraphQLResponseKey checks that a response key is only selected once in a selection set.
+func checkGraphQLResponseKey(keys map[string]bool, key string) error {
+	if keys[key] {
+		return fmt.Errorf("field or alias %q is selected more than once", key)
+	}
+	keys[key] = true
+	return nil
+}
+
+// gqlEntry is a single entry of a gqlObject.
+type gqlEntry struct {
+	key   string
+	value interface{}
+}
+
+// gqlObject is a JSON object which keeps its entries in the order of the query selections.
+type gqlObject []gqlEntry
+
+// MarshalJSON implements json.Marshaler.
+func (o gqlObject) MarshalJSON() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteByte('{')
+	for i, entry := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(entry.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(entry.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// gqlValueKind is the kind of an argument value.
+type gqlValueKind int
+
+const (
+	// gqlLiteral is a string, number, boolean or enum literal whose string representation is in raw.
+	gqlLiteral gqlValueKind = iota
+
+	// gqlVariable is a variable whose name is in raw.
+	gqlVariable
+
+	// gqlNull is the null literal.
+	gqlNull
+)
+
+// gqlValue is an argument value.
+type gqlValue struct {
+	kind gqlValueKind
+	raw  string
+}
+
+// gqlArgument is an argument of a field selection.
+type gqlArgument struct {
+	name  string
+	value gqlValue
+}
+
+// gqlSelection is a field selection. selections is nil for leaf fields.
+type gqlSelection struct {
+	alias      string
+	name       string
+	args       []gqlArgument
+	selections []gqlSelection
+}
+
+// key returns the response key of the selection.
+func (s gqlSelection) key() string {
+	if s.alias != "" {
+		return s.alias
+	}
+	return s.name
+}
+
+// checkLeaf checks that the selection has neither arguments nor a selection set.
+func (s gqlSelection) checkLeaf() error {
+	if len(s.args) != 0 {
+		return fmt.Errorf("field %q does not take arguments", s.name)
+	}
+	if s.selections != nil {
+		return fmt.Errorf("field %q must not have a selection", s.name)
+	}
+	return nil
+}
+
+// gqlOperation is a parsed query operation.
+type gqlOperation struct {
+	name       string
+	variables  map[string]gqlValue
+	selections []gqlSelection
+}
+
+// gqlParser parses the subset of the GraphQL query language supported by the query server.
+type gqlParser struct {
+	src string
+	pos int
+}
+
+// document parses a document and returns the operation to execute.
+func (p *gqlParser) document(operationName string) (gqlOperation, error) {
+	var ops []gqlOperation
+	for {
+		p.skip()
+		if p.pos == len(p.src) {
+			break
+		}
+		op, err := p.operation()
+		if err != nil {
+			return gqlOperation{}, err
+		}
+		ops = append(ops, op)
+	}
+
+	switch {
+	case len(ops) == 0:
+		return gqlOperation{}, errors.New("query contains no operations")
+	case operationName != "":
+		for _, op := range ops {
+			if op.name == operationName {
+				return op, nil
+			}
+		}
+		return gqlOperation{}, fmt.Errorf("operation %q not found", operationName)
+	case len(ops) > 1:
+		return gqlOperation{}, errors.New("operationName is required when the query contains multiple operations")
+	default:
+		return ops[0], nil
+	}
+}
+
+// operation parses a query operation or a query shorthand.
+func (p *gqlParser) operation() (gqlOperation, error) {
+	op := gqlOperation{variables: map[string]gqlValue{}}
+	if p.peek() != '{' {
+		start := p.pos
+		kind, err := p.name()
+		if err != nil {
+			return gqlOperation{}, err
+		}
+		switch kind {
+		case "query":
+		case "mutation", "subscription":
+			return gqlOperation{}, fmt.Errorf("%s operations are not supported", kind)
+		case "fragment":
+			return gqlOperation{}, errors.New("fragments are not supported")
+		default:
+			return gqlOperation{}, p.errorf(start, "expected an operation, got %q", kind)
+		}
+
+		if p.peek() != '(' && p.peek() != '{' && p.peek() != '@' {
+			if op.name, err = p.name(); err != nil {
+				return gqlOperation{}, err
+			}
+		}
+
+		if p.consume('(') {
+			for !p.consume(')') {
+				if err := p.variableDefinition(op.variables); err != nil {
+					return gqlOperation{}, err
+				}
+			}
+		}
+	}
+
+	var err error
+	op.selections, err = p.selectionSet()
+	return op, err
+}
+
+// variableDefinition parses a variable definition and stores its default value in vars.
+func (p *gqlParser) variableDefinition(vars map[string]gqlValue) error {
+	if err := p.expect('$'); err != nil {
+		return err
+	}
+	name, err := p.name()
+	if err != nil {
+		return err
+	}
+	if _, ok := vars[name]; ok {
+		return fmt.Errorf("variable $%s is defined more than once", name)
+	}
+	if err := p.expect(':'); err != nil {
+		return err
+	}
+	if err := p.skipType(); err != nil {
+		return err
+	}
+
+	def := gqlValue{kind: gqlNull}
+	if p.consume('=') {
+		start := p.pos
+		if def, err = p.value(); err != nil {
+			return err
+		}
+		if def.kind == gqlVariable {
+			return p.errorf(start, "default values must not be variables")
+		}
+	}
+	vars[name] = def
+	return nil
+}
+
+// skipType parses a type reference. Argument values are validated against the object type fields instead.
+func (p *gqlParser) skipType() error {
+	if p.consume('[') {
+		if err := p.skipType(); err != nil {
+			return err
+		}
+		if err := p.expect(']'); err != nil {
+			return err
+		}
+	} else if _, err := p.name(); err != nil {
+		return err
+	}
+	p.consume('!')
+	return nil
+}
+
+// selectionSet parses a selection set.
+func (p *gqlParser) selectionSet() ([]gqlSelection, error) {
+	if err := p.expect('{'); err != nil {
+		return nil, err
+	}
+
+	selections := []gqlSelection{}
+	for !p.consume('}') {
+		sel, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, sel)
+	}
+
+	if len(selections) == 0 {
+		return nil, errors.New("selection sets must not be empty")
+	}
+
+	return selections, nil
+}
+
+// selection parses a field selection.
+func (p *gqlParser) selection() (gqlSelection, error) {
+	if p.peek() == '.' {
+		return gqlSelection{}, errors.New("fragments are not supported")
+	}
+
+	var sel gqlSelection
+	var err error
+	if sel.name, err = p.name(); err != nil {
+		return gqlSelection{}, err
+	}
+	if p.consume(':') {
+		sel.alias = sel.name
+		if sel.name, err = p.name(); err != nil {
+			return gqlSelection{}, err
+		}
+	}
+	if sel.name == "__schema" || sel.name == "__type" {
+		return gqlSelection{}, errors.New("introspection is not supported, the module schemas are served at /modules")
+	}
+
+	if p.consume('(') {
+		seen := map[string]bool{}
+		for !p.consume(')') {
+			var arg gqlArgument
+			if arg.name, err = p.name(); err != nil {
+				return gqlSelection{}, err
+			}
+			if seen[arg.name] {
+				return gqlSelection{}, fmt.Errorf("argument %q is passed more than once", arg.name)
+			}
+			seen[arg.name] = true
+			if err := p.expect(':'); err != nil {
+				return gqlSelection{}, err
+			}
+			if arg.value, err = p.value(); err != nil {
+				return gqlSelection{}, err
+			}
+			sel.args = append(sel.args, arg)
+		}
+	}
+
+	if p.peek() == '@' {
+		return gqlSelection{}, errors.New("directives are not supported")
+	}
+
+	if p.peek() == '{' {
+		if sel.selections, err = p.selectionSet(); err != nil {
+			return gqlSelection{}, err
+		}
+	}
+
+	return sel, nil
+}
+
+// value parses an argument value.
+func (p *gqlParser) value() (gqlValue, error) {
+	c := p.peek()
+	start := p.pos
+	switch {
+	case c == '$':
+		p.pos++
+		name, err := p.name()
+		return gqlValue{kind: gqlVariable, raw: name}, err
+	case c == '"':
+		str, err := p.stringValue()
+		return gqlValue{kind: gqlLiteral, raw: str}, err
+	case c == '[' || c == '{':
+		return gqlValue{}, p.errorf(start, "list and object values are not supported")
+	case c == '-' || (c >= '0' && c <= '9'):
+		p.pos++
+		for p.pos < len(p.src) && strings.IndexByte("0123456789.eE+-", p.src[p.pos]) >= 0 {
+			p.pos++
+		}
+		return gqlValue{kind: gqlLiteral, raw: p.src[start:p.pos]}, nil
+	default:
+		name, err := p.name()
+		if name == "null" {
+			return gqlValue{kind: gqlNull}, err
+		}
+		return gqlValue{kind: gqlLiteral, raw: name}, err
+	}
+}
+
+// stringValue parses a quoted string value.
+func (p *gqlParser) stringValue() (string, error) {
+	start := p.pos
+	if strings.HasPrefix(p.src[p.pos:], `"""`) {
+		return "", p.errorf(start, "block strings are not supported")
+	}
+	p.pos++
+
+	var sb strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '"':
+			p.pos++
+			return sb.String(), nil
+		case c == '\n' || c == '\r':
+			return "", p.errorf(start, "unterminated string")
+		case c == '\\' && p.pos+1 < len(p.src):
+			p.pos += 2
+			switch esc := p.src[p.pos-1]; esc {
+			case '"', '\\', '/':
+				sb.WriteByte(esc)
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'u':
+				if p.pos+4 > len(p.src) {
+					return "", p.errorf(p.pos-2, "invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 32)
+				if err != nil {
+					return "", p.errorf(p.pos-2, "invalid unicode escape")
+				}
+				sb.WriteRune(rune(r))
+				p.pos += 4
+			default:
+				return "", p.errorf(p.pos-2, "invalid escape sequence")
+			}
+		default:
+			r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+			sb.WriteRune(r)
+			p.pos += size
+		}
+	}
+
+	return "", p.errorf(start, "unterminated string")
+}
+
+// name parses a name.
+func (p *gqlParser) name() (string, error) {
+	p.skip()
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (p.pos > start && c >= '0' && c <= '9') {
+			p.pos++
+			continue
+		}
+		break
+	}
+
+	if p.pos == start {
+		return "", p.errorf(start, "expected a name")
+	}
+	return p.src[start:p.pos], nil
+}
+
+// expect consumes the punctuator c or returns an error.
+func (p *gqlParser) expect(c byte) error {
+	if !p.consume(c) {
+		return p.errorf(p.pos, "expected %q", c)
+	}
+	return nil
+}
+
+// consume consumes the punctuator c if it is next.
+func (p *gqlParser) consume(c byte) bool {
+	if p.peek() != c {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+// peek returns the next character after ignored tokens, or 0 at the end of the query.
+func (p *gqlParser) peek() byte {
+	p.skip()
+	if p.pos == len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// skip skips whitespace, commas, comments and byte order marks.
+func (p *gqlParser) skip() {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			p.pos++
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' && p.src[p.pos] != '\r' {
+				p.pos++
+			}
+		case strings.HasPrefix(p.src[p.pos:], "\ufeff"):
+			p.pos += len("\ufeff")
+		default:
+			return
+		}
+	}
+}
+
+// errorf returns a syntax error at the byte offset pos of the query.
+func (p *gqlParser) errorf(pos int, format string, args ...interface{}) error {
+	if pos >= len(p.src) {
+		return fmt.Errorf("syntax error at end of query: "+format, args...)
+	}
+	return fmt.Errorf("syntax error at offset %d: "+format, append([]interface{}{pos}, args...)...)
+}
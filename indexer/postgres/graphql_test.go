@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_queryServer_graphQLErrors() {
+	qs := exampleGraphQLQueryServer()
+
+	for _, body := range []string{
+		`{}`,
+		`{"query": "mutation { test { vote { vote } } }"}`,
+		`{"query": "{ bank { balance { amount } } }"}`,
+		`{"query": "{ test { balance { amount } } }"}`,
+		`{"query": "{ test { vote { weight } } }"}`,
+		`{"query": "{ test { vote(vote: \"yes\") { vote } } }"}`,
+		`{"query": "{ test { vote(proposal: \"abc\") { vote } } }"}`,
+		`{"query": "{ test { vote(limit: 0) { vote } } }"}`,
+		`{"query": "{ test { vote(proposal: $p) { vote } } }"}`,
+		`{"query": "{ test { vote { ...VoteFields } } }"}`,
+		`{"query": "{ test { vote @skip(if: true) { vote } } }"}`,
+		`{"query": "{ __schema { types { name } } }"}`,
+		`{"query": "{ test { vote { vote vote } } }"}`,
+		`{"query": "query A { __typename } query B { __typename }"}`,
+		`{"query": "{ test { vote(proposal: \"1) { vote } } }"}`,
+	} {
+		rec := httptest.NewRecorder()
+		qs.ServeHTTP(rec, httptest.NewRequest("POST", "/graphql", strings.NewReader(body)))
+		fmt.Println(rec.Code, strings.TrimSpace(rec.Body.String()))
+	}
+
+	rec := httptest.NewRecorder()
+	qs.ServeHTTP(rec, httptest.NewRequest("DELETE", "/graphql", nil))
+	fmt.Println(rec.Code, strings.TrimSpace(rec.Body.String()))
+	// Output:
+	// 400 {"errors":[{"message":"missing query"}]}
+	// 400 {"errors":[{"message":"mutation operations are not supported"}]}
+	// 400 {"errors":[{"message":"module \"bank\" not found"}]}
+	// 400 {"errors":[{"message":"object type \"balance\" not found in module \"test\""}]}
+	// 400 {"errors":[{"message":"field \"weight\" not found in object type \"vote\""}]}
+	// 400 {"errors":[{"message":"unknown argument \"vote\", only key fields can be filtered on"}]}
+	// 400 {"errors":[{"message":"invalid value for argument \"proposal\": strconv.ParseInt: parsing \"abc\": invalid syntax"}]}
+	// 400 {"errors":[{"message":"invalid value for argument \"limit\": limit must be between 1 and 1000"}]}
+	// 400 {"errors":[{"message":"variable $p is not defined"}]}
+	// 400 {"errors":[{"message":"fragments are not supported"}]}
+	// 400 {"errors":[{"message":"directives are not supported"}]}
+	// 400 {"errors":[{"message":"introspection is not supported, the module schemas are served at /modules"}]}
+	// 400 {"errors":[{"message":"field or alias \"vote\" is selected more than once"}]}
+	// 400 {"errors":[{"message":"operationName is required when the query contains multiple operations"}]}
+	// 400 {"errors":[{"message":"syntax error at offset 24: unterminated string"}]}
+	// 400 {"errors":[{"message":"only GET and POST requests are supported"}]}
+}
+
+func Example_queryServer_graphQLTypename() {
+	qs := exampleGraphQLQueryServer()
+
+	query := url.Values{"query": {"{ __typename t: test { __typename } }"}}
+	rec := httptest.NewRecorder()
+	qs.ServeHTTP(rec, httptest.NewRequest("GET", "/graphql?"+query.Encode(), nil))
+	fmt.Println(rec.Code, strings.TrimSpace(rec.Body.String()))
+	// Output:
+	// 200 {"data":{"__typename":"Query","t":{"__typename":"test"}}}
+}
+
+func Example_queryServer_planGraphQL() {
+	qs := exampleGraphQLQueryServer()
+
+	plan, err := qs.planGraphQL(graphQLRequest{
+		Query: `
+			# the votes of a proposal
+			query Votes($proposal: Int!, $limit: Int = 10, $deleted: Boolean) {
+			  test {
+			    votes: vote(proposal: $proposal, limit: $limit, include_deleted: $deleted) {
+			      __typename
+			      voter: address
+			      vote
+			      _deleted
+			    }
+			    first: vote(address: "0x0a0b", limit: 1) { proposal }
+			  }
+			}
+			query Other { __typename }`,
+		OperationName: "Votes",
+		Variables:     map[string]interface{}{"proposal": "3", "deleted": nil},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, mod := range plan {
+		fmt.Println(mod.key)
+		for _, obj := range mod.objects {
+			fmt.Printf("  %s: %s %v %t %d %d\n", obj.key, obj.tm.typ.Name, obj.params.keyFilter, obj.params.includeDeleted, obj.params.limit, obj.params.offset)
+			for _, field := range obj.fields {
+				fmt.Printf("    %s: %s\n", field.key, field.name)
+			}
+		}
+	}
+	// Output:
+	// test
+	//   votes: vote map[proposal:3] false 10 0
+	//     __typename: __typename
+	//     voter: address
+	//     vote: vote
+	//     _deleted: _deleted
+	//   first: vote map[address:[10 11]] false 1 0
+	//     proposal: proposal
+}
+
+func exampleGraphQLQueryServer() *queryServer {
+	opts := options{
+		logger:       logutil.NoopLogger{},
+		addressCodec: addressutil.HexAddressCodec{},
+		dialect:      postgresDialect{},
+	}
+	mod := newModuleIndexer("test", testdata.ExampleSchema, opts)
+	mod.tables[testdata.VoteObject.Name] = newObjectIndexer("test", testdata.ExampleSchema, testdata.VoteObject, opts)
+
+	qs := &queryServer{opts: opts, modules: map[string]*moduleIndexer{}}
+	qs.registerModule(mod)
+	return qs
+}
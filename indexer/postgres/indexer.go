@@ -11,14 +11,33 @@ import (
 )
 
 type Config struct {
-	// DatabaseURL is the PostgreSQL connection URL to use to connect to the database.
+	// DatabaseURL is the connection URL to use to connect to the database.
 	DatabaseURL string `json:"database_url"`
 
-	// DatabaseDriver is the PostgreSQL database/sql driver to use. This defaults to "pgx".
+	// DatabaseDriver is the database/sql driver to use. This defaults to "pgx" for PostgreSQL,
+	// "sqlite3" for SQLite and "mysql" for MySQL. The driver must be imported by the application.
 	DatabaseDriver string `json:"database_driver"`
 
+	// Dialect is the SQL dialect of the target database, one of "postgres", "sqlite" or "mysql".
+	// This defaults to "postgres".
+	Dialect string `json:"dialect"`
+
 	// DisableRetainDeletions disables the retain deletions functionality even if it is set in an object type schema.
 	DisableRetainDeletions bool `json:"disable_retain_deletions"`
+
+	// EnableCDCMetadata adds _block_number and _tx_ordinal columns to all object tables and writes a block_commit
+	// row at the end of each block so that change data capture pipelines can consume changes in the correct order.
+	EnableCDCMetadata bool `json:"enable_cdc_metadata"`
+
+	// Bech32AddressPrefix, if set, stores address fields as raw bytes in a column with the _bytes suffix
+	// together with a generated column containing the bech32 string computed with this prefix, so that
+	// addresses can be queried both ways without app code. All address fields use the same prefix.
+	// This is only supported by the postgres dialect.
+	Bech32AddressPrefix string `json:"bech32_address_prefix"`
+
+	// QueryServerAddress is the TCP address, e.g. "localhost:8080", on which a read-only HTTP server exposing
+	// the indexed object types is started. The query server is disabled when it is empty.
+	QueryServerAddress string `json:"query_server_address"`
 }
 
 type indexerImpl struct {
@@ -28,6 +47,14 @@ type indexerImpl struct {
 	opts    options
 	modules map[string]*moduleIndexer
 	logger  logutil.Logger
+
+	// queryServer is the optional read-only query server, it is nil when disabled.
+	queryServer *queryServer
+
+	// inBlock, blockNumber and changeCount track the current block for CDC metadata.
+	inBlock     bool
+	blockNumber uint64
+	changeCount int64
 }
 
 func init() {
@@ -52,9 +79,21 @@ func startIndexer(params indexer.InitParams) (indexer.InitResult, error) {
 		return indexer.InitResult{}, errors.New("missing database URL")
 	}
 
+	d, err := getDialect(config.Dialect)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	if config.Bech32AddressPrefix != "" {
+		err = validateBech32AddressPrefix(d, config.Bech32AddressPrefix)
+		if err != nil {
+			return indexer.InitResult{}, err
+		}
+	}
+
 	driver := config.DatabaseDriver
 	if driver == "" {
-		driver = "pgx"
+		driver = d.defaultDriver()
 	}
 
 	db, err := sql.Open(driver, config.DatabaseURL)
@@ -68,16 +107,41 @@ func startIndexer(params indexer.InitParams) (indexer.InitResult, error) {
 	}
 
 	// commit base schema
-	_, err = tx.Exec(baseSQL)
+	_, err = tx.Exec(d.baseSQL())
 	if err != nil {
 		return indexer.InitResult{}, err
 	}
 
+	if config.Bech32AddressPrefix != "" {
+		_, err = tx.Exec(bech32EncodeSQL)
+		if err != nil {
+			return indexer.InitResult{}, err
+		}
+	}
+
+	if config.EnableCDCMetadata {
+		_, err = tx.Exec(blockCommitSQL)
+		if err != nil {
+			return indexer.InitResult{}, err
+		}
+	}
+
 	moduleIndexers := map[string]*moduleIndexer{}
 	opts := options{
 		disableRetainDeletions: config.DisableRetainDeletions,
 		logger:                 params.Logger,
 		addressCodec:           params.AddressCodec,
+		bech32AddressPrefix:    config.Bech32AddressPrefix,
+		cdcMetadata:            config.EnableCDCMetadata,
+		dialect:                d,
+	}
+
+	var qs *queryServer
+	if config.QueryServerAddress != "" {
+		qs, err = startQueryServer(ctx, config.QueryServerAddress, db, opts)
+		if err != nil {
+			return indexer.InitResult{}, err
+		}
 	}
 
 	idx := &indexerImpl{
@@ -87,6 +151,8 @@ func startIndexer(params indexer.InitParams) (indexer.InitResult, error) {
 		opts:    opts,
 		modules: moduleIndexers,
 		logger:  params.Logger,
+
+		queryServer: qs,
 	}
 
 	return indexer.InitResult{
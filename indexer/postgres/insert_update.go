@@ -8,7 +8,8 @@ import (
 )
 
 // insertUpdate inserts or updates the row with the provided key and value.
-func (tm *objectIndexer) insertUpdate(ctx context.Context, conn dbConn, key, value interface{}) error {
+// meta is the CDC metadata to write with the change and may be nil.
+func (tm *objectIndexer) insertUpdate(ctx context.Context, conn dbConn, key, value interface{}, meta *changeMetadata) error {
 	exists, err := tm.exists(ctx, conn, key)
 	if err != nil {
 		return err
@@ -22,9 +23,9 @@ func (tm *objectIndexer) insertUpdate(ctx context.Context, conn dbConn, key, val
 			return nil
 		}
 
-		params, err = tm.updateSql(buf, key, value)
+		params, err = tm.updateSql(buf, key, value, meta)
 	} else {
-		params, err = tm.insertSql(buf, key, value)
+		params, err = tm.insertSql(buf, key, value, meta)
 	}
 	if err != nil {
 		return err
@@ -39,7 +40,7 @@ func (tm *objectIndexer) insertUpdate(ctx context.Context, conn dbConn, key, val
 }
 
 // insertSql generates an INSERT statement and binding parameters for the provided key and value.
-func (tm *objectIndexer) insertSql(w io.Writer, key, value interface{}) ([]interface{}, error) {
+func (tm *objectIndexer) insertSql(w io.Writer, key, value interface{}, meta *changeMetadata) ([]interface{}, error) {
 	keyParams, keyCols, err := tm.bindKeyParams(key)
 	if err != nil {
 		return nil, err
@@ -50,29 +51,28 @@ func (tm *objectIndexer) insertSql(w io.Writer, key, value interface{}) ([]inter
 		return nil, err
 	}
 
+	cdcParams, cdcCols := tm.cdcParams(meta)
+
 	var allParams []interface{}
 	allParams = append(allParams, keyParams...)
 	allParams = append(allParams, valueParams...)
+	allParams = append(allParams, cdcParams...)
 
-	allCols := make([]string, 0, len(keyCols)+len(valueCols))
+	allCols := make([]string, 0, len(keyCols)+len(valueCols)+len(cdcCols))
 	allCols = append(allCols, keyCols...)
 	allCols = append(allCols, valueCols...)
+	allCols = append(allCols, cdcCols...)
 
-	var paramBindings []string
-	for i := 1; i <= len(allCols); i++ {
-		paramBindings = append(paramBindings, fmt.Sprintf("$%d", i))
-	}
-
-	_, err = fmt.Fprintf(w, "INSERT INTO %q (%s) VALUES (%s);", tm.tableName(),
+	_, err = fmt.Fprintf(w, "INSERT INTO %s (%s) VALUES (%s);", tm.quotedTableName(),
 		strings.Join(allCols, ", "),
-		strings.Join(paramBindings, ", "),
+		bindVarList(tm.options.dialect, len(allCols)),
 	)
 	return allParams, err
 }
 
 // updateSql generates an UPDATE statement and binding parameters for the provided key and value.
-func (tm *objectIndexer) updateSql(w io.Writer, key, value interface{}) ([]interface{}, error) {
-	_, err := fmt.Fprintf(w, "UPDATE %q SET ", tm.tableName())
+func (tm *objectIndexer) updateSql(w io.Writer, key, value interface{}, meta *changeMetadata) ([]interface{}, error) {
+	_, err := fmt.Fprintf(w, "UPDATE %s SET ", tm.quotedTableName())
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +82,10 @@ func (tm *objectIndexer) updateSql(w io.Writer, key, value interface{}) ([]inter
 		return nil, err
 	}
 
+	cdcParams, cdcCols := tm.cdcParams(meta)
+	valueParams = append(valueParams, cdcParams...)
+	valueCols = append(valueCols, cdcCols...)
+
 	paramIdx := 1
 	for i, col := range valueCols {
 		if i > 0 {
@@ -90,7 +94,7 @@ func (tm *objectIndexer) updateSql(w io.Writer, key, value interface{}) ([]inter
 				return nil, err
 			}
 		}
-		_, err = fmt.Fprintf(w, "%s = $%d", col, paramIdx)
+		_, err = fmt.Fprintf(w, "%s = %s", col, tm.options.dialect.bindVar(paramIdx))
 		if err != nil {
 			return nil, err
 		}
@@ -20,7 +20,15 @@ func (i *indexerImpl) listener() appdata.Listener {
 			mm := newModuleIndexer(moduleName, modSchema, i.opts)
 			i.modules[moduleName] = mm
 
-			return mm.initializeSchema(i.ctx, i.tx)
+			err := mm.initializeSchema(i.ctx, i.tx)
+			if err != nil {
+				return err
+			}
+
+			if i.queryServer != nil {
+				i.queryServer.registerModule(mm)
+			}
+			return nil
 		},
 		StartBlock: func(data appdata.StartBlockData) error {
 			var (
@@ -41,9 +49,16 @@ func (i *indexerImpl) listener() appdata.Listener {
 			}
 
 			// TODO: verify the format of headerBz, otherwise we'll get `ERROR: invalid input syntax for type json (SQLSTATE 22P02)`
-			_, err = i.tx.Exec("INSERT INTO block (number, header) VALUES ($1, $2)", data.Height, headerBz)
+			_, err = i.tx.Exec(fmt.Sprintf("INSERT INTO block (number, header) VALUES (%s)", bindVarList(i.opts.dialect, 2)),
+				data.Height, headerBz)
+			if err != nil {
+				return err
+			}
 
-			return err
+			i.inBlock = true
+			i.blockNumber = data.Height
+			i.changeCount = 0
+			return nil
 		},
 		OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
 			module := data.ModuleName
@@ -62,10 +77,13 @@ func (i *indexerImpl) listener() appdata.Listener {
 				}
 
 				var err error
-				if update.Delete {
-					err = tm.delete(i.ctx, i.tx, update.Key)
+				meta := i.nextChangeMetadata()
+				if update.Delete && update.DeleteRange != nil {
+					err = tm.deleteRange(i.ctx, i.tx, *update.DeleteRange, meta)
+				} else if update.Delete {
+					err = tm.delete(i.ctx, i.tx, update.Key, meta)
 				} else {
-					err = tm.insertUpdate(i.ctx, i.tx, update.Key, update.Value)
+					err = tm.insertUpdate(i.ctx, i.tx, update.Key, update.Value, meta)
 				}
 				if err != nil {
 					return err
@@ -74,7 +92,15 @@ func (i *indexerImpl) listener() appdata.Listener {
 			return nil
 		},
 		Commit: func(data appdata.CommitData) (func() error, error) {
-			err := i.tx.Commit()
+			// all changes of a block are written in a single database transaction so that CDC consumers
+			// see block boundaries as transaction boundaries
+			err := i.commitBlockBoundary(i.ctx, i.tx)
+			if err != nil {
+				return nil, err
+			}
+			i.inBlock = false
+
+			err = i.tx.Commit()
 			if err != nil {
 				return nil, err
 			}
@@ -107,7 +133,7 @@ func txListener(i *indexerImpl) func(data appdata.TxData) error {
 			}
 		}
 
-		_, err := i.tx.Exec("INSERT INTO tx (block_number, index_in_block, data, bytes) VALUES ($1, $2, $3, $4)",
+		_, err := i.tx.Exec(fmt.Sprintf("INSERT INTO tx (block_number, index_in_block, data, bytes) VALUES (%s)", bindVarList(i.opts.dialect, 4)),
 			td.BlockNumber, td.TxIndex, jsonData, bz)
 
 		return err
@@ -142,7 +168,7 @@ func eventListener(i *indexerImpl) func(data appdata.EventData) error {
 				}
 			}
 
-			_, err := i.tx.Exec("INSERT INTO event (block_number, block_stage, tx_index, msg_index, event_index, type, data) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+			_, err := i.tx.Exec(fmt.Sprintf("INSERT INTO event (block_number, block_stage, tx_index, msg_index, event_index, type, data) VALUES (%s)", bindVarList(i.opts.dialect, 7)),
 				e.BlockNumber, e.BlockStage, e.TxIndex, e.MsgIndex, e.EventIndex, e.Type, jsonData)
 			if err != nil {
 				return fmt.Errorf("failed to index event: %w", err)
@@ -29,19 +29,21 @@ func newModuleIndexer(moduleName string, modSchema schema.ModuleSchema, options
 
 // initializeSchema creates tables for all object types in the module schema and creates enum types.
 func (m *moduleIndexer) initializeSchema(ctx context.Context, conn dbConn) error {
-	// create enum types
+	// create enum types for dialects which require them, others define enums inline in column definitions
 	var err error
-	m.schema.EnumTypes(func(enumType schema.EnumType) bool {
-		err = m.createEnumType(ctx, conn, enumType)
-		return err == nil
-	})
-	if err != nil {
-		return err
+	if m.options.dialect.namedEnumTypes() {
+		m.schema.EnumTypes(func(enumType schema.EnumType) bool {
+			err = m.createEnumType(ctx, conn, enumType)
+			return err == nil
+		})
+		if err != nil {
+			return err
+		}
 	}
 
 	// create tables for all object types
 	m.schema.StateObjectTypes(func(typ schema.StateObjectType) bool {
-		tm := newObjectIndexer(m.moduleName, typ, m.options)
+		tm := newObjectIndexer(m.moduleName, m.schema, typ, m.options)
 		m.tables[typ.Name] = tm
 		err = tm.createTable(ctx, conn)
 		if err != nil {
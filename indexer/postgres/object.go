@@ -9,14 +9,15 @@ import (
 // objectIndexer is a helper struct that generates SQL for a given object type.
 type objectIndexer struct {
 	moduleName  string
+	modSchema   schema.ModuleSchema
 	typ         schema.StateObjectType
 	valueFields map[string]schema.Field
 	allFields   map[string]schema.Field
 	options     options
 }
 
-// newObjectIndexer creates a new objectIndexer for the given object type.
-func newObjectIndexer(moduleName string, typ schema.StateObjectType, options options) *objectIndexer {
+// newObjectIndexer creates a new objectIndexer for the given object type in the module schema.
+func newObjectIndexer(moduleName string, modSchema schema.ModuleSchema, typ schema.StateObjectType, options options) *objectIndexer {
 	allFields := make(map[string]schema.Field)
 	valueFields := make(map[string]schema.Field)
 
@@ -31,6 +32,7 @@ func newObjectIndexer(moduleName string, typ schema.StateObjectType, options opt
 
 	return &objectIndexer{
 		moduleName:  moduleName,
+		modSchema:   modSchema,
 		typ:         typ,
 		allFields:   allFields,
 		valueFields: valueFields,
@@ -42,3 +44,8 @@ func newObjectIndexer(moduleName string, typ schema.StateObjectType, options opt
 func (tm *objectIndexer) tableName() string {
 	return fmt.Sprintf("%s_%s", tm.moduleName, tm.typ.Name)
 }
+
+// quotedTableName returns the table name quoted for the dialect.
+func (tm *objectIndexer) quotedTableName() string {
+	return tm.options.dialect.quoteIdentifier(tm.tableName())
+}
@@ -15,4 +15,14 @@ type options struct {
 
 	// addressCodec is the codec for encoding and decoding addresses. It is expected to be non-nil.
 	addressCodec addressutil.AddressCodec
+
+	// bech32AddressPrefix enables storing address fields as raw bytes together with a generated
+	// bech32 string column using this prefix when it is non-empty.
+	bech32AddressPrefix string
+
+	// cdcMetadata enables writing change data capture metadata columns, see changeMetadata.
+	cdcMetadata bool
+
+	// dialect is the SQL dialect of the target database. It is expected to be non-nil.
+	dialect dialect
 }
@@ -107,7 +107,7 @@ func (tm *objectIndexer) bindParam(field schema.Field, value interface{}) (param
 		}
 
 		param = int64(t)
-	} else if field.Kind == schema.AddressKind {
+	} else if field.Kind == schema.AddressKind && !tm.hasAddressBytesColumn(field) {
 		param, err = tm.options.addressCodec.BytesToString(value.([]byte))
 		if err != nil {
 			return nil, fmt.Errorf("address encoding failed for field %q: %w", field.Name, err)
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+const (
+	// defaultQueryLimit is the number of rows returned by a query when no limit is specified.
+	defaultQueryLimit = 100
+
+	// maxQueryLimit is the maximum number of rows which can be returned by a single query.
+	maxQueryLimit = 1000
+)
+
+// queryParams are the filtering and pagination parameters of a read-only query over an object table.
+type queryParams struct {
+	// keyFilter contains the values of the key fields to filter by keyed by field name.
+	// Key fields which are not present are not filtered on.
+	keyFilter map[string]interface{}
+
+	// includeDeleted includes rows which have been deleted in tables that retain deletions.
+	includeDeleted bool
+
+	// limit is the maximum number of rows to return.
+	limit int
+
+	// offset is the number of rows to skip.
+	offset int
+}
+
+// query returns the rows of the table matching the query parameters ordered by primary key.
+func (tm *objectIndexer) query(ctx context.Context, conn dbConn, q queryParams) ([]schema.StateObjectUpdate, error) {
+	buf := new(strings.Builder)
+	params, err := tm.querySqlAndParams(buf, q)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.logger != nil {
+		tm.options.logger.Debug("Query", "sql", sqlStr, "params", params)
+	}
+
+	rows, err := conn.QueryContext(ctx, sqlStr, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []schema.StateObjectUpdate
+	for rows.Next() {
+		update, _, err := tm.readRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, update)
+	}
+
+	return res, rows.Err()
+}
+
+// querySqlAndParams generates a SELECT statement and binding parameters for the query.
+func (tm *objectIndexer) querySqlAndParams(w io.Writer, q queryParams) ([]interface{}, error) {
+	err := tm.selectAllClause(w)
+	if err != nil {
+		return nil, err
+	}
+
+	for name := range q.keyFilter {
+		_, isField := tm.allFields[name]
+		_, isValueField := tm.valueFields[name]
+		if !isField || isValueField {
+			return nil, fmt.Errorf("unknown key field %q", name)
+		}
+	}
+
+	// iterate over the key fields rather than the filter map so that the generated SQL is deterministic
+	var filterFields []schema.Field
+	var filterValues []interface{}
+	for _, field := range tm.typ.KeyFields {
+		value, ok := q.keyFilter[field.Name]
+		if !ok {
+			continue
+		}
+		filterFields = append(filterFields, field)
+		filterValues = append(filterValues, value)
+	}
+
+	var params []interface{}
+	hasWhere := false
+	if len(filterFields) > 0 {
+		bindParams, cols, err := tm.bindParams(filterFields, filterValues)
+		if err != nil {
+			return nil, err
+		}
+
+		_, params, err = tm.whereSql(w, bindParams, cols, 1)
+		if err != nil {
+			return nil, err
+		}
+		hasWhere = true
+	}
+
+	if !q.includeDeleted && !tm.options.disableRetainDeletions && tm.typ.RetainDeletions {
+		if hasWhere {
+			_, err = fmt.Fprintf(w, " AND _deleted = FALSE")
+		} else {
+			_, err = fmt.Fprintf(w, " WHERE _deleted = FALSE")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var orderCols []string
+	if len(tm.typ.KeyFields) == 0 {
+		orderCols = []string{"_id"}
+	} else {
+		for _, field := range tm.typ.KeyFields {
+			name, err := tm.updatableColumnName(field)
+			if err != nil {
+				return nil, err
+			}
+			orderCols = append(orderCols, name)
+		}
+	}
+
+	limit := q.limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	} else if limit > maxQueryLimit {
+		limit = maxQueryLimit
+	}
+
+	offset := q.offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	_, err = fmt.Fprintf(w, " ORDER BY %s LIMIT %d OFFSET %d;", strings.Join(orderCols, ", "), limit, offset)
+	return params, err
+}
@@ -0,0 +1,339 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// queryServer is an optional read-only HTTP server which exposes the indexed object types as REST
+// and GraphQL endpoints generated from the module schemas, so that lightweight frontends don't need
+// direct database credentials. It only ever reads committed data through its own connection pool.
+//
+// The following endpoints are served:
+//   - GET /modules lists the schemas of all indexed modules
+//   - GET /modules/{module} returns the schema of a single module
+//   - GET /modules/{module}/{object} lists the objects of an object type, optionally filtered
+//     by key fields passed as query parameters and paginated with the limit and offset parameters
+//   - GET or POST /graphql executes a GraphQL query over the same objects, see serveGraphQL
+type queryServer struct {
+	conn dbConn
+	opts options
+
+	mu      sync.RWMutex
+	modules map[string]*moduleIndexer
+}
+
+// queryResponse is the response of an object query.
+type queryResponse struct {
+	Objects    []queryObject `json:"objects"`
+	Limit      int           `json:"limit"`
+	Offset     int           `json:"offset"`
+	NextOffset *int          `json:"next_offset,omitempty"`
+}
+
+// queryObject is a single object in a queryResponse.
+type queryObject struct {
+	Key     map[string]interface{} `json:"key"`
+	Value   map[string]interface{} `json:"value"`
+	Deleted bool                   `json:"deleted,omitempty"`
+}
+
+// startQueryServer starts a query server listening on addr which is shut down when ctx is done.
+func startQueryServer(ctx context.Context, addr string, conn dbConn, opts options) (*queryServer, error) {
+	qs := &queryServer{
+		conn:    conn,
+		opts:    opts,
+		modules: map[string]*moduleIndexer{},
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start query server: %w", err)
+	}
+
+	srv := &http.Server{
+		Handler:           qs,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		err := srv.Serve(listener)
+		if err != nil && err != http.ErrServerClosed && opts.logger != nil {
+			opts.logger.Error("query server stopped", "error", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	if opts.logger != nil {
+		opts.logger.Info("Started query server", "address", listener.Addr().String())
+	}
+
+	return qs, nil
+}
+
+// registerModule makes the object types of the module available for querying.
+func (qs *queryServer) registerModule(mod *moduleIndexer) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	qs.modules[mod.moduleName] = mod
+}
+
+// ServeHTTP implements http.Handler.
+func (qs *queryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/graphql" {
+		qs.serveGraphQL(w, r)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		writeQueryError(w, http.StatusMethodNotAllowed, "only GET requests are supported")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if parts[0] != "modules" || len(parts) > 3 {
+		writeQueryError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	qs.mu.RLock()
+	defer qs.mu.RUnlock()
+
+	if len(parts) == 1 {
+		schemas := make(map[string]schema.ModuleSchema, len(qs.modules))
+		for name, mod := range qs.modules {
+			schemas[name] = mod.schema
+		}
+		writeQueryJSON(w, schemas)
+		return
+	}
+
+	mod, ok := qs.modules[parts[1]]
+	if !ok {
+		writeQueryError(w, http.StatusNotFound, fmt.Sprintf("module %q not found", parts[1]))
+		return
+	}
+
+	if len(parts) == 2 {
+		writeQueryJSON(w, mod.schema)
+		return
+	}
+
+	tm, ok := mod.tables[parts[2]]
+	if !ok {
+		writeQueryError(w, http.StatusNotFound, fmt.Sprintf("object type %q not found in module %q", parts[2], parts[1]))
+		return
+	}
+
+	q, err := tm.parseQueryParams(r.URL.Query())
+	if err != nil {
+		writeQueryError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updates, err := tm.query(r.Context(), qs.conn, q)
+	if err != nil {
+		if qs.opts.logger != nil {
+			qs.opts.logger.Error("query failed", "module", mod.moduleName, "type", tm.typ.Name, "error", err)
+		}
+		writeQueryError(w, http.StatusInternalServerError, "query failed")
+		return
+	}
+
+	res := queryResponse{
+		Objects: make([]queryObject, 0, len(updates)),
+		Limit:   q.limit,
+		Offset:  q.offset,
+	}
+	for _, update := range updates {
+		obj, err := tm.queryObject(update)
+		if err != nil {
+			writeQueryError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		res.Objects = append(res.Objects, obj)
+	}
+
+	if len(updates) == q.limit {
+		next := q.offset + q.limit
+		res.NextOffset = &next
+	}
+
+	writeQueryJSON(w, res)
+}
+
+// parseQueryParams parses the key filters and pagination parameters of an object query.
+func (tm *objectIndexer) parseQueryParams(values url.Values) (queryParams, error) {
+	q := newQueryParams()
+	for name := range values {
+		if err := tm.setQueryParam(&q, "query parameter", name, values.Get(name)); err != nil {
+			return queryParams{}, err
+		}
+	}
+
+	return q, nil
+}
+
+// newQueryParams returns the parameters of an object query without filters and with the default limit.
+func newQueryParams() queryParams {
+	return queryParams{
+		keyFilter: map[string]interface{}{},
+		limit:     defaultQueryLimit,
+	}
+}
+
+// setQueryParam sets the key filter or pagination parameter of an object query from its string representation.
+// paramKind describes the parameter in errors, e.g. "query parameter" or "argument".
+func (tm *objectIndexer) setQueryParam(q *queryParams, paramKind, name, value string) error {
+	var err error
+	switch name {
+	case "limit":
+		q.limit, err = strconv.Atoi(value)
+		if err == nil && (q.limit <= 0 || q.limit > maxQueryLimit) {
+			err = fmt.Errorf("limit must be between 1 and %d", maxQueryLimit)
+		}
+	case "offset":
+		q.offset, err = strconv.Atoi(value)
+		if err == nil && q.offset < 0 {
+			err = errors.New("offset must not be negative")
+		}
+	case "include_deleted":
+		q.includeDeleted, err = strconv.ParseBool(value)
+	default:
+		field, ok := tm.allFields[name]
+		if _, isValueField := tm.valueFields[name]; !ok || isValueField {
+			return fmt.Errorf("unknown %s %q, only key fields can be filtered on", paramKind, name)
+		}
+		q.keyFilter[name], err = tm.parseFieldValue(field, value)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value for %s %q: %w", paramKind, name, err)
+	}
+
+	return nil
+}
+
+// parseFieldValue parses the string representation of a field value used in query parameters.
+func (tm *objectIndexer) parseFieldValue(field schema.Field, str string) (interface{}, error) {
+	switch field.Kind {
+	case schema.StringKind, schema.EnumKind, schema.IntegerKind, schema.DecimalKind:
+		return str, nil
+	case schema.BytesKind:
+		return base64.StdEncoding.DecodeString(str)
+	case schema.Int8Kind:
+		value, err := strconv.ParseInt(str, 10, 8)
+		return int8(value), err
+	case schema.Int16Kind:
+		value, err := strconv.ParseInt(str, 10, 16)
+		return int16(value), err
+	case schema.Int32Kind:
+		value, err := strconv.ParseInt(str, 10, 32)
+		return int32(value), err
+	case schema.Int64Kind:
+		return strconv.ParseInt(str, 10, 64)
+	case schema.Uint8Kind:
+		value, err := strconv.ParseUint(str, 10, 8)
+		return uint8(value), err
+	case schema.Uint16Kind:
+		value, err := strconv.ParseUint(str, 10, 16)
+		return uint16(value), err
+	case schema.Uint32Kind:
+		value, err := strconv.ParseUint(str, 10, 32)
+		return uint32(value), err
+	case schema.Uint64Kind:
+		return strconv.ParseUint(str, 10, 64)
+	case schema.BoolKind:
+		return strconv.ParseBool(str)
+	case schema.TimeKind:
+		return time.Parse(time.RFC3339Nano, str)
+	case schema.DurationKind:
+		return time.ParseDuration(str)
+	case schema.AddressKind:
+		return tm.options.addressCodec.StringToBytes(str)
+	default:
+		return nil, fmt.Errorf("filtering on %s fields is not supported", field.Kind)
+	}
+}
+
+// queryObject converts an object read from the table to its JSON response representation.
+func (tm *objectIndexer) queryObject(update schema.StateObjectUpdate) (queryObject, error) {
+	var keys, values []interface{}
+	if len(tm.typ.KeyFields) == 1 {
+		keys = []interface{}{update.Key}
+	} else {
+		keys, _ = update.Key.([]interface{})
+	}
+
+	if len(tm.typ.ValueFields) == 1 {
+		values = []interface{}{update.Value}
+	} else {
+		values, _ = update.Value.([]interface{})
+	}
+
+	obj := queryObject{
+		Key:     make(map[string]interface{}, len(tm.typ.KeyFields)),
+		Value:   make(map[string]interface{}, len(tm.typ.ValueFields)),
+		Deleted: update.Delete,
+	}
+
+	for i, field := range tm.typ.KeyFields {
+		value, err := tm.fieldJSONValue(field, keys[i])
+		if err != nil {
+			return queryObject{}, err
+		}
+		obj.Key[field.Name] = value
+	}
+
+	for i, field := range tm.typ.ValueFields {
+		value, err := tm.fieldJSONValue(field, values[i])
+		if err != nil {
+			return queryObject{}, err
+		}
+		obj.Value[field.Name] = value
+	}
+
+	return obj, nil
+}
+
+// fieldJSONValue returns the value which should be JSON encoded for the field. Values are encoded
+// with their default Go JSON encoding except for addresses which are encoded as strings.
+func (tm *objectIndexer) fieldJSONValue(field schema.Field, value interface{}) (interface{}, error) {
+	if field.Kind == schema.AddressKind && value != nil {
+		bz, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("expected []byte value for address field %q, got %T", field.Name, value)
+		}
+		return tm.options.addressCodec.BytesToString(bz)
+	}
+
+	return value, nil
+}
+
+// writeQueryJSON writes a successful JSON response.
+func writeQueryJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeQueryError writes a JSON error response.
+func writeQueryError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_queryServer_errors() {
+	opts := options{
+		logger:       logutil.NoopLogger{},
+		addressCodec: addressutil.HexAddressCodec{},
+		dialect:      postgresDialect{},
+	}
+	mod := newModuleIndexer("test", testdata.ExampleSchema, opts)
+	mod.tables[testdata.VoteObject.Name] = newObjectIndexer("test", testdata.ExampleSchema, testdata.VoteObject, opts)
+
+	qs := &queryServer{opts: opts, modules: map[string]*moduleIndexer{}}
+	qs.registerModule(mod)
+
+	for _, target := range []string{
+		"/foo",
+		"/modules/bank",
+		"/modules/test/balance",
+		"/modules/test/vote?vote=yes",
+		"/modules/test/vote?proposal=abc",
+		"/modules/test/vote?limit=0",
+	} {
+		rec := httptest.NewRecorder()
+		qs.ServeHTTP(rec, httptest.NewRequest("GET", target, nil))
+		fmt.Println(rec.Code, strings.TrimSpace(rec.Body.String()))
+	}
+	// Output:
+	// 404 {"error":"not found"}
+	// 404 {"error":"module \"bank\" not found"}
+	// 404 {"error":"object type \"balance\" not found in module \"test\""}
+	// 400 {"error":"unknown query parameter \"vote\", only key fields can be filtered on"}
+	// 400 {"error":"invalid value for query parameter \"proposal\": strconv.ParseInt: parsing \"abc\": invalid syntax"}
+	// 400 {"error":"invalid value for query parameter \"limit\": limit must be between 1 and 1000"}
+}
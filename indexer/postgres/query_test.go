@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_querySqlAndParams_noFilter() {
+	exampleQuerySql(testdata.VoteObject, queryParams{})
+	// Output:
+	// SELECT "proposal", "address", "vote", _deleted FROM "test_vote" WHERE _deleted = FALSE ORDER BY "proposal", "address" LIMIT 100 OFFSET 0;
+	// []
+}
+
+func Example_objectIndexer_querySqlAndParams_keyFilter() {
+	exampleQuerySql(testdata.VoteObject, queryParams{
+		keyFilter: map[string]interface{}{"proposal": int64(2)},
+		limit:     10,
+		offset:    20,
+	})
+	// Output:
+	// SELECT "proposal", "address", "vote", _deleted FROM "test_vote" WHERE "proposal" = $1 AND _deleted = FALSE ORDER BY "proposal", "address" LIMIT 10 OFFSET 20;
+	// [2]
+}
+
+func Example_objectIndexer_querySqlAndParams_includeDeleted() {
+	exampleQuerySql(testdata.VoteObject, queryParams{
+		keyFilter:      map[string]interface{}{"proposal": int64(2), "address": []byte{0xab}},
+		includeDeleted: true,
+		limit:          5000,
+	})
+	// Output:
+	// SELECT "proposal", "address", "vote", _deleted FROM "test_vote" WHERE "proposal" = $1 AND "address" = $2 ORDER BY "proposal", "address" LIMIT 1000 OFFSET 0;
+	// [2 0xab]
+}
+
+func Example_objectIndexer_querySqlAndParams_singleton() {
+	exampleQuerySql(testdata.SingletonObject, queryParams{})
+	// Output:
+	// SELECT "foo", "bar", "an_enum" FROM "test_singleton" ORDER BY _id LIMIT 100 OFFSET 0;
+	// []
+}
+
+func Example_objectIndexer_querySqlAndParams_unknownField() {
+	exampleQuerySql(testdata.VoteObject, queryParams{
+		keyFilter: map[string]interface{}{"vote": "yes"},
+	})
+	// Output:
+	// unknown key field "vote"
+}
+
+func exampleQuerySql(objectType schema.StateObjectType, q queryParams) {
+	tm := newObjectIndexer("test", testdata.ExampleSchema, objectType, options{
+		logger:       logutil.NoopLogger{},
+		addressCodec: addressutil.HexAddressCodec{},
+		dialect:      postgresDialect{},
+	})
+	buf := new(strings.Builder)
+	params, err := tm.querySqlAndParams(buf, q)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(buf.String())
+	fmt.Println(params)
+}
@@ -16,7 +16,7 @@ import (
 
 // count returns the number of rows in the table.
 func (tm *objectIndexer) count(ctx context.Context, conn dbConn) (int, error) {
-	sqlStr := fmt.Sprintf("SELECT COUNT(*) FROM %q;", tm.tableName())
+	sqlStr := fmt.Sprintf("SELECT COUNT(*) FROM %s;", tm.quotedTableName())
 	if tm.options.logger != nil {
 		tm.options.logger.Debug("Count", "sql", sqlStr)
 	}
@@ -56,7 +56,7 @@ func (tm *objectIndexer) checkExists(ctx context.Context, conn dbConn, sqlStr st
 
 // existsSqlAndParams generates a SELECT statement to check if a row with the provided key exists in the table.
 func (tm *objectIndexer) existsSqlAndParams(w io.Writer, key interface{}) ([]interface{}, error) {
-	_, err := fmt.Fprintf(w, "SELECT 1 FROM %q", tm.tableName())
+	_, err := fmt.Fprintf(w, "SELECT 1 FROM %s", tm.quotedTableName())
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +139,7 @@ func (tm *objectIndexer) selectAllClause(w io.Writer) error {
 		allFields = append(allFields, "_deleted")
 	}
 
-	_, err := fmt.Fprintf(w, "SELECT %s FROM %q", strings.Join(allFields, ", "), tm.tableName())
+	_, err := fmt.Fprintf(w, "SELECT %s FROM %s", strings.Join(allFields, ", "), tm.quotedTableName())
 	if err != nil {
 		return err
 	}
@@ -216,6 +216,10 @@ func (tm *objectIndexer) readRow(row interface{ Scan(...interface{}) error }) (s
 }
 
 func (tm *objectIndexer) colBindValue(field schema.Field) interface{} {
+	if tm.hasAddressBytesColumn(field) {
+		return new(interface{})
+	}
+
 	switch field.Kind {
 	case schema.BytesKind:
 		return new(interface{})
@@ -225,6 +229,12 @@ func (tm *objectIndexer) colBindValue(field schema.Field) interface{} {
 }
 
 func (tm *objectIndexer) readCol(field schema.Field, value interface{}) (interface{}, error) {
+	if tm.hasAddressBytesColumn(field) {
+		// raw address bytes are read like bytes types
+		value = *value.(*interface{})
+		return value, nil
+	}
+
 	switch field.Kind {
 	case schema.BytesKind:
 		// for bytes types we either get []byte or nil
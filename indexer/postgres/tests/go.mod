@@ -35,5 +35,6 @@ require (
 
 replace (
 	cosmossdk.io/indexer/postgres => ../.
+	cosmossdk.io/schema => ../../../schema
 	cosmossdk.io/schema/testing => ../../../schema/testing
 )
@@ -45,7 +45,7 @@ func (tm *objectIndexer) whereSql(w io.Writer, params []interface{}, cols []stri
 			}
 
 		} else {
-			_, err = fmt.Fprintf(w, "= $%d", endParamIdx)
+			_, err = fmt.Fprintf(w, "= %s", tm.options.dialect.bindVar(endParamIdx))
 			if err != nil {
 				return 0, nil, err
 			}
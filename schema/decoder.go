@@ -37,4 +37,9 @@ type KVPairUpdate = struct {
 	// Remove is a flag that indicates that the key-value pair was deleted. If it is false,
 	// then it is assumed that this has been a set operation.
 	Remove bool
+
+	// RemovePrefix is a flag that indicates that all the key-value pairs whose keys start with Key were
+	// deleted, such as when a module clears a prefix of its state. Key must end at the boundary of a part
+	// of the keys so that it can be decoded. Value is ignored and Remove should be true as well.
+	RemovePrefix bool
 }
@@ -1,6 +1,9 @@
 package schema
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // ValidateObjectKey validates that the value conforms to the set of fields as a Key in an StateObjectUpdate.
 // See StateObjectUpdate.Key for documentation on the requirements of such keys.
@@ -45,6 +48,54 @@ func ValidateObjectValue(valueFields []Field, value interface{}, typeSet TypeSet
 	return nil
 }
 
+// ValidateKeyRange validates that the range conforms to the set of fields as a KeyRange in a StateObjectUpdate.
+// See KeyRange for documentation on the requirements of such ranges.
+func ValidateKeyRange(keyFields []Field, keyRange KeyRange, typeSet TypeSet) error {
+	if len(keyFields) == 0 {
+		return errors.New("key ranges are not supported for singleton objects")
+	}
+
+	startLen, err := validateKeyPrefix(keyFields, keyRange.Start, typeSet)
+	if err != nil {
+		return fmt.Errorf("invalid range start: %v", err) //nolint:errorlint // false positive due to using go1.12
+	}
+
+	endLen, err := validateKeyPrefix(keyFields, keyRange.End, typeSet)
+	if err != nil {
+		return fmt.Errorf("invalid range end: %v", err) //nolint:errorlint // false positive due to using go1.12
+	}
+
+	if startLen != endLen {
+		return fmt.Errorf("range start has %d key fields but range end has %d", startLen, endLen)
+	}
+
+	return nil
+}
+
+// validateKeyPrefix validates a key prefix as described by KeyRange and returns its length.
+func validateKeyPrefix(keyFields []Field, value interface{}, typeSet TypeSet) (int, error) {
+	if len(keyFields) == 1 {
+		return 1, keyFields[0].ValidateValue(value, typeSet)
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		return 0, fmt.Errorf("expected slice of values for key prefix, got %T", value)
+	}
+
+	if len(values) == 0 || len(values) > len(keyFields) {
+		return 0, fmt.Errorf("expected between 1 and %d key prefix values, got %d", len(keyFields), len(values))
+	}
+
+	for i, v := range values {
+		if err := keyFields[i].ValidateValue(v, typeSet); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(values), nil
+}
+
 func validateFieldsValue(fields []Field, value interface{}, typeSet TypeSet) error {
 	if len(fields) == 0 {
 		return nil
@@ -94,6 +94,18 @@ func (o StateObjectType) ValidateObjectUpdate(update StateObjectUpdate, typeSet
 		return fmt.Errorf("object type name %q does not match update type name %q", o.Name, update.TypeName)
 	}
 
+	if update.DeleteRange != nil {
+		if !update.Delete {
+			return fmt.Errorf("delete range set on non-delete update for object type %q", update.TypeName)
+		}
+
+		if err := ValidateKeyRange(o.KeyFields, *update.DeleteRange, typeSet); err != nil {
+			return fmt.Errorf("invalid delete range for object type %q: %v", update.TypeName, err) //nolint:errorlint // false positive due to using go1.12
+		}
+
+		return nil
+	}
+
 	if err := ValidateObjectKey(o.KeyFields, update.Key, typeSet); err != nil {
 		return fmt.Errorf("invalid key for object type %q: %v", update.TypeName, err) //nolint:errorlint // false positive due to using go1.12
 	}
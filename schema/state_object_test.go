@@ -263,6 +263,61 @@ func TestObjectType_ValidateObjectUpdate(t *testing.T) {
 				Delete:   true,
 			},
 		},
+		{
+			name:       "valid range deletion",
+			objectType: object4Type,
+			object: StateObjectUpdate{
+				TypeName:    "object4",
+				Delete:      true,
+				DeleteRange: &KeyRange{Start: int32(1), End: int32(10)},
+			},
+		},
+		{
+			name: "valid prefix deletion",
+			objectType: StateObjectType{
+				Name:      "object2",
+				KeyFields: object2Type.KeyFields,
+			},
+			object: StateObjectUpdate{
+				TypeName:    "object2",
+				Delete:      true,
+				DeleteRange: &KeyRange{Start: []interface{}{"a"}, End: []interface{}{"a"}},
+			},
+		},
+		{
+			name:       "range without delete",
+			objectType: object4Type,
+			object: StateObjectUpdate{
+				TypeName:    "object4",
+				Key:         int32(1),
+				Value:       "hello",
+				DeleteRange: &KeyRange{Start: int32(1), End: int32(10)},
+			},
+			errContains: "delete range set on non-delete update",
+		},
+		{
+			name: "mismatched range prefix lengths",
+			objectType: StateObjectType{
+				Name:      "object2",
+				KeyFields: object2Type.KeyFields,
+			},
+			object: StateObjectUpdate{
+				TypeName:    "object2",
+				Delete:      true,
+				DeleteRange: &KeyRange{Start: []interface{}{"a"}, End: []interface{}{"a", int32(1)}},
+			},
+			errContains: "range start has 1 key fields but range end has 2",
+		},
+		{
+			name:       "invalid range value",
+			objectType: object4Type,
+			object: StateObjectUpdate{
+				TypeName:    "object4",
+				Delete:      true,
+				DeleteRange: &KeyRange{Start: "a", End: int32(10)},
+			},
+			errContains: "invalid range start",
+		},
 	}
 
 	for _, tt := range tests {
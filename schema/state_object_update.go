@@ -27,6 +27,26 @@ type StateObjectUpdate struct {
 	// Delete is a flag that indicates whether this update is a delete operation. If true, then the Value field
 	// is ignored and can be nil.
 	Delete bool
+
+	// DeleteRange optionally turns a delete operation into a bulk deletion of all objects whose keys lie
+	// within the range, such as when a module clears a prefix of its state. If it is non-nil, Delete must
+	// be true and Key is ignored.
+	DeleteRange *KeyRange
+}
+
+// KeyRange represents an inclusive range of object keys.
+//
+// Start and End are key prefixes. For object types with a single key field, they must be valid values
+// for that field. For object types with multiple key fields, they must be slices of values for the first
+// n key fields, where n is between 1 and the number of key fields and must be the same for both bounds.
+// A range matches all objects whose first n key field values compare between Start and End inclusively,
+// so a deletion of all objects sharing a key prefix is represented by a range where Start and End are equal.
+type KeyRange struct {
+	// Start is the inclusive lower bound of the range.
+	Start interface{}
+
+	// End is the inclusive upper bound of the range.
+	End interface{}
 }
 
 // ValueUpdates is an interface that represents the value fields of an object update. fields that
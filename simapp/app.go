@@ -18,6 +18,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/x/accounts"
 	basedepinject "cosmossdk.io/x/accounts/defaults/base/depinject"
+	"cosmossdk.io/x/accounts/defaults/lockup"
 	lockupdepinject "cosmossdk.io/x/accounts/defaults/lockup/depinject"
 	multisigdepinject "cosmossdk.io/x/accounts/defaults/multisig/depinject"
 	"cosmossdk.io/x/accounts/testing/account_abstraction"
@@ -168,6 +169,9 @@ func NewSimApp(
 				// inject desired account types:
 				multisigdepinject.ProvideAccount,
 				basedepinject.ProvideAccount,
+				basedepinject.ProvideSessionAccount,
+				basedepinject.ProvideSpendLimitAccount,
+				basedepinject.ProvideRecoveryAccount,
 				lockupdepinject.ProvideAllLockupAccounts,
 
 				// provide base account options
@@ -305,7 +309,7 @@ func (app *SimApp) setCustomAnteHandler() {
 		HandlerOptions{
 			ante.HandlerOptions{
 				AccountKeeper:            app.AuthKeeper,
-				BankKeeper:               app.BankKeeper,
+				BankKeeper:               lockup.NewFeeBankKeeper(app.BankKeeper, lockup.NewLockedCoinsProvider(app.AccountsKeeper)),
 				ConsensusKeeper:          app.ConsensusParamsKeeper,
 				SignModeHandler:          app.txConfig.SignModeHandler(),
 				FeegrantKeeper:           app.FeeGrantKeeper,
@@ -58,7 +58,11 @@ func AppConfig() depinject.Config {
 			// inject desired account types:
 			multisigdepinject.ProvideAccount,
 			basedepinject.ProvideAccount,
+			basedepinject.ProvideSessionAccount,
+			basedepinject.ProvideSpendLimitAccount,
+			basedepinject.ProvideRecoveryAccount,
 			lockupdepinject.ProvideAllLockupAccounts,
+			lockupdepinject.ProvideLockedCoinsProvider,
 
 			// provide base account options
 			basedepinject.ProvideSecp256K1PubKey,
@@ -14,6 +14,7 @@ const (
 	DBTypeRocksDB   DBType = "rocksdb"
 	DBTypePebbleDB  DBType = "pebbledb"
 	DBTypePrefixDB  DBType = "prefixdb"
+	DBTypeMemDB     DBType = "memdb"
 
 	DBFileSuffix string = ".db"
 )
@@ -25,6 +26,10 @@ func NewDB(dbType DBType, name, dataDir string, opts coreserver.DynamicConfig) (
 
 	case DBTypePebbleDB:
 		return NewPebbleDB(name, dataDir)
+
+	case DBTypeMemDB:
+		// in-memory databases are not persisted, e.g. for tests
+		return NewMemDB(), nil
 	}
 
 	return nil, fmt.Errorf("unsupported db type: %s", dbType)
@@ -145,7 +145,35 @@ var (
 				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
 				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
 		),
+		GenType(&feegranttypes.MsgGrantSubAllowance{}, &feegrantapi.MsgGrantSubAllowance{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(
+					&feegrantapi.BasicAllowance{},
+					&feegrantapi.PeriodicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
+		),
 		GenType(&feegranttypes.MsgRevokeAllowance{}, &feegrantapi.MsgRevokeAllowance{}, GenOpts),
+		GenType(&feegranttypes.MsgRevokeAllAllowances{}, &feegrantapi.MsgRevokeAllAllowances{}, GenOpts),
+		GenType(&feegranttypes.MsgGrantGroupAllowance{}, &feegrantapi.MsgGrantGroupAllowance{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(
+					&feegrantapi.BasicAllowance{},
+					&feegrantapi.PeriodicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
+		),
+		GenType(&feegranttypes.MsgRevokeGroupAllowance{}, &feegrantapi.MsgRevokeGroupAllowance{}, GenOpts),
+		GenType(&feegranttypes.MsgGrantModuleAllowance{}, &feegrantapi.MsgGrantModuleAllowance{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(
+					&feegrantapi.BasicAllowance{},
+					&feegrantapi.PeriodicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
+		),
+		GenType(&feegranttypes.MsgRevokeModuleAllowance{}, &feegrantapi.MsgRevokeModuleAllowance{}, GenOpts),
+		GenType(&feegranttypes.MsgUpdateParams{}, &feegrantapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
 
 		// gov v1beta1
 		GenType(&gov_v1beta1_types.MsgSubmitProposal{}, &gov_v1beta1_api.MsgSubmitProposal{},
@@ -252,6 +280,47 @@ var (
 				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
 				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
 		),
+		GenType(&feegranttypes.AllowedMsgCountAllowance{}, &feegrantapi.AllowedMsgCountAllowance{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(
+					&feegrantapi.BasicAllowance{},
+					&feegrantapi.PeriodicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
+		),
+		GenType(&feegranttypes.GasPriceCapAllowance{}, &feegrantapi.GasPriceCapAllowance{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(
+					&feegrantapi.BasicAllowance{},
+					&feegrantapi.PeriodicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
+		),
+		GenType(&feegranttypes.AllowedDenomAllowance{}, &feegrantapi.AllowedDenomAllowance{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(
+					&feegrantapi.BasicAllowance{},
+					&feegrantapi.PeriodicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
+		),
+		GenType(&feegranttypes.BudgetAllowance{}, &feegrantapi.BudgetAllowance{}, GenOpts.WithDisallowNil()),
+		GenType(&feegranttypes.AndAllowance{}, &feegrantapi.AndAllowance{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(
+					&feegrantapi.BasicAllowance{},
+					&feegrantapi.PeriodicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
+		),
+		GenType(&feegranttypes.OrAllowance{}, &feegrantapi.OrAllowance{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(
+					&feegrantapi.BasicAllowance{},
+					&feegrantapi.PeriodicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
+		),
 
 		GenType(&gov_v1beta1_types.TextProposal{}, &gov_v1beta1_api.TextProposal{}, GenOpts),
 
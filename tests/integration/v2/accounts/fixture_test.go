@@ -10,7 +10,6 @@ import (
 	"cosmossdk.io/core/router"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/depinject"
-	"cosmossdk.io/log"
 	"cosmossdk.io/runtime/v2"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/accounts/accountstd"
@@ -129,21 +128,6 @@ func initFixture(t *testing.T, f authentiacteFunc) *fixture {
 	encodingCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, bank.AppModule{}, accounts.AppModule{})
 	cdc := encodingCfg.Codec
 
-	moduleConfigs := []configurator.ModuleOption{
-		configurator.AccountsModule(),
-		configurator.AuthModule(),
-		configurator.BankModule(),
-		configurator.VestingModule(),
-		configurator.StakingModule(),
-		configurator.TxModule(),
-		configurator.ValidateModule(),
-		configurator.ConsensusModule(),
-		configurator.GenutilModule(),
-	}
-
-	var err error
-	startupCfg := integration.DefaultStartUpConfig(t)
-
 	msgRouterService := integration.NewRouterService()
 	fixture.registerMsgRouterService(msgRouterService)
 
@@ -156,13 +140,9 @@ func initFixture(t *testing.T, f authentiacteFunc) *fixture {
 
 	serviceBuilder := runtime.NewRouterBuilder(routerFactory, queryRouterService)
 
-	startupCfg.BranchService = &integration.BranchService{}
-	startupCfg.RouterServiceBuilder = serviceBuilder
-	startupCfg.HeaderService = &integration.HeaderService{}
-	startupCfg.GasService = &integration.GasService{}
-
-	fixture.app, err = integration.NewApp(
-		depinject.Configs(configurator.NewAppV2Config(moduleConfigs...), depinject.Provide(
+	appFixture := integration.NewFixture().
+		WithModules(configurator.VestingModule(), configurator.GenutilModule()).
+		WithDepInjectConfigs(depinject.Provide(
 			// inject desired account types:
 			basedepinject.ProvideAccount,
 
@@ -171,12 +151,17 @@ func initFixture(t *testing.T, f authentiacteFunc) *fixture {
 
 			ProvideMockAccount,
 			counteraccount.ProvideAccount,
-		), depinject.Supply(log.NewNopLogger(), f)),
-		startupCfg,
-		&fixture.bankKeeper, &fixture.accountsKeeper, &fixture.authKeeper, &fixture.cdc)
-	require.NoError(t, err)
-
-	fixture.ctx = fixture.app.StateLatestContext(t)
+		), depinject.Supply(f)).
+		WithStartupConfig(func(cfg *integration.StartupConfig) {
+			cfg.BranchService = &integration.BranchService{}
+			cfg.RouterServiceBuilder = serviceBuilder
+			cfg.HeaderService = &integration.HeaderService{}
+			cfg.GasService = &integration.GasService{}
+		}).
+		Build(t, &fixture.bankKeeper, &fixture.accountsKeeper, &fixture.authKeeper, &fixture.cdc)
+
+	fixture.app = appFixture.App
+	fixture.ctx = appFixture.Ctx
 
 	// init account
 	_, addr, err := fixture.accountsKeeper.Init(fixture.ctx, "mock", []byte("system"), &gogotypes.Empty{}, nil, nil)
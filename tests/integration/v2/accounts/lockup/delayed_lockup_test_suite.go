@@ -13,33 +13,34 @@ import (
 	types "cosmossdk.io/x/accounts/defaults/lockup/v1"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/tests/integration/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *IntegrationTestSuite) TestDelayedLockingAccount() {
 	t := s.T()
-	app := setupApp(t)
+	f := initFixture(t)
 	currentTime := time.Now()
-	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger()).WithHeaderInfo(header.Info{
+	ctx := integration.SetHeaderInfo(f.ctx, header.Info{
 		Time: currentTime,
 	})
-	s.setupStakingParams(ctx, app)
-	ownerAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(accOwner)
+	s.setupStakingParams(ctx, f)
+	ownerAddrStr, err := f.authKeeper.AddressCodec().BytesToString(accOwner)
 	require.NoError(t, err)
-	s.fundAccount(app, ctx, accOwner, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000000))})
+	s.fundAccount(f, ctx, accOwner, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000000))})
 	randAcc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
-	_, accountAddr, err := app.AccountsKeeper.Init(ctx, lockupaccount.DELAYED_LOCKING_ACCOUNT, accOwner, &types.MsgInitLockupAccount{
+	_, accountAddr, err := f.accountsKeeper.Init(ctx, lockupaccount.DELAYED_LOCKING_ACCOUNT, accOwner, &types.MsgInitLockupAccount{
 		Owner: ownerAddrStr,
 		// end time in 1 minutes
 		EndTime: currentTime.Add(time.Minute),
 	}, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000))}, nil)
 	require.NoError(t, err)
 
-	addr, err := app.AuthKeeper.AddressCodec().BytesToString(randAcc)
+	addr, err := f.authKeeper.AddressCodec().BytesToString(randAcc)
 	require.NoError(t, err)
 
-	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	vals, err := f.stakingKeeper.GetAllValidators(ctx)
 	require.NoError(t, err)
 	val := vals[0]
 
@@ -49,7 +50,7 @@ func (s *IntegrationTestSuite) TestDelayedLockingAccount() {
 			ToAddress: addr,
 			Amount:    sdk.Coins{sdk.NewCoin("stake", math.NewInt(100))},
 		}
-		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err := s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NotNil(t, err)
 	})
 	t.Run("error - execute send message, insufficient fund", func(t *testing.T) {
@@ -58,7 +59,7 @@ func (s *IntegrationTestSuite) TestDelayedLockingAccount() {
 			ToAddress: addr,
 			Amount:    sdk.Coins{sdk.NewCoin("stake", math.NewInt(100))},
 		}
-		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err := s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NotNil(t, err)
 	})
 	t.Run("ok - execute delegate message", func(t *testing.T) {
@@ -67,20 +68,20 @@ func (s *IntegrationTestSuite) TestDelayedLockingAccount() {
 			ValidatorAddress: val.OperatorAddress,
 			Amount:           sdk.NewCoin("stake", math.NewInt(100)),
 		}
-		err = s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err = s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NoError(t, err)
 
-		valbz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+		valbz, err := f.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
 		require.NoError(t, err)
 
-		del, err := app.StakingKeeper.Delegations.Get(
+		del, err := f.stakingKeeper.Delegations.Get(
 			ctx, collections.Join(sdk.AccAddress(accountAddr), sdk.ValAddress(valbz)),
 		)
 		require.NoError(t, err)
 		require.NotNil(t, del)
 
 		// check if tracking is updated accordingly
-		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
+		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, f, accountAddr)
 		delLocking := lockupAccountInfoResponse.DelegatedLocking
 		require.True(t, delLocking.AmountOf("stake").Equal(math.NewInt(100)))
 	})
@@ -89,11 +90,11 @@ func (s *IntegrationTestSuite) TestDelayedLockingAccount() {
 			Sender:           ownerAddrStr,
 			ValidatorAddress: val.OperatorAddress,
 		}
-		err = s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err = s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NoError(t, err)
 	})
 	t.Run("ok - execute undelegate message", func(t *testing.T) {
-		vals, err := app.StakingKeeper.GetAllValidators(ctx)
+		vals, err := f.stakingKeeper.GetAllValidators(ctx)
 		require.NoError(t, err)
 		val := vals[0]
 		msg := &types.MsgUndelegate{
@@ -101,19 +102,19 @@ func (s *IntegrationTestSuite) TestDelayedLockingAccount() {
 			ValidatorAddress: val.OperatorAddress,
 			Amount:           sdk.NewCoin("stake", math.NewInt(100)),
 		}
-		err = s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err = s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NoError(t, err)
-		valbz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+		valbz, err := f.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
 		require.NoError(t, err)
 
-		ubd, err := app.StakingKeeper.GetUnbondingDelegation(
+		ubd, err := f.stakingKeeper.GetUnbondingDelegation(
 			ctx, sdk.AccAddress(accountAddr), sdk.ValAddress(valbz),
 		)
 		require.NoError(t, err)
 		require.Equal(t, len(ubd.Entries), 1)
 
 		// check if an entry is added
-		unbondingEntriesResponse := s.queryUnbondingEntries(ctx, app, accountAddr, val.OperatorAddress)
+		unbondingEntriesResponse := s.queryUnbondingEntries(ctx, f, accountAddr, val.OperatorAddress)
 		entries := unbondingEntriesResponse.UnbondingEntries
 		require.True(t, entries[0].Amount.Amount.Equal(math.NewInt(100)))
 		require.True(t, entries[0].ValidatorAddress == val.OperatorAddress)
@@ -122,12 +123,12 @@ func (s *IntegrationTestSuite) TestDelayedLockingAccount() {
 	// Update context time
 	// After endtime fund should be unlock
 	// And unbond time elapsed
-	ctx = ctx.WithHeaderInfo(header.Info{
+	ctx = integration.SetHeaderInfo(ctx, header.Info{
 		Time: currentTime.Add(time.Second * 61),
 	})
 
 	// trigger endblock for staking to handle matured unbonding delegation
-	_, err = app.StakingKeeper.EndBlocker(ctx)
+	_, err = f.stakingKeeper.EndBlocker(ctx)
 	require.NoError(t, err)
 
 	// Check if token is sendable after unlock
@@ -137,19 +138,19 @@ func (s *IntegrationTestSuite) TestDelayedLockingAccount() {
 			ToAddress: addr,
 			Amount:    sdk.Coins{sdk.NewCoin("stake", math.NewInt(100))},
 		}
-		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err := s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NoError(t, err)
 
-		balance := app.BankKeeper.GetBalance(ctx, randAcc, "stake")
+		balance := f.bankKeeper.GetBalance(ctx, randAcc, "stake")
 		require.True(t, balance.Amount.Equal(math.NewInt(100)))
 
 		// check if tracking ubd entry is updated accordingly
-		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
+		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, f, accountAddr)
 		delLocking := lockupAccountInfoResponse.DelegatedLocking
 		require.True(t, delLocking.AmountOf("stake").Equal(math.ZeroInt()))
 
 		// check if the entry is removed
-		unbondingEntriesResponse := s.queryUnbondingEntries(ctx, app, accountAddr, val.OperatorAddress)
+		unbondingEntriesResponse := s.queryUnbondingEntries(ctx, f, accountAddr, val.OperatorAddress)
 		entries := unbondingEntriesResponse.UnbondingEntries
 		require.Len(t, entries, 0)
 	})
@@ -13,31 +13,32 @@ import (
 	types "cosmossdk.io/x/accounts/defaults/lockup/v1"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/tests/integration/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *IntegrationTestSuite) TestPermanentLockingAccount() {
 	t := s.T()
-	app := setupApp(t)
+	f := initFixture(t)
 	currentTime := time.Now()
-	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger()).WithHeaderInfo(header.Info{
+	ctx := integration.SetHeaderInfo(f.ctx, header.Info{
 		Time: currentTime,
 	})
-	s.setupStakingParams(ctx, app)
-	ownerAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(accOwner)
+	s.setupStakingParams(ctx, f)
+	ownerAddrStr, err := f.authKeeper.AddressCodec().BytesToString(accOwner)
 	require.NoError(t, err)
-	s.fundAccount(app, ctx, accOwner, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000000))})
+	s.fundAccount(f, ctx, accOwner, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000000))})
 	randAcc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
-	_, accountAddr, err := app.AccountsKeeper.Init(ctx, lockupaccount.PERMANENT_LOCKING_ACCOUNT, accOwner, &types.MsgInitLockupAccount{
+	_, accountAddr, err := f.accountsKeeper.Init(ctx, lockupaccount.PERMANENT_LOCKING_ACCOUNT, accOwner, &types.MsgInitLockupAccount{
 		Owner: ownerAddrStr,
 	}, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000))}, nil)
 	require.NoError(t, err)
 
-	addr, err := app.AuthKeeper.AddressCodec().BytesToString(randAcc)
+	addr, err := f.authKeeper.AddressCodec().BytesToString(randAcc)
 	require.NoError(t, err)
 
-	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	vals, err := f.stakingKeeper.GetAllValidators(ctx)
 	require.NoError(t, err)
 	val := vals[0]
 
@@ -47,7 +48,7 @@ func (s *IntegrationTestSuite) TestPermanentLockingAccount() {
 			ToAddress: addr,
 			Amount:    sdk.Coins{sdk.NewCoin("stake", math.NewInt(100))},
 		}
-		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err := s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NotNil(t, err)
 	})
 	t.Run("error - execute send message, insufficient fund", func(t *testing.T) {
@@ -56,7 +57,7 @@ func (s *IntegrationTestSuite) TestPermanentLockingAccount() {
 			ToAddress: addr,
 			Amount:    sdk.Coins{sdk.NewCoin("stake", math.NewInt(100))},
 		}
-		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err := s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NotNil(t, err)
 	})
 	t.Run("ok - execute delegate message", func(t *testing.T) {
@@ -65,20 +66,20 @@ func (s *IntegrationTestSuite) TestPermanentLockingAccount() {
 			ValidatorAddress: val.OperatorAddress,
 			Amount:           sdk.NewCoin("stake", math.NewInt(100)),
 		}
-		err = s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err = s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NoError(t, err)
 
-		valbz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+		valbz, err := f.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
 		require.NoError(t, err)
 
-		del, err := app.StakingKeeper.Delegations.Get(
+		del, err := f.stakingKeeper.Delegations.Get(
 			ctx, collections.Join(sdk.AccAddress(accountAddr), sdk.ValAddress(valbz)),
 		)
 		require.NoError(t, err)
 		require.NotNil(t, del)
 
 		// check if tracking is updated accordingly
-		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
+		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, f, accountAddr)
 		delLocking := lockupAccountInfoResponse.DelegatedLocking
 		require.True(t, delLocking.AmountOf("stake").Equal(math.NewInt(100)))
 	})
@@ -87,11 +88,11 @@ func (s *IntegrationTestSuite) TestPermanentLockingAccount() {
 			Sender:           ownerAddrStr,
 			ValidatorAddress: val.OperatorAddress,
 		}
-		err = s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err = s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NoError(t, err)
 	})
 	t.Run("ok - execute undelegate message", func(t *testing.T) {
-		vals, err := app.StakingKeeper.GetAllValidators(ctx)
+		vals, err := f.stakingKeeper.GetAllValidators(ctx)
 		require.NoError(t, err)
 		val := vals[0]
 		msg := &types.MsgUndelegate{
@@ -99,25 +100,25 @@ func (s *IntegrationTestSuite) TestPermanentLockingAccount() {
 			ValidatorAddress: val.OperatorAddress,
 			Amount:           sdk.NewCoin("stake", math.NewInt(100)),
 		}
-		err = s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err = s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NoError(t, err)
-		valbz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+		valbz, err := f.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
 		require.NoError(t, err)
 
-		ubd, err := app.StakingKeeper.GetUnbondingDelegation(
+		ubd, err := f.stakingKeeper.GetUnbondingDelegation(
 			ctx, sdk.AccAddress(accountAddr), sdk.ValAddress(valbz),
 		)
 		require.NoError(t, err)
 		require.Equal(t, len(ubd.Entries), 1)
 
 		// check if an entry is added
-		unbondingEntriesResponse := s.queryUnbondingEntries(ctx, app, accountAddr, val.OperatorAddress)
+		unbondingEntriesResponse := s.queryUnbondingEntries(ctx, f, accountAddr, val.OperatorAddress)
 		entries := unbondingEntriesResponse.UnbondingEntries
 		require.True(t, entries[0].Amount.Amount.Equal(math.NewInt(100)))
 		require.True(t, entries[0].ValidatorAddress == val.OperatorAddress)
 	})
 
-	s.fundAccount(app, ctx, accountAddr, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000))})
+	s.fundAccount(f, ctx, accountAddr, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000))})
 
 	t.Run("ok - execute send message", func(t *testing.T) {
 		msg := &types.MsgSend{
@@ -125,20 +126,20 @@ func (s *IntegrationTestSuite) TestPermanentLockingAccount() {
 			ToAddress: addr,
 			Amount:    sdk.Coins{sdk.NewCoin("stake", math.NewInt(100))},
 		}
-		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err := s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NoError(t, err)
 
-		balance := app.BankKeeper.GetBalance(ctx, randAcc, "stake")
+		balance := f.bankKeeper.GetBalance(ctx, randAcc, "stake")
 		require.True(t, balance.Amount.Equal(math.NewInt(100)))
 	})
 
 	// Update context time
-	ctx = ctx.WithHeaderInfo(header.Info{
+	ctx = integration.SetHeaderInfo(ctx, header.Info{
 		Time: currentTime.Add(time.Second * 11),
 	})
 
 	// trigger endblock for staking to handle matured unbonding delegation
-	_, err = app.StakingKeeper.EndBlocker(ctx)
+	_, err = f.stakingKeeper.EndBlocker(ctx)
 	require.NoError(t, err)
 
 	t.Run("ok - execute delegate message", func(t *testing.T) {
@@ -147,26 +148,26 @@ func (s *IntegrationTestSuite) TestPermanentLockingAccount() {
 			ValidatorAddress: val.OperatorAddress,
 			Amount:           sdk.NewCoin("stake", math.NewInt(10)),
 		}
-		err = s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		err = s.executeTx(ctx, msg, f, accountAddr, accOwner)
 		require.NoError(t, err)
 
-		valbz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+		valbz, err := f.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
 		require.NoError(t, err)
 
-		del, err := app.StakingKeeper.Delegations.Get(
+		del, err := f.stakingKeeper.Delegations.Get(
 			ctx, collections.Join(sdk.AccAddress(accountAddr), sdk.ValAddress(valbz)),
 		)
 		require.NoError(t, err)
 		require.NotNil(t, del)
 
 		// check if tracking is updated accordingly
-		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
+		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, f, accountAddr)
 		delLocking := lockupAccountInfoResponse.DelegatedLocking
 		// matured ubd entry should be cleared so del locking should only be 10
 		require.True(t, delLocking.AmountOf("stake").Equal(math.NewInt(10)))
 
 		// check if the entry is removed
-		unbondingEntriesResponse := s.queryUnbondingEntries(ctx, app, accountAddr, val.OperatorAddress)
+		unbondingEntriesResponse := s.queryUnbondingEntries(ctx, f, accountAddr, val.OperatorAddress)
 		entries := unbondingEntriesResponse.UnbondingEntries
 		require.Len(t, entries, 0)
 	})
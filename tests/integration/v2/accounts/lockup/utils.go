@@ -0,0 +1,261 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/router"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/runtime/v2"
+	"cosmossdk.io/x/accounts"
+	basedepinject "cosmossdk.io/x/accounts/defaults/base/depinject"
+	lockupdepinject "cosmossdk.io/x/accounts/defaults/lockup/depinject"
+	types "cosmossdk.io/x/accounts/defaults/lockup/v1"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	"cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+	_ "cosmossdk.io/x/distribution" // import as blank for app wiring
+	distrkeeper "cosmossdk.io/x/distribution/keeper"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	_ "cosmossdk.io/x/mint"         // import as blank for app wiring
+	_ "cosmossdk.io/x/protocolpool" // import as blank for app wiring
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/tests/integration/v2"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	_ "github.com/cosmos/cosmos-sdk/x/genutil" // import as blank for app wiring
+)
+
+var (
+	ownerAddr = secp256k1.GenPrivKey().PubKey().Address()
+	accOwner  = sdk.AccAddress(ownerAddr)
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+}
+
+func NewIntegrationTestSuite() *IntegrationTestSuite {
+	return &IntegrationTestSuite{}
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+}
+
+type fixture struct {
+	app *integration.App
+	ctx context.Context
+
+	accountsKeeper accounts.Keeper
+	authKeeper     authkeeper.AccountKeeper
+	bankKeeper     bankkeeper.Keeper
+	distrKeeper    distrkeeper.Keeper
+	stakingKeeper  *stakingkeeper.Keeper
+}
+
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	f := &fixture{}
+
+	msgRouterService := integration.NewRouterService()
+	f.registerMsgRouterService(msgRouterService)
+
+	var routerFactory runtime.RouterServiceFactory = func(_ []byte) router.Service {
+		return msgRouterService
+	}
+
+	queryRouterService := integration.NewRouterService()
+	f.registerQueryRouterService(queryRouterService)
+
+	serviceBuilder := runtime.NewRouterBuilder(routerFactory, queryRouterService)
+
+	appFixture := integration.NewFixture().
+		WithModules(
+			configurator.GenutilModule(),
+			configurator.DistributionModule(),
+			configurator.MintModule(),
+			configurator.ProtocolPoolModule(),
+		).
+		WithDepInjectConfigs(depinject.Provide(
+			// inject desired account types:
+			basedepinject.ProvideAccount,
+
+			// provide base account options
+			basedepinject.ProvideSecp256K1PubKey,
+
+			// provide extra accounts
+			lockupdepinject.ProvideAllLockupAccounts,
+		)).
+		WithStartupConfig(func(cfg *integration.StartupConfig) {
+			cfg.BranchService = &integration.BranchService{}
+			cfg.RouterServiceBuilder = serviceBuilder
+			cfg.HeaderService = &integration.HeaderService{}
+			cfg.GasService = &integration.GasService{}
+		}).
+		Build(t, &f.accountsKeeper, &f.authKeeper, &f.bankKeeper, &f.distrKeeper, &f.stakingKeeper)
+
+	f.app = appFixture.App
+	f.ctx = appFixture.Ctx
+	return f
+}
+
+// registerMsgRouterService registers the handlers of the module messages sent by the lockup accounts.
+func (f *fixture) registerMsgRouterService(router *integration.RouterService) {
+	bankSendHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*banktypes.MsgSend)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		msgServer := bankkeeper.NewMsgServerImpl(f.bankKeeper)
+		return msgServer.Send(ctx, msg)
+	}
+
+	stakingDelegateHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*stakingtypes.MsgDelegate)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		msgServer := stakingkeeper.NewMsgServerImpl(f.stakingKeeper)
+		return msgServer.Delegate(ctx, msg)
+	}
+
+	stakingUndelegateHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*stakingtypes.MsgUndelegate)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		msgServer := stakingkeeper.NewMsgServerImpl(f.stakingKeeper)
+		return msgServer.Undelegate(ctx, msg)
+	}
+
+	distrWithdrawRewardHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*distrtypes.MsgWithdrawDelegatorReward)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+		return msgServer.WithdrawDelegatorReward(ctx, msg)
+	}
+
+	router.RegisterHandler(bankSendHandler, "cosmos.bank.v1beta1.MsgSend")
+	router.RegisterHandler(stakingDelegateHandler, "cosmos.staking.v1beta1.MsgDelegate")
+	router.RegisterHandler(stakingUndelegateHandler, "cosmos.staking.v1beta1.MsgUndelegate")
+	router.RegisterHandler(distrWithdrawRewardHandler, "cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward")
+}
+
+// registerQueryRouterService registers the handlers of the module queries sent by the lockup accounts.
+func (f *fixture) registerQueryRouterService(router *integration.RouterService) {
+	bankBalanceHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*banktypes.QueryBalanceRequest)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		return f.bankKeeper.Balance(ctx, msg)
+	}
+
+	bankAllBalancesHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*banktypes.QueryAllBalancesRequest)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		return f.bankKeeper.AllBalances(ctx, msg)
+	}
+
+	stakingParamsHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*stakingtypes.QueryParamsRequest)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		qs := stakingkeeper.NewQuerier(f.stakingKeeper)
+		return qs.Params(ctx, msg)
+	}
+
+	stakingDelegatorDelegationsHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*stakingtypes.QueryDelegatorDelegationsRequest)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		qs := stakingkeeper.NewQuerier(f.stakingKeeper)
+		return qs.DelegatorDelegations(ctx, msg)
+	}
+
+	stakingUnbondingDelegationHandler := func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+		msg, ok := req.(*stakingtypes.QueryUnbondingDelegationRequest)
+		if !ok {
+			return nil, integration.ErrInvalidMsgType
+		}
+		qs := stakingkeeper.NewQuerier(f.stakingKeeper)
+		return qs.UnbondingDelegation(ctx, msg)
+	}
+
+	router.RegisterHandler(bankBalanceHandler, "cosmos.bank.v1beta1.QueryBalanceRequest")
+	router.RegisterHandler(bankAllBalancesHandler, "cosmos.bank.v1beta1.QueryAllBalancesRequest")
+	router.RegisterHandler(stakingParamsHandler, "cosmos.staking.v1beta1.QueryParamsRequest")
+	router.RegisterHandler(stakingDelegatorDelegationsHandler, "cosmos.staking.v1beta1.QueryDelegatorDelegationsRequest")
+	router.RegisterHandler(stakingUnbondingDelegationHandler, "cosmos.staking.v1beta1.QueryUnbondingDelegationRequest")
+}
+
+func (s *IntegrationTestSuite) executeTx(ctx context.Context, msg sdk.Msg, f *fixture, accAddr, sender []byte) error {
+	_, err := f.accountsKeeper.Execute(ctx, accAddr, sender, msg, nil)
+	return err
+}
+
+func (s *IntegrationTestSuite) queryAcc(ctx context.Context, req sdk.Msg, f *fixture, accAddr []byte) (transaction.Msg, error) {
+	resp, err := f.accountsKeeper.Query(ctx, accAddr, req)
+	return resp, err
+}
+
+func (s *IntegrationTestSuite) fundAccount(f *fixture, ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	require.NoError(s.T(), testutil.FundAccount(ctx, f.bankKeeper, addr, amt))
+}
+
+func (s *IntegrationTestSuite) queryLockupAccInfo(ctx context.Context, f *fixture, accAddr []byte) *types.QueryLockupAccountInfoResponse {
+	req := &types.QueryLockupAccountInfoRequest{}
+	resp, err := s.queryAcc(ctx, req, f, accAddr)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), resp)
+
+	lockupAccountInfoResponse, ok := resp.(*types.QueryLockupAccountInfoResponse)
+	require.True(s.T(), ok)
+
+	return lockupAccountInfoResponse
+}
+
+func (s *IntegrationTestSuite) queryUnbondingEntries(ctx context.Context, f *fixture, accAddr []byte, valAddr string) *types.QueryUnbondingEntriesResponse {
+	req := &types.QueryUnbondingEntriesRequest{
+		ValidatorAddress: valAddr,
+	}
+	resp, err := s.queryAcc(ctx, req, f, accAddr)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), resp)
+
+	unbondingEntriesResponse, ok := resp.(*types.QueryUnbondingEntriesResponse)
+	require.True(s.T(), ok)
+
+	return unbondingEntriesResponse
+}
+
+func (s *IntegrationTestSuite) setupStakingParams(ctx context.Context, f *fixture) {
+	params, err := f.stakingKeeper.Params.Get(ctx)
+	require.NoError(s.T(), err)
+
+	// update unbonding time
+	params.UnbondingTime = time.Duration(time.Second * 10)
+	err = f.stakingKeeper.Params.Set(ctx, params)
+	require.NoError(s.T(), err)
+}
@@ -155,6 +155,7 @@ func WithAutomaticCommit() Option {
 // NewApp initializes a new runtime.App. A Nop logger is set in runtime.App.
 // appConfig defines the application configuration (f.e. app_config.go).
 // extraOutputs defines the extra outputs to be assigned by the dependency injector (depinject).
+// Each app has its own in-memory database and services, so that apps can run in parallel tests.
 func NewApp(
 	appConfig depinject.Config,
 	startupConfig StartupConfig,
@@ -182,7 +183,7 @@ func NewApp(
 			depinject.Supply(
 				&root.Config{
 					Home:         startupConfig.HomeDir,
-					AppDBBackend: "goleveldb",
+					AppDBBackend: "memdb",
 					Options:      root.DefaultStoreOptions(),
 				},
 				runtime.GlobalConfig{
@@ -225,7 +226,7 @@ func NewApp(
 		return nil, fmt.Errorf("failed to set initial version: %w", err)
 	}
 
-	integrationApp := &App{App: app, Store: store, txConfig: txConfig, lastHeight: 0}
+	integrationApp := &App{App: app, Store: store, txConfig: txConfig, cdc: cdc, lastHeight: 0}
 	if startupConfig.GenesisBehavior == Genesis_SKIP {
 		return integrationApp, nil
 	}
@@ -285,11 +286,12 @@ func NewApp(
 	)
 
 	emptyHash := sha256.Sum256(nil)
+	integrationApp.lastBlockTime = time.Now()
 	_, genesisState, err := app.InitGenesis(
 		ctx,
 		&server.BlockRequest[stateMachineTx]{
 			Height:    1,
-			Time:      time.Now(),
+			Time:      integrationApp.lastBlockTime,
 			Hash:      emptyHash[:],
 			ChainId:   "test-chain",
 			AppHash:   emptyHash[:],
@@ -318,22 +320,63 @@ func NewApp(
 // App is a wrapper around runtime.App that provides additional testing utilities.
 type App struct {
 	*runtime.App[stateMachineTx]
-	lastHeight uint64
-	Store      store.RootStore
-	txConfig   client.TxConfig
+	lastHeight    uint64
+	lastBlockTime time.Time
+	queuedTxs     []stateMachineTx
+	Store         store.RootStore
+	txConfig      client.TxConfig
+	cdc           codec.Codec
 }
 
 func (a App) LastBlockHeight() uint64 {
 	return a.lastHeight
 }
 
+// LastBlockTime returns the header time of the last block delivered by NextBlock, or of the genesis block.
+func (a App) LastBlockTime() time.Time {
+	return a.lastBlockTime
+}
+
+// QueueTx queues the given transactions, to be delivered in the next block by NextBlock.
+func (a *App) QueueTx(txs ...stateMachineTx) {
+	a.queuedTxs = append(a.queuedTxs, txs...)
+}
+
+// NextBlock delivers and commits a block at the next height, holding the queued transactions, whose header time
+// is d after the one of the last block. The begin and end blockers of the modules run as in any block. The
+// header info of the integration context of ctx, if any, is set to the height and time of the block.
+func (a *App) NextBlock(t *testing.T, ctx context.Context, d time.Duration) *server.BlockResponse {
+	t.Helper()
+
+	blockTime := a.lastBlockTime.Add(d)
+	txs := a.queuedTxs
+	a.queuedTxs = nil
+
+	resp, state := a.deliver(t, ctx, txs, blockTime)
+	a.lastBlockTime = blockTime
+
+	_, err := a.Commit(state)
+	require.NoError(t, err)
+
+	return resp
+}
+
 // Deliver delivers a block with the given transactions and returns the resulting state.
 func (a *App) Deliver(
 	t *testing.T, ctx context.Context, txs []stateMachineTx,
+) (*server.BlockResponse, corestore.WriterMap) {
+	t.Helper()
+	return a.deliver(t, ctx, txs, time.Time{})
+}
+
+// deliver delivers a block with the given transactions and header time, and returns the resulting state.
+func (a *App) deliver(
+	t *testing.T, ctx context.Context, txs []stateMachineTx, blockTime time.Time,
 ) (*server.BlockResponse, corestore.WriterMap) {
 	t.Helper()
 	req := &server.BlockRequest[stateMachineTx]{
 		Height:  a.lastHeight + 1,
+		Time:    blockTime,
 		Txs:     txs,
 		Hash:    make([]byte, 32),
 		AppHash: make([]byte, 32),
@@ -346,6 +389,9 @@ func (a *App) Deliver(
 	iCtx, ok := ctx.Value(contextKey).(*integrationContext)
 	if ok {
 		iCtx.header.Height = int64(a.lastHeight)
+		if !blockTime.IsZero() {
+			iCtx.header.Time = blockTime
+		}
 	}
 	return resp, state
 }
@@ -378,6 +424,31 @@ func (a *App) SignCheckDeliver(
 ) server.TxResult {
 	t.Helper()
 
+	builtTx := a.SignTx(t, ctx, msgs, chainID, accNums, accSeqs, privateKeys)
+	blockResponse, blockState := a.Deliver(t, ctx, []stateMachineTx{builtTx})
+
+	require.Equal(t, 1, len(blockResponse.TxResults))
+	txResult := blockResponse.TxResults[0]
+	if txErrString != "" {
+		require.ErrorContains(t, txResult.Error, txErrString)
+	} else {
+		require.NoError(t, txResult.Error)
+	}
+
+	_, err := a.Commit(blockState)
+	require.NoError(t, err)
+
+	return txResult
+}
+
+// SignTx returns a transaction of the given messages, signed by the given private keys, e.g. to be queued with
+// QueueTx.
+func (a *App) SignTx(
+	t *testing.T, ctx context.Context, msgs []sdk.Msg,
+	chainID string, accNums, accSeqs []uint64, privateKeys []cryptotypes.PrivKey,
+) stateMachineTx {
+	t.Helper()
+
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	sigs := make([]signing.SignatureV2, len(privateKeys))
 
@@ -430,21 +501,7 @@ func (a *App) SignCheckDeliver(
 	err = txBuilder.SetSignatures(sigs...)
 	require.NoError(t, err)
 
-	builtTx := txBuilder.GetTx()
-	blockResponse, blockState := a.Deliver(t, ctx, []stateMachineTx{builtTx})
-
-	require.Equal(t, 1, len(blockResponse.TxResults))
-	txResult := blockResponse.TxResults[0]
-	if txErrString != "" {
-		require.ErrorContains(t, txResult.Error, txErrString)
-	} else {
-		require.NoError(t, txResult.Error)
-	}
-
-	_, err = a.Commit(blockState)
-	require.NoError(t, err)
-
-	return txResult
+	return txBuilder.GetTx()
 }
 
 // RunMsg runs the handler for a transaction message.
@@ -2,6 +2,7 @@ package bank
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	secp256k1_internal "gitlab.com/yawning/secp256k1-voi"
@@ -22,6 +23,7 @@ import (
 	govv1 "cosmossdk.io/x/gov/types/v1"
 	_ "cosmossdk.io/x/protocolpool"
 	_ "cosmossdk.io/x/staking"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -467,3 +469,146 @@ func TestSendToNonExistingAccount(t *testing.T) {
 	acc2 = s.AccountKeeper.GetAccount(ctx, addr2)
 	require.NotNil(t, acc2, "account should have been created %s", addr2.String())
 }
+
+func TestNextBlock(t *testing.T) {
+	acc1 := authtypes.NewBaseAccountWithAddress(addr1)
+	genAccs := []authtypes.GenesisAccount{acc1}
+	s := createTestSuite(t, genAccs)
+	ctx := s.App.StateLatestContext(t)
+
+	require.NoError(t, testutil.FundAccount(ctx, s.BankKeeper, addr1, coins))
+	height := s.App.LastBlockHeight()
+	blockTime := s.App.LastBlockTime().Add(time.Hour)
+
+	// empty blocks advance the height and the header time
+	resp := s.App.NextBlock(t, ctx, time.Hour)
+	require.Empty(t, resp.TxResults)
+	require.Equal(t, height+1, s.App.LastBlockHeight())
+	require.True(t, blockTime.Equal(s.App.LastBlockTime()))
+	headerInfo := integration.HeaderInfoFromContext(ctx)
+	require.Equal(t, int64(height+1), headerInfo.Height)
+	require.True(t, blockTime.Equal(headerInfo.Time))
+
+	// queued transactions are delivered in the next block, and only there
+	addr2Str, err := s.AccountKeeper.AddressCodec().BytesToString(addr2)
+	require.NoError(t, err)
+	sendMsg := types.NewMsgSend(addr1.String(), addr2Str, halfCoins)
+	s.App.QueueTx(s.App.SignTx(t, ctx, []sdk.Msg{sendMsg}, "", []uint64{0}, []uint64{0}, []cryptotypes.PrivKey{priv1}))
+
+	resp = s.App.NextBlock(t, ctx, time.Minute)
+	require.Len(t, resp.TxResults, 1)
+	require.NoError(t, resp.TxResults[0].Error)
+	s.App.CheckBalance(t, ctx, addr2, halfCoins, s.BankKeeper)
+	require.True(t, blockTime.Add(time.Minute).Equal(integration.HeaderInfoFromContext(ctx).Time))
+
+	resp = s.App.NextBlock(t, ctx, time.Minute)
+	require.Empty(t, resp.TxResults)
+	s.App.CheckBalance(t, ctx, addr2, halfCoins, s.BankKeeper)
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	acc1 := authtypes.NewBaseAccountWithAddress(addr1)
+	genAccs := []authtypes.GenesisAccount{acc1}
+	s := createTestSuite(t, genAccs)
+	ctx := s.App.StateLatestContext(t)
+
+	require.NoError(t, testutil.FundAccount(ctx, s.BankKeeper, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 67))))
+	s.App.NextBlock(t, ctx, time.Second)
+	snapshot := s.App.Snapshot(t)
+	height := s.App.LastBlockHeight()
+
+	addr2Str, err := s.AccountKeeper.AddressCodec().BytesToString(addr2)
+	require.NoError(t, err)
+	sendMsg := types.NewMsgSend(addr1.String(), addr2Str, coins)
+
+	// each subtest sends from the same account sequence, which only succeeds on a restored state
+	for _, desc := range []string{"first", "second"} {
+		t.Run(desc, func(t *testing.T) {
+			ctx := s.App.Restore(t, snapshot)
+			require.Equal(t, height, s.App.LastBlockHeight())
+			s.App.CheckBalance(t, ctx, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 67)}, s.BankKeeper)
+
+			s.App.SignCheckDeliver(t, ctx, []sdk.Msg{sendMsg}, "", []uint64{0}, []uint64{0}, []cryptotypes.PrivKey{priv1}, "")
+			s.App.CheckBalance(t, ctx, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 57)}, s.BankKeeper)
+			s.App.CheckBalance(t, ctx, addr2, coins, s.BankKeeper)
+		})
+	}
+}
+
+func TestParallelApps(t *testing.T) {
+	for _, d := range []time.Duration{time.Minute, time.Hour} {
+		t.Run(d.String(), func(t *testing.T) {
+			t.Parallel()
+
+			acc1 := authtypes.NewBaseAccountWithAddress(addr1)
+			s := createTestSuite(t, []authtypes.GenesisAccount{acc1})
+			ctx := s.App.StateLatestContext(t)
+
+			require.NoError(t, testutil.FundAccount(ctx, s.BankKeeper, addr1, coins))
+			blockTime := s.App.LastBlockTime().Add(d)
+			s.App.NextBlock(t, ctx, d)
+			s.App.CheckBalance(t, s.App.StateLatestContext(t), addr1, coins, s.BankKeeper)
+			require.True(t, blockTime.Equal(integration.HeaderInfoFromContext(ctx).Time))
+			require.True(t, blockTime.Equal(s.App.LastBlockTime()))
+		})
+	}
+}
+
+func TestFixture(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+	f := integration.NewFixture().
+		WithValidators(3).
+		WithFundedAccounts(5, coins).
+		Build(t, &bankKeeper, &stakingKeeper)
+
+	require.Len(t, f.Accounts, 5)
+	for _, acc := range f.Accounts {
+		f.App.CheckBalance(t, f.Ctx, acc.Address, coins, bankKeeper)
+	}
+
+	validators, err := stakingKeeper.GetAllValidators(f.Ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, 3)
+	require.Len(t, f.Validators, 3)
+
+	// the account number of each funded account follows its index
+	from, to := f.Accounts[1], f.Accounts[0]
+	sendMsg := types.NewMsgSend(from.AddressBech32, to.AddressBech32, halfCoins)
+	f.App.SignCheckDeliver(t, f.Ctx, []sdk.Msg{sendMsg}, "", []uint64{2}, []uint64{0}, []cryptotypes.PrivKey{from.PrivKey}, "")
+	f.App.CheckBalance(t, f.Ctx, to.Address, coins.Add(halfCoins...), bankKeeper)
+	f.App.CheckBalance(t, f.Ctx, from.Address, halfCoins, bankKeeper)
+}
+
+func TestDeliverTx(t *testing.T) {
+	var bankKeeper bankkeeper.Keeper
+	stake := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	f := integration.NewFixture().
+		WithFundedAccounts(2, coins.Add(stake)).
+		Build(t, &bankKeeper)
+	from, to := f.Accounts[0], f.Accounts[1]
+	sendMsg := types.NewMsgSend(from.AddressBech32, to.AddressBech32, halfCoins)
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+
+	// the ante handlers deduct the fees and meter the gas of the transaction
+	res := f.App.DeliverTx(t, f.Ctx, []sdk.Msg{sendMsg}, from.PrivKey, integration.TxParams{
+		AccountNumber: 1,
+		Fees:          sdk.NewCoins(fee),
+	})
+	require.NoError(t, res.Error)
+	require.Equal(t, uint64(integration.DefaultGenTxGas), res.GasWanted)
+	require.NotZero(t, res.GasUsed)
+	f.App.CheckBalance(t, f.Ctx, from.Address, halfCoins.Add(stake.Sub(fee)), bankKeeper)
+	f.App.CheckBalance(t, f.Ctx, to.Address, coins.Add(halfCoins...).Add(stake), bankKeeper)
+
+	// and reject transactions with a stale sequence
+	res = f.App.DeliverTx(t, f.Ctx, []sdk.Msg{sendMsg}, from.PrivKey, integration.TxParams{AccountNumber: 1})
+	require.ErrorContains(t, res.Error, "account sequence mismatch")
+
+	// or signed with another account number
+	res = f.App.DeliverTx(t, f.Ctx, []sdk.Msg{sendMsg}, from.PrivKey, integration.TxParams{AccountNumber: 2, Sequence: 1})
+	require.ErrorContains(t, res.Error, "signature verification failed")
+	f.App.CheckBalance(t, f.Ctx, from.Address, halfCoins.Add(stake.Sub(fee)), bankKeeper)
+}
@@ -0,0 +1,148 @@
+package integration
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	_ "cosmossdk.io/x/accounts"  // import as blank for app wiring
+	_ "cosmossdk.io/x/bank"      // import as blank for app wiring
+	_ "cosmossdk.io/x/consensus" // import as blank for app wiring
+	_ "cosmossdk.io/x/staking"   // import as blank for app wiring
+
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+	_ "github.com/cosmos/cosmos-sdk/x/auth"           // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config" // import as blank for app wiring
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// fixtureSeed is the seed of the funded accounts of fixtures, so that their addresses are stable across runs.
+const fixtureSeed = 1
+
+// Fixture is the app of an integration test, along with its context, funded accounts and validators.
+type Fixture struct {
+	App *App
+	Ctx context.Context
+
+	// Accounts are the funded accounts of the fixture. The account number of Accounts[i] is i+1, 0 being the one
+	// of the genesis account of DefaultStartUpConfig.
+	Accounts []simulation.Account
+	// Validators are the operator addresses of the bonded validators of the fixture.
+	Validators []sdk.ValAddress
+}
+
+// FixtureBuilder builds the Fixture of an integration test, e.g.
+//
+//	var bankKeeper bankkeeper.Keeper
+//	f := integration.NewFixture().
+//		WithValidators(3).
+//		WithFundedAccounts(5, coins).
+//		WithModules(configurator.DistributionModule()).
+//		Build(t, &bankKeeper)
+type FixtureBuilder struct {
+	validators int
+	accounts   int
+	coins      sdk.Coins
+	modules    []configurator.ModuleOption
+	depInject  []depinject.Config
+	configs    []func(cfg *StartupConfig)
+}
+
+// NewFixture returns a builder of a fixture with a single validator, no funded account, and the modules required
+// by the genesis of integration apps: accounts, auth, bank, consensus, staking, tx and validate.
+func NewFixture() *FixtureBuilder {
+	return &FixtureBuilder{
+		validators: 1,
+		modules: []configurator.ModuleOption{
+			configurator.AccountsModule(),
+			configurator.AuthModule(),
+			configurator.BankModule(),
+			configurator.StakingModule(),
+			configurator.TxModule(),
+			configurator.ValidateModule(),
+			configurator.ConsensusModule(),
+		},
+	}
+}
+
+// WithValidators sets the number of bonded validators of the fixture.
+func (b *FixtureBuilder) WithValidators(n int) *FixtureBuilder {
+	b.validators = n
+	return b
+}
+
+// WithFundedAccounts sets the number of accounts of the fixture, funded at genesis with the given coins.
+func (b *FixtureBuilder) WithFundedAccounts(n int, coins sdk.Coins) *FixtureBuilder {
+	b.accounts = n
+	b.coins = coins
+	return b
+}
+
+// WithModules adds the given modules to the app of the fixture. The modules must be imported by the test for
+// app wiring.
+func (b *FixtureBuilder) WithModules(modules ...configurator.ModuleOption) *FixtureBuilder {
+	b.modules = append(b.modules, modules...)
+	return b
+}
+
+// WithDepInjectConfigs adds the given dependency injection configs to the app of the fixture, e.g. to provide
+// account types or to supply values to the modules.
+func (b *FixtureBuilder) WithDepInjectConfigs(configs ...depinject.Config) *FixtureBuilder {
+	b.depInject = append(b.depInject, configs...)
+	return b
+}
+
+// WithStartupConfig configures the startup config of the app of the fixture, e.g. to set custom services.
+func (b *FixtureBuilder) WithStartupConfig(configure func(cfg *StartupConfig)) *FixtureBuilder {
+	b.configs = append(b.configs, configure)
+	return b
+}
+
+// Build builds the fixture. The keepers and other outputs of the app are assigned to the given outputs by the
+// dependency injector, as with NewApp.
+func (b *FixtureBuilder) Build(t *testing.T, outputs ...any) *Fixture {
+	t.Helper()
+
+	valSet, err := createValidatorSet(b.validators)
+	require.NoError(t, err)
+	validators := make([]sdk.ValAddress, len(valSet.Validators))
+	for i, val := range valSet.Validators {
+		validators[i] = sdk.ValAddress(val.Address)
+	}
+
+	accounts := simulation.RandomAccounts(rand.New(rand.NewSource(fixtureSeed)), b.accounts)
+
+	startupCfg := DefaultStartUpConfig(t)
+	startupCfg.ValidatorSet = func() (*cmttypes.ValidatorSet, error) { return valSet, nil }
+	for i, acc := range accounts {
+		startupCfg.GenesisAccounts = append(startupCfg.GenesisAccounts, GenesisAccount{
+			GenesisAccount: authtypes.NewBaseAccount(acc.Address, acc.PubKey, uint64(i+1), 0),
+			Coins:          b.coins,
+		})
+	}
+	for _, configure := range b.configs {
+		configure(&startupCfg)
+	}
+
+	configs := append([]depinject.Config{configurator.NewAppV2Config(b.modules...), depinject.Supply(log.NewNopLogger())}, b.depInject...)
+	app, err := NewApp(
+		depinject.Configs(configs...),
+		startupCfg,
+		outputs...,
+	)
+	require.NoError(t, err)
+
+	return &Fixture{
+		App:        app,
+		Ctx:        app.StateLatestContext(t),
+		Accounts:   accounts,
+		Validators: validators,
+	}
+}
@@ -104,11 +104,11 @@ func genesisStateWithValSet(
 		)
 	}
 
-	// add bonded amount to bonded pool module account
+	// add bonded amount of each validator to bonded pool module account
 	balances = append(balances, banktypes.Balance{
 		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).
 			String(),
-		Coins: sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, bondAmt)},
+		Coins: sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, bondAmt.MulRaw(int64(len(delegations))))},
 	})
 
 	// update total supply
@@ -126,16 +126,21 @@ func genesisStateWithValSet(
 
 // CreateRandomValidatorSet creates a validator set with one random validator
 func CreateRandomValidatorSet() (*cmttypes.ValidatorSet, error) {
-	privVal := mock.NewPV()
-	pubKey, err := privVal.GetPubKey()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get pub key: %w", err)
-	}
+	return createValidatorSet(1)
+}
 
-	// create validator set with single validator
-	validator := cmttypes.NewValidator(pubKey, 1)
+// createValidatorSet creates a validator set with n random validators of the same power.
+func createValidatorSet(n int) (*cmttypes.ValidatorSet, error) {
+	validators := make([]*cmttypes.Validator, n)
+	for i := range validators {
+		pubKey, err := mock.NewPV().GetPubKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pub key: %w", err)
+		}
+		validators[i] = cmttypes.NewValidator(pubKey, 1)
+	}
 
-	return cmttypes.NewValidatorSet([]*cmttypes.Validator{validator}), nil
+	return cmttypes.NewValidatorSet(validators), nil
 }
 
 type GenesisAccount struct {
@@ -48,7 +48,7 @@ func (d *dynamicConfigImpl) GetString(key string) string {
 	case "home":
 		return d.homeDir
 	case "store.app-db-backend":
-		return "goleveldb"
+		return "memdb"
 	case "server.minimum-gas-prices":
 		return "0stake"
 	default:
@@ -0,0 +1,50 @@
+package integration
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Snapshot is a snapshot of the committed state of an App, taken with Snapshot and restored with Restore.
+type Snapshot struct {
+	version   uint64
+	blockTime time.Time
+	queuedTxs []stateMachineTx
+}
+
+// Snapshot returns a snapshot of the committed state of the app, e.g. once the fixtures shared by several
+// subtests are committed. Changes of contexts which are not committed yet are not part of the snapshot.
+func (a *App) Snapshot(t *testing.T) Snapshot {
+	t.Helper()
+
+	version, err := a.Store.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, a.lastHeight, version, "the state of the app must be committed to be snapshotted")
+
+	return Snapshot{
+		version:   version,
+		blockTime: a.lastBlockTime,
+		queuedTxs: slices.Clone(a.queuedTxs),
+	}
+}
+
+// Restore restores the state of the app to the given snapshot, discarding the blocks committed since, and
+// returns a new context with the restored state, e.g. at the beginning of each subtest. Contexts created before
+// the restore must not be used anymore.
+//
+// The version of the snapshot must not be pruned by the store in the meantime: with the default store options,
+// the versions older than the 2 last ones are pruned when a multiple of 100 is committed.
+func (a *App) Restore(t *testing.T, snapshot Snapshot) context.Context {
+	t.Helper()
+
+	require.NoError(t, a.Store.LoadVersionForOverwriting(snapshot.version))
+	a.lastHeight = snapshot.version
+	a.lastBlockTime = snapshot.blockTime
+	a.queuedTxs = slices.Clone(snapshot.queuedTxs)
+
+	return a.StateLatestContext(t)
+}
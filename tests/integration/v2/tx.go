@@ -0,0 +1,106 @@
+package integration
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clitx "cosmossdk.io/client/v2/tx"
+	"cosmossdk.io/core/server"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// signerKeyName is the name of the key of the signer in the keyring of the client/v2 tx factory.
+const signerKeyName = "signer"
+
+// TxParams are the parameters of the transactions built by BuildTx.
+type TxParams struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	// Fees are the fees paid by the signer.
+	Fees sdk.Coins
+	// GasLimit is the gas limit of the transaction, DefaultGenTxGas if zero.
+	GasLimit uint64
+}
+
+// BuildTx returns a transaction of the given messages, built and signed by the given signer with the client/v2 tx
+// factory, as clients do.
+func (a *App) BuildTx(t *testing.T, msgs []sdk.Msg, signer cryptotypes.PrivKey, params TxParams) stateMachineTx {
+	t.Helper()
+
+	signingCtx := a.cdc.InterfaceRegistry().SigningContext()
+	ac := signingCtx.AddressCodec()
+	txConfig, err := clitx.NewTxConfig(clitx.ConfigOptions{
+		AddressCodec:          ac,
+		Cdc:                   a.cdc,
+		ValidatorAddressCodec: signingCtx.ValidatorAddressCodec(),
+	})
+	require.NoError(t, err)
+
+	kr := keyring.NewInMemory(a.cdc)
+	require.NoError(t, kr.ImportPrivKeyHex(signerKeyName, hex.EncodeToString(signer.Bytes()), signer.Type()))
+	autoCLIKeyring, err := keyring.NewAutoCLIKeyring(kr, ac)
+	require.NoError(t, err)
+
+	addr := signer.PubKey().Address()
+	fromAddress, err := ac.BytesToString(addr)
+	require.NoError(t, err)
+
+	gasLimit := params.GasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultGenTxGas
+	}
+	gasConfig, err := clitx.NewGasConfig(gasLimit, 1, "")
+	require.NoError(t, err)
+	feeConfig, err := clitx.NewFeeConfig(params.Fees.String(), "", "")
+	require.NoError(t, err)
+
+	// the factory is offline: it neither queries accounts nor simulates transactions
+	f, err := clitx.NewFactory(autoCLIKeyring, a.cdc, nil, txConfig, ac, nil, clitx.TxParameters{
+		ChainID: params.ChainID,
+		AccountConfig: clitx.AccountConfig{
+			AccountNumber: params.AccountNumber,
+			Sequence:      params.Sequence,
+			FromName:      signerKeyName,
+			FromAddress:   fromAddress,
+			Address:       addr,
+		},
+		GasConfig: gasConfig,
+		FeeConfig: feeConfig,
+	})
+	require.NoError(t, err)
+
+	signedTx, err := f.BuildsSignedTx(context.Background(), msgs...)
+	require.NoError(t, err)
+	bz, err := txConfig.TxEncoder()(signedTx)
+	require.NoError(t, err)
+
+	tx, err := a.txConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+	return tx
+}
+
+// DeliverTx builds the transaction of the given messages with BuildTx, delivers it in a block and commits the
+// block. Unlike RunMsg, the transaction goes through the whole pipeline of the app: its signature, fees and gas
+// are checked by the ante handlers, its messages are executed with gas metering, and the post handlers run. The
+// result of the transaction is returned, its error included.
+func (a *App) DeliverTx(
+	t *testing.T, ctx context.Context, msgs []sdk.Msg, signer cryptotypes.PrivKey, params TxParams,
+) server.TxResult {
+	t.Helper()
+
+	tx := a.BuildTx(t, msgs, signer, params)
+	blockResponse, blockState := a.Deliver(t, ctx, []stateMachineTx{tx})
+	require.Len(t, blockResponse.TxResults, 1)
+
+	_, err := a.Commit(blockState)
+	require.NoError(t, err)
+
+	return blockResponse.TxResults[0]
+}
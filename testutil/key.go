@@ -1,11 +1,16 @@
 package testutil
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
 // GenerateCoinKey generates a new key mnemonic along with its address.
@@ -78,3 +83,24 @@ func GenerateSaveCoinKey(
 	}
 	return addr, secret, nil
 }
+
+// DeterministicKeyName returns the name of the i-th key of the keyrings of NewDeterministicKeyring.
+func DeterministicKeyName(i int) string {
+	return fmt.Sprintf("acc%d", i)
+}
+
+// NewDeterministicKeyring returns an in-memory keyring holding n keys, named after DeterministicKeyName, along
+// with their accounts. The keys are the ones of the accounts generated by simtypes.RandomAccounts from a
+// rand.NewSource(seed) source, as in simsx tests, so that the names, addresses and keys of the keyring are
+// stable across runs, e.g. for golden tests.
+func NewDeterministicKeyring(cdc codec.Codec, seed int64, n int, opts ...keyring.Option) (keyring.Keyring, []simtypes.Account, error) {
+	kb := keyring.NewInMemory(cdc, opts...)
+	accs := simtypes.RandomAccounts(rand.New(rand.NewSource(seed)), n)
+	for i, acc := range accs {
+		if err := kb.ImportPrivKeyHex(DeterministicKeyName(i), hex.EncodeToString(acc.PrivKey.Bytes()), string(hd.Secp256k1Type)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return kb, accs, nil
+}
@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func TestGenerateCoinKey(t *testing.T) {
@@ -72,3 +75,45 @@ func TestGenerateSaveCoinKeyOverwriteFlag(t *testing.T) {
 
 	require.NotEqual(t, addr1, addr2)
 }
+
+func TestNewDeterministicKeyring(t *testing.T) {
+	t.Parallel()
+
+	cdc := testutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	kb, accs, err := NewDeterministicKeyring(cdc, 1, 3)
+	require.NoError(t, err)
+	require.Len(t, accs, 3)
+
+	// the keys are the ones of the simulation accounts of the same seed
+	simAccs := simtypes.RandomAccounts(rand.New(rand.NewSource(1)), 3)
+	for i, acc := range simAccs {
+		require.Equal(t, acc.Address, accs[i].Address)
+
+		k, err := kb.Key(DeterministicKeyName(i))
+		require.NoError(t, err)
+		addr, err := k.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, acc.Address, addr)
+	}
+
+	// and are stable across keyrings
+	other, _, err := NewDeterministicKeyring(cdc, 1, 3)
+	require.NoError(t, err)
+	k, err := other.Key(DeterministicKeyName(2))
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, simAccs[2].Address, addr)
+
+	msg := []byte("message")
+	sig, _, err := kb.Sign(DeterministicKeyName(0), msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	otherSig, _, err := other.Sign(DeterministicKeyName(0), msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.Equal(t, sig, otherSig)
+
+	// other seeds generate other keys
+	_, otherAccs, err := NewDeterministicKeyring(cdc, 2, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, accs[0].Address, otherAccs[0].Address)
+}
@@ -18,18 +18,21 @@ import (
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
 	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	migrationv1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
 	accountsv1 "cosmossdk.io/x/accounts/v1"
 	"cosmossdk.io/x/tx/signing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
-	PubKeyPrefix     = collections.NewPrefix(0)
-	PubKeyTypePrefix = collections.NewPrefix(1)
-	SequencePrefix   = collections.NewPrefix(2)
+	PubKeyPrefix      = collections.NewPrefix(0)
+	PubKeyTypePrefix  = collections.NewPrefix(1)
+	SequencePrefix    = collections.NewPrefix(2)
+	SessionKeysPrefix = collections.NewPrefix(3)
 )
 
 type Option func(a *Account)
@@ -42,6 +45,7 @@ func NewAccount(name string, handlerMap *signing.HandlerMap, options ...Option)
 			PubKey:           collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key_bytes", collections.BytesValue),
 			PubKeyType:       collections.NewItem(deps.SchemaBuilder, PubKeyTypePrefix, "pub_key_type", collections.StringValue),
 			Sequence:         collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			SessionKeys:      collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
 			addrCodec:        deps.AddressCodec,
 			hs:               deps.Environment.HeaderService,
 			ts:               deps.Environment.TransactionService,
@@ -65,6 +69,9 @@ type Account struct {
 
 	Sequence collections.Sequence
 
+	// SessionKeys maps the pubkeys of the session keys to their limits.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
+
 	addrCodec address.Codec
 	hs        header.Service
 	ts        transaction.Service
@@ -72,6 +79,8 @@ type Account struct {
 	supportedPubKeys map[string]pubKeyImpl
 
 	signingHandlers *signing.HandlerMap
+
+	sessionKeysEnabled bool
 }
 
 func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
@@ -103,6 +112,21 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, fmt.Errorf("unable to compute signer data: %w", err)
 	}
 
+	sessionKey, err := a.loadSessionKey(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if sessionKey != nil {
+		pubKey, err = a.decodePubKey(sessionKey.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		signerData.PubKey = &anypb.Any{
+			TypeUrl: sessionKey.PubKey.TypeUrl,
+			Value:   sessionKey.PubKey.Value,
+		}
+	}
+
 	txData, err := a.getTxData(msg)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get tx data: %w", err)
@@ -135,9 +159,25 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, errors.New("signature verification failed")
 	}
 
+	if sessionKey != nil {
+		if err := a.useSessionKey(ctx, sessionKey, msg); err != nil {
+			return nil, err
+		}
+	}
+
 	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
 }
 
+// MigrateOut allows the account to be migrated to another account type. The migration is authorized by the
+// account itself, which sends the migration message to the accounts module.
+func (a Account) MigrateOut(ctx context.Context, _ *migrationv1.MsgMigrateOut) (*migrationv1.MsgMigrateOutResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	return &migrationv1.MsgMigrateOutResponse{}, nil
+}
+
 func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 	single, ok := info.Sum.(*tx.ModeInfo_Single_)
 	if !ok {
@@ -244,27 +284,35 @@ func (a Account) loadPubKey(ctx context.Context) (PubKey, error) {
 }
 
 func (a Account) savePubKey(ctx context.Context, anyPk *codectypes.Any) error {
+	if _, err := a.decodePubKey(anyPk); err != nil {
+		return err
+	}
+
+	// save into state
+	err := a.PubKey.Set(ctx, anyPk.Value)
+	if err != nil {
+		return fmt.Errorf("unable to save pubkey: %w", err)
+	}
+	return a.PubKeyType.Set(ctx, nameFromTypeURL(anyPk.TypeUrl))
+}
+
+// decodePubKey decodes and validates a pubkey of a supported type.
+func (a Account) decodePubKey(anyPk *codectypes.Any) (PubKey, error) {
 	// check if known
 	name := nameFromTypeURL(anyPk.TypeUrl)
 	impl, exists := a.supportedPubKeys[name]
 	if !exists {
-		return fmt.Errorf("unknown pubkey type %s", name)
+		return nil, fmt.Errorf("unknown pubkey type %s", name)
 	}
 	pk, err := impl.decode(anyPk.Value)
 	if err != nil {
-		return fmt.Errorf("unable to decode pubkey: %w", err)
+		return nil, fmt.Errorf("unable to decode pubkey: %w", err)
 	}
 	err = impl.validate(pk)
 	if err != nil {
-		return fmt.Errorf("unable to validate pubkey: %w", err)
+		return nil, fmt.Errorf("unable to validate pubkey: %w", err)
 	}
-
-	// save into state
-	err = a.PubKey.Set(ctx, anyPk.Value)
-	if err != nil {
-		return fmt.Errorf("unable to save pubkey: %w", err)
-	}
-	return a.PubKeyType.Set(ctx, name)
+	return pk, nil
 }
 
 func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
@@ -336,10 +384,18 @@ func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
 func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
 	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+	accountstd.RegisterExecuteHandler(builder, a.MigrateOut)
+	if a.sessionKeysEnabled {
+		accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+		accountstd.RegisterExecuteHandler(builder, a.RemoveSessionKey)
+	}
 }
 
 func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
 	accountstd.RegisterQueryHandler(builder, a.QueryPubKey)
 	accountstd.RegisterQueryHandler(builder, a.AuthRetroCompatibility)
+	if a.sessionKeysEnabled {
+		accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+	}
 }
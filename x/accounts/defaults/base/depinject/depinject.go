@@ -1,6 +1,8 @@
 package basedepinject
 
 import (
+	"slices"
+
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/defaults/base"
@@ -18,6 +20,25 @@ func ProvideAccount(in Inputs) accountstd.DepinjectAccount {
 	return accountstd.DepinjectAccount{MakeAccount: base.NewAccount("base", in.SignHandlersMap, in.Options...)}
 }
 
+// ProvideSessionAccount provides the session account type, a base account whose session keys can
+// authenticate limited transactions without the root key.
+func ProvideSessionAccount(in Inputs) accountstd.DepinjectAccount {
+	options := append(slices.Clone(in.Options), base.WithSessionKeys())
+	return accountstd.DepinjectAccount{MakeAccount: base.NewAccount("session", in.SignHandlersMap, options...)}
+}
+
+// ProvideSpendLimitAccount provides the spend limit account type, a base account whose bank sends are
+// limited per day and per week.
+func ProvideSpendLimitAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: base.NewSpendLimitAccount("spend-limit", in.SignHandlersMap, in.Options...)}
+}
+
+// ProvideRecoveryAccount provides the recovery account type, a base account whose pubkey can be rotated
+// by a quorum of guardians.
+func ProvideRecoveryAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: base.NewRecoveryAccount("recovery", in.SignHandlersMap, in.Options...)}
+}
+
 func ProvideSecp256K1PubKey() base.Option {
 	return base.WithSecp256K1PubKey()
 }
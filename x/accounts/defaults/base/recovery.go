@@ -0,0 +1,268 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	RecoveryConfigPrefix = collections.NewPrefix(8)
+	RecoveryPrefix       = collections.NewPrefix(9)
+)
+
+// NewRecoveryAccount creates a base account whose pubkey can be rotated by a quorum of guardians, after a
+// timelock during which the account can cancel the rotation.
+func NewRecoveryAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, acc, err := NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return name, RecoveryAccount{
+			Account:        acc.(Account),
+			RecoveryConfig: collections.NewItem(deps.SchemaBuilder, RecoveryConfigPrefix, "recovery_config", codec.CollValue[v1.RecoveryConfig](deps.LegacyStateCodec)),
+			Recovery:       collections.NewItem(deps.SchemaBuilder, RecoveryPrefix, "recovery", codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+		}, nil
+	}
+}
+
+// RecoveryAccount implements a base account with social recovery: a quorum of guardians can rotate the
+// pubkey of the account, for instance when its private key is lost.
+type RecoveryAccount struct {
+	Account
+
+	// RecoveryConfig holds the guardians of the account.
+	RecoveryConfig collections.Item[v1.RecoveryConfig]
+	// Recovery holds the pending recovery, if any.
+	Recovery collections.Item[v1.Recovery]
+}
+
+func (a RecoveryAccount) Init(ctx context.Context, msg *v1.MsgInitRecovery) (*v1.MsgInitRecoveryResponse, error) {
+	if err := a.validateRecoveryConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+
+	_, err := a.Account.Init(ctx, &v1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitRecoveryResponse{}, a.RecoveryConfig.Set(ctx, msg.Config)
+}
+
+// UpdateRecoveryConfig replaces the guardians of the account. It can only be executed by the account
+// itself, while no recovery is pending.
+func (a RecoveryAccount) UpdateRecoveryConfig(ctx context.Context, msg *v1.MsgUpdateRecoveryConfig) (*v1.MsgUpdateRecoveryConfigResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	has, err := a.Recovery.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.New("cannot update the guardians while a recovery is pending")
+	}
+	if err := a.validateRecoveryConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateRecoveryConfigResponse{}, a.RecoveryConfig.Set(ctx, msg.Config)
+}
+
+// ProposeRecovery proposes a new pubkey for the account, approved by the proposing guardian.
+func (a RecoveryAccount) ProposeRecovery(ctx context.Context, msg *v1.MsgProposeRecovery) (*v1.MsgProposeRecoveryResponse, error) {
+	guardian, config, err := a.checkGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	has, err := a.Recovery.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.New("a recovery is already pending")
+	}
+	if msg.NewPubKey == nil {
+		return nil, errors.New("new pubkey must be set")
+	}
+	if _, err := a.decodePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	recovery := v1.Recovery{NewPubKey: msg.NewPubKey}
+	return &v1.MsgProposeRecoveryResponse{}, a.approveRecovery(ctx, config, recovery, guardian)
+}
+
+// ApproveRecovery approves the pending recovery. Once the threshold of approvals is reached, the recovery
+// can be executed after the delay of the guardians config.
+func (a RecoveryAccount) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	guardian, config, err := a.checkGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recovery, err := a.Recovery.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errors.New("no recovery is pending")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if msg.NewPubKey == nil || msg.NewPubKey.TypeUrl != recovery.NewPubKey.TypeUrl || !bytes.Equal(msg.NewPubKey.Value, recovery.NewPubKey.Value) {
+		return nil, errors.New("new pubkey does not match the pending recovery")
+	}
+	if slices.Contains(recovery.Approvals, guardian) {
+		return nil, fmt.Errorf("guardian %s already approved the recovery", guardian)
+	}
+
+	return &v1.MsgApproveRecoveryResponse{}, a.approveRecovery(ctx, config, recovery, guardian)
+}
+
+// approveRecovery adds the approval of the guardian to the recovery, and starts its timelock once the
+// threshold is reached.
+func (a RecoveryAccount) approveRecovery(ctx context.Context, config v1.RecoveryConfig, recovery v1.Recovery, guardian string) error {
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	if recovery.ExecutableAfter == nil && len(recovery.Approvals) >= int(config.Threshold) {
+		executableAfter := a.hs.HeaderInfo(ctx).Time.Add(config.Delay)
+		recovery.ExecutableAfter = &executableAfter
+	}
+
+	return a.Recovery.Set(ctx, recovery)
+}
+
+// ExecuteRecovery rotates the pubkey of the account to the one of the pending recovery, once its timelock
+// is over. It can be executed by any guardian.
+func (a RecoveryAccount) ExecuteRecovery(ctx context.Context, _ *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	if _, _, err := a.checkGuardian(ctx); err != nil {
+		return nil, err
+	}
+	recovery, err := a.Recovery.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errors.New("no recovery is pending")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if recovery.ExecutableAfter == nil {
+		return nil, errors.New("recovery has not reached the threshold of approvals")
+	}
+	if a.hs.HeaderInfo(ctx).Time.Before(*recovery.ExecutableAfter) {
+		return nil, fmt.Errorf("recovery cannot be executed before %s", recovery.ExecutableAfter)
+	}
+
+	err = a.savePubKey(ctx, recovery.NewPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteRecoveryResponse{}, a.Recovery.Remove(ctx)
+}
+
+// CancelRecovery cancels the pending recovery. It can only be executed by the account itself, so with the
+// current pubkey, before the recovery is executed.
+func (a RecoveryAccount) CancelRecovery(ctx context.Context, _ *v1.MsgCancelRecovery) (*v1.MsgCancelRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	has, err := a.Recovery.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("no recovery is pending")
+	}
+
+	return &v1.MsgCancelRecoveryResponse{}, a.Recovery.Remove(ctx)
+}
+
+// QueryRecovery returns the guardians and the pending recovery of the account.
+func (a RecoveryAccount) QueryRecovery(ctx context.Context, _ *v1.QueryRecovery) (*v1.QueryRecoveryResponse, error) {
+	config, err := a.RecoveryConfig.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.QueryRecoveryResponse{Config: config}
+
+	recovery, err := a.Recovery.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		resp.Recovery = &recovery
+	}
+
+	return resp, nil
+}
+
+// checkGuardian checks that the sender is a guardian of the account, and returns its address with the
+// guardians config.
+func (a RecoveryAccount) checkGuardian(ctx context.Context) (string, v1.RecoveryConfig, error) {
+	config, err := a.RecoveryConfig.Get(ctx)
+	if err != nil {
+		return "", v1.RecoveryConfig{}, err
+	}
+	sender, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return "", v1.RecoveryConfig{}, err
+	}
+	if !slices.Contains(config.Guardians, sender) {
+		return "", v1.RecoveryConfig{}, errors.New("unauthorized: only the guardians are allowed to call this")
+	}
+	return sender, config, nil
+}
+
+func (a RecoveryAccount) validateRecoveryConfig(ctx context.Context, config v1.RecoveryConfig) error {
+	if len(config.Guardians) == 0 {
+		return errors.New("guardians must be set")
+	}
+	seen := make(map[string]struct{}, len(config.Guardians))
+	for _, guardian := range config.Guardians {
+		addr, err := a.addrCodec.StringToBytes(guardian)
+		if err != nil {
+			return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+		}
+		if bytes.Equal(addr, accountstd.Whoami(ctx)) {
+			return errors.New("the account cannot be its own guardian")
+		}
+		if _, ok := seen[guardian]; ok {
+			return fmt.Errorf("duplicate guardian %s", guardian)
+		}
+		seen[guardian] = struct{}{}
+	}
+	if config.Threshold == 0 || int(config.Threshold) > len(config.Guardians) {
+		return fmt.Errorf("threshold must be between 1 and the number of guardians %d", len(config.Guardians))
+	}
+	if config.Delay <= 0 {
+		return errors.New("delay must be positive")
+	}
+	return nil
+}
+
+func (a RecoveryAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a RecoveryAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateRecoveryConfig)
+	accountstd.RegisterExecuteHandler(builder, a.ProposeRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecovery)
+}
+
+func (a RecoveryAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecovery)
+}
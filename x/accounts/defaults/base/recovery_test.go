@@ -0,0 +1,106 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func TestRecoveryAccount(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	deps := makeMockDependencies(ss)
+	deps.Environment.HeaderService = timeHeaderService{now: &now}
+	_, acc, err := NewRecoveryAccount("recovery", signing.NewHandlerMap(directHandler{}), WithPubKeyWithValidationFunc(func(pt *secp256k1.PubKey) error {
+		_, err := dcrd_secp256k1.ParsePubKey(pt.Key)
+		return err
+	}))(deps)
+	require.NoError(t, err)
+	recoveryAcc := acc.(RecoveryAccount)
+
+	config := v1.RecoveryConfig{
+		Guardians: []string{"guardian1", "guardian2", "guardian3"},
+		Threshold: 2,
+		Delay:     24 * time.Hour,
+	}
+	_, err = recoveryAcc.Init(ctx, &v1.MsgInitRecovery{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: v1.RecoveryConfig{Guardians: []string{"guardian1", "guardian1"}, Threshold: 1, Delay: time.Hour},
+	})
+	require.ErrorContains(t, err, "duplicate guardian guardian1")
+	_, err = recoveryAcc.Init(ctx, &v1.MsgInitRecovery{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: v1.RecoveryConfig{Guardians: []string{"guardian1"}, Threshold: 2, Delay: time.Hour},
+	})
+	require.ErrorContains(t, err, "threshold must be between 1 and the number of guardians 1")
+	_, err = recoveryAcc.Init(ctx, &v1.MsgInitRecovery{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: config,
+	})
+	require.NoError(t, err)
+
+	guardian1Ctx := accountstd.SetSender(ctx, []byte("guardian1"))
+	guardian2Ctx := accountstd.SetSender(ctx, []byte("guardian2"))
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+	newPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+
+	// only the guardians can propose a recovery
+	_, err = recoveryAcc.ProposeRecovery(ctx, &v1.MsgProposeRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "unauthorized")
+
+	_, err = recoveryAcc.ProposeRecovery(guardian1Ctx, &v1.MsgProposeRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	_, err = recoveryAcc.ProposeRecovery(guardian2Ctx, &v1.MsgProposeRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "a recovery is already pending")
+	_, err = recoveryAcc.ApproveRecovery(guardian1Ctx, &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "guardian guardian1 already approved the recovery")
+	_, err = recoveryAcc.ExecuteRecovery(guardian1Ctx, &v1.MsgExecuteRecovery{})
+	require.ErrorContains(t, err, "recovery has not reached the threshold of approvals")
+
+	// the account can cancel the recovery
+	_, err = recoveryAcc.CancelRecovery(guardian1Ctx, &v1.MsgCancelRecovery{})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = recoveryAcc.CancelRecovery(selfCtx, &v1.MsgCancelRecovery{})
+	require.NoError(t, err)
+	resp, err := recoveryAcc.QueryRecovery(ctx, &v1.QueryRecovery{})
+	require.NoError(t, err)
+	require.Nil(t, resp.Recovery)
+
+	_, err = recoveryAcc.ProposeRecovery(guardian1Ctx, &v1.MsgProposeRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	_, err = recoveryAcc.ApproveRecovery(guardian2Ctx, &v1.MsgApproveRecovery{NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.ErrorContains(t, err, "new pubkey does not match the pending recovery")
+	_, err = recoveryAcc.ApproveRecovery(guardian2Ctx, &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+
+	resp, err = recoveryAcc.QueryRecovery(ctx, &v1.QueryRecovery{})
+	require.NoError(t, err)
+	require.Equal(t, config, resp.Config)
+	require.Equal(t, []string{"guardian1", "guardian2"}, resp.Recovery.Approvals)
+	require.Equal(t, now.Add(24*time.Hour), *resp.Recovery.ExecutableAfter)
+
+	// the recovery is timelocked, and the guardians cannot be updated meanwhile
+	_, err = recoveryAcc.ExecuteRecovery(guardian1Ctx, &v1.MsgExecuteRecovery{})
+	require.ErrorContains(t, err, "recovery cannot be executed before")
+	_, err = recoveryAcc.UpdateRecoveryConfig(selfCtx, &v1.MsgUpdateRecoveryConfig{Config: config})
+	require.ErrorContains(t, err, "cannot update the guardians while a recovery is pending")
+
+	now = now.Add(24 * time.Hour)
+	_, err = recoveryAcc.ExecuteRecovery(guardian1Ctx, &v1.MsgExecuteRecovery{})
+	require.NoError(t, err)
+
+	pubKeyResp, err := recoveryAcc.QueryPubKey(ctx, &v1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, newPubKey.Value, pubKeyResp.PubKey.Value)
+	resp, err = recoveryAcc.QueryRecovery(ctx, &v1.QueryRecovery{})
+	require.NoError(t, err)
+	require.Nil(t, resp.Recovery)
+}
@@ -0,0 +1,191 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// bankMsgSendTypeURL is the type url of the bank sends counted in the spend limit of the session keys.
+const bankMsgSendTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+// WithSessionKeys enables the session keys of the account, which can authenticate limited transactions
+// without the root key.
+func WithSessionKeys() Option {
+	return func(a *Account) {
+		a.sessionKeysEnabled = true
+	}
+}
+
+// AddSessionKey adds a session key to the account, or replaces it. It can only be executed by the
+// account itself, so with the root key or an allowed session key.
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	sessionKey := msg.SessionKey
+	if sessionKey.PubKey == nil {
+		return nil, errors.New("session key pubkey must be set")
+	}
+	if _, err := a.decodePubKey(sessionKey.PubKey); err != nil {
+		return nil, err
+	}
+	rootPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(rootPubKey, sessionKey.PubKey.Value) {
+		return nil, errors.New("session key cannot be the root key of the account")
+	}
+	if !sessionKey.Expiration.After(a.hs.HeaderInfo(ctx).Time) {
+		return nil, errors.New("session key expiration must be in the future")
+	}
+	if len(sessionKey.AllowedMessages) == 0 {
+		return nil, errors.New("session key allowed messages must be set")
+	}
+	if err := sessionKey.SpendLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid session key spend limit: %w", err)
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, sessionKey.PubKey.Value, sessionKey)
+}
+
+// RemoveSessionKey removes a session key from the account. It can only be executed by the account itself.
+func (a Account) RemoveSessionKey(ctx context.Context, msg *v1.MsgRemoveSessionKey) (*v1.MsgRemoveSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	if msg.PubKey == nil {
+		return nil, errors.New("session key pubkey must be set")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey.Value)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("session key not found")
+	}
+
+	return &v1.MsgRemoveSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey.Value)
+}
+
+// QuerySessionKeys returns the session keys of the account, including the expired ones.
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	resp := &v1.QuerySessionKeysResponse{}
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, sessionKey v1.SessionKey) (bool, error) {
+		resp.SessionKeys = append(resp.SessionKeys, sessionKey)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// loadSessionKey returns the session key of the signer of the transaction, if the transaction is not signed
+// with the root key and the session keys are enabled.
+func (a Account) loadSessionKey(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*v1.SessionKey, error) {
+	signerPubKey := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].PublicKey
+	if !a.sessionKeysEnabled || signerPubKey == nil {
+		return nil, nil
+	}
+
+	rootPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(rootPubKey, signerPubKey.Value) {
+		return nil, nil
+	}
+
+	sessionKey, err := a.SessionKeys.Get(ctx, signerPubKey.Value)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errors.New("signer pubkey is neither the root key nor a session key of the account")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &sessionKey, nil
+}
+
+// useSessionKey checks that the transaction authenticated by the session key is within its limits, and
+// deducts its spending from the spend limit.
+func (a Account) useSessionKey(ctx context.Context, sessionKey *v1.SessionKey, msg *aa_interface_v1.MsgAuthenticate) error {
+	if !a.hs.HeaderInfo(ctx).Time.Before(sessionKey.Expiration) {
+		return errors.New("session key is expired")
+	}
+
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	spent := sdk.NewCoins()
+	for _, anyMsg := range msg.Tx.Body.Messages {
+		if !slices.Contains(sessionKey.AllowedMessages, anyMsg.TypeUrl) {
+			return fmt.Errorf("message %s is not allowed for the session key", anyMsg.TypeUrl)
+		}
+		sent, err := bankSentCoins(addrStr, anyMsg)
+		if err != nil {
+			return err
+		}
+		spent = spent.Add(sent...)
+	}
+
+	// the fees are paid by the fee payer, which defaults to the first signer
+	fee := msg.Tx.AuthInfo.Fee
+	if fee != nil && (fee.Payer == addrStr || (fee.Payer == "" && msg.SignerIndex == 0)) {
+		spent = spent.Add(fee.Amount...)
+	}
+
+	if !sessionKey.SpendLimit.IsAllGTE(spent) {
+		return fmt.Errorf("session key spend limit %s is smaller than %s", sessionKey.SpendLimit, spent)
+	}
+	sessionKey.SpendLimit = sessionKey.SpendLimit.Sub(spent...)
+
+	return a.SessionKeys.Set(ctx, sessionKey.PubKey.Value, *sessionKey)
+}
+
+// bankSentCoins returns the coins sent from the given address by the message, if it is a bank send.
+func bankSentCoins(fromAddress string, anyMsg *codectypes.Any) (sdk.Coins, error) {
+	if anyMsg.TypeUrl != bankMsgSendTypeURL {
+		return nil, nil
+	}
+
+	msgSend := &bankv1beta1.MsgSend{}
+	if err := proto.Unmarshal(anyMsg.Value, msgSend); err != nil {
+		return nil, err
+	}
+	if msgSend.FromAddress != fromAddress {
+		return nil, nil
+	}
+
+	sent := sdk.NewCoins()
+	for _, coin := range msgSend.Amount {
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %s", coin.Amount)
+		}
+		sent = sent.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return sent, nil
+}
@@ -0,0 +1,168 @@
+package base
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+type timeHeaderService struct {
+	now *time.Time
+}
+
+func (h timeHeaderService) HeaderInfo(context.Context) header.Info {
+	return header.Info{
+		ChainID: "test",
+		Time:    *h.now,
+	}
+}
+
+func signSessionTx(t *testing.T, privKey *secp256k1.PrivKey, seq uint64, fee sdk.Coins, msgs ...*codectypes.Any) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	transaction := tx.Tx{
+		Body: &tx.TxBody{Messages: msgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: toAnyPb(t, privKey.PubKey()),
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: seq,
+				},
+			},
+			Fee: &tx.Fee{Amount: fee},
+		},
+	}
+
+	bodyByte, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authByte, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+	txDoc := tx.SignDoc{
+		BodyBytes:     bodyByte,
+		AuthInfoBytes: authByte,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}
+	signBytes, err := txDoc.Marshal()
+	require.NoError(t, err)
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sig}
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	}
+}
+
+func bankSendAny(t *testing.T, from string, amount int64) *codectypes.Any {
+	t.Helper()
+	bz, err := proto.Marshal(&bankv1beta1.MsgSend{
+		FromAddress: from,
+		ToAddress:   "receiver",
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: strconv.FormatInt(amount, 10)}},
+	})
+	require.NoError(t, err)
+	return &codectypes.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: bz}
+}
+
+func TestSessionKeys(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	now := time.Now()
+	deps := makeMockDependencies(ss)
+	deps.Environment.HeaderService = timeHeaderService{now: &now}
+	_, acc, err := NewAccount("session", signing.NewHandlerMap(directHandler{}), WithSessionKeys(), WithPubKeyWithValidationFunc(func(pt *secp256k1.PubKey) error {
+		_, err := dcrd_secp256k1.ParsePubKey(pt.Key)
+		return err
+	}))(deps)
+	require.NoError(t, err)
+	sessionAcc := acc.(Account)
+
+	rootKey := secp256k1.GenPrivKey()
+	_, err = sessionAcc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, rootKey.PubKey())})
+	require.NoError(t, err)
+
+	sessionPrivKey := secp256k1.GenPrivKey()
+	sessionKey := v1.SessionKey{
+		PubKey:          toAnyPb(t, sessionPrivKey.PubKey()),
+		Expiration:      now.Add(time.Hour),
+		AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend"},
+		SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+
+	// only the account itself can add session keys
+	_, err = sessionAcc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.ErrorContains(t, err, "unauthorized")
+
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+	_, err = sessionAcc.AddSessionKey(selfCtx, &v1.MsgAddSessionKey{SessionKey: v1.SessionKey{
+		PubKey:          toAnyPb(t, rootKey.PubKey()),
+		Expiration:      now.Add(time.Hour),
+		AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend"},
+	}})
+	require.ErrorContains(t, err, "session key cannot be the root key of the account")
+
+	_, err = sessionAcc.AddSessionKey(selfCtx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.NoError(t, err)
+
+	authCtx := accountstd.SetSender(ctx, address.Module("accounts"))
+
+	// the fees and the bank sends are deducted from the spend limit
+	_, err = sessionAcc.Authenticate(authCtx, signSessionTx(t, sessionPrivKey, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), bankSendAny(t, "mock_base_account", 60)))
+	require.NoError(t, err)
+
+	resp, err := sessionAcc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, resp.SessionKeys, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), resp.SessionKeys[0].SpendLimit)
+
+	_, err = sessionAcc.Authenticate(authCtx, signSessionTx(t, sessionPrivKey, 1, nil, bankSendAny(t, "mock_base_account", 40)))
+	require.ErrorContains(t, err, "session key spend limit 30stake is smaller than 40stake")
+
+	// only the allowed messages can be authenticated
+	_, err = sessionAcc.Authenticate(authCtx, signSessionTx(t, sessionPrivKey, 2, nil, &codectypes.Any{TypeUrl: "/cosmos.staking.v1beta1.MsgDelegate"}))
+	require.ErrorContains(t, err, "message /cosmos.staking.v1beta1.MsgDelegate is not allowed for the session key")
+
+	// the root key is not limited
+	_, err = sessionAcc.Authenticate(authCtx, signSessionTx(t, rootKey, 3, nil, bankSendAny(t, "mock_base_account", 1000)))
+	require.NoError(t, err)
+
+	now = now.Add(time.Hour)
+	_, err = sessionAcc.Authenticate(authCtx, signSessionTx(t, sessionPrivKey, 4, nil, bankSendAny(t, "mock_base_account", 1)))
+	require.ErrorContains(t, err, "session key is expired")
+
+	_, err = sessionAcc.RemoveSessionKey(selfCtx, &v1.MsgRemoveSessionKey{PubKey: sessionKey.PubKey})
+	require.NoError(t, err)
+	_, err = sessionAcc.Authenticate(authCtx, signSessionTx(t, sessionPrivKey, 5, nil, bankSendAny(t, "mock_base_account", 1)))
+	require.ErrorContains(t, err, "signer pubkey is neither the root key nor a session key of the account")
+}
@@ -0,0 +1,285 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	OwnerPrefix          = collections.NewPrefix(4)
+	SpendLimitsPrefix    = collections.NewPrefix(5)
+	DailySpendingPrefix  = collections.NewPrefix(6)
+	WeeklySpendingPrefix = collections.NewPrefix(7)
+)
+
+const (
+	secondsPerDay  = 24 * 60 * 60
+	secondsPerWeek = 7 * secondsPerDay
+)
+
+// NewSpendLimitAccount creates a base account whose bank sends are limited per day and per week, and
+// whose owner can update the limits and send coins without them.
+func NewSpendLimitAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, acc, err := NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return name, SpendLimitAccount{
+			Account:        acc.(Account),
+			Owner:          collections.NewItem(deps.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+			SpendLimits:    collections.NewItem(deps.SchemaBuilder, SpendLimitsPrefix, "spend_limits", codec.CollValue[v1.SpendLimits](deps.LegacyStateCodec)),
+			DailySpending:  collections.NewItem(deps.SchemaBuilder, DailySpendingPrefix, "daily_spending", codec.CollValue[v1.Spending](deps.LegacyStateCodec)),
+			WeeklySpending: collections.NewItem(deps.SchemaBuilder, WeeklySpendingPrefix, "weekly_spending", codec.CollValue[v1.Spending](deps.LegacyStateCodec)),
+		}, nil
+	}
+}
+
+// SpendLimitAccount implements a base account enforcing daily and weekly spend limits per denom on the
+// bank sends of its transactions.
+type SpendLimitAccount struct {
+	Account
+
+	// Owner is the address which can update the spend limits and send coins without limits.
+	Owner       collections.Item[[]byte]
+	SpendLimits collections.Item[v1.SpendLimits]
+
+	// DailySpending and WeeklySpending are the amounts sent during the last day and week in which the
+	// account sent coins.
+	DailySpending  collections.Item[v1.Spending]
+	WeeklySpending collections.Item[v1.Spending]
+}
+
+func (a SpendLimitAccount) Init(ctx context.Context, msg *v1.MsgInitSpendLimit) (*v1.MsgInitSpendLimitResponse, error) {
+	owner, err := a.addrCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner address: %w", err)
+	}
+	if bytes.Equal(owner, accountstd.Whoami(ctx)) {
+		return nil, errors.New("owner cannot be the account itself")
+	}
+	if err := validateSpendLimits(msg.SpendLimits); err != nil {
+		return nil, err
+	}
+
+	_, err = a.Account.Init(ctx, &v1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence})
+	if err != nil {
+		return nil, err
+	}
+	err = a.Owner.Set(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitSpendLimitResponse{}, a.SpendLimits.Set(ctx, msg.SpendLimits)
+}
+
+// UpdateSpendLimits replaces the spend limits of the account. It can only be executed by the owner. The
+// amounts already sent during the current day and week are kept.
+func (a SpendLimitAccount) UpdateSpendLimits(ctx context.Context, msg *v1.MsgUpdateSpendLimits) (*v1.MsgUpdateSpendLimitsResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+	if err := validateSpendLimits(msg.SpendLimits); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateSpendLimitsResponse{}, a.SpendLimits.Set(ctx, msg.SpendLimits)
+}
+
+// OwnerSend sends coins from the account without the spend limits. It can only be executed by the owner,
+// and the coins sent are not counted in the spendings of the account.
+func (a SpendLimitAccount) OwnerSend(ctx context.Context, msg *v1.MsgOwnerSend) (*v1.MsgOwnerSendResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+	if msg.Amount.IsZero() {
+		return nil, errors.New("amount must be positive")
+	}
+
+	fromAddress, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	asAny, err := accountstd.PackAny(&banktypes.MsgSend{
+		FromAddress: fromAddress,
+		ToAddress:   msg.ToAddress,
+		Amount:      msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := accountstd.ExecModuleAnys(ctx, []*codectypes.Any{asAny})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgOwnerSendResponse{Responses: resp}, nil
+}
+
+// Authenticate implements the authentication flow of a spend limit account. The transaction is
+// authenticated as for a base account, then its bank sends from the account are counted in the daily
+// and weekly spendings, which must stay within the spend limits.
+func (a SpendLimitAccount) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	resp, err := a.Account.Authenticate(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAddress, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	sent := sdk.NewCoins()
+	for _, anyMsg := range msg.Tx.Body.Messages {
+		msgSent, err := bankSentCoins(fromAddress, anyMsg)
+		if err != nil {
+			return nil, err
+		}
+		sent = sent.Add(msgSent...)
+	}
+	if sent.IsZero() {
+		return resp, nil
+	}
+
+	limits, err := a.SpendLimits.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := a.hs.HeaderInfo(ctx).Time.Unix()
+	err = a.spend(ctx, a.DailySpending, now/secondsPerDay, limits.DailyLimit, sent, "daily")
+	if err != nil {
+		return nil, err
+	}
+	err = a.spend(ctx, a.WeeklySpending, now/secondsPerWeek, limits.WeeklyLimit, sent, "weekly")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// spend adds the sent coins to the spending of the given period, and checks it against the limit.
+func (a SpendLimitAccount) spend(ctx context.Context, item collections.Item[v1.Spending], period int64, limit, sent sdk.Coins, name string) error {
+	spending, err := a.currentSpending(ctx, item, period)
+	if err != nil {
+		return err
+	}
+
+	spending.Amount = spending.Amount.Add(sent...)
+	for _, coin := range limit {
+		if spending.Amount.AmountOf(coin.Denom).GT(coin.Amount) {
+			return fmt.Errorf("%s spend limit of %s exceeded: %s%s spent", name, coin, spending.Amount.AmountOf(coin.Denom), coin.Denom)
+		}
+	}
+
+	return item.Set(ctx, spending)
+}
+
+// currentSpending returns the spending of the given period, which is empty if the account did not send
+// coins during the period yet.
+func (a SpendLimitAccount) currentSpending(ctx context.Context, item collections.Item[v1.Spending], period int64) (v1.Spending, error) {
+	spending, err := item.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return v1.Spending{}, err
+	}
+	if err != nil || spending.Period != period {
+		return v1.Spending{Period: period, Amount: sdk.NewCoins()}, nil
+	}
+
+	return spending, nil
+}
+
+// QuerySpendLimits returns the owner and the spend limits of the account, and the amounts sent during the
+// current day and week.
+func (a SpendLimitAccount) QuerySpendLimits(ctx context.Context, _ *v1.QuerySpendLimits) (*v1.QuerySpendLimitsResponse, error) {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerStr, err := a.addrCodec.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := a.SpendLimits.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := a.hs.HeaderInfo(ctx).Time.Unix()
+	daily, err := a.currentSpending(ctx, a.DailySpending, now/secondsPerDay)
+	if err != nil {
+		return nil, err
+	}
+	weekly, err := a.currentSpending(ctx, a.WeeklySpending, now/secondsPerWeek)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QuerySpendLimitsResponse{
+		Owner:       ownerStr,
+		SpendLimits: limits,
+		DailySpent:  daily.Amount,
+		WeeklySpent: weekly.Amount,
+	}, nil
+}
+
+func (a SpendLimitAccount) checkOwner(ctx context.Context) error {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !accountstd.HasSender(ctx, owner) {
+		return errors.New("unauthorized: only the owner is allowed to call this")
+	}
+	return nil
+}
+
+func validateSpendLimits(limits v1.SpendLimits) error {
+	if err := limits.DailyLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid daily limit: %w", err)
+	}
+	if err := limits.WeeklyLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid weekly limit: %w", err)
+	}
+	return nil
+}
+
+func (a SpendLimitAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+// RegisterExecuteHandlers registers the handlers of the base account, except the migration to another
+// account type, which would lift the spend limits without the owner.
+func (a SpendLimitAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+	if a.sessionKeysEnabled {
+		accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+		accountstd.RegisterExecuteHandler(builder, a.RemoveSessionKey)
+	}
+	accountstd.RegisterExecuteHandler(builder, a.UpdateSpendLimits)
+	accountstd.RegisterExecuteHandler(builder, a.OwnerSend)
+}
+
+func (a SpendLimitAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QuerySpendLimits)
+}
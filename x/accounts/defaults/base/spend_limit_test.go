@@ -0,0 +1,111 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestSpendLimitAccount(t *testing.T) {
+	var sent []*banktypes.MsgSend
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("mock_base_account"), []byte("sender"), nil,
+		func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			sent = append(sent, msg.(*banktypes.MsgSend))
+			return &banktypes.MsgSendResponse{}, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			return &accountsv1.AccountNumberResponse{
+				Number: 1,
+			}, nil
+		},
+	)
+	// a sunday, the weeks are counted from a thursday
+	now := time.Date(2024, 1, 7, 12, 0, 0, 0, time.UTC)
+	deps := makeMockDependencies(ss)
+	deps.Environment.HeaderService = timeHeaderService{now: &now}
+	_, acc, err := NewSpendLimitAccount("spend-limit", signing.NewHandlerMap(directHandler{}), WithPubKeyWithValidationFunc(func(pt *secp256k1.PubKey) error {
+		_, err := dcrd_secp256k1.ParsePubKey(pt.Key)
+		return err
+	}))(deps)
+	require.NoError(t, err)
+	spendLimitAcc := acc.(SpendLimitAccount)
+
+	privKey := secp256k1.GenPrivKey()
+	limits := v1.SpendLimits{
+		DailyLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		WeeklyLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 150)),
+	}
+	_, err = spendLimitAcc.Init(ctx, &v1.MsgInitSpendLimit{
+		PubKey:      toAnyPb(t, privKey.PubKey()),
+		Owner:       "owner",
+		SpendLimits: limits,
+	})
+	require.NoError(t, err)
+
+	authCtx := accountstd.SetSender(ctx, address.Module("accounts"))
+	seq := uint64(0)
+	authenticate := func(msgs ...*codectypes.Any) error {
+		defer func() { seq++ }()
+		_, err := spendLimitAcc.Authenticate(authCtx, signSessionTx(t, privKey, seq, nil, msgs...))
+		return err
+	}
+
+	require.NoError(t, authenticate(bankSendAny(t, "mock_base_account", 60)))
+	// the fees and the sends of other accounts are not limited
+	require.NoError(t, authenticate(bankSendAny(t, "other_account", 1000)))
+	require.ErrorContains(t, authenticate(bankSendAny(t, "mock_base_account", 30), bankSendAny(t, "mock_base_account", 20)), "daily spend limit of 100stake exceeded: 110stake spent")
+	require.NoError(t, authenticate(bankSendAny(t, "mock_base_account", 40)))
+
+	resp, err := spendLimitAcc.QuerySpendLimits(ctx, &v1.QuerySpendLimits{})
+	require.NoError(t, err)
+	require.Equal(t, "owner", resp.Owner)
+	require.Equal(t, limits, resp.SpendLimits)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), resp.DailySpent)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), resp.WeeklySpent)
+
+	// the next day, the daily spending is reset but not the weekly one
+	now = now.Add(24 * time.Hour)
+	require.ErrorContains(t, authenticate(bankSendAny(t, "mock_base_account", 60)), "weekly spend limit of 150stake exceeded: 160stake spent")
+	require.NoError(t, authenticate(bankSendAny(t, "mock_base_account", 50)))
+
+	// only the owner can update the limits and send without them
+	_, err = spendLimitAcc.UpdateSpendLimits(ctx, &v1.MsgUpdateSpendLimits{SpendLimits: v1.SpendLimits{}})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = spendLimitAcc.OwnerSend(ctx, &v1.MsgOwnerSend{ToAddress: "receiver", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 500))})
+	require.ErrorContains(t, err, "unauthorized")
+
+	ownerCtx := accountstd.SetSender(ctx, []byte("owner"))
+	_, err = spendLimitAcc.OwnerSend(ownerCtx, &v1.MsgOwnerSend{ToAddress: "receiver", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 500))})
+	require.NoError(t, err)
+	require.Equal(t, []*banktypes.MsgSend{{
+		FromAddress: "mock_base_account",
+		ToAddress:   "receiver",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+	}}, sent)
+
+	_, err = spendLimitAcc.UpdateSpendLimits(ownerCtx, &v1.MsgUpdateSpendLimits{SpendLimits: v1.SpendLimits{
+		DailyLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}})
+	require.NoError(t, err)
+	require.NoError(t, authenticate(bankSendAny(t, "mock_base_account", 500)))
+
+	resp, err = spendLimitAcc.QuerySpendLimits(ctx, &v1.QuerySpendLimits{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 550)), resp.DailySpent)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 650)), resp.WeeklySpent)
+}
@@ -5,17 +5,24 @@ package v1
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -366,6 +373,331 @@ func (m *QueryPubKeyResponse) GetPubKey() *any.Any {
 	return nil
 }
 
+// SessionKey defines an ephemeral key which can authenticate limited transactions on behalf of the account,
+// without the root key.
+type SessionKey struct {
+	// pub_key defines the pubkey of the session key arbitrary encapsulated.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// expiration defines the time from which the session key can no longer authenticate transactions.
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// allowed_messages defines the type urls of the messages that the transactions of the session key can
+	// contain.
+	AllowedMessages []string `protobuf:"bytes,3,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// spend_limit defines the funds that the transactions of the session key can still spend, in fees and
+	// bank sends. It is decreased by each transaction.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *SessionKey) Reset()         { *m = SessionKey{} }
+func (m *SessionKey) String() string { return proto.CompactTextString(m) }
+func (*SessionKey) ProtoMessage()    {}
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c860870b5ed6dc2, []int{8}
+}
+func (m *SessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKey.Merge(m, src)
+}
+func (m *SessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKey proto.InternalMessageInfo
+
+func (m *SessionKey) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SessionKey) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+func (m *SessionKey) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
+func (m *SessionKey) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// MsgAddSessionKey is used to add a session key to the account, or to replace it.
+type MsgAddSessionKey struct {
+	SessionKey SessionKey `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key"`
+}
+
+func (m *MsgAddSessionKey) Reset()         { *m = MsgAddSessionKey{} }
+func (m *MsgAddSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSessionKey) ProtoMessage()    {}
+func (*MsgAddSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c860870b5ed6dc2, []int{9}
+}
+func (m *MsgAddSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSessionKey.Merge(m, src)
+}
+func (m *MsgAddSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSessionKey proto.InternalMessageInfo
+
+func (m *MsgAddSessionKey) GetSessionKey() SessionKey {
+	if m != nil {
+		return m.SessionKey
+	}
+	return SessionKey{}
+}
+
+// MsgAddSessionKeyResponse is the response for the MsgAddSessionKey message.
+// This is empty.
+type MsgAddSessionKeyResponse struct {
+}
+
+func (m *MsgAddSessionKeyResponse) Reset()         { *m = MsgAddSessionKeyResponse{} }
+func (m *MsgAddSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSessionKeyResponse) ProtoMessage()    {}
+func (*MsgAddSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c860870b5ed6dc2, []int{10}
+}
+func (m *MsgAddSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSessionKeyResponse.Merge(m, src)
+}
+func (m *MsgAddSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSessionKeyResponse proto.InternalMessageInfo
+
+// MsgRemoveSessionKey is used to remove a session key from the account.
+type MsgRemoveSessionKey struct {
+	// pub_key defines the pubkey of the session key to remove.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *MsgRemoveSessionKey) Reset()         { *m = MsgRemoveSessionKey{} }
+func (m *MsgRemoveSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSessionKey) ProtoMessage()    {}
+func (*MsgRemoveSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c860870b5ed6dc2, []int{11}
+}
+func (m *MsgRemoveSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSessionKey.Merge(m, src)
+}
+func (m *MsgRemoveSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSessionKey proto.InternalMessageInfo
+
+func (m *MsgRemoveSessionKey) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// MsgRemoveSessionKeyResponse is the response for the MsgRemoveSessionKey message.
+// This is empty.
+type MsgRemoveSessionKeyResponse struct {
+}
+
+func (m *MsgRemoveSessionKeyResponse) Reset()         { *m = MsgRemoveSessionKeyResponse{} }
+func (m *MsgRemoveSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSessionKeyResponse) ProtoMessage()    {}
+func (*MsgRemoveSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c860870b5ed6dc2, []int{12}
+}
+func (m *MsgRemoveSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSessionKeyResponse.Merge(m, src)
+}
+func (m *MsgRemoveSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSessionKeyResponse proto.InternalMessageInfo
+
+// QuerySessionKeys is the request used to query the session keys of an account.
+type QuerySessionKeys struct {
+}
+
+func (m *QuerySessionKeys) Reset()         { *m = QuerySessionKeys{} }
+func (m *QuerySessionKeys) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeys) ProtoMessage()    {}
+func (*QuerySessionKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c860870b5ed6dc2, []int{13}
+}
+func (m *QuerySessionKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeys.Merge(m, src)
+}
+func (m *QuerySessionKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeys proto.InternalMessageInfo
+
+// QuerySessionKeysResponse is the response returned when a QuerySessionKeys message is sent.
+type QuerySessionKeysResponse struct {
+	SessionKeys []SessionKey `protobuf:"bytes,1,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys"`
+}
+
+func (m *QuerySessionKeysResponse) Reset()         { *m = QuerySessionKeysResponse{} }
+func (m *QuerySessionKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeysResponse) ProtoMessage()    {}
+func (*QuerySessionKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c860870b5ed6dc2, []int{14}
+}
+func (m *QuerySessionKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeysResponse.Merge(m, src)
+}
+func (m *QuerySessionKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeysResponse proto.InternalMessageInfo
+
+func (m *QuerySessionKeysResponse) GetSessionKeys() []SessionKey {
+	if m != nil {
+		return m.SessionKeys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgInit)(nil), "cosmos.accounts.defaults.base.v1.MsgInit")
 	proto.RegisterType((*MsgInitResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgInitResponse")
@@ -375,6 +707,13 @@ func init() {
 	proto.RegisterType((*QuerySequenceResponse)(nil), "cosmos.accounts.defaults.base.v1.QuerySequenceResponse")
 	proto.RegisterType((*QueryPubKey)(nil), "cosmos.accounts.defaults.base.v1.QueryPubKey")
 	proto.RegisterType((*QueryPubKeyResponse)(nil), "cosmos.accounts.defaults.base.v1.QueryPubKeyResponse")
+	proto.RegisterType((*SessionKey)(nil), "cosmos.accounts.defaults.base.v1.SessionKey")
+	proto.RegisterType((*MsgAddSessionKey)(nil), "cosmos.accounts.defaults.base.v1.MsgAddSessionKey")
+	proto.RegisterType((*MsgAddSessionKeyResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgAddSessionKeyResponse")
+	proto.RegisterType((*MsgRemoveSessionKey)(nil), "cosmos.accounts.defaults.base.v1.MsgRemoveSessionKey")
+	proto.RegisterType((*MsgRemoveSessionKeyResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgRemoveSessionKeyResponse")
+	proto.RegisterType((*QuerySessionKeys)(nil), "cosmos.accounts.defaults.base.v1.QuerySessionKeys")
+	proto.RegisterType((*QuerySessionKeysResponse)(nil), "cosmos.accounts.defaults.base.v1.QuerySessionKeysResponse")
 }
 
 func init() {
@@ -382,28 +721,45 @@ func init() {
 }
 
 var fileDescriptor_7c860870b5ed6dc2 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x10, 0xc7, 0xbb, 0x1f, 0x1f, 0xad, 0x4e, 0x0d, 0xc5, 0x68, 0xb1, 0xf6, 0x10, 0x4a, 0xbc, 0x14,
-	0xc4, 0x5d, 0x6a, 0x7d, 0x01, 0x8b, 0x1e, 0x44, 0x0a, 0xda, 0xde, 0x04, 0x29, 0x49, 0x3a, 0x0d,
-	0xa1, 0x75, 0x37, 0x76, 0xb3, 0xad, 0x79, 0x0b, 0x1f, 0xcb, 0x63, 0x8f, 0x1e, 0xa5, 0x7d, 0x11,
-	0x71, 0x37, 0x09, 0xf6, 0x20, 0xe8, 0x69, 0x99, 0xe1, 0xf7, 0xff, 0xcd, 0xb0, 0x03, 0xa7, 0x81,
-	0x90, 0x4f, 0x42, 0x32, 0x2f, 0x08, 0x84, 0xe2, 0x89, 0x64, 0x63, 0x9c, 0x78, 0x6a, 0x96, 0x48,
-	0xe6, 0x7b, 0x12, 0xd9, 0xa2, 0xa3, 0x5f, 0x1a, 0xcf, 0x45, 0x22, 0xec, 0x96, 0x81, 0x69, 0x0e,
-	0xd3, 0x1c, 0xa6, 0x1a, 0x5a, 0x74, 0x9a, 0xc7, 0xa1, 0x10, 0xe1, 0x0c, 0x99, 0xe6, 0x7d, 0x35,
-	0x61, 0x1e, 0x4f, 0x4d, 0xd8, 0x7d, 0x84, 0x4a, 0x5f, 0x86, 0x37, 0x3c, 0x4a, 0xec, 0x33, 0xa8,
-	0xc4, 0xca, 0x1f, 0x4d, 0x31, 0x6d, 0x90, 0x16, 0x69, 0x57, 0xcf, 0x0f, 0xa9, 0xc9, 0xd1, 0x3c,
-	0x47, 0x2f, 0x79, 0x3a, 0x28, 0xc7, 0xca, 0xbf, 0xc5, 0xd4, 0x3e, 0x01, 0x2b, 0xe2, 0x51, 0x32,
-	0x92, 0xf8, 0xac, 0x90, 0x07, 0xd8, 0xf8, 0xd7, 0x22, 0xed, 0xff, 0x83, 0xbd, 0xaf, 0xe6, 0x30,
-	0xeb, 0xb9, 0xfb, 0x50, 0xcb, 0xf4, 0x03, 0x94, 0xb1, 0xe0, 0x12, 0xdd, 0x6b, 0xb0, 0xfa, 0x32,
-	0x1c, 0x2e, 0xbd, 0xf8, 0xce, 0x88, 0x2e, 0xa0, 0xca, 0x71, 0x39, 0xfa, 0xcd, 0xec, 0x5d, 0x8e,
-	0x4b, 0x93, 0x72, 0x8f, 0xa0, 0xbe, 0xa5, 0x29, 0xfc, 0x35, 0xb0, 0xee, 0x15, 0xce, 0xd3, 0x62,
-	0x87, 0x2e, 0xd4, 0xb7, 0x1a, 0x39, 0x69, 0x37, 0x61, 0xa7, 0x58, 0x9e, 0xe8, 0xe5, 0x8b, 0xda,
-	0xb5, 0xa0, 0xaa, 0x43, 0xd9, 0xb4, 0x2b, 0x38, 0xf8, 0x56, 0x16, 0x86, 0xbf, 0x7d, 0x59, 0xaf,
-	0xf7, 0xb6, 0x76, 0xc8, 0x6a, 0xed, 0x90, 0x8f, 0xb5, 0x43, 0x5e, 0x37, 0x4e, 0x69, 0xb5, 0x71,
-	0x4a, 0xef, 0x1b, 0xa7, 0xf4, 0xd0, 0x36, 0x37, 0x94, 0xe3, 0x29, 0x8d, 0x04, 0x7b, 0xf9, 0xf9,
-	0xf0, 0x7e, 0x59, 0x9b, 0xbb, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30, 0xaf, 0x2e, 0x20, 0x23,
-	0x02, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0x09, 0xe2, 0xe7, 0x9a, 0x08, 0x3e, 0x03, 0xfa, 0x4c, 0xaa, 0x3a, 0x91, 0xbb, 0x49,
+	0xd5, 0x32, 0x2e, 0xd0, 0x17, 0x20, 0xa5, 0x8b, 0xaa, 0x8d, 0xd4, 0x3a, 0xed, 0xa6, 0x52, 0x15,
+	0xd9, 0xf1, 0xc5, 0x1d, 0x11, 0xcf, 0x18, 0xee, 0x98, 0x90, 0xb7, 0x60, 0xdd, 0x47, 0xe8, 0x93,
+	0xb0, 0x64, 0xd9, 0x55, 0xa9, 0xe0, 0x45, 0xaa, 0xd8, 0x13, 0x13, 0x68, 0xab, 0x16, 0x56, 0x33,
+	0x73, 0xe6, 0xde, 0x73, 0xee, 0x9c, 0x9c, 0x18, 0x9e, 0xf4, 0x25, 0x25, 0x92, 0xbc, 0xa0, 0xdf,
+	0x97, 0x99, 0x50, 0xe4, 0x45, 0xb8, 0x1f, 0x64, 0x03, 0x45, 0x5e, 0x18, 0x10, 0x7a, 0xc7, 0x5b,
+	0xf9, 0xca, 0xd2, 0x23, 0xa9, 0xa4, 0xd5, 0x2c, 0x8a, 0xd9, 0xa4, 0x98, 0x4d, 0x8a, 0x59, 0x5e,
+	0x74, 0xbc, 0x55, 0xdf, 0x88, 0xa5, 0x8c, 0x07, 0xe8, 0xe5, 0xf5, 0x61, 0xb6, 0xef, 0x05, 0x62,
+	0x54, 0x34, 0xd7, 0x1b, 0xb7, 0xaf, 0x14, 0x4f, 0x90, 0x54, 0x90, 0xa4, 0xba, 0x60, 0x2d, 0x96,
+	0xb1, 0xcc, 0xb7, 0xde, 0x78, 0xa7, 0x51, 0x47, 0x0f, 0xa8, 0xc7, 0x09, 0x51, 0x05, 0x5b, 0x5e,
+	0x5f, 0x72, 0x51, 0xdc, 0xbb, 0x9f, 0x60, 0xbe, 0x43, 0xf1, 0x2b, 0xc1, 0x95, 0xb5, 0x09, 0xf3,
+	0x69, 0x16, 0xf6, 0x0e, 0x70, 0x64, 0x1b, 0x4d, 0xa3, 0x65, 0x6e, 0xaf, 0xb1, 0x42, 0x93, 0x4d,
+	0x34, 0xd9, 0xae, 0x18, 0xf9, 0x73, 0x69, 0x16, 0xbe, 0xc6, 0x91, 0xf5, 0x08, 0x6a, 0x5c, 0x70,
+	0xd5, 0x23, 0x3c, 0xcc, 0x50, 0xf4, 0xd1, 0x9e, 0x69, 0x1a, 0xad, 0x59, 0x7f, 0x69, 0x0c, 0x76,
+	0x35, 0xe6, 0xfe, 0x07, 0xcb, 0x9a, 0xde, 0x47, 0x4a, 0xa5, 0x20, 0x74, 0x5f, 0x42, 0xad, 0x43,
+	0x71, 0x77, 0x18, 0xa4, 0x6f, 0x0b, 0xa2, 0xe7, 0x60, 0x0a, 0x1c, 0xf6, 0xfe, 0x45, 0x7b, 0x51,
+	0xe0, 0xb0, 0xe8, 0x72, 0xff, 0x87, 0xf5, 0x1b, 0x34, 0x25, 0xff, 0x32, 0xd4, 0xde, 0x65, 0x78,
+	0x34, 0x2a, 0x67, 0xd8, 0x81, 0xf5, 0x1b, 0xc0, 0xa4, 0xd2, 0xaa, 0xc3, 0x42, 0x39, 0xbc, 0x91,
+	0x0f, 0x5f, 0x9e, 0xdd, 0x1a, 0x98, 0x79, 0x93, 0x56, 0xdb, 0x83, 0xd5, 0xa9, 0x63, 0xc9, 0x70,
+	0x37, 0xcb, 0xdc, 0x2f, 0x33, 0x00, 0x5d, 0x24, 0xe2, 0x52, 0x8c, 0x1f, 0x7e, 0x47, 0xc3, 0xf7,
+	0x00, 0xf0, 0x24, 0xe5, 0x47, 0x81, 0xe2, 0x52, 0xe4, 0x6e, 0x9b, 0xdb, 0xf5, 0x5f, 0x3a, 0xde,
+	0x4f, 0x62, 0xd1, 0x5e, 0x38, 0xfb, 0xde, 0xa8, 0x9c, 0x5e, 0x34, 0x0c, 0x7f, 0xaa, 0xcf, 0x7a,
+	0x0c, 0x2b, 0xc1, 0x60, 0x20, 0x87, 0x18, 0xf5, 0x12, 0x24, 0x0a, 0x62, 0x24, 0xbb, 0xda, 0xac,
+	0xb6, 0x16, 0xfd, 0x65, 0x8d, 0x77, 0x34, 0x6c, 0x0d, 0xc0, 0xa4, 0x14, 0x45, 0xd4, 0x1b, 0xf0,
+	0x84, 0x2b, 0x7b, 0xb6, 0x59, 0x6d, 0x99, 0xdb, 0x1b, 0x4c, 0xa7, 0x58, 0x67, 0x36, 0x4f, 0x14,
+	0x7b, 0x21, 0xb9, 0x68, 0x3f, 0x1b, 0x0b, 0x7e, 0xbd, 0x68, 0xb4, 0x62, 0xae, 0x3e, 0x67, 0x21,
+	0xeb, 0xcb, 0xc4, 0xd3, 0xf1, 0x2b, 0x96, 0x4d, 0x8a, 0x0e, 0x3c, 0x35, 0x4a, 0x91, 0xf2, 0x06,
+	0xf2, 0x21, 0xe7, 0x7f, 0x33, 0xa6, 0x77, 0x63, 0x58, 0xe9, 0x50, 0xbc, 0x1b, 0x45, 0x53, 0x0e,
+	0x75, 0xc1, 0xa4, 0xe2, 0x34, 0xe5, 0xd2, 0x53, 0xf6, 0xb7, 0xff, 0x11, 0xbb, 0xa6, 0x68, 0xcf,
+	0x8e, 0x87, 0xf2, 0x81, 0x4a, 0xc4, 0xad, 0x83, 0x7d, 0x5b, 0xa8, 0x0c, 0xcf, 0x1e, 0xac, 0x76,
+	0x28, 0xf6, 0x31, 0x91, 0xc7, 0x78, 0xef, 0x5f, 0xca, 0x7d, 0x08, 0x0f, 0x7e, 0xc3, 0x52, 0x8a,
+	0x58, 0xb0, 0xa2, 0x03, 0x39, 0xb9, 0x22, 0xf7, 0x10, 0xec, 0xdb, 0x58, 0x99, 0xb2, 0x0f, 0xb0,
+	0x34, 0xe5, 0x02, 0xd9, 0x46, 0xb3, 0x7a, 0x4f, 0x1b, 0xcc, 0x6b, 0x1b, 0xa8, 0xdd, 0x3e, 0xbb,
+	0x74, 0x8c, 0xf3, 0x4b, 0xc7, 0xf8, 0x71, 0xe9, 0x18, 0xa7, 0x57, 0x4e, 0xe5, 0xfc, 0xca, 0xa9,
+	0x7c, 0xbb, 0x72, 0x2a, 0x1f, 0x5b, 0x05, 0x33, 0x45, 0x07, 0x8c, 0x4b, 0xef, 0xe4, 0xcf, 0x5f,
+	0xb7, 0x70, 0x2e, 0x7f, 0xff, 0xce, 0xcf, 0x01, 0x00, 0x40, 0x9a, 0x3d, 0x1b, 0x08, 0x05, 0x00,
+	0x00,
 }
 
 func (m *MsgInit) Marshal() (dAtA []byte, err error) {
@@ -636,49 +992,289 @@ func (m *QueryPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBase(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBase(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovBase(uint64(l))
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBase(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.InitSequence != 0 {
-		n += 1 + sovBase(uint64(m.InitSequence))
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintBase(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintBase(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBase(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSwapPubKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewPubKey != nil {
+	{
+		size, err := m.SessionKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBase(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBase(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySessionKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySessionKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for iNdEx := len(m.SessionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBase(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBase(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBase(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovBase(uint64(l))
+	}
+	if m.InitSequence != 0 {
+		n += 1 + sovBase(uint64(m.InitSequence))
+	}
+	return n
+}
+
+func (m *MsgInitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewPubKey != nil {
 		l = m.NewPubKey.Size()
 		n += 1 + l + sovBase(uint64(l))
 	}
@@ -734,16 +1330,569 @@ func (m *QueryPubKeyResponse) Size() (n int) {
 		l = m.PubKey.Size()
 		n += 1 + l + sovBase(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *SessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovBase(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovBase(uint64(l))
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovBase(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovBase(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SessionKey.Size()
+	n += 1 + l + sovBase(uint64(l))
+	return n
+}
+
+func (m *MsgAddSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovBase(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySessionKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySessionKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for _, e := range m.SessionKeys {
+			l = e.Size()
+			n += 1 + l + sovBase(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBase(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBase(x uint64) (n int) {
+	return sovBase(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgInit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitSequence", wireType)
+			}
+			m.InitSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &any.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovBase(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBase(x uint64) (n int) {
-	return sovBase(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgInit) Unmarshal(dAtA []byte) error {
+func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -766,10 +1915,10 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInit: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPubKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -808,25 +1957,6 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitSequence", wireType)
-			}
-			m.InitSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBase
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBase(dAtA[iNdEx:])
@@ -848,7 +1978,7 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
+func (m *SessionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -871,12 +2001,147 @@ func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBase
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBase(dAtA[iNdEx:])
@@ -898,7 +2163,7 @@ func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapPubKey) Unmarshal(dAtA []byte) error {
+func (m *MsgAddSessionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -921,15 +2186,15 @@ func (m *MsgSwapPubKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapPubKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddSessionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -956,10 +2221,7 @@ func (m *MsgSwapPubKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewPubKey == nil {
-				m.NewPubKey = &any.Any{}
-			}
-			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SessionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -984,7 +2246,7 @@ func (m *MsgSwapPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapPubKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddSessionKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,10 +2269,10 @@ func (m *MsgSwapPubKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapPubKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddSessionKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1034,7 +2296,7 @@ func (m *MsgSwapPubKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySequence) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSessionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1057,12 +2319,48 @@ func (m *QuerySequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSessionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBase(dAtA[iNdEx:])
@@ -1084,7 +2382,7 @@ func (m *QuerySequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSessionKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1107,31 +2405,12 @@ func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSessionKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBase
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBase(dAtA[iNdEx:])
@@ -1153,7 +2432,7 @@ func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPubKey) Unmarshal(dAtA []byte) error {
+func (m *QuerySessionKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1176,10 +2455,10 @@ func (m *QueryPubKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPubKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySessionKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySessionKeys: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1203,7 +2482,7 @@ func (m *QueryPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySessionKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1226,15 +2505,15 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPubKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySessionKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySessionKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1261,10 +2540,8 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubKey == nil {
-				m.PubKey = &any.Any{}
-			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SessionKeys = append(m.SessionKeys, SessionKey{})
+			if err := m.SessionKeys[len(m.SessionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
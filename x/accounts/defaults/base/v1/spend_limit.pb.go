@@ -0,0 +1,2273 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/defaults/base/v1/spend_limit.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SpendLimits defines the maximum amounts per denom that a spend limit account can send per day and per week.
+// The denoms without a limit are not limited.
+type SpendLimits struct {
+	// daily_limit defines the maximum amounts that can be sent during a UTC day.
+	DailyLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=daily_limit,json=dailyLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_limit"`
+	// weekly_limit defines the maximum amounts that can be sent during a week, counted in periods of seven
+	// days since the unix epoch.
+	WeeklyLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=weekly_limit,json=weeklyLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"weekly_limit"`
+}
+
+func (m *SpendLimits) Reset()         { *m = SpendLimits{} }
+func (m *SpendLimits) String() string { return proto.CompactTextString(m) }
+func (*SpendLimits) ProtoMessage()    {}
+func (*SpendLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{0}
+}
+func (m *SpendLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimits.Merge(m, src)
+}
+func (m *SpendLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimits proto.InternalMessageInfo
+
+func (m *SpendLimits) GetDailyLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailyLimit
+	}
+	return nil
+}
+
+func (m *SpendLimits) GetWeeklyLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WeeklyLimit
+	}
+	return nil
+}
+
+// Spending defines the amounts sent by a spend limit account during a period.
+type Spending struct {
+	// period defines the index of the period since the unix epoch.
+	Period int64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// amount defines the amounts sent during the period.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Spending) Reset()         { *m = Spending{} }
+func (m *Spending) String() string { return proto.CompactTextString(m) }
+func (*Spending) ProtoMessage()    {}
+func (*Spending) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{1}
+}
+func (m *Spending) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Spending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Spending.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Spending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spending.Merge(m, src)
+}
+func (m *Spending) XXX_Size() int {
+	return m.Size()
+}
+func (m *Spending) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spending.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Spending proto.InternalMessageInfo
+
+func (m *Spending) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *Spending) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgInitSpendLimit is used to initialize a spend limit account.
+type MsgInitSpendLimit struct {
+	// pub_key defines a pubkey for the account arbitrary encapsulated.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// init_sequence defines the initial sequence of the account.
+	// Defaults to zero if not set.
+	InitSequence uint64 `protobuf:"varint,2,opt,name=init_sequence,json=initSequence,proto3" json:"init_sequence,omitempty"`
+	// owner defines the address which can update the spend limits and send coins without limits.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spend_limits defines the initial spend limits of the account.
+	SpendLimits SpendLimits `protobuf:"bytes,4,opt,name=spend_limits,json=spendLimits,proto3" json:"spend_limits"`
+}
+
+func (m *MsgInitSpendLimit) Reset()         { *m = MsgInitSpendLimit{} }
+func (m *MsgInitSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgInitSpendLimit) ProtoMessage()    {}
+func (*MsgInitSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{2}
+}
+func (m *MsgInitSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitSpendLimit.Merge(m, src)
+}
+func (m *MsgInitSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitSpendLimit proto.InternalMessageInfo
+
+func (m *MsgInitSpendLimit) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *MsgInitSpendLimit) GetInitSequence() uint64 {
+	if m != nil {
+		return m.InitSequence
+	}
+	return 0
+}
+
+func (m *MsgInitSpendLimit) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgInitSpendLimit) GetSpendLimits() SpendLimits {
+	if m != nil {
+		return m.SpendLimits
+	}
+	return SpendLimits{}
+}
+
+// MsgInitSpendLimitResponse is the response returned after spend limit account initialization.
+// This is empty.
+type MsgInitSpendLimitResponse struct {
+}
+
+func (m *MsgInitSpendLimitResponse) Reset()         { *m = MsgInitSpendLimitResponse{} }
+func (m *MsgInitSpendLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitSpendLimitResponse) ProtoMessage()    {}
+func (*MsgInitSpendLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{3}
+}
+func (m *MsgInitSpendLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitSpendLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitSpendLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitSpendLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitSpendLimitResponse.Merge(m, src)
+}
+func (m *MsgInitSpendLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitSpendLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitSpendLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitSpendLimitResponse proto.InternalMessageInfo
+
+// MsgUpdateSpendLimits is used by the owner to update the spend limits of the account.
+type MsgUpdateSpendLimits struct {
+	SpendLimits SpendLimits `protobuf:"bytes,1,opt,name=spend_limits,json=spendLimits,proto3" json:"spend_limits"`
+}
+
+func (m *MsgUpdateSpendLimits) Reset()         { *m = MsgUpdateSpendLimits{} }
+func (m *MsgUpdateSpendLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSpendLimits) ProtoMessage()    {}
+func (*MsgUpdateSpendLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{4}
+}
+func (m *MsgUpdateSpendLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSpendLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSpendLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSpendLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSpendLimits.Merge(m, src)
+}
+func (m *MsgUpdateSpendLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSpendLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSpendLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSpendLimits proto.InternalMessageInfo
+
+func (m *MsgUpdateSpendLimits) GetSpendLimits() SpendLimits {
+	if m != nil {
+		return m.SpendLimits
+	}
+	return SpendLimits{}
+}
+
+// MsgUpdateSpendLimitsResponse is the response for the MsgUpdateSpendLimits message.
+// This is empty.
+type MsgUpdateSpendLimitsResponse struct {
+}
+
+func (m *MsgUpdateSpendLimitsResponse) Reset()         { *m = MsgUpdateSpendLimitsResponse{} }
+func (m *MsgUpdateSpendLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSpendLimitsResponse) ProtoMessage()    {}
+func (*MsgUpdateSpendLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{5}
+}
+func (m *MsgUpdateSpendLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSpendLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSpendLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSpendLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSpendLimitsResponse.Merge(m, src)
+}
+func (m *MsgUpdateSpendLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSpendLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSpendLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSpendLimitsResponse proto.InternalMessageInfo
+
+// MsgOwnerSend is used by the owner to send coins from the account without the spend limits.
+type MsgOwnerSend struct {
+	ToAddress string                                   `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgOwnerSend) Reset()         { *m = MsgOwnerSend{} }
+func (m *MsgOwnerSend) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnerSend) ProtoMessage()    {}
+func (*MsgOwnerSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{6}
+}
+func (m *MsgOwnerSend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnerSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnerSend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOwnerSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnerSend.Merge(m, src)
+}
+func (m *MsgOwnerSend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnerSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnerSend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOwnerSend proto.InternalMessageInfo
+
+func (m *MsgOwnerSend) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgOwnerSend) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgOwnerSendResponse is the response for the MsgOwnerSend message.
+type MsgOwnerSendResponse struct {
+	Responses []*any.Any `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (m *MsgOwnerSendResponse) Reset()         { *m = MsgOwnerSendResponse{} }
+func (m *MsgOwnerSendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnerSendResponse) ProtoMessage()    {}
+func (*MsgOwnerSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{7}
+}
+func (m *MsgOwnerSendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnerSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnerSendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOwnerSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnerSendResponse.Merge(m, src)
+}
+func (m *MsgOwnerSendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnerSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnerSendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOwnerSendResponse proto.InternalMessageInfo
+
+func (m *MsgOwnerSendResponse) GetResponses() []*any.Any {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+// QuerySpendLimits is the request used to query the spend limits of an account.
+type QuerySpendLimits struct {
+}
+
+func (m *QuerySpendLimits) Reset()         { *m = QuerySpendLimits{} }
+func (m *QuerySpendLimits) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendLimits) ProtoMessage()    {}
+func (*QuerySpendLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{8}
+}
+func (m *QuerySpendLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendLimits.Merge(m, src)
+}
+func (m *QuerySpendLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendLimits proto.InternalMessageInfo
+
+// QuerySpendLimitsResponse is the response returned when a QuerySpendLimits message is sent.
+type QuerySpendLimitsResponse struct {
+	// owner is the address which can update the spend limits and send coins without limits.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spend_limits are the spend limits of the account.
+	SpendLimits SpendLimits `protobuf:"bytes,2,opt,name=spend_limits,json=spendLimits,proto3" json:"spend_limits"`
+	// daily_spent are the amounts sent during the current day.
+	DailySpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=daily_spent,json=dailySpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_spent"`
+	// weekly_spent are the amounts sent during the current week.
+	WeeklySpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=weekly_spent,json=weeklySpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"weekly_spent"`
+}
+
+func (m *QuerySpendLimitsResponse) Reset()         { *m = QuerySpendLimitsResponse{} }
+func (m *QuerySpendLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendLimitsResponse) ProtoMessage()    {}
+func (*QuerySpendLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a7ae13eb8015a1d, []int{9}
+}
+func (m *QuerySpendLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendLimitsResponse.Merge(m, src)
+}
+func (m *QuerySpendLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendLimitsResponse proto.InternalMessageInfo
+
+func (m *QuerySpendLimitsResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QuerySpendLimitsResponse) GetSpendLimits() SpendLimits {
+	if m != nil {
+		return m.SpendLimits
+	}
+	return SpendLimits{}
+}
+
+func (m *QuerySpendLimitsResponse) GetDailySpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailySpent
+	}
+	return nil
+}
+
+func (m *QuerySpendLimitsResponse) GetWeeklySpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WeeklySpent
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SpendLimits)(nil), "cosmos.accounts.defaults.base.v1.SpendLimits")
+	proto.RegisterType((*Spending)(nil), "cosmos.accounts.defaults.base.v1.Spending")
+	proto.RegisterType((*MsgInitSpendLimit)(nil), "cosmos.accounts.defaults.base.v1.MsgInitSpendLimit")
+	proto.RegisterType((*MsgInitSpendLimitResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgInitSpendLimitResponse")
+	proto.RegisterType((*MsgUpdateSpendLimits)(nil), "cosmos.accounts.defaults.base.v1.MsgUpdateSpendLimits")
+	proto.RegisterType((*MsgUpdateSpendLimitsResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgUpdateSpendLimitsResponse")
+	proto.RegisterType((*MsgOwnerSend)(nil), "cosmos.accounts.defaults.base.v1.MsgOwnerSend")
+	proto.RegisterType((*MsgOwnerSendResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgOwnerSendResponse")
+	proto.RegisterType((*QuerySpendLimits)(nil), "cosmos.accounts.defaults.base.v1.QuerySpendLimits")
+	proto.RegisterType((*QuerySpendLimitsResponse)(nil), "cosmos.accounts.defaults.base.v1.QuerySpendLimitsResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/defaults/base/v1/spend_limit.proto", fileDescriptor_8a7ae13eb8015a1d)
+}
+
+var fileDescriptor_8a7ae13eb8015a1d = []byte{
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x3d, 0x6f, 0x13, 0x31,
+	0x18, 0xc7, 0xe3, 0x26, 0x04, 0xea, 0x0b, 0x12, 0x58, 0x11, 0xba, 0x14, 0x74, 0x8d, 0x8e, 0xe5,
+	0x96, 0xf8, 0x68, 0x18, 0x98, 0x1b, 0x26, 0x5e, 0x2a, 0xc4, 0x45, 0x30, 0xb0, 0x44, 0xf7, 0xe2,
+	0x1e, 0x56, 0x12, 0xfb, 0x38, 0xfb, 0x5a, 0x6e, 0x63, 0x63, 0xe5, 0x73, 0x20, 0x46, 0x3e, 0x44,
+	0xc7, 0x8a, 0x89, 0x09, 0x50, 0x33, 0x32, 0xf0, 0x15, 0xd0, 0xd9, 0xce, 0x8b, 0x4a, 0x51, 0x25,
+	0x94, 0x30, 0xc5, 0x79, 0xec, 0xe7, 0xf9, 0xff, 0x9e, 0xc7, 0xff, 0x38, 0xb0, 0x1f, 0x73, 0x31,
+	0xe5, 0xc2, 0x0f, 0xe3, 0x98, 0x17, 0x4c, 0x0a, 0x3f, 0x21, 0x87, 0x61, 0x31, 0x91, 0xc2, 0x8f,
+	0x42, 0x41, 0xfc, 0xa3, 0x3d, 0x5f, 0x64, 0x84, 0x25, 0xa3, 0x09, 0x9d, 0x52, 0x89, 0xb3, 0x9c,
+	0x4b, 0x8e, 0xba, 0x3a, 0x07, 0xcf, 0x73, 0xf0, 0x3c, 0x07, 0x57, 0x39, 0xf8, 0x68, 0x6f, 0xa7,
+	0x93, 0x72, 0x9e, 0x4e, 0x88, 0xaf, 0xce, 0x47, 0xc5, 0xa1, 0x1f, 0xb2, 0x52, 0x27, 0xef, 0xb4,
+	0x53, 0x9e, 0x72, 0xb5, 0xf4, 0xab, 0x95, 0x89, 0x76, 0x74, 0xc9, 0x91, 0xde, 0x30, 0xf5, 0xf5,
+	0x96, 0x63, 0x08, 0x0d, 0x4f, 0x44, 0x64, 0xb8, 0xe7, 0xc7, 0x9c, 0x32, 0xbd, 0xef, 0xfe, 0x04,
+	0xd0, 0x1a, 0x56, 0x8c, 0x4f, 0x2b, 0x44, 0x81, 0x26, 0xd0, 0x4a, 0x42, 0x3a, 0x29, 0x35, 0xb2,
+	0x0d, 0xba, 0x75, 0xcf, 0xea, 0x77, 0xb0, 0xa9, 0x69, 0x08, 0x55, 0x15, 0xfc, 0x90, 0x53, 0x36,
+	0xb8, 0x77, 0xf2, 0x6d, 0xb7, 0xf6, 0xf1, 0xfb, 0xae, 0x97, 0x52, 0xf9, 0xba, 0x88, 0x70, 0xcc,
+	0xa7, 0x06, 0xc0, 0x7c, 0xf4, 0x44, 0x32, 0xf6, 0x65, 0x99, 0x11, 0xa1, 0x12, 0x44, 0x00, 0x55,
+	0x7d, 0x25, 0x87, 0x18, 0x6c, 0x1d, 0x13, 0x32, 0x5e, 0xc8, 0x6d, 0xad, 0x5f, 0xce, 0xd2, 0x02,
+	0x4a, 0xcf, 0x7d, 0x0f, 0xe0, 0x35, 0xd5, 0x2d, 0x65, 0x29, 0xba, 0x05, 0x9b, 0x19, 0xc9, 0x29,
+	0x4f, 0x6c, 0xd0, 0x05, 0x5e, 0x3d, 0x30, 0xdf, 0x50, 0x0c, 0x9b, 0xe1, 0xb4, 0xba, 0x9a, 0x4d,
+	0xe0, 0x98, 0xd2, 0xee, 0x2f, 0x00, 0x6f, 0x1e, 0x88, 0xf4, 0x11, 0xa3, 0x72, 0x39, 0x7e, 0xd4,
+	0x83, 0x57, 0xb3, 0x22, 0x1a, 0x8d, 0x49, 0xa9, 0x98, 0xac, 0x7e, 0x1b, 0x6b, 0x2f, 0xe0, 0xb9,
+	0x17, 0xf0, 0x3e, 0x2b, 0x83, 0x66, 0x56, 0x44, 0x4f, 0x48, 0x89, 0xee, 0xc2, 0xeb, 0x94, 0x51,
+	0x39, 0x12, 0xe4, 0x4d, 0x41, 0x58, 0x4c, 0xec, 0xad, 0x2e, 0xf0, 0x1a, 0x41, 0xab, 0x0a, 0x0e,
+	0x4d, 0x0c, 0x61, 0x78, 0x85, 0x1f, 0x33, 0x92, 0xdb, 0xf5, 0x2e, 0xf0, 0xb6, 0x07, 0xf6, 0x97,
+	0xcf, 0xbd, 0xb6, 0x69, 0x68, 0x3f, 0x49, 0x72, 0x22, 0xc4, 0x50, 0xe6, 0x94, 0xa5, 0x81, 0x3e,
+	0x86, 0x5e, 0xc2, 0xd6, 0x8a, 0x69, 0x85, 0xdd, 0x50, 0x20, 0x3d, 0x7c, 0x99, 0x6d, 0xf1, 0xb2,
+	0x0f, 0x31, 0x68, 0x54, 0x83, 0x09, 0x2c, 0xb1, 0x0c, 0xb9, 0xb7, 0x61, 0xe7, 0x8f, 0x86, 0x03,
+	0x22, 0x32, 0xce, 0x04, 0x71, 0x19, 0x6c, 0x1f, 0x88, 0xf4, 0x45, 0x96, 0x84, 0x92, 0xac, 0xda,
+	0xf1, 0x3c, 0x0c, 0x58, 0x13, 0x8c, 0x03, 0xef, 0x5c, 0xa4, 0xb7, 0xe0, 0xf9, 0x04, 0x60, 0xeb,
+	0x40, 0xa4, 0xcf, 0xaa, 0x89, 0x0c, 0x09, 0x4b, 0xd0, 0x03, 0x08, 0x25, 0x1f, 0x85, 0x7a, 0x60,
+	0x36, 0xb8, 0x64, 0x94, 0xdb, 0x92, 0x9b, 0xc0, 0xff, 0x71, 0xd3, 0x63, 0xd8, 0x5e, 0xa5, 0x9d,
+	0xb7, 0x81, 0xfa, 0x70, 0x3b, 0x37, 0x6b, 0x61, 0x7e, 0xcb, 0x17, 0x3b, 0x6a, 0x79, 0xcc, 0x45,
+	0xf0, 0xc6, 0xf3, 0x82, 0xe4, 0xe5, 0xca, 0x58, 0xdc, 0x77, 0x75, 0x68, 0x9f, 0x0f, 0x2e, 0x44,
+	0x16, 0x06, 0x03, 0xff, 0x66, 0xb0, 0xad, 0xf5, 0xdc, 0xe9, 0xf2, 0xe9, 0xaa, 0x82, 0xd2, 0xae,
+	0x6f, 0xea, 0xe9, 0xaa, 0x30, 0x56, 0x9f, 0x2e, 0x2d, 0xd7, 0xd8, 0xd8, 0xd3, 0xa5, 0xf4, 0x06,
+	0x83, 0x93, 0x33, 0x07, 0x9c, 0x9e, 0x39, 0xe0, 0xc7, 0x99, 0x03, 0x3e, 0xcc, 0x9c, 0xda, 0xe9,
+	0xcc, 0xa9, 0x7d, 0x9d, 0x39, 0xb5, 0x57, 0x9e, 0x4e, 0x17, 0xc9, 0x18, 0x53, 0xee, 0xbf, 0xfd,
+	0xfb, 0x9f, 0x51, 0xd4, 0x54, 0x77, 0x7e, 0xff, 0xf7, 0x00, 0xe4, 0xb0, 0x3a, 0x2b, 0xb7, 0x06,
+	0x00, 0x00,
+}
+
+func (m *SpendLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WeeklyLimit) > 0 {
+		for iNdEx := len(m.WeeklyLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeeklyLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DailyLimit) > 0 {
+		for iNdEx := len(m.DailyLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Spending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Spending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Spending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Period != 0 {
+		i = encodeVarintSpendLimit(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SpendLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSpendLimit(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InitSequence != 0 {
+		i = encodeVarintSpendLimit(dAtA, i, uint64(m.InitSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitSpendLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitSpendLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitSpendLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSpendLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSpendLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSpendLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SpendLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSpendLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSpendLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSpendLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnerSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnerSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnerSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintSpendLimit(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnerSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnerSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnerSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WeeklySpent) > 0 {
+		for iNdEx := len(m.WeeklySpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeeklySpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DailySpent) > 0 {
+		for iNdEx := len(m.DailySpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailySpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.SpendLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSpendLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSpendLimit(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSpendLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSpendLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SpendLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DailyLimit) > 0 {
+		for _, e := range m.DailyLimit {
+			l = e.Size()
+			n += 1 + l + sovSpendLimit(uint64(l))
+		}
+	}
+	if len(m.WeeklyLimit) > 0 {
+		for _, e := range m.WeeklyLimit {
+			l = e.Size()
+			n += 1 + l + sovSpendLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Spending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovSpendLimit(uint64(m.Period))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSpendLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInitSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovSpendLimit(uint64(l))
+	}
+	if m.InitSequence != 0 {
+		n += 1 + sovSpendLimit(uint64(m.InitSequence))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSpendLimit(uint64(l))
+	}
+	l = m.SpendLimits.Size()
+	n += 1 + l + sovSpendLimit(uint64(l))
+	return n
+}
+
+func (m *MsgInitSpendLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSpendLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpendLimits.Size()
+	n += 1 + l + sovSpendLimit(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateSpendLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOwnerSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovSpendLimit(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSpendLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgOwnerSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovSpendLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySpendLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySpendLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSpendLimit(uint64(l))
+	}
+	l = m.SpendLimits.Size()
+	n += 1 + l + sovSpendLimit(uint64(l))
+	if len(m.DailySpent) > 0 {
+		for _, e := range m.DailySpent {
+			l = e.Size()
+			n += 1 + l + sovSpendLimit(uint64(l))
+		}
+	}
+	if len(m.WeeklySpent) > 0 {
+		for _, e := range m.WeeklySpent {
+			l = e.Size()
+			n += 1 + l + sovSpendLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSpendLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSpendLimit(x uint64) (n int) {
+	return sovSpendLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SpendLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyLimit = append(m.DailyLimit, types.Coin{})
+			if err := m.DailyLimit[len(m.DailyLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklyLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeeklyLimit = append(m.WeeklyLimit, types.Coin{})
+			if err := m.WeeklyLimit[len(m.WeeklyLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Spending) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spending: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spending: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitSequence", wireType)
+			}
+			m.InitSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitSpendLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitSpendLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitSpendLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpendLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpendLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpendLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpendLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpendLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpendLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOwnerSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOwnerSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOwnerSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOwnerSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOwnerSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOwnerSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &any.Any{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailySpent = append(m.DailySpent, types.Coin{})
+			if err := m.DailySpent[len(m.DailySpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklySpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeeklySpent = append(m.WeeklySpent, types.Coin{})
+			if err := m.WeeklySpent[len(m.WeeklySpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpendLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpendLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSpendLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSpendLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSpendLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSpendLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSpendLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSpendLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSpendLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSpendLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSpendLimit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,338 @@
+package lockup
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = (*CliffLockingAccount)(nil)
+)
+
+// NewCliffLockingAccount creates a new CliffLockingAccount object.
+func NewCliffLockingAccount(d accountstd.Dependencies) (*CliffLockingAccount, error) {
+	baseLockup := newBaseLockup(d)
+
+	cliffLockingAccount := CliffLockingAccount{
+		BaseLockup: baseLockup,
+		StartTime:  collections.NewItem(d.SchemaBuilder, StartTimePrefix, "start_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		CliffTime:  collections.NewItem(d.SchemaBuilder, CliffTimePrefix, "cliff_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+	}
+
+	return &cliffLockingAccount, nil
+}
+
+// CliffLockingAccount locks all the coins until the cliff time, after which the coins unlock
+// linearly from the start time until the end time, as for a ContinuousLockingAccount.
+type CliffLockingAccount struct {
+	*BaseLockup
+	StartTime collections.Item[time.Time]
+	CliffTime collections.Item[time.Time]
+}
+
+func (cla CliffLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitCliffLockingAccount) (*lockuptypes.MsgInitCliffLockingAccountResponse, error) {
+	if msg.EndTime.IsZero() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid end time %s", msg.EndTime.String())
+	}
+
+	hs := cla.headerService.HeaderInfo(ctx)
+
+	start := msg.StartTime
+	if msg.StartTime.IsZero() {
+		start = hs.Time
+	}
+
+	if !msg.EndTime.After(start) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid start and end time (must be start before end)")
+	}
+
+	if msg.CliffTime.Before(start) || msg.CliffTime.After(msg.EndTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid cliff time (must be between start and end)")
+	}
+
+	err := cla.StartTime.Set(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cla.CliffTime.Set(ctx, msg.CliffTime)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = cla.BaseLockup.Init(ctx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     msg.Owner,
+		EndTime:   msg.EndTime,
+		StartTime: start,
+		Admin:     msg.Admin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitCliffLockingAccountResponse{}, nil
+}
+
+func (cla *CliffLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cla.BaseLockup.Delegate(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CliffLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cla.BaseLockup.SendCoins(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CliffLockingAccount) MultiSendCoins(ctx context.Context, msg *lockuptypes.MsgMultiSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cla.BaseLockup.MultiSendCoins(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CliffLockingAccount) IBCTransfer(ctx context.Context, msg *lockuptypes.MsgIBCTransfer) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cla.BaseLockup.IBCTransfer(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CliffLockingAccount) SpendAllowance(ctx context.Context, msg *lockuptypes.MsgSpendAllowance) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cla.BaseLockup.SpendAllowance(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CliffLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return cla.BaseLockup.ClawbackFunds(ctx, msg, cla.GetLockedCoinsWithDenoms, nil)
+}
+
+func (cla *CliffLockingAccount) SplitLockup(ctx context.Context, msg *lockuptypes.MsgSplitLockup) (
+	*lockuptypes.MsgSplitLockupResponse, error,
+) {
+	return cla.BaseLockup.SplitLockup(ctx, msg, CLIFF_LOCKING_ACCOUNT, cla.GetLockedCoinsWithDenoms, cla.splitLockup)
+}
+
+// splitLockup removes the share of the original locking. Before the cliff, the new account has the same
+// schedule, after it the new account unlocks the split funds from the current block time until the end time.
+func (cla CliffLockingAccount) splitLockup(ctx context.Context, newOwner string, share math.LegacyDec) (proto.Message, sdk.Coins, error) {
+	funds, err := cla.splitOriginalLocking(ctx, share, cla.GetLockedCoinsWithDenoms)
+	if err != nil {
+		return nil, nil, err
+	}
+	startTime, err := cla.StartTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	cliffTime, err := cla.CliffTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	endTime, err := cla.EndTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	admin, err := cla.getAdminAddress(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hs := cla.headerService.HeaderInfo(ctx)
+	if !cliffTime.After(hs.Time) {
+		startTime = hs.Time
+		cliffTime = hs.Time
+	}
+
+	return &lockuptypes.MsgInitCliffLockingAccount{
+		Owner:     newOwner,
+		StartTime: startTime,
+		CliffTime: cliffTime,
+		EndTime:   endTime,
+		Admin:     admin,
+	}, funds, nil
+}
+
+// GetLockCoinsInfo returns the total number of unlocked and locked coins.
+func (cla CliffLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
+	unlockedCoins = sdk.Coins{}
+	lockedCoins = sdk.Coins{}
+
+	err = cla.IterateCoinEntries(ctx, cla.OriginalLocking, func(key string, value math.Int) (stop bool, err error) {
+		unlockedCoin, lockedCoin, err := cla.GetLockCoinInfoWithDenom(ctx, blockTime, key)
+		if err != nil {
+			return true, err
+		}
+		unlockedCoins = append(unlockedCoins, *unlockedCoin)
+		lockedCoins = append(lockedCoins, *lockedCoin)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return unlockedCoins, lockedCoins, nil
+}
+
+// GetLockCoinInfoWithDenom returns the number of unlocked and locked coin for a specific denom.
+// Nothing is unlocked before the cliff time, then the coins unlock linearly from the start time.
+func (cla CliffLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, blockTime time.Time, denom string) (unlockedCoin, lockedCoin *sdk.Coin, err error) {
+	startTime, err := cla.StartTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	cliffTime, err := cla.CliffTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	endTime, err := cla.EndTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	originalLockingAmt, err := cla.OriginalLocking.Get(ctx, denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	originalLocking := sdk.NewCoin(denom, originalLockingAmt)
+	if cliffTime.After(blockTime) {
+		zero := sdk.NewCoin(denom, math.ZeroInt())
+		return &zero, &originalLocking, nil
+	} else if endTime.Before(blockTime) {
+		zero := sdk.NewCoin(denom, math.ZeroInt())
+		return &originalLocking, &zero, nil
+	}
+
+	// calculate the locking scalar
+	x := blockTime.Unix() - startTime.Unix()
+	y := endTime.Unix() - startTime.Unix()
+	s := math.LegacyNewDec(x).Quo(math.LegacyNewDec(y))
+
+	unlockedAmt := math.LegacyNewDecFromInt(originalLocking.Amount).Mul(s).RoundInt()
+	unlocked := sdk.NewCoin(originalLocking.Denom, unlockedAmt)
+
+	locked := originalLocking.Sub(unlocked)
+
+	return &unlocked, &locked, nil
+}
+
+// GetLockedCoins returns the total number of locked coins.
+func (cla CliffLockingAccount) GetLockedCoins(ctx context.Context, blockTime time.Time) (sdk.Coins, error) {
+	_, lockedCoins, err := cla.GetLockCoinsInfo(ctx, blockTime)
+	if err != nil {
+		return nil, err
+	}
+	return lockedCoins, nil
+}
+
+// GetLockedCoinsWithDenoms returns the number of locked coin for a specific denom.
+func (cla CliffLockingAccount) GetLockedCoinsWithDenoms(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
+	lockedCoins := sdk.Coins{}
+	for _, denom := range denoms {
+		_, lockedCoin, err := cla.GetLockCoinInfoWithDenom(ctx, blockTime, denom)
+		if err != nil {
+			return nil, err
+		}
+		lockedCoins = append(lockedCoins, *lockedCoin)
+	}
+
+	return lockedCoins, nil
+}
+
+func (cla CliffLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
+	*lockuptypes.QueryLockupAccountInfoResponse, error,
+) {
+	resp, err := cla.BaseLockup.QueryLockupAccountBaseInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := cla.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cliffTime, err := cla.CliffTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hs := cla.headerService.HeaderInfo(ctx)
+	unlockedCoins, lockedCoins, err := cla.GetLockCoinsInfo(ctx, hs.Time)
+	if err != nil {
+		return nil, err
+	}
+	resp.StartTime = &startTime
+	resp.CliffTime = &cliffTime
+	resp.LockedCoins = lockedCoins
+	resp.UnlockedCoins = unlockedCoins
+	return resp, nil
+}
+
+func (cla CliffLockingAccount) QuerySpendableTokens(ctx context.Context, req *lockuptypes.QuerySpendableAmountRequest) (
+	*lockuptypes.QuerySpendableAmountResponse, error,
+) {
+	hs := cla.headerService.HeaderInfo(ctx)
+	_, lockedCoins, err := cla.GetLockCoinsInfo(ctx, hs.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return cla.BaseLockup.QuerySpendableTokens(ctx, lockedCoins)
+}
+
+// QueryUnlockSchedule returns the unlock schedule of the account, the coins unlocked since the start time are
+// released at the cliff time, then the coins unlock linearly until the end time.
+func (cla CliffLockingAccount) QueryUnlockSchedule(ctx context.Context, req *lockuptypes.QueryUnlockScheduleRequest) (
+	*lockuptypes.QueryUnlockScheduleResponse, error,
+) {
+	cliffTime, err := cla.CliffTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := cla.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return cla.BaseLockup.QueryUnlockSchedule(ctx, []time.Time{cliffTime, endTime}, true, cla.getUnlockedCoins)
+}
+
+func (cla CliffLockingAccount) getUnlockedCoins(ctx context.Context, blockTime time.Time) (sdk.Coins, error) {
+	unlockedCoins, _, err := cla.GetLockCoinsInfo(ctx, blockTime)
+	return unlockedCoins, err
+}
+
+// Implement smart account interface
+func (cla CliffLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, cla.Init)
+}
+
+func (cla CliffLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, cla.Delegate)
+	accountstd.RegisterExecuteHandler(builder, cla.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, cla.MultiSendCoins)
+	accountstd.RegisterExecuteHandler(builder, cla.IBCTransfer)
+	accountstd.RegisterExecuteHandler(builder, cla.SpendAllowance)
+	accountstd.RegisterExecuteHandler(builder, cla.Clawback)
+	accountstd.RegisterExecuteHandler(builder, cla.SplitLockup)
+	cla.BaseLockup.RegisterExecuteHandlers(builder)
+}
+
+func (cla CliffLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, cla.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, cla.QuerySpendableTokens)
+	accountstd.RegisterQueryHandler(builder, cla.QueryUnlockSchedule)
+	cla.BaseLockup.RegisterQueryHandlers(builder)
+}
@@ -0,0 +1,136 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func setupCliffAccount(t *testing.T, ctx context.Context, ss store.KVStoreService) *CliffLockingAccount {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	owner := "owner"
+
+	now := time.Now()
+
+	acc, err := NewCliffLockingAccount(deps)
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitCliffLockingAccount{
+		Owner:     owner,
+		StartTime: now,
+		CliffTime: now.Add(time.Minute),
+		EndTime:   now.Add(time.Minute * 2),
+	})
+	require.NoError(t, err)
+
+	return acc
+}
+
+func TestCliffAccountInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc, err := NewCliffLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitCliffLockingAccount{
+		Owner:     "owner",
+		StartTime: time.Now(),
+		CliffTime: time.Now().Add(time.Minute * 3),
+		EndTime:   time.Now().Add(time.Minute * 2),
+	})
+	require.ErrorContains(t, err, "invalid cliff time")
+
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitCliffLockingAccount{
+		Owner:     "owner",
+		StartTime: time.Now(),
+		CliffTime: time.Now().Add(-time.Minute),
+		EndTime:   time.Now().Add(time.Minute * 2),
+	})
+	require.ErrorContains(t, err, "invalid cliff time")
+
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitCliffLockingAccount{
+		Owner:     "owner",
+		StartTime: time.Now(),
+		CliffTime: time.Now(),
+	})
+	require.ErrorContains(t, err, "invalid end time")
+}
+
+func TestCliffAccountSendCoins(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupCliffAccount(t, sdkCtx, ss)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to before the cliff
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Second * 30),
+	})
+
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))),
+	})
+	require.Error(t, err)
+
+	// Update context time to the cliff, which unlocks half of the original locking amount
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute),
+	})
+
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+	})
+	require.NoError(t, err)
+}
+
+func TestCliffAccountGetLockCoinInfo(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupCliffAccount(t, sdkCtx, ss)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// nothing is unlocked before the cliff
+	unlocked, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Second*30))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.ZeroInt()))
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(10)))
+
+	// the coins unlocked since the start time are released at the cliff
+	unlocked, locked, err = acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(5)))
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(5)))
+
+	// all coins are unlocked after the end time
+	unlocked, locked, err = acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*3))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
+	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/math"
@@ -72,6 +74,69 @@ func (cva *ContinuousLockingAccount) SendCoins(ctx context.Context, msg *lockupt
 	return cva.BaseLockup.SendCoins(ctx, msg, cva.GetLockedCoinsWithDenoms)
 }
 
+func (cva *ContinuousLockingAccount) MultiSendCoins(ctx context.Context, msg *lockuptypes.MsgMultiSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cva.BaseLockup.MultiSendCoins(ctx, msg, cva.GetLockedCoinsWithDenoms)
+}
+
+func (cva *ContinuousLockingAccount) IBCTransfer(ctx context.Context, msg *lockuptypes.MsgIBCTransfer) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cva.BaseLockup.IBCTransfer(ctx, msg, cva.GetLockedCoinsWithDenoms)
+}
+
+func (cva *ContinuousLockingAccount) SpendAllowance(ctx context.Context, msg *lockuptypes.MsgSpendAllowance) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cva.BaseLockup.SpendAllowance(ctx, msg, cva.GetLockedCoinsWithDenoms)
+}
+
+func (cva *ContinuousLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return cva.BaseLockup.ClawbackFunds(ctx, msg, cva.GetLockedCoinsWithDenoms, nil)
+}
+
+func (cva *ContinuousLockingAccount) SplitLockup(ctx context.Context, msg *lockuptypes.MsgSplitLockup) (
+	*lockuptypes.MsgSplitLockupResponse, error,
+) {
+	return cva.BaseLockup.SplitLockup(ctx, msg, CONTINUOUS_LOCKING_ACCOUNT, cva.GetLockedCoinsWithDenoms, cva.splitLockup)
+}
+
+// splitLockup removes the share of the original locking, the new account unlocks the split funds from the
+// current block time until the end time, at the same rate as the remaining funds.
+func (cva ContinuousLockingAccount) splitLockup(ctx context.Context, newOwner string, share math.LegacyDec) (proto.Message, sdk.Coins, error) {
+	funds, err := cva.splitOriginalLocking(ctx, share, cva.GetLockedCoinsWithDenoms)
+	if err != nil {
+		return nil, nil, err
+	}
+	startTime, err := cva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	endTime, err := cva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	admin, err := cva.getAdminAddress(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hs := cva.headerService.HeaderInfo(ctx)
+	if hs.Time.After(startTime) {
+		startTime = hs.Time
+	}
+
+	return &lockuptypes.MsgInitLockupAccount{
+		Owner:     newOwner,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Admin:     admin,
+	}, funds, nil
+}
+
 // GetLockCoinsInfo returns the total number of unlocked and locked coins.
 func (cva ContinuousLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
 	unlockedCoins = sdk.Coins{}
@@ -197,6 +262,28 @@ func (cva ContinuousLockingAccount) QuerySpendableTokens(ctx context.Context, re
 	return resp, nil
 }
 
+// QueryUnlockSchedule returns the unlock schedule of the account, the coins unlock linearly from the
+// start time to the end time.
+func (cva ContinuousLockingAccount) QueryUnlockSchedule(ctx context.Context, req *lockuptypes.QueryUnlockScheduleRequest) (
+	*lockuptypes.QueryUnlockScheduleResponse, error,
+) {
+	startTime, err := cva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := cva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return cva.BaseLockup.QueryUnlockSchedule(ctx, []time.Time{startTime, endTime}, true, cva.getUnlockedCoins)
+}
+
+func (cva ContinuousLockingAccount) getUnlockedCoins(ctx context.Context, blockTime time.Time) (sdk.Coins, error) {
+	unlockedCoins, _, err := cva.GetLockCoinsInfo(ctx, blockTime)
+	return unlockedCoins, err
+}
+
 // Implement smart account interface
 func (cva ContinuousLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
 	accountstd.RegisterInitHandler(builder, cva.Init)
@@ -205,11 +292,17 @@ func (cva ContinuousLockingAccount) RegisterInitHandler(builder *accountstd.Init
 func (cva ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, cva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, cva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, cva.MultiSendCoins)
+	accountstd.RegisterExecuteHandler(builder, cva.IBCTransfer)
+	accountstd.RegisterExecuteHandler(builder, cva.SpendAllowance)
+	accountstd.RegisterExecuteHandler(builder, cva.Clawback)
+	accountstd.RegisterExecuteHandler(builder, cva.SplitLockup)
 	cva.BaseLockup.RegisterExecuteHandlers(builder)
 }
 
 func (cva ContinuousLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, cva.QueryLockupAccountInfo)
 	accountstd.RegisterQueryHandler(builder, cva.QuerySpendableTokens)
+	accountstd.RegisterQueryHandler(builder, cva.QueryUnlockSchedule)
 	cva.BaseLockup.RegisterQueryHandlers(builder)
 }
@@ -14,6 +14,7 @@ import (
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func setupContinuousAccount(t *testing.T, ctx context.Context, ss store.KVStoreService) *ContinuousLockingAccount {
@@ -149,6 +150,164 @@ func TestContinuousAccountSendCoins(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestContinuousAccountEvents(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinuousAccount(t, sdkCtx, ss)
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	eventTypes := func() []string {
+		var types []string
+		for _, event := range sdkCtx.EventManager().Events() {
+			types = append(types, event.Type)
+		}
+		return types
+	}
+
+	// half of the original locking amount is unlocked
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 1),
+	})
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"cosmos.accounts.defaults.lockup.v1.EventUnlock",
+		"cosmos.accounts.defaults.lockup.v1.EventWithdraw",
+	}, eventTypes())
+
+	unlocked, err := acc.Unlocked.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, unlocked.Equal(math.NewInt(5)))
+
+	// the unlocked coins are only reported once
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(1)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"cosmos.accounts.defaults.lockup.v1.EventUnlock",
+		"cosmos.accounts.defaults.lockup.v1.EventWithdraw",
+		"cosmos.accounts.defaults.lockup.v1.EventDelegate",
+	}, eventTypes())
+
+	// the whole original locking amount is unlocked
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 2),
+	})
+	_, err = acc.Undelegate(sdkCtx, &lockuptypes.MsgUndelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(1)),
+	})
+	require.NoError(t, err)
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"cosmos.accounts.defaults.lockup.v1.EventUnlock",
+		"cosmos.accounts.defaults.lockup.v1.EventWithdraw",
+		"cosmos.accounts.defaults.lockup.v1.EventDelegate",
+		"cosmos.accounts.defaults.lockup.v1.EventUndelegate",
+		"cosmos.accounts.defaults.lockup.v1.EventUnlock",
+		"cosmos.accounts.defaults.lockup.v1.EventWithdraw",
+	}, eventTypes())
+
+	unlocked, err = acc.Unlocked.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, unlocked.Equal(math.NewInt(10)))
+}
+
+func TestContinuousAccountMultiSendCoins(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinuousAccount(t, sdkCtx, ss)
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to unlocked half of the original locking amount
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 1),
+	})
+
+	_, err = acc.MultiSendCoins(sdkCtx, &lockuptypes.MsgMultiSend{
+		Sender: "owner",
+	})
+	require.Error(t, err)
+
+	// the total sent amount exceeds the unlocked amount
+	_, err = acc.MultiSendCoins(sdkCtx, &lockuptypes.MsgMultiSend{
+		Sender: "owner",
+		Outputs: []lockuptypes.Output{
+			{Address: "receiver1", Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(3)))},
+			{Address: "receiver2", Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(3)))},
+		},
+	})
+	require.Error(t, err)
+
+	resp, err := acc.MultiSendCoins(sdkCtx, &lockuptypes.MsgMultiSend{
+		Sender: "owner",
+		Outputs: []lockuptypes.Output{
+			{Address: "receiver1", Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(3)))},
+			{Address: "receiver2", Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2)))},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 2)
+}
+
+func TestContinuousAccountIBCTransfer(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinuousAccount(t, sdkCtx, ss)
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to unlocked half of the original locking amount
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 1),
+	})
+
+	msg := &lockuptypes.MsgIBCTransfer{
+		Sender:           "owner",
+		SourcePort:       "transfer",
+		SourceChannel:    "channel-0",
+		Token:            sdk.NewCoin("test", math.NewInt(6)),
+		Receiver:         "receiver",
+		TimeoutTimestamp: uint64(startTime.Add(time.Hour).UnixNano()),
+	}
+	_, err = acc.IBCTransfer(sdkCtx, msg)
+	require.ErrorContains(t, err, "spendable balance")
+
+	msg.SourceChannel = ""
+	msg.Token = sdk.NewCoin("test", math.NewInt(5))
+	_, err = acc.IBCTransfer(sdkCtx, msg)
+	require.ErrorContains(t, err, "source port and channel must be set")
+
+	// the transfer is routed to the ICS-20 transfer module, which is not part of the test app
+	msg.SourceChannel = "channel-0"
+	_, err = acc.IBCTransfer(sdkCtx, msg)
+	require.ErrorContains(t, err, "no message type found for ibc.applications.transfer.v1.MsgTransfer")
+}
+
 func TestContinousAccountGetLockCoinInfo(t *testing.T) {
 	ctx, ss := newMockContext(t)
 	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
@@ -177,3 +336,227 @@ func TestContinousAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestContinuousAccountClawback(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	// clawback is disabled without admin
+	acc := setupContinuousAccount(t, sdkCtx, ss)
+	_, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Sender: "admin", Recipient: "funder"})
+	require.ErrorContains(t, err, "clawback is disabled")
+
+	ctx, ss = newMockContext(t)
+	sdkCtx = sdkCtx.WithContext(ctx)
+	acc, err = NewContinuousLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     "owner",
+		Admin:     "admin",
+		EndTime:   time.Now().Add(time.Minute * 2),
+		StartTime: time.Now(),
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(1)),
+	})
+	require.NoError(t, err)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to unlocked half of the original locking amount
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 1),
+	})
+
+	_, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Sender: "owner", Recipient: "funder"})
+	require.ErrorContains(t, err, "sender is not the admin")
+
+	// the locked funds which are not delegated are sent, the delegated ones are undelegated
+	resp, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Sender: "admin", Recipient: "funder", IncludeDelegated: true})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4))), resp.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))), resp.Pending)
+
+	originalLocking, err := acc.OriginalLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, originalLocking.IsZero())
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.IsZero())
+
+	// the undelegated funds are locked until they are clawed back
+	info, err := acc.QueryLockupAccountInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "admin", info.Admin)
+	require.True(t, info.LockedCoins.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))), info.ClawbackPending)
+
+	spendable, err := acc.QuerySpendableTokens(sdkCtx, &lockuptypes.QuerySpendableAmountRequest{})
+	require.NoError(t, err)
+	require.True(t, spendable.SpendableTokens.AmountOf("test").Equal(math.NewInt(9)))
+
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Sender: "admin", Recipient: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))), resp.Amount)
+	require.True(t, resp.Pending.IsZero())
+
+	_, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Sender: "admin", Recipient: "funder"})
+	require.ErrorContains(t, err, "no funds to claw back")
+}
+
+func TestContinuousAccountUnlockSchedule(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinuousAccount(t, sdkCtx, ss)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+	endTime, err := acc.EndTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to unlocked half of the original locking amount
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 1),
+	})
+
+	resp, err := acc.QueryUnlockSchedule(sdkCtx, &lockuptypes.QueryUnlockScheduleRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Linear)
+	require.Len(t, resp.Schedule, 2)
+	require.Equal(t, startTime, resp.Schedule[0].Time)
+	require.True(t, resp.Schedule[0].UnlockedCoins.IsZero())
+	require.Equal(t, endTime, resp.Schedule[1].Time)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10))), resp.Schedule[1].UnlockedCoins)
+	require.Equal(t, &resp.Schedule[1], resp.NextUnlock)
+
+	// Update context time to after the end time
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: endTime.Add(time.Second),
+	})
+
+	resp, err = acc.QueryUnlockSchedule(sdkCtx, &lockuptypes.QueryUnlockScheduleRequest{})
+	require.NoError(t, err)
+	require.Nil(t, resp.NextUnlock)
+}
+
+func TestContinuousAccountReconcileDelegations(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinuousAccount(t, sdkCtx, ss)
+	_, err := acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(3)),
+	})
+	require.NoError(t, err)
+
+	_, err = acc.ReconcileDelegations(sdkCtx, &lockuptypes.MsgReconcileDelegations{Sender: "admin"})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	// the validator was slashed, only 1 is still delegated
+	resp, err := acc.ReconcileDelegations(sdkCtx, &lockuptypes.MsgReconcileDelegations{Sender: "owner"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2))), resp.Removed)
+
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.Equal(math.NewInt(1)))
+
+	// the tracking is already in sync
+	resp, err = acc.ReconcileDelegations(sdkCtx, &lockuptypes.MsgReconcileDelegations{Sender: "owner"})
+	require.NoError(t, err)
+	require.True(t, resp.Removed.IsZero())
+}
+
+func TestContinuousAccountSplitLockup(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinuousAccount(t, sdkCtx, ss)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to unlocked half of the original locking amount
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 1),
+	})
+
+	_, err = acc.SplitLockup(sdkCtx, &lockuptypes.MsgSplitLockup{Sender: "owner", NewOwner: "new_owner", Share: math.LegacyNewDec(2)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = acc.SplitLockup(sdkCtx, &lockuptypes.MsgSplitLockup{Sender: "new_owner", NewOwner: "new_owner", Share: math.LegacyNewDecWithPrec(4, 1)})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	resp, err := acc.SplitLockup(sdkCtx, &lockuptypes.MsgSplitLockup{Sender: "owner", NewOwner: "new_owner", Share: math.LegacyNewDecWithPrec(4, 1)})
+	require.NoError(t, err)
+	require.Equal(t, "new_lockup_account", resp.AccountAddress)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2))), resp.Amount)
+
+	originalLocking, err := acc.OriginalLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, originalLocking.Equal(math.NewInt(6)))
+
+	_, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*1))
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(3)))
+
+	// the new account unlocks the split funds from now until the end time
+	initMsg, _, err := acc.splitLockup(sdkCtx, "new_owner", math.LegacyNewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	endTime, err := acc.EndTime.Get(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, startTime.Add(time.Minute*1), initMsg.(*lockuptypes.MsgInitLockupAccount).StartTime)
+	require.Equal(t, endTime, initMsg.(*lockuptypes.MsgInitLockupAccount).EndTime)
+}
+
+func TestContinuousAccountQueryDelegations(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinuousAccount(t, sdkCtx, ss)
+	_, err := acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(1)),
+	})
+	require.NoError(t, err)
+
+	// the delegation is locked
+	resp, err := acc.QueryDelegations(sdkCtx, &lockuptypes.QueryDelegationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Delegations, 1)
+	require.Equal(t, "val_address", resp.Delegations[0].ValidatorAddress)
+	require.Equal(t, sdk.NewCoin("test", math.NewInt(1)), resp.Delegations[0].Balance)
+	require.Equal(t, sdk.NewCoin("test", math.NewInt(1)), resp.Delegations[0].Locked)
+	require.True(t, resp.Delegations[0].Free.IsZero())
+
+	// the delegation is free once the delegated locking funds are unlocked
+	err = acc.DelegatedLocking.Set(sdkCtx, "test", math.ZeroInt())
+	require.NoError(t, err)
+	err = acc.DelegatedFree.Set(sdkCtx, "test", math.NewInt(1))
+	require.NoError(t, err)
+
+	resp, err = acc.QueryDelegations(sdkCtx, &lockuptypes.QueryDelegationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Delegations, 1)
+	require.True(t, resp.Delegations[0].Locked.IsZero())
+	require.Equal(t, sdk.NewCoin("test", math.NewInt(1)), resp.Delegations[0].Free)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
@@ -49,6 +51,53 @@ func (dva *DelayedLockingAccount) SendCoins(ctx context.Context, msg *lockuptype
 	return dva.BaseLockup.SendCoins(ctx, msg, dva.GetLockedCoinsWithDenoms)
 }
 
+func (dva *DelayedLockingAccount) MultiSendCoins(ctx context.Context, msg *lockuptypes.MsgMultiSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return dva.BaseLockup.MultiSendCoins(ctx, msg, dva.GetLockedCoinsWithDenoms)
+}
+
+func (dva *DelayedLockingAccount) IBCTransfer(ctx context.Context, msg *lockuptypes.MsgIBCTransfer) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return dva.BaseLockup.IBCTransfer(ctx, msg, dva.GetLockedCoinsWithDenoms)
+}
+
+func (dva *DelayedLockingAccount) SpendAllowance(ctx context.Context, msg *lockuptypes.MsgSpendAllowance) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return dva.BaseLockup.SpendAllowance(ctx, msg, dva.GetLockedCoinsWithDenoms)
+}
+
+func (dva *DelayedLockingAccount) SplitLockup(ctx context.Context, msg *lockuptypes.MsgSplitLockup) (
+	*lockuptypes.MsgSplitLockupResponse, error,
+) {
+	return dva.BaseLockup.SplitLockup(ctx, msg, DELAYED_LOCKING_ACCOUNT, dva.GetLockedCoinsWithDenoms, dva.splitLockup)
+}
+
+// splitLockup removes the share of the original locking, the new account unlocks the split funds at the
+// same end time.
+func (dva DelayedLockingAccount) splitLockup(ctx context.Context, newOwner string, share math.LegacyDec) (proto.Message, sdk.Coins, error) {
+	funds, err := dva.splitOriginalLocking(ctx, share, dva.GetLockedCoinsWithDenoms)
+	if err != nil {
+		return nil, nil, err
+	}
+	endTime, err := dva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	admin, err := dva.getAdminAddress(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &lockuptypes.MsgInitLockupAccount{
+		Owner:   newOwner,
+		EndTime: endTime,
+		Admin:   admin,
+	}, funds, nil
+}
+
 // GetLockCoinsInfo returns the total number of unlocked and locked coins.
 func (dva DelayedLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (sdk.Coins, sdk.Coins, error) {
 	endTime, err := dva.EndTime.Get(ctx)
@@ -146,6 +195,25 @@ func (dva DelayedLockingAccount) QuerySpendableTokens(ctx context.Context, req *
 	return resp, nil
 }
 
+// QueryUnlockSchedule returns the unlock schedule of the account, all the coins are released at the end time.
+func (dva DelayedLockingAccount) QueryUnlockSchedule(ctx context.Context, req *lockuptypes.QueryUnlockScheduleRequest) (
+	*lockuptypes.QueryUnlockScheduleResponse, error,
+) {
+	endTime, err := dva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dva.BaseLockup.QueryUnlockSchedule(ctx, []time.Time{endTime}, false, func(ctx context.Context, _ time.Time) (sdk.Coins, error) {
+		originalLocking := sdk.Coins{}
+		err := dva.IterateCoinEntries(ctx, dva.OriginalLocking, func(key string, value math.Int) (stop bool, err error) {
+			originalLocking = append(originalLocking, sdk.NewCoin(key, value))
+			return false, nil
+		})
+		return originalLocking, err
+	})
+}
+
 // Implement smart account interface
 func (dva DelayedLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
 	accountstd.RegisterInitHandler(builder, dva.Init)
@@ -154,11 +222,16 @@ func (dva DelayedLockingAccount) RegisterInitHandler(builder *accountstd.InitBui
 func (dva DelayedLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, dva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, dva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, dva.MultiSendCoins)
+	accountstd.RegisterExecuteHandler(builder, dva.IBCTransfer)
+	accountstd.RegisterExecuteHandler(builder, dva.SpendAllowance)
+	accountstd.RegisterExecuteHandler(builder, dva.SplitLockup)
 	dva.BaseLockup.RegisterExecuteHandlers(builder)
 }
 
 func (dva DelayedLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, dva.QueryVestingAccountInfo)
 	accountstd.RegisterQueryHandler(builder, dva.QuerySpendableTokens)
+	accountstd.RegisterQueryHandler(builder, dva.QueryUnlockSchedule)
 	dva.BaseLockup.RegisterQueryHandlers(builder)
 }
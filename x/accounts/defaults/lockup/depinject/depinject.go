@@ -1,14 +1,17 @@
 package lockupdepinject
 
 import (
+	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/defaults/lockup"
+	bankv2types "cosmossdk.io/x/bank/v2/types"
 )
 
 func ProvideAllLockupAccounts() []accountstd.DepinjectAccount {
 	return []accountstd.DepinjectAccount{
 		ProvidePeriodicLockingAccount(),
 		ProvideContinuousLockingAccount(),
+		ProvideCliffLockingAccount(),
 		ProvidePermanentLockingAccount(),
 		ProvideDelayedLockingAccount(),
 	}
@@ -18,6 +21,10 @@ func ProvideContinuousLockingAccount() accountstd.DepinjectAccount {
 	return accountstd.DIAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT, lockup.NewContinuousLockingAccount)
 }
 
+func ProvideCliffLockingAccount() accountstd.DepinjectAccount {
+	return accountstd.DIAccount(lockup.CLIFF_LOCKING_ACCOUNT, lockup.NewCliffLockingAccount)
+}
+
 func ProvidePeriodicLockingAccount() accountstd.DepinjectAccount {
 	return accountstd.DIAccount(lockup.PERIODIC_LOCKING_ACCOUNT, lockup.NewPeriodicLockingAccount)
 }
@@ -29,3 +36,8 @@ func ProvideDelayedLockingAccount() accountstd.DepinjectAccount {
 func ProvidePermanentLockingAccount() accountstd.DepinjectAccount {
 	return accountstd.DIAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount)
 }
+
+// ProvideLockedCoinsProvider provides the coins locked in the lockup accounts to x/bank/v2.
+func ProvideLockedCoinsProvider(accountsKeeper accounts.Keeper) bankv2types.LockedCoinsProvider {
+	return lockup.NewLockedCoinsProvider(accountsKeeper)
+}
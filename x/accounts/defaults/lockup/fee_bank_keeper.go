@@ -0,0 +1,79 @@
+package lockup
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ authtypes.BankKeeper = FeeBankKeeper{}
+
+// SpendableBankKeeper defines the expected interface of the bank keeper wrapped by FeeBankKeeper.
+type SpendableBankKeeper interface {
+	authtypes.BankKeeper
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// FeeBankKeeper wraps the bank keeper of the x/auth fee deduction decorator, so that the fees paid by the
+// lockup accounts are only deducted from their unlocked balances. It is meant for the bank keepers which do
+// not enforce the locked coins of the lockup accounts themselves, like the legacy x/bank keeper, as x/bank/v2
+// already excludes them with the LockedCoinsProvider.
+type FeeBankKeeper struct {
+	SpendableBankKeeper
+	lockedCoinsProvider LockedCoinsProvider
+}
+
+// NewFeeBankKeeper creates a new FeeBankKeeper.
+func NewFeeBankKeeper(bankKeeper SpendableBankKeeper, lockedCoinsProvider LockedCoinsProvider) FeeBankKeeper {
+	return FeeBankKeeper{
+		SpendableBankKeeper: bankKeeper,
+		lockedCoinsProvider: lockedCoinsProvider,
+	}
+}
+
+// SendCoinsFromAccountToModule implements authtypes.BankKeeper. It fails if the sender is a lockup account
+// and the sent coins exceed its unlocked balance.
+func (k FeeBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	locked, err := k.lockedCoinsProvider.LockedCoins(ctx, senderAddr)
+	if err != nil {
+		return err
+	}
+
+	if !locked.IsZero() {
+		unlocked := unlockedCoins(k.SpendableBankKeeper.SpendableCoins(ctx, senderAddr), locked)
+		if !unlocked.IsAllGTE(amt) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
+				"lockup account unlocked balance %s is smaller than %s", unlocked, amt)
+		}
+	}
+
+	return k.SpendableBankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// SpendableCoins implements authtypes.BankKeeper. The locked coins of the lockup accounts are excluded, and
+// no coins are returned if they cannot be retrieved.
+func (k FeeBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	spendable := k.SpendableBankKeeper.SpendableCoins(ctx, addr)
+	locked, err := k.lockedCoinsProvider.LockedCoins(ctx, addr)
+	if err != nil {
+		return sdk.NewCoins()
+	}
+
+	return unlockedCoins(spendable, locked)
+}
+
+// unlockedCoins returns the given spendable coins, their locked amount excluded.
+func unlockedCoins(spendable, locked sdk.Coins) sdk.Coins {
+	unlocked := sdk.NewCoins()
+	for _, coin := range spendable {
+		amount := coin.Amount.Sub(math.MinInt(coin.Amount, locked.AmountOf(coin.Denom)))
+		unlocked = unlocked.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return unlocked
+}
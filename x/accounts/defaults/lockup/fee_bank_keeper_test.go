@@ -0,0 +1,62 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockBankKeeper struct {
+	balance sdk.Coins
+	sent    sdk.Coins
+}
+
+func (m *mockBankKeeper) IsSendEnabledCoins(_ context.Context, _ ...sdk.Coin) error { return nil }
+
+func (m *mockBankKeeper) SendCoins(_ context.Context, _, _ sdk.AccAddress, amt sdk.Coins) error {
+	m.sent = m.sent.Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+	m.sent = m.sent.Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, _ sdk.AccAddress) sdk.Coins {
+	return m.balance
+}
+
+func TestFeeBankKeeper(t *testing.T) {
+	ctx := context.Background()
+	bankKeeper := &mockBankKeeper{balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}
+	feeBankKeeper := NewFeeBankKeeper(bankKeeper, NewLockedCoinsProvider(mockAccountsKeeper{
+		types: map[string]string{
+			"lockup": CONTINUOUS_LOCKING_ACCOUNT,
+		},
+		info: &lockuptypes.QueryLockupAccountInfoResponse{
+			LockedCoins: sdk.NewCoins(sdk.NewInt64Coin("stake", 70)),
+		},
+	}))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), feeBankKeeper.SpendableCoins(ctx, []byte("lockup")))
+	require.Equal(t, bankKeeper.balance, feeBankKeeper.SpendableCoins(ctx, []byte("base")))
+
+	// the fees cannot be paid with the locked coins
+	err := feeBankKeeper.SendCoinsFromAccountToModule(ctx, []byte("lockup"), "fee_collector", sdk.NewCoins(sdk.NewInt64Coin("stake", 31)))
+	require.ErrorContains(t, err, "lockup account unlocked balance 30stake is smaller than 31stake")
+	require.Empty(t, bankKeeper.sent)
+
+	err = feeBankKeeper.SendCoinsFromAccountToModule(ctx, []byte("lockup"), "fee_collector", sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), bankKeeper.sent)
+
+	// the other accounts are not restricted
+	err = feeBankKeeper.SendCoinsFromAccountToModule(ctx, []byte("base"), "fee_collector", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, err)
+}
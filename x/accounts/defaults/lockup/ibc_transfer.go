@@ -0,0 +1,59 @@
+package lockup
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// ics20MsgTransferTypeURL is the type URL of the ICS-20 transfer message of ibc-go.
+const ics20MsgTransferTypeURL = "/ibc.applications.transfer.v1.MsgTransfer"
+
+// newICS20TransferAny packs the ICS-20 transfer of msg from the given sender. The ICS-20 message is encoded
+// here, so that the lockup accounts do not depend on ibc-go, and it is routed to the transfer module of the
+// chain, if any.
+func newICS20TransferAny(sender string, msg *lockuptypes.MsgIBCTransfer) (*codectypes.Any, error) {
+	token, err := msg.Token.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var timeoutHeight []byte
+	timeoutHeight = appendUint64(timeoutHeight, 1, msg.TimeoutRevisionNumber)
+	timeoutHeight = appendUint64(timeoutHeight, 2, msg.TimeoutRevisionHeight)
+
+	var bz []byte
+	bz = appendString(bz, 1, msg.SourcePort)
+	bz = appendString(bz, 2, msg.SourceChannel)
+	bz = appendBytes(bz, 3, token)
+	bz = appendString(bz, 4, sender)
+	bz = appendString(bz, 5, msg.Receiver)
+	bz = appendBytes(bz, 6, timeoutHeight)
+	bz = appendUint64(bz, 7, msg.TimeoutTimestamp)
+	bz = appendString(bz, 8, msg.Memo)
+
+	return &codectypes.Any{TypeUrl: ics20MsgTransferTypeURL, Value: bz}, nil
+}
+
+func appendString(bz []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return bz
+	}
+	bz = protowire.AppendTag(bz, num, protowire.BytesType)
+	return protowire.AppendString(bz, v)
+}
+
+func appendBytes(bz []byte, num protowire.Number, v []byte) []byte {
+	bz = protowire.AppendTag(bz, num, protowire.BytesType)
+	return protowire.AppendBytes(bz, v)
+}
+
+func appendUint64(bz []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return bz
+	}
+	bz = protowire.AppendTag(bz, num, protowire.VarintType)
+	return protowire.AppendVarint(bz, v)
+}
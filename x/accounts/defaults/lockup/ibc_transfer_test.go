@@ -0,0 +1,66 @@
+package lockup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewICS20TransferAny(t *testing.T) {
+	msg := &lockuptypes.MsgIBCTransfer{
+		Sender:                "owner",
+		SourcePort:            "transfer",
+		SourceChannel:         "channel-0",
+		Token:                 sdk.NewInt64Coin("test", 5),
+		Receiver:              "receiver",
+		TimeoutRevisionNumber: 1,
+		TimeoutRevisionHeight: 100,
+		TimeoutTimestamp:      200,
+		Memo:                  "memo",
+	}
+	transfer, err := newICS20TransferAny("lockup", msg)
+	require.NoError(t, err)
+	require.Equal(t, "/ibc.applications.transfer.v1.MsgTransfer", transfer.TypeUrl)
+
+	token, err := msg.Token.Marshal()
+	require.NoError(t, err)
+
+	fields := map[protowire.Number]any{}
+	bz := transfer.Value
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		bz = bz[n:]
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(bz)
+			require.GreaterOrEqual(t, n, 0)
+			fields[num] = v
+			bz = bz[n:]
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(bz)
+			require.GreaterOrEqual(t, n, 0)
+			fields[num] = string(v)
+			bz = bz[n:]
+		default:
+			t.Fatalf("unexpected wire type %d", typ)
+		}
+	}
+
+	require.Equal(t, map[protowire.Number]any{
+		1: "transfer",
+		2: "channel-0",
+		3: string(token),
+		// the lockup account sends the transfer
+		4: "lockup",
+		5: "receiver",
+		6: string([]byte{0x08, 0x01, 0x10, 0x64}),
+		7: uint64(200),
+		8: "memo",
+	}, fields)
+}
@@ -0,0 +1,241 @@
+package lockup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/transaction"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// LegacyAccountKeeper defines the x/auth keeper methods used to migrate the legacy vesting accounts.
+type LegacyAccountKeeper interface {
+	RemoveAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// LegacyAccountsKeeper defines the x/accounts keeper methods used to migrate the legacy vesting accounts.
+type LegacyAccountsKeeper interface {
+	MigrateLegacyAccount(ctx context.Context, addr []byte, accNum uint64, accType string, msg transaction.Msg) (transaction.Msg, error)
+}
+
+// LegacyOwnerFunc returns the owner of the lockup account replacing the given legacy vesting account.
+// A lockup account cannot sign transactions, so its owner must be an other account.
+type LegacyOwnerFunc = func(ctx context.Context, acc sdk.AccountI) (string, error)
+
+// LegacyMigrationEntry reports the migration of a legacy vesting account to a lockup account.
+type LegacyMigrationEntry struct {
+	Address          string
+	LegacyType       string
+	AccountType      string
+	Owner            string
+	OriginalLocking  sdk.Coins
+	DelegatedFree    sdk.Coins
+	DelegatedLocking sdk.Coins
+	// Error is set if the account cannot be migrated, only on dry runs.
+	Error error
+}
+
+// LegacyMigrationReport reports the migration of the legacy vesting accounts.
+type LegacyMigrationReport struct {
+	Entries []LegacyMigrationEntry
+	// Skipped is the number of accounts which are not legacy vesting accounts.
+	Skipped int
+}
+
+// Failed returns the number of accounts which cannot be migrated.
+func (r LegacyMigrationReport) Failed() int {
+	failed := 0
+	for _, entry := range r.Entries {
+		if entry.Error != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+func (r LegacyMigrationReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "legacy vesting accounts: %d, failed: %d, skipped accounts: %d\n", len(r.Entries), r.Failed(), r.Skipped)
+	for _, entry := range r.Entries {
+		fmt.Fprintf(&sb, "%s: %s -> %s, owner: %s, original locking: %s, delegated free: %s, delegated locking: %s",
+			entry.Address, entry.LegacyType, entry.AccountType, entry.Owner, entry.OriginalLocking, entry.DelegatedFree, entry.DelegatedLocking)
+		if entry.Error != nil {
+			fmt.Fprintf(&sb, ", error: %s", entry.Error)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// LegacyVestingMigrator migrates the legacy x/auth vesting accounts to lockup accounts, e.g. in an upgrade
+// handler. The lockup accounts keep the address, the account number, the schedule and the delegation
+// tracking of the legacy accounts.
+type LegacyVestingMigrator struct {
+	accountKeeper  LegacyAccountKeeper
+	accountsKeeper LegacyAccountsKeeper
+	addressCodec   address.Codec
+	ownerOf        LegacyOwnerFunc
+}
+
+// NewLegacyVestingMigrator creates a new LegacyVestingMigrator.
+func NewLegacyVestingMigrator(
+	accountKeeper LegacyAccountKeeper, accountsKeeper LegacyAccountsKeeper, addressCodec address.Codec, ownerOf LegacyOwnerFunc,
+) LegacyVestingMigrator {
+	return LegacyVestingMigrator{
+		accountKeeper:  accountKeeper,
+		accountsKeeper: accountsKeeper,
+		addressCodec:   addressCodec,
+		ownerOf:        ownerOf,
+	}
+}
+
+// Migrate migrates the legacy vesting accounts among the given accounts, the other accounts are skipped.
+// It stops at the first account which cannot be migrated.
+func (m LegacyVestingMigrator) Migrate(ctx context.Context, accounts []sdk.AccountI) (LegacyMigrationReport, error) {
+	return m.migrate(ctx, accounts, false)
+}
+
+// DryRun reports the migration of the legacy vesting accounts among the given accounts, without modifying
+// the state. The accounts which cannot be migrated are reported with their error.
+func (m LegacyVestingMigrator) DryRun(ctx context.Context, accounts []sdk.AccountI) (LegacyMigrationReport, error) {
+	return m.migrate(ctx, accounts, true)
+}
+
+func (m LegacyVestingMigrator) migrate(ctx context.Context, accounts []sdk.AccountI, dryRun bool) (LegacyMigrationReport, error) {
+	report := LegacyMigrationReport{}
+	for _, acc := range accounts {
+		accountType, legacyState := legacyVestingAccountType(acc)
+		if accountType == "" {
+			report.Skipped++
+			continue
+		}
+
+		addr, err := m.addressCodec.BytesToString(acc.GetAddress())
+		if err != nil {
+			return report, err
+		}
+		entry := LegacyMigrationEntry{
+			Address:          addr,
+			LegacyType:       sdk.MsgTypeURL(acc),
+			AccountType:      accountType,
+			OriginalLocking:  legacyState.OriginalLocking,
+			DelegatedFree:    legacyState.DelegatedFree,
+			DelegatedLocking: legacyState.DelegatedLocking,
+		}
+
+		err = m.migrateAccount(ctx, acc, &entry, dryRun)
+		if err != nil {
+			if !dryRun {
+				return report, fmt.Errorf("failed to migrate legacy vesting account %s: %w", addr, err)
+			}
+			entry.Error = err
+		}
+		report.Entries = append(report.Entries, entry)
+	}
+
+	return report, nil
+}
+
+func (m LegacyVestingMigrator) migrateAccount(ctx context.Context, acc sdk.AccountI, entry *LegacyMigrationEntry, dryRun bool) error {
+	owner, err := m.ownerOf(ctx, acc)
+	if err != nil {
+		return err
+	}
+	ownerBz, err := m.addressCodec.StringToBytes(owner)
+	if err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	if sdk.AccAddress(ownerBz).Equals(acc.GetAddress()) {
+		return fmt.Errorf("owner must not be the migrated account")
+	}
+	entry.Owner = owner
+
+	initMsg, err := newLegacyInitMsg(acc, owner)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		return nil
+	}
+
+	_, err = m.accountsKeeper.MigrateLegacyAccount(ctx, acc.GetAddress(), acc.GetAccountNumber(), entry.AccountType, initMsg)
+	if err != nil {
+		return err
+	}
+
+	// account is then removed from state
+	m.accountKeeper.RemoveAccount(ctx, acc)
+	return nil
+}
+
+// legacyVestingAccountType returns the lockup account type and the state of the given legacy vesting
+// account, or an empty account type if it is not a legacy vesting account.
+func legacyVestingAccountType(acc sdk.AccountI) (string, *lockuptypes.LegacyVestingState) {
+	var bva *vestingtypes.BaseVestingAccount
+	var accountType string
+	switch a := acc.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		bva, accountType = a.BaseVestingAccount, CONTINUOUS_LOCKING_ACCOUNT
+	case *vestingtypes.PeriodicVestingAccount:
+		bva, accountType = a.BaseVestingAccount, PERIODIC_LOCKING_ACCOUNT
+	case *vestingtypes.DelayedVestingAccount:
+		bva, accountType = a.BaseVestingAccount, DELAYED_LOCKING_ACCOUNT
+	case *vestingtypes.PermanentLockedAccount:
+		bva, accountType = a.BaseVestingAccount, PERMANENT_LOCKING_ACCOUNT
+	default:
+		return "", nil
+	}
+
+	return accountType, &lockuptypes.LegacyVestingState{
+		OriginalLocking:  bva.OriginalVesting,
+		DelegatedFree:    bva.DelegatedFree,
+		DelegatedLocking: bva.DelegatedVesting,
+	}
+}
+
+// newLegacyInitMsg returns the init message of the lockup account replacing the given legacy vesting account.
+func newLegacyInitMsg(acc sdk.AccountI, owner string) (transaction.Msg, error) {
+	_, legacyState := legacyVestingAccountType(acc)
+	switch a := acc.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		return &lockuptypes.MsgInitLockupAccount{
+			Owner:       owner,
+			StartTime:   time.Unix(a.StartTime, 0),
+			EndTime:     time.Unix(a.EndTime, 0),
+			LegacyState: legacyState,
+		}, nil
+	case *vestingtypes.PeriodicVestingAccount:
+		periods := make([]lockuptypes.Period, 0, len(a.VestingPeriods))
+		for _, period := range a.VestingPeriods {
+			periods = append(periods, lockuptypes.Period{
+				Length: period.Duration(),
+				Amount: period.Amount,
+			})
+		}
+		return &lockuptypes.MsgInitPeriodicLockingAccount{
+			Owner:          owner,
+			StartTime:      time.Unix(a.StartTime, 0),
+			LockingPeriods: periods,
+			LegacyState:    legacyState,
+		}, nil
+	case *vestingtypes.DelayedVestingAccount:
+		return &lockuptypes.MsgInitLockupAccount{
+			Owner:       owner,
+			EndTime:     time.Unix(a.EndTime, 0),
+			LegacyState: legacyState,
+		}, nil
+	case *vestingtypes.PermanentLockedAccount:
+		return &lockuptypes.MsgInitLockupAccount{
+			Owner:       owner,
+			LegacyState: legacyState,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported legacy vesting account %T", acc)
+	}
+}
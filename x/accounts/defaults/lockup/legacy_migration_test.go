@@ -0,0 +1,173 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type mockLegacyAccountKeeper struct {
+	removed []sdk.AccountI
+}
+
+func (m *mockLegacyAccountKeeper) RemoveAccount(_ context.Context, acc sdk.AccountI) {
+	m.removed = append(m.removed, acc)
+}
+
+type mockLegacyAccountsKeeper struct {
+	accTypes []string
+	msgs     []transaction.Msg
+}
+
+func (m *mockLegacyAccountsKeeper) MigrateLegacyAccount(_ context.Context, _ []byte, _ uint64, accType string, msg transaction.Msg) (transaction.Msg, error) {
+	m.accTypes = append(m.accTypes, accType)
+	m.msgs = append(m.msgs, msg)
+	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
+}
+
+func TestLegacyVestingMigrator(t *testing.T) {
+	ctx := context.Background()
+	coins := sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))
+
+	continuousAcc, err := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(sdk.AccAddress("continuous")), coins, 100, 200)
+	require.NoError(t, err)
+	continuousAcc.DelegatedFree = sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2)))
+	continuousAcc.DelegatedVesting = sdk.NewCoins(sdk.NewCoin("test", math.NewInt(3)))
+	periodicAcc, err := vestingtypes.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(sdk.AccAddress("periodic")), coins, 100, vestingtypes.Periods{
+		vestingtypes.Period{Length: 60, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4)))},
+		vestingtypes.Period{Length: 60, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6)))},
+	})
+	require.NoError(t, err)
+	baseAcc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress("base"))
+	accounts := []sdk.AccountI{continuousAcc, periodicAcc, baseAcc}
+
+	ak := &mockLegacyAccountKeeper{}
+	accountsKeeper := &mockLegacyAccountsKeeper{}
+	migrator := NewLegacyVestingMigrator(ak, accountsKeeper, addressCodec{}, func(_ context.Context, acc sdk.AccountI) (string, error) {
+		return "owner", nil
+	})
+
+	// the dry run does not migrate the accounts
+	report, err := migrator.DryRun(ctx, accounts)
+	require.NoError(t, err)
+	require.Len(t, report.Entries, 2)
+	require.Equal(t, 1, report.Skipped)
+	require.Equal(t, 0, report.Failed())
+	require.Equal(t, CONTINUOUS_LOCKING_ACCOUNT, report.Entries[0].AccountType)
+	require.Equal(t, "owner", report.Entries[0].Owner)
+	require.Equal(t, coins, report.Entries[0].OriginalLocking)
+	require.Equal(t, continuousAcc.DelegatedFree, report.Entries[0].DelegatedFree)
+	require.Equal(t, continuousAcc.DelegatedVesting, report.Entries[0].DelegatedLocking)
+	require.Equal(t, PERIODIC_LOCKING_ACCOUNT, report.Entries[1].AccountType)
+	require.Empty(t, accountsKeeper.msgs)
+	require.Empty(t, ak.removed)
+
+	report, err = migrator.Migrate(ctx, accounts)
+	require.NoError(t, err)
+	require.Len(t, report.Entries, 2)
+	require.Equal(t, []string{CONTINUOUS_LOCKING_ACCOUNT, PERIODIC_LOCKING_ACCOUNT}, accountsKeeper.accTypes)
+	require.Equal(t, []sdk.AccountI{continuousAcc, periodicAcc}, ak.removed)
+
+	continuousMsg := accountsKeeper.msgs[0].(*lockuptypes.MsgInitLockupAccount)
+	require.Equal(t, "owner", continuousMsg.Owner)
+	require.Equal(t, time.Unix(100, 0), continuousMsg.StartTime)
+	require.Equal(t, time.Unix(200, 0), continuousMsg.EndTime)
+	require.Equal(t, continuousAcc.DelegatedVesting, continuousMsg.LegacyState.DelegatedLocking)
+
+	periodicMsg := accountsKeeper.msgs[1].(*lockuptypes.MsgInitPeriodicLockingAccount)
+	require.Equal(t, time.Unix(100, 0), periodicMsg.StartTime)
+	require.Len(t, periodicMsg.LockingPeriods, 2)
+	require.Equal(t, time.Minute, periodicMsg.LockingPeriods[0].Length)
+
+	// the account cannot be its own owner
+	migrator = NewLegacyVestingMigrator(ak, accountsKeeper, addressCodec{}, func(_ context.Context, acc sdk.AccountI) (string, error) {
+		return string(acc.GetAddress()), nil
+	})
+	report, err = migrator.DryRun(ctx, accounts)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Failed())
+	_, err = migrator.Migrate(ctx, accounts)
+	require.Error(t, err)
+}
+
+func TestLegacyVestingStateInit(t *testing.T) {
+	legacyState := &lockuptypes.LegacyVestingState{
+		OriginalLocking:  sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10))),
+		DelegatedFree:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2))),
+		DelegatedLocking: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(3))),
+	}
+	now := time.Now()
+
+	// the legacy state can only be set by the migration
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: now,
+	})
+	acc, err := NewContinuousLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:       "owner",
+		StartTime:   now.Add(-time.Minute),
+		EndTime:     now.Add(time.Minute),
+		LegacyState: legacyState,
+	})
+	require.Error(t, err)
+
+	ctx, ss = newMockContextWithSender(t, []byte("lockup_account"), nil)
+	sdkCtx = sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: now,
+	})
+	acc, err = NewContinuousLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:       "owner",
+		StartTime:   now.Add(-time.Minute),
+		EndTime:     now.Add(time.Minute),
+		LegacyState: legacyState,
+	})
+	require.NoError(t, err)
+
+	originalLocking, err := acc.OriginalLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, originalLocking.Equal(math.NewInt(10)))
+	delFree, err := acc.DelegatedFree.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delFree.Equal(math.NewInt(2)))
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.Equal(math.NewInt(3)))
+
+	// a migrated periodic account keeps its past start time
+	ctx, ss = newMockContextWithSender(t, []byte("lockup_account"), nil)
+	sdkCtx = sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: now,
+	})
+	periodicAcc, err := NewPeriodicLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = periodicAcc.Init(sdkCtx, &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:     "owner",
+		StartTime: now.Add(-time.Minute),
+		LockingPeriods: []lockuptypes.Period{
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10))),
+				Length: time.Minute * 2,
+			},
+		},
+		LegacyState: legacyState,
+	})
+	require.NoError(t, err)
+	_, err = periodicAcc.Funder.Get(sdkCtx)
+	require.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package lockup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountsKeeper defines the expected interface of the x/accounts keeper to query the lockup accounts.
+type AccountsKeeper interface {
+	IsAccountsModuleAccount(ctx context.Context, accountAddr []byte) bool
+	AccountType(ctx context.Context, accountAddr []byte) (string, error)
+	Query(ctx context.Context, accountAddr []byte, queryRequest transaction.Msg) (transaction.Msg, error)
+}
+
+// LockedCoinsProvider provides the coins locked in the lockup accounts to the bank module, so that
+// the locked coins are rejected by the bank send path and not only by the lockup account messages.
+type LockedCoinsProvider struct {
+	accountsKeeper AccountsKeeper
+}
+
+// NewLockedCoinsProvider creates a new LockedCoinsProvider.
+func NewLockedCoinsProvider(accountsKeeper AccountsKeeper) LockedCoinsProvider {
+	return LockedCoinsProvider{accountsKeeper: accountsKeeper}
+}
+
+// LockedCoins returns the coins locked in the balance of the given account if it is a lockup account.
+// The locked coins which are delegated are not in the balance of the account, so they are excluded, while
+// the clawed back coins which are not yet sent to the admin are included.
+func (p LockedCoinsProvider) LockedCoins(ctx context.Context, addr []byte) (sdk.Coins, error) {
+	if !p.accountsKeeper.IsAccountsModuleAccount(ctx, addr) {
+		return nil, nil
+	}
+
+	accountType, err := p.accountsKeeper.AccountType(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(lockupAccountTypes, accountType) {
+		return nil, nil
+	}
+
+	resp, err := p.accountsKeeper.Query(ctx, addr, &lockuptypes.QueryLockupAccountInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	info, ok := resp.(*lockuptypes.QueryLockupAccountInfoResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected lockup account info response type %T", resp)
+	}
+
+	var locked sdk.Coins
+	for _, coin := range info.LockedCoins {
+		amount := coin.Amount.Sub(math.MinInt(coin.Amount, info.DelegatedLocking.AmountOf(coin.Denom)))
+		locked = locked.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	locked = locked.Add(info.ClawbackPending...)
+
+	return locked, nil
+}
@@ -0,0 +1,82 @@
+package lockup
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/transaction"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockAccountsKeeper struct {
+	types map[string]string
+	info  *lockuptypes.QueryLockupAccountInfoResponse
+}
+
+func (m mockAccountsKeeper) IsAccountsModuleAccount(_ context.Context, accountAddr []byte) bool {
+	_, ok := m.types[string(accountAddr)]
+	return ok
+}
+
+func (m mockAccountsKeeper) AccountType(_ context.Context, accountAddr []byte) (string, error) {
+	accountType, ok := m.types[string(accountAddr)]
+	if !ok {
+		return "", collections.ErrNotFound
+	}
+	return accountType, nil
+}
+
+func (m mockAccountsKeeper) Query(_ context.Context, _ []byte, _ transaction.Msg) (transaction.Msg, error) {
+	return m.info, nil
+}
+
+func (m mockAccountsKeeper) IterateAccountsByType(_ context.Context, accountType string, cb func([]byte) (bool, error)) error {
+	addrs := make([]string, 0, len(m.types))
+	for addr, addrType := range m.types {
+		if addrType == accountType {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+
+	for _, addr := range addrs {
+		stop, err := cb([]byte(addr))
+		if err != nil || stop {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestLockedCoinsProvider(t *testing.T) {
+	provider := NewLockedCoinsProvider(mockAccountsKeeper{
+		types: map[string]string{
+			"lockup": CONTINUOUS_LOCKING_ACCOUNT,
+			"base":   "base",
+		},
+		info: &lockuptypes.QueryLockupAccountInfoResponse{
+			LockedCoins:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("test", 10)),
+			DelegatedLocking: sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("test", 20)),
+		},
+	})
+
+	// the delegated locked coins are excluded
+	locked, err := provider.LockedCoins(context.Background(), []byte("lockup"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), locked)
+
+	// no coins are locked in other accounts
+	locked, err = provider.LockedCoins(context.Background(), []byte("base"))
+	require.NoError(t, err)
+	require.Empty(t, locked)
+
+	locked, err = provider.LockedCoins(context.Background(), []byte("unknown"))
+	require.NoError(t, err)
+	require.Empty(t, locked)
+}
@@ -11,19 +11,24 @@ import (
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/header"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+	migrationv1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
 	banktypes "cosmossdk.io/x/bank/types"
 	distrtypes "cosmossdk.io/x/distribution/types"
+	govtypes "cosmossdk.io/x/gov/types/v1"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var (
@@ -35,10 +40,21 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	UnbondEntriesPrefix    = collections.NewPrefix(7)
+	AdminPrefix            = collections.NewPrefix(8)
+	ClawbackPendingPrefix  = collections.NewPrefix(9)
+	CliffTimePrefix        = collections.NewPrefix(10)
+	PendingOwnerPrefix     = collections.NewPrefix(11)
+	AutoCompoundPrefix     = collections.NewPrefix(12)
+	FunderPrefix           = collections.NewPrefix(13)
+	DenomSchedulesPrefix   = collections.NewPrefix(14)
+	FrozenPrefix           = collections.NewPrefix(15)
+	AllowancesPrefix       = collections.NewPrefix(16)
+	UnlockedPrefix         = collections.NewPrefix(17)
 )
 
 var (
 	CONTINUOUS_LOCKING_ACCOUNT = "continuous-locking-account"
+	CLIFF_LOCKING_ACCOUNT      = "cliff-locking-account"
 	DELAYED_LOCKING_ACCOUNT    = "delayed-locking-account"
 	PERIODIC_LOCKING_ACCOUNT   = "periodic-locking-account"
 	PERMANENT_LOCKING_ACCOUNT  = "permanent-locking-account"
@@ -46,16 +62,29 @@ var (
 
 type getLockedCoinsFunc = func(ctx context.Context, time time.Time, denoms ...string) (sdk.Coins, error)
 
+// splitLockupFunc removes the given share of the remaining locked schedule from the account, and returns
+// the init message of a new account of the same type owned by newOwner, which locks the removed funds with
+// the same schedule, along with the removed funds.
+type splitLockupFunc = func(ctx context.Context, newOwner string, share math.LegacyDec) (proto.Message, sdk.Coins, error)
+
 // newBaseLockup creates a new BaseLockup object.
 func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 	BaseLockup := &BaseLockup{
 		Owner:            collections.NewItem(d.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+		PendingOwner:     collections.NewItem(d.SchemaBuilder, PendingOwnerPrefix, "pending_owner", collections.BytesValue),
+		Admin:            collections.NewItem(d.SchemaBuilder, AdminPrefix, "admin", collections.BytesValue),
 		OriginalLocking:  collections.NewMap(d.SchemaBuilder, OriginalLockingPrefix, "original_locking", collections.StringKey, sdk.IntValue),
 		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
 		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
 		UnbondEntries:    collections.NewMap(d.SchemaBuilder, UnbondEntriesPrefix, "unbond_entries", collections.StringKey, codec.CollValue[lockuptypes.UnbondingEntries](d.LegacyStateCodec)),
+		ClawbackPending:  collections.NewMap(d.SchemaBuilder, ClawbackPendingPrefix, "clawback_pending", collections.StringKey, sdk.IntValue),
+		AutoCompound:     collections.NewItem(d.SchemaBuilder, AutoCompoundPrefix, "auto_compound", collections.BoolValue),
+		Frozen:           collections.NewItem(d.SchemaBuilder, FrozenPrefix, "frozen", collections.BoolValue),
+		Allowances:       collections.NewMap(d.SchemaBuilder, AllowancesPrefix, "allowances", collections.BytesKey, codec.CollValue[lockuptypes.SpendAllowance](d.LegacyStateCodec)),
+		Unlocked:         collections.NewMap(d.SchemaBuilder, UnlockedPrefix, "unlocked", collections.StringKey, sdk.IntValue),
 		addressCodec:     d.AddressCodec,
 		headerService:    d.Environment.HeaderService,
+		eventService:     d.Environment.EventService,
 		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
 	}
 
@@ -64,14 +93,29 @@ func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 
 type BaseLockup struct {
 	// Owner is the address of the account owner.
-	Owner            collections.Item[[]byte]
+	Owner collections.Item[[]byte]
+	// PendingOwner is the address the ownership is being transferred to, if any.
+	PendingOwner collections.Item[[]byte]
+	// Admin is the address allowed to claw back the locked funds, if any.
+	Admin            collections.Item[[]byte]
 	OriginalLocking  collections.Map[string, math.Int]
 	DelegatedFree    collections.Map[string, math.Int]
 	DelegatedLocking collections.Map[string, math.Int]
 	// map val address to unbonding entries
 	UnbondEntries collections.Map[string, lockuptypes.UnbondingEntries]
+	// clawed back funds being undelegated, which are locked until they are sent to the admin
+	ClawbackPending collections.Map[string, math.Int]
+	// whether the withdrawn rewards are re-delegated to the same validator
+	AutoCompound collections.Item[bool]
+	// whether the sends are frozen by the owner
+	Frozen collections.Item[bool]
+	// map grantee address to the allowance to spend the unlocked funds granted by the owner
+	Allowances collections.Map[[]byte, lockuptypes.SpendAllowance]
+	// unlocked amounts reported by the last unlock event
+	Unlocked      collections.Map[string, math.Int]
 	addressCodec  address.Codec
 	headerService header.Service
+	eventService  event.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
 }
@@ -88,7 +132,19 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
+	err = bva.setAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
 	funds := accountstd.Funds(ctx)
+	if msg.LegacyState != nil {
+		err = checkLegacyMigration(ctx)
+		if err != nil {
+			return nil, err
+		}
+		funds = msg.LegacyState.OriginalLocking
+	}
 
 	sortedAmt := funds.Sort()
 	for _, coin := range sortedAmt {
@@ -115,6 +171,13 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
+	if msg.LegacyState != nil {
+		err = bva.setLegacyDelegations(ctx, msg.LegacyState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = bva.EndTime.Set(ctx, msg.EndTime)
 	if err != nil {
 		return nil, err
@@ -144,6 +207,12 @@ func (bva *BaseLockup) Delegate(
 	if err != nil {
 		return nil, err
 	}
+	// the clawed back funds cannot be delegated
+	clawbackPending, err := bva.getClawbackPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	available := sdk.NewCoin(balance.Denom, math.MaxInt(balance.Amount.Sub(clawbackPending.AmountOf(balance.Denom)), math.ZeroInt()))
 	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Amount.Denom)
 	if err != nil {
 		return nil, err
@@ -155,15 +224,28 @@ func (bva *BaseLockup) Delegate(
 		return nil, err
 	}
 
+	err = bva.emitUnlock(ctx, delegatorAddress, getLockedCoinsFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	delLockingBefore, err := bva.getCoinEntry(ctx, bva.DelegatedLocking, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
 	err = bva.TrackDelegation(
 		ctx,
-		sdk.Coins{*balance},
+		sdk.Coins{available},
 		lockedCoins,
 		sdk.Coins{msg.Amount},
 	)
 	if err != nil {
 		return nil, err
 	}
+	delLockingAfter, err := bva.getCoinEntry(ctx, bva.DelegatedLocking, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
 
 	msgDelegate := &stakingtypes.MsgDelegate{
 		DelegatorAddress: delegatorAddress,
@@ -175,6 +257,16 @@ func (bva *BaseLockup) Delegate(
 		return nil, err
 	}
 
+	err = bva.eventService.EventManager(ctx).Emit(&lockuptypes.EventDelegate{
+		Account:          delegatorAddress,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           msg.Amount,
+		Locked:           sdk.NewCoin(msg.Amount.Denom, delLockingAfter.Sub(delLockingBefore)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
 }
 
@@ -247,6 +339,15 @@ func (bva *BaseLockup) Undelegate(
 		return nil, err
 	}
 
+	err = bva.eventService.EventManager(ctx).Emit(&lockuptypes.EventUndelegate{
+		Account:          delegatorAddress,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
 }
 
@@ -265,60 +366,1076 @@ func (bva *BaseLockup) WithdrawReward(
 		return nil, err
 	}
 
-	msgWithdraw := &distrtypes.MsgWithdrawDelegatorReward{
-		DelegatorAddress: delegatorAddress,
-		ValidatorAddress: msg.ValidatorAddress,
+	msgWithdraw := &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: delegatorAddress,
+		ValidatorAddress: msg.ValidatorAddress,
+	}
+	responses, err := sendMessage(ctx, msgWithdraw)
+	if err != nil {
+		return nil, err
+	}
+
+	autoCompound, err := bva.AutoCompound.Get(ctx)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if autoCompound {
+		delegateResponses, err := bva.compoundRewards(ctx, delegatorAddress, msg.ValidatorAddress, responses[0])
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, delegateResponses...)
+	}
+
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: responses}, nil
+}
+
+// Vote votes on a governance proposal with the account as voter.
+func (bva *BaseLockup) Vote(
+	ctx context.Context, msg *lockuptypes.MsgVote,
+) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	voterAddress, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	msgVote := &govtypes.MsgVote{
+		ProposalId: msg.ProposalId,
+		Voter:      voterAddress,
+		Option:     msg.Option,
+		Metadata:   msg.Metadata,
+	}
+	responses, err := sendMessage(ctx, msgVote)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: responses}, nil
+}
+
+// VoteWeighted splits the vote of the account on a governance proposal between the given options.
+func (bva *BaseLockup) VoteWeighted(
+	ctx context.Context, msg *lockuptypes.MsgVoteWeighted,
+) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	voterAddress, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	msgVoteWeighted := &govtypes.MsgVoteWeighted{
+		ProposalId: msg.ProposalId,
+		Voter:      voterAddress,
+		Options:    msg.Options,
+		Metadata:   msg.Metadata,
+	}
+	responses, err := sendMessage(ctx, msgVoteWeighted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: responses}, nil
+}
+
+// compoundRewards re-delegates the withdrawn staking rewards to the validator they were withdrawn from.
+// The rewards are not locked, so they are tracked as free delegation.
+func (bva *BaseLockup) compoundRewards(
+	ctx context.Context, delegatorAddress, validatorAddress string, withdrawResponse *codectypes.Any,
+) ([]*codectypes.Any, error) {
+	msgWithdrawResp, err := accountstd.UnpackAny[distrtypes.MsgWithdrawDelegatorRewardResponse](withdrawResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := getStakingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardAmt := msgWithdrawResp.Amount.AmountOf(bondDenom)
+	if !rewardAmt.IsPositive() {
+		return nil, nil
+	}
+
+	msgDelegate := &stakingtypes.MsgDelegate{
+		DelegatorAddress: delegatorAddress,
+		ValidatorAddress: validatorAddress,
+		Amount:           sdk.NewCoin(bondDenom, rewardAmt),
+	}
+	responses, err := sendMessage(ctx, msgDelegate)
+	if err != nil {
+		return nil, err
+	}
+
+	delFreeAmt, err := bva.DelegatedFree.Get(ctx, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+	err = bva.DelegatedFree.Set(ctx, bondDenom, delFreeAmt.Add(rewardAmt))
+	if err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+}
+
+// SetAutoCompound enables or disables the re-delegation of the rewards withdrawn with WithdrawReward.
+func (bva *BaseLockup) SetAutoCompound(
+	ctx context.Context, msg *lockuptypes.MsgSetAutoCompound,
+) (
+	*lockuptypes.MsgSetAutoCompoundResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.AutoCompound.Set(ctx, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgSetAutoCompoundResponse{}, nil
+}
+
+// SetFrozen freezes or unfreezes the sends of the lockup account. While the account is frozen, its funds
+// cannot be sent, but the staking rewards can still be withdrawn and the ownership can be transferred.
+func (bva *BaseLockup) SetFrozen(
+	ctx context.Context, msg *lockuptypes.MsgSetFrozen,
+) (
+	*lockuptypes.MsgSetFrozenResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.Frozen.Set(ctx, msg.Frozen)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgSetFrozenResponse{}, nil
+}
+
+// GrantAllowance grants the grantee an allowance to spend the unlocked funds of the lockup account, replacing
+// its previous allowance if any.
+func (bva *BaseLockup) GrantAllowance(
+	ctx context.Context, msg *lockuptypes.MsgGrantAllowance,
+) (
+	*lockuptypes.MsgGrantAllowanceResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := bva.addressCodec.StringToBytes(msg.Allowance.Grantee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if err := msg.Allowance.SpendLimit.Validate(); err != nil {
+		return nil, err
+	}
+	hs := bva.headerService.HeaderInfo(ctx)
+	if msg.Allowance.Expiration != nil && !msg.Allowance.Expiration.After(hs.Time) {
+		return nil, errors.New("allowance expiration must be after the current block time")
+	}
+
+	err = bva.Allowances.Set(ctx, grantee, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgGrantAllowanceResponse{}, nil
+}
+
+// RevokeAllowance revokes the allowance of the grantee.
+func (bva *BaseLockup) RevokeAllowance(
+	ctx context.Context, msg *lockuptypes.MsgRevokeAllowance,
+) (
+	*lockuptypes.MsgRevokeAllowanceResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := bva.addressCodec.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	has, err := bva.Allowances.Has(ctx, grantee)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no allowance granted to %s", msg.Grantee)
+	}
+
+	err = bva.Allowances.Remove(ctx, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgRevokeAllowanceResponse{}, nil
+}
+
+// SpendAllowance sends the unlocked funds of the lockup account on behalf of the grantee of an allowance, and
+// deducts them from its spend limit.
+func (bva *BaseLockup) SpendAllowance(
+	ctx context.Context, msg *lockuptypes.MsgSpendAllowance, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	grantee, err := bva.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	allowance, err := bva.Allowances.Get(ctx, grantee)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return nil, errors.New("no allowance granted to sender")
+		}
+		return nil, err
+	}
+	hs := bva.headerService.HeaderInfo(ctx)
+	if allowance.Expiration != nil && !allowance.Expiration.After(hs.Time) {
+		return nil, errors.New("allowance is expired")
+	}
+	err = bva.checkNotFrozen(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return nil, err
+	}
+
+	var remaining sdk.Coins
+	if !allowance.SpendLimit.Empty() {
+		var hasNeg bool
+		remaining, hasNeg = allowance.SpendLimit.SafeSub(msg.Amount...)
+		if hasNeg {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
+				"allowance spend limit %s is smaller than %s", allowance.SpendLimit, msg.Amount)
+		}
+	}
+
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Amount.Denoms()...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.checkTokensSendable(ctx, fromAddress, msg.Amount, lockedCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.emitUnlock(ctx, fromAddress, getLockedCoinsFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowance.SpendLimit.Empty() {
+		if remaining.IsZero() {
+			err = bva.Allowances.Remove(ctx, grantee)
+		} else {
+			allowance.SpendLimit = remaining
+			err = bva.Allowances.Set(ctx, grantee, allowance)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: fromAddress,
+		ToAddress:   msg.ToAddress,
+		Amount:      msg.Amount,
+	}
+	resp, err := sendMessage(ctx, msgSend)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.emitWithdraw(ctx, fromAddress, msg.Sender, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
+}
+
+func (bva *BaseLockup) SendCoins(
+	ctx context.Context, msg *lockuptypes.MsgSend, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	err = bva.checkNotFrozen(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+
+	if err := msg.Amount.Validate(); err != nil {
+		return nil, err
+	}
+
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Amount.Denoms()...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.checkTokensSendable(ctx, fromAddress, msg.Amount, lockedCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.emitUnlock(ctx, fromAddress, getLockedCoinsFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: fromAddress,
+		ToAddress:   msg.ToAddress,
+		Amount:      msg.Amount,
+	}
+	resp, err := sendMessage(ctx, msgSend)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.emitWithdraw(ctx, fromAddress, msg.Sender, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
+}
+
+// MultiSendCoins sends the unlocked funds of the lockup account to multiple recipients in one execution.
+// The total sent amount is bounded by the spendable funds of the account.
+func (bva *BaseLockup) MultiSendCoins(
+	ctx context.Context, msg *lockuptypes.MsgMultiSend, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	err = bva.checkNotFrozen(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.Outputs) == 0 {
+		return nil, errors.New("no outputs to send to")
+	}
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdk.NewCoins()
+	for _, output := range msg.Outputs {
+		if _, err := bva.addressCodec.StringToBytes(output.Address); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid output address: %s", err)
+		}
+		if err := output.Amount.Validate(); err != nil {
+			return nil, err
+		}
+		if output.Amount.IsZero() {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("empty output amount for %s", output.Address)
+		}
+		total = total.Add(output.Amount...)
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, total.Denoms()...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.checkTokensSendable(ctx, fromAddress, total, lockedCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.emitUnlock(ctx, fromAddress, getLockedCoinsFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*codectypes.Any, 0, len(msg.Outputs))
+	for _, output := range msg.Outputs {
+		asAny, err := accountstd.PackAny(&banktypes.MsgSend{
+			FromAddress: fromAddress,
+			ToAddress:   output.Address,
+			Amount:      output.Amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, asAny)
+	}
+	resp, err := accountstd.ExecModuleAnys(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, output := range msg.Outputs {
+		err = bva.emitWithdraw(ctx, fromAddress, msg.Sender, output.Address, output.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
+}
+
+// IBCTransfer transfers the unlocked funds of the lockup account to another chain with an ICS-20 transfer.
+// The transferred amount is bounded by the spendable funds of the account.
+func (bva *BaseLockup) IBCTransfer(
+	ctx context.Context, msg *lockuptypes.MsgIBCTransfer, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	err = bva.checkNotFrozen(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.SourcePort == "" || msg.SourceChannel == "" {
+		return nil, errors.New("source port and channel must be set")
+	}
+	if msg.Receiver == "" {
+		return nil, errors.New("receiver must be set")
+	}
+	if !msg.Token.IsValid() || msg.Token.IsZero() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid token %s", msg.Token)
+	}
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Token.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.NewCoins(msg.Token)
+	err = bva.checkTokensSendable(ctx, fromAddress, amount, lockedCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.emitUnlock(ctx, fromAddress, getLockedCoinsFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := newICS20TransferAny(fromAddress, msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := accountstd.ExecModuleAnys(ctx, []*codectypes.Any{transfer})
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.emitWithdraw(ctx, fromAddress, msg.Sender, msg.Receiver, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
+}
+
+// ClawbackFunds claws back the locked funds of the lockup account to the recipient, on behalf of its
+// admin, and terminates the lockup of the clawed back denoms so that their remaining funds are
+// unlocked. The locked funds which are delegated are left to the owner, unless IncludeDelegated is
+// set, in which case they are undelegated and kept locked until a later clawback sends them to the
+// recipient once their unbonding completes. clearLockingFunc, if set, clears the locking schedule of
+// a denom specific to the lockup account type.
+func (bva *BaseLockup) ClawbackFunds(
+	ctx context.Context, msg *lockuptypes.MsgClawback, getLockedCoinsFunc getLockedCoinsFunc,
+	clearLockingFunc func(ctx context.Context, denom string) error,
+) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	err := bva.checkAdmin(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := bva.addressCodec.StringToBytes(msg.Recipient); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	whoami := accountstd.Whoami(ctx)
+	accAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	// refresh ubd entries to make sure delegation locking amount is up to date
+	err = bva.checkUnbondingEntriesMature(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := msg.Denoms
+	if len(denoms) == 0 {
+		err = bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(denom string, _ math.Int) (bool, error) {
+			denoms = append(denoms, denom)
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, denoms...)
+	if err != nil {
+		return nil, err
+	}
+
+	// report the coins unlocked before the lockup is terminated
+	err = bva.emitUnlock(ctx, accAddress, getLockedCoinsFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := getStakingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// first send the clawed back funds whose unbonding completed
+	clawback := sdk.Coins{}
+	clawbackPending, err := bva.getClawbackPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, coin := range clawbackPending {
+		balance, err := bva.getBalance(ctx, accAddress, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		amt := math.MinInt(coin.Amount, balance.Amount)
+		if remaining := coin.Amount.Sub(amt); remaining.IsZero() {
+			err = bva.ClawbackPending.Remove(ctx, coin.Denom)
+		} else {
+			err = bva.ClawbackPending.Set(ctx, coin.Denom, remaining)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		clawback = clawback.Add(sdk.NewCoin(coin.Denom, amt))
+	}
+
+	pending := sdk.Coins{}
+	for _, denom := range denoms {
+		lockedAmt := lockedCoins.AmountOf(denom)
+
+		if denom == bondDenom {
+			delLockingAmt, err := bva.DelegatedLocking.Get(ctx, bondDenom)
+			if err != nil {
+				return nil, err
+			}
+			delFreeAmt, err := bva.DelegatedFree.Get(ctx, bondDenom)
+			if err != nil {
+				return nil, err
+			}
+
+			delegatedLockedAmt := math.MinInt(lockedAmt, delLockingAmt)
+			lockedAmt = lockedAmt.Sub(delegatedLockedAmt)
+
+			if msg.IncludeDelegated && delegatedLockedAmt.IsPositive() {
+				undelegated, err := bva.undelegateForClawback(ctx, accAddress, sdk.NewCoin(bondDenom, delegatedLockedAmt))
+				if err != nil {
+					return nil, err
+				}
+				pending = pending.Add(undelegated)
+				delLockingAmt = delLockingAmt.Sub(delegatedLockedAmt)
+			}
+
+			// the lockup is terminated, so the remaining delegated locking funds are now free
+			err = bva.DelegatedFree.Set(ctx, bondDenom, delFreeAmt.Add(delLockingAmt))
+			if err != nil {
+				return nil, err
+			}
+			err = bva.DelegatedLocking.Set(ctx, bondDenom, math.ZeroInt())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if lockedAmt.IsPositive() {
+			balance, err := bva.getBalance(ctx, accAddress, denom)
+			if err != nil {
+				return nil, err
+			}
+			available := balance.Amount.Sub(clawback.AmountOf(denom))
+			clawback = clawback.Add(sdk.NewCoin(denom, math.MinInt(lockedAmt, math.MaxInt(available, math.ZeroInt()))))
+		}
+
+		// clear the lock tokens tracking
+		err = bva.OriginalLocking.Set(ctx, denom, math.ZeroInt())
+		if err != nil {
+			return nil, err
+		}
+		if clearLockingFunc != nil {
+			err = clearLockingFunc(ctx, denom)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, coin := range pending {
+		err = bva.ClawbackPending.Set(ctx, coin.Denom, clawbackPending.AmountOf(coin.Denom).Add(coin.Amount))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if clawback.IsZero() && pending.IsZero() {
+		return nil, errors.New("no funds to claw back")
+	}
+
+	if !clawback.IsZero() {
+		msgSend := &banktypes.MsgSend{
+			FromAddress: accAddress,
+			ToAddress:   msg.Recipient,
+			Amount:      clawback,
+		}
+		if _, err := sendMessage(ctx, msgSend); err != nil {
+			return nil, err
+		}
+	}
+
+	err = bva.eventService.EventManager(ctx).Emit(&lockuptypes.EventClawback{
+		Account:   accAddress,
+		Recipient: msg.Recipient,
+		Amount:    clawback,
+		Pending:   pending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgClawbackResponse{Amount: clawback, Pending: pending}, nil
+}
+
+// undelegateForClawback undelegates the given amount from the delegations of the account for a
+// clawback. The undelegations are not tracked as unbonding entries, as the undelegated funds are not
+// returned to the owner.
+func (bva *BaseLockup) undelegateForClawback(ctx context.Context, delAddr string, amount sdk.Coin) (sdk.Coin, error) {
+	resp, err := accountstd.QueryModule[*stakingtypes.QueryDelegatorDelegationsResponse](
+		ctx, &stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: delAddr},
+	)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	undelegated := sdk.NewCoin(amount.Denom, math.ZeroInt())
+	remaining := amount.Amount
+	for _, delegation := range resp.DelegationResponses {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		amt := math.MinInt(remaining, delegation.Balance.Amount)
+		if !amt.IsPositive() {
+			continue
+		}
+
+		responses, err := sendMessage(ctx, &stakingtypes.MsgUndelegate{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: delegation.Delegation.ValidatorAddress,
+			Amount:           sdk.NewCoin(amount.Denom, amt),
+		})
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		msgUndelegateResp, err := accountstd.UnpackAny[stakingtypes.MsgUndelegateResponse](responses[0])
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		undelegated = undelegated.Add(msgUndelegateResp.Amount)
+		remaining = remaining.Sub(amt)
+	}
+
+	return undelegated, nil
+}
+
+// TransferOwnership transfers the ownership of the account to a new owner. With a two step transfer,
+// the new owner becomes the pending owner until it accepts the ownership with AcceptOwnership.
+func (bva *BaseLockup) TransferOwnership(
+	ctx context.Context, msg *lockuptypes.MsgTransferOwnership,
+) (
+	*lockuptypes.MsgTransferOwnershipResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	newOwner, err := bva.addressCodec.StringToBytes(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'new_owner' address: %s", err)
+	}
+	owner, err := bva.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(owner, newOwner) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("new owner is already the owner of this lockup account")
+	}
+
+	if msg.TwoStep {
+		err = bva.PendingOwner.Set(ctx, newOwner)
+	} else {
+		err = bva.setOwner(ctx, newOwner)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgTransferOwnershipResponse{}, nil
+}
+
+// AcceptOwnership completes a two step ownership transfer, making the pending owner the owner of the account.
+func (bva *BaseLockup) AcceptOwnership(
+	ctx context.Context, msg *lockuptypes.MsgAcceptOwnership,
+) (
+	*lockuptypes.MsgAcceptOwnershipResponse, error,
+) {
+	pendingOwner, err := bva.PendingOwner.Get(ctx)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return nil, errors.New("no pending ownership transfer for this lockup account")
+		}
+		return nil, err
+	}
+	senderBytes, err := bva.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+	if !bytes.Equal(pendingOwner, senderBytes) {
+		return nil, errors.New("sender is not the pending owner of this lockup account")
+	}
+
+	err = bva.setOwner(ctx, pendingOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgAcceptOwnershipResponse{}, nil
+}
+
+// setOwner sets the owner of the account, cancelling any pending ownership transfer.
+func (bva *BaseLockup) setOwner(ctx context.Context, owner []byte) error {
+	err := bva.Owner.Set(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	return bva.PendingOwner.Remove(ctx)
+}
+
+// SplitLockup moves a share of the remaining locked funds of the account to a new account of the given
+// type, created with the init message returned by splitFunc.
+func (bva *BaseLockup) SplitLockup(
+	ctx context.Context, msg *lockuptypes.MsgSplitLockup, accountType string,
+	getLockedCoinsFunc getLockedCoinsFunc, splitFunc splitLockupFunc,
+) (
+	*lockuptypes.MsgSplitLockupResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := bva.addressCodec.StringToBytes(msg.NewOwner); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'new_owner' address: %s", err)
+	}
+	if msg.Share.IsNil() || !msg.Share.IsPositive() || msg.Share.GT(math.LegacyOneDec()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid share %s, must be in (0, 1]", msg.Share)
+	}
+	whoami := accountstd.Whoami(ctx)
+	accAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	var denoms []string
+	err = bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(denom string, _ math.Int) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, denoms...)
+	if err != nil {
+		return nil, err
+	}
+
+	initMsg, funds, err := splitFunc(ctx, msg.NewOwner, msg.Share)
+	if err != nil {
+		return nil, err
+	}
+	if funds.IsZero() {
+		return nil, errors.New("no locked funds to split")
+	}
+
+	// only the locked funds which are not delegated can be moved
+	for _, coin := range funds {
+		notBondedLockedCoin, err := bva.GetNotBondedLockedCoin(ctx, sdk.NewCoin(coin.Denom, lockedCoins.AmountOf(coin.Denom)), coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if notBondedLockedCoin.Amount.LT(coin.Amount) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
+				"locked amount not delegated is smaller than the split amount: %s < %s", notBondedLockedCoin, coin)
+		}
+	}
+
+	initMsgAny, err := accountstd.PackAny(initMsg)
+	if err != nil {
+		return nil, err
+	}
+	responses, err := sendMessage(ctx, &accountsv1.MsgInit{
+		Sender:      accAddress,
+		AccountType: accountType,
+		Message:     initMsgAny,
+		Funds:       funds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msgInitResp, err := accountstd.UnpackAny[accountsv1.MsgInitResponse](responses[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgSplitLockupResponse{
+		AccountAddress: msgInitResp.AccountAddress,
+		Amount:         funds,
+	}, nil
+}
+
+// splitOriginalLocking removes the given share of the original locking of each denom, and returns the
+// resulting decrease of the locked coins at the current block time. It can be used by the account types
+// whose locked coins are proportional to the original locking.
+func (bva *BaseLockup) splitOriginalLocking(
+	ctx context.Context, share math.LegacyDec, getLockedCoinsFunc getLockedCoinsFunc,
+) (sdk.Coins, error) {
+	originalLocking := sdk.Coins{}
+	err := bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(denom string, value math.Int) (bool, error) {
+		originalLocking = append(originalLocking, sdk.NewCoin(denom, value))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	denoms := make([]string, 0, len(originalLocking))
+	for _, coin := range originalLocking {
+		denoms = append(denoms, coin.Denom)
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+	lockedBefore, err := getLockedCoinsFunc(ctx, hs.Time, denoms...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, coin := range originalLocking {
+		removed := math.LegacyNewDecFromInt(coin.Amount).Mul(share).TruncateInt()
+		err = bva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount.Sub(removed))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lockedAfter, err := getLockedCoinsFunc(ctx, hs.Time, denoms...)
+	if err != nil {
+		return nil, err
+	}
+
+	split := sdk.Coins{}
+	for _, denom := range denoms {
+		split = split.Add(sdk.NewCoin(denom, lockedBefore.AmountOf(denom).Sub(lockedAfter.AmountOf(denom))))
+	}
+
+	return split, nil
+}
+
+// getAdminAddress returns the address of the admin of the account, empty if there is none.
+func (bva BaseLockup) getAdminAddress(ctx context.Context) (string, error) {
+	admin, err := bva.Admin.Get(ctx)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return bva.addressCodec.BytesToString(admin)
+}
+
+func (bva *BaseLockup) setAdmin(ctx context.Context, admin string) error {
+	if admin == "" {
+		return nil
+	}
+
+	adminBytes, err := bva.addressCodec.StringToBytes(admin)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'admin' address: %s", err)
+	}
+
+	return bva.Admin.Set(ctx, adminBytes)
+}
+
+func (bva *BaseLockup) checkAdmin(ctx context.Context, sender string) error {
+	admin, err := bva.Admin.Get(ctx)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return errors.New("clawback is disabled for this lockup account")
+		}
+		return err
 	}
-	responses, err := sendMessage(ctx, msgWithdraw)
+	senderBytes, err := bva.addressCodec.StringToBytes(sender)
 	if err != nil {
-		return nil, err
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+	if !bytes.Equal(admin, senderBytes) {
+		return errors.New("sender is not the admin of this lockup account")
 	}
 
-	return &lockuptypes.MsgExecuteMessagesResponse{Responses: responses}, nil
+	return nil
 }
 
-func (bva *BaseLockup) SendCoins(
-	ctx context.Context, msg *lockuptypes.MsgSend, getLockedCoinsFunc getLockedCoinsFunc,
-) (
-	*lockuptypes.MsgExecuteMessagesResponse, error,
-) {
-	err := bva.checkSender(ctx, msg.Sender)
+// getClawbackPending returns the clawed back funds being undelegated.
+func (bva BaseLockup) getClawbackPending(ctx context.Context) (sdk.Coins, error) {
+	pending := sdk.Coins{}
+	err := bva.IterateCoinEntries(ctx, bva.ClawbackPending, func(denom string, value math.Int) (bool, error) {
+		pending = append(pending, sdk.NewCoin(denom, value))
+		return false, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	whoami := accountstd.Whoami(ctx)
-	fromAddress, err := bva.addressCodec.BytesToString(whoami)
-	if err != nil {
-		return nil, err
+
+	return pending, nil
+}
+
+// checkLegacyMigration checks the account is initialized by the migration of a legacy x/auth vesting account,
+// in which case the sender is the account itself and no funds are sent as the account already owns them.
+func checkLegacyMigration(ctx context.Context) error {
+	if !accountstd.SenderIsSelf(ctx) || !accountstd.Funds(ctx).IsZero() {
+		return errors.New("legacy state can only be set when migrating a legacy vesting account")
 	}
 
-	hs := bva.headerService.HeaderInfo(ctx)
+	return nil
+}
 
-	if err := msg.Amount.Validate(); err != nil {
-		return nil, err
+// MigrateIn completes the migration of an account of another type to a lockup account, keeping its address
+// and balances. Unless the original locking was set from a legacy state in the init message, the whole
+// balance of the account is locked. The delegations of the account are not locked, and can be tracked
+// with ReconcileDelegations.
+func (bva *BaseLockup) MigrateIn(ctx context.Context, _ *migrationv1.MsgMigrateIn) (*migrationv1.MsgMigrateInResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
 	}
 
-	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Amount.Denoms()...)
+	hasOriginalLocking := false
+	err := bva.OriginalLocking.Walk(ctx, nil, func(_ string, _ math.Int) (bool, error) {
+		hasOriginalLocking = true
+		return true, nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	if hasOriginalLocking {
+		return &migrationv1.MsgMigrateInResponse{}, nil
+	}
 
-	err = bva.checkTokensSendable(ctx, fromAddress, msg.Amount, lockedCoins)
+	addr, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
 	if err != nil {
 		return nil, err
 	}
-
-	msgSend := &banktypes.MsgSend{
-		FromAddress: fromAddress,
-		ToAddress:   msg.ToAddress,
-		Amount:      msg.Amount,
-	}
-	resp, err := sendMessage(ctx, msgSend)
+	balances, err := bva.getAllBalances(ctx, addr)
 	if err != nil {
 		return nil, err
 	}
+	for _, coin := range balances {
+		err = bva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
+	return &migrationv1.MsgMigrateInResponse{}, nil
+}
+
+// setLegacyDelegations sets the delegation tracking of an account migrated from a legacy x/auth vesting account.
+func (bva *BaseLockup) setLegacyDelegations(ctx context.Context, state *lockuptypes.LegacyVestingState) error {
+	for _, coin := range state.DelegatedFree {
+		err := bva.DelegatedFree.Set(ctx, coin.Denom, coin.Amount)
+		if err != nil {
+			return err
+		}
+	}
+	for _, coin := range state.DelegatedLocking {
+		err := bva.DelegatedLocking.Set(ctx, coin.Denom, coin.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (bva *BaseLockup) checkSender(ctx context.Context, sender string) error {
@@ -337,6 +1454,83 @@ func (bva *BaseLockup) checkSender(ctx context.Context, sender string) error {
 	return nil
 }
 
+// checkNotFrozen returns an error if the sends of the lockup account are frozen by its owner.
+func (bva *BaseLockup) checkNotFrozen(ctx context.Context) error {
+	frozen, err := bva.Frozen.Get(ctx)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	if frozen {
+		return errors.New("lockup account is frozen")
+	}
+
+	return nil
+}
+
+// emitUnlock emits an EventUnlock with the coins unlocked since the previous event, if any. The coins unlock
+// over time without any transaction, so they are reported by the next execution of the account.
+func (bva *BaseLockup) emitUnlock(ctx context.Context, account string, getLockedCoinsFunc getLockedCoinsFunc) error {
+	var denoms []string
+	err := bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(denom string, _ math.Int) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, denoms...)
+	if err != nil {
+		return err
+	}
+
+	newlyUnlocked := sdk.NewCoins()
+	unlocked := sdk.NewCoins()
+	for _, denom := range denoms {
+		originalLocking, err := bva.OriginalLocking.Get(ctx, denom)
+		if err != nil {
+			return err
+		}
+		unlockedAmt := math.MaxInt(originalLocking.Sub(lockedCoins.AmountOf(denom)), math.ZeroInt())
+		unlocked = unlocked.Add(sdk.NewCoin(denom, unlockedAmt))
+
+		reported, err := bva.getCoinEntry(ctx, bva.Unlocked, denom)
+		if err != nil {
+			return err
+		}
+		if unlockedAmt.LTE(reported) {
+			continue
+		}
+
+		err = bva.Unlocked.Set(ctx, denom, unlockedAmt)
+		if err != nil {
+			return err
+		}
+		newlyUnlocked = newlyUnlocked.Add(sdk.NewCoin(denom, unlockedAmt.Sub(reported)))
+	}
+
+	if newlyUnlocked.IsZero() {
+		return nil
+	}
+
+	return bva.eventService.EventManager(ctx).Emit(&lockuptypes.EventUnlock{
+		Account:  account,
+		Amount:   newlyUnlocked,
+		Unlocked: unlocked,
+	})
+}
+
+// emitWithdraw emits an EventWithdraw for coins sent from the account by the sender.
+func (bva *BaseLockup) emitWithdraw(ctx context.Context, account, sender, recipient string, amount sdk.Coins) error {
+	return bva.eventService.EventManager(ctx).Emit(&lockuptypes.EventWithdraw{
+		Account:   account,
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+	})
+}
+
 func sendMessage(ctx context.Context, msg proto.Message) ([]*codectypes.Any, error) {
 	asAny, err := accountstd.PackAny(msg)
 	if err != nil {
@@ -547,6 +1741,135 @@ func (bva *BaseLockup) TrackUndelegation(ctx context.Context, amount sdk.Coins)
 	return nil
 }
 
+// ReconcileDelegations re-syncs the tracked delegations with the actual delegations of the account. The
+// delegated amount can decrease without an undelegation when a validator is slashed, the missing amount
+// is then removed from the tracked delegations as for an undelegation, free delegations first.
+func (bva *BaseLockup) ReconcileDelegations(
+	ctx context.Context, msg *lockuptypes.MsgReconcileDelegations,
+) (
+	*lockuptypes.MsgReconcileDelegationsResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	whoami := accountstd.Whoami(ctx)
+	delegatorAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	// refresh ubd entries to make sure the tracked unbonding amount is up to date
+	err = bva.checkUnbondingEntriesMature(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := getStakingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tracked delegations include the amount being unbonded
+	delegatedAmt, err := bva.getDelegatedAmount(ctx, delegatorAddress, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+	err = bva.UnbondEntries.Walk(ctx, nil, func(_ string, value lockuptypes.UnbondingEntries) (stop bool, err error) {
+		for _, entry := range value.Entries {
+			delegatedAmt = delegatedAmt.Add(entry.Amount.Amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delLockingAmt, err := bva.DelegatedLocking.Get(ctx, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+	delFreeAmt, err := bva.DelegatedFree.Get(ctx, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := sdk.Coins{}
+	if excess := delLockingAmt.Add(delFreeAmt).Sub(delegatedAmt); excess.IsPositive() {
+		removed = sdk.NewCoins(sdk.NewCoin(bondDenom, excess))
+		err = bva.TrackUndelegation(ctx, removed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lockuptypes.MsgReconcileDelegationsResponse{Removed: removed}, nil
+}
+
+// getDelegatedAmount returns the total amount delegated by the account.
+func (bva BaseLockup) getDelegatedAmount(ctx context.Context, delAddr, bondDenom string) (math.Int, error) {
+	delegations, err := bva.getDelegations(ctx, delAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	total := math.ZeroInt()
+	for _, delegation := range delegations {
+		if delegation.Balance.Denom == bondDenom {
+			total = total.Add(delegation.Balance.Amount)
+		}
+	}
+	return total, nil
+}
+
+// getDelegations returns all the delegations of the account.
+func (bva BaseLockup) getDelegations(ctx context.Context, delAddr string) ([]stakingtypes.DelegationResponse, error) {
+	var delegations []stakingtypes.DelegationResponse
+	var nextKey []byte
+	for {
+		resp, err := accountstd.QueryModule[*stakingtypes.QueryDelegatorDelegationsResponse](
+			ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
+				DelegatorAddr: delAddr,
+				Pagination:    &query.PageRequest{Key: nextKey},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		delegations = append(delegations, resp.DelegationResponses...)
+
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			return delegations, nil
+		}
+		nextKey = resp.Pagination.NextKey
+	}
+}
+
+// getAllBalances returns all the balances of the account.
+func (bva BaseLockup) getAllBalances(ctx context.Context, addr string) (sdk.Coins, error) {
+	balances := sdk.NewCoins()
+	var nextKey []byte
+	for {
+		resp, err := accountstd.QueryModule[*banktypes.QueryAllBalancesResponse](
+			ctx, &banktypes.QueryAllBalancesRequest{
+				Address:    addr,
+				Pagination: &query.PageRequest{Key: nextKey},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = balances.Add(resp.Balances...)
+
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			return balances, nil
+		}
+		nextKey = resp.Pagination.NextKey
+	}
+}
+
 func (bva BaseLockup) getBalance(ctx context.Context, sender, denom string) (*sdk.Coin, error) {
 	// Query account balance for the sent denom
 	resp, err := accountstd.QueryModule[*banktypes.QueryBalanceResponse](ctx, &banktypes.QueryBalanceRequest{Address: sender, Denom: denom})
@@ -569,6 +1892,11 @@ func (bva BaseLockup) getUnbondingEntries(ctx context.Context, delAddr, valAddr
 }
 
 func (bva BaseLockup) checkTokensSendable(ctx context.Context, sender string, amount, lockedCoins sdk.Coins) error {
+	clawbackPending, err := bva.getClawbackPending(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Check if any sent tokens is exceeds lockup account balances
 	for _, coin := range amount {
 		balance, err := bva.getBalance(ctx, sender, coin.Denom)
@@ -582,6 +1910,8 @@ func (bva BaseLockup) checkTokensSendable(ctx context.Context, sender string, am
 		if err != nil {
 			return err
 		}
+		// the clawed back funds being undelegated cannot be sent either
+		notBondedLockedCoin = notBondedLockedCoin.AddAmount(clawbackPending.AmountOf(coin.Denom))
 
 		spendable, hasNeg := sdk.Coins{*balance}.SafeSub(notBondedLockedCoin)
 		if hasNeg {
@@ -691,8 +2021,45 @@ func (bva BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, _ *lockupt
 	}
 	delegatedFree := sdk.NewCoins(sdk.NewCoin(bondDenom, delegatedFreeAmt))
 
+	adminAddress, err := bva.getAdminAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingOwnerAddress string
+	pendingOwner, err := bva.PendingOwner.Get(ctx)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if len(pendingOwner) > 0 {
+		pendingOwnerAddress, err = bva.addressCodec.BytesToString(pendingOwner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	clawbackPending, err := bva.getClawbackPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	autoCompound, err := bva.AutoCompound.Get(ctx)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	frozen, err := bva.Frozen.Get(ctx)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
 	return &lockuptypes.QueryLockupAccountInfoResponse{
 		Owner:            ownerAddress,
+		PendingOwner:     pendingOwnerAddress,
+		AutoCompound:     autoCompound,
+		Frozen:           frozen,
+		Admin:            adminAddress,
+		ClawbackPending:  clawbackPending,
 		OriginalLocking:  originalLocking,
 		DelegatedLocking: delegatedLocking,
 		DelegatedFree:    delegatedFree,
@@ -719,6 +2086,77 @@ func (bva BaseLockup) QueryUnbondingEntries(ctx context.Context, req *lockuptype
 	}, nil
 }
 
+// QueryAllowances returns the spend allowances granted by the owner of the account.
+func (bva BaseLockup) QueryAllowances(ctx context.Context, _ *lockuptypes.QueryAllowancesRequest) (
+	*lockuptypes.QueryAllowancesResponse, error,
+) {
+	allowances := []lockuptypes.SpendAllowance{}
+	err := bva.Allowances.Walk(ctx, nil, func(_ []byte, allowance lockuptypes.SpendAllowance) (bool, error) {
+		allowances = append(allowances, allowance)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.QueryAllowancesResponse{Allowances: allowances}, nil
+}
+
+// QueryDelegations returns the delegations of the account by validator, with their locked and free parts.
+// The locked and free delegated amounts are only tracked in total, so they are split between the delegations
+// in proportion to their balance.
+func (bva BaseLockup) QueryDelegations(ctx context.Context, _ *lockuptypes.QueryDelegationsRequest) (
+	*lockuptypes.QueryDelegationsResponse, error,
+) {
+	delegatorAddress, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	delegations, err := bva.getDelegations(ctx, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lockuptypes.QueryDelegationsResponse{
+		Delegations: make([]lockuptypes.DelegationLockingInfo, 0, len(delegations)),
+	}
+	for _, delegation := range delegations {
+		denom := delegation.Balance.Denom
+		delLockingAmt, err := bva.getCoinEntry(ctx, bva.DelegatedLocking, denom)
+		if err != nil {
+			return nil, err
+		}
+		delFreeAmt, err := bva.getCoinEntry(ctx, bva.DelegatedFree, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		lockedAmt := math.ZeroInt()
+		if delegated := delLockingAmt.Add(delFreeAmt); delegated.IsPositive() {
+			lockedAmt = delegation.Balance.Amount.Mul(delLockingAmt).Quo(delegated)
+		}
+		locked := sdk.NewCoin(denom, lockedAmt)
+
+		resp.Delegations = append(resp.Delegations, lockuptypes.DelegationLockingInfo{
+			ValidatorAddress: delegation.Delegation.ValidatorAddress,
+			Balance:          delegation.Balance,
+			Locked:           locked,
+			Free:             delegation.Balance.Sub(locked),
+		})
+	}
+
+	return resp, nil
+}
+
+// getCoinEntry returns the amount of the given denom in the given coin entries, or zero if not set.
+func (bva BaseLockup) getCoinEntry(ctx context.Context, entries collections.Map[string, math.Int], denom string) (math.Int, error) {
+	amt, err := entries.Get(ctx, denom)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return amt, err
+}
+
 func (bva BaseLockup) QuerySpendableTokens(ctx context.Context, lockedCoins sdk.Coins) (
 	*lockuptypes.QuerySpendableAmountResponse, error,
 ) {
@@ -728,6 +2166,11 @@ func (bva BaseLockup) QuerySpendableTokens(ctx context.Context, lockedCoins sdk.
 		return nil, err
 	}
 
+	clawbackPending, err := bva.getClawbackPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	spendables := sdk.Coins{}
 	for _, denom := range lockedCoins.Denoms() {
 		balance, err := bva.getBalance(ctx, accAddr, denom)
@@ -742,6 +2185,7 @@ func (bva BaseLockup) QuerySpendableTokens(ctx context.Context, lockedCoins sdk.
 		if err != nil {
 			return nil, err
 		}
+		notBondedLockedCoin = notBondedLockedCoin.AddAmount(clawbackPending.AmountOf(balance.Denom))
 
 		spendable, hasNeg := sdk.Coins{*balance}.SafeSub(notBondedLockedCoin)
 		if hasNeg {
@@ -756,11 +2200,56 @@ func (bva BaseLockup) QuerySpendableTokens(ctx context.Context, lockedCoins sdk.
 	}, nil
 }
 
+// QueryUnlockSchedule returns the unlock schedule with the cumulative unlocked coins at each of the given
+// times, computed by getUnlockedCoinsFunc, and the first entry after the current block time.
+func (bva BaseLockup) QueryUnlockSchedule(
+	ctx context.Context, times []time.Time, linear bool,
+	getUnlockedCoinsFunc func(ctx context.Context, blockTime time.Time) (sdk.Coins, error),
+) (
+	*lockuptypes.QueryUnlockScheduleResponse, error,
+) {
+	hs := bva.headerService.HeaderInfo(ctx)
+
+	resp := &lockuptypes.QueryUnlockScheduleResponse{
+		Schedule: make([]lockuptypes.UnlockScheduleEntry, 0, len(times)),
+		Linear:   linear,
+	}
+	for _, t := range times {
+		unlockedCoins, err := getUnlockedCoinsFunc(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := lockuptypes.UnlockScheduleEntry{
+			Time:          t,
+			UnlockedCoins: sdk.NewCoins(unlockedCoins...),
+		}
+		resp.Schedule = append(resp.Schedule, entry)
+		if resp.NextUnlock == nil && t.After(hs.Time) {
+			resp.NextUnlock = &entry
+		}
+	}
+
+	return resp, nil
+}
+
 func (bva BaseLockup) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, bva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, bva.WithdrawReward)
+	accountstd.RegisterExecuteHandler(builder, bva.TransferOwnership)
+	accountstd.RegisterExecuteHandler(builder, bva.AcceptOwnership)
+	accountstd.RegisterExecuteHandler(builder, bva.ReconcileDelegations)
+	accountstd.RegisterExecuteHandler(builder, bva.SetAutoCompound)
+	accountstd.RegisterExecuteHandler(builder, bva.SetFrozen)
+	accountstd.RegisterExecuteHandler(builder, bva.GrantAllowance)
+	accountstd.RegisterExecuteHandler(builder, bva.RevokeAllowance)
+	accountstd.RegisterExecuteHandler(builder, bva.Vote)
+	accountstd.RegisterExecuteHandler(builder, bva.VoteWeighted)
+	accountstd.RegisterExecuteHandler(builder, bva.MigrateIn)
 }
 
 func (bva BaseLockup) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, bva.QueryUnbondingEntries)
+	accountstd.RegisterQueryHandler(builder, bva.QueryDelegations)
+	accountstd.RegisterQueryHandler(builder, bva.QueryAllowances)
 }
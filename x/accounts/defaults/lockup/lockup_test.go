@@ -11,9 +11,13 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+	migrationv1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
+	govtypes "cosmossdk.io/x/gov/types/v1"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -282,3 +286,250 @@ func TestQueryLockupAccountBaseInfo(t *testing.T) {
 	require.Equal(t, res.Owner, "owner")
 	require.NoError(t, err)
 }
+
+func TestTransferOwnership(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	baseLockup := setup(t, sdkCtx, ss)
+
+	_, err := baseLockup.TransferOwnership(sdkCtx, &lockuptypes.MsgTransferOwnership{Sender: "new_owner", NewOwner: "new_owner"})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	_, err = baseLockup.TransferOwnership(sdkCtx, &lockuptypes.MsgTransferOwnership{Sender: "owner", NewOwner: "owner"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = baseLockup.AcceptOwnership(sdkCtx, &lockuptypes.MsgAcceptOwnership{Sender: "new_owner"})
+	require.ErrorContains(t, err, "no pending ownership transfer")
+
+	// two step transfer keeps the owner until the new owner accepts
+	_, err = baseLockup.TransferOwnership(sdkCtx, &lockuptypes.MsgTransferOwnership{Sender: "owner", NewOwner: "new_owner", TwoStep: true})
+	require.NoError(t, err)
+
+	info, err := baseLockup.QueryLockupAccountBaseInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "owner", info.Owner)
+	require.Equal(t, "new_owner", info.PendingOwner)
+
+	_, err = baseLockup.AcceptOwnership(sdkCtx, &lockuptypes.MsgAcceptOwnership{Sender: "owner"})
+	require.ErrorContains(t, err, "sender is not the pending owner")
+
+	_, err = baseLockup.AcceptOwnership(sdkCtx, &lockuptypes.MsgAcceptOwnership{Sender: "new_owner"})
+	require.NoError(t, err)
+
+	info, err = baseLockup.QueryLockupAccountBaseInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "new_owner", info.Owner)
+	require.Empty(t, info.PendingOwner)
+
+	require.Error(t, baseLockup.checkSender(sdkCtx, "owner"))
+	require.NoError(t, baseLockup.checkSender(sdkCtx, "new_owner"))
+
+	// direct transfer
+	_, err = baseLockup.TransferOwnership(sdkCtx, &lockuptypes.MsgTransferOwnership{Sender: "new_owner", NewOwner: "owner"})
+	require.NoError(t, err)
+	require.NoError(t, baseLockup.checkSender(sdkCtx, "owner"))
+}
+
+func TestAutoCompound(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	baseLockup := setup(t, sdkCtx, ss)
+
+	resp, err := baseLockup.WithdrawReward(sdkCtx, &lockuptypes.MsgWithdrawReward{Sender: "owner", ValidatorAddress: "val_address"})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 1)
+
+	_, err = baseLockup.SetAutoCompound(sdkCtx, &lockuptypes.MsgSetAutoCompound{Sender: "admin", Enabled: true})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	_, err = baseLockup.SetAutoCompound(sdkCtx, &lockuptypes.MsgSetAutoCompound{Sender: "owner", Enabled: true})
+	require.NoError(t, err)
+
+	// the withdrawn rewards are re-delegated as free delegation
+	resp, err = baseLockup.WithdrawReward(sdkCtx, &lockuptypes.MsgWithdrawReward{Sender: "owner", ValidatorAddress: "val_address"})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 2)
+
+	delFree, err := baseLockup.DelegatedFree.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delFree.Equal(math.NewInt(1)))
+
+	info, err := baseLockup.QueryLockupAccountBaseInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.True(t, info.AutoCompound)
+}
+
+func TestVote(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	baseLockup := setup(t, sdkCtx, ss)
+
+	_, err := baseLockup.Vote(sdkCtx, &lockuptypes.MsgVote{Sender: "admin", ProposalId: 1, Option: govtypes.OptionYes})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	resp, err := baseLockup.Vote(sdkCtx, &lockuptypes.MsgVote{Sender: "owner", ProposalId: 1, Option: govtypes.OptionYes})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 1)
+
+	options := []*govtypes.WeightedVoteOption{
+		{Option: govtypes.OptionYes, Weight: "0.7"},
+		{Option: govtypes.OptionNo, Weight: "0.3"},
+	}
+	_, err = baseLockup.VoteWeighted(sdkCtx, &lockuptypes.MsgVoteWeighted{Sender: "admin", ProposalId: 1, Options: options})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	resp, err = baseLockup.VoteWeighted(sdkCtx, &lockuptypes.MsgVoteWeighted{Sender: "owner", ProposalId: 1, Options: options})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 1)
+}
+
+func TestFreeze(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	baseLockup := setup(t, sdkCtx, ss)
+	noLockedCoins := func(_ context.Context, _ time.Time, _ ...string) (sdk.Coins, error) {
+		return sdk.Coins{}, nil
+	}
+	sendMsg := &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+	}
+	multiSendMsg := &lockuptypes.MsgMultiSend{
+		Sender:  "owner",
+		Outputs: []lockuptypes.Output{{Address: "receiver", Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))}},
+	}
+
+	_, err := baseLockup.SetFrozen(sdkCtx, &lockuptypes.MsgSetFrozen{Sender: "admin", Frozen: true})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	_, err = baseLockup.SetFrozen(sdkCtx, &lockuptypes.MsgSetFrozen{Sender: "owner", Frozen: true})
+	require.NoError(t, err)
+
+	info, err := baseLockup.QueryLockupAccountBaseInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.True(t, info.Frozen)
+
+	// the sends are blocked while the account is frozen
+	_, err = baseLockup.SendCoins(sdkCtx, sendMsg, noLockedCoins)
+	require.ErrorContains(t, err, "lockup account is frozen")
+	_, err = baseLockup.MultiSendCoins(sdkCtx, multiSendMsg, noLockedCoins)
+	require.ErrorContains(t, err, "lockup account is frozen")
+
+	// the rewards can still be withdrawn
+	_, err = baseLockup.WithdrawReward(sdkCtx, &lockuptypes.MsgWithdrawReward{Sender: "owner", ValidatorAddress: "val_address"})
+	require.NoError(t, err)
+
+	_, err = baseLockup.SetFrozen(sdkCtx, &lockuptypes.MsgSetFrozen{Sender: "owner", Frozen: false})
+	require.NoError(t, err)
+
+	_, err = baseLockup.SendCoins(sdkCtx, sendMsg, noLockedCoins)
+	require.NoError(t, err)
+	_, err = baseLockup.MultiSendCoins(sdkCtx, multiSendMsg, noLockedCoins)
+	require.NoError(t, err)
+}
+
+func TestSpendAllowance(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	now := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: now,
+	})
+
+	baseLockup := setup(t, sdkCtx, ss)
+	// 8 of the 10 test coins of the account are locked
+	lockedCoins := func(_ context.Context, _ time.Time, _ ...string) (sdk.Coins, error) {
+		return sdk.NewCoins(sdk.NewCoin("test", math.NewInt(8))), nil
+	}
+	spendMsg := func(amount int64) *lockuptypes.MsgSpendAllowance {
+		return &lockuptypes.MsgSpendAllowance{
+			Sender:    "hot_wallet",
+			ToAddress: "receiver",
+			Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(amount))),
+		}
+	}
+
+	_, err := baseLockup.SpendAllowance(sdkCtx, spendMsg(1), lockedCoins)
+	require.ErrorContains(t, err, "no allowance granted to sender")
+
+	allowance := lockuptypes.SpendAllowance{
+		Grantee:    "hot_wallet",
+		SpendLimit: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(3))),
+	}
+	_, err = baseLockup.GrantAllowance(sdkCtx, &lockuptypes.MsgGrantAllowance{Sender: "hot_wallet", Allowance: allowance})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	expired := now.Add(-time.Minute)
+	_, err = baseLockup.GrantAllowance(sdkCtx, &lockuptypes.MsgGrantAllowance{Sender: "owner", Allowance: lockuptypes.SpendAllowance{
+		Grantee:    "hot_wallet",
+		Expiration: &expired,
+	}})
+	require.Error(t, err)
+
+	_, err = baseLockup.GrantAllowance(sdkCtx, &lockuptypes.MsgGrantAllowance{Sender: "owner", Allowance: allowance})
+	require.NoError(t, err)
+
+	allowances, err := baseLockup.QueryAllowances(sdkCtx, &lockuptypes.QueryAllowancesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []lockuptypes.SpendAllowance{allowance}, allowances.Allowances)
+
+	// the allowance can only spend the unlocked funds
+	_, err = baseLockup.SpendAllowance(sdkCtx, spendMsg(3), lockedCoins)
+	require.ErrorContains(t, err, "spendable balance 2test is smaller than 3test")
+
+	resp, err := baseLockup.SpendAllowance(sdkCtx, spendMsg(2), lockedCoins)
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 1)
+
+	// the spent amount is deducted from the spend limit
+	_, err = baseLockup.SpendAllowance(sdkCtx, spendMsg(2), lockedCoins)
+	require.ErrorContains(t, err, "allowance spend limit 1test is smaller than 2test")
+
+	_, err = baseLockup.RevokeAllowance(sdkCtx, &lockuptypes.MsgRevokeAllowance{Sender: "owner", Grantee: "hot_wallet"})
+	require.NoError(t, err)
+
+	_, err = baseLockup.SpendAllowance(sdkCtx, spendMsg(1), lockedCoins)
+	require.ErrorContains(t, err, "no allowance granted to sender")
+
+	_, err = baseLockup.RevokeAllowance(sdkCtx, &lockuptypes.MsgRevokeAllowance{Sender: "owner", Grantee: "hot_wallet"})
+	require.Error(t, err)
+}
+
+func TestMigrateIn(t *testing.T) {
+	// the account initializes itself without funds when migrating from another account type
+	ctx, ss := newMockContextWithSender(t, []byte("lockup_account"), nil)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+	deps := makeMockDependencies(ss)
+
+	baseLockup := newBaseLockup(deps)
+	_, err := baseLockup.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:   "owner",
+		EndTime: time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = baseLockup.MigrateIn(sdkCtx, &migrationv1.MsgMigrateIn{OldAccountType: "base"})
+	require.ErrorContains(t, err, "unauthorized")
+
+	// the whole balance is locked
+	migrateCtx := accountstd.SetSender(sdkCtx, address.Module("accounts"))
+	_, err = baseLockup.MigrateIn(migrateCtx, &migrationv1.MsgMigrateIn{OldAccountType: "base"})
+	require.NoError(t, err)
+	originalLocking, err := baseLockup.OriginalLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.Equal(t, TestFunds.AmountOf("test"), originalLocking)
+}
@@ -1,9 +1,14 @@
 package lockup
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"slices"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	errorsmod "cosmossdk.io/errors"
@@ -27,6 +32,8 @@ func NewPeriodicLockingAccount(d accountstd.Dependencies) (*PeriodicLockingAccou
 		BaseLockup:     baseLockup,
 		StartTime:      collections.NewItem(d.SchemaBuilder, StartTimePrefix, "start_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
 		LockingPeriods: collections.NewVec(d.SchemaBuilder, LockingPeriodsPrefix, "locking_periods", codec.CollValue[lockuptypes.Period](d.LegacyStateCodec)),
+		Funder:         collections.NewItem(d.SchemaBuilder, FunderPrefix, "funder", collections.BytesValue),
+		DenomSchedules: collections.NewMap(d.SchemaBuilder, DenomSchedulesPrefix, "denom_schedules", collections.StringKey, codec.CollValue[lockuptypes.DenomLockingSchedule](d.LegacyStateCodec)),
 	}
 
 	return &periodicsVestingAccount, nil
@@ -36,6 +43,10 @@ type PeriodicLockingAccount struct {
 	*BaseLockup
 	StartTime      collections.Item[time.Time]
 	LockingPeriods collections.Vec[lockuptypes.Period]
+	// Funder is the address which created the account, allowed to top it up.
+	Funder collections.Item[[]byte]
+	// DenomSchedules are the locking schedules of the denoms which do not follow LockingPeriods.
+	DenomSchedules collections.Map[string, lockuptypes.DenomLockingSchedule]
 }
 
 func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitPeriodicLockingAccount) (*lockuptypes.MsgInitPeriodicLockingAccountResponse, error) {
@@ -46,35 +57,55 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 
 	hs := pva.headerService.HeaderInfo(ctx)
 
-	if msg.StartTime.Before(hs.Time) {
+	// a migrated legacy vesting account keeps its past start time
+	if msg.StartTime.Before(hs.Time) && msg.LegacyState == nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("start time %s should be after block time")
 	}
 
-	totalCoins := sdk.Coins{}
-	endTime := msg.StartTime
-	for _, period := range msg.LockingPeriods {
-		if period.Length.Seconds() <= 0 {
-			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period duration length %d", period.Length)
-		}
+	totalCoins, endTime, err := validateLockingPeriods(msg.StartTime, msg.LockingPeriods)
+	if err != nil {
+		return nil, err
+	}
 
-		if err := validateAmount(period.Amount); err != nil {
-			return nil, err
+	for _, schedule := range msg.DenomSchedules {
+		if schedule.StartTime.Before(hs.Time) && msg.LegacyState == nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("start time of the %s schedule should be after block time", schedule.Denom)
 		}
-
-		totalCoins = totalCoins.Add(period.Amount...)
-		// Calculate end time
-		endTime = endTime.Add(period.Length)
-		err = pva.LockingPeriods.Push(ctx, period)
+		scheduleCoins, scheduleEndTime, err := validateDenomSchedule(schedule, totalCoins)
 		if err != nil {
 			return nil, err
 		}
+		totalCoins = totalCoins.Add(scheduleCoins...)
+		if scheduleEndTime.After(endTime) {
+			endTime = scheduleEndTime
+		}
 	}
 
 	funds := accountstd.Funds(ctx)
+	if msg.LegacyState != nil {
+		err = checkLegacyMigration(ctx)
+		if err != nil {
+			return nil, err
+		}
+		funds = msg.LegacyState.OriginalLocking
+	}
 	if !funds.Equal(totalCoins) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid funding amount, should be equal to total coins lockup")
 	}
 
+	for _, period := range msg.LockingPeriods {
+		err = pva.LockingPeriods.Push(ctx, period)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, schedule := range msg.DenomSchedules {
+		err = pva.DenomSchedules.Set(ctx, schedule.Denom, schedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	sortedAmt := totalCoins.Sort()
 	for _, coin := range sortedAmt {
 		err := pva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount)
@@ -100,6 +131,13 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 		return nil, err
 	}
 
+	if msg.LegacyState != nil {
+		err = pva.setLegacyDelegations(ctx, msg.LegacyState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = pva.StartTime.Set(ctx, msg.StartTime)
 	if err != nil {
 		return nil, err
@@ -112,10 +150,152 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 	if err != nil {
 		return nil, err
 	}
+	err = pva.setAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	// a migrated legacy vesting account has no funder, so it cannot be topped up
+	if msg.LegacyState == nil {
+		err = pva.Funder.Set(ctx, accountstd.Sender(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &lockuptypes.MsgInitPeriodicLockingAccountResponse{}, nil
 }
 
+// validateLockingPeriods validates the given periods appended to a schedule ending at the given end time.
+// It returns the total amount of the periods and the new end time of the schedule.
+func validateLockingPeriods(endTime time.Time, periods []lockuptypes.Period) (sdk.Coins, time.Time, error) {
+	totalCoins := sdk.Coins{}
+	for _, period := range periods {
+		if period.Length.Seconds() <= 0 {
+			return nil, time.Time{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period duration length %d", period.Length)
+		}
+
+		if err := validateAmount(period.Amount); err != nil {
+			return nil, time.Time{}, err
+		}
+
+		totalCoins = totalCoins.Add(period.Amount...)
+		// Calculate end time
+		endTime = endTime.Add(period.Length)
+	}
+
+	return totalCoins, endTime, nil
+}
+
+// validateDenomSchedule validates the given denom schedule, whose denom must not be in the given coins locked
+// by the other schedules. It returns the total amount and the end time of the schedule.
+func validateDenomSchedule(schedule lockuptypes.DenomLockingSchedule, lockedCoins sdk.Coins) (sdk.Coins, time.Time, error) {
+	if err := sdk.ValidateDenom(schedule.Denom); err != nil {
+		return nil, time.Time{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid schedule denom: %s", err)
+	}
+	if !lockedCoins.AmountOf(schedule.Denom).IsZero() {
+		return nil, time.Time{}, sdkerrors.ErrInvalidRequest.Wrapf("denom %s is locked by more than one schedule", schedule.Denom)
+	}
+
+	totalCoins, endTime, err := validateLockingPeriods(schedule.StartTime, schedule.LockingPeriods)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(totalCoins) != 1 || totalCoins[0].Denom != schedule.Denom {
+		return nil, time.Time{}, sdkerrors.ErrInvalidRequest.Wrapf("the locking periods of the %s schedule must only lock %s", schedule.Denom, schedule.Denom)
+	}
+
+	return totalCoins, endTime, nil
+}
+
+// TopUp locks the funds sent by the funder of the account, by appending the given periods to its schedule.
+func (pva *PeriodicLockingAccount) TopUp(ctx context.Context, msg *lockuptypes.MsgTopUpPeriodicLockingAccount) (
+	*lockuptypes.MsgTopUpPeriodicLockingAccountResponse, error,
+) {
+	funder, err := pva.Funder.Get(ctx)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return nil, errors.New("top up is disabled for this lockup account")
+		}
+		return nil, err
+	}
+	senderBytes, err := pva.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+	if !bytes.Equal(funder, senderBytes) {
+		return nil, errors.New("sender is not the funder of this lockup account")
+	}
+	if len(msg.LockingPeriods) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("no locking periods")
+	}
+
+	// the periods are appended after the last locking period, which may end before the denom schedules
+	periodsEndTime, err := pva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = pva.IteratePeriods(ctx, func(period lockuptypes.Period) (stop bool, err error) {
+		periodsEndTime = periodsEndTime.Add(period.Length)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCoins, periodsEndTime, err := validateLockingPeriods(periodsEndTime, msg.LockingPeriods)
+	if err != nil {
+		return nil, err
+	}
+	for _, coin := range totalCoins {
+		hasSchedule, err := pva.DenomSchedules.Has(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if hasSchedule {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("denom %s has its own locking schedule", coin.Denom)
+		}
+	}
+
+	funds := accountstd.Funds(ctx)
+	if !funds.Equal(totalCoins) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid funding amount, should be equal to total coins lockup")
+	}
+
+	for _, period := range msg.LockingPeriods {
+		err = pva.LockingPeriods.Push(ctx, period)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, coin := range totalCoins {
+		originalLockingAmt, err := pva.OriginalLocking.Get(ctx, coin.Denom)
+		if err != nil {
+			if !errorsmod.IsOf(err, collections.ErrNotFound) {
+				return nil, err
+			}
+			originalLockingAmt = math.ZeroInt()
+		}
+		err = pva.OriginalLocking.Set(ctx, coin.Denom, originalLockingAmt.Add(coin.Amount))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	endTime, err := pva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if periodsEndTime.After(endTime) {
+		err = pva.EndTime.Set(ctx, periodsEndTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lockuptypes.MsgTopUpPeriodicLockingAccountResponse{}, nil
+}
+
 func (pva *PeriodicLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (
 	*lockuptypes.MsgExecuteMessagesResponse, error,
 ) {
@@ -128,6 +308,196 @@ func (pva *PeriodicLockingAccount) SendCoins(ctx context.Context, msg *lockuptyp
 	return pva.BaseLockup.SendCoins(ctx, msg, pva.GetLockedCoinsWithDenoms)
 }
 
+func (pva *PeriodicLockingAccount) MultiSendCoins(ctx context.Context, msg *lockuptypes.MsgMultiSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return pva.BaseLockup.MultiSendCoins(ctx, msg, pva.GetLockedCoinsWithDenoms)
+}
+
+func (pva *PeriodicLockingAccount) IBCTransfer(ctx context.Context, msg *lockuptypes.MsgIBCTransfer) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return pva.BaseLockup.IBCTransfer(ctx, msg, pva.GetLockedCoinsWithDenoms)
+}
+
+func (pva *PeriodicLockingAccount) SpendAllowance(ctx context.Context, msg *lockuptypes.MsgSpendAllowance) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return pva.BaseLockup.SpendAllowance(ctx, msg, pva.GetLockedCoinsWithDenoms)
+}
+
+func (pva *PeriodicLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return pva.BaseLockup.ClawbackFunds(ctx, msg, pva.GetLockedCoinsWithDenoms, pva.clearLockingPeriods)
+}
+
+// clearLockingPeriods removes the given denom from the locking periods and its schedule, once its lockup
+// is terminated by a clawback.
+func (pva PeriodicLockingAccount) clearLockingPeriods(ctx context.Context, denom string) error {
+	err := pva.DenomSchedules.Remove(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	length, err := pva.LockingPeriods.Len(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < length; i++ {
+		period, err := pva.LockingPeriods.Get(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		amount := period.Amount.AmountOf(denom)
+		if amount.IsZero() {
+			continue
+		}
+
+		period.Amount = period.Amount.Sub(sdk.NewCoin(denom, amount))
+		err = pva.LockingPeriods.Replace(ctx, i, period)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pva *PeriodicLockingAccount) SplitLockup(ctx context.Context, msg *lockuptypes.MsgSplitLockup) (
+	*lockuptypes.MsgSplitLockupResponse, error,
+) {
+	return pva.BaseLockup.SplitLockup(ctx, msg, PERIODIC_LOCKING_ACCOUNT, pva.GetLockedCoinsWithDenoms, pva.splitLockup)
+}
+
+// splitLockup removes the share of the amount of each period which is not unlocked yet, including the
+// periods of the denom schedules. The new account unlocks the split amounts at the end of the same periods,
+// starting from the current block time.
+func (pva PeriodicLockingAccount) splitLockup(ctx context.Context, newOwner string, share math.LegacyDec) (proto.Message, sdk.Coins, error) {
+	startTime, err := pva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	admin, err := pva.getAdminAddress(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hs := pva.headerService.HeaderInfo(ctx)
+	newStartTime := startTime
+	if hs.Time.After(startTime) {
+		newStartTime = hs.Time
+	}
+
+	var periods []lockuptypes.Period
+	err = pva.IteratePeriods(ctx, func(period lockuptypes.Period) (stop bool, err error) {
+		periods = append(periods, period)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newPeriods, funds := splitPeriods(periods, startTime, newStartTime, hs.Time, share)
+	for i, period := range periods {
+		err = pva.LockingPeriods.Replace(ctx, uint64(i), period)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var schedules []lockuptypes.DenomLockingSchedule
+	err = pva.DenomSchedules.Walk(ctx, nil, func(_ string, schedule lockuptypes.DenomLockingSchedule) (stop bool, err error) {
+		schedules = append(schedules, schedule)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newSchedules := []lockuptypes.DenomLockingSchedule{}
+	for _, schedule := range schedules {
+		newScheduleStartTime := schedule.StartTime
+		if hs.Time.After(newScheduleStartTime) {
+			newScheduleStartTime = hs.Time
+		}
+
+		newSchedulePeriods, scheduleFunds := splitPeriods(schedule.LockingPeriods, schedule.StartTime, newScheduleStartTime, hs.Time, share)
+		if scheduleFunds.IsZero() {
+			continue
+		}
+		err = pva.DenomSchedules.Set(ctx, schedule.Denom, schedule)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		newSchedules = append(newSchedules, lockuptypes.DenomLockingSchedule{
+			Denom:          schedule.Denom,
+			StartTime:      newScheduleStartTime,
+			LockingPeriods: newSchedulePeriods,
+		})
+		funds = funds.Add(scheduleFunds...)
+	}
+
+	for _, coin := range funds {
+		originalLockingAmt, err := pva.OriginalLocking.Get(ctx, coin.Denom)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = pva.OriginalLocking.Set(ctx, coin.Denom, originalLockingAmt.Sub(coin.Amount))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:          newOwner,
+		StartTime:      newStartTime,
+		LockingPeriods: newPeriods,
+		Admin:          admin,
+		DenomSchedules: newSchedules,
+	}, funds, nil
+}
+
+// splitPeriods removes the share of the amount of each of the given periods which is not unlocked yet at
+// the given block time. It returns the periods unlocking the split amounts at the end of the same periods,
+// starting from newStartTime, and the split amounts.
+func splitPeriods(periods []lockuptypes.Period, startTime, newStartTime, blockTime time.Time, share math.LegacyDec) ([]lockuptypes.Period, sdk.Coins) {
+	funds := sdk.Coins{}
+	newPeriods := []lockuptypes.Period{}
+	periodStartTime, newPeriodStartTime := startTime, newStartTime
+	for i, period := range periods {
+		periodEndTime := periodStartTime.Add(period.Length)
+		periodStartTime = periodEndTime
+		// the period is already unlocked
+		if !periodEndTime.After(blockTime) {
+			continue
+		}
+
+		splitAmount := sdk.Coins{}
+		for _, coin := range period.Amount {
+			amount := math.LegacyNewDecFromInt(coin.Amount).Mul(share).TruncateInt()
+			splitAmount = splitAmount.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		if splitAmount.IsZero() {
+			continue
+		}
+
+		newPeriods = append(newPeriods, lockuptypes.Period{
+			Length: periodEndTime.Sub(newPeriodStartTime),
+			Amount: splitAmount,
+		})
+		newPeriodStartTime = periodEndTime
+
+		periods[i].Amount = period.Amount.Sub(splitAmount...)
+		funds = funds.Add(splitAmount...)
+	}
+
+	return newPeriods, funds
+}
+
 // IteratePeriods iterates over all the Periods entries.
 func (pva PeriodicLockingAccount) IteratePeriods(
 	ctx context.Context,
@@ -145,6 +515,30 @@ func (pva PeriodicLockingAccount) IteratePeriods(
 
 // GetLockCoinsInfo returns the total number of locked and unlocked coins.
 func (pva PeriodicLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
+	unlockedCoins, lockedCoins, err = pva.getLockingPeriodsCoinsInfo(ctx, blockTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = pva.DenomSchedules.Walk(ctx, nil, func(_ string, schedule lockuptypes.DenomLockingSchedule) (stop bool, err error) {
+		unlockedCoin, lockedCoin, err := pva.getDenomScheduleCoinInfo(ctx, blockTime, schedule)
+		if err != nil {
+			return true, err
+		}
+		unlockedCoins = unlockedCoins.Add(*unlockedCoin)
+		lockedCoins = lockedCoins.Add(*lockedCoin)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return unlockedCoins, lockedCoins, nil
+}
+
+// getLockingPeriodsCoinsInfo returns the number of locked and unlocked coins of the denoms following the
+// locking periods.
+func (pva PeriodicLockingAccount) getLockingPeriodsCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
 	unlockedCoins = sdk.Coins{}
 	lockedCoins = sdk.Coins{}
 
@@ -161,6 +555,14 @@ func (pva PeriodicLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTim
 	}
 	originalLocking := sdk.Coins{}
 	err = pva.IterateCoinEntries(ctx, pva.OriginalLocking, func(key string, value math.Int) (stop bool, err error) {
+		hasSchedule, err := pva.DenomSchedules.Has(ctx, key)
+		if err != nil {
+			return true, err
+		}
+		// the denom follows its own schedule
+		if hasSchedule {
+			return false, nil
+		}
 		originalLocking = append(originalLocking, sdk.NewCoin(key, value))
 		return false, nil
 	})
@@ -212,6 +614,18 @@ func (pva PeriodicLockingAccount) GetLockedCoins(ctx context.Context, blockTime
 
 // GetLockCoinInfoWithDenom returns the total number of locked and unlocked coin for a specific denom.
 func (pva PeriodicLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, blockTime time.Time, denom string) (unlockedCoin, lockedCoin *sdk.Coin, err error) {
+	hasSchedule, err := pva.DenomSchedules.Has(ctx, denom)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasSchedule {
+		schedule, err := pva.DenomSchedules.Get(ctx, denom)
+		if err != nil {
+			return nil, nil, err
+		}
+		return pva.getDenomScheduleCoinInfo(ctx, blockTime, schedule)
+	}
+
 	// We must handle the case where the start time for a lockup account has
 	// been set into the future or when the start of the chain is not exactly
 	// known.
@@ -264,6 +678,33 @@ func (pva PeriodicLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context,
 	return &unlocked, &locked, err
 }
 
+// getDenomScheduleCoinInfo returns the number of locked and unlocked coin of the denom of the given schedule.
+func (pva PeriodicLockingAccount) getDenomScheduleCoinInfo(ctx context.Context, blockTime time.Time, schedule lockuptypes.DenomLockingSchedule) (unlockedCoin, lockedCoin *sdk.Coin, err error) {
+	originalLockingAmt, err := pva.OriginalLocking.Get(ctx, schedule.Denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unlocked := sdk.NewCoin(schedule.Denom, math.ZeroInt())
+	// track the start time of the next period
+	currentPeriodStartTime := schedule.StartTime
+	for _, period := range schedule.LockingPeriods {
+		x := blockTime.Sub(currentPeriodStartTime)
+		if x.Seconds() < period.Length.Seconds() {
+			break
+		}
+
+		unlocked = unlocked.Add(sdk.NewCoin(schedule.Denom, period.Amount.AmountOf(schedule.Denom)))
+
+		// update the start time of the next period
+		currentPeriodStartTime = currentPeriodStartTime.Add(period.Length)
+	}
+
+	locked := sdk.NewCoin(schedule.Denom, originalLockingAmt).Sub(unlocked)
+
+	return &unlocked, &locked, nil
+}
+
 // GetLockedCoinsWithDenoms returns the total number of locked coins. If no coins are
 // locked, nil is returned.
 func (pva PeriodicLockingAccount) GetLockedCoinsWithDenoms(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
@@ -311,11 +752,59 @@ func (pva PeriodicLockingAccount) QueryLockingPeriods(ctx context.Context, msg *
 	if err != nil {
 		return nil, err
 	}
+	denomSchedules := []lockuptypes.DenomLockingSchedule{}
+	err = pva.DenomSchedules.Walk(ctx, nil, func(_ string, schedule lockuptypes.DenomLockingSchedule) (stop bool, err error) {
+		denomSchedules = append(denomSchedules, schedule)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return &lockuptypes.QueryLockingPeriodsResponse{
 		LockingPeriods: lockingPeriods,
+		DenomSchedules: denomSchedules,
 	}, nil
 }
 
+// QueryUnlockSchedule returns the unlock schedule of the account, the coins of each period are released at
+// the end of the period.
+func (pva PeriodicLockingAccount) QueryUnlockSchedule(ctx context.Context, req *lockuptypes.QueryUnlockScheduleRequest) (
+	*lockuptypes.QueryUnlockScheduleResponse, error,
+) {
+	periodEndTime, err := pva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var times []time.Time
+	err = pva.IteratePeriods(ctx, func(period lockuptypes.Period) (stop bool, err error) {
+		periodEndTime = periodEndTime.Add(period.Length)
+		times = append(times, periodEndTime)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = pva.DenomSchedules.Walk(ctx, nil, func(_ string, schedule lockuptypes.DenomLockingSchedule) (stop bool, err error) {
+		periodEndTime := schedule.StartTime
+		for _, period := range schedule.LockingPeriods {
+			periodEndTime = periodEndTime.Add(period.Length)
+			times = append(times, periodEndTime)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(times, time.Time.Compare)
+	times = slices.CompactFunc(times, time.Time.Equal)
+
+	return pva.BaseLockup.QueryUnlockSchedule(ctx, times, false, func(ctx context.Context, blockTime time.Time) (sdk.Coins, error) {
+		unlockedCoins, _, err := pva.GetLockCoinsInfo(ctx, blockTime)
+		return unlockedCoins, err
+	})
+}
+
 // Implement smart account interface
 func (pva PeriodicLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
 	accountstd.RegisterInitHandler(builder, pva.Init)
@@ -324,11 +813,18 @@ func (pva PeriodicLockingAccount) RegisterInitHandler(builder *accountstd.InitBu
 func (pva PeriodicLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, pva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, pva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, pva.MultiSendCoins)
+	accountstd.RegisterExecuteHandler(builder, pva.IBCTransfer)
+	accountstd.RegisterExecuteHandler(builder, pva.SpendAllowance)
+	accountstd.RegisterExecuteHandler(builder, pva.Clawback)
+	accountstd.RegisterExecuteHandler(builder, pva.SplitLockup)
+	accountstd.RegisterExecuteHandler(builder, pva.TopUp)
 	pva.BaseLockup.RegisterExecuteHandlers(builder)
 }
 
 func (pva PeriodicLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, pva.QueryLockupAccountInfo)
 	accountstd.RegisterQueryHandler(builder, pva.QueryLockingPeriods)
+	accountstd.RegisterQueryHandler(builder, pva.QueryUnlockSchedule)
 	pva.BaseLockup.RegisterQueryHandlers(builder)
 }
@@ -217,3 +217,308 @@ func TestPeriodicAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestPeriodicAccountClawback(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc, err := NewPeriodicLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:     "owner",
+		Admin:     "admin",
+		StartTime: time.Now(),
+		LockingPeriods: []lockuptypes.Period{
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+				Length: time.Minute,
+			},
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+				Length: time.Minute,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(1)),
+	})
+	require.NoError(t, err)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to unlocked first period token
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 1),
+	})
+
+	// the delegated locked funds are left to the owner, as free delegated funds
+	resp, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Sender: "admin", Recipient: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4))), resp.Amount)
+	require.True(t, resp.Pending.IsZero())
+
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.IsZero())
+	delFree, err := acc.DelegatedFree.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delFree.Equal(math.NewInt(1)))
+
+	// the lockup is terminated
+	periods, err := acc.QueryLockingPeriods(sdkCtx, &lockuptypes.QueryLockingPeriodsRequest{})
+	require.NoError(t, err)
+	for _, period := range periods.LockingPeriods {
+		require.True(t, period.Amount.IsZero())
+	}
+
+	lockedCoins, err := acc.GetLockedCoins(sdkCtx, startTime.Add(time.Second*90))
+	require.NoError(t, err)
+	require.True(t, lockedCoins.IsZero())
+}
+
+func TestPeriodicAccountUnlockSchedule(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupPeriodicAccount(t, sdkCtx, ss)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to unlocked first period token
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 1),
+	})
+
+	resp, err := acc.QueryUnlockSchedule(sdkCtx, &lockuptypes.QueryUnlockScheduleRequest{})
+	require.NoError(t, err)
+	require.False(t, resp.Linear)
+	require.Len(t, resp.Schedule, 3)
+
+	expected := []int64{5, 7, 10}
+	for i, entry := range resp.Schedule {
+		require.Equal(t, startTime.Add(time.Minute*time.Duration(i+1)), entry.Time)
+		require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(expected[i]))), entry.UnlockedCoins)
+	}
+	require.Equal(t, &resp.Schedule[1], resp.NextUnlock)
+}
+
+func TestPeriodicAccountSplitLockup(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupPeriodicAccount(t, sdkCtx, ss)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to half of the second period
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Second * 90),
+	})
+
+	initMsg, funds, err := acc.splitLockup(sdkCtx, "new_owner", math.LegacyNewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2))), funds)
+
+	// the new account unlocks the split amounts at the end of the same periods
+	msg := initMsg.(*lockuptypes.MsgInitPeriodicLockingAccount)
+	require.Equal(t, "new_owner", msg.Owner)
+	require.Equal(t, startTime.Add(time.Second*90), msg.StartTime)
+	require.Equal(t, []lockuptypes.Period{
+		{
+			Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))),
+			Length: time.Second * 30,
+		},
+		{
+			Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))),
+			Length: time.Minute,
+		},
+	}, msg.LockingPeriods)
+
+	originalLocking, err := acc.OriginalLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, originalLocking.Equal(math.NewInt(8)))
+
+	_, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Second*90))
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(3)))
+
+	_, locked, err = acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*3))
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("test").IsZero())
+}
+
+func TestPeriodicAccountTopUp(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupPeriodicAccount(t, sdkCtx, ss)
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	periods := []lockuptypes.Period{
+		{
+			Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4))),
+			Length: time.Minute,
+		},
+		{
+			Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6))),
+			Length: time.Minute,
+		},
+	}
+
+	// only the funder of the account can top it up
+	_, err = acc.TopUp(sdkCtx, &lockuptypes.MsgTopUpPeriodicLockingAccount{
+		Sender:         "owner",
+		LockingPeriods: periods,
+	})
+	require.Error(t, err)
+
+	// the funds sent must match the periods amounts
+	_, err = acc.TopUp(sdkCtx, &lockuptypes.MsgTopUpPeriodicLockingAccount{
+		Sender:         "sender",
+		LockingPeriods: periods[:1],
+	})
+	require.Error(t, err)
+
+	_, err = acc.TopUp(sdkCtx, &lockuptypes.MsgTopUpPeriodicLockingAccount{
+		Sender:         "sender",
+		LockingPeriods: periods,
+	})
+	require.NoError(t, err)
+
+	originalLocking, err := acc.OriginalLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, originalLocking.Equal(math.NewInt(20)))
+
+	endTime, err := acc.EndTime.Get(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, startTime.Add(time.Minute*5), endTime)
+
+	_, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*3))
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(10)))
+
+	_, locked, err = acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*4))
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(6)))
+
+	_, locked, err = acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*5))
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("test").IsZero())
+}
+
+func TestPeriodicAccountDenomSchedules(t *testing.T) {
+	funds := sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)), sdk.NewCoin("stake", math.NewInt(6)))
+	ctx, ss := newMockContextWithSender(t, []byte("sender"), funds)
+	now := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: now,
+	})
+
+	acc, err := NewPeriodicLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+
+	periods := []lockuptypes.Period{
+		{
+			Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4))),
+			Length: time.Minute,
+		},
+		{
+			Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6))),
+			Length: time.Minute,
+		},
+	}
+	stakeSchedule := lockuptypes.DenomLockingSchedule{
+		Denom:     "stake",
+		StartTime: now.Add(time.Second * 30),
+		LockingPeriods: []lockuptypes.Period{
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(3))),
+				Length: time.Minute * 2,
+			},
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(3))),
+				Length: time.Minute * 2,
+			},
+		},
+	}
+
+	// the denom of a schedule must not be locked by the locking periods
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:          "owner",
+		StartTime:      now,
+		LockingPeriods: periods,
+		DenomSchedules: []lockuptypes.DenomLockingSchedule{
+			{
+				Denom:          "test",
+				StartTime:      now,
+				LockingPeriods: stakeSchedule.LockingPeriods,
+			},
+		},
+	})
+	require.Error(t, err)
+
+	// the locking periods of a schedule must only lock its denom
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:          "owner",
+		StartTime:      now,
+		LockingPeriods: periods,
+		DenomSchedules: []lockuptypes.DenomLockingSchedule{
+			{
+				Denom:          "other",
+				StartTime:      now,
+				LockingPeriods: stakeSchedule.LockingPeriods,
+			},
+		},
+	})
+	require.Error(t, err)
+
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:          "owner",
+		StartTime:      now,
+		LockingPeriods: periods,
+		DenomSchedules: []lockuptypes.DenomLockingSchedule{stakeSchedule},
+	})
+	require.NoError(t, err)
+
+	endTime, err := acc.EndTime.Get(sdkCtx)
+	require.NoError(t, err)
+	require.True(t, endTime.Equal(now.Add(time.Second*270)))
+
+	_, locked, err := acc.GetLockCoinsInfo(sdkCtx, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(6)))
+	require.True(t, locked.AmountOf("stake").Equal(math.NewInt(6)))
+
+	unlocked, locked, err := acc.GetLockCoinsInfo(sdkCtx, now.Add(time.Second*150))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
+	require.True(t, locked.AmountOf("test").IsZero())
+	require.True(t, unlocked.AmountOf("stake").Equal(math.NewInt(3)))
+	require.True(t, locked.AmountOf("stake").Equal(math.NewInt(3)))
+
+	locked, err = acc.GetLockedCoinsWithDenoms(sdkCtx, now.Add(time.Second*270), "stake")
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("stake").IsZero())
+
+	resp, err := acc.QueryLockingPeriods(sdkCtx, &lockuptypes.QueryLockingPeriodsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.LockingPeriods, 2)
+	require.Len(t, resp.DenomSchedules, 1)
+	require.Equal(t, "stake", resp.DenomSchedules[0].Denom)
+}
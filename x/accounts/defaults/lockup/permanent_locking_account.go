@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
@@ -72,6 +74,47 @@ func (plva *PermanentLockingAccount) SendCoins(ctx context.Context, msg *lockupt
 	return plva.BaseLockup.SendCoins(ctx, msg, plva.GetlockedCoinsWithDenoms)
 }
 
+func (plva *PermanentLockingAccount) MultiSendCoins(ctx context.Context, msg *lockuptypes.MsgMultiSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return plva.BaseLockup.MultiSendCoins(ctx, msg, plva.GetlockedCoinsWithDenoms)
+}
+
+func (plva *PermanentLockingAccount) IBCTransfer(ctx context.Context, msg *lockuptypes.MsgIBCTransfer) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return plva.BaseLockup.IBCTransfer(ctx, msg, plva.GetlockedCoinsWithDenoms)
+}
+
+func (plva *PermanentLockingAccount) SpendAllowance(ctx context.Context, msg *lockuptypes.MsgSpendAllowance) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return plva.BaseLockup.SpendAllowance(ctx, msg, plva.GetlockedCoinsWithDenoms)
+}
+
+func (plva *PermanentLockingAccount) SplitLockup(ctx context.Context, msg *lockuptypes.MsgSplitLockup) (
+	*lockuptypes.MsgSplitLockupResponse, error,
+) {
+	return plva.BaseLockup.SplitLockup(ctx, msg, PERMANENT_LOCKING_ACCOUNT, plva.GetlockedCoinsWithDenoms, plva.splitLockup)
+}
+
+// splitLockup removes the share of the original locking, which is permanently locked by the new account.
+func (plva PermanentLockingAccount) splitLockup(ctx context.Context, newOwner string, share math.LegacyDec) (proto.Message, sdk.Coins, error) {
+	funds, err := plva.splitOriginalLocking(ctx, share, plva.GetlockedCoinsWithDenoms)
+	if err != nil {
+		return nil, nil, err
+	}
+	admin, err := plva.getAdminAddress(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &lockuptypes.MsgInitLockupAccount{
+		Owner: newOwner,
+		Admin: admin,
+	}, funds, nil
+}
+
 func (plva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
 	*lockuptypes.QueryLockupAccountInfoResponse, error,
 ) {
@@ -93,6 +136,15 @@ func (plva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context,
 	return resp, nil
 }
 
+// QueryUnlockSchedule returns the unlock schedule of the account, which is empty as the coins are never unlocked.
+func (plva PermanentLockingAccount) QueryUnlockSchedule(ctx context.Context, req *lockuptypes.QueryUnlockScheduleRequest) (
+	*lockuptypes.QueryUnlockScheduleResponse, error,
+) {
+	return &lockuptypes.QueryUnlockScheduleResponse{
+		Schedule: []lockuptypes.UnlockScheduleEntry{},
+	}, nil
+}
+
 // Implement smart account interface
 func (plva PermanentLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
 	accountstd.RegisterInitHandler(builder, plva.Init)
@@ -102,10 +154,24 @@ func (plva PermanentLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 	accountstd.RegisterExecuteHandler(builder, plva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, plva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, plva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, plva.MultiSendCoins)
+	accountstd.RegisterExecuteHandler(builder, plva.IBCTransfer)
+	accountstd.RegisterExecuteHandler(builder, plva.SpendAllowance)
 	accountstd.RegisterExecuteHandler(builder, plva.WithdrawReward)
+	accountstd.RegisterExecuteHandler(builder, plva.TransferOwnership)
+	accountstd.RegisterExecuteHandler(builder, plva.AcceptOwnership)
+	accountstd.RegisterExecuteHandler(builder, plva.ReconcileDelegations)
+	accountstd.RegisterExecuteHandler(builder, plva.SetAutoCompound)
+	accountstd.RegisterExecuteHandler(builder, plva.SetFrozen)
+	accountstd.RegisterExecuteHandler(builder, plva.GrantAllowance)
+	accountstd.RegisterExecuteHandler(builder, plva.RevokeAllowance)
+	accountstd.RegisterExecuteHandler(builder, plva.Vote)
+	accountstd.RegisterExecuteHandler(builder, plva.VoteWeighted)
+	accountstd.RegisterExecuteHandler(builder, plva.SplitLockup)
 }
 
 func (plva PermanentLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, plva.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, plva.QueryUnlockSchedule)
 	plva.BaseLockup.RegisterQueryHandlers(builder)
 }
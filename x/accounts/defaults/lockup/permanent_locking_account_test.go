@@ -109,3 +109,55 @@ func TestPermanentAccountSendCoins(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestPermanentAccountSpendRewards(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupPermanentAccount(t, sdkCtx, ss)
+	_, err := acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(5)),
+	})
+	require.NoError(t, err)
+
+	// the mock balance stays at 10, as if 5 were received as rewards after the delegation
+	_, err = acc.WithdrawReward(sdkCtx, &lockuptypes.MsgWithdrawReward{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+	})
+	require.NoError(t, err)
+
+	// the principal is never unlocked
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6))),
+	})
+	require.ErrorContains(t, err, "spendable balance 5test is smaller than 6test")
+
+	// the rewards are freely spendable
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+	})
+	require.NoError(t, err)
+}
+
+func TestPermanentAccountUnlockSchedule(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupPermanentAccount(t, sdkCtx, ss)
+
+	resp, err := acc.QueryUnlockSchedule(sdkCtx, &lockuptypes.QueryUnlockScheduleRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Schedule)
+	require.Nil(t, resp.NextUnlock)
+}
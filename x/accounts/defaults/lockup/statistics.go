@@ -0,0 +1,70 @@
+package lockup
+
+import (
+	"context"
+	"fmt"
+
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// lockupAccountTypes are the account types of the lockup accounts.
+var lockupAccountTypes = []string{
+	CONTINUOUS_LOCKING_ACCOUNT,
+	CLIFF_LOCKING_ACCOUNT,
+	DELAYED_LOCKING_ACCOUNT,
+	PERIODIC_LOCKING_ACCOUNT,
+	PERMANENT_LOCKING_ACCOUNT,
+}
+
+// StatisticsAccountsKeeper defines the expected interface of the x/accounts keeper to aggregate the
+// lockup accounts.
+type StatisticsAccountsKeeper interface {
+	AccountsKeeper
+	IterateAccountsByType(ctx context.Context, accountType string, cb func(accountAddr []byte) (stop bool, err error)) error
+}
+
+// StatisticsQuerier aggregates the balances of all the lockup accounts of the chain, for the explorers and
+// the tokenomics dashboards.
+type StatisticsQuerier struct {
+	accountsKeeper StatisticsAccountsKeeper
+}
+
+// NewStatisticsQuerier creates a new StatisticsQuerier.
+func NewStatisticsQuerier(accountsKeeper StatisticsAccountsKeeper) StatisticsQuerier {
+	return StatisticsQuerier{accountsKeeper: accountsKeeper}
+}
+
+// LockupStatistics returns the number of lockup accounts, and their total locked and unlocked coins per denom.
+// The lockup accounts are visited through the x/accounts index of the accounts by type, which is maintained
+// as the accounts are created, while their locked coins are computed at the current block time.
+func (q StatisticsQuerier) LockupStatistics(ctx context.Context, _ *lockuptypes.QueryLockupStatisticsRequest) (*lockuptypes.QueryLockupStatisticsResponse, error) {
+	resp := &lockuptypes.QueryLockupStatisticsResponse{
+		LockedCoins:   sdk.NewCoins(),
+		UnlockedCoins: sdk.NewCoins(),
+	}
+	for _, accountType := range lockupAccountTypes {
+		err := q.accountsKeeper.IterateAccountsByType(ctx, accountType, func(addr []byte) (bool, error) {
+			infoResp, err := q.accountsKeeper.Query(ctx, addr, &lockuptypes.QueryLockupAccountInfoRequest{})
+			if err != nil {
+				return true, err
+			}
+
+			info, ok := infoResp.(*lockuptypes.QueryLockupAccountInfoResponse)
+			if !ok {
+				return true, fmt.Errorf("unexpected lockup account info response type %T", infoResp)
+			}
+
+			resp.Accounts++
+			resp.LockedCoins = resp.LockedCoins.Add(info.LockedCoins...)
+			resp.UnlockedCoins = resp.UnlockedCoins.Add(info.UnlockedCoins...)
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
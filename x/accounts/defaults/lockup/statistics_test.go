@@ -0,0 +1,33 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestLockupStatistics(t *testing.T) {
+	querier := NewStatisticsQuerier(mockAccountsKeeper{
+		types: map[string]string{
+			"continuous": CONTINUOUS_LOCKING_ACCOUNT,
+			"periodic":   PERIODIC_LOCKING_ACCOUNT,
+			"base":       "base",
+		},
+		info: &lockuptypes.QueryLockupAccountInfoResponse{
+			LockedCoins:   sdk.NewCoins(sdk.NewInt64Coin("stake", 70)),
+			UnlockedCoins: sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("test", 5)),
+		},
+	})
+
+	// the other accounts are not aggregated
+	resp, err := querier.LockupStatistics(context.Background(), &lockuptypes.QueryLockupStatisticsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Accounts)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 140)), resp.LockedCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60), sdk.NewInt64Coin("test", 10)), resp.UnlockedCoins)
+}
@@ -15,11 +15,14 @@ import (
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
 	banktypes "cosmossdk.io/x/bank/types"
 	distrtypes "cosmossdk.io/x/distribution/types"
+	govtypes "cosmossdk.io/x/gov/types/v1"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -68,9 +71,14 @@ func (h headerService) HeaderInfo(ctx context.Context) header.Info {
 }
 
 func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
+	t.Helper()
+	return newMockContextWithSender(t, []byte("sender"), TestFunds)
+}
+
+func newMockContextWithSender(t *testing.T, sender []byte, funds sdk.Coins) (context.Context, store.KVStoreService) {
 	t.Helper()
 	return accountstd.NewMockContext(
-		0, []byte("lockup_account"), []byte("sender"), TestFunds,
+		0, []byte("lockup_account"), sender, funds,
 		func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
 			typeUrl := sdk.MsgTypeURL(msg)
 			switch typeUrl {
@@ -81,9 +89,19 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 					Amount: sdk.NewCoin("test", math.NewInt(1)),
 				}, nil
 			case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
-				return &distrtypes.MsgWithdrawDelegatorRewardResponse{}, nil
+				return &distrtypes.MsgWithdrawDelegatorRewardResponse{
+					Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))),
+				}, nil
 			case "/cosmos.bank.v1beta1.MsgSend":
 				return &banktypes.MsgSendResponse{}, nil
+			case "/cosmos.gov.v1.MsgVote":
+				return &govtypes.MsgVoteResponse{}, nil
+			case "/cosmos.gov.v1.MsgVoteWeighted":
+				return &govtypes.MsgVoteWeightedResponse{}, nil
+			case "/cosmos.accounts.v1.MsgInit":
+				return &accountsv1.MsgInitResponse{
+					AccountAddress: "new_lockup_account",
+				}, nil
 			default:
 				return nil, errors.New("unrecognized request type")
 			}
@@ -115,6 +133,19 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 						},
 					},
 				}, nil
+			case "/cosmos.staking.v1beta1.QueryDelegatorDelegationsRequest":
+				return &stakingtypes.QueryDelegatorDelegationsResponse{
+					DelegationResponses: []stakingtypes.DelegationResponse{
+						{
+							Delegation: stakingtypes.Delegation{
+								DelegatorAddress: "lockup_account",
+								ValidatorAddress: "val_address",
+								Shares:           math.LegacyNewDec(1),
+							},
+							Balance: sdk.NewCoin("test", math.NewInt(1)),
+						},
+					},
+				}, nil
 			case "/cosmos.bank.v1beta1.QueryBalanceRequest":
 				return &banktypes.QueryBalanceResponse{
 					Balance: &(sdk.Coin{
@@ -122,6 +153,10 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 						Amount: TestFunds.AmountOf("test"),
 					}),
 				}, nil
+			case "/cosmos.bank.v1beta1.QueryAllBalancesRequest":
+				return &banktypes.QueryAllBalancesResponse{
+					Balances: TestFunds,
+				}, nil
 			default:
 				return nil, errors.New("unrecognized request type")
 			}
@@ -139,6 +174,7 @@ func makeMockDependencies(storeservice store.KVStoreService) accountstd.Dependen
 		LegacyStateCodec: mockStateCodec{},
 		Environment: appmodulev2.Environment{
 			HeaderService: headerService{},
+			EventService:  runtime.EventService{},
 		},
 	}
 }
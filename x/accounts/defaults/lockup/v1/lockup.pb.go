@@ -86,6 +86,70 @@ func (m *Period) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// DenomLockingSchedule defines the locking periods of a single denom of a periodic lockup account, which
+// are independent of the locking periods of the other denoms.
+type DenomLockingSchedule struct {
+	// denom is the only denom of the amounts of the locking periods
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_time is start of the lockup of the denom
+	StartTime      time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	LockingPeriods []Period  `protobuf:"bytes,3,rep,name=locking_periods,json=lockingPeriods,proto3" json:"locking_periods"`
+}
+
+func (m *DenomLockingSchedule) Reset()         { *m = DenomLockingSchedule{} }
+func (m *DenomLockingSchedule) String() string { return proto.CompactTextString(m) }
+func (*DenomLockingSchedule) ProtoMessage()    {}
+func (*DenomLockingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9783f5e2b76d96, []int{1}
+}
+func (m *DenomLockingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomLockingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomLockingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomLockingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomLockingSchedule.Merge(m, src)
+}
+func (m *DenomLockingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomLockingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomLockingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomLockingSchedule proto.InternalMessageInfo
+
+func (m *DenomLockingSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomLockingSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *DenomLockingSchedule) GetLockingPeriods() []Period {
+	if m != nil {
+		return m.LockingPeriods
+	}
+	return nil
+}
+
 type UnbondingEntries struct {
 	Entries []*UnbondingEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
@@ -94,7 +158,7 @@ func (m *UnbondingEntries) Reset()         { *m = UnbondingEntries{} }
 func (m *UnbondingEntries) String() string { return proto.CompactTextString(m) }
 func (*UnbondingEntries) ProtoMessage()    {}
 func (*UnbondingEntries) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b9783f5e2b76d96, []int{1}
+	return fileDescriptor_6b9783f5e2b76d96, []int{2}
 }
 func (m *UnbondingEntries) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,7 +209,7 @@ func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
 func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
 func (*UnbondingEntry) ProtoMessage()    {}
 func (*UnbondingEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b9783f5e2b76d96, []int{2}
+	return fileDescriptor_6b9783f5e2b76d96, []int{3}
 }
 func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,10 +266,195 @@ func (m *UnbondingEntry) GetValidatorAddress() string {
 	return ""
 }
 
+// UnlockScheduleEntry defines the cumulative unlocked coins of a lockup account at a given time.
+type UnlockScheduleEntry struct {
+	Time          time.Time                                `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
+}
+
+func (m *UnlockScheduleEntry) Reset()         { *m = UnlockScheduleEntry{} }
+func (m *UnlockScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*UnlockScheduleEntry) ProtoMessage()    {}
+func (*UnlockScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9783f5e2b76d96, []int{4}
+}
+func (m *UnlockScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockScheduleEntry.Merge(m, src)
+}
+func (m *UnlockScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockScheduleEntry proto.InternalMessageInfo
+
+func (m *UnlockScheduleEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *UnlockScheduleEntry) GetUnlockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnlockedCoins
+	}
+	return nil
+}
+
+// LegacyVestingState defines the state of a legacy x/auth vesting account migrated to a lockup account.
+type LegacyVestingState struct {
+	// original_locking is the original vesting amount of the legacy account.
+	OriginalLocking github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=original_locking,json=originalLocking,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"original_locking"`
+	// delegated_free is the delegated vested amount of the legacy account.
+	DelegatedFree github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=delegated_free,json=delegatedFree,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_free"`
+	// delegated_locking is the delegated vesting amount of the legacy account.
+	DelegatedLocking github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=delegated_locking,json=delegatedLocking,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_locking"`
+}
+
+func (m *LegacyVestingState) Reset()         { *m = LegacyVestingState{} }
+func (m *LegacyVestingState) String() string { return proto.CompactTextString(m) }
+func (*LegacyVestingState) ProtoMessage()    {}
+func (*LegacyVestingState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9783f5e2b76d96, []int{5}
+}
+func (m *LegacyVestingState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LegacyVestingState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LegacyVestingState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LegacyVestingState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyVestingState.Merge(m, src)
+}
+func (m *LegacyVestingState) XXX_Size() int {
+	return m.Size()
+}
+func (m *LegacyVestingState) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyVestingState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyVestingState proto.InternalMessageInfo
+
+func (m *LegacyVestingState) GetOriginalLocking() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OriginalLocking
+	}
+	return nil
+}
+
+func (m *LegacyVestingState) GetDelegatedFree() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedFree
+	}
+	return nil
+}
+
+func (m *LegacyVestingState) GetDelegatedLocking() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedLocking
+	}
+	return nil
+}
+
+// SpendAllowance defines an allowance granted by the owner of a lockup account, which lets the grantee spend
+// the unlocked funds of the account.
+type SpendAllowance struct {
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// spend_limit is the remaining amount the grantee can spend, it is unlimited if empty.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// expiration is the time after which the allowance cannot be spent, if any.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *SpendAllowance) Reset()         { *m = SpendAllowance{} }
+func (m *SpendAllowance) String() string { return proto.CompactTextString(m) }
+func (*SpendAllowance) ProtoMessage()    {}
+func (*SpendAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9783f5e2b76d96, []int{6}
+}
+func (m *SpendAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendAllowance.Merge(m, src)
+}
+func (m *SpendAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendAllowance proto.InternalMessageInfo
+
+func (m *SpendAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *SpendAllowance) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *SpendAllowance) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.accounts.defaults.lockup.v1.Period")
+	proto.RegisterType((*DenomLockingSchedule)(nil), "cosmos.accounts.defaults.lockup.v1.DenomLockingSchedule")
 	proto.RegisterType((*UnbondingEntries)(nil), "cosmos.accounts.defaults.lockup.v1.UnbondingEntries")
 	proto.RegisterType((*UnbondingEntry)(nil), "cosmos.accounts.defaults.lockup.v1.UnbondingEntry")
+	proto.RegisterType((*UnlockScheduleEntry)(nil), "cosmos.accounts.defaults.lockup.v1.UnlockScheduleEntry")
+	proto.RegisterType((*LegacyVestingState)(nil), "cosmos.accounts.defaults.lockup.v1.LegacyVestingState")
+	proto.RegisterType((*SpendAllowance)(nil), "cosmos.accounts.defaults.lockup.v1.SpendAllowance")
 }
 
 func init() {
@@ -213,42 +462,104 @@ func init() {
 }
 
 var fileDescriptor_6b9783f5e2b76d96 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0xe3, 0x06, 0xa5, 0xd4, 0x40, 0x69, 0x57, 0x1c, 0xd2, 0x48, 0x6c, 0x42, 0x2e, 0x44,
-	0x91, 0x6a, 0x2b, 0xe5, 0xca, 0x01, 0x42, 0x40, 0x1c, 0x10, 0x42, 0xe1, 0xcf, 0x81, 0x4b, 0xf0,
-	0xae, 0x5d, 0xc7, 0xca, 0xae, 0x1d, 0xad, 0xbd, 0x2b, 0xf2, 0x10, 0x48, 0x3d, 0x22, 0x9e, 0x00,
-	0x71, 0xea, 0x81, 0x57, 0x40, 0xea, 0xb1, 0xe2, 0xc4, 0x89, 0xa2, 0xe4, 0xd0, 0xd7, 0x40, 0x6b,
-	0x7b, 0x2b, 0xa5, 0x08, 0xb8, 0xec, 0xce, 0xce, 0xf8, 0xfb, 0xc6, 0xf3, 0x1b, 0x2d, 0xc4, 0xb1,
-	0xd2, 0xa9, 0xd2, 0x98, 0xc4, 0xb1, 0xca, 0xa5, 0xd1, 0x98, 0xb2, 0x43, 0x92, 0x27, 0x46, 0xe3,
-	0x44, 0xc5, 0xb3, 0x7c, 0x8e, 0x8b, 0x81, 0x8f, 0xd0, 0x3c, 0x53, 0x46, 0x05, 0x5d, 0x27, 0x40,
-	0x95, 0x00, 0x55, 0x02, 0xe4, 0x8f, 0x15, 0x83, 0xd6, 0x2e, 0x49, 0x85, 0x54, 0xd8, 0x3e, 0x9d,
-	0xac, 0x15, 0xfa, 0x3e, 0x11, 0xd1, 0x0c, 0x17, 0x83, 0x88, 0x19, 0x32, 0xc0, 0xb1, 0x12, 0xd2,
-	0xd7, 0x6f, 0x71, 0xc5, 0x95, 0x0d, 0x71, 0x19, 0xf9, 0xec, 0x9e, 0x53, 0x4d, 0x5c, 0xc1, 0x77,
-	0xf6, 0x86, 0x5c, 0x29, 0x9e, 0x30, 0x6c, 0xbf, 0xa2, 0xfc, 0x10, 0xd3, 0x3c, 0x23, 0x46, 0xa8,
-	0xca, 0xb0, 0x7d, 0xb9, 0x6e, 0x44, 0xca, 0xb4, 0x21, 0xa9, 0x1f, 0xa4, 0xfb, 0x0d, 0xc0, 0xc6,
-	0x0b, 0x96, 0x09, 0x45, 0x83, 0x07, 0xb0, 0x91, 0x30, 0xc9, 0xcd, 0xb4, 0x09, 0x3a, 0xa0, 0x77,
-	0xed, 0x60, 0x0f, 0x39, 0x31, 0xaa, 0xc4, 0x68, 0xe4, 0xcd, 0x87, 0x37, 0x4e, 0x7e, 0xb6, 0x6b,
-	0x1f, 0xcf, 0xda, 0xe0, 0xf3, 0xf9, 0x71, 0x1f, 0x8c, 0xbd, 0x2e, 0x58, 0xc0, 0x06, 0x49, 0x4b,
-	0x1e, 0xcd, 0x8d, 0x4e, 0xdd, 0x3a, 0xf8, 0xcb, 0x96, 0xf3, 0x22, 0x3f, 0x2f, 0x7a, 0xa4, 0x84,
-	0x1c, 0x3e, 0x29, 0x1d, 0xbe, 0x9c, 0xb5, 0x7b, 0x5c, 0x98, 0x69, 0x1e, 0xa1, 0x58, 0xa5, 0xd5,
-	0x12, 0xdc, 0x6b, 0x5f, 0xd3, 0x19, 0x36, 0x8b, 0x39, 0xd3, 0x56, 0xa0, 0x3f, 0x9d, 0x1f, 0xf7,
-	0xaf, 0x27, 0x8c, 0x93, 0x78, 0x31, 0x29, 0x89, 0x69, 0xdf, 0xda, 0x35, 0xec, 0xbe, 0x83, 0x3b,
-	0xaf, 0x65, 0xa4, 0x24, 0x15, 0x92, 0x3f, 0x96, 0x26, 0x13, 0x4c, 0x07, 0xcf, 0xe0, 0x26, 0x73,
-	0x61, 0x13, 0xd8, 0xfb, 0x1c, 0xa0, 0xff, 0xaf, 0x0d, 0xad, 0xd9, 0x2c, 0xc6, 0x95, 0x45, 0xf7,
-	0xc3, 0x06, 0xdc, 0x5e, 0xaf, 0x05, 0x77, 0xe1, 0xcd, 0x38, 0x63, 0x16, 0xc9, 0x64, 0xca, 0x04,
-	0x9f, 0x1a, 0x8b, 0xae, 0x3e, 0xde, 0xae, 0xd2, 0x4f, 0x6d, 0x36, 0x18, 0xc1, 0xab, 0x4c, 0xd2,
-	0x49, 0x09, 0xbf, 0xb9, 0x61, 0xe1, 0xb6, 0xfe, 0x80, 0xfb, 0xaa, 0xda, 0x8c, 0xa3, 0x7b, 0x74,
-	0x41, 0x77, 0x93, 0x49, 0x5a, 0x16, 0x83, 0xfb, 0x17, 0x78, 0xeb, 0x7e, 0x41, 0x7f, 0xc5, 0xbb,
-	0x55, 0x5a, 0xac, 0x11, 0x0a, 0x9e, 0xc3, 0xdd, 0x82, 0x24, 0x82, 0x12, 0xa3, 0xb2, 0x09, 0xa1,
-	0x34, 0x63, 0x5a, 0x37, 0xaf, 0x74, 0x40, 0x6f, 0x6b, 0x78, 0xe7, 0xfb, 0xd7, 0xfd, 0xdb, 0xde,
-	0xeb, 0x4d, 0x75, 0xe6, 0xa1, 0x3b, 0xf2, 0xd2, 0x64, 0x42, 0xf2, 0xf1, 0x4e, 0x71, 0x29, 0x3f,
-	0x1c, 0x9d, 0x2c, 0x43, 0x70, 0xba, 0x0c, 0xc1, 0xaf, 0x65, 0x08, 0x8e, 0x56, 0x61, 0xed, 0x74,
-	0x15, 0xd6, 0x7e, 0xac, 0xc2, 0xda, 0xdb, 0xbe, 0xb3, 0xd2, 0x74, 0x86, 0x84, 0xc2, 0xef, 0xff,
-	0xf5, 0x57, 0x45, 0x0d, 0x3b, 0xff, 0xbd, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xd3, 0xd6,
-	0xb7, 0x82, 0x03, 0x00, 0x00,
+	// 793 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xbe, 0x39, 0x1f, 0x77, 0xdc, 0x84, 0xf8, 0x7c, 0x83, 0x8b, 0xcd, 0x49, 0xd8, 0x61, 0x1b,
+	0x22, 0x4b, 0xb7, 0x2b, 0x1f, 0x1d, 0x02, 0x29, 0x31, 0x26, 0x4a, 0x61, 0x21, 0xe4, 0x23, 0x29,
+	0x28, 0x58, 0xc6, 0x3b, 0xef, 0xd6, 0x23, 0xef, 0xce, 0x58, 0x3b, 0xb3, 0x26, 0x6e, 0xa9, 0x90,
+	0xf8, 0xa1, 0x94, 0x88, 0x8a, 0x12, 0x51, 0xa5, 0xc8, 0xbf, 0x10, 0x29, 0x65, 0x94, 0x02, 0x51,
+	0x71, 0xe8, 0xae, 0x08, 0x7f, 0x06, 0x9a, 0x1f, 0x6b, 0x72, 0x41, 0x70, 0x40, 0xe1, 0xc6, 0x9e,
+	0x9d, 0xf7, 0xbe, 0xef, 0x7b, 0xef, 0x9b, 0x37, 0xbb, 0x38, 0x4e, 0xa5, 0x2a, 0xa4, 0x8a, 0x69,
+	0x9a, 0xca, 0x4a, 0x68, 0x15, 0x33, 0x38, 0xa1, 0x55, 0xae, 0x55, 0x9c, 0xcb, 0x74, 0x56, 0xcd,
+	0xe3, 0x45, 0xdf, 0xaf, 0xa2, 0x79, 0x29, 0xb5, 0x24, 0xa1, 0x03, 0x44, 0x35, 0x20, 0xaa, 0x01,
+	0x91, 0x4f, 0x5b, 0xf4, 0x0f, 0xf6, 0x69, 0xc1, 0x85, 0x8c, 0xed, 0xaf, 0x83, 0x1d, 0x74, 0xbc,
+	0xce, 0x84, 0x2a, 0x88, 0x17, 0xfd, 0x09, 0x68, 0xda, 0x8f, 0x53, 0xc9, 0x85, 0x8f, 0xb7, 0x33,
+	0x99, 0x49, 0xbb, 0x8c, 0xcd, 0xca, 0xef, 0x5e, 0x73, 0xa8, 0xc4, 0x05, 0xbc, 0xb2, 0x27, 0xcc,
+	0xa4, 0xcc, 0x72, 0x88, 0xed, 0xd3, 0xa4, 0x3a, 0x89, 0x59, 0x55, 0x52, 0xcd, 0x65, 0x4d, 0xd8,
+	0x7d, 0x39, 0xae, 0x79, 0x01, 0x4a, 0xd3, 0xc2, 0x37, 0x12, 0x3e, 0x46, 0x78, 0xfb, 0x23, 0x28,
+	0xb9, 0x64, 0xe4, 0x26, 0xde, 0xce, 0x41, 0x64, 0x7a, 0x1a, 0xa0, 0xeb, 0xe8, 0xc6, 0x95, 0xa3,
+	0x6b, 0x91, 0x03, 0x47, 0x35, 0x38, 0x1a, 0x7a, 0xf2, 0xc1, 0xd5, 0x27, 0xbf, 0x76, 0x37, 0xbe,
+	0x3b, 0xed, 0xa2, 0x1f, 0x9f, 0x3f, 0xec, 0xa1, 0xb1, 0xc7, 0x91, 0x25, 0xde, 0xa6, 0x85, 0xf1,
+	0x23, 0xd8, 0xbc, 0xde, 0xb0, 0x0c, 0xbe, 0x58, 0xd3, 0x6f, 0xe4, 0xfb, 0x8d, 0xde, 0x97, 0x5c,
+	0x0c, 0x6e, 0x1b, 0x86, 0x9f, 0x4e, 0xbb, 0x37, 0x32, 0xae, 0xa7, 0xd5, 0x24, 0x4a, 0x65, 0x51,
+	0x1f, 0x82, 0xfb, 0x3b, 0x54, 0x6c, 0x16, 0xeb, 0xe5, 0x1c, 0x94, 0x05, 0xa8, 0xef, 0x9f, 0x3f,
+	0xec, 0xbd, 0x96, 0x43, 0x46, 0xd3, 0x65, 0x62, 0x1c, 0x53, 0x5e, 0xda, 0x09, 0x86, 0x3f, 0x23,
+	0xdc, 0x1e, 0x82, 0x90, 0xc5, 0x48, 0xa6, 0x33, 0x2e, 0xb2, 0xe3, 0x74, 0x0a, 0xac, 0xca, 0x81,
+	0xb4, 0xf1, 0x2b, 0xcc, 0xec, 0xdb, 0xa6, 0x76, 0xc7, 0xee, 0x81, 0xdc, 0xc1, 0x58, 0x69, 0x5a,
+	0xea, 0xc4, 0xf8, 0x11, 0x6c, 0xda, 0x7e, 0x0f, 0xfe, 0xd2, 0xef, 0xc7, 0xb5, 0x59, 0xae, 0xe1,
+	0x07, 0xab, 0x86, 0x77, 0x2d, 0xd8, 0x84, 0xc9, 0xa7, 0x78, 0x2f, 0x77, 0x92, 0xc9, 0xdc, 0xfa,
+	0xa8, 0x82, 0x86, 0x6d, 0xbe, 0x17, 0x5d, 0x3e, 0x23, 0x91, 0xb3, 0x7e, 0xb0, 0x6b, 0xe8, 0x1d,
+	0x75, 0xd3, 0xb3, 0xb9, 0x88, 0x0a, 0x3f, 0xc3, 0xad, 0xbb, 0x62, 0x22, 0x05, 0xe3, 0x22, 0xfb,
+	0x40, 0xe8, 0x92, 0x83, 0x22, 0x23, 0xbc, 0x03, 0x6e, 0x19, 0x20, 0xab, 0x75, 0xf4, 0x6f, 0xb4,
+	0x2e, 0xd0, 0x2c, 0xc7, 0x35, 0x45, 0xf8, 0xcd, 0x26, 0x6e, 0x5e, 0x8c, 0x91, 0xb7, 0xf0, 0x5e,
+	0x5a, 0x82, 0x3d, 0xeb, 0x64, 0x0a, 0x3c, 0x9b, 0x6a, 0x6b, 0x5f, 0x63, 0xdc, 0xac, 0xb7, 0xef,
+	0xd8, 0x5d, 0x32, 0xc4, 0xaf, 0x82, 0x60, 0xff, 0xd3, 0xc5, 0x1d, 0x10, 0xcc, 0x7a, 0xf8, 0xee,
+	0x6a, 0x6e, 0x1a, 0x7e, 0xf2, 0xfe, 0x76, 0x6e, 0x5e, 0x70, 0xca, 0x63, 0xc8, 0x87, 0x78, 0x7f,
+	0x41, 0x73, 0xce, 0xa8, 0x96, 0x65, 0x42, 0x19, 0x2b, 0x41, 0xa9, 0x60, 0xcb, 0x9c, 0xf6, 0xe0,
+	0xcd, 0x67, 0x8f, 0x0e, 0xdf, 0xf0, 0x5c, 0xf7, 0xea, 0x9c, 0x5b, 0x2e, 0xe5, 0x58, 0x97, 0x5c,
+	0x64, 0xe3, 0xd6, 0xe2, 0xa5, 0xfd, 0xf0, 0x14, 0xe1, 0xd7, 0xef, 0x0a, 0xe3, 0x5b, 0x3d, 0x44,
+	0xce, 0x94, 0xf7, 0xf0, 0x96, 0xed, 0x13, 0xfd, 0xd7, 0x3e, 0x2d, 0x8c, 0x7c, 0x89, 0x70, 0xb3,
+	0xb2, 0xb4, 0xc0, 0xdc, 0x04, 0xaf, 0xef, 0x96, 0x5c, 0xad, 0x85, 0x6d, 0x52, 0xf8, 0xb8, 0x81,
+	0xc9, 0xc8, 0x26, 0xdd, 0x03, 0xa5, 0xcd, 0x6d, 0xd1, 0x54, 0x03, 0xf9, 0x1a, 0xe1, 0x96, 0x2c,
+	0x79, 0xc6, 0x05, 0xcd, 0x13, 0x3f, 0x86, 0x01, 0x5a, 0x57, 0x8d, 0x7b, 0xb5, 0xb4, 0xbf, 0xc1,
+	0xd6, 0x30, 0x06, 0x26, 0x51, 0x03, 0x4b, 0x4e, 0x4a, 0x80, 0x35, 0x1a, 0xb6, 0x12, 0xbe, 0x5d,
+	0x02, 0x90, 0x6f, 0x11, 0xde, 0xff, 0xb3, 0x94, 0xda, 0x9a, 0xc6, 0xba, 0xaa, 0x69, 0xad, 0xb4,
+	0xbd, 0x37, 0xef, 0x6c, 0xfd, 0xfe, 0x43, 0x17, 0x85, 0x5f, 0x6d, 0xe2, 0xe6, 0xf1, 0x1c, 0x04,
+	0xbb, 0x95, 0xe7, 0xf2, 0x73, 0x2a, 0x52, 0x20, 0x47, 0x78, 0x27, 0x2b, 0xa9, 0xd0, 0xe0, 0xe6,
+	0x74, 0x77, 0x10, 0x3c, 0x7b, 0x74, 0xd8, 0xf6, 0x15, 0x5e, 0x9c, 0xfc, 0x3a, 0x91, 0x7c, 0x81,
+	0xf0, 0x15, 0x65, 0x68, 0x92, 0x9c, 0x17, 0x7c, 0x8d, 0x2f, 0x6f, 0x6c, 0x55, 0x47, 0x46, 0x94,
+	0xdc, 0xc4, 0x18, 0xee, 0xcf, 0xb9, 0xfb, 0xc0, 0x04, 0x8d, 0x4b, 0xef, 0xd8, 0x96, 0xb9, 0x5f,
+	0xe3, 0x17, 0x30, 0x83, 0xe1, 0x93, 0xb3, 0x0e, 0x7a, 0x7a, 0xd6, 0x41, 0xbf, 0x9d, 0x75, 0xd0,
+	0x83, 0xf3, 0xce, 0xc6, 0xd3, 0xf3, 0xce, 0xc6, 0x2f, 0xe7, 0x9d, 0x8d, 0x4f, 0x7a, 0xae, 0x2a,
+	0xc5, 0x66, 0x11, 0x97, 0xf1, 0xfd, 0x7f, 0xfa, 0xcc, 0x4f, 0xb6, 0xad, 0xd6, 0xdb, 0x7f, 0x0c,
+	0x00, 0x2d, 0xf8, 0xce, 0x42, 0x13, 0x08, 0x00, 0x00,
 }
 
+func (this *LegacyVestingState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LegacyVestingState)
+	if !ok {
+		that2, ok := that.(LegacyVestingState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.OriginalLocking) != len(that1.OriginalLocking) {
+		return false
+	}
+	for i := range this.OriginalLocking {
+		if !this.OriginalLocking[i].Equal(&that1.OriginalLocking[i]) {
+			return false
+		}
+	}
+	if len(this.DelegatedFree) != len(that1.DelegatedFree) {
+		return false
+	}
+	for i := range this.DelegatedFree {
+		if !this.DelegatedFree[i].Equal(&that1.DelegatedFree[i]) {
+			return false
+		}
+	}
+	if len(this.DelegatedLocking) != len(that1.DelegatedLocking) {
+		return false
+	}
+	for i := range this.DelegatedLocking {
+		if !this.DelegatedLocking[i].Equal(&that1.DelegatedLocking[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Period) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -294,6 +605,58 @@ func (m *Period) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomLockingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomLockingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomLockingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockingPeriods) > 0 {
+		for iNdEx := len(m.LockingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLockup(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLockup(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UnbondingEntries) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -368,12 +731,12 @@ func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintLockup(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintLockup(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -384,37 +747,222 @@ func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLockup(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLockup(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnlockScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Period) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UnlockScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Length)
-	n += 1 + l + sovLockup(uint64(l))
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovLockup(uint64(l))
+	if len(m.UnlockedCoins) > 0 {
+		for iNdEx := len(m.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLockup(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingEntries) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LegacyVestingState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LegacyVestingState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LegacyVestingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatedLocking) > 0 {
+		for iNdEx := len(m.DelegatedLocking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedLocking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for iNdEx := len(m.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedFree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OriginalLocking) > 0 {
+		for iNdEx := len(m.OriginalLocking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalLocking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintLockup(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintLockup(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLockup(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLockup(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Period) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Length)
+	n += 1 + l + sovLockup(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomLockingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLockup(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovLockup(uint64(l))
+	if len(m.LockingPeriods) > 0 {
+		for _, e := range m.LockingPeriods {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingEntries) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -444,16 +992,433 @@ func (m *UnbondingEntry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLockup(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *UnlockScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLockup(uint64(l))
+	if len(m.UnlockedCoins) > 0 {
+		for _, e := range m.UnlockedCoins {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LegacyVestingState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OriginalLocking) > 0 {
+		for _, e := range m.OriginalLocking {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for _, e := range m.DelegatedFree {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	if len(m.DelegatedLocking) > 0 {
+		for _, e := range m.DelegatedLocking {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpendAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovLockup(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovLockup(uint64(l))
+	}
+	return n
+}
+
+func sovLockup(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLockup(x uint64) (n int) {
+	return sovLockup(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Period) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Length, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomLockingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomLockingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomLockingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockingPeriods = append(m.LockingPeriods, Period{})
+			if err := m.LockingPeriods[len(m.LockingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingEntries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &UnbondingEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovLockup(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLockup(x uint64) (n int) {
-	return sovLockup(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Period) Unmarshal(dAtA []byte) error {
+func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -476,15 +1441,34 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -511,11 +1495,11 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Length, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -544,11 +1528,42 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLockup(dAtA[iNdEx:])
@@ -570,7 +1585,7 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingEntries) Unmarshal(dAtA []byte) error {
+func (m *UnlockScheduleEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -593,15 +1608,15 @@ func (m *UnbondingEntries) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingEntries: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockScheduleEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -628,8 +1643,41 @@ func (m *UnbondingEntries) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &UnbondingEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockedCoins = append(m.UnlockedCoins, types.Coin{})
+			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -654,7 +1702,7 @@ func (m *UnbondingEntries) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
+func (m *LegacyVestingState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -677,17 +1725,17 @@ func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: LegacyVestingState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LegacyVestingState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalLocking", wireType)
 			}
-			m.CreationHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLockup
@@ -697,14 +1745,29 @@ func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalLocking = append(m.OriginalLocking, types.Coin{})
+			if err := m.OriginalLocking[len(m.OriginalLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -731,13 +1794,14 @@ func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedLocking", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -764,13 +1828,64 @@ func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatedLocking = append(m.DelegatedLocking, types.Coin{})
+			if err := m.DelegatedLocking[len(m.DelegatedLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -798,7 +1913,77 @@ func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
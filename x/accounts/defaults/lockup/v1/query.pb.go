@@ -85,6 +85,20 @@ type QueryLockupAccountInfoResponse struct {
 	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
 	// owner defines the value of the owner of the lockup account.
 	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// admin defines the address allowed to claw back the locked funds, empty if clawback is disabled.
+	Admin string `protobuf:"bytes,9,opt,name=admin,proto3" json:"admin,omitempty"`
+	// clawback_pending defines the clawed back funds being undelegated, which are locked until they are
+	// sent to the admin.
+	ClawbackPending github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=clawback_pending,json=clawbackPending,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawback_pending"`
+	// cliff_time defines the time before which no coins are unlocked, only set for cliff locking accounts.
+	CliffTime *time.Time `protobuf:"bytes,11,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time,omitempty"`
+	// pending_owner defines the address the ownership is being transferred to, empty if there is no pending
+	// ownership transfer.
+	PendingOwner string `protobuf:"bytes,12,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	// auto_compound defines whether the withdrawn staking rewards are re-delegated to the same validator.
+	AutoCompound bool `protobuf:"varint,13,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
+	// frozen defines whether the sends of the lockup account are frozen by its owner.
+	Frozen bool `protobuf:"varint,14,opt,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
 func (m *QueryLockupAccountInfoResponse) Reset()         { *m = QueryLockupAccountInfoResponse{} }
@@ -176,6 +190,48 @@ func (m *QueryLockupAccountInfoResponse) GetOwner() string {
 	return ""
 }
 
+func (m *QueryLockupAccountInfoResponse) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *QueryLockupAccountInfoResponse) GetClawbackPending() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawbackPending
+	}
+	return nil
+}
+
+func (m *QueryLockupAccountInfoResponse) GetCliffTime() *time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return nil
+}
+
+func (m *QueryLockupAccountInfoResponse) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
+func (m *QueryLockupAccountInfoResponse) GetAutoCompound() bool {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return false
+}
+
+func (m *QueryLockupAccountInfoResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 // QueryUnbondingEntriesRequest is used to query the lockup account unbonding entries.
 type QueryUnbondingEntriesRequest struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -308,6 +364,8 @@ var xxx_messageInfo_QueryLockingPeriodsRequest proto.InternalMessageInfo
 type QueryLockingPeriodsResponse struct {
 	// lockup_periods defines the value of the periodic lockup account locking periods.
 	LockingPeriods []*Period `protobuf:"bytes,1,rep,name=locking_periods,json=lockingPeriods,proto3" json:"locking_periods,omitempty"`
+	// denom_schedules defines the locking schedules of the denoms which do not follow the locking periods.
+	DenomSchedules []DenomLockingSchedule `protobuf:"bytes,2,rep,name=denom_schedules,json=denomSchedules,proto3" json:"denom_schedules"`
 }
 
 func (m *QueryLockingPeriodsResponse) Reset()         { *m = QueryLockingPeriodsResponse{} }
@@ -350,6 +408,13 @@ func (m *QueryLockingPeriodsResponse) GetLockingPeriods() []*Period {
 	return nil
 }
 
+func (m *QueryLockingPeriodsResponse) GetDenomSchedules() []DenomLockingSchedule {
+	if m != nil {
+		return m.DenomSchedules
+	}
+	return nil
+}
+
 // QuerySpendableAmountRequest is used to query the lockup account total spendable tokens.
 type QuerySpendableAmountRequest struct {
 }
@@ -432,337 +497,542 @@ func (m *QuerySpendableAmountResponse) GetSpendableTokens() github_com_cosmos_co
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryLockupAccountInfoRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoRequest")
-	proto.RegisterType((*QueryLockupAccountInfoResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse")
-	proto.RegisterType((*QueryUnbondingEntriesRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryUnbondingEntriesRequest")
-	proto.RegisterType((*QueryUnbondingEntriesResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryUnbondingEntriesResponse")
-	proto.RegisterType((*QueryLockingPeriodsRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsRequest")
-	proto.RegisterType((*QueryLockingPeriodsResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse")
-	proto.RegisterType((*QuerySpendableAmountRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QuerySpendableAmountRequest")
-	proto.RegisterType((*QuerySpendableAmountResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QuerySpendableAmountResponse")
+// QueryUnlockScheduleRequest is used to query the lockup account unlock schedule.
+type QueryUnlockScheduleRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("cosmos/accounts/defaults/lockup/v1/query.proto", fileDescriptor_f2c1403191515490)
+func (m *QueryUnlockScheduleRequest) Reset()         { *m = QueryUnlockScheduleRequest{} }
+func (m *QueryUnlockScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockScheduleRequest) ProtoMessage()    {}
+func (*QueryUnlockScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{8}
+}
+func (m *QueryUnlockScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockScheduleRequest.Merge(m, src)
+}
+func (m *QueryUnlockScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockScheduleRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_f2c1403191515490 = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x4e, 0xdb, 0x4c,
-	0x14, 0x8e, 0x7f, 0xee, 0xc3, 0xff, 0x43, 0x88, 0x58, 0x84, 0xfc, 0xe0, 0x50, 0xaf, 0x22, 0x24,
-	0x66, 0x1a, 0xba, 0xec, 0xa2, 0x22, 0xbd, 0x48, 0x95, 0x50, 0xd5, 0x1a, 0xda, 0x45, 0x37, 0x96,
-	0x9d, 0x39, 0x71, 0x47, 0x71, 0x66, 0xc2, 0xcc, 0x38, 0x85, 0x5d, 0x1f, 0x81, 0x55, 0x1f, 0xa2,
-	0xeb, 0x3e, 0x04, 0x4b, 0xd4, 0x55, 0x57, 0xa5, 0x82, 0xf7, 0xa8, 0x2a, 0x7b, 0x66, 0x82, 0x80,
-	0x5e, 0x58, 0xc0, 0x2a, 0x3e, 0x73, 0xce, 0xf9, 0x2e, 0x3e, 0x3e, 0x13, 0x84, 0xbb, 0x42, 0x0d,
-	0x84, 0x22, 0x71, 0xb7, 0x2b, 0x72, 0xae, 0x15, 0xa1, 0xd0, 0x8b, 0xf3, 0x4c, 0x2b, 0x92, 0x89,
-	0x6e, 0x3f, 0x1f, 0x92, 0x51, 0x9b, 0xec, 0xe7, 0x20, 0x0f, 0xf1, 0x50, 0x0a, 0x2d, 0x6a, 0x81,
-	0xa9, 0xc7, 0xae, 0x1e, 0xbb, 0x7a, 0x6c, 0xea, 0xf1, 0xa8, 0xdd, 0x20, 0x37, 0xc0, 0xb4, 0xd5,
-	0x25, 0x68, 0xc3, 0xb7, 0x0d, 0x49, 0xac, 0x80, 0x8c, 0xda, 0x09, 0xe8, 0xb8, 0x4d, 0xba, 0x82,
-	0x71, 0x9b, 0x5f, 0x4e, 0x45, 0x2a, 0xca, 0x47, 0x52, 0x3c, 0xd9, 0xd3, 0x66, 0x2a, 0x44, 0x9a,
-	0x01, 0x29, 0xa3, 0x24, 0xef, 0x11, 0xcd, 0x06, 0xa0, 0x74, 0x3c, 0x70, 0xb0, 0x2b, 0x06, 0x36,
-	0x32, 0x9d, 0x56, 0x78, 0x19, 0x04, 0x4d, 0xb4, 0xf6, 0xaa, 0x70, 0xb5, 0x53, 0xca, 0xd8, 0x36,
-	0x42, 0x9f, 0xf3, 0x9e, 0x08, 0x61, 0x3f, 0x07, 0xa5, 0x83, 0x1f, 0x53, 0xc8, 0xff, 0x5d, 0x85,
-	0x1a, 0x0a, 0xae, 0xa0, 0x36, 0x42, 0x55, 0x21, 0x59, 0xca, 0x78, 0x9c, 0x45, 0x85, 0x1d, 0xc6,
-	0xd3, 0xba, 0xb7, 0x3e, 0xd1, 0x9a, 0xdf, 0x5a, 0xb1, 0x6f, 0x15, 0x17, 0x86, 0xb0, 0x35, 0x84,
-	0x1f, 0x0b, 0xc6, 0x3b, 0xf7, 0x8f, 0xbf, 0x35, 0x2b, 0x9f, 0x4e, 0x9b, 0xad, 0x94, 0xe9, 0x77,
-	0x79, 0x82, 0xbb, 0x62, 0xe0, 0x5e, 0x97, 0xf9, 0xd9, 0x54, 0xb4, 0x4f, 0xf4, 0xe1, 0x10, 0x54,
-	0xd9, 0xa0, 0xc2, 0x45, 0x47, 0xb2, 0x63, 0x38, 0x6a, 0x12, 0x2d, 0x50, 0xc8, 0x20, 0x8d, 0x35,
-	0xd0, 0xa8, 0x27, 0x01, 0xea, 0xff, 0xdc, 0x3e, 0xeb, 0x7f, 0x63, 0x8a, 0x67, 0x12, 0xa0, 0x76,
-	0x80, 0x96, 0x2e, 0x38, 0x9d, 0xd9, 0x89, 0xdb, 0xa7, 0xad, 0x8e, 0x59, 0x9c, 0xdb, 0x47, 0x08,
-	0x29, 0x1d, 0x4b, 0x1d, 0x15, 0xd3, 0xad, 0x4f, 0xae, 0x7b, 0xad, 0xf9, 0xad, 0x06, 0x36, 0xa3,
-	0xc7, 0x6e, 0xf4, 0x78, 0xcf, 0x8d, 0xbe, 0x33, 0x79, 0x74, 0xda, 0xf4, 0xc2, 0xb9, 0xb2, 0xa7,
-	0x38, 0xad, 0x3d, 0x44, 0xb3, 0xc0, 0xa9, 0x69, 0x9f, 0xba, 0x61, 0xfb, 0x0c, 0x70, 0x5a, 0x36,
-	0x73, 0xf4, 0x6f, 0xe1, 0x16, 0x68, 0x54, 0x7c, 0x8e, 0xaa, 0x3e, 0x7d, 0xfb, 0x96, 0xe7, 0x0d,
-	0x41, 0x19, 0x14, 0xb3, 0xcd, 0xf9, 0x25, 0xc6, 0x99, 0x3b, 0x98, 0xad, 0xa3, 0x30, 0x9c, 0xcb,
-	0x68, 0x4a, 0xbc, 0xe7, 0x20, 0xeb, 0xb3, 0xeb, 0x5e, 0x6b, 0x2e, 0x34, 0x41, 0xc0, 0xd1, 0x6a,
-	0xf9, 0xfd, 0xbf, 0xe6, 0x89, 0xe0, 0x94, 0xf1, 0xf4, 0x29, 0xd7, 0x92, 0x81, 0xb2, 0x0b, 0x52,
-	0x7b, 0x81, 0x96, 0x46, 0x71, 0xc6, 0x68, 0xac, 0x85, 0x8c, 0x62, 0x4a, 0x25, 0x28, 0x55, 0xf7,
-	0x0a, 0x84, 0xce, 0xbd, 0x2f, 0x9f, 0x37, 0xd7, 0xac, 0xde, 0x37, 0xae, 0x66, 0xdb, 0x94, 0xec,
-	0x6a, 0xc9, 0x78, 0x1a, 0x56, 0x47, 0x57, 0xce, 0x83, 0x0f, 0x9e, 0x5d, 0xc9, 0xeb, 0x84, 0x76,
-	0xdf, 0x22, 0xb4, 0x94, 0xbb, 0x5c, 0x04, 0x26, 0x69, 0x17, 0x6e, 0x0b, 0xff, 0xfd, 0x5a, 0xc2,
-	0x97, 0x80, 0x0f, 0xc3, 0x6a, 0x7e, 0x85, 0x28, 0x58, 0x45, 0x8d, 0xf1, 0xca, 0x33, 0x9e, 0xbe,
-	0x04, 0xc9, 0x04, 0x75, 0x86, 0x03, 0x89, 0xfe, 0xff, 0x65, 0xd6, 0xaa, 0xdb, 0x45, 0x8b, 0x76,
-	0x2f, 0xa2, 0xa1, 0x49, 0x59, 0x6d, 0x1b, 0x37, 0xd1, 0x66, 0xd0, 0xc2, 0x85, 0xec, 0x12, 0x78,
-	0xb0, 0x66, 0x39, 0x77, 0x87, 0xc0, 0x69, 0x9c, 0x64, 0xb0, 0x3d, 0x28, 0x20, 0x9c, 0xa4, 0x8f,
-	0x9e, 0x1d, 0xd2, 0xb5, 0xfc, 0xc5, 0x15, 0xa5, 0x5c, 0x2a, 0xd2, 0xa2, 0x0f, 0x5c, 0xdd, 0xc9,
-	0x15, 0x35, 0x26, 0xd9, 0x2b, 0x39, 0x3a, 0x4f, 0x8e, 0xcf, 0x7c, 0xef, 0xe4, 0xcc, 0xf7, 0xbe,
-	0x9f, 0xf9, 0xde, 0xd1, 0xb9, 0x5f, 0x39, 0x39, 0xf7, 0x2b, 0x5f, 0xcf, 0xfd, 0xca, 0xdb, 0x0d,
-	0x03, 0xa1, 0x68, 0x1f, 0x33, 0x41, 0x0e, 0xfe, 0xf4, 0x1f, 0x91, 0x4c, 0x97, 0xfb, 0xf9, 0xe0,
-	0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0xa7, 0xeb, 0x0d, 0xa4, 0x06, 0x00, 0x00,
+var xxx_messageInfo_QueryUnlockScheduleRequest proto.InternalMessageInfo
+
+// QueryUnlockScheduleResponse returns the lockup account unlock schedule.
+type QueryUnlockScheduleResponse struct {
+	// schedule defines the cumulative unlocked coins at each point of the unlock schedule, ordered by time. No
+	// coins are unlocked before the first entry, and all the coins are unlocked at the last one. It is empty if
+	// the coins are never unlocked.
+	Schedule []UnlockScheduleEntry `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule"`
+	// linear defines whether the coins unlock linearly between two consecutive entries of the schedule. Otherwise,
+	// the unlocked coins of an entry are released at once at its time.
+	Linear bool `protobuf:"varint,2,opt,name=linear,proto3" json:"linear,omitempty"`
+	// next_unlock defines the first entry of the schedule after the current block time, unset if all the coins
+	// are unlocked.
+	NextUnlock *UnlockScheduleEntry `protobuf:"bytes,3,opt,name=next_unlock,json=nextUnlock,proto3" json:"next_unlock,omitempty"`
 }
 
-func (m *QueryLockupAccountInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnlockScheduleResponse) Reset()         { *m = QueryUnlockScheduleResponse{} }
+func (m *QueryUnlockScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockScheduleResponse) ProtoMessage()    {}
+func (*QueryUnlockScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{9}
+}
+func (m *QueryUnlockScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryUnlockScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockScheduleResponse.Merge(m, src)
+}
+func (m *QueryUnlockScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLockupAccountInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryUnlockScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryUnlockScheduleResponse) GetSchedule() []UnlockScheduleEntry {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
 }
 
-func (m *QueryLockupAccountInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryUnlockScheduleResponse) GetLinear() bool {
+	if m != nil {
+		return m.Linear
+	}
+	return false
 }
 
-func (m *QueryLockupAccountInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnlockScheduleResponse) GetNextUnlock() *UnlockScheduleEntry {
+	if m != nil {
+		return m.NextUnlock
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLockupAccountInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegationsRequest is used to query the lockup account delegations by validator.
+type QueryDelegationsRequest struct {
 }
 
-func (m *QueryLockupAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.UnlockedCoins) > 0 {
-		for iNdEx := len(m.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnlockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.LockedCoins) > 0 {
-		for iNdEx := len(m.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.EndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintQuery(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.StartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintQuery(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.DelegatedLocking) > 0 {
-		for iNdEx := len(m.DelegatedLocking) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegatedLocking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DelegatedFree) > 0 {
-		for iNdEx := len(m.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegatedFree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryDelegationsRequest) Reset()         { *m = QueryDelegationsRequest{} }
+func (m *QueryDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{10}
+}
+func (m *QueryDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.OriginalLocking) > 0 {
-		for iNdEx := len(m.OriginalLocking) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OriginalLocking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsRequest.Merge(m, src)
+}
+func (m *QueryDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsRequest proto.InternalMessageInfo
+
+// QueryDelegationsResponse returns the lockup account delegations by validator.
+type QueryDelegationsResponse struct {
+	Delegations []DelegationLockingInfo `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+}
+
+func (m *QueryDelegationsResponse) Reset()         { *m = QueryDelegationsResponse{} }
+func (m *QueryDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsResponse) ProtoMessage()    {}
+func (*QueryDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{11}
+}
+func (m *QueryDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsResponse.Merge(m, src)
+}
+func (m *QueryDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingEntriesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsResponse) GetDelegations() []DelegationLockingInfo {
+	if m != nil {
+		return m.Delegations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUnbondingEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelegationLockingInfo defines the locked and free parts of a delegation of the lockup account.
+type DelegationLockingInfo struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// balance is the amount of the delegation.
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	// locked is the part of the delegation which is still subject to the lockup.
+	Locked types.Coin `protobuf:"bytes,3,opt,name=locked,proto3" json:"locked"`
+	// free is the part of the delegation which is unlocked.
+	Free types.Coin `protobuf:"bytes,4,opt,name=free,proto3" json:"free"`
 }
 
-func (m *QueryUnbondingEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *DelegationLockingInfo) Reset()         { *m = DelegationLockingInfo{} }
+func (m *DelegationLockingInfo) String() string { return proto.CompactTextString(m) }
+func (*DelegationLockingInfo) ProtoMessage()    {}
+func (*DelegationLockingInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{12}
+}
+func (m *DelegationLockingInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationLockingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationLockingInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DelegationLockingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationLockingInfo.Merge(m, src)
+}
+func (m *DelegationLockingInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationLockingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationLockingInfo.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingEntriesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegationLockingInfo proto.InternalMessageInfo
+
+func (m *DelegationLockingInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUnbondingEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DelegationLockingInfo) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
 }
 
-func (m *QueryUnbondingEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnbondingEntries) > 0 {
-		for iNdEx := len(m.UnbondingEntries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbondingEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DelegationLockingInfo) GetLocked() types.Coin {
+	if m != nil {
+		return m.Locked
+	}
+	return types.Coin{}
+}
+
+func (m *DelegationLockingInfo) GetFree() types.Coin {
+	if m != nil {
+		return m.Free
+	}
+	return types.Coin{}
+}
+
+// QueryAllowancesRequest is used to query the spend allowances granted by the lockup account owner.
+type QueryAllowancesRequest struct {
+}
+
+func (m *QueryAllowancesRequest) Reset()         { *m = QueryAllowancesRequest{} }
+func (m *QueryAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowancesRequest) ProtoMessage()    {}
+func (*QueryAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{13}
+}
+func (m *QueryAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowancesRequest.Merge(m, src)
+}
+func (m *QueryAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowancesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLockingPeriodsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowancesRequest proto.InternalMessageInfo
+
+// QueryAllowancesResponse returns the spend allowances granted by the lockup account owner.
+type QueryAllowancesResponse struct {
+	Allowances []SpendAllowance `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+}
+
+func (m *QueryAllowancesResponse) Reset()         { *m = QueryAllowancesResponse{} }
+func (m *QueryAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowancesResponse) ProtoMessage()    {}
+func (*QueryAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{14}
+}
+func (m *QueryAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowancesResponse.Merge(m, src)
+}
+func (m *QueryAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowancesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLockingPeriodsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAllowancesResponse proto.InternalMessageInfo
+
+func (m *QueryAllowancesResponse) GetAllowances() []SpendAllowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
 }
 
-func (m *QueryLockingPeriodsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryLockupStatisticsRequest is used to query the aggregated balances of all the lockup accounts.
+type QueryLockupStatisticsRequest struct {
 }
 
-func (m *QueryLockingPeriodsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLockupStatisticsRequest) Reset()         { *m = QueryLockupStatisticsRequest{} }
+func (m *QueryLockupStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupStatisticsRequest) ProtoMessage()    {}
+func (*QueryLockupStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{15}
+}
+func (m *QueryLockupStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupStatisticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryLockupStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupStatisticsRequest.Merge(m, src)
+}
+func (m *QueryLockupStatisticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupStatisticsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLockingPeriodsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryLockupStatisticsRequest proto.InternalMessageInfo
+
+// QueryLockupStatisticsResponse returns the aggregated balances of all the lockup accounts.
+type QueryLockupStatisticsResponse struct {
+	// accounts is the number of lockup accounts.
+	Accounts uint64 `protobuf:"varint,1,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	// locked_coins is the total locked supply, per denom.
+	LockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=locked_coins,json=lockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked_coins"`
+	// unlocked_coins is the total unlocked supply, per denom.
+	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
 }
 
-func (m *QueryLockingPeriodsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LockingPeriods) > 0 {
-		for iNdEx := len(m.LockingPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LockingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryLockupStatisticsResponse) Reset()         { *m = QueryLockupStatisticsResponse{} }
+func (m *QueryLockupStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupStatisticsResponse) ProtoMessage()    {}
+func (*QueryLockupStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{16}
+}
+func (m *QueryLockupStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupStatisticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLockupStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupStatisticsResponse.Merge(m, src)
+}
+func (m *QueryLockupStatisticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupStatisticsResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySpendableAmountRequest) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_QueryLockupStatisticsResponse proto.InternalMessageInfo
+
+func (m *QueryLockupStatisticsResponse) GetAccounts() uint64 {
+	if m != nil {
+		return m.Accounts
+	}
+	return 0
+}
+
+func (m *QueryLockupStatisticsResponse) GetLockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LockedCoins
+	}
+	return nil
+}
+
+func (m *QueryLockupStatisticsResponse) GetUnlockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnlockedCoins
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryLockupAccountInfoRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoRequest")
+	proto.RegisterType((*QueryLockupAccountInfoResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse")
+	proto.RegisterType((*QueryUnbondingEntriesRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryUnbondingEntriesRequest")
+	proto.RegisterType((*QueryUnbondingEntriesResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryUnbondingEntriesResponse")
+	proto.RegisterType((*QueryLockingPeriodsRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsRequest")
+	proto.RegisterType((*QueryLockingPeriodsResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse")
+	proto.RegisterType((*QuerySpendableAmountRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QuerySpendableAmountRequest")
+	proto.RegisterType((*QuerySpendableAmountResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QuerySpendableAmountResponse")
+	proto.RegisterType((*QueryUnlockScheduleRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleRequest")
+	proto.RegisterType((*QueryUnlockScheduleResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryUnlockScheduleResponse")
+	proto.RegisterType((*QueryDelegationsRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryDelegationsRequest")
+	proto.RegisterType((*QueryDelegationsResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryDelegationsResponse")
+	proto.RegisterType((*DelegationLockingInfo)(nil), "cosmos.accounts.defaults.lockup.v1.DelegationLockingInfo")
+	proto.RegisterType((*QueryAllowancesRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryAllowancesRequest")
+	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryAllowancesResponse")
+	proto.RegisterType((*QueryLockupStatisticsRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsRequest")
+	proto.RegisterType((*QueryLockupStatisticsResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockupStatisticsResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/defaults/lockup/v1/query.proto", fileDescriptor_f2c1403191515490)
+}
+
+var fileDescriptor_f2c1403191515490 = []byte{
+	// 1036 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcb, 0x6f, 0xdc, 0x44,
+	0x18, 0x8f, 0x93, 0x6d, 0xb2, 0xf9, 0x36, 0x4f, 0xab, 0xb4, 0x4e, 0xc8, 0x3e, 0x70, 0x2f, 0xab,
+	0x4a, 0xb5, 0x49, 0x7a, 0x28, 0x15, 0x07, 0x94, 0x6d, 0x40, 0x42, 0xaa, 0xa0, 0x78, 0x5b, 0x54,
+	0xb8, 0x58, 0xb3, 0xf6, 0xac, 0x6b, 0xad, 0x77, 0x66, 0xeb, 0x19, 0x6f, 0x12, 0x24, 0x24, 0xfe,
+	0x84, 0x9c, 0xb8, 0x72, 0xe7, 0xc2, 0x85, 0x3f, 0xa2, 0xc7, 0x8a, 0x13, 0x12, 0x12, 0x45, 0xc9,
+	0x8d, 0xbf, 0x02, 0xcd, 0xcb, 0x79, 0x97, 0x45, 0x24, 0x3d, 0x65, 0xe7, 0x7b, 0xfc, 0x7e, 0xdf,
+	0xcb, 0xdf, 0x4c, 0xc0, 0x8b, 0x28, 0x1b, 0x52, 0xe6, 0xa3, 0x28, 0xa2, 0x05, 0xe1, 0xcc, 0x8f,
+	0x71, 0x1f, 0x15, 0x19, 0x67, 0x7e, 0x46, 0xa3, 0x41, 0x31, 0xf2, 0xc7, 0x9b, 0xfe, 0xcb, 0x02,
+	0xe7, 0xfb, 0xde, 0x28, 0xa7, 0x9c, 0xda, 0xae, 0xb2, 0xf7, 0x8c, 0xbd, 0x67, 0xec, 0x3d, 0x65,
+	0xef, 0x8d, 0x37, 0xd7, 0xfd, 0x09, 0x30, 0xb5, 0xb5, 0x04, 0x5d, 0x6f, 0x68, 0x87, 0x1e, 0x62,
+	0xd8, 0x1f, 0x6f, 0xf6, 0x30, 0x47, 0x9b, 0x7e, 0x44, 0x53, 0xa2, 0xf5, 0x37, 0x13, 0x9a, 0x50,
+	0xf9, 0xd3, 0x17, 0xbf, 0xb4, 0xb4, 0x99, 0x50, 0x9a, 0x64, 0xd8, 0x97, 0xa7, 0x5e, 0xd1, 0xf7,
+	0x79, 0x3a, 0xc4, 0x8c, 0xa3, 0xa1, 0x81, 0x5d, 0x53, 0xb0, 0xa1, 0xf2, 0xd4, 0x81, 0xcb, 0x83,
+	0xdb, 0x84, 0xfa, 0x57, 0x22, 0xab, 0xc7, 0x32, 0x8c, 0x6d, 0x15, 0xe8, 0xe7, 0xa4, 0x4f, 0x03,
+	0xfc, 0xb2, 0xc0, 0x8c, 0xbb, 0xbf, 0x54, 0xa1, 0x71, 0x99, 0x05, 0x1b, 0x51, 0xc2, 0xb0, 0x3d,
+	0x86, 0x15, 0x9a, 0xa7, 0x49, 0x4a, 0x50, 0x16, 0x8a, 0x74, 0x52, 0x92, 0x38, 0x56, 0x6b, 0xa6,
+	0x5d, 0xdb, 0x5a, 0xd3, 0x55, 0xf5, 0x44, 0x42, 0x9e, 0x4e, 0xc8, 0x7b, 0x44, 0x53, 0xd2, 0xf9,
+	0xf0, 0xd5, 0x9f, 0xcd, 0xa9, 0x9f, 0xdf, 0x34, 0xdb, 0x49, 0xca, 0x5f, 0x14, 0x3d, 0x2f, 0xa2,
+	0x43, 0x53, 0x2e, 0xf5, 0xe7, 0x1e, 0x8b, 0x07, 0x3e, 0xdf, 0x1f, 0x61, 0x26, 0x1d, 0x58, 0xb0,
+	0x6c, 0x48, 0x1e, 0x2b, 0x0e, 0x3b, 0x87, 0xa5, 0x18, 0x67, 0x38, 0x41, 0x1c, 0xc7, 0x61, 0x3f,
+	0xc7, 0xd8, 0x99, 0xbe, 0x7a, 0xd6, 0xc5, 0x92, 0xe2, 0xb3, 0x1c, 0x63, 0x7b, 0x0f, 0x56, 0x8f,
+	0x39, 0x4d, 0xb2, 0x33, 0x57, 0x4f, 0xbb, 0x52, 0xb2, 0x98, 0x6c, 0x3f, 0x01, 0x60, 0x1c, 0xe5,
+	0x3c, 0x14, 0xdd, 0x75, 0x2a, 0x2d, 0xab, 0x5d, 0xdb, 0x5a, 0xf7, 0x54, 0xeb, 0x3d, 0xd3, 0x7a,
+	0xef, 0xa9, 0x69, 0x7d, 0xa7, 0x72, 0xf0, 0xa6, 0x69, 0x05, 0xf3, 0xd2, 0x47, 0x48, 0xed, 0x8f,
+	0xa1, 0x8a, 0x49, 0xac, 0xdc, 0x6f, 0x4c, 0xe8, 0x3e, 0x87, 0x49, 0x2c, 0x9d, 0x09, 0x2c, 0x88,
+	0x6c, 0x71, 0x1c, 0x8a, 0x71, 0x64, 0xce, 0xec, 0xd5, 0xa7, 0x5c, 0x53, 0x04, 0xf2, 0x20, 0x7a,
+	0x5b, 0x90, 0x53, 0x8c, 0x73, 0xd7, 0xd0, 0x5b, 0x43, 0xa1, 0x38, 0x6f, 0xc2, 0x0d, 0xba, 0x4b,
+	0x70, 0xee, 0x54, 0x5b, 0x56, 0x7b, 0x3e, 0x50, 0x07, 0x21, 0x45, 0xf1, 0x30, 0x25, 0xce, 0xbc,
+	0x92, 0xca, 0x83, 0x98, 0xf9, 0x28, 0x43, 0xbb, 0x3d, 0x14, 0x0d, 0xc2, 0x11, 0x26, 0xb1, 0x18,
+	0x03, 0xb8, 0x86, 0x99, 0x37, 0x24, 0x4f, 0x14, 0x87, 0x98, 0x82, 0x28, 0x4b, 0xfb, 0x7d, 0xd5,
+	0xc6, 0xda, 0xa4, 0x53, 0x20, 0x7d, 0x64, 0x23, 0xef, 0xc0, 0xa2, 0x8e, 0x37, 0x54, 0xc9, 0x2e,
+	0xc8, 0xb4, 0x16, 0xb4, 0xf0, 0x4b, 0x99, 0xf3, 0x1d, 0x58, 0x44, 0x05, 0xa7, 0x61, 0x44, 0x87,
+	0x23, 0x5a, 0x90, 0xd8, 0x59, 0x6c, 0x59, 0xed, 0x6a, 0xb0, 0x20, 0x84, 0x8f, 0xb4, 0xcc, 0xbe,
+	0x05, 0xb3, 0xfd, 0x9c, 0x7e, 0x87, 0x89, 0xb3, 0x24, 0xb5, 0xfa, 0xe4, 0x12, 0xd8, 0x90, 0x0b,
+	0xe3, 0x19, 0xe9, 0x51, 0x89, 0xf9, 0x29, 0xe1, 0x79, 0x8a, 0x99, 0xde, 0x28, 0xf6, 0x17, 0xb0,
+	0x3a, 0x46, 0x59, 0x1a, 0x23, 0x4e, 0xf3, 0x10, 0xc5, 0x71, 0x8e, 0x19, 0x73, 0x2c, 0x11, 0x45,
+	0xe7, 0x83, 0xdf, 0x7e, 0xbd, 0x57, 0xd7, 0xe5, 0xfb, 0xda, 0xd8, 0x6c, 0x2b, 0x93, 0x2e, 0xcf,
+	0x53, 0x92, 0x04, 0x2b, 0xe3, 0x33, 0x72, 0xf7, 0x07, 0x0b, 0xea, 0x97, 0x10, 0xea, 0x05, 0x15,
+	0xc2, 0x6a, 0x61, 0x74, 0x21, 0x56, 0x4a, 0xbd, 0xa1, 0xb6, 0xbc, 0x7f, 0xdf, 0xe3, 0xde, 0x29,
+	0xe0, 0xfd, 0x60, 0xa5, 0x38, 0x43, 0xe4, 0x6e, 0xc0, 0x7a, 0xb9, 0x23, 0x53, 0x92, 0x3c, 0xc1,
+	0x79, 0x4a, 0x63, 0x93, 0xb0, 0xfb, 0x87, 0x05, 0xef, 0x5f, 0xa8, 0xd6, 0xe1, 0x75, 0x61, 0x59,
+	0x6f, 0x92, 0x70, 0xa4, 0x54, 0x3a, 0xb8, 0xbb, 0x93, 0x04, 0xa7, 0xd0, 0x82, 0xa5, 0xec, 0x14,
+	0xb8, 0x9d, 0xc0, 0x72, 0x8c, 0x09, 0x1d, 0x86, 0x2c, 0x7a, 0x81, 0xe3, 0x22, 0xc3, 0x4c, 0x6f,
+	0xc7, 0x8f, 0x26, 0x01, 0xdd, 0x11, 0xae, 0x3a, 0xdc, 0xae, 0x06, 0xe8, 0x54, 0xc4, 0xf8, 0x06,
+	0x4b, 0x12, 0xd6, 0x08, 0x99, 0x5b, 0xd7, 0xc9, 0x75, 0xc5, 0x04, 0xa1, 0x5e, 0x86, 0xb7, 0x87,
+	0x02, 0xd6, 0x24, 0xff, 0xa3, 0x05, 0x1b, 0x17, 0xeb, 0x8f, 0x6f, 0x0f, 0x66, 0x54, 0x21, 0xa7,
+	0x03, 0x4c, 0xd8, 0xb5, 0xdc, 0x1e, 0x25, 0xc9, 0x53, 0xc9, 0x51, 0xf6, 0xec, 0x99, 0xdc, 0x01,
+	0x26, 0x1f, 0x13, 0xf6, 0xdf, 0xa6, 0x67, 0x67, 0xd5, 0x3a, 0xea, 0x6f, 0xa0, 0x6a, 0x0a, 0xab,
+	0xa3, 0x7d, 0x30, 0xd9, 0x24, 0x9d, 0x44, 0x93, 0xe3, 0xa4, 0xcb, 0x5a, 0xc2, 0x89, 0xef, 0x2a,
+	0x4b, 0x09, 0x46, 0xb9, 0x33, 0xad, 0xbe, 0x2b, 0x75, 0xb2, 0x9f, 0x43, 0x8d, 0xe0, 0x3d, 0x1e,
+	0xaa, 0xa5, 0xe5, 0xcc, 0xb4, 0xac, 0xff, 0xc1, 0x1a, 0x80, 0xc0, 0x52, 0x0a, 0x77, 0x0d, 0x6e,
+	0xcb, 0x5c, 0x77, 0xd4, 0x9d, 0x93, 0x52, 0x52, 0xce, 0xee, 0xf7, 0xe0, 0x9c, 0x57, 0xe9, 0x1a,
+	0x20, 0xa8, 0xc5, 0xc7, 0x62, 0x5d, 0x86, 0x87, 0x93, 0x8d, 0x97, 0x71, 0xd3, 0x33, 0x26, 0xde,
+	0x13, 0xba, 0x10, 0x27, 0x31, 0xdd, 0x83, 0x69, 0x78, 0xef, 0x42, 0xe3, 0xab, 0xde, 0x22, 0xf6,
+	0x43, 0x98, 0xeb, 0xa1, 0x0c, 0x91, 0x08, 0xcb, 0xb2, 0xbf, 0x75, 0xfa, 0x54, 0xa0, 0xc6, 0xde,
+	0x7e, 0x00, 0xb3, 0xea, 0x1a, 0x71, 0x66, 0x26, 0xf3, 0xd4, 0xe6, 0xf6, 0x7d, 0xa8, 0xc8, 0x67,
+	0x4b, 0x65, 0x32, 0x37, 0x69, 0xec, 0x3a, 0x70, 0x4b, 0x76, 0x64, 0x3b, 0xcb, 0xe8, 0xae, 0xe0,
+	0x2f, 0x7b, 0xc5, 0xe0, 0xf6, 0x39, 0x8d, 0x6e, 0xd5, 0x73, 0x00, 0x54, 0x4a, 0xff, 0xcb, 0xea,
+	0x93, 0x5f, 0x6d, 0x09, 0xa8, 0x03, 0x39, 0x81, 0xe5, 0x36, 0xf4, 0xe7, 0xad, 0x9e, 0x87, 0x5d,
+	0x8e, 0x78, 0xca, 0x78, 0x1a, 0x95, 0x41, 0xfd, 0x34, 0x0d, 0xf5, 0x4b, 0x0c, 0x74, 0x6c, 0xeb,
+	0x50, 0x35, 0x11, 0xc8, 0x06, 0x56, 0x82, 0xf2, 0x7c, 0xee, 0xd9, 0x31, 0xfd, 0xce, 0x9f, 0x1d,
+	0x33, 0xd7, 0xfd, 0xec, 0xe8, 0xec, 0xbc, 0x3a, 0x6c, 0x58, 0xaf, 0x0f, 0x1b, 0xd6, 0x5f, 0x87,
+	0x0d, 0xeb, 0xe0, 0xa8, 0x31, 0xf5, 0xfa, 0xa8, 0x31, 0xf5, 0xfb, 0x51, 0x63, 0xea, 0xdb, 0xbb,
+	0x0a, 0x80, 0xc5, 0x03, 0x2f, 0xa5, 0xfe, 0xde, 0xdb, 0xfe, 0x8f, 0xe8, 0xcd, 0xca, 0xcb, 0xff,
+	0xfe, 0x3f, 0x03, 0x00, 0x24, 0x7b, 0xba, 0xfb, 0xc8, 0x0c, 0x00, 0x00,
+}
+
+func (m *QueryLockupAccountInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -772,12 +1042,12 @@ func (m *QuerySpendableAmountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpendableAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLockupAccountInfoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpendableAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLockupAccountInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -785,7 +1055,7 @@ func (m *QuerySpendableAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpendableAmountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryLockupAccountInfoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -795,20 +1065,57 @@ func (m *QuerySpendableAmountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpendableAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLockupAccountInfoResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpendableAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLockupAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpendableTokens) > 0 {
-		for iNdEx := len(m.SpendableTokens) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.CliffTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ClawbackPending) > 0 {
+		for iNdEx := len(m.ClawbackPending) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SpendableTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ClawbackPending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -816,166 +1123,1855 @@ func (m *QuerySpendableAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x52
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryLockupAccountInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryLockupAccountInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	if len(m.OriginalLocking) > 0 {
-		for _, e := range m.OriginalLocking {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.UnlockedCoins) > 0 {
+		for iNdEx := len(m.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.DelegatedFree) > 0 {
-		for _, e := range m.DelegatedFree {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.LockedCoins) > 0 {
+		for iNdEx := len(m.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.DelegatedLocking) > 0 {
-		for _, e := range m.DelegatedLocking {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.StartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.EndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovQuery(uint64(l))
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.LockedCoins) > 0 {
-		for _, e := range m.LockedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatedLocking) > 0 {
+		for iNdEx := len(m.DelegatedLocking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedLocking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.UnlockedCoins) > 0 {
-		for _, e := range m.UnlockedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatedFree) > 0 {
+		for iNdEx := len(m.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedFree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OriginalLocking) > 0 {
+		for iNdEx := len(m.OriginalLocking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalLocking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnbondingEntriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondingEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnbondingEntriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondingEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.UnbondingEntries) > 0 {
-		for _, e := range m.UnbondingEntries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.UnbondingEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockingPeriodsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLockingPeriodsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockingPeriodsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockingPeriodsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockingPeriodsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLockingPeriodsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockingPeriodsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockingPeriodsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.DenomSchedules) > 0 {
+		for iNdEx := len(m.DenomSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.LockingPeriods) > 0 {
-		for _, e := range m.LockingPeriods {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.LockingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendableAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendableAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendableAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendableAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendableAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendableAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendableTokens) > 0 {
+		for iNdEx := len(m.SpendableTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendableTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextUnlock != nil {
+		{
+			size, err := m.NextUnlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Linear {
+		i--
+		if m.Linear {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationLockingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationLockingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationLockingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Free.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnlockedCoins) > 0 {
+		for iNdEx := len(m.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LockedCoins) > 0 {
+		for iNdEx := len(m.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Accounts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Accounts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLockupAccountInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockupAccountInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OriginalLocking) > 0 {
+		for _, e := range m.OriginalLocking {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for _, e := range m.DelegatedFree {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatedLocking) > 0 {
+		for _, e := range m.DelegatedLocking {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.LockedCoins) > 0 {
+		for _, e := range m.LockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnlockedCoins) > 0 {
+		for _, e := range m.UnlockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ClawbackPending) > 0 {
+		for _, e := range m.ClawbackPending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CliffTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AutoCompound {
+		n += 2
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryUnbondingEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingEntries) > 0 {
+		for _, e := range m.UnbondingEntries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockingPeriodsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockingPeriodsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockingPeriods) > 0 {
+		for _, e := range m.LockingPeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DenomSchedules) > 0 {
+		for _, e := range m.DenomSchedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySpendableAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySpendableAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendableTokens) > 0 {
+		for _, e := range m.SpendableTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnlockScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUnlockScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Linear {
+		n += 2
+	}
+	if m.NextUnlock != nil {
+		l = m.NextUnlock.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationLockingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Free.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockupStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockupStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accounts != 0 {
+		n += 1 + sovQuery(uint64(m.Accounts))
+	}
+	if len(m.LockedCoins) > 0 {
+		for _, e := range m.LockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnlockedCoins) > 0 {
+		for _, e := range m.UnlockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockupAccountInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockupAccountInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockupAccountInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockupAccountInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalLocking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalLocking = append(m.OriginalLocking, types.Coin{})
+			if err := m.OriginalLocking[len(m.OriginalLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedLocking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedLocking = append(m.DelegatedLocking, types.Coin{})
+			if err := m.DelegatedLocking[len(m.DelegatedLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedCoins = append(m.LockedCoins, types.Coin{})
+			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockedCoins = append(m.UnlockedCoins, types.Coin{})
+			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackPending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackPending = append(m.ClawbackPending, types.Coin{})
+			if err := m.ClawbackPending[len(m.ClawbackPending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CliffTime == nil {
+				m.CliffTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEntries = append(m.UnbondingEntries, &UnbondingEntry{})
+			if err := m.UnbondingEntries[len(m.UnbondingEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockingPeriodsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockingPeriodsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockingPeriodsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockingPeriodsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockingPeriodsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockingPeriods = append(m.LockingPeriods, &Period{})
+			if err := m.LockingPeriods[len(m.LockingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomSchedules = append(m.DenomSchedules, DenomLockingSchedule{})
+			if err := m.DenomSchedules[len(m.DenomSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QuerySpendableAmountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QuerySpendableAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SpendableTokens) > 0 {
-		for _, e := range m.SpendableTokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendableAmountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -998,10 +2994,10 @@ func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockupAccountInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendableAmountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockupAccountInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendableAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1025,7 +3021,7 @@ func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendableAmountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1048,15 +3044,15 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockupAccountInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendableAmountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockupAccountInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendableAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalLocking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendableTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1083,118 +3079,114 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalLocking = append(m.OriginalLocking, types.Coin{})
-			if err := m.OriginalLocking[len(m.OriginalLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpendableTokens = append(m.SpendableTokens, types.Coin{})
+			if err := m.SpendableTokens[len(m.SpendableTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
-			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedLocking", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedLocking = append(m.DelegatedLocking, types.Coin{})
-			if err := m.DelegatedLocking[len(m.DelegatedLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartTime == nil {
-				m.StartTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1221,18 +3213,16 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTime == nil {
-				m.EndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedule = append(m.Schedule, UnlockScheduleEntry{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Linear", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1242,29 +3232,15 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LockedCoins = append(m.LockedCoins, types.Coin{})
-			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.Linear = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1291,43 +3267,63 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnlockedCoins = append(m.UnlockedCoins, types.Coin{})
-			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NextUnlock == nil {
+				m.NextUnlock = &UnlockScheduleEntry{}
+			}
+			if err := m.NextUnlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1349,7 +3345,7 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingEntriesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1372,17 +3368,17 @@ func (m *QueryUnbondingEntriesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingEntriesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1392,23 +3388,25 @@ func (m *QueryUnbondingEntriesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Delegations = append(m.Delegations, DelegationLockingInfo{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1431,7 +3429,7 @@ func (m *QueryUnbondingEntriesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingEntriesResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegationLockingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1454,15 +3452,47 @@ func (m *QueryUnbondingEntriesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingEntriesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationLockingInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationLockingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1489,8 +3519,73 @@ func (m *QueryUnbondingEntriesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingEntries = append(m.UnbondingEntries, &UnbondingEntry{})
-			if err := m.UnbondingEntries[len(m.UnbondingEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Free", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Free.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1515,7 +3610,7 @@ func (m *QueryUnbondingEntriesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockingPeriodsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1538,10 +3633,10 @@ func (m *QueryLockingPeriodsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockingPeriodsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockingPeriodsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1565,7 +3660,7 @@ func (m *QueryLockingPeriodsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1588,15 +3683,15 @@ func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockingPeriodsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockingPeriodsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1623,8 +3718,8 @@ func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockingPeriods = append(m.LockingPeriods, &Period{})
-			if err := m.LockingPeriods[len(m.LockingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allowances = append(m.Allowances, SpendAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1649,7 +3744,7 @@ func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableAmountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockupStatisticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1672,10 +3767,10 @@ func (m *QuerySpendableAmountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableAmountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockupStatisticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockupStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1699,7 +3794,7 @@ func (m *QuerySpendableAmountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableAmountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockupStatisticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1722,15 +3817,34 @@ func (m *QuerySpendableAmountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableAmountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockupStatisticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockupStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			m.Accounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendableTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1757,8 +3871,42 @@ func (m *QuerySpendableAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendableTokens = append(m.SpendableTokens, types.Coin{})
-			if err := m.SpendableTokens[len(m.SpendableTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockedCoins = append(m.LockedCoins, types.Coin{})
+			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockedCoins = append(m.UnlockedCoins, types.Coin{})
+			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+	migrationv1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -370,12 +371,23 @@ func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryC
 	return &v1.QueryConfigResponse{Config: &cfg, Members: members}, nil
 }
 
+// MigrateIn completes the migration of an account of another type to a multisig account. Once migrated,
+// the account keeps its address and balances, and is controlled by the proposals of its members.
+func (a *Account) MigrateIn(ctx context.Context, _ *migrationv1.MsgMigrateIn) (*migrationv1.MsgMigrateInResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	return &migrationv1.MsgMigrateInResponse{}, nil
+}
+
 // RegisterExecuteHandlers implements implementation.Account.
 func (a *Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.Vote)
 	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
 	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
 	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.MigrateIn)
 }
 
 // RegisterInitHandler implements implementation.Account.
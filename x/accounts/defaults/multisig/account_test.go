@@ -14,6 +14,8 @@ import (
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func setup(t *testing.T, _ context.Context, ss store.KVStoreService, timefn func() time.Time) *Account {
@@ -205,6 +207,7 @@ func TestUpdateConfig(t *testing.T) {
 		expErr     string
 		expCfg     *v1.Config
 		expMembers []*v1.Member
+		expEvents  sdk.Events
 	}{
 		{
 			"change members",
@@ -249,6 +252,18 @@ func TestUpdateConfig(t *testing.T) {
 					Weight:  1000,
 				},
 			},
+			sdk.Events{
+				sdk.NewEvent("member_updated", sdk.NewAttribute("address", "addr1"), sdk.NewAttribute("weight", "500")),
+				sdk.NewEvent("member_updated", sdk.NewAttribute("address", "addr2"), sdk.NewAttribute("weight", "700")),
+				sdk.NewEvent("config_updated",
+					sdk.NewAttribute("threshold", "666"),
+					sdk.NewAttribute("quorum", "400"),
+					sdk.NewAttribute("voting_period", "60"),
+					sdk.NewAttribute("revote", "false"),
+					sdk.NewAttribute("early_execution", "false"),
+					sdk.NewAttribute("total_weight", "3200"),
+				),
+			},
 		},
 		{
 			"remove member",
@@ -277,6 +292,9 @@ func TestUpdateConfig(t *testing.T) {
 					Weight:  1000,
 				},
 			},
+			sdk.Events{
+				sdk.NewEvent("member_updated", sdk.NewAttribute("address", "addr1"), sdk.NewAttribute("weight", "0")),
+			},
 		},
 		{
 			"add member",
@@ -313,6 +331,57 @@ func TestUpdateConfig(t *testing.T) {
 					Weight:  200,
 				},
 			},
+			sdk.Events{
+				sdk.NewEvent("member_updated", sdk.NewAttribute("address", "addr5"), sdk.NewAttribute("weight", "200")),
+			},
+		},
+		{
+			"duplicate member",
+			&v1.MsgUpdateConfig{
+				UpdateMembers: []*v1.Member{
+					{
+						Address: "addr1",
+						Weight:  500,
+					},
+					{
+						Address: "addr1",
+						Weight:  0,
+					},
+				},
+				Config: nil,
+			},
+			"duplicate member address found",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"remove all members",
+			&v1.MsgUpdateConfig{
+				UpdateMembers: []*v1.Member{
+					{
+						Address: "addr1",
+						Weight:  0,
+					},
+					{
+						Address: "addr2",
+						Weight:  0,
+					},
+					{
+						Address: "addr3",
+						Weight:  0,
+					},
+					{
+						Address: "addr4",
+						Weight:  0,
+					},
+				},
+				Config: nil,
+			},
+			"threshold must be less than or equal to the total weight",
+			nil,
+			nil,
+			nil,
 		},
 		{
 			"change members, invalid weights",
@@ -336,6 +405,7 @@ func TestUpdateConfig(t *testing.T) {
 			"overflow",
 			nil,
 			nil,
+			nil,
 		},
 	}
 
@@ -347,6 +417,7 @@ func TestUpdateConfig(t *testing.T) {
 			require.NoError(t, err)
 
 			ctx = accountstd.SetSender(ctx, []byte("mock_multisig_account"))
+			emittedEvents(acc)
 
 			_, err = acc.UpdateConfig(ctx, tc.msg)
 			if tc.expErr != "" {
@@ -354,6 +425,7 @@ func TestUpdateConfig(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			require.Equal(t, tc.expEvents, emittedEvents(acc))
 
 			cfg, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
 			require.NoError(t, err)
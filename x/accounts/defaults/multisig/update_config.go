@@ -3,7 +3,9 @@ package multisig
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 )
@@ -16,7 +18,13 @@ func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1
 	}
 
 	// set members
+	membersMap := map[string]struct{}{} // to check for duplicates
 	for i := range msg.UpdateMembers {
+		if _, ok := membersMap[msg.UpdateMembers[i].Address]; ok {
+			return nil, errors.New("duplicate member address found")
+		}
+		membersMap[msg.UpdateMembers[i].Address] = struct{}{}
+
 		addrBz, err := a.addrCodec.StringToBytes(msg.UpdateMembers[i].Address)
 		if err != nil {
 			return nil, err
@@ -65,6 +73,29 @@ func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1
 		return nil, err
 	}
 
+	// emit the membership and config changes, a member with a zero weight is removed
+	for _, member := range msg.UpdateMembers {
+		if err := a.eventService.EventManager(ctx).EmitKV("member_updated",
+			event.NewAttribute("address", member.Address),
+			event.NewAttribute("weight", fmt.Sprint(member.Weight)),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.Config != nil {
+		if err := a.eventService.EventManager(ctx).EmitKV("config_updated",
+			event.NewAttribute("threshold", fmt.Sprint(config.Threshold)),
+			event.NewAttribute("quorum", fmt.Sprint(config.Quorum)),
+			event.NewAttribute("voting_period", fmt.Sprint(config.VotingPeriod)),
+			event.NewAttribute("revote", fmt.Sprint(config.Revote)),
+			event.NewAttribute("early_execution", fmt.Sprint(config.EarlyExecution)),
+			event.NewAttribute("total_weight", fmt.Sprint(totalWeight)),
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	return &v1.MsgUpdateConfigResponse{}, nil
 }
 
@@ -187,7 +187,7 @@ func makeMockDependencies(storeservice store.KVStoreService, timefn func() time.
 		LegacyStateCodec: mockStateCodec{},
 		Environment: appmodulev2.Environment{
 			HeaderService: headerService{timefn},
-			EventService:  eventService{},
+			EventService:  eventService{manager: sdk.NewEventManager()},
 		},
 	}
 }
@@ -202,9 +202,20 @@ func (h headerService) HeaderInfo(context.Context) header.Info {
 	}
 }
 
-type eventService struct{}
+// eventService records the events emitted by the account.
+type eventService struct {
+	manager *sdk.EventManager
+}
 
 // EventManager implements event.Service.
-func (eventService) EventManager(context.Context) event.Manager {
-	return runtime.EventService{Events: runtime.Events{EventManagerI: sdk.NewEventManager()}}
+func (e eventService) EventManager(context.Context) event.Manager {
+	return runtime.EventService{Events: runtime.Events{EventManagerI: e.manager}}
+}
+
+// emittedEvents returns the events emitted by the account since the last call.
+func emittedEvents(acc *Account) sdk.Events {
+	manager := acc.eventService.(eventService).manager
+	events := manager.Events()
+	*manager = *sdk.NewEventManager()
+	return events
 }
@@ -28,6 +28,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config       *modulev1.Module
 	Cdc          codec.Codec
 	Environment  appmodule.Environment
 	AddressCodec address.Codec
@@ -64,6 +65,16 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	if err != nil {
 		panic(err)
 	}
+
+	maxDepth, gasLimit := uint32(DefaultMaxExecutionDepth), uint64(DefaultNestedExecutionGasLimit)
+	if in.Config.MaxExecutionDepth != 0 {
+		maxDepth = in.Config.MaxExecutionDepth
+	}
+	if in.Config.NestedExecutionGasLimit != 0 {
+		gasLimit = in.Config.NestedExecutionGasLimit
+	}
+	accountsKeeper.SetExecutionLimits(maxDepth, gasLimit)
+
 	m := NewAppModule(in.Cdc, accountsKeeper)
 	return ModuleOutputs{AccountsKeeper: accountsKeeper, Module: m}
 }
@@ -12,4 +12,11 @@ var (
 	ErrExecution = errors.New(ModuleName, 3, "execution failed")
 	// ErrAccountAlreadyExists is returned when the account already exists in state.
 	ErrAccountAlreadyExists = errors.New(ModuleName, 4, "account already exists")
+	// ErrAccountMigration is returned when the migration of an account to another account type fails.
+	ErrAccountMigration = errors.New(ModuleName, 5, "account migration failed")
+	// ErrExecutionDepthExceeded is returned when an account execution exceeds the maximum number of nested
+	// account executions.
+	ErrExecutionDepthExceeded = errors.New(ModuleName, 6, "max execution depth exceeded")
+	// ErrNestedExecutionOutOfGas is returned when a nested account execution exceeds its gas limit.
+	ErrNestedExecutionOutOfGas = errors.New(ModuleName, 7, "nested execution out of gas")
 )
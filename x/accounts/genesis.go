@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -52,6 +53,13 @@ func (k Keeper) exportAccount(ctx context.Context, addr []byte, accType string,
 	if err != nil {
 		return nil, err
 	}
+	metadata, err := k.AccountsMetadata.Get(ctx, addr)
+	switch {
+	case err == nil:
+		account.Metadata = &metadata
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
 	return account, nil
 }
 
@@ -108,6 +116,10 @@ func (k Keeper) importAccount(ctx context.Context, acc *v1.GenesisAccount) error
 	if err != nil {
 		return err
 	}
+	err = k.AccountsByTypeIndex.Set(ctx, collections.Join(acc.AccountType, addrBytes))
+	if err != nil {
+		return err
+	}
 	err = k.AccountByNumber.Set(ctx, addrBytes, acc.AccountNumber)
 	if err != nil {
 		return err
@@ -118,5 +130,11 @@ func (k Keeper) importAccount(ctx context.Context, acc *v1.GenesisAccount) error
 			return err
 		}
 	}
+	if acc.Metadata != nil {
+		if err := ValidateAccountMetadata(*acc.Metadata); err != nil {
+			return err
+		}
+		return k.setAccountMetadata(ctx, addrBytes, *acc.Metadata)
+	}
 	return nil
 }
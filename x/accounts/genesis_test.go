@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/internal/implementation"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
@@ -30,6 +31,10 @@ func TestGenesis(t *testing.T) {
 	_, err = k.Execute(ctx, addr2, []byte("sender"), &types.UInt64Value{Value: 20}, nil)
 	require.NoError(t, err)
 
+	// we set the metadata of the first account
+	metadata := v1.AccountMetadata{Name: "alice", Uri: "https://example.com"}
+	require.NoError(t, k.SetAccountMetadata(ctx, addr1, metadata))
+
 	// export state
 	state, err := k.ExportState(ctx)
 	require.NoError(t, err)
@@ -61,6 +66,13 @@ func TestGenesis(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.UInt64Value{Value: 20}, resp)
 
+	gotMetadata, err := k.AccountMetadata(ctx, addr1)
+	require.NoError(t, err)
+	require.Equal(t, metadata, gotMetadata)
+	has, err := k.AccountsByNameIndex.Has(ctx, collections.Join("alice", addr1))
+	require.NoError(t, err)
+	require.True(t, has)
+
 	// check initted on genesis account
 	addr3, err := k.makeAddress([]byte("sender-2"), 2, nil)
 	require.NoError(t, err)
@@ -0,0 +1,237 @@
+package accounts
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/x/accounts/internal/implementation"
+)
+
+// accountNumberField is the key field prepended to the object types of the account states.
+var accountNumberField = schema.Field{Name: "account_number", Kind: schema.Uint64Kind}
+
+// ModuleCodec returns the schema.ModuleCodec of the module. On top of the module collections, the state of
+// the accounts is decoded with the collections schema of their account type, as one object type per account
+// type and collection named <account_type>_<collection>, keyed by the account number.
+// The account types are learnt from the account creations, so the decoder must observe them, which is the
+// case when indexing from genesis or from a full state sync. The states of unknown accounts are skipped.
+func (k Keeper) ModuleCodec() (schema.ModuleCodec, error) {
+	moduleCodec, err := k.Schema.ModuleCodec(collections.IndexingOptions{})
+	if err != nil {
+		return schema.ModuleCodec{}, err
+	}
+
+	var types []schema.Type
+	typeNames := map[string]bool{}
+	moduleCodec.Schema.AllTypes(func(t schema.Type) bool {
+		types = append(types, t)
+		typeNames[t.TypeName()] = true
+		return true
+	})
+
+	accountTypes := make([]string, 0, len(k.accounts))
+	for accountType := range k.accounts {
+		accountTypes = append(accountTypes, accountType)
+	}
+	sort.Strings(accountTypes)
+
+	decoder := &accountsDecoder{
+		moduleDecoder: moduleCodec.KVDecoder,
+		keeper:        k,
+		accounts:      map[string]*accountCodec{},
+		types:         map[string]string{},
+		numbers:       map[string]uint64{},
+		numberTypes:   map[uint64]string{},
+	}
+	for _, accountType := range accountTypes {
+		accCodec, err := newAccountCodec(accountType, k.accounts[accountType])
+		if err != nil {
+			return schema.ModuleCodec{}, fmt.Errorf("account type %s: %w", accountType, err)
+		}
+		decoder.accounts[accountType] = accCodec
+
+		for _, t := range accCodec.types {
+			// the enum types can be shared by several account types
+			if _, ok := t.(schema.EnumType); ok && typeNames[t.TypeName()] {
+				continue
+			}
+			types = append(types, t)
+			typeNames[t.TypeName()] = true
+		}
+	}
+
+	modSchema, err := schema.CompileModuleSchema(types...)
+	if err != nil {
+		return schema.ModuleCodec{}, err
+	}
+
+	return schema.ModuleCodec{
+		Schema:    modSchema,
+		KVDecoder: decoder.decodeKV,
+	}, nil
+}
+
+// accountCodec decodes the state of the accounts of an account type.
+type accountCodec struct {
+	decoder schema.KVDecoder
+	types   []schema.Type
+	// keyFields maps the collection names to their number of key fields
+	keyFields map[string]int
+	// typePrefix is prepended to the collection names
+	typePrefix string
+}
+
+func newAccountCodec(accountType string, impl implementation.Implementation) (*accountCodec, error) {
+	accModuleCodec, err := impl.CollectionsSchema.ModuleCodec(collections.IndexingOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	accCodec := &accountCodec{
+		decoder:    accModuleCodec.KVDecoder,
+		keyFields:  map[string]int{},
+		typePrefix: strings.ReplaceAll(accountType, "-", "_") + "_",
+	}
+	accModuleCodec.Schema.AllTypes(func(t schema.Type) bool {
+		objectType, ok := t.(schema.StateObjectType)
+		if !ok {
+			accCodec.types = append(accCodec.types, t)
+			return true
+		}
+
+		accCodec.keyFields[objectType.Name] = len(objectType.KeyFields)
+		objectType.Name = accCodec.typePrefix + objectType.Name
+		objectType.KeyFields = append([]schema.Field{accountNumberField}, objectType.KeyFields...)
+		accCodec.types = append(accCodec.types, objectType)
+		return true
+	})
+
+	return accCodec, nil
+}
+
+// decodeKV decodes an update of the account state, and prefixes its object type with the account type and
+// its key with the account number.
+func (c *accountCodec) decodeKV(accountNumber uint64, update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+	updates, err := c.decoder(update)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, u := range updates {
+		switch c.keyFields[u.TypeName] {
+		case 0:
+			u.Key = accountNumber
+		case 1:
+			u.Key = []any{accountNumber, u.Key}
+		default:
+			keys, ok := u.Key.([]any)
+			if !ok {
+				return nil, fmt.Errorf("unexpected key %v of %s", u.Key, u.TypeName)
+			}
+			u.Key = append([]any{accountNumber}, keys...)
+		}
+		u.TypeName = c.typePrefix + u.TypeName
+		updates[i] = u
+	}
+
+	return updates, nil
+}
+
+// accountsDecoder is the stateful decoder of the module. It is not safe for concurrent use.
+type accountsDecoder struct {
+	moduleDecoder schema.KVDecoder
+	keeper        Keeper
+	accounts      map[string]*accountCodec
+
+	// types and numbers track the account types and numbers of the account addresses until both are known.
+	types   map[string]string
+	numbers map[string]uint64
+	// numberTypes maps the account numbers to their account type.
+	numberTypes map[uint64]string
+}
+
+func (d *accountsDecoder) decodeKV(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+	switch {
+	case bytes.HasPrefix(update.Key, d.keeper.AccountsState.GetPrefix()):
+		return d.decodeAccountState(update)
+	case bytes.HasPrefix(update.Key, d.keeper.AccountsByType.GetPrefix()) && !update.Remove:
+		_, addr, err := d.keeper.AccountsByType.KeyCodec().Decode(update.Key[len(d.keeper.AccountsByType.GetPrefix()):])
+		if err != nil {
+			return nil, err
+		}
+		accountType, err := d.keeper.AccountsByType.ValueCodec().Decode(update.Value)
+		if err != nil {
+			return nil, err
+		}
+		d.types[string(addr)] = accountType
+		d.trackAccount(string(addr))
+	case bytes.HasPrefix(update.Key, d.keeper.AccountByNumber.GetPrefix()) && !update.Remove:
+		_, addr, err := d.keeper.AccountByNumber.KeyCodec().Decode(update.Key[len(d.keeper.AccountByNumber.GetPrefix()):])
+		if err != nil {
+			return nil, err
+		}
+		number, err := d.keeper.AccountByNumber.ValueCodec().Decode(update.Value)
+		if err != nil {
+			return nil, err
+		}
+		d.numbers[string(addr)] = number
+		d.trackAccount(string(addr))
+	}
+
+	return d.moduleDecoder(update)
+}
+
+// trackAccount maps the account number to its account type once both are known.
+func (d *accountsDecoder) trackAccount(addr string) {
+	accountType, ok := d.types[addr]
+	if !ok {
+		return
+	}
+	number, ok := d.numbers[addr]
+	if !ok {
+		return
+	}
+
+	d.numberTypes[number] = accountType
+	delete(d.types, addr)
+	delete(d.numbers, addr)
+}
+
+func (d *accountsDecoder) decodeAccountState(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+	updates, err := d.moduleDecoder(update)
+	if err != nil {
+		return updates, err
+	}
+
+	_, key, err := d.keeper.AccountsState.KeyCodec().Decode(update.Key[len(d.keeper.AccountsState.GetPrefix()):])
+	if err != nil {
+		return updates, err
+	}
+	accountType, ok := d.numberTypes[key.K1()]
+	if !ok {
+		return updates, nil
+	}
+	accCodec, ok := d.accounts[accountType]
+	if !ok {
+		return updates, nil
+	}
+
+	value := update.Value
+	if !update.Remove {
+		value, err = d.keeper.AccountsState.ValueCodec().Decode(update.Value)
+		if err != nil {
+			return updates, err
+		}
+	}
+
+	accUpdates, err := accCodec.decodeKV(key.K1(), schema.KVPairUpdate{
+		Key:    key.K2(),
+		Value:  value,
+		Remove: update.Remove,
+	})
+	return append(updates, accUpdates...), err
+}
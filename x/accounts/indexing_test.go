@@ -0,0 +1,47 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+func TestKeeper_ModuleCodec(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+
+	cdc, err := m.ModuleCodec()
+	require.NoError(t, err)
+
+	objectType, ok := cdc.Schema.LookupStateObjectType("test_counter")
+	require.True(t, ok)
+	require.Equal(t, accountNumberField, objectType.KeyFields[0])
+
+	sender := []byte("sender")
+	_, accAddr, err := m.Init(ctx, "test", sender, &types.Empty{}, nil, nil)
+	require.NoError(t, err)
+	_, err = m.Execute(ctx, accAddr, sender, &types.UInt64Value{Value: 10}, nil)
+	require.NoError(t, err)
+
+	// decode the whole state, as the indexer does during a full sync
+	var updates []schema.StateObjectUpdate
+	it, err := m.KVStoreService.OpenKVStore(ctx).Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		kvUpdates, err := cdc.KVDecoder(schema.KVPairUpdate{Key: it.Key(), Value: it.Value()})
+		require.NoError(t, err)
+		for _, update := range kvUpdates {
+			if update.TypeName == "test_counter" {
+				updates = append(updates, update)
+			}
+		}
+	}
+
+	require.Equal(t, []schema.StateObjectUpdate{
+		{TypeName: "test_counter", Key: uint64(0), Value: uint64(10)},
+	}, updates)
+}
@@ -0,0 +1,738 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/interfaces/migration/v1/interface.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgMigrateOut is a message that an x/accounts account implementer must handle to allow its accounts to
+// be migrated to another account type. It is executed before the state of the account is deleted, and
+// the migration is aborted if it fails. Always ensure the caller is the Accounts module.
+type MsgMigrateOut struct {
+	// new_account_type defines the account type the account is migrated to.
+	NewAccountType string `protobuf:"bytes,1,opt,name=new_account_type,json=newAccountType,proto3" json:"new_account_type,omitempty"`
+}
+
+func (m *MsgMigrateOut) Reset()         { *m = MsgMigrateOut{} }
+func (m *MsgMigrateOut) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateOut) ProtoMessage()    {}
+func (*MsgMigrateOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc03654fa976ddfe, []int{0}
+}
+func (m *MsgMigrateOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateOut.Merge(m, src)
+}
+func (m *MsgMigrateOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateOut proto.InternalMessageInfo
+
+func (m *MsgMigrateOut) GetNewAccountType() string {
+	if m != nil {
+		return m.NewAccountType
+	}
+	return ""
+}
+
+// MsgMigrateOutResponse is the response to MsgMigrateOut.
+type MsgMigrateOutResponse struct {
+}
+
+func (m *MsgMigrateOutResponse) Reset()         { *m = MsgMigrateOutResponse{} }
+func (m *MsgMigrateOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateOutResponse) ProtoMessage()    {}
+func (*MsgMigrateOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc03654fa976ddfe, []int{1}
+}
+func (m *MsgMigrateOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateOutResponse.Merge(m, src)
+}
+func (m *MsgMigrateOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateOutResponse proto.InternalMessageInfo
+
+// MsgMigrateIn is a message that an x/accounts account implementer must handle to allow accounts of other
+// types to be migrated to it. It is executed after the account is initialized with the new account type,
+// and the migration is aborted if it fails. Always ensure the caller is the Accounts module.
+type MsgMigrateIn struct {
+	// old_account_type defines the account type the account is migrated from.
+	OldAccountType string `protobuf:"bytes,1,opt,name=old_account_type,json=oldAccountType,proto3" json:"old_account_type,omitempty"`
+}
+
+func (m *MsgMigrateIn) Reset()         { *m = MsgMigrateIn{} }
+func (m *MsgMigrateIn) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateIn) ProtoMessage()    {}
+func (*MsgMigrateIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc03654fa976ddfe, []int{2}
+}
+func (m *MsgMigrateIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateIn.Merge(m, src)
+}
+func (m *MsgMigrateIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateIn proto.InternalMessageInfo
+
+func (m *MsgMigrateIn) GetOldAccountType() string {
+	if m != nil {
+		return m.OldAccountType
+	}
+	return ""
+}
+
+// MsgMigrateInResponse is the response to MsgMigrateIn.
+type MsgMigrateInResponse struct {
+}
+
+func (m *MsgMigrateInResponse) Reset()         { *m = MsgMigrateInResponse{} }
+func (m *MsgMigrateInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateInResponse) ProtoMessage()    {}
+func (*MsgMigrateInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc03654fa976ddfe, []int{3}
+}
+func (m *MsgMigrateInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateInResponse.Merge(m, src)
+}
+func (m *MsgMigrateInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateInResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgMigrateOut)(nil), "cosmos.accounts.interfaces.migration.v1.MsgMigrateOut")
+	proto.RegisterType((*MsgMigrateOutResponse)(nil), "cosmos.accounts.interfaces.migration.v1.MsgMigrateOutResponse")
+	proto.RegisterType((*MsgMigrateIn)(nil), "cosmos.accounts.interfaces.migration.v1.MsgMigrateIn")
+	proto.RegisterType((*MsgMigrateInResponse)(nil), "cosmos.accounts.interfaces.migration.v1.MsgMigrateInResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/interfaces/migration/v1/interface.proto", fileDescriptor_fc03654fa976ddfe)
+}
+
+var fileDescriptor_fc03654fa976ddfe = []byte{
+	// 222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4f, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0xcf, 0xcc, 0x2b, 0x49,
+	0x2d, 0x4a, 0x4b, 0x4c, 0x4e, 0x2d, 0xd6, 0xcf, 0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf,
+	0xd3, 0x2f, 0x33, 0x44, 0x88, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0xa9, 0x43, 0x34, 0xea,
+	0xc1, 0x34, 0xea, 0x21, 0x34, 0xea, 0xc1, 0x35, 0xea, 0x95, 0x19, 0x2a, 0x59, 0x72, 0xf1, 0xfa,
+	0x16, 0xa7, 0xfb, 0x82, 0x85, 0x52, 0xfd, 0x4b, 0x4b, 0x84, 0x34, 0xb8, 0x04, 0xf2, 0x52, 0xcb,
+	0xe3, 0xa1, 0x1a, 0xe3, 0x4b, 0x2a, 0x0b, 0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xf8,
+	0xf2, 0x52, 0xcb, 0x1d, 0x21, 0xc2, 0x21, 0x95, 0x05, 0xa9, 0x4a, 0xe2, 0x5c, 0xa2, 0x28, 0x5a,
+	0x83, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x2c, 0xb8, 0x78, 0x10, 0x12, 0x9e, 0x79,
+	0x20, 0x23, 0xf3, 0x73, 0x52, 0xb0, 0x1a, 0x99, 0x9f, 0x93, 0x82, 0x6c, 0xa4, 0x18, 0x97, 0x08,
+	0xb2, 0x4e, 0x98, 0x89, 0x4e, 0x9e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
+	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
+	0xa5, 0x0f, 0xf1, 0x68, 0x71, 0x4a, 0xb6, 0x5e, 0x66, 0xbe, 0x7e, 0x05, 0xc1, 0x90, 0x4a, 0x62,
+	0x03, 0x07, 0x90, 0x31, 0x60, 0x00, 0x91, 0x2d, 0xc8, 0xef, 0x5b, 0x01, 0x00, 0x00,
+}
+
+func (m *MsgMigrateOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAccountType) > 0 {
+		i -= len(m.NewAccountType)
+		copy(dAtA[i:], m.NewAccountType)
+		i = encodeVarintInterface(dAtA, i, uint64(len(m.NewAccountType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OldAccountType) > 0 {
+		i -= len(m.OldAccountType)
+		copy(dAtA[i:], m.OldAccountType)
+		i = encodeVarintInterface(dAtA, i, uint64(len(m.OldAccountType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterface(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterface(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMigrateOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewAccountType)
+	if l > 0 {
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldAccountType)
+	if l > 0 {
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovInterface(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterface(x uint64) (n int) {
+	return sovInterface(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMigrateOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldAccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInterface(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInterface
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInterface
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInterface
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInterface        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInterface          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInterface = fmt.Errorf("proto: unexpected end of group")
+)
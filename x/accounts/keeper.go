@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/branch"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -37,6 +39,12 @@ var (
 	AccountNumberKey = collections.NewPrefix(1)
 	// AccountByNumber is the key for the accounts by number.
 	AccountByNumber = collections.NewPrefix(2)
+	// AccountsByTypeIndexPrefix is the prefix for the accounts by type index.
+	AccountsByTypeIndexPrefix = collections.NewPrefix(3)
+	// AccountsMetadataPrefix is the prefix for the metadata of the accounts.
+	AccountsMetadataPrefix = collections.NewPrefix(4)
+	// AccountsByNameIndexPrefix is the prefix for the accounts by metadata name index.
+	AccountsByNameIndexPrefix = collections.NewPrefix(5)
 )
 
 type InterfaceRegistry interface {
@@ -54,16 +62,31 @@ func NewKeeper(
 ) (Keeper, error) {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	keeper := Keeper{
-		Environment:      env,
-		txDecoder:        txDecoder,
-		addressCodec:     addressCodec,
-		codec:            cdc,
-		makeSendCoinsMsg: defaultCoinsTransferMsgFunc(addressCodec),
-		accounts:         nil,
-		Schema:           collections.Schema{},
-		AccountNumber:    collections.NewSequence(sb, AccountNumberKey, "account_number"),
-		AccountsByType:   collections.NewMap(sb, AccountTypeKeyPrefix, "accounts_by_type", collections.BytesKey.WithName("address"), collections.StringValue.WithName("type")),
-		AccountByNumber:  collections.NewMap(sb, AccountByNumber, "account_by_number", collections.BytesKey.WithName("address"), collections.Uint64Value.WithName("number")),
+		Environment:             env,
+		txDecoder:               txDecoder,
+		addressCodec:            addressCodec,
+		codec:                   cdc,
+		makeSendCoinsMsg:        defaultCoinsTransferMsgFunc(addressCodec),
+		maxExecutionDepth:       DefaultMaxExecutionDepth,
+		nestedExecutionGasLimit: DefaultNestedExecutionGasLimit,
+		accounts:                nil,
+		Schema:                  collections.Schema{},
+		AccountNumber:           collections.NewSequence(sb, AccountNumberKey, "account_number"),
+		AccountsByType:          collections.NewMap(sb, AccountTypeKeyPrefix, "accounts_by_type", collections.BytesKey.WithName("address"), collections.StringValue.WithName("type")),
+		AccountByNumber:         collections.NewMap(sb, AccountByNumber, "account_by_number", collections.BytesKey.WithName("address"), collections.Uint64Value.WithName("number")),
+		AccountsByTypeIndex: collections.NewKeySet(sb, AccountsByTypeIndexPrefix, "accounts_by_type_index", collections.NamedPairKeyCodec(
+			"type",
+			collections.StringKey,
+			"address",
+			collections.BytesKey,
+		)),
+		AccountsMetadata: collections.NewMap(sb, AccountsMetadataPrefix, "accounts_metadata", collections.BytesKey.WithName("address"), codec.CollValue[v1.AccountMetadata](cdc)),
+		AccountsByNameIndex: collections.NewKeySet(sb, AccountsByNameIndexPrefix, "accounts_by_name_index", collections.NamedPairKeyCodec(
+			"name",
+			collections.StringKey,
+			"address",
+			collections.BytesKey,
+		)),
 		AccountsState: collections.NewMap(sb, implementation.AccountStatePrefix, "accounts_state", collections.NamedPairKeyCodec(
 			"number",
 			collections.Uint64Key,
@@ -103,6 +126,12 @@ type Keeper struct {
 	AccountsByType collections.Map[[]byte, string]
 	// AccountByNumber maps account number to their address.
 	AccountByNumber collections.Map[[]byte, uint64]
+	// AccountsByTypeIndex indexes the account addresses by account type.
+	AccountsByTypeIndex collections.KeySet[collections.Pair[string, []byte]]
+	// AccountsMetadata maps account address to their metadata.
+	AccountsMetadata collections.Map[[]byte, v1.AccountMetadata]
+	// AccountsByNameIndex indexes the account addresses by metadata name.
+	AccountsByNameIndex collections.KeySet[collections.Pair[string, []byte]]
 
 	// AccountsState keeps track of the state of each account.
 	// NOTE: this is only used for genesis import and export.
@@ -111,6 +140,9 @@ type Keeper struct {
 	AccountsState collections.Map[collections.Pair[uint64, []byte], []byte]
 
 	bundlingDisabled bool // if this is set then bundling of txs is disallowed.
+
+	maxExecutionDepth       uint32 // maximum number of nested account executions.
+	nestedExecutionGasLimit uint64 // gas limit of each nested account execution.
 }
 
 // IsAccountsModuleAccount check if an address belong to a smart account.
@@ -122,6 +154,20 @@ func (k Keeper) IsAccountsModuleAccount(
 	return hasAcc
 }
 
+// AccountType returns the type of the given smart account.
+func (k Keeper) AccountType(ctx context.Context, accountAddr []byte) (string, error) {
+	return k.AccountsByType.Get(ctx, accountAddr)
+}
+
+// IterateAccountsByType iterates over the addresses of the smart accounts of the given type, until the
+// callback returns true or an error.
+func (k Keeper) IterateAccountsByType(ctx context.Context, accountType string, cb func(accountAddr []byte) (stop bool, err error)) error {
+	rng := collections.NewPrefixedPairRange[string, []byte](accountType)
+	return k.AccountsByTypeIndex.Walk(ctx, rng, func(key collections.Pair[string, []byte]) (bool, error) {
+		return cb(key.K2())
+	})
+}
+
 func (k Keeper) NextAccountNumber(
 	ctx context.Context,
 ) (accNum uint64, err error) {
@@ -172,6 +218,82 @@ func (k Keeper) Init(
 	return initResp, accountAddr, nil
 }
 
+// InitWithSalt creates a new account of the given type, whose address is derived from the creator, the
+// account type and the salt, as returned by PredictAddress.
+func (k Keeper) InitWithSalt(
+	ctx context.Context,
+	accountType string,
+	creator []byte,
+	initRequest transaction.Msg,
+	funds sdk.Coins,
+	salt []byte,
+) (transaction.Msg, []byte, error) {
+	if len(salt) == 0 {
+		return nil, nil, errors.New("salt must be set")
+	}
+	num, err := k.AccountNumber.Next(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	accountAddr := k.PredictAddress(creator, accountType, salt)
+	initResp, err := k.init(ctx, accountType, creator, num, accountAddr, initRequest, funds)
+	if err != nil {
+		return nil, nil, err
+	}
+	return initResp, accountAddr, nil
+}
+
+// BatchInitRequest defines an account to create with InitBatch.
+type BatchInitRequest struct {
+	// InitRequest is the init message of the account.
+	InitRequest transaction.Msg
+	// Funds are sent by the creator to the account.
+	Funds sdk.Coins
+	// AddressSeed is used to derive the address of the account, if set.
+	AddressSeed []byte
+}
+
+// BatchInitResult defines the result of the creation of an account with InitBatch.
+type BatchInitResult struct {
+	// InitResponse is the response of the account implementation, if the account was created.
+	InitResponse transaction.Msg
+	// AccountAddr is the address of the account, if it was created.
+	AccountAddr []byte
+	// Err is the error which prevented the creation of the account, if any.
+	Err error
+}
+
+// InitBatch creates many accounts of the given type, in order. Each account is created in its own branch,
+// so that a failing account is reported in its result without reverting the other accounts. The addresses
+// are derived as in Init, so they only depend on the creator and the address seed, or the account number
+// when no seed is provided.
+func (k Keeper) InitBatch(
+	ctx context.Context,
+	accountType string,
+	creator []byte,
+	requests []BatchInitRequest,
+) ([]BatchInitResult, error) {
+	if _, ok := k.accounts[accountType]; !ok {
+		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, accountType)
+	}
+
+	results := make([]BatchInitResult, len(requests))
+	for i, req := range requests {
+		err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			resp, addr, err := k.Init(ctx, accountType, creator, req.InitRequest, req.Funds, req.AddressSeed)
+			if err != nil {
+				return err
+			}
+			results[i] = BatchInitResult{InitResponse: resp, AccountAddr: addr}
+			return nil
+		})
+		if err != nil {
+			results[i] = BatchInitResult{Err: err}
+		}
+	}
+	return results, nil
+}
+
 // initFromMsg is a helper which inits an account given a v1.MsgInit.
 func (k Keeper) initFromMsg(ctx context.Context, initMsg *v1.MsgInit) (transaction.Msg, []byte, error) {
 	creator, err := k.addressCodec.StringToBytes(initMsg.Sender)
@@ -186,6 +308,12 @@ func (k Keeper) initFromMsg(ctx context.Context, initMsg *v1.MsgInit) (transacti
 	}
 
 	// run account creation logic
+	if len(initMsg.Salt) > 0 {
+		if len(initMsg.AddressSeed) > 0 {
+			return nil, nil, errors.New("address seed and salt cannot be both set")
+		}
+		return k.InitWithSalt(ctx, initMsg.AccountType, creator, msg, initMsg.Funds, initMsg.Salt)
+	}
 	return k.Init(ctx, initMsg.AccountType, creator, msg, initMsg.Funds, initMsg.AddressSeed)
 }
 
@@ -230,6 +358,9 @@ func (k Keeper) init(
 	if err := k.AccountsByType.Set(ctx, accountAddr, accountType); err != nil {
 		return nil, err
 	}
+	if err := k.AccountsByTypeIndex.Set(ctx, collections.Join(accountType, accountAddr)); err != nil {
+		return nil, err
+	}
 	// map account number to account address
 	if err := k.AccountByNumber.Set(ctx, accountAddr, accountNum); err != nil {
 		return nil, err
@@ -253,12 +384,45 @@ func (k Keeper) MigrateLegacyAccount(
 }
 
 // Execute executes a state transition on the given account.
+// When the execution is nested in another account execution, e.g. an account executing another account,
+// it runs in a branch limited to the nested execution gas limit, and fails if the maximum execution depth
+// is reached.
 func (k Keeper) Execute(
 	ctx context.Context,
 	accountAddr []byte,
 	sender []byte,
 	execRequest transaction.Msg,
 	funds sdk.Coins,
+) (transaction.Msg, error) {
+	depth := executionDepth(ctx)
+	if depth >= k.maxExecutionDepth {
+		return nil, fmt.Errorf("%w: maximum depth is %d", ErrExecutionDepthExceeded, k.maxExecutionDepth)
+	}
+	if depth == 0 {
+		return k.execute(withExecutionDepth(ctx, 1), accountAddr, sender, execRequest, funds)
+	}
+
+	var resp transaction.Msg
+	_, err := k.BranchService.ExecuteWithGasLimit(ctx, k.nestedExecutionGasLimit, func(ctx context.Context) error {
+		var err error
+		resp, err = k.execute(withExecutionDepth(ctx, depth+1), accountAddr, sender, execRequest, funds)
+		return err
+	})
+	if errors.Is(err, branch.ErrGasLimitExceeded) || errors.Is(err, sdkerrors.ErrOutOfGas) {
+		return nil, fmt.Errorf("%w: %w", ErrNestedExecutionOutOfGas, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (k Keeper) execute(
+	ctx context.Context,
+	accountAddr []byte,
+	sender []byte,
+	execRequest transaction.Msg,
+	funds sdk.Coins,
 ) (transaction.Msg, error) {
 	// get account implementation
 	impl, err := k.getImplementation(ctx, accountAddr)
@@ -345,6 +509,21 @@ func (k Keeper) makeAddress(creator []byte, accNum uint64, addressSeed []byte) (
 	return addr[:], nil
 }
 
+// PredictAddress returns the address of the account of the given type created by the creator with the given
+// salt, CREATE2-style: it does not depend on the account number, so it can be computed before the account is
+// created. The creator and the account type are length prefixed, so that the derivation is not ambiguous.
+func (k Keeper) PredictAddress(creator []byte, accountType string, salt []byte) []byte {
+	seed := []byte(ModuleName)
+	seed = binary.AppendUvarint(seed, uint64(len(creator)))
+	seed = append(seed, creator...)
+	seed = binary.AppendUvarint(seed, uint64(len(accountType)))
+	seed = append(seed, accountType...)
+	seed = append(seed, salt...)
+
+	addr := sha256.Sum256(seed)
+	return addr[:]
+}
+
 // makeAccountContext makes a new context for the given account.
 func (k Keeper) makeAccountContext(ctx context.Context, accountNumber uint64, accountAddr, sender []byte, funds sdk.Coins, isQuery bool) context.Context {
 	// if it's not a query we create a context that allows to do anything.
@@ -503,6 +682,25 @@ func (k *Keeper) DisableTxBundling() {
 	k.bundlingDisabled = true
 }
 
+// SetExecutionLimits sets the maximum number of nested account executions, and the gas limit of each
+// nested account execution.
+func (k *Keeper) SetExecutionLimits(maxDepth uint32, nestedGasLimit uint64) {
+	k.maxExecutionDepth = maxDepth
+	k.nestedExecutionGasLimit = nestedGasLimit
+}
+
+// executionDepthKey is the context key of the number of nested account executions.
+type executionDepthKey struct{}
+
+func executionDepth(ctx context.Context) uint32 {
+	depth, _ := ctx.Value(executionDepthKey{}).(uint32)
+	return depth
+}
+
+func withExecutionDepth(ctx context.Context, depth uint32) context.Context {
+	return context.WithValue(ctx, executionDepthKey{}, depth)
+}
+
 const msgInterfaceName = "cosmos.accounts.v1.MsgInterface"
 
 // creates a new interface type which is an alias of the proto message interface to avoid conflicts with sdk.Msg
@@ -0,0 +1,93 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	v1 "cosmossdk.io/x/accounts/v1"
+)
+
+const (
+	// MaxMetadataNameLength is the maximum length in bytes of the name of an account metadata.
+	MaxMetadataNameLength = 64
+	// MaxMetadataURILength is the maximum length in bytes of the URI of an account metadata.
+	MaxMetadataURILength = 256
+	// MaxMetadataAvatarHashLength is the maximum length in bytes of the avatar hash of an account metadata.
+	MaxMetadataAvatarHashLength = 64
+)
+
+// AccountMetadata returns the metadata of the given account, which is empty if the account has none.
+func (k Keeper) AccountMetadata(ctx context.Context, accountAddr []byte) (v1.AccountMetadata, error) {
+	metadata, err := k.AccountsMetadata.Get(ctx, accountAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.AccountMetadata{}, nil
+	}
+	return metadata, err
+}
+
+// SetAccountMetadata replaces the metadata of the given account, and indexes it by name. An empty metadata
+// removes the metadata of the account.
+// NOTE: this assumes the caller checked that the update is authorized by the account.
+func (k Keeper) SetAccountMetadata(ctx context.Context, accountAddr []byte, metadata v1.AccountMetadata) error {
+	has, err := k.AccountsByType.Has(ctx, accountAddr)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return fmt.Errorf("%w: account not found", collections.ErrNotFound)
+	}
+	if err := ValidateAccountMetadata(metadata); err != nil {
+		return err
+	}
+
+	err = k.removeAccountMetadata(ctx, accountAddr)
+	if err != nil {
+		return err
+	}
+	if metadata.Name == "" && metadata.Uri == "" && len(metadata.AvatarHash) == 0 {
+		return nil
+	}
+	return k.setAccountMetadata(ctx, accountAddr, metadata)
+}
+
+func (k Keeper) setAccountMetadata(ctx context.Context, accountAddr []byte, metadata v1.AccountMetadata) error {
+	err := k.AccountsMetadata.Set(ctx, accountAddr, metadata)
+	if err != nil {
+		return err
+	}
+	if metadata.Name == "" {
+		return nil
+	}
+	return k.AccountsByNameIndex.Set(ctx, collections.Join(metadata.Name, accountAddr))
+}
+
+func (k Keeper) removeAccountMetadata(ctx context.Context, accountAddr []byte) error {
+	metadata, err := k.AccountsMetadata.Get(ctx, accountAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = k.AccountsByNameIndex.Remove(ctx, collections.Join(metadata.Name, accountAddr))
+	if err != nil {
+		return err
+	}
+	return k.AccountsMetadata.Remove(ctx, accountAddr)
+}
+
+// ValidateAccountMetadata checks the size limits of an account metadata.
+func ValidateAccountMetadata(metadata v1.AccountMetadata) error {
+	if len(metadata.Name) > MaxMetadataNameLength {
+		return fmt.Errorf("metadata name is longer than %d bytes", MaxMetadataNameLength)
+	}
+	if len(metadata.Uri) > MaxMetadataURILength {
+		return fmt.Errorf("metadata uri is longer than %d bytes", MaxMetadataURILength)
+	}
+	if len(metadata.AvatarHash) > MaxMetadataAvatarHashLength {
+		return fmt.Errorf("metadata avatar hash is longer than %d bytes", MaxMetadataAvatarHashLength)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package accounts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/v1"
+)
+
+func TestKeeper_SetAccountMetadata(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+
+	_, accAddr, err := m.Init(ctx, "test", []byte("sender"), &types.Empty{}, nil, nil)
+	require.NoError(t, err)
+
+	names := func(name string) [][]byte {
+		var addrs [][]byte
+		err := m.AccountsByNameIndex.Walk(ctx, collections.NewPrefixedPairRange[string, []byte](name), func(key collections.Pair[string, []byte]) (bool, error) {
+			addrs = append(addrs, key.K2())
+			return false, nil
+		})
+		require.NoError(t, err)
+		return addrs
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		metadata := v1.AccountMetadata{Name: "alice", Uri: "https://example.com", AvatarHash: []byte("hash")}
+		require.NoError(t, m.SetAccountMetadata(ctx, accAddr, metadata))
+
+		got, err := m.AccountMetadata(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, metadata, got)
+		require.Equal(t, [][]byte{accAddr}, names("alice"))
+
+		// the index is updated with the name
+		require.NoError(t, m.SetAccountMetadata(ctx, accAddr, v1.AccountMetadata{Name: "bob"}))
+		require.Empty(t, names("alice"))
+		require.Equal(t, [][]byte{accAddr}, names("bob"))
+
+		// an empty metadata removes it
+		require.NoError(t, m.SetAccountMetadata(ctx, accAddr, v1.AccountMetadata{}))
+		has, err := m.AccountsMetadata.Has(ctx, accAddr)
+		require.NoError(t, err)
+		require.False(t, has)
+		require.Empty(t, names("bob"))
+	})
+
+	t.Run("size limits", func(t *testing.T) {
+		err := m.SetAccountMetadata(ctx, accAddr, v1.AccountMetadata{Name: strings.Repeat("a", MaxMetadataNameLength+1)})
+		require.ErrorContains(t, err, "metadata name is longer than 64 bytes")
+		err = m.SetAccountMetadata(ctx, accAddr, v1.AccountMetadata{Uri: strings.Repeat("a", MaxMetadataURILength+1)})
+		require.ErrorContains(t, err, "metadata uri is longer than 256 bytes")
+		err = m.SetAccountMetadata(ctx, accAddr, v1.AccountMetadata{AvatarHash: make([]byte, MaxMetadataAvatarHashLength+1)})
+		require.ErrorContains(t, err, "metadata avatar hash is longer than 64 bytes")
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		err := m.SetAccountMetadata(ctx, []byte("unknown"), v1.AccountMetadata{Name: "alice"})
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+}
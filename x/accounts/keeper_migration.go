@@ -0,0 +1,87 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/transaction"
+	migration_v1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// MigrateAccountType migrates the given account to another account type in place, so it keeps its address,
+// its account number and its balances. Both account implementations must agree to the migration:
+// the old implementation must handle migration_v1.MsgMigrateOut, executed before the state of the account
+// is deleted, and the new implementation must handle migration_v1.MsgMigrateIn, executed after the account
+// is initialized with the given init message.
+// NOTE: this assumes the caller checked that the migration is authorized by the account.
+func (k Keeper) MigrateAccountType(
+	ctx context.Context,
+	accountAddr []byte,
+	newAccountType string,
+	initRequest transaction.Msg,
+) (transaction.Msg, error) {
+	oldAccountType, err := k.AccountsByType.Get(ctx, accountAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, fmt.Errorf("%w: account not found", ErrAccountMigration)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if oldAccountType == newAccountType {
+		return nil, fmt.Errorf("%w: account is already of type %s", ErrAccountMigration, newAccountType)
+	}
+	oldImpl, ok := k.accounts[oldAccountType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errAccountTypeNotFound, oldAccountType)
+	}
+	newImpl, ok := k.accounts[newAccountType]
+	if !ok {
+		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, newAccountType)
+	}
+	if !oldImpl.HasExec(&migration_v1.MsgMigrateOut{}) {
+		return nil, fmt.Errorf("%w: account type %s does not support migrating out", ErrAccountMigration, oldAccountType)
+	}
+	if !newImpl.HasExec(&migration_v1.MsgMigrateIn{}) {
+		return nil, fmt.Errorf("%w: account type %s does not support migrating in", ErrAccountMigration, newAccountType)
+	}
+
+	accountNum, err := k.AccountByNumber.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// handshake with the old implementation, then delete the old state of the account.
+	_, err = k.Execute(ctx, accountAddr, address.Module(ModuleName), &migration_v1.MsgMigrateOut{NewAccountType: newAccountType}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAccountMigration, err)
+	}
+	err = k.AccountsState.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](accountNum))
+	if err != nil {
+		return nil, err
+	}
+	err = k.AccountsByType.Remove(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+	err = k.AccountsByTypeIndex.Remove(ctx, collections.Join(oldAccountType, accountAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	// init the account with the new implementation, as if the account created itself, then handshake
+	// with the new implementation.
+	resp, err := k.init(ctx, newAccountType, accountAddr, accountNum, accountAddr, initRequest, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAccountMigration, err)
+	}
+	_, err = k.Execute(ctx, accountAddr, address.Module(ModuleName), &migration_v1.MsgMigrateIn{OldAccountType: oldAccountType}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAccountMigration, err)
+	}
+
+	return resp, nil
+}
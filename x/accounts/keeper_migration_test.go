@@ -0,0 +1,139 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	migration_v1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+)
+
+var _ implementation.Account = migratingAccount{}
+
+func newMigratingAccount(d accountstd.Dependencies) (migratingAccount, error) {
+	return migratingAccount{
+		Counter:      collections.NewSequence(d.SchemaBuilder, collections.NewPrefix(0), "counter"),
+		MigratedFrom: collections.NewItem(d.SchemaBuilder, collections.NewPrefix(1), "migrated_from", collections.StringValue),
+	}, nil
+}
+
+// migratingAccount is a test account which can be migrated from and to other account types.
+type migratingAccount struct {
+	Counter      collections.Sequence
+	MigratedFrom collections.Item[string]
+}
+
+func (m migratingAccount) RegisterInitHandler(builder *implementation.InitBuilder) {
+	implementation.RegisterInitHandler(builder, func(_ context.Context, _ *types.Empty) (*types.Empty, error) {
+		return &types.Empty{}, nil
+	})
+}
+
+func (m migratingAccount) RegisterExecuteHandlers(builder *implementation.ExecuteBuilder) {
+	implementation.RegisterExecuteHandler(builder, func(ctx context.Context, req *types.UInt64Value) (*types.Empty, error) {
+		return &types.Empty{}, m.Counter.Set(ctx, req.Value)
+	})
+	implementation.RegisterExecuteHandler(builder, func(ctx context.Context, _ *migration_v1.MsgMigrateOut) (*migration_v1.MsgMigrateOutResponse, error) {
+		if !accountstd.SenderIsAccountsModule(ctx) {
+			return nil, errors.New("unauthorized")
+		}
+		return &migration_v1.MsgMigrateOutResponse{}, nil
+	})
+	implementation.RegisterExecuteHandler(builder, func(ctx context.Context, req *migration_v1.MsgMigrateIn) (*migration_v1.MsgMigrateInResponse, error) {
+		if !accountstd.SenderIsAccountsModule(ctx) {
+			return nil, errors.New("unauthorized")
+		}
+		return &migration_v1.MsgMigrateInResponse{}, m.MigratedFrom.Set(ctx, req.OldAccountType)
+	})
+}
+
+func (m migratingAccount) RegisterQueryHandlers(builder *implementation.QueryBuilder) {
+	implementation.RegisterQueryHandler(builder, func(ctx context.Context, _ *types.Empty) (*types.UInt64Value, error) {
+		v, err := m.Counter.Peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &types.UInt64Value{Value: v}, nil
+	})
+	implementation.RegisterQueryHandler(builder, func(ctx context.Context, _ *types.StringValue) (*types.StringValue, error) {
+		v, err := m.MigratedFrom.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		return &types.StringValue{Value: v}, nil
+	})
+}
+
+func TestKeeper_MigrateAccountType(t *testing.T) {
+	m, ctx := newKeeper(t,
+		accountstd.AddAccount("test", NewTestAccount),
+		accountstd.AddAccount("migrating_a", newMigratingAccount),
+		accountstd.AddAccount("migrating_b", newMigratingAccount),
+	)
+
+	sender := []byte("sender")
+	_, accAddr, err := m.Init(ctx, "migrating_a", sender, &types.Empty{}, nil, nil)
+	require.NoError(t, err)
+	_, err = m.Execute(ctx, accAddr, sender, &types.UInt64Value{Value: 10}, nil)
+	require.NoError(t, err)
+	accNum, err := m.AccountByNumber.Get(ctx, accAddr)
+	require.NoError(t, err)
+
+	t.Run("same account type", func(t *testing.T) {
+		_, err := m.MigrateAccountType(ctx, accAddr, "migrating_a", &types.Empty{})
+		require.ErrorIs(t, err, ErrAccountMigration)
+	})
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := m.MigrateAccountType(ctx, accAddr, "unknown", &types.Empty{})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+
+	t.Run("new account type does not support migrating in", func(t *testing.T) {
+		_, err := m.MigrateAccountType(ctx, accAddr, "test", &types.Empty{})
+		require.ErrorContains(t, err, "account type test does not support migrating in")
+	})
+
+	t.Run("old account type does not support migrating out", func(t *testing.T) {
+		_, testAddr, err := m.Init(ctx, "test", sender, &types.Empty{}, nil, nil)
+		require.NoError(t, err)
+		_, err = m.MigrateAccountType(ctx, testAddr, "migrating_a", &types.Empty{})
+		require.ErrorContains(t, err, "account type test does not support migrating out")
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := m.MigrateAccountType(ctx, accAddr, "migrating_b", &types.Empty{})
+		require.NoError(t, err)
+		require.Equal(t, &types.Empty{}, resp)
+
+		accType, err := m.AccountType(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, "migrating_b", accType)
+		gotNum, err := m.AccountByNumber.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, accNum, gotNum)
+
+		// the index is updated
+		var addrs [][]byte
+		err = m.IterateAccountsByType(ctx, "migrating_a", func(addr []byte) (bool, error) {
+			addrs = append(addrs, addr)
+			return false, nil
+		})
+		require.NoError(t, err)
+		require.Empty(t, addrs)
+
+		// the old state is deleted, and the new implementation was notified
+		counter, err := m.Query(ctx, accAddr, &types.Empty{})
+		require.NoError(t, err)
+		require.Equal(t, &types.UInt64Value{Value: 0}, counter)
+		migratedFrom, err := m.Query(ctx, accAddr, &types.StringValue{})
+		require.NoError(t, err)
+		require.Equal(t, &types.StringValue{Value: "migrating_a"}, migratedFrom)
+	})
+}
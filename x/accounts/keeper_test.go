@@ -1,14 +1,17 @@
 package accounts
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/branch"
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
+	v1 "cosmossdk.io/x/accounts/v1"
 )
 
 func TestKeeper_Init(t *testing.T) {
@@ -63,6 +66,48 @@ func TestKeeper_Execute(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, implementation.Equal(&types.Empty{}, resp))
 	})
+
+	t.Run("max execution depth", func(t *testing.T) {
+		_, err := m.Execute(withExecutionDepth(ctx, DefaultMaxExecutionDepth-1), accAddr, sender, &types.Empty{}, nil)
+		require.NoError(t, err)
+		_, err = m.Execute(withExecutionDepth(ctx, DefaultMaxExecutionDepth), accAddr, sender, &types.Empty{}, nil)
+		require.ErrorIs(t, err, ErrExecutionDepthExceeded)
+	})
+
+	t.Run("nested execution gas limit", func(t *testing.T) {
+		m := m
+		m.SetExecutionLimits(DefaultMaxExecutionDepth, 1000)
+		limits := &gasLimitBranchService{}
+		m.BranchService = limits
+
+		// the top level execution is not limited
+		_, err := m.Execute(ctx, accAddr, sender, &types.Empty{}, nil)
+		require.NoError(t, err)
+		require.Empty(t, limits.gasLimits)
+
+		_, err = m.Execute(withExecutionDepth(ctx, 1), accAddr, sender, &types.Empty{}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1000}, limits.gasLimits)
+
+		limits.err = branch.ErrGasLimitExceeded
+		_, err = m.Execute(withExecutionDepth(ctx, 1), accAddr, sender, &types.Empty{}, nil)
+		require.ErrorIs(t, err, ErrNestedExecutionOutOfGas)
+	})
+}
+
+// gasLimitBranchService records the gas limits of the branches, and fails them with err after executing them.
+type gasLimitBranchService struct {
+	branchService
+	gasLimits []uint64
+	err       error
+}
+
+func (b *gasLimitBranchService) ExecuteWithGasLimit(ctx context.Context, gasLimit uint64, f func(ctx context.Context) error) (uint64, error) {
+	b.gasLimits = append(b.gasLimits, gasLimit)
+	if err := f(ctx); err != nil {
+		return gasLimit, err
+	}
+	return gasLimit, b.err
 }
 
 func TestKeeper_Query(t *testing.T) {
@@ -90,3 +135,94 @@ func TestKeeper_Query(t *testing.T) {
 		require.True(t, implementation.Equal(&types.Int64Value{Value: 1000}, resp))
 	})
 }
+
+func TestKeeper_IterateAccountsByType(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("other", NewTestAccount))
+
+	sender := []byte("sender")
+	_, accAddr, err := m.Init(ctx, "test", sender, &types.Empty{}, nil, nil)
+	require.NoError(t, err)
+	_, _, err = m.Init(ctx, "other", sender, &types.Empty{}, nil, nil)
+	require.NoError(t, err)
+
+	var addrs [][]byte
+	err = m.IterateAccountsByType(ctx, "test", func(addr []byte) (bool, error) {
+		addrs = append(addrs, addr)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{accAddr}, addrs)
+}
+
+func TestKeeper_InitBatch(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+
+	sender := []byte("sender")
+	results, err := m.InitBatch(ctx, "test", sender, []BatchInitRequest{
+		{InitRequest: &types.Empty{}, AddressSeed: []byte("seed1")},
+		{InitRequest: &types.Empty{}, AddressSeed: []byte("seed1")},
+		{InitRequest: &types.Empty{}},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	// the addresses are derived as in Init
+	require.NoError(t, results[0].Err)
+	require.Equal(t, &types.Empty{}, results[0].InitResponse)
+	addr, err := m.makeAddress(sender, 0, []byte("seed1"))
+	require.NoError(t, err)
+	require.Equal(t, addr, results[0].AccountAddr)
+
+	// a failing account does not prevent the creation of the others
+	require.ErrorIs(t, results[1].Err, ErrAccountAlreadyExists)
+	require.Nil(t, results[1].AccountAddr)
+
+	require.NoError(t, results[2].Err)
+	accType, err := m.AccountsByType.Get(ctx, results[2].AccountAddr)
+	require.NoError(t, err)
+	require.Equal(t, "test", accType)
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := m.InitBatch(ctx, "unknown", sender, []BatchInitRequest{{InitRequest: &types.Empty{}}})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+}
+
+func TestKeeper_InitWithSalt(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("other", NewTestAccount))
+
+	sender := []byte("sender")
+	salt := []byte("salt")
+	predicted := m.PredictAddress(sender, "test", salt)
+	require.NotEqual(t, predicted, m.PredictAddress(sender, "other", salt))
+	require.NotEqual(t, predicted, m.PredictAddress([]byte("other"), "test", salt))
+
+	t.Run("ok", func(t *testing.T) {
+		_, addr, err := m.InitWithSalt(ctx, "test", sender, &types.Empty{}, nil, salt)
+		require.NoError(t, err)
+		require.Equal(t, predicted, addr)
+
+		// the same salt can be used for another account type
+		_, addr, err = m.InitWithSalt(ctx, "other", sender, &types.Empty{}, nil, salt)
+		require.NoError(t, err)
+		require.Equal(t, m.PredictAddress(sender, "other", salt), addr)
+	})
+
+	t.Run("salt reused", func(t *testing.T) {
+		_, _, err := m.InitWithSalt(ctx, "test", sender, &types.Empty{}, nil, salt)
+		require.ErrorIs(t, err, ErrAccountAlreadyExists)
+	})
+
+	t.Run("salt and address seed", func(t *testing.T) {
+		msg, err := implementation.PackAny(&types.Empty{})
+		require.NoError(t, err)
+		_, _, err = m.initFromMsg(ctx, &v1.MsgInit{
+			Sender:      "sender",
+			AccountType: "test",
+			Message:     msg,
+			AddressSeed: []byte("seed"),
+			Salt:        []byte("other-salt"),
+		})
+		require.ErrorContains(t, err, "address seed and salt cannot be both set")
+	})
+}
@@ -0,0 +1,24 @@
+package accounts
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates x/accounts storage from version 1 to 2, indexing the existing accounts by type.
+func (m Migrator) Migrate1to2(ctx context.Context) error {
+	return m.k.AccountsByType.Walk(ctx, nil, func(accountAddr []byte, accountType string) (bool, error) {
+		return false, m.k.AccountsByTypeIndex.Set(ctx, collections.Join(accountType, accountAddr))
+	})
+}
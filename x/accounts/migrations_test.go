@@ -0,0 +1,26 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+
+	// accounts created before the index
+	require.NoError(t, m.AccountsByType.Set(ctx, []byte("addr1"), "test"))
+	require.NoError(t, m.AccountsByType.Set(ctx, []byte("addr2"), "test"))
+
+	require.NoError(t, NewMigrator(m).Migrate1to2(ctx))
+
+	for _, addr := range []string{"addr1", "addr2"} {
+		has, err := m.AccountsByTypeIndex.Has(ctx, collections.Join("test", []byte(addr)))
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+}
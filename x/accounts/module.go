@@ -3,11 +3,11 @@ package accounts
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
-	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/schema"
@@ -23,7 +23,7 @@ const (
 	ModuleName = "accounts"
 	StoreKey   = "_" + ModuleName // unfortunately accounts collides with auth store key
 
-	ConsensusVersion = 1
+	ConsensusVersion = 2
 )
 
 // ModuleAccountAddress defines the x/accounts module address.
@@ -33,6 +33,7 @@ var (
 	_ appmodule.AppModule           = AppModule{}
 	_ appmodule.HasGenesis          = AppModule{}
 	_ appmodule.HasConsensusVersion = AppModule{}
+	_ appmodule.HasMigrations       = AppModule{}
 )
 
 func NewAppModule(cdc codec.Codec, k Keeper) AppModule {
@@ -108,8 +109,19 @@ func (AppModule) GetQueryCmd() *cobra.Command {
 
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// RegisterMigrations registers the accounts module's migrations.
+func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
+	m := NewMigrator(am.k)
+
+	if err := mr.Register(ModuleName, 1, m.Migrate1to2); err != nil {
+		return fmt.Errorf("failed to migrate x/accounts from version 1 to 2: %w", err)
+	}
+
+	return nil
+}
+
 // ModuleCodec implements `schema.HasModuleCodec` interface.
 // It allows the indexer to decode the module's KVPairUpdate.
 func (am AppModule) ModuleCodec() (schema.ModuleCodec, error) {
-	return am.k.Schema.ModuleCodec(collections.IndexingOptions{})
+	return am.k.ModuleCodec()
 }
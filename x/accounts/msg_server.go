@@ -53,6 +53,66 @@ func (m msgServer) Init(ctx context.Context, request *v1.MsgInit) (*v1.MsgInitRe
 	}, nil
 }
 
+func (m msgServer) InitBatch(ctx context.Context, request *v1.MsgInitBatch) (*v1.MsgInitBatchResponse, error) {
+	if len(request.Items) == 0 {
+		return nil, errors.New("no accounts to initialize")
+	}
+	creator, err := m.k.addressCodec.StringToBytes(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode message bytes into the concrete boxed message types, reporting the invalid ones.
+	responses := make([]v1.InitBatchItemResponse, len(request.Items))
+	var (
+		requests []BatchInitRequest
+		indexes  []int
+	)
+	for i, item := range request.Items {
+		msg, err := implementation.UnpackAnyRaw(item.Message)
+		if err != nil {
+			responses[i].Error = err.Error()
+			continue
+		}
+		requests = append(requests, BatchInitRequest{InitRequest: msg, Funds: item.Funds, AddressSeed: item.AddressSeed})
+		indexes = append(indexes, i)
+	}
+
+	results, err := m.k.InitBatch(ctx, request.AccountType, creator, requests)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize accounts: %w", err)
+	}
+
+	eventManager := m.k.EventService.EventManager(ctx)
+	for j, result := range results {
+		i := indexes[j]
+		if result.Err != nil {
+			responses[i].Error = result.Err.Error()
+			continue
+		}
+
+		accAddrString, err := m.k.addressCodec.BytesToString(result.AccountAddr)
+		if err != nil {
+			return nil, err
+		}
+		err = eventManager.EmitKV(
+			"account_creation",
+			event.NewAttribute("address", accAddrString),
+		)
+		if err != nil {
+			return nil, err
+		}
+		anyResp, err := implementation.PackAny(result.InitResponse)
+		if err != nil {
+			return nil, err
+		}
+		responses[i].AccountAddress = accAddrString
+		responses[i].Response = anyResp
+	}
+
+	return &v1.MsgInitBatchResponse{Responses: responses}, nil
+}
+
 func (m msgServer) Execute(ctx context.Context, execute *v1.MsgExecute) (*v1.MsgExecuteResponse, error) {
 	// decode sender address
 	senderAddr, err := m.k.addressCodec.StringToBytes(execute.Sender)
@@ -103,3 +163,62 @@ func (m msgServer) ExecuteBundle(ctx context.Context, req *v1.MsgExecuteBundle)
 	}
 	return &v1.MsgExecuteBundleResponse{Responses: responses}, nil
 }
+
+func (m msgServer) MigrateAccountType(ctx context.Context, req *v1.MsgMigrateAccountType) (*v1.MsgMigrateAccountTypeResponse, error) {
+	// the account migrates itself, so the sender is the account to migrate.
+	accountAddr, err := m.k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode message bytes into the concrete boxed message type
+	initReq, err := implementation.UnpackAnyRaw(req.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.k.MigrateAccountType(ctx, accountAddr, req.NewAccountType, initReq)
+	if err != nil {
+		return nil, err
+	}
+
+	eventManager := m.k.EventService.EventManager(ctx)
+	err = eventManager.EmitKV(
+		"account_migration",
+		event.NewAttribute("address", req.Sender),
+		event.NewAttribute("account_type", req.NewAccountType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	anyResp, err := implementation.PackAny(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgMigrateAccountTypeResponse{Response: anyResp}, nil
+}
+
+func (m msgServer) UpdateAccountMetadata(ctx context.Context, req *v1.MsgUpdateAccountMetadata) (*v1.MsgUpdateAccountMetadataResponse, error) {
+	// the account updates its own metadata, so the sender is the account.
+	accountAddr, err := m.k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.k.SetAccountMetadata(ctx, accountAddr, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.k.EventService.EventManager(ctx).EmitKV(
+		"account_metadata_update",
+		event.NewAttribute("address", req.Sender),
+		event.NewAttribute("name", req.Metadata.Name),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateAccountMetadataResponse{}, nil
+}
@@ -2,10 +2,14 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/internal/implementation"
 	v1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ v1.QueryServer = &queryServer{}
@@ -93,9 +97,90 @@ func (q *queryServer) AccountNumber(ctx context.Context, request *v1.AccountNumb
 	return &v1.AccountNumberResponse{Number: number}, nil
 }
 
+// AccountsByType returns the addresses of the accounts of the given account type, using the index of the
+// accounts by type.
+func (q *queryServer) AccountsByType(ctx context.Context, request *v1.AccountsByTypeRequest) (*v1.AccountsByTypeResponse, error) {
+	if _, ok := q.k.accounts[request.AccountType]; !ok {
+		return nil, fmt.Errorf("%w: %s", errAccountTypeNotFound, request.AccountType)
+	}
+
+	addrs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.AccountsByTypeIndex,
+		request.Pagination,
+		func(key collections.Pair[string, []byte], _ collections.NoValue) (string, error) {
+			return q.k.addressCodec.BytesToString(key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, []byte](request.AccountType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.AccountsByTypeResponse{Addresses: addrs, Pagination: pageRes}, nil
+}
+
+func (q *queryServer) AccountMetadata(ctx context.Context, request *v1.AccountMetadataRequest) (*v1.AccountMetadataResponse, error) {
+	addr, err := q.k.addressCodec.StringToBytes(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := q.k.AccountMetadata(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AccountMetadataResponse{Metadata: metadata}, nil
+}
+
+// AccountsByName returns the addresses of the accounts with the given metadata name, using the index of the
+// accounts by name.
+func (q *queryServer) AccountsByName(ctx context.Context, request *v1.AccountsByNameRequest) (*v1.AccountsByNameResponse, error) {
+	if request.Name == "" {
+		return nil, errors.New("name must be set")
+	}
+
+	addrs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.AccountsByNameIndex,
+		request.Pagination,
+		func(key collections.Pair[string, []byte], _ collections.NoValue) (string, error) {
+			return q.k.addressCodec.BytesToString(key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, []byte](request.Name),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.AccountsByNameResponse{Addresses: addrs, Pagination: pageRes}, nil
+}
+
+func (q *queryServer) PredictAddress(_ context.Context, request *v1.PredictAddressRequest) (*v1.PredictAddressResponse, error) {
+	if _, ok := q.k.accounts[request.AccountType]; !ok {
+		return nil, fmt.Errorf("%w: %s", errAccountTypeNotFound, request.AccountType)
+	}
+	if len(request.Salt) == 0 {
+		return nil, errors.New("salt must be set")
+	}
+	creator, err := q.k.addressCodec.StringToBytes(request.Creator)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := q.k.addressCodec.BytesToString(q.k.PredictAddress(creator, request.AccountType, request.Salt))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PredictAddressResponse{Address: addr}, nil
+}
+
 const (
 	// TODO(tip): evaluate if the following numbers should be parametrised over state, or over the node.
 	SimulateAuthenticateGasLimit   = 1_000_000
 	SimulateBundlerPaymentGasLimit = SimulateAuthenticateGasLimit
 	ExecuteGasLimit                = SimulateAuthenticateGasLimit
+
+	// DefaultMaxExecutionDepth is the default maximum number of nested account executions.
+	DefaultMaxExecutionDepth = 8
+	// DefaultNestedExecutionGasLimit is the default gas limit of each nested account execution.
+	DefaultNestedExecutionGasLimit = ExecuteGasLimit
 )
@@ -11,10 +11,12 @@ import (
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
 	v1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func TestQueryServer(t *testing.T) {
-	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("other", NewTestAccount))
 
 	ms := NewMsgServer(k)
 	qs := NewQueryServer(k)
@@ -74,4 +76,42 @@ func TestQueryServer(t *testing.T) {
 		// Check if both responses are the same (cached)
 		require.Equal(t, schemaResp1, schemaResp2)
 	})
+
+	t.Run("accounts by type", func(t *testing.T) {
+		secondResp, err := ms.Init(ctx, &v1.MsgInit{
+			Sender:      "sender",
+			AccountType: "test",
+			Message:     initMsg,
+		})
+		require.NoError(t, err)
+		_, err = ms.Init(ctx, &v1.MsgInit{
+			Sender:      "sender",
+			AccountType: "other",
+			Message:     initMsg,
+		})
+		require.NoError(t, err)
+
+		firstPage, err := qs.AccountsByType(ctx, &v1.AccountsByTypeRequest{
+			AccountType: "test",
+			Pagination:  &query.PageRequest{Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, firstPage.Addresses, 1)
+		require.NotEmpty(t, firstPage.Pagination.NextKey)
+
+		secondPage, err := qs.AccountsByType(ctx, &v1.AccountsByTypeRequest{
+			AccountType: "test",
+			Pagination:  &query.PageRequest{Key: firstPage.Pagination.NextKey},
+		})
+		require.NoError(t, err)
+		require.Len(t, secondPage.Addresses, 1)
+		require.Empty(t, secondPage.Pagination.NextKey)
+		require.ElementsMatch(t,
+			[]string{initResp.AccountAddress, secondResp.AccountAddress},
+			append(firstPage.Addresses, secondPage.Addresses...),
+		)
+
+		_, err = qs.AccountsByType(ctx, &v1.AccountsByTypeRequest{AccountType: "unknown"})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
 }
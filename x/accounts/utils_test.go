@@ -40,6 +40,17 @@ func (e eventService) EmitKV(eventType string, attrs ...event.Attribute) error {
 
 func (e eventService) EventManager(ctx context.Context) event.Manager { return e }
 
+// branchService executes the branches in the parent context, as the test context cannot be branched.
+type branchService struct{}
+
+func (b branchService) Execute(ctx context.Context, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
+
+func (b branchService) ExecuteWithGasLimit(ctx context.Context, gasLimit uint64, f func(ctx context.Context) error) (gasUsed uint64, err error) {
+	return 0, f(ctx)
+}
+
 func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Keeper, context.Context) {
 	t.Helper()
 
@@ -75,6 +86,7 @@ func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Kee
 	ss := coretesting.KVStoreService(ctx, "test")
 	env := runtime.NewEnvironment(ss, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(queryRouter), runtime.EnvWithMsgRouterService(msgRouter))
 	env.EventService = eventService{}
+	env.BranchService = branchService{}
 	m, err := NewKeeper(codec.NewProtoCodec(ir), env, addressCodec, ir, nil, accounts...)
 	require.NoError(t, err)
 	return m, ctx
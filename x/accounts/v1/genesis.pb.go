@@ -87,6 +87,8 @@ type GenesisAccount struct {
 	AccountNumber uint64 `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
 	// state is the account state represented as a slice of raw key value byte pairs.
 	State []*KVPair `protobuf:"bytes,4,rep,name=state,proto3" json:"state,omitempty"`
+	// metadata is the metadata of the account, if any.
+	Metadata *AccountMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *GenesisAccount) Reset()         { *m = GenesisAccount{} }
@@ -150,6 +152,13 @@ func (m *GenesisAccount) GetState() []*KVPair {
 	return nil
 }
 
+func (m *GenesisAccount) GetMetadata() *AccountMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // KVPair defines a key value pair.
 type KVPair struct {
 	// key is the key of the pair.
@@ -214,28 +223,30 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/genesis.proto", fileDescriptor_409859d32eae9438) }
 
 var fileDescriptor_409859d32eae9438 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x3b, 0xfd, 0x53, 0x6f, 0x63, 0xd5, 0xc1, 0x45, 0x68, 0x21, 0xd4, 0x80, 0xd0, 0x55,
-	0xd2, 0xaa, 0x6b, 0x41, 0x37, 0x45, 0xa4, 0x22, 0xa3, 0xb8, 0x70, 0x53, 0xd2, 0x76, 0x08, 0x43,
-	0x6d, 0xa6, 0xe4, 0x4e, 0x4b, 0xfb, 0x16, 0xee, 0x7c, 0x03, 0x9f, 0xc5, 0x65, 0x97, 0x2e, 0xa5,
-	0x7d, 0x11, 0xc9, 0x4c, 0x22, 0x8a, 0xd9, 0xe5, 0x7e, 0x39, 0xe7, 0xdc, 0xc3, 0x5c, 0x68, 0x8d,
-	0x24, 0x4e, 0x25, 0xfa, 0xc1, 0x68, 0x24, 0xe7, 0x91, 0x42, 0x7f, 0xd1, 0xf5, 0x43, 0x1e, 0x71,
-	0x14, 0xe8, 0xcd, 0x62, 0xa9, 0x24, 0xa5, 0x46, 0xe1, 0x65, 0x0a, 0x6f, 0xd1, 0x6d, 0x34, 0x73,
-	0x5c, 0x6a, 0x69, 0x0c, 0xee, 0x1b, 0x01, 0xab, 0x67, 0x22, 0x1e, 0x54, 0xa0, 0x38, 0xbd, 0x84,
-	0xdd, 0x4c, 0x68, 0x93, 0x56, 0xa9, 0x5d, 0x3b, 0x73, 0xbd, 0xff, 0xa1, 0x5e, 0xea, 0xb9, 0x32,
-	0x88, 0xfd, 0x78, 0x68, 0x0f, 0x8e, 0x44, 0x24, 0xd4, 0x20, 0x05, 0x83, 0x29, 0x86, 0x68, 0x17,
-	0x75, 0x50, 0x33, 0x2f, 0xa8, 0x8f, 0xe1, 0x4d, 0x24, 0x14, 0x3b, 0x48, 0x5c, 0x69, 0x5c, 0x1f,
-	0x43, 0x74, 0xdf, 0x09, 0xd4, 0xff, 0x6e, 0xa1, 0x36, 0xec, 0x04, 0xe3, 0x71, 0xcc, 0x31, 0xa9,
-	0x46, 0xda, 0x7b, 0x2c, 0x1b, 0xe9, 0x09, 0x58, 0xd9, 0x42, 0xb5, 0x9a, 0x71, 0xbb, 0xa8, 0x7f,
-	0xd7, 0x52, 0xf6, 0xb8, 0x9a, 0x71, 0x7a, 0x0a, 0xf5, 0x4c, 0x12, 0xcd, 0xa7, 0x43, 0x1e, 0xdb,
-	0xa5, 0x16, 0x69, 0x97, 0xd9, 0x7e, 0x4a, 0xef, 0x34, 0xa4, 0x1d, 0xa8, 0x60, 0xf2, 0x10, 0x76,
-	0x59, 0x77, 0x6e, 0xe4, 0x75, 0xbe, 0x7d, 0xba, 0x0f, 0x44, 0xcc, 0x8c, 0xd0, 0xed, 0x40, 0xd5,
-	0x00, 0x7a, 0x08, 0xa5, 0x09, 0x5f, 0xe9, 0x6e, 0x16, 0x4b, 0x3e, 0xe9, 0x31, 0x54, 0x16, 0xc1,
-	0xcb, 0xdc, 0x14, 0xb2, 0x98, 0x19, 0xae, 0x2f, 0x3e, 0x36, 0x0e, 0x59, 0x6f, 0x1c, 0xf2, 0xb5,
-	0x71, 0xc8, 0xeb, 0xd6, 0x29, 0xac, 0xb7, 0x4e, 0xe1, 0x73, 0xeb, 0x14, 0x9e, 0x1b, 0x66, 0x1b,
-	0x8e, 0x27, 0x9e, 0x90, 0xfe, 0xf2, 0xf7, 0xcd, 0x86, 0x55, 0x7d, 0xb1, 0xf3, 0xef, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x06, 0xd4, 0x9c, 0x6a, 0x06, 0x02, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4f, 0x4f, 0xc2, 0x30,
+	0x18, 0xc6, 0x29, 0xff, 0xc4, 0x32, 0x51, 0x1b, 0x0f, 0xcd, 0x48, 0x96, 0x31, 0x63, 0xb2, 0xd3,
+	0x06, 0xe8, 0x59, 0xa3, 0x17, 0x62, 0x0c, 0xc6, 0x54, 0xe3, 0xc1, 0x0b, 0x29, 0xac, 0x59, 0x16,
+	0xdc, 0x46, 0xd6, 0x42, 0xe0, 0x5b, 0x78, 0xf3, 0x2b, 0x79, 0xe4, 0xe8, 0xd1, 0xc0, 0xc1, 0xaf,
+	0x61, 0xd6, 0x6e, 0x44, 0xe3, 0x6e, 0xeb, 0xd3, 0xe7, 0xf7, 0x3c, 0xef, 0xbb, 0x42, 0x73, 0x12,
+	0xf3, 0x30, 0xe6, 0x2e, 0x9d, 0x4c, 0xe2, 0x79, 0x24, 0xb8, 0xbb, 0xe8, 0xb9, 0x3e, 0x8b, 0x18,
+	0x0f, 0xb8, 0x33, 0x4b, 0x62, 0x11, 0x23, 0xa4, 0x1c, 0x4e, 0xee, 0x70, 0x16, 0x3d, 0xbd, 0x5d,
+	0x40, 0x89, 0xa5, 0x02, 0xf4, 0x4e, 0xc1, 0xe5, 0x0e, 0x96, 0x16, 0xeb, 0x1d, 0x40, 0x6d, 0xa0,
+	0x5a, 0x1e, 0x05, 0x15, 0x0c, 0x5d, 0xc2, 0x46, 0x6e, 0xc1, 0xc0, 0xac, 0xd8, 0xcd, 0xbe, 0xe5,
+	0xfc, 0xef, 0x75, 0x32, 0xe6, 0x5a, 0x49, 0x64, 0xc7, 0xa0, 0x01, 0x3c, 0x0e, 0xa2, 0x40, 0x8c,
+	0x32, 0x61, 0x14, 0x72, 0x9f, 0xe3, 0xb2, 0x0c, 0x6a, 0x17, 0x05, 0x0d, 0xb9, 0x7f, 0x1b, 0x05,
+	0x82, 0x1c, 0xa6, 0x54, 0x16, 0x37, 0xe4, 0x3e, 0xb7, 0xbe, 0x01, 0x6c, 0xfd, 0x6d, 0x41, 0x18,
+	0xee, 0x51, 0xcf, 0x4b, 0x18, 0x4f, 0x47, 0x03, 0xf6, 0x3e, 0xc9, 0x8f, 0xa8, 0x03, 0xb5, 0xbc,
+	0x50, 0xac, 0x66, 0x0c, 0x97, 0xe5, 0x75, 0x33, 0xd3, 0x9e, 0x56, 0x33, 0x86, 0xce, 0x60, 0x2b,
+	0xb7, 0x44, 0xf3, 0x70, 0xcc, 0x12, 0x5c, 0x31, 0x81, 0x5d, 0x25, 0x07, 0x99, 0x7a, 0x2f, 0x45,
+	0xd4, 0x85, 0x35, 0x9e, 0xfe, 0x08, 0x5c, 0x95, 0x33, 0xeb, 0x45, 0x33, 0xdf, 0x3d, 0x3f, 0xd0,
+	0x20, 0x21, 0xca, 0x88, 0xae, 0x60, 0x23, 0x64, 0x82, 0x7a, 0x54, 0x50, 0x5c, 0x33, 0x81, 0xdd,
+	0xec, 0x9f, 0x16, 0x41, 0xf9, 0x6e, 0x99, 0x95, 0xec, 0x20, 0xab, 0x0b, 0xeb, 0x2a, 0x11, 0x1d,
+	0xc1, 0xca, 0x94, 0xad, 0xe4, 0x72, 0x1a, 0x49, 0x3f, 0xd1, 0x09, 0xac, 0x2d, 0xe8, 0xeb, 0x5c,
+	0x6d, 0xa4, 0x11, 0x75, 0xb8, 0xb9, 0xf8, 0xd8, 0x18, 0x60, 0xbd, 0x31, 0xc0, 0xd7, 0xc6, 0x00,
+	0x6f, 0x5b, 0xa3, 0xb4, 0xde, 0x1a, 0xa5, 0xcf, 0xad, 0x51, 0x7a, 0xd1, 0x55, 0x33, 0xf7, 0xa6,
+	0x4e, 0x10, 0xbb, 0xcb, 0xdf, 0x4f, 0x3f, 0xae, 0xcb, 0x27, 0x3f, 0xff, 0x19, 0x00, 0x29, 0xa9,
+	0xf8, 0x1e, 0x6a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -309,6 +320,18 @@ func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.State) > 0 {
 		for iNdEx := len(m.State) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -437,6 +460,10 @@ func (m *GenesisAccount) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -727,6 +754,42 @@ func (m *GenesisAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &AccountMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
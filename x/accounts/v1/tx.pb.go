@@ -47,6 +47,9 @@ type MsgInit struct {
 	// address_seed can be used to deterministically create the address of the account.
 	// If not present the address will be generated based on its associated account number.
 	AddressSeed []byte `protobuf:"bytes,5,opt,name=address_seed,json=addressSeed,proto3" json:"address_seed,omitempty"`
+	// salt can be used to derive the address of the account from the sender, the account type and the salt,
+	// so the address can be computed before the account is created. It cannot be set with address_seed.
+	Salt []byte `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (m *MsgInit) Reset()         { *m = MsgInit{} }
@@ -117,6 +120,13 @@ func (m *MsgInit) GetAddressSeed() []byte {
 	return nil
 }
 
+func (m *MsgInit) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
 // MsgInitResponse defines the Create response type for the Msg/Create RPC method.
 type MsgInitResponse struct {
 	// account_address is the address of the newly created account.
@@ -172,6 +182,246 @@ func (m *MsgInitResponse) GetResponse() *any.Any {
 	return nil
 }
 
+// MsgInitBatch defines the InitBatch request type for the Msg/InitBatch RPC method.
+type MsgInitBatch struct {
+	// sender is the address of the sender of this message, which creates all the accounts.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account_type is the type of the accounts to be created.
+	AccountType string `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	// items are the accounts to be created.
+	Items []InitBatchItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+}
+
+func (m *MsgInitBatch) Reset()         { *m = MsgInitBatch{} }
+func (m *MsgInitBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgInitBatch) ProtoMessage()    {}
+func (*MsgInitBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{2}
+}
+func (m *MsgInitBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitBatch.Merge(m, src)
+}
+func (m *MsgInitBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitBatch proto.InternalMessageInfo
+
+func (m *MsgInitBatch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgInitBatch) GetAccountType() string {
+	if m != nil {
+		return m.AccountType
+	}
+	return ""
+}
+
+func (m *MsgInitBatch) GetItems() []InitBatchItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// InitBatchItem defines an account to be created by a MsgInitBatch.
+type InitBatchItem struct {
+	// message is the init message to be sent to the account.
+	Message *any.Any `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// funds contains the coins that the sender wants to send to the account.
+	Funds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+	// address_seed can be used to deterministically create the address of the account.
+	// If not present the address will be generated based on its associated account number.
+	AddressSeed []byte `protobuf:"bytes,3,opt,name=address_seed,json=addressSeed,proto3" json:"address_seed,omitempty"`
+}
+
+func (m *InitBatchItem) Reset()         { *m = InitBatchItem{} }
+func (m *InitBatchItem) String() string { return proto.CompactTextString(m) }
+func (*InitBatchItem) ProtoMessage()    {}
+func (*InitBatchItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{3}
+}
+func (m *InitBatchItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitBatchItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitBatchItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InitBatchItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitBatchItem.Merge(m, src)
+}
+func (m *InitBatchItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitBatchItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitBatchItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitBatchItem proto.InternalMessageInfo
+
+func (m *InitBatchItem) GetMessage() *any.Any {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *InitBatchItem) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+func (m *InitBatchItem) GetAddressSeed() []byte {
+	if m != nil {
+		return m.AddressSeed
+	}
+	return nil
+}
+
+// MsgInitBatchResponse defines the InitBatch response type for the Msg/InitBatch RPC method.
+type MsgInitBatchResponse struct {
+	// responses are the results of the items, in the same order.
+	Responses []InitBatchItemResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses"`
+}
+
+func (m *MsgInitBatchResponse) Reset()         { *m = MsgInitBatchResponse{} }
+func (m *MsgInitBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitBatchResponse) ProtoMessage()    {}
+func (*MsgInitBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{4}
+}
+func (m *MsgInitBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitBatchResponse.Merge(m, src)
+}
+func (m *MsgInitBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitBatchResponse proto.InternalMessageInfo
+
+func (m *MsgInitBatchResponse) GetResponses() []InitBatchItemResponse {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+// InitBatchItemResponse defines the result of the creation of an account of a MsgInitBatch.
+type InitBatchItemResponse struct {
+	// account_address is the address of the newly created account, if it was created.
+	AccountAddress string `protobuf:"bytes,1,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	// response is the response returned by the account implementation.
+	Response *any.Any `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	// error defines the error that occurred during the creation of the account.
+	// If the error is not empty, the account was not created and its state changes were discarded.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *InitBatchItemResponse) Reset()         { *m = InitBatchItemResponse{} }
+func (m *InitBatchItemResponse) String() string { return proto.CompactTextString(m) }
+func (*InitBatchItemResponse) ProtoMessage()    {}
+func (*InitBatchItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{5}
+}
+func (m *InitBatchItemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitBatchItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitBatchItemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InitBatchItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitBatchItemResponse.Merge(m, src)
+}
+func (m *InitBatchItemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitBatchItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitBatchItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitBatchItemResponse proto.InternalMessageInfo
+
+func (m *InitBatchItemResponse) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *InitBatchItemResponse) GetResponse() *any.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *InitBatchItemResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // MsgExecute defines the Execute request type for the Msg/Execute RPC method.
 type MsgExecute struct {
 	// sender is the address of the sender of this message.
@@ -189,7 +439,7 @@ func (m *MsgExecute) Reset()         { *m = MsgExecute{} }
 func (m *MsgExecute) String() string { return proto.CompactTextString(m) }
 func (*MsgExecute) ProtoMessage()    {}
 func (*MsgExecute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{2}
+	return fileDescriptor_29c2b6d8a13d4189, []int{6}
 }
 func (m *MsgExecute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +506,7 @@ func (m *MsgExecuteResponse) Reset()         { *m = MsgExecuteResponse{} }
 func (m *MsgExecuteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteResponse) ProtoMessage()    {}
 func (*MsgExecuteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{3}
+	return fileDescriptor_29c2b6d8a13d4189, []int{7}
 }
 func (m *MsgExecuteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +555,7 @@ func (m *MsgExecuteBundle) Reset()         { *m = MsgExecuteBundle{} }
 func (m *MsgExecuteBundle) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteBundle) ProtoMessage()    {}
 func (*MsgExecuteBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{4}
+	return fileDescriptor_29c2b6d8a13d4189, []int{8}
 }
 func (m *MsgExecuteBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +626,7 @@ func (m *BundledTxResponse) Reset()         { *m = BundledTxResponse{} }
 func (m *BundledTxResponse) String() string { return proto.CompactTextString(m) }
 func (*BundledTxResponse) ProtoMessage()    {}
 func (*BundledTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{5}
+	return fileDescriptor_29c2b6d8a13d4189, []int{9}
 }
 func (m *BundledTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,7 +707,7 @@ func (m *MsgExecuteBundleResponse) Reset()         { *m = MsgExecuteBundleRespon
 func (m *MsgExecuteBundleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteBundleResponse) ProtoMessage()    {}
 func (*MsgExecuteBundleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{6}
+	return fileDescriptor_29c2b6d8a13d4189, []int{10}
 }
 func (m *MsgExecuteBundleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,147 +743,426 @@ func (m *MsgExecuteBundleResponse) GetResponses() []*BundledTxResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgInit)(nil), "cosmos.accounts.v1.MsgInit")
-	proto.RegisterType((*MsgInitResponse)(nil), "cosmos.accounts.v1.MsgInitResponse")
-	proto.RegisterType((*MsgExecute)(nil), "cosmos.accounts.v1.MsgExecute")
-	proto.RegisterType((*MsgExecuteResponse)(nil), "cosmos.accounts.v1.MsgExecuteResponse")
-	proto.RegisterType((*MsgExecuteBundle)(nil), "cosmos.accounts.v1.MsgExecuteBundle")
-	proto.RegisterType((*BundledTxResponse)(nil), "cosmos.accounts.v1.BundledTxResponse")
-	proto.RegisterType((*MsgExecuteBundleResponse)(nil), "cosmos.accounts.v1.MsgExecuteBundleResponse")
+// MsgMigrateAccountType defines the MigrateAccountType request type for the Msg/MigrateAccountType RPC method.
+type MsgMigrateAccountType struct {
+	// sender is the address of the account to migrate.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// new_account_type is the account type to migrate the account to.
+	NewAccountType string `protobuf:"bytes,2,opt,name=new_account_type,json=newAccountType,proto3" json:"new_account_type,omitempty"`
+	// message is the init message of the new account type.
+	Message *any.Any `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmos/accounts/v1/tx.proto", fileDescriptor_29c2b6d8a13d4189) }
-
-var fileDescriptor_29c2b6d8a13d4189 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcb, 0x4e, 0xdb, 0x40,
-	0x14, 0x8d, 0xf3, 0x2c, 0x37, 0x69, 0xa1, 0x53, 0x0a, 0xc6, 0x48, 0x26, 0x4d, 0x5f, 0x11, 0xa2,
-	0x36, 0xa1, 0x55, 0x2b, 0xb1, 0x03, 0x44, 0x1f, 0x52, 0x91, 0xa8, 0x4b, 0x37, 0xdd, 0x44, 0x8e,
-	0x3d, 0x98, 0x08, 0xe2, 0x89, 0x7c, 0xc7, 0x28, 0xd9, 0x55, 0xdd, 0x74, 0x57, 0xf5, 0x3b, 0xba,
-	0xe2, 0x33, 0x58, 0xb2, 0xec, 0xa2, 0xea, 0x03, 0x16, 0xfc, 0x46, 0x95, 0xf1, 0xd8, 0x26, 0x3c,
-	0x22, 0x96, 0x5d, 0x65, 0x66, 0xce, 0xbd, 0xe7, 0x9e, 0x73, 0x26, 0xb6, 0x61, 0xd6, 0x61, 0xd8,
-	0x61, 0x68, 0xda, 0x8e, 0xc3, 0x42, 0x9f, 0xa3, 0xb9, 0xdf, 0x30, 0x79, 0xcf, 0xe8, 0x06, 0x8c,
-	0x33, 0x42, 0x22, 0xd0, 0x88, 0x41, 0x63, 0xbf, 0xa1, 0xcd, 0x78, 0x8c, 0x79, 0x7b, 0xd4, 0x14,
-	0x15, 0xad, 0x70, 0xdb, 0xb4, 0xfd, 0x7e, 0x54, 0xae, 0x4d, 0x4b, 0xae, 0x0e, 0x7a, 0x03, 0x9a,
-	0x0e, 0x7a, 0x12, 0xd0, 0x25, 0xd0, 0xb2, 0x91, 0x9a, 0xfb, 0x8d, 0x16, 0xe5, 0x76, 0xc3, 0x74,
-	0x58, 0xdb, 0x97, 0xb8, 0x26, 0x71, 0xde, 0x4b, 0xd0, 0x58, 0x83, 0x36, 0xe9, 0x31, 0x8f, 0x89,
-	0xa5, 0x39, 0x58, 0x45, 0xa7, 0xb5, 0x2f, 0x59, 0x28, 0x6d, 0xa0, 0xf7, 0xc6, 0x6f, 0x73, 0x32,
-	0x05, 0x45, 0xa4, 0xbe, 0x4b, 0x03, 0x55, 0xa9, 0x2a, 0xf5, 0x31, 0x4b, 0xee, 0xc8, 0x3d, 0xa8,
-	0x48, 0xe1, 0x4d, 0xde, 0xef, 0x52, 0x35, 0x2b, 0xd0, 0xb2, 0x3c, 0xdb, 0xea, 0x77, 0x29, 0x31,
-	0xa0, 0xd4, 0xa1, 0x88, 0xb6, 0x47, 0xd5, 0x5c, 0x55, 0xa9, 0x97, 0x97, 0x26, 0x8d, 0xc8, 0x9e,
-	0x11, 0xdb, 0x33, 0x56, 0xfc, 0xbe, 0x15, 0x17, 0x11, 0x1b, 0x0a, 0xdb, 0xa1, 0xef, 0xa2, 0x9a,
-	0xaf, 0xe6, 0xea, 0xe5, 0xa5, 0x19, 0x43, 0x06, 0x34, 0x30, 0x66, 0x48, 0xe9, 0xc6, 0x1a, 0x6b,
-	0xfb, 0xab, 0x8b, 0x87, 0xbf, 0xe6, 0x32, 0xdf, 0x7f, 0xcf, 0xd5, 0xbd, 0x36, 0xdf, 0x09, 0x5b,
-	0x86, 0xc3, 0x3a, 0xa6, 0x74, 0x19, 0xfd, 0x3c, 0x41, 0x77, 0xd7, 0x1c, 0xe8, 0x42, 0xd1, 0x80,
-	0x56, 0xc4, 0x2c, 0x54, 0xbb, 0x6e, 0x40, 0x11, 0x9b, 0x48, 0xa9, 0xab, 0x16, 0xaa, 0x4a, 0xbd,
-	0x62, 0x95, 0xe5, 0xd9, 0x7b, 0x4a, 0xdd, 0xe5, 0xf2, 0xe7, 0xd3, 0x83, 0x79, 0xe9, 0xb2, 0xb6,
-	0x07, 0xe3, 0x32, 0x08, 0x8b, 0x62, 0x97, 0xf9, 0x48, 0xc9, 0x63, 0x18, 0x8f, 0x8d, 0xcb, 0x36,
-	0x99, 0xcc, 0x2d, 0x79, 0xbc, 0x12, 0x9d, 0x92, 0x45, 0xb8, 0x11, 0xc8, 0x26, 0x91, 0xce, 0x55,
-	0xfe, 0x93, 0xaa, 0xda, 0x4f, 0x05, 0x60, 0x03, 0xbd, 0xf5, 0x1e, 0x75, 0x42, 0x4e, 0xaf, 0x8c,
-	0x7e, 0x0a, 0x8a, 0xdc, 0x0e, 0x3c, 0xca, 0x65, 0xe8, 0x72, 0xf7, 0x1f, 0xe6, 0x3d, 0x1c, 0xe6,
-	0x4b, 0x20, 0xa9, 0xbb, 0x24, 0xcf, 0xb3, 0x31, 0x29, 0xd7, 0x8a, 0xe9, 0x2d, 0x4c, 0xa4, 0x3c,
-	0xab, 0xa1, 0xef, 0xee, 0x51, 0xa2, 0x42, 0xa9, 0x25, 0x56, 0x71, 0x58, 0xf1, 0x96, 0x4c, 0x40,
-	0x8e, 0xf7, 0x50, 0xcd, 0x56, 0x73, 0xf5, 0x8a, 0x35, 0x58, 0x2e, 0x57, 0x06, 0xa2, 0x62, 0xbc,
-	0xf6, 0x37, 0x0b, 0xb7, 0x23, 0x12, 0x77, 0xab, 0x97, 0xa8, 0x7a, 0x0e, 0xd3, 0x76, 0xc8, 0x77,
-	0xa8, 0xcf, 0xdb, 0x8e, 0xcd, 0xdb, 0xcc, 0x6f, 0x7a, 0x36, 0x36, 0x43, 0xa4, 0xae, 0xe0, 0xcf,
-	0x5b, 0x77, 0x87, 0xe1, 0x57, 0x36, 0x7e, 0x40, 0xea, 0x92, 0x17, 0xa0, 0x4a, 0xe2, 0x66, 0xd7,
-	0xee, 0x77, 0xa8, 0xcf, 0xd3, 0xc6, 0x6c, 0xd4, 0x28, 0xf1, 0xcd, 0x08, 0x8e, 0x1b, 0x37, 0x61,
-	0xe6, 0x7c, 0x63, 0x6c, 0x18, 0xd5, 0x9c, 0xb8, 0xa0, 0xcb, 0x73, 0x99, 0x1e, 0xe6, 0x8b, 0x1d,
-	0x20, 0x59, 0x00, 0x42, 0x45, 0x46, 0x43, 0xea, 0xf3, 0x42, 0xc4, 0x44, 0x82, 0xc4, 0xf3, 0xd7,
-	0xe1, 0x4e, 0x5a, 0x9d, 0x4e, 0x2e, 0x8c, 0x98, 0x9c, 0xd2, 0xa7, 0x43, 0x27, 0xa1, 0x40, 0x83,
-	0x80, 0x05, 0x6a, 0x51, 0xdc, 0x42, 0xb4, 0xa9, 0x35, 0x41, 0x3d, 0x7f, 0x63, 0x49, 0xd2, 0x6b,
-	0x30, 0x96, 0x8e, 0x53, 0xc4, 0xb8, 0x87, 0xc6, 0xc5, 0x57, 0xa3, 0x71, 0xe1, 0x8e, 0xac, 0xb4,
-	0x6f, 0xe9, 0x6b, 0x16, 0x72, 0x1b, 0xe8, 0x91, 0xd7, 0x90, 0x17, 0x6f, 0xad, 0xd9, 0xcb, 0x18,
-	0xe4, 0x93, 0xac, 0xdd, 0x1f, 0x01, 0x26, 0xb2, 0xde, 0x41, 0x29, 0x7e, 0x0e, 0xf5, 0x2b, 0xea,
-	0x25, 0xae, 0x3d, 0x1a, 0x8d, 0x27, 0x94, 0x0e, 0xdc, 0x1c, 0xfe, 0xd3, 0x3e, 0x18, 0xdd, 0x18,
-	0x55, 0x69, 0x0b, 0xd7, 0xa9, 0x8a, 0x87, 0x68, 0x85, 0x4f, 0xa7, 0x07, 0xf3, 0xca, 0xea, 0xb3,
-	0xc3, 0x63, 0x5d, 0x39, 0x3a, 0xd6, 0x95, 0x3f, 0xc7, 0xba, 0xf2, 0xed, 0x44, 0xcf, 0x1c, 0x9d,
-	0xe8, 0x99, 0x1f, 0x27, 0x7a, 0xe6, 0xa3, 0xfc, 0x1c, 0xa0, 0xbb, 0x6b, 0xb4, 0x99, 0xd9, 0x3b,
-	0xfb, 0x6d, 0x6a, 0x15, 0xc5, 0xfd, 0x3e, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0x53, 0x6a, 0x24,
-	0xdc, 0xb8, 0x06, 0x00, 0x00,
+func (m *MsgMigrateAccountType) Reset()         { *m = MsgMigrateAccountType{} }
+func (m *MsgMigrateAccountType) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAccountType) ProtoMessage()    {}
+func (*MsgMigrateAccountType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{11}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Init creates a new account in the chain.
-	Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOption) (*MsgInitResponse, error)
-	// Execute executes a message to the target account.
-	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
-	// ExecuteBundle pertains account abstraction, it is used by the bundler
-	// to execute multiple UserOperations in a single transaction message.
-	ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error)
+func (m *MsgMigrateAccountType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgMigrateAccountType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAccountType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgMigrateAccountType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAccountType.Merge(m, src)
+}
+func (m *MsgMigrateAccountType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateAccountType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAccountType.DiscardUnknown(m)
 }
 
-func (c *msgClient) Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOption) (*MsgInitResponse, error) {
-	out := new(MsgInitResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/Init", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgMigrateAccountType proto.InternalMessageInfo
+
+func (m *MsgMigrateAccountType) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error) {
-	out := new(MsgExecuteResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/Execute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateAccountType) GetNewAccountType() string {
+	if m != nil {
+		return m.NewAccountType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error) {
-	out := new(MsgExecuteBundleResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/ExecuteBundle", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateAccountType) GetMessage() *any.Any {
+	if m != nil {
+		return m.Message
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Init creates a new account in the chain.
-	Init(context.Context, *MsgInit) (*MsgInitResponse, error)
-	// Execute executes a message to the target account.
-	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
-	// ExecuteBundle pertains account abstraction, it is used by the bundler
-	// to execute multiple UserOperations in a single transaction message.
-	ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error)
+// MsgMigrateAccountTypeResponse defines the MigrateAccountType response type for the Msg/MigrateAccountType
+// RPC method.
+type MsgMigrateAccountTypeResponse struct {
+	// response is the init response returned by the new account implementation.
+	Response *any.Any `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgMigrateAccountTypeResponse) Reset()         { *m = MsgMigrateAccountTypeResponse{} }
+func (m *MsgMigrateAccountTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAccountTypeResponse) ProtoMessage()    {}
+func (*MsgMigrateAccountTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{12}
+}
+func (m *MsgMigrateAccountTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateAccountTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAccountTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateAccountTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAccountTypeResponse.Merge(m, src)
+}
+func (m *MsgMigrateAccountTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateAccountTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAccountTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateAccountTypeResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateAccountTypeResponse) GetResponse() *any.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+// MsgUpdateAccountMetadata defines the UpdateAccountMetadata request type for the Msg/UpdateAccountMetadata RPC
+// method.
+type MsgUpdateAccountMetadata struct {
+	// sender is the address of the account to update the metadata of.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// metadata is the new metadata of the account, an empty metadata removes it.
+	Metadata AccountMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *MsgUpdateAccountMetadata) Reset()         { *m = MsgUpdateAccountMetadata{} }
+func (m *MsgUpdateAccountMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAccountMetadata) ProtoMessage()    {}
+func (*MsgUpdateAccountMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{13}
+}
+func (m *MsgUpdateAccountMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAccountMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAccountMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAccountMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAccountMetadata.Merge(m, src)
+}
+func (m *MsgUpdateAccountMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAccountMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAccountMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAccountMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateAccountMetadata) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateAccountMetadata) GetMetadata() AccountMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return AccountMetadata{}
+}
+
+// MsgUpdateAccountMetadataResponse defines the UpdateAccountMetadata response type for the
+// Msg/UpdateAccountMetadata RPC method.
+type MsgUpdateAccountMetadataResponse struct {
+}
+
+func (m *MsgUpdateAccountMetadataResponse) Reset()         { *m = MsgUpdateAccountMetadataResponse{} }
+func (m *MsgUpdateAccountMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAccountMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateAccountMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{14}
+}
+func (m *MsgUpdateAccountMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAccountMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAccountMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAccountMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAccountMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateAccountMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAccountMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAccountMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAccountMetadataResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgInit)(nil), "cosmos.accounts.v1.MsgInit")
+	proto.RegisterType((*MsgInitResponse)(nil), "cosmos.accounts.v1.MsgInitResponse")
+	proto.RegisterType((*MsgInitBatch)(nil), "cosmos.accounts.v1.MsgInitBatch")
+	proto.RegisterType((*InitBatchItem)(nil), "cosmos.accounts.v1.InitBatchItem")
+	proto.RegisterType((*MsgInitBatchResponse)(nil), "cosmos.accounts.v1.MsgInitBatchResponse")
+	proto.RegisterType((*InitBatchItemResponse)(nil), "cosmos.accounts.v1.InitBatchItemResponse")
+	proto.RegisterType((*MsgExecute)(nil), "cosmos.accounts.v1.MsgExecute")
+	proto.RegisterType((*MsgExecuteResponse)(nil), "cosmos.accounts.v1.MsgExecuteResponse")
+	proto.RegisterType((*MsgExecuteBundle)(nil), "cosmos.accounts.v1.MsgExecuteBundle")
+	proto.RegisterType((*BundledTxResponse)(nil), "cosmos.accounts.v1.BundledTxResponse")
+	proto.RegisterType((*MsgExecuteBundleResponse)(nil), "cosmos.accounts.v1.MsgExecuteBundleResponse")
+	proto.RegisterType((*MsgMigrateAccountType)(nil), "cosmos.accounts.v1.MsgMigrateAccountType")
+	proto.RegisterType((*MsgMigrateAccountTypeResponse)(nil), "cosmos.accounts.v1.MsgMigrateAccountTypeResponse")
+	proto.RegisterType((*MsgUpdateAccountMetadata)(nil), "cosmos.accounts.v1.MsgUpdateAccountMetadata")
+	proto.RegisterType((*MsgUpdateAccountMetadataResponse)(nil), "cosmos.accounts.v1.MsgUpdateAccountMetadataResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/accounts/v1/tx.proto", fileDescriptor_29c2b6d8a13d4189) }
+
+var fileDescriptor_29c2b6d8a13d4189 = []byte{
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xf5, 0xe7, 0x78, 0xa4, 0x24, 0xee, 0xd6, 0x4e, 0x68, 0x06, 0x55, 0x64, 0xa5, 0x3f,
+	0x4a, 0x90, 0x92, 0x91, 0x1b, 0xb4, 0x40, 0x80, 0x1e, 0xac, 0xc0, 0x6d, 0x03, 0x54, 0x40, 0xc2,
+	0x26, 0x28, 0xd0, 0x8b, 0xb0, 0x22, 0x27, 0x34, 0x11, 0x93, 0x14, 0xb8, 0x2b, 0x47, 0x42, 0x2f,
+	0x45, 0x0f, 0x45, 0x8f, 0xbd, 0xb4, 0x97, 0xbe, 0x41, 0x4f, 0x79, 0x89, 0x02, 0x39, 0xe6, 0x98,
+	0x43, 0xd1, 0x1f, 0xfb, 0x90, 0xd7, 0x28, 0xb8, 0x5c, 0x92, 0xa2, 0x45, 0x29, 0x4e, 0x83, 0x16,
+	0x3d, 0x69, 0x77, 0xe7, 0x9b, 0x6f, 0x66, 0xbe, 0x99, 0x5d, 0x11, 0x2e, 0x59, 0x01, 0xf3, 0x02,
+	0x66, 0x50, 0xcb, 0x0a, 0xc6, 0x3e, 0x67, 0xc6, 0x61, 0xd7, 0xe0, 0x13, 0x7d, 0x14, 0x06, 0x3c,
+	0x20, 0x24, 0x36, 0xea, 0x89, 0x51, 0x3f, 0xec, 0x6a, 0x5b, 0x4e, 0x10, 0x38, 0x07, 0x68, 0x08,
+	0xc4, 0x70, 0xfc, 0xd0, 0xa0, 0xfe, 0x34, 0x86, 0x6b, 0x17, 0x25, 0x97, 0xc7, 0x9c, 0x88, 0xc6,
+	0x63, 0x8e, 0x34, 0x34, 0xa5, 0x61, 0x48, 0x19, 0x1a, 0x87, 0xdd, 0x21, 0x72, 0xda, 0x35, 0xac,
+	0xc0, 0xf5, 0xa5, 0x5d, 0x93, 0x76, 0x3e, 0x49, 0xad, 0x49, 0x0e, 0xda, 0x76, 0x41, 0x82, 0x69,
+	0x3e, 0x31, 0x64, 0xc3, 0x09, 0x9c, 0x40, 0x2c, 0x8d, 0x68, 0x15, 0x9f, 0xb6, 0x7f, 0x2e, 0xc1,
+	0x6a, 0x9f, 0x39, 0x77, 0x7c, 0x97, 0x93, 0x0b, 0x50, 0x63, 0xe8, 0xdb, 0x18, 0xaa, 0x4a, 0x4b,
+	0xe9, 0xac, 0x99, 0x72, 0x47, 0xb6, 0xa1, 0x21, 0xb9, 0x06, 0x7c, 0x3a, 0x42, 0xb5, 0x24, 0xac,
+	0x75, 0x79, 0x76, 0x7f, 0x3a, 0x42, 0xa2, 0xc3, 0xaa, 0x87, 0x8c, 0x51, 0x07, 0xd5, 0x72, 0x4b,
+	0xe9, 0xd4, 0x77, 0x36, 0xf4, 0x58, 0x01, 0x3d, 0x51, 0x40, 0xdf, 0xf5, 0xa7, 0x66, 0x02, 0x22,
+	0x14, 0xaa, 0x0f, 0xc7, 0xbe, 0xcd, 0xd4, 0x4a, 0xab, 0xdc, 0xa9, 0xef, 0x6c, 0xe9, 0x52, 0xc3,
+	0xa8, 0x76, 0x5d, 0x56, 0xa7, 0xdf, 0x0e, 0x5c, 0xbf, 0x77, 0xe3, 0xe9, 0xef, 0x97, 0x57, 0x7e,
+	0xf9, 0xe3, 0x72, 0xc7, 0x71, 0xf9, 0xfe, 0x78, 0xa8, 0x5b, 0x81, 0x67, 0xc8, 0x62, 0xe3, 0x9f,
+	0xf7, 0x99, 0xfd, 0xc8, 0x88, 0xf2, 0x62, 0xc2, 0x81, 0x99, 0x31, 0xb3, 0xc8, 0xda, 0xb6, 0x43,
+	0x64, 0x6c, 0xc0, 0x10, 0x6d, 0xb5, 0xda, 0x52, 0x3a, 0x0d, 0xb3, 0x2e, 0xcf, 0xbe, 0x40, 0xb4,
+	0x09, 0x81, 0x0a, 0xa3, 0x07, 0x5c, 0xad, 0x09, 0x93, 0x58, 0xdf, 0xaa, 0x7f, 0xfb, 0xe2, 0xc9,
+	0x35, 0x59, 0x79, 0xfb, 0x00, 0xce, 0x4b, 0x71, 0x4c, 0x64, 0xa3, 0xc0, 0x67, 0x48, 0xde, 0x83,
+	0xf3, 0x89, 0x18, 0x92, 0x4a, 0xaa, 0x75, 0x4e, 0x1e, 0xef, 0xc6, 0xa7, 0xe4, 0x06, 0x9c, 0x09,
+	0xa5, 0x93, 0x5a, 0x5a, 0xa2, 0x49, 0x8a, 0x6a, 0xff, 0xa8, 0x40, 0x43, 0x86, 0xeb, 0x51, 0x6e,
+	0xed, 0xbf, 0x4e, 0x43, 0x3e, 0x86, 0xaa, 0xcb, 0xd1, 0x63, 0x6a, 0x59, 0x08, 0xbc, 0xad, 0xcf,
+	0x0f, 0xa9, 0x9e, 0x06, 0xba, 0xc3, 0xd1, 0xeb, 0x55, 0x22, 0xa1, 0xcd, 0xd8, 0x2b, 0xaf, 0xc2,
+	0xaf, 0x0a, 0x9c, 0xcd, 0x61, 0x67, 0xdb, 0xad, 0xbc, 0x52, 0xbb, 0x4b, 0xff, 0x59, 0xbb, 0xcb,
+	0x73, 0xed, 0x6e, 0x23, 0x6c, 0xcc, 0xca, 0x9b, 0xb6, 0xb4, 0x0f, 0x6b, 0x49, 0x0f, 0xa2, 0x66,
+	0x46, 0x19, 0x5e, 0x7d, 0xa9, 0x5e, 0x89, 0xb7, 0xd4, 0x2d, 0x63, 0x68, 0x7f, 0xaf, 0xc0, 0x66,
+	0x21, 0xf4, 0x5f, 0x9c, 0x1d, 0xb2, 0x01, 0x55, 0x0c, 0xc3, 0x20, 0x14, 0x75, 0xaf, 0x99, 0xf1,
+	0xa6, 0xfd, 0x9b, 0x02, 0xd0, 0x67, 0xce, 0xde, 0x04, 0xad, 0x31, 0xc7, 0x85, 0xf3, 0x74, 0x01,
+	0x6a, 0x9c, 0x86, 0x0e, 0x72, 0x39, 0x49, 0x72, 0xf7, 0x3f, 0xbc, 0xd5, 0xf9, 0xc1, 0xfc, 0x04,
+	0x48, 0x56, 0x5d, 0xaa, 0xf2, 0xac, 0x78, 0xca, 0xa9, 0x2e, 0xde, 0xe7, 0xb0, 0x9e, 0xf1, 0xf4,
+	0xc6, 0xbe, 0x7d, 0x80, 0x44, 0x85, 0xd5, 0xa1, 0x58, 0x25, 0x62, 0x25, 0x5b, 0xb2, 0x0e, 0x65,
+	0x3e, 0x89, 0x47, 0xb9, 0x61, 0x46, 0xcb, 0x5b, 0x8d, 0x28, 0xa9, 0xc4, 0xde, 0xfe, 0xab, 0x04,
+	0x6f, 0xc4, 0x24, 0xf6, 0xfd, 0x49, 0x9a, 0xd5, 0x87, 0x70, 0x91, 0x8e, 0xf9, 0x3e, 0xfa, 0xdc,
+	0xb5, 0x28, 0x77, 0x03, 0x7f, 0xe0, 0x50, 0x36, 0x18, 0x33, 0xb4, 0x05, 0x7f, 0xc5, 0xdc, 0xcc,
+	0x9b, 0x3f, 0xa5, 0xec, 0x01, 0x43, 0x9b, 0x7c, 0x04, 0xaa, 0x24, 0x1e, 0x8c, 0xe8, 0xd4, 0x43,
+	0x9f, 0x67, 0x8e, 0xa5, 0xd8, 0x51, 0xda, 0xef, 0xc6, 0xe6, 0xc4, 0xf1, 0x2e, 0x6c, 0x9d, 0x74,
+	0xcc, 0xa6, 0x3c, 0x7e, 0x15, 0x8a, 0x75, 0xb9, 0x98, 0xe7, 0x4b, 0x2a, 0x60, 0xe4, 0x3a, 0x10,
+	0x14, 0x1a, 0xe5, 0xb2, 0xaf, 0x88, 0x24, 0xd6, 0x53, 0x4b, 0x12, 0x7f, 0x0f, 0xde, 0xcc, 0xd0,
+	0x59, 0xe4, 0xea, 0x92, 0xc8, 0x19, 0x7d, 0x16, 0x34, 0x1d, 0xec, 0xda, 0xec, 0x60, 0x0f, 0x40,
+	0x3d, 0xd9, 0xb1, 0x54, 0xe9, 0xdb, 0xf3, 0xd7, 0xf9, 0x9d, 0xa2, 0xeb, 0x3c, 0xd7, 0xa3, 0xd9,
+	0x4b, 0xfc, 0x93, 0x02, 0x9b, 0x7d, 0xe6, 0xf4, 0x5d, 0x27, 0xa4, 0x1c, 0x77, 0x67, 0x5e, 0xd6,
+	0x45, 0x97, 0xa8, 0x03, 0xeb, 0x3e, 0x3e, 0x1e, 0x14, 0x3c, 0xcc, 0xe7, 0x7c, 0x7c, 0xbc, 0xfb,
+	0xcf, 0xff, 0x2c, 0xf3, 0x33, 0x7f, 0x0f, 0xde, 0x2a, 0xcc, 0xeb, 0x35, 0xc6, 0xff, 0x3b, 0x45,
+	0xa8, 0xf9, 0x60, 0x64, 0x67, 0x94, 0x7d, 0xe4, 0xd4, 0xa6, 0x9c, 0x2e, 0x2c, 0x77, 0x0f, 0xce,
+	0x78, 0x12, 0x23, 0x9f, 0xa8, 0x2b, 0x45, 0x22, 0x9f, 0xa0, 0x93, 0xaf, 0x65, 0xea, 0x9a, 0xaf,
+	0xad, 0x0d, 0xad, 0x45, 0x79, 0x24, 0xe5, 0xed, 0x3c, 0xaf, 0x40, 0xb9, 0xcf, 0x1c, 0xf2, 0x19,
+	0x54, 0xc4, 0x47, 0xcb, 0xa5, 0xa2, 0xa8, 0xf2, 0x99, 0xd7, 0xae, 0x2c, 0x31, 0xa6, 0x82, 0x7d,
+	0x09, 0x6b, 0xd9, 0x5f, 0x6e, 0x6b, 0x89, 0x87, 0x40, 0x68, 0x9d, 0x97, 0x21, 0x52, 0xe2, 0x7b,
+	0xb0, 0x9a, 0xbc, 0xbc, 0xcd, 0x05, 0x4e, 0xd2, 0xae, 0xbd, 0xbb, 0xdc, 0x9e, 0x52, 0x5a, 0x70,
+	0x36, 0xff, 0x4c, 0xbd, 0xbd, 0xdc, 0x31, 0x46, 0x69, 0xd7, 0x4f, 0x83, 0x4a, 0x83, 0x84, 0x40,
+	0x0a, 0xe6, 0xfe, 0xea, 0x02, 0x8e, 0x79, 0xa8, 0xd6, 0x3d, 0x35, 0x34, 0x8d, 0xf9, 0x35, 0x6c,
+	0x16, 0xcf, 0xdf, 0xa2, 0xd4, 0x0b, 0xd1, 0xda, 0xcd, 0x57, 0x41, 0x27, 0xc1, 0xb5, 0xea, 0x37,
+	0x2f, 0x9e, 0x5c, 0x53, 0x7a, 0x37, 0x9f, 0x1e, 0x35, 0x95, 0x67, 0x47, 0x4d, 0xe5, 0xcf, 0xa3,
+	0xa6, 0xf2, 0xc3, 0x71, 0x73, 0xe5, 0xd9, 0x71, 0x73, 0xe5, 0xf9, 0x71, 0x73, 0xe5, 0x2b, 0xf9,
+	0xe9, 0xcd, 0xec, 0x47, 0xba, 0x1b, 0x18, 0x93, 0xd9, 0xcf, 0xec, 0x61, 0x4d, 0xdc, 0xaa, 0x0f,
+	0xfe, 0x1e, 0x00, 0x82, 0x9a, 0x56, 0x56, 0x24, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Init creates a new account in the chain.
+	Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOption) (*MsgInitResponse, error)
+	// InitBatch creates many new accounts of the same type in the chain.
+	InitBatch(ctx context.Context, in *MsgInitBatch, opts ...grpc.CallOption) (*MsgInitBatchResponse, error)
+	// Execute executes a message to the target account.
+	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
+	// ExecuteBundle pertains account abstraction, it is used by the bundler
+	// to execute multiple UserOperations in a single transaction message.
+	ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error)
+	// MigrateAccountType migrates the sender account to another account type, keeping its address and
+	// balances.
+	MigrateAccountType(ctx context.Context, in *MsgMigrateAccountType, opts ...grpc.CallOption) (*MsgMigrateAccountTypeResponse, error)
+	// UpdateAccountMetadata sets the metadata of the sender account.
+	UpdateAccountMetadata(ctx context.Context, in *MsgUpdateAccountMetadata, opts ...grpc.CallOption) (*MsgUpdateAccountMetadataResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOption) (*MsgInitResponse, error) {
+	out := new(MsgInitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitBatch(ctx context.Context, in *MsgInitBatch, opts ...grpc.CallOption) (*MsgInitBatchResponse, error) {
+	out := new(MsgInitBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/InitBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error) {
+	out := new(MsgExecuteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/Execute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error) {
+	out := new(MsgExecuteBundleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/ExecuteBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateAccountType(ctx context.Context, in *MsgMigrateAccountType, opts ...grpc.CallOption) (*MsgMigrateAccountTypeResponse, error) {
+	out := new(MsgMigrateAccountTypeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/MigrateAccountType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAccountMetadata(ctx context.Context, in *MsgUpdateAccountMetadata, opts ...grpc.CallOption) (*MsgUpdateAccountMetadataResponse, error) {
+	out := new(MsgUpdateAccountMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/UpdateAccountMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Init creates a new account in the chain.
+	Init(context.Context, *MsgInit) (*MsgInitResponse, error)
+	// InitBatch creates many new accounts of the same type in the chain.
+	InitBatch(context.Context, *MsgInitBatch) (*MsgInitBatchResponse, error)
+	// Execute executes a message to the target account.
+	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
+	// ExecuteBundle pertains account abstraction, it is used by the bundler
+	// to execute multiple UserOperations in a single transaction message.
+	ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error)
+	// MigrateAccountType migrates the sender account to another account type, keeping its address and
+	// balances.
+	MigrateAccountType(context.Context, *MsgMigrateAccountType) (*MsgMigrateAccountTypeResponse, error)
+	// UpdateAccountMetadata sets the metadata of the sender account.
+	UpdateAccountMetadata(context.Context, *MsgUpdateAccountMetadata) (*MsgUpdateAccountMetadataResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
 func (*UnimplementedMsgServer) Init(ctx context.Context, req *MsgInit) (*MsgInitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
 }
+func (*UnimplementedMsgServer) InitBatch(ctx context.Context, req *MsgInitBatch) (*MsgInitBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitBatch not implemented")
+}
 func (*UnimplementedMsgServer) Execute(ctx context.Context, req *MsgExecute) (*MsgExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
 func (*UnimplementedMsgServer) ExecuteBundle(ctx context.Context, req *MsgExecuteBundle) (*MsgExecuteBundleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBundle not implemented")
 }
+func (*UnimplementedMsgServer) MigrateAccountType(ctx context.Context, req *MsgMigrateAccountType) (*MsgMigrateAccountTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAccountType not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAccountMetadata(ctx context.Context, req *MsgUpdateAccountMetadata) (*MsgUpdateAccountMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountMetadata not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -657,6 +1186,24 @@ func _Msg_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InitBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accounts.v1.Msg/InitBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitBatch(ctx, req.(*MsgInitBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecute)
 	if err := dec(in); err != nil {
@@ -693,6 +1240,42 @@ func _Msg_ExecuteBundle_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateAccountType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAccountType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAccountType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accounts.v1.Msg/MigrateAccountType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAccountType(ctx, req.(*MsgMigrateAccountType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAccountMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccountMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccountMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accounts.v1.Msg/UpdateAccountMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccountMetadata(ctx, req.(*MsgUpdateAccountMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.accounts.v1.Msg",
@@ -702,6 +1285,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Init",
 			Handler:    _Msg_Init_Handler,
 		},
+		{
+			MethodName: "InitBatch",
+			Handler:    _Msg_InitBatch_Handler,
+		},
 		{
 			MethodName: "Execute",
 			Handler:    _Msg_Execute_Handler,
@@ -710,6 +1297,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteBundle",
 			Handler:    _Msg_ExecuteBundle_Handler,
 		},
+		{
+			MethodName: "MigrateAccountType",
+			Handler:    _Msg_MigrateAccountType_Handler,
+		},
+		{
+			MethodName: "UpdateAccountMetadata",
+			Handler:    _Msg_UpdateAccountMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/accounts/v1/tx.proto",
@@ -735,6 +1330,13 @@ func (m *MsgInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.AddressSeed) > 0 {
 		i -= len(m.AddressSeed)
 		copy(dAtA[i:], m.AddressSeed)
@@ -827,7 +1429,7 @@ func (m *MsgInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecute) Marshal() (dAtA []byte, err error) {
+func (m *MsgInitBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -837,20 +1439,20 @@ func (m *MsgExecute) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecute) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInitBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInitBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Funds) > 0 {
-		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -858,23 +1460,216 @@ func (m *MsgExecute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountType)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InitBatchItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitBatchItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitBatchItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressSeed) > 0 {
+		i -= len(m.AddressSeed)
+		copy(dAtA[i:], m.AddressSeed)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AddressSeed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InitBatchItemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitBatchItemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitBatchItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
 		copy(dAtA[i:], m.Target)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
 		i--
@@ -1074,49 +1869,200 @@ func (m *MsgExecuteBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgMigrateAccountType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgMigrateAccountType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAccountType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AccountType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Funds) > 0 {
-		for _, e := range m.Funds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.AddressSeed)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NewAccountType) > 0 {
+		i -= len(m.NewAccountType)
+		copy(dAtA[i:], m.NewAccountType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAccountType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInitResponse) Size() (n int) {
+func (m *MsgMigrateAccountTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAccountTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAccountTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAccountMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccountMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccountMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAccountMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccountMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccountMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.AddressSeed)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1133,6 +2079,88 @@ func (m *MsgInitResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgInitBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InitBatchItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.AddressSeed)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InitBatchItemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgExecute) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1219,35 +2247,999 @@ func (m *BundledTxResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMigrateAccountType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAccountType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateAccountTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAccountMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAccountMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgInit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &any.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressSeed = append(m.AddressSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressSeed == nil {
+				m.AddressSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &any.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, InitBatchItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitBatchItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitBatchItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitBatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &any.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressSeed = append(m.AddressSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressSeed == nil {
+				m.AddressSeed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, InitBatchItemResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitBatchItemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitBatchItemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitBatchItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &any.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgExecuteBundleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgInit) Unmarshal(dAtA []byte) error {
+func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1270,10 +3262,10 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1310,7 +3302,7 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1338,7 +3330,7 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountType = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1410,11 +3402,61 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1424,24 +3466,26 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressSeed = append(m.AddressSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.AddressSeed == nil {
-				m.AddressSeed = []byte{}
+			if m.Response == nil {
+				m.Response = &any.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1465,7 +3509,7 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,15 +3532,15 @@ func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteBundle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteBundle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundler", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1524,13 +3568,13 @@ func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.Bundler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1540,27 +3584,23 @@ func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &any.Any{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1583,7 +3623,7 @@ func (m *MsgInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecute) Unmarshal(dAtA []byte) error {
+func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1606,17 +3646,17 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecute: wiretype end group for non-group")
+			return fmt.Errorf("proto: BundledTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BundledTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationGasUsed", wireType)
 			}
-			var stringLen uint64
+			m.AuthenticationGasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1626,29 +3666,35 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuthenticationGasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlerPaymentGasUsed", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BundlerPaymentGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundlerPaymentGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlerPaymentResponses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1658,27 +3704,48 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.BundlerPaymentResponses = append(m.BundlerPaymentResponses, &any.Any{})
+			if err := m.BundlerPaymentResponses[len(m.BundlerPaymentResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasUsed", wireType)
+			}
+			m.ExecutionGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionResponses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1705,18 +3772,16 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &any.Any{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExecutionResponses = append(m.ExecutionResponses, &any.Any{})
+			if err := m.ExecutionResponses[len(m.ExecutionResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1726,25 +3791,23 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funds = append(m.Funds, types.Coin{})
-			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1767,7 +3830,7 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteBundleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,15 +3853,15 @@ func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteBundleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1825,10 +3888,8 @@ func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &any.Any{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Responses = append(m.Responses, &BundledTxResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1853,7 +3914,7 @@ func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAccountType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1876,15 +3937,15 @@ func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteBundle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAccountType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAccountType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bundler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1912,13 +3973,13 @@ func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bundler = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAccountType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1928,23 +3989,59 @@ func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
-			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			m.NewAccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &any.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1967,7 +4064,7 @@ func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAccountTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1990,53 +4087,15 @@ func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BundledTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAccountTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BundledTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAccountTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationGasUsed", wireType)
-			}
-			m.AuthenticationGasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuthenticationGasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundlerPaymentGasUsed", wireType)
-			}
-			m.BundlerPaymentGasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BundlerPaymentGasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundlerPaymentResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2063,35 +4122,68 @@ func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundlerPaymentResponses = append(m.BundlerPaymentResponses, &any.Any{})
-			if err := m.BundlerPaymentResponses[len(m.BundlerPaymentResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Response == nil {
+				m.Response = &any.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasUsed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ExecutionGasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecutionGasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAccountMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAccountMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAccountMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2101,31 +4193,29 @@ func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutionResponses = append(m.ExecutionResponses, &any.Any{})
-			if err := m.ExecutionResponses[len(m.ExecutionResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2135,23 +4225,24 @@ func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2174,7 +4265,7 @@ func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteBundleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAccountMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2197,46 +4288,12 @@ func (m *MsgExecuteBundleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteBundleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAccountMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAccountMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Responses = append(m.Responses, &BundledTxResponse{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -24,7 +24,7 @@ type AccountKeeper interface {
 
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
-	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, gasLimit uint64, msgs []sdk.Msg) error
 }
 
 type ConsensusKeeper interface {
@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -25,6 +27,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// feeGrantTxGas is the gas limit of the transactions paid with fee grants.
+const feeGrantTxGas uint64 = 10000000
+
 func TestDeductFeesNoDelegation(t *testing.T) {
 	cases := map[string]struct {
 		fee      int64
@@ -96,7 +101,7 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 			malleate: func(suite *AnteTestSuite) (TestAccount, sdk.AccAddress) {
 				accs := suite.CreateTestAccounts(2)
 
-				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), feeGrantTxGas, gomock.Any()).Return(nil).Times(2)
 				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[1].acc.GetAddress(), authtypes.FeeCollectorName, gomock.Any()).Return(nil).Times(2)
 				return accs[0], accs[1].acc.GetAddress()
 			},
@@ -108,7 +113,7 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 			malleate: func(suite *AnteTestSuite) (TestAccount, sdk.AccAddress) {
 				accs := suite.CreateTestAccounts(2)
 				suite.feeGrantKeeper.EXPECT().
-					UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).
+					UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), feeGrantTxGas, gomock.Any()).
 					Return(sdkerrors.ErrNotFound.Wrap("fee-grant not found")).
 					Times(2)
 				return accs[0], accs[1].acc.GetAddress()
@@ -121,7 +126,7 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 			malleate: func(suite *AnteTestSuite) (TestAccount, sdk.AccAddress) {
 				accs := suite.CreateTestAccounts(2)
 				suite.feeGrantKeeper.EXPECT().
-					UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).
+					UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), feeGrantTxGas, gomock.Any()).
 					Return(errors.New("fee limit exceeded")).
 					Times(2)
 				return accs[0], accs[1].acc.GetAddress()
@@ -133,7 +138,7 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 			err:   sdkerrors.ErrInsufficientFunds,
 			malleate: func(suite *AnteTestSuite) (TestAccount, sdk.AccAddress) {
 				accs := suite.CreateTestAccounts(2)
-				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), feeGrantTxGas, gomock.Any()).Return(nil).Times(2)
 				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[1].acc.GetAddress(), authtypes.FeeCollectorName, gomock.Any()).Return(sdkerrors.ErrInsufficientFunds).Times(2)
 				return accs[0], accs[1].acc.GetAddress()
 			},
@@ -165,8 +170,7 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 				accNums, seqs = []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}
 			}
 
-			var defaultGenTxGas uint64 = 10000000
-			tx, err := genTxWithFeeGranter(protoTxCfg, msgs, fee, defaultGenTxGas, suite.ctx.ChainID(), accNums, seqs, feeAcc, privs...)
+			tx, err := genTxWithFeeGranter(protoTxCfg, msgs, fee, feeGrantTxGas, suite.ctx.ChainID(), accNums, seqs, feeAcc, privs...)
 			require.NoError(t, err)
 			txBytes, err := protoTxCfg.TxEncoder()(tx)
 			require.NoError(t, err)
@@ -189,6 +193,30 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 	}
 }
 
+func TestDeductFeesGasLimitInSimulateMode(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	dfd := ante.NewDeductFeeDecorator(suite.accountKeeper, suite.bankKeeper, suite.feeGrantKeeper, nil)
+	feeAnteHandler := sdk.ChainAnteDecorators(dfd)
+
+	accs := suite.CreateTestAccounts(2)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+	msgs := []sdk.Msg{testdata.NewTestMsg(accs[0].acc.GetAddress())}
+
+	// the gas price of the granted fee is computed with the gas limit of the transaction, not with
+	// the limit of the gas meter which is infinite when simulating
+	suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), fee, feeGrantTxGas, gomock.Any()).Return(nil)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[1].acc.GetAddress(), authtypes.FeeCollectorName, fee).Return(nil)
+
+	acc := suite.accountKeeper.GetAccount(suite.ctx, accs[0].acc.GetAddress())
+	tx, err := genTxWithFeeGranter(suite.clientCtx.TxConfig, msgs, fee, feeGrantTxGas, suite.ctx.ChainID(),
+		[]uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, accs[1].acc.GetAddress(), accs[0].priv)
+	require.NoError(t, err)
+
+	ctx := suite.ctx.WithExecMode(sdk.ExecModeSimulate).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = feeAnteHandler(ctx, tx, true)
+	require.NoError(t, err)
+}
+
 func genTxWithFeeGranter(gen client.TxConfig, msgs []sdk.Msg, feeAmt sdk.Coins, gas uint64, chainID string, accNums,
 	accSeqs []uint64, feeGranter sdk.AccAddress, priv ...cryptotypes.PrivKey,
 ) (sdk.Tx, error) {
@@ -17,6 +17,7 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -533,6 +534,9 @@ func DefaultSigVerificationGasConsumer(meter gas.Meter, sig signing.SignatureV2,
 	case *secp256r1.PubKey:
 		return meter.Consume(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 
+	case *bls12_381.PubKey:
+		return meter.Consume(params.SigVerifyCostBls12_381(), "ante verify: bls12_381")
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
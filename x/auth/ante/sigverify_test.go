@@ -15,6 +15,7 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -90,6 +91,13 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 			}},
 			false,
 		},
+		{
+			"PubKeyBls12_381",
+			args{nil, &bls12_381.PubKey{Key: make([]byte, 96)}, params, func(mm *gastestutil.MockMeter) {
+				mm.EXPECT().Consume(p.SigVerifyCostBls12_381(), "ante verify: bls12_381").Times(1)
+			}},
+			false,
+		},
 		{
 			"Multisig",
 			args{multisignature1, multisigKey1, params, func(mm *gastestutil.MockMeter) {
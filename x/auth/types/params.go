@@ -47,6 +47,13 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBls12_381 returns gas fee of bls12_381 signature verification.
+// A bls12_381 signature verification computes two pairings, which makes it several times slower than a
+// secp256k1 signature verification, so it is priced accordingly.
+func (p Params) SigVerifyCostBls12_381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 6
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -0,0 +1,55 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/x/bank/v2/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ authtypes.BankKeeper = BankKeeper{}
+
+// BankKeeper adapts the bank/v2 keeper to the bank keeper expected by the x/auth ante handlers
+// and by x/feegrant, so that apps can deduct fees without the legacy x/bank module.
+type BankKeeper struct {
+	keeper *keeper.Keeper
+}
+
+// NewBankKeeper returns the bank keeper of the ante handlers backed by the bank/v2 keeper.
+// It registers the fee collector module account on the keeper if it is not registered yet.
+func NewBankKeeper(k *keeper.Keeper) (BankKeeper, error) {
+	if _, ok := k.GetModuleAddress(authtypes.FeeCollectorName); !ok {
+		if _, err := k.RegisterModuleAccount(authtypes.FeeCollectorName); err != nil {
+			return BankKeeper{}, err
+		}
+	}
+
+	return BankKeeper{keeper: k}, nil
+}
+
+// IsSendEnabledCoins implements authtypes.BankKeeper.
+func (bk BankKeeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error {
+	return bk.keeper.IsSendEnabledCoins(ctx, coins...)
+}
+
+// SendCoins implements authtypes.BankKeeper.
+func (bk BankKeeper) SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	return bk.keeper.SendCoins(ctx, from, to, amt)
+}
+
+// SendCoinsFromAccountToModule implements authtypes.BankKeeper.
+func (bk BankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return bk.keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// SpendableCoins returns the spendable balances of an account, or no coins if they cannot be
+// retrieved, as expected by x/feegrant.
+func (bk BankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	coins, err := bk.keeper.SpendableCoins(ctx, addr)
+	if err != nil {
+		return sdk.NewCoins()
+	}
+	return coins
+}
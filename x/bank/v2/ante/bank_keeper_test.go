@@ -0,0 +1,53 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	storetypes "cosmossdk.io/store/types"
+	bankv2ante "cosmossdk.io/x/bank/v2/ante"
+	"cosmossdk.io/x/bank/v2/keeper"
+	banktestutil "cosmossdk.io/x/bank/v2/testutil"
+	banktypes "cosmossdk.io/x/bank/v2/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestDeductFees(t *testing.T) {
+	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+	k := keeper.NewKeeper(authtypes.NewModuleAddress("gov"), codectestutil.CodecOptions{}.GetAddressCodec(), env, encCfg.Codec)
+
+	bk, err := bankv2ante.NewBankKeeper(k)
+	require.NoError(t, err)
+
+	// the fee collector module account is registered
+	feeCollector, ok := k.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, ok)
+	_, err = bankv2ante.NewBankKeeper(k)
+	require.NoError(t, err)
+
+	payer := sdk.AccAddress("payer_______________")
+	require.NoError(t, banktestutil.FundAccount(ctx, *k, payer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), bk.SpendableCoins(ctx, payer))
+
+	// the fees are deducted to the fee collector
+	require.NoError(t, authante.DeductFees(bk, ctx, payer, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.Equal(t, sdk.NewInt64Coin("stake", 70), k.GetBalance(ctx, payer, "stake"))
+	require.Equal(t, sdk.NewInt64Coin("stake", 30), k.GetBalance(ctx, feeCollector, "stake"))
+
+	require.Error(t, authante.DeductFees(bk, ctx, payer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+}
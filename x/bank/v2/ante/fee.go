@@ -0,0 +1,23 @@
+package ante
+
+import (
+	"cosmossdk.io/x/bank/v2/keeper"
+
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// NewDeductFeeDecorator returns the x/auth fee deduction decorator deducting the fees with the
+// bank/v2 keeper, with fee grants if a feegrant keeper is given.
+func NewDeductFeeDecorator(
+	ak authante.AccountKeeper,
+	k *keeper.Keeper,
+	fk authante.FeegrantKeeper,
+	tfc authante.TxFeeChecker,
+) (*authante.DeductFeeDecorator, error) {
+	bk, err := NewBankKeeper(k)
+	if err != nil {
+		return nil, err
+	}
+
+	return authante.NewDeductFeeDecorator(ak, bk, fk, tfc), nil
+}
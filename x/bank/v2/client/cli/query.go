@@ -2,10 +2,12 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/v2/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -14,7 +16,9 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom      = "denom"
+	FlagMinBalance = "min-balance"
+	FlagFormat     = "format"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -31,6 +35,17 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalanceCmd(),
+		GetDenomMetadataCmd(),
+		GetDenomsMetadataCmd(),
+		GetSpendableBalancesCmd(),
+		GetDenomOwnersCmd(),
+		GetTotalSupplyCmd(),
+		GetSuppliesOfCmd(),
+		GetBlockedAddressesCmd(),
+		GetDenomAdminCmd(),
+		GetDenomsFromCreatorCmd(),
+		GetBalancesSnapshotCmd(),
+		GetHoldsCmd(),
 	)
 
 	return cmd
@@ -77,3 +92,421 @@ func GetBalanceCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata [denom]",
+		Short: "Query the client metadata of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := types.NewQueryDenomMetadataRequest(args[0])
+			out := new(types.QueryDenomMetadataResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryDenomMetadataRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetDenomsMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms-metadata",
+		Short: "Query the client metadata of all the registered denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomsMetadataRequest{Pagination: pageReq}
+			out := new(types.QueryDenomsMetadataResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryDenomsMetadataRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denoms metadata")
+
+	return cmd
+}
+
+func GetSpendableBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable-balances [address]",
+		Short: "Query the spendable balances of an account, their locked amount excluded",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.NewQuerySpendableBalancesRequest(addr.String(), pageReq)
+			out := new(types.QuerySpendableBalancesResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QuerySpendableBalancesRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "spendable balances")
+
+	return cmd
+}
+
+func GetDenomOwnersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Short: "Query the holders of a denom with their balance",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minBalanceStr, err := cmd.Flags().GetString(FlagMinBalance)
+			if err != nil {
+				return err
+			}
+
+			minBalance := math.ZeroInt()
+			if minBalanceStr != "" {
+				var ok bool
+				minBalance, ok = math.NewIntFromString(minBalanceStr)
+				if !ok || minBalance.IsNegative() {
+					return fmt.Errorf("invalid min balance: %s", minBalanceStr)
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.NewQueryDenomOwnersRequest(args[0], minBalance, pageReq)
+			out := new(types.QueryDenomOwnersResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryDenomOwnersRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	cmd.Flags().String(FlagMinBalance, "", "The minimum balance of the holders to query for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom owners")
+
+	return cmd
+}
+
+func GetTotalSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-supply",
+		Short: "Query the total supply of coins of the chain",
+		Long: `Query the total supply of coins of the chain.
+To query for the total supply of a specific coin denomination use the '--denom' flag.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			if denom != "" {
+				req := &types.QuerySupplyOfRequest{Denom: denom}
+				out := new(types.QuerySupplyOfResponse)
+
+				err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QuerySupplyOfRequest{}), req, out)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(out)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTotalSupplyRequest{Pagination: pageReq}
+			out := new(types.QueryTotalSupplyResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryTotalSupplyRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all supply totals")
+
+	return cmd
+}
+
+func GetSuppliesOfCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supplies-of [denom] [denom]...",
+		Short: "Query the supply of several coin denominations",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySuppliesOfRequest{Denoms: args, Pagination: pageReq}
+			out := new(types.QuerySuppliesOfResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QuerySuppliesOfRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "supplies of")
+
+	return cmd
+}
+
+func GetBlockedAddressesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-addresses",
+		Short: "Query the addresses which are not allowed to receive funds",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBlockedAddressesRequest{}
+			out := new(types.QueryBlockedAddressesResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryBlockedAddressesRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetDenomAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-admin [denom]",
+		Short: "Query the admin of a factory denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomAdminRequest{Denom: args[0]}
+			out := new(types.QueryDenomAdminResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryDenomAdminRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetDenomsFromCreatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms-from-creator [creator]",
+		Short: "Query the factory denoms created by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomsFromCreatorRequest{Creator: args[0]}
+			out := new(types.QueryDenomsFromCreatorResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryDenomsFromCreatorRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetHoldsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holds [address]",
+		Short: "Query the holds placed on the coins of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHoldsRequest{Address: args[0]}
+			out := new(types.QueryHoldsResponse)
+
+			err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryHoldsRequest{}), req, out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetBalancesSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances-snapshot",
+		Short: "Export the balances of all the accounts at a height",
+		Long: `Export the balances of all the accounts at a height, e.g. for audits and airdrop snapshots.
+The balances are streamed to the output as CSV or JSON, one entry per account and denom, ordered by address then denom.
+The '--height' flag is required so that all the pages are read at the same height, which must not be pruned by the node.
+
+Example:
+$ <appd> query bankv2 balances-snapshot --height 1000 --format csv > snapshot.csv
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Height <= 0 {
+				return errors.New("a positive height must be set with the --height flag")
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sw, err := types.NewBalanceSnapshotWriter(cmd.OutOrStdout(), format)
+			if err != nil {
+				return err
+			}
+
+			for {
+				req := &types.QueryAllBalancesRequest{Pagination: pageReq}
+				out := new(types.QueryAllBalancesResponse)
+
+				err = clientCtx.Invoke(cmd.Context(), gogoproto.MessageName(&types.QueryAllBalancesRequest{}), req, out)
+				if err != nil {
+					return err
+				}
+
+				for _, balance := range out.Balances {
+					if err := sw.Write(balance.Address, balance.Balance); err != nil {
+						return err
+					}
+				}
+
+				if out.Pagination == nil || len(out.Pagination.NextKey) == 0 {
+					return sw.Close()
+				}
+				pageReq.Key, pageReq.Offset = out.Pagination.NextKey, 0
+			}
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, types.SnapshotFormatCSV, "The output format of the snapshot (csv|json)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "balances per query")
+
+	return cmd
+}
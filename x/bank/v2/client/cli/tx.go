@@ -1,8 +1,13 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/bank/v2/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -11,6 +16,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// FlagSplit is the flag to split the amount between the recipients of a multi send.
+	FlagSplit = "split"
+	// FlagTransferMemo is the flag to set the memo of a transfer, emitted in its events.
+	FlagTransferMemo = "transfer-memo"
+)
+
 // TODO: Use AutoCLI commands
 // https://github.com/cosmos/cosmos-sdk/issues/21682
 func NewTxCmd() *cobra.Command {
@@ -24,6 +36,12 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewSendTxCmd(),
+		NewMultiSendTxCmd(),
+		NewAtomicSwapTxCmd(),
+		NewCreateDenomTxCmd(),
+		NewMintDenomTxCmd(),
+		NewBurnDenomTxCmd(),
+		NewChangeDenomAdminTxCmd(),
 	)
 
 	return txCmd
@@ -53,7 +71,228 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 				return err
 			}
 
-			msg := types.NewMsgSend(clientCtx.GetFromAddress().String(), args[1], coins)
+			memo, err := cmd.Flags().GetString(FlagTransferMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendWithMemo(clientCtx.GetFromAddress().String(), args[1], coins, memo)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTransferMemo, "", "The memo of the transfer, e.g. an invoice number, emitted in its events")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMultiSendTxCmd returns a CLI command handler for creating a MsgMultiSend transaction.
+func NewMultiSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send [from_key_or_address] [to_address_1] [to_address_2]... [amount]",
+		Short: "Send funds from one account to two or more accounts.",
+		Long: `Send funds from one account to two or more accounts.
+By default, sends the [amount] to each address of the list.
+Using the '--split' flag, the [amount] is split equally between the addresses.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+When using '--dry-run' a key name cannot be used, only a bech32 address.
+`,
+		Args: cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[len(args)-1])
+			if err != nil {
+				return err
+			}
+
+			if coins.IsZero() {
+				return errors.New("must send positive amount")
+			}
+
+			split, err := cmd.Flags().GetBool(FlagSplit)
+			if err != nil {
+				return err
+			}
+
+			// coins to be received by each address
+			sendCoins := coins
+			if split {
+				sendCoins = coins.QuoInt(sdkmath.NewInt(int64(len(args) - 2)))
+			}
+
+			outputs := make([]types.Output, 0, len(args)-2)
+			for _, arg := range args[1 : len(args)-1] {
+				if _, err := clientCtx.AddressCodec.StringToBytes(arg); err != nil {
+					return err
+				}
+
+				outputs = append(outputs, types.NewOutput(arg, sendCoins))
+			}
+
+			msg := types.NewMsgMultiSend(clientCtx.GetFromAddress().String(), outputs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagSplit, false, "Send the equally split token amount to each address")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAtomicSwapTxCmd returns a CLI command handler for creating a MsgAtomicSwap transaction.
+func NewAtomicSwapTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "atomic-swap [from_address:to_address:amount] [from_address:to_address:amount]...",
+		Short: "Execute the transfers of several parties atomically.",
+		Long: `Execute the transfers of several parties atomically, either all or none of them are executed.
+The transaction must be signed by the senders of all the transfers, so it is usually generated with
+'--generate-only' and then signed by every party.
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			transfers := make([]types.SwapTransfer, 0, len(args))
+			for _, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid transfer %s, expected from_address:to_address:amount", arg)
+				}
+
+				for _, addr := range parts[:2] {
+					if _, err := clientCtx.AddressCodec.StringToBytes(addr); err != nil {
+						return err
+					}
+				}
+
+				coins, err := sdk.ParseCoinsNormalized(parts[2])
+				if err != nil {
+					return err
+				}
+
+				transfers = append(transfers, types.NewSwapTransfer(parts[0], parts[1], coins))
+			}
+
+			msg := types.NewMsgAtomicSwap(transfers)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreateDenomTxCmd returns a CLI command handler for creating a MsgCreateDenom transaction.
+func NewCreateDenomTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Short: "Create the factory/{creator}/{subdenom} denom, with the creator as admin.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMintDenomTxCmd returns a CLI command handler for creating a MsgMintDenom transaction.
+func NewMintDenomTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-denom [to_address] [amount]",
+		Short: "Mint factory denoms administrated by the sender to an account.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintDenom(clientCtx.GetFromAddress().String(), args[0], coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnDenomTxCmd returns a CLI command handler for creating a MsgBurnDenom transaction.
+func NewBurnDenomTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-denom [amount]",
+		Short: "Burn factory denoms administrated by the sender from its balance.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnDenom(clientCtx.GetFromAddress().String(), coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChangeDenomAdminTxCmd returns a CLI command handler for creating a MsgChangeDenomAdmin transaction.
+func NewChangeDenomAdminTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-denom-admin [denom] [new_admin]",
+		Short: "Change the admin of a factory denom.",
+		Long: `Change the admin of a factory denom.
+Use an empty [new_admin] ("") to renounce the admin of the denom, which can then no longer be minted or burned by an admin.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeDenomAdmin(clientCtx.GetFromAddress().String(), args[0], args[1])
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
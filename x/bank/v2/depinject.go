@@ -10,12 +10,14 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	bankv2ante "cosmossdk.io/x/bank/v2/ante"
 	"cosmossdk.io/x/bank/v2/keeper"
 	"cosmossdk.io/x/bank/v2/types"
 	moduletypes "cosmossdk.io/x/bank/v2/types/module"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -28,6 +30,8 @@ func init() {
 		&moduletypes.Module{},
 		appconfig.Provide(ProvideModule),
 		appconfig.Invoke(InvokeSetSendRestrictions),
+		appconfig.Invoke(InvokeSetHooks),
+		appconfig.Invoke(InvokeSetLockedCoinsProvider),
 	)
 }
 
@@ -51,15 +55,20 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	// default to governance authority if not provided
 	authority := sdkaddress.Module(types.GovModuleName)
 	if in.Config.Authority != "" {
-		bz, err := in.AddressCodec.StringToBytes(in.Config.Authority)
-		if err != nil { // module name
-			authority = sdkaddress.Module(in.Config.Authority)
-		} else { // actual address
-			authority = bz
-		}
+		authority = configAddress(in.AddressCodec, in.Config.Authority)
 	}
 
 	k := keeper.NewKeeper(authority, in.AddressCodec, in.Environment, in.Cdc)
+	for _, burner := range in.Config.Burners {
+		if err := k.RegisterBurner(configAddress(in.AddressCodec, burner)); err != nil {
+			panic(err)
+		}
+	}
+	for _, blocked := range in.Config.BlockedAddresses {
+		if err := k.BlockAddress(configAddress(in.AddressCodec, blocked)); err != nil {
+			panic(err)
+		}
+	}
 	m := NewAppModule(in.Cdc, k)
 
 	return ModuleOutputs{
@@ -68,12 +77,28 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 }
 
+// ProvideAuthBankKeeper provides the bank keeper of the x/auth ante handlers backed by the bank/v2
+// keeper, for apps running without the legacy x/bank module. It is not provided by default, as it
+// would conflict with x/bank, and is added to the app with depinject.Provide.
+func ProvideAuthBankKeeper(k *keeper.Keeper) (authtypes.BankKeeper, error) {
+	return bankv2ante.NewBankKeeper(k)
+}
+
+// configAddress returns the address set in the module config, either a module name or an actual address.
+func configAddress(addressCodec address.Codec, addr string) []byte {
+	bz, err := addressCodec.StringToBytes(addr)
+	if err != nil { // module name
+		return sdkaddress.Module(addr)
+	}
+	return bz // actual address
+}
+
 func InvokeSetSendRestrictions(
 	config *moduletypes.Module,
-	keeper keeper.Keeper,
+	keeper *keeper.Keeper,
 	restrictions map[string]types.SendRestrictionFn,
 ) error {
-	if config == nil {
+	if config == nil || keeper == nil {
 		return nil
 	}
 
@@ -103,3 +128,34 @@ func InvokeSetSendRestrictions(
 
 	return nil
 }
+
+// InvokeSetHooks sets the bank hooks registered by other modules.
+func InvokeSetHooks(keeper *keeper.Keeper, bankHooks map[string]types.BankHooksWrapper) error {
+	if keeper == nil || bankHooks == nil {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	modNames := slices.Sorted(maps.Keys(bankHooks))
+	var multiHooks types.MultiBankHooks
+	for _, modName := range modNames {
+		hook, ok := bankHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find bank hooks for module %s", modName)
+		}
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
+
+// InvokeSetLockedCoinsProvider sets the provider of the locked coins, e.g. of the lockup accounts, if any.
+func InvokeSetLockedCoinsProvider(keeper *keeper.Keeper, provider types.LockedCoinsProvider) {
+	if keeper == nil || provider == nil {
+		return
+	}
+
+	keeper.SetLockedCoinsProvider(provider)
+}
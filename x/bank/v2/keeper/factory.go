@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateDenom creates the factory/{creator}/{subdenom} denom, with the creator as admin.
+// It returns the full name of the created denom.
+func (k Keeper) CreateDenom(ctx context.Context, creator []byte, subdenom string) (string, error) {
+	creatorStr, err := k.addressCodec.BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	exists, err := k.denomAdmins.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if exists || !k.GetSupply(ctx, denom).IsZero() {
+		return "", errorsmod.Wrap(types.ErrDenomExists, denom)
+	}
+
+	if err := k.denomAdmins.Set(ctx, denom, creator); err != nil {
+		return "", err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventCreateDenom{Creator: creatorStr, Denom: denom}); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// GetDenomAdmin returns the admin of a factory denom, which is empty if the admin was renounced.
+// It returns false if the denom is not a factory denom.
+func (k Keeper) GetDenomAdmin(ctx context.Context, denom string) ([]byte, bool) {
+	admin, err := k.denomAdmins.Get(ctx, denom)
+	if err != nil {
+		return nil, false
+	}
+	return admin, true
+}
+
+// SetDenomAdmin changes the admin of a factory denom, an empty admin renounces it.
+func (k Keeper) SetDenomAdmin(ctx context.Context, denom string, newAdmin []byte) error {
+	if _, found := k.GetDenomAdmin(ctx, denom); !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "factory denom %s does not exist", denom)
+	}
+
+	var newAdminStr string
+	if len(newAdmin) > 0 {
+		var err error
+		newAdminStr, err = k.addressCodec.BytesToString(newAdmin)
+		if err != nil {
+			return err
+		}
+	} else {
+		// a renounced admin is stored as an empty value, as the store doesn't accept nil values
+		newAdmin = []byte{}
+	}
+
+	if err := k.denomAdmins.Set(ctx, denom, newAdmin); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&types.EventChangeDenomAdmin{Denom: denom, NewAdmin: newAdminStr})
+}
+
+// GetDenomsFromCreator returns the factory denoms created by the given creator, sorted by denom.
+func (k Keeper) GetDenomsFromCreator(ctx context.Context, creator []byte) ([]string, error) {
+	creatorStr, err := k.addressCodec.BytesToString(creator)
+	if err != nil {
+		return nil, err
+	}
+
+	var denoms []string
+	rng := new(collections.Range[string]).Prefix(types.FactoryDenomsPrefix(creatorStr))
+	err = k.denomAdmins.Walk(ctx, rng, func(denom string, _ []byte) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return denoms, nil
+}
+
+// MintDenom mints factory denoms to the given account, the minter must be the admin of all the
+// minted denoms.
+func (k Keeper) MintDenom(ctx context.Context, admin, to []byte, amounts sdk.Coins) error {
+	if err := k.checkDenomAdmin(ctx, admin, amounts); err != nil {
+		return err
+	}
+
+	return k.MintCoins(ctx, to, amounts)
+}
+
+// BurnDenom burns factory denoms from the balance of their admin, which must be the admin of all
+// the burned denoms. It doesn't require a burn permission.
+func (k Keeper) BurnDenom(ctx context.Context, admin []byte, amounts sdk.Coins) error {
+	if err := k.checkDenomAdmin(ctx, admin, amounts); err != nil {
+		return err
+	}
+
+	return k.burnCoins(ctx, admin, amounts)
+}
+
+// checkDenomAdmin returns an error if the given account is not the admin of all the denoms of the coins.
+func (k Keeper) checkDenomAdmin(ctx context.Context, admin []byte, coins sdk.Coins) error {
+	if len(admin) == 0 {
+		return errors.New("admin cannot be empty")
+	}
+
+	for _, coin := range coins {
+		denomAdmin, found := k.GetDenomAdmin(ctx, coin.Denom)
+		if !found || !bytes.Equal(denomAdmin, admin) {
+			return errorsmod.Wrap(types.ErrNotDenomAdmin, coin.Denom)
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/v2/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +26,10 @@ func (k *Keeper) InitGenesis(ctx context.Context, state *types.GenesisState) err
 			return err
 		}
 
+		if err := balance.Coins.Validate(); err != nil {
+			return fmt.Errorf("invalid balance for address %s: %w", addr, err)
+		}
+
 		for _, coin := range balance.Coins {
 			err := k.balances.Set(ctx, collections.Join(bz, coin.Denom), coin.Amount)
 			if err != nil {
@@ -37,13 +42,47 @@ func (k *Keeper) InitGenesis(ctx context.Context, state *types.GenesisState) err
 	totalSupply := totalSupplyMap.ToCoins()
 
 	if !state.Supply.Empty() && !state.Supply.Equal(totalSupply) {
-		return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", state.Supply, totalSupply)
+		return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", totalSupply, state.Supply)
 	}
 
 	for _, supply := range totalSupply {
 		k.setSupply(ctx, supply)
 	}
 
+	for _, metadata := range state.DenomMetadata {
+		if err := k.SetDenomMetadata(ctx, metadata); err != nil {
+			return err
+		}
+	}
+
+	for _, factoryDenom := range state.FactoryDenoms {
+		admin := []byte{}
+		if factoryDenom.Admin != "" {
+			var err error
+			admin, err = k.addressCodec.StringToBytes(factoryDenom.Admin)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := k.denomAdmins.Set(ctx, factoryDenom.Denom, admin); err != nil {
+			return err
+		}
+	}
+
+	for _, hold := range state.Holds {
+		addr, err := k.addressCodec.StringToBytes(hold.Address)
+		if err != nil {
+			return err
+		}
+
+		for _, coin := range hold.Amount {
+			if err := k.holds.Set(ctx, collections.Join3(addr, hold.Name, coin.Denom), coin.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -53,5 +92,78 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, fmt.Errorf("failed to get params: %w", err)
 	}
 
-	return types.NewGenesisState(params), nil
+	genState := types.NewGenesisState(params)
+
+	// the balances are exported ordered by address then denom, and the supply by denom, so that
+	// exports of the same state are identical
+	err = k.balances.Walk(ctx, nil, func(key collections.Pair[[]byte, string], amount math.Int) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		coin := sdk.NewCoin(key.K2(), amount)
+		if n := len(genState.Balances); n > 0 && genState.Balances[n-1].Address == addrStr {
+			genState.Balances[n-1].Coins = append(genState.Balances[n-1].Coins, coin)
+		} else {
+			genState.Balances = append(genState.Balances, types.Balance{Address: addrStr, Coins: sdk.Coins{coin}})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	err = k.supply.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		genState.Supply = append(genState.Supply, sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get supply: %w", err)
+	}
+
+	err = k.denomMetadata.Walk(ctx, nil, func(_ string, metadata types.Metadata) (bool, error) {
+		genState.DenomMetadata = append(genState.DenomMetadata, metadata)
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get denom metadata: %w", err)
+	}
+
+	err = k.denomAdmins.Walk(ctx, nil, func(denom string, admin []byte) (bool, error) {
+		factoryDenom := types.FactoryDenom{Denom: denom}
+		if len(admin) > 0 {
+			adminStr, err := k.addressCodec.BytesToString(admin)
+			if err != nil {
+				return true, err
+			}
+			factoryDenom.Admin = adminStr
+		}
+
+		genState.FactoryDenoms = append(genState.FactoryDenoms, factoryDenom)
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get factory denoms: %w", err)
+	}
+
+	err = k.holds.Walk(ctx, nil, func(key collections.Triple[[]byte, string, string], amount math.Int) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		coin := sdk.NewCoin(key.K3(), amount)
+		if n := len(genState.Holds); n > 0 && genState.Holds[n-1].Address == addrStr && genState.Holds[n-1].Name == key.K2() {
+			genState.Holds[n-1].Amount = append(genState.Holds[n-1].Amount, coin)
+		} else {
+			genState.Holds = append(genState.Holds, types.Hold{Address: addrStr, Name: key.K2(), Amount: sdk.Coins{coin}})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holds: %w", err)
+	}
+
+	return genState, nil
 }
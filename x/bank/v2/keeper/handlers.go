@@ -5,15 +5,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/v2/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type handlers struct {
@@ -45,6 +49,12 @@ func (h handlers) MsgUpdateParams(ctx context.Context, msg *types.MsgUpdateParam
 		return nil, err
 	}
 
+	if msg.Params.DustCollector != "" {
+		if _, err := h.addressCodec.StringToBytes(msg.Params.DustCollector); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid dust collector address: %s", err)
+		}
+	}
+
 	if err := h.params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -76,9 +86,11 @@ func (h handlers) MsgSend(ctx context.Context, msg *types.MsgSend) (*types.MsgSe
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
 	}
 
-	// TODO: Check denom enable
+	if err := h.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
 
-	err = h.SendCoins(ctx, from, to, msg.Amount)
+	err = h.SendCoinsWithMemo(ctx, from, to, msg.Amount, msg.Memo)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +98,72 @@ func (h handlers) MsgSend(ctx context.Context, msg *types.MsgSend) (*types.MsgSe
 	return &types.MsgSendResponse{}, nil
 }
 
+func (h handlers) MsgMultiSend(ctx context.Context, msg *types.MsgMultiSend) (*types.MsgMultiSendResponse, error) {
+	from, err := h.addressCodec.StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	for _, output := range msg.Outputs {
+		if err := h.IsSendEnabledCoins(ctx, output.Coins...); err != nil {
+			return nil, err
+		}
+	}
+
+	err = h.MultiSendCoins(ctx, from, msg.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMultiSendResponse{}, nil
+}
+
+// MsgAtomicSwap executes the transfers of all the parties of the swap, which have all signed the message.
+// A failing transfer fails the message, and the whole transaction is reverted.
+func (h handlers) MsgAtomicSwap(ctx context.Context, msg *types.MsgAtomicSwap) (*types.MsgAtomicSwapResponse, error) {
+	type transfer struct {
+		from, to []byte
+		amount   sdk.Coins
+	}
+
+	transfers := make([]transfer, 0, len(msg.Transfers))
+	parties := make(map[string]struct{}, len(msg.Transfers))
+	for _, t := range msg.Transfers {
+		from, err := h.addressCodec.StringToBytes(t.FromAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+		}
+
+		to, err := h.addressCodec.StringToBytes(t.ToAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+		}
+
+		if !t.Amount.IsValid() || !t.Amount.IsAllPositive() {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, t.Amount.String())
+		}
+
+		if err := h.IsSendEnabledCoins(ctx, t.Amount...); err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, transfer{from: from, to: to, amount: t.Amount})
+		parties[string(from)] = struct{}{}
+	}
+
+	if len(parties) < 2 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "atomic swap must have at least two parties")
+	}
+
+	for _, t := range transfers {
+		if err := h.SendCoins(ctx, t.from, t.to, t.amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgAtomicSwapResponse{}, nil
+}
+
 func (h handlers) MsgMint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
 	authorityBytes, err := h.addressCodec.StringToBytes(msg.Authority)
 	if err != nil {
@@ -123,6 +201,110 @@ func (h handlers) MsgMint(ctx context.Context, msg *types.MsgMint) (*types.MsgMi
 	return &types.MsgMintResponse{}, nil
 }
 
+// MsgSetDenomMetadata sets the metadata of a denom, it is gated by the module authority.
+func (h handlers) MsgSetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	authorityBytes, err := h.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	// the admin of a factory denom can set its metadata
+	denomAdmin, isFactoryDenom := h.GetDenomAdmin(ctx, msg.Metadata.Base)
+	if !bytes.Equal(h.authority, authorityBytes) && (!isFactoryDenom || !bytes.Equal(denomAdmin, authorityBytes)) {
+		expectedAuthority, err := h.addressCodec.BytesToString(h.authority)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("invalid authority; expected %s or the denom admin, got %s", expectedAuthority, msg.Authority)
+	}
+
+	if err := h.SetDenomMetadata(ctx, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (h handlers) MsgCreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := h.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	denom, err := h.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (h handlers) MsgMintDenom(ctx context.Context, msg *types.MsgMintDenom) (*types.MsgMintDenomResponse, error) {
+	admin, err := h.addressCodec.StringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	to, err := h.addressCodec.StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := h.MintDenom(ctx, admin, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintDenomResponse{}, nil
+}
+
+func (h handlers) MsgBurnDenom(ctx context.Context, msg *types.MsgBurnDenom) (*types.MsgBurnDenomResponse, error) {
+	admin, err := h.addressCodec.StringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := h.BurnDenom(ctx, admin, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnDenomResponse{}, nil
+}
+
+func (h handlers) MsgChangeDenomAdmin(ctx context.Context, msg *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	admin, err := h.addressCodec.StringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	var newAdmin []byte
+	if msg.NewAdmin != "" {
+		newAdmin, err = h.addressCodec.StringToBytes(msg.NewAdmin)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	denomAdmin, found := h.GetDenomAdmin(ctx, msg.Denom)
+	if !found || !bytes.Equal(denomAdmin, admin) {
+		return nil, errorsmod.Wrap(types.ErrNotDenomAdmin, msg.Denom)
+	}
+
+	if err := h.SetDenomAdmin(ctx, msg.Denom, newAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeDenomAdminResponse{}, nil
+}
+
 // QueryParams queries the parameters of the bank/v2 module.
 func (h handlers) QueryParams(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -156,3 +338,316 @@ func (h handlers) QueryBalance(ctx context.Context, req *types.QueryBalanceReque
 
 	return &types.QueryBalanceResponse{Balance: &balance}, nil
 }
+
+// QueryDenomMetadata queries the metadata of a denom.
+func (h handlers) QueryDenomMetadata(ctx context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metadata, found := h.GetDenomMetadata(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "client metadata for denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// QueryDenomsMetadata queries the metadata of all the denoms.
+func (h handlers) QueryDenomsMetadata(ctx context.Context, req *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	metadatas, pageRes, err := query.CollectionPaginate(ctx, h.denomMetadata, req.Pagination, func(_ string, metadata types.Metadata) (types.Metadata, error) {
+		return metadata, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: metadatas, Pagination: pageRes}, nil
+}
+
+// QuerySpendableBalances queries the spendable balances of an account, their locked amount excluded.
+func (h handlers) QuerySpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	addr, err := h.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	locked, err := h.LockedCoins(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.balances,
+		req.Pagination,
+		func(key collections.Pair[[]byte, string], amount math.Int) (sdk.Coin, error) {
+			return spendableCoin(sdk.NewCoin(key.K2(), amount), locked), nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, string](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySpendableBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// QueryDenomOwners queries the holders of a denom with their balance, optionally skipping the
+// holders with a balance smaller than the minimum balance.
+func (h handlers) QueryDenomOwners(ctx context.Context, req *types.QueryDenomOwnersRequest) (*types.QueryDenomOwnersResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	minBalance := req.MinBalance
+	if minBalance.IsNil() {
+		minBalance = math.ZeroInt()
+	}
+	if minBalance.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "min balance cannot be negative")
+	}
+
+	denomOwners, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		h.balances.Indexes.Denom,
+		req.Pagination,
+		func(key collections.Pair[string, []byte], _ collections.NoValue) (bool, error) {
+			if minBalance.IsZero() {
+				return true, nil
+			}
+
+			amt, err := h.balances.Get(ctx, collections.Join(key.K2(), req.Denom))
+			if err != nil {
+				return false, err
+			}
+			return amt.GTE(minBalance), nil
+		},
+		func(key collections.Pair[string, []byte], _ collections.NoValue) (*types.DenomOwner, error) {
+			amt, err := h.balances.Get(ctx, collections.Join(key.K2(), req.Denom))
+			if err != nil {
+				return nil, err
+			}
+
+			addr, err := h.addressCodec.BytesToString(key.K2())
+			if err != nil {
+				return nil, err
+			}
+
+			return &types.DenomOwner{Address: addr, Balance: sdk.NewCoin(req.Denom, amt)}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, []byte](req.Denom),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
+}
+
+// QueryTotalSupply queries the total supply of all the coins.
+func (h handlers) QueryTotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	supply, pageRes, err := query.CollectionPaginate(ctx, h.supply, req.Pagination, func(denom string, amount math.Int) (sdk.Coin, error) {
+		return sdk.NewCoin(denom, amount), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTotalSupplyResponse{Supply: supply, Pagination: pageRes}, nil
+}
+
+// QuerySupplyOf queries the supply of a single coin.
+func (h handlers) QuerySupplyOf(ctx context.Context, req *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySupplyOfResponse{Amount: h.GetSupply(ctx, req.Denom)}, nil
+}
+
+// QuerySuppliesOf queries the supply of several coins, paginating over the requested denoms.
+func (h handlers) QuerySuppliesOf(ctx context.Context, req *types.QuerySuppliesOfRequest) (*types.QuerySuppliesOfResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	for _, denom := range req.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var (
+		start      int
+		limit      = int(query.DefaultLimit)
+		countTotal bool
+	)
+	if req.Pagination != nil {
+		if req.Pagination.Key != nil && req.Pagination.Offset > 0 {
+			return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+		}
+
+		start = int(req.Pagination.Offset)
+		if req.Pagination.Key != nil {
+			// the key is the first denom of the page
+			start = slices.Index(req.Denoms, string(req.Pagination.Key))
+			if start < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid pagination key %s", req.Pagination.Key)
+			}
+		}
+
+		if req.Pagination.Limit > 0 {
+			limit = int(req.Pagination.Limit)
+		}
+		countTotal = req.Pagination.CountTotal
+	}
+
+	start = min(start, len(req.Denoms))
+	end := min(start+limit, len(req.Denoms))
+
+	supplies := make([]sdk.Coin, 0, end-start)
+	for _, denom := range req.Denoms[start:end] {
+		supplies = append(supplies, h.GetSupply(ctx, denom))
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < len(req.Denoms) {
+		pageRes.NextKey = []byte(req.Denoms[end])
+	}
+	if countTotal {
+		pageRes.Total = uint64(len(req.Denoms))
+	}
+
+	return &types.QuerySuppliesOfResponse{Supplies: supplies, Pagination: pageRes}, nil
+}
+
+// QueryBlockedAddresses queries the addresses which are not allowed to receive funds.
+func (h handlers) QueryBlockedAddresses(ctx context.Context, req *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	blockedAddrs := h.GetBlockedAddresses()
+	addresses := make([]string, len(blockedAddrs))
+	for i, addr := range blockedAddrs {
+		addrStr, err := h.addressCodec.BytesToString(addr)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = addrStr
+	}
+
+	return &types.QueryBlockedAddressesResponse{Addresses: addresses}, nil
+}
+
+// QueryDenomAdmin queries the admin of a factory denom.
+func (h handlers) QueryDenomAdmin(ctx context.Context, req *types.QueryDenomAdminRequest) (*types.QueryDenomAdminResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	admin, found := h.GetDenomAdmin(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "factory denom %s does not exist", req.Denom)
+	}
+
+	if len(admin) == 0 {
+		return &types.QueryDenomAdminResponse{}, nil
+	}
+
+	adminStr, err := h.addressCodec.BytesToString(admin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomAdminResponse{Admin: adminStr}, nil
+}
+
+// QueryDenomsFromCreator queries the factory denoms created by an account.
+func (h handlers) QueryDenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	creator, err := h.addressCodec.StringToBytes(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+	}
+
+	denoms, err := h.GetDenomsFromCreator(ctx, creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
+}
+
+// QueryAllBalances queries the balances of all the accounts, ordered by address then denom.
+func (h handlers) QueryAllBalances(ctx context.Context, req *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	balances, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.balances,
+		req.Pagination,
+		func(key collections.Pair[[]byte, string], amount math.Int) (types.DenomOwner, error) {
+			addr, err := h.addressCodec.BytesToString(key.K1())
+			if err != nil {
+				return types.DenomOwner{}, err
+			}
+
+			return types.DenomOwner{Address: addr, Balance: sdk.NewCoin(key.K2(), amount)}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// QueryHolds queries the holds placed on the coins of an account.
+func (h handlers) QueryHolds(ctx context.Context, req *types.QueryHoldsRequest) (*types.QueryHoldsResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	addr, err := h.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	holds, err := h.GetHolds(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHoldsResponse{Holds: holds}, nil
+}
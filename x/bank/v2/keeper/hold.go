@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HoldCoins places the given coins of an account on hold under a name, e.g. the module or purpose
+// holding them. The coins are kept in the balance of the account, but cannot be spent until they
+// are released with ReleaseCoins. Holding coins under an existing hold adds them to it.
+// An error is returned if the amount exceeds the spendable balance of the account.
+func (k Keeper) HoldCoins(ctx context.Context, addr []byte, name string, amt sdk.Coins) error {
+	if name == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "hold name cannot be empty")
+	}
+
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	locked, err := k.LockedCoins(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range amt {
+		spendable := spendableCoin(k.GetBalance(ctx, addr, coin.Denom), locked)
+		if spendable.IsLT(coin) {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFunds,
+				"spendable balance %s is smaller than %s",
+				spendable, coin,
+			)
+		}
+
+		key := collections.Join3(addr, name, coin.Denom)
+		held, err := k.holds.Get(ctx, key)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			held = math.ZeroInt()
+		}
+
+		if err := k.holds.Set(ctx, key, held.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&types.EventHold{Address: addrStr, Name: name, Amount: amt})
+}
+
+// ReleaseCoins releases the given coins of an account from the hold of the given name, so that they
+// can be spent again. The hold is removed once all its coins are released.
+// An error is returned if the amount exceeds the coins on hold.
+func (k Keeper) ReleaseCoins(ctx context.Context, addr []byte, name string, amt sdk.Coins) error {
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range amt {
+		key := collections.Join3(addr, name, coin.Denom)
+		held, err := k.holds.Get(ctx, key)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			held = math.ZeroInt()
+		}
+
+		if held.LT(coin.Amount) {
+			return errorsmod.Wrapf(types.ErrInsufficientHold, "hold %s has %s%s, cannot release %s", name, held, coin.Denom, coin)
+		}
+
+		if remaining := held.Sub(coin.Amount); remaining.IsZero() {
+			err = k.holds.Remove(ctx, key)
+		} else {
+			err = k.holds.Set(ctx, key, remaining)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&types.EventRelease{Address: addrStr, Name: name, Amount: amt})
+}
+
+// HeldCoins returns the total coins of the given account on hold.
+func (k Keeper) HeldCoins(ctx context.Context, addr []byte) (sdk.Coins, error) {
+	held := sdk.NewCoins()
+	err := k.holds.Walk(ctx, collections.NewPrefixedTripleRange[[]byte, string, string](addr), func(key collections.Triple[[]byte, string, string], amount math.Int) (bool, error) {
+		held = held.Add(sdk.NewCoin(key.K3(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return held, nil
+}
+
+// GetHolds returns the holds placed on the coins of the given account, ordered by name.
+func (k Keeper) GetHolds(ctx context.Context, addr []byte) ([]types.Hold, error) {
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var holds []types.Hold
+	err = k.holds.Walk(ctx, collections.NewPrefixedTripleRange[[]byte, string, string](addr), func(key collections.Triple[[]byte, string, string], amount math.Int) (bool, error) {
+		coin := sdk.NewCoin(key.K3(), amount)
+		if n := len(holds); n > 0 && holds[n-1].Name == key.K2() {
+			holds[n-1].Amount = append(holds[n-1].Amount, coin)
+		} else {
+			holds = append(holds, types.Hold{Address: addrStr, Name: key.K2(), Amount: sdk.Coins{coin}})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -10,6 +13,7 @@ import (
 	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	"cosmossdk.io/schema"
 	"cosmossdk.io/x/bank/v2/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,14 +26,22 @@ import (
 type Keeper struct {
 	appmodulev2.Environment
 
-	authority    []byte
-	addressCodec address.Codec
-	schema       collections.Schema
-	params       collections.Item[types.Params]
-	balances     *collections.IndexedMap[collections.Pair[[]byte, string], math.Int, BalancesIndexes]
-	supply       collections.Map[string, math.Int]
-
-	sendRestriction *sendRestriction
+	authority     []byte
+	addressCodec  address.Codec
+	schema        collections.Schema
+	params        collections.Item[types.Params]
+	balances      *collections.IndexedMap[collections.Pair[[]byte, string], math.Int, BalancesIndexes]
+	supply        collections.Map[string, math.Int]
+	denomMetadata collections.Map[string, types.Metadata]
+	denomAdmins   collections.Map[string, []byte]
+	holds         collections.Map[collections.Triple[[]byte, string, string], math.Int]
+
+	sendRestriction     *sendRestriction
+	moduleAccounts      map[string][]byte
+	burners             map[string]struct{}
+	blockedAddrs        map[string]struct{}
+	lockedCoinsProvider types.LockedCoinsProvider
+	hooks               types.BankHooks
 }
 
 func NewKeeper(authority []byte, addressCodec address.Codec, env appmodulev2.Environment, cdc codec.BinaryCodec) *Keeper {
@@ -40,9 +52,15 @@ func NewKeeper(authority []byte, addressCodec address.Codec, env appmodulev2.Env
 		authority:       authority,
 		addressCodec:    addressCodec, // TODO(@julienrbrt): Should we add address codec to the environment?
 		params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		balances:        collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(collections.BytesKey, collections.StringKey), sdk.IntValue, newBalancesIndexes(sb)),
-		supply:          collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		balances:        collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.NamedPairKeyCodec("address", collections.BytesKey, "denom", collections.StringKey), sdk.IntValue, newBalancesIndexes(sb)),
+		supply:          collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey.WithName("denom"), sdk.IntValue),
+		denomMetadata:   collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey.WithName("denom"), codec.CollValue[types.Metadata](cdc)),
+		denomAdmins:     collections.NewMap(sb, types.DenomAdminPrefix, "denom_admins", collections.StringKey.WithName("denom"), collections.BytesValue.WithName("admin")),
+		holds:           collections.NewMap(sb, types.HoldsPrefix, "holds", collections.NamedTripleKeyCodec("address", collections.BytesKey, "name", collections.StringKey, "denom", collections.StringKey), sdk.IntValue),
 		sendRestriction: newSendRestriction(),
+		moduleAccounts:  make(map[string][]byte),
+		burners:         make(map[string]struct{}),
+		blockedAddrs:    make(map[string]struct{}),
 	}
 
 	schema, err := sb.Build()
@@ -54,6 +72,12 @@ func NewKeeper(authority []byte, addressCodec address.Codec, env appmodulev2.Env
 	return k
 }
 
+// ModuleCodec returns the codec of the state of the module, which lets the indexer decode the
+// balances, supply, denom metadata, denom admins and holds.
+func (k Keeper) ModuleCodec() (schema.ModuleCodec, error) {
+	return k.schema.ModuleCodec(collections.IndexingOptions{})
+}
+
 // MintCoins creates new coins from thin air and adds it to the module account.
 // An error is returned if the module account does not exist or is unauthorized.
 func (k Keeper) MintCoins(ctx context.Context, addr []byte, amounts sdk.Coins) error {
@@ -80,18 +104,124 @@ func (k Keeper) MintCoins(ctx context.Context, addr []byte, amounts sdk.Coins) e
 	}
 
 	// emit mint event
-	return k.EventService.EventManager(ctx).EmitKV(
+	if err := k.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeCoinMint,
 		event.NewAttribute(types.AttributeKeyMinter, addrStr),
 		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
-	)
+	); err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventMint{Minter: addrStr, Amount: amounts}); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterMint(ctx, addr, amounts)
+}
+
+// BatchMintCoins mints coins to many accounts at once, e.g. for genesis tooling or tests. The outputs
+// are all validated before any coins are minted.
+func (k Keeper) BatchMintCoins(ctx context.Context, outputs []types.Output) error {
+	if len(outputs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no outputs")
+	}
+
+	recipients := make([][]byte, len(outputs))
+	for i, output := range outputs {
+		if !output.Coins.IsValid() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, output.Coins.String())
+		}
+
+		addr, err := k.addressCodec.StringToBytes(output.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid output address: %s", err)
+		}
+		recipients[i] = addr
+	}
+
+	for i, output := range outputs {
+		if err := k.MintCoins(ctx, recipients[i], output.Coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BurnCoins burns coins from the given account, decreasing the supply.
+// An error is returned if the account has no burn permission or doesn't have enough spendable coins.
+func (k Keeper) BurnCoins(ctx context.Context, addr []byte, amounts sdk.Coins) error {
+	if !k.HasBurnPermission(addr) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return err
+		}
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s does not have permissions to burn tokens", addrStr)
+	}
+
+	return k.burnCoins(ctx, addr, amounts)
+}
+
+// burnCoins burns coins from the given account without checking its burn permission.
+func (k Keeper) burnCoins(ctx context.Context, addr []byte, amounts sdk.Coins) error {
+	if !amounts.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amounts.String())
+	}
+
+	err := k.subUnlockedCoins(ctx, addr, amounts)
+	if err != nil {
+		return err
+	}
+
+	for _, amount := range amounts {
+		supply := k.GetSupply(ctx, amount.GetDenom())
+		supply = supply.Sub(amount)
+		k.setSupply(ctx, supply)
+	}
+
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	// emit burn event
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinBurn,
+		event.NewAttribute(types.AttributeKeyBurner, addrStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
+	); err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventBurn{Burner: addrStr, Amount: amounts}); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterBurn(ctx, addr, amounts)
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // Function take sender & recipient as []byte.
 // They can be sdk address or module name.
-// An error is returned upon failure.
+// An error is returned upon failure, or if the recipient is a blocked address.
 func (k Keeper) SendCoins(ctx context.Context, from, to []byte, amt sdk.Coins) error {
+	return k.sendCoins(ctx, from, to, amt, "", false)
+}
+
+// SendCoinsWithMemo transfers amt coins from a sending account to a receiving account like SendCoins,
+// along with a memo referencing the transfer. The memo is not stored, only emitted in the events.
+func (k Keeper) SendCoinsWithMemo(ctx context.Context, from, to []byte, amt sdk.Coins, memo string) error {
+	if len(memo) > types.MaxTransferMemoLength {
+		return errorsmod.Wrapf(sdkerrors.ErrMemoTooLarge, "maximum number of characters is %d but received %d characters", types.MaxTransferMemoLength, len(memo))
+	}
+
+	return k.sendCoins(ctx, from, to, amt, memo, false)
+}
+
+// sendCoins transfers amt coins from a sending account to a receiving account, the blocked
+// addresses can only receive the coins if allowBlocked is set. The memo, if any, is emitted
+// in the events of the transfer.
+func (k Keeper) sendCoins(ctx context.Context, from, to []byte, amt sdk.Coins, memo string, allowBlocked bool) error {
 	if !amt.IsValid() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
@@ -102,6 +232,12 @@ func (k Keeper) SendCoins(ctx context.Context, from, to []byte, amt sdk.Coins) e
 		return err
 	}
 
+	if !allowBlocked {
+		if err := k.checkBlockedAddress(to); err != nil {
+			return err
+		}
+	}
+
 	err = k.subUnlockedCoins(ctx, from, amt)
 	if err != nil {
 		return err
@@ -121,12 +257,247 @@ func (k Keeper) SendCoins(ctx context.Context, from, to []byte, amt sdk.Coins) e
 		return err
 	}
 
-	return k.EventService.EventManager(ctx).EmitKV(
-		types.EventTypeTransfer,
+	attrs := []event.Attribute{
 		event.NewAttribute(types.AttributeKeyRecipient, toAddrString),
 		event.NewAttribute(types.AttributeKeySender, fromAddrString),
 		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	}
+	if memo != "" {
+		attrs = append(attrs, event.NewAttribute(types.AttributeKeyMemo, memo))
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeTransfer, attrs...); err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventSend{Sender: fromAddrString, Recipient: toAddrString, Amount: amt, Memo: memo}); err != nil {
+		return err
+	}
+
+	if err := k.Hooks().AfterSend(ctx, from, to, amt); err != nil {
+		return err
+	}
+
+	return k.sweepDust(ctx, from, amt)
+}
+
+// MultiSendCoins transfers coins from a sending account to many receiving accounts in a single
+// state transition, none of which can be a blocked address. The send restrictions are applied to
+// each output, then the total amount is spent from the sender at once and outputs to the same
+// recipient are aggregated, so that a single coin_spent event is emitted along with a coin_received
+// and a transfer event per recipient, and the AfterSend hook is called once per recipient.
+// An error is returned upon failure.
+func (k Keeper) MultiSendCoins(ctx context.Context, from []byte, outputs []types.Output) error {
+	if len(outputs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no outputs")
+	}
+
+	var (
+		total      sdk.Coins
+		recipients [][]byte
+		amounts    = make(map[string]sdk.Coins, len(outputs))
 	)
+	for _, output := range outputs {
+		if !output.Coins.IsValid() || !output.Coins.IsAllPositive() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, output.Coins.String())
+		}
+
+		to, err := k.addressCodec.StringToBytes(output.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid output address: %s", err)
+		}
+
+		to, err = k.sendRestriction.apply(ctx, from, to, output.Coins)
+		if err != nil {
+			return err
+		}
+
+		if err := k.checkBlockedAddress(to); err != nil {
+			return err
+		}
+
+		if _, ok := amounts[string(to)]; !ok {
+			recipients = append(recipients, to)
+		}
+		amounts[string(to)] = amounts[string(to)].Add(output.Coins...)
+		total = total.Add(output.Coins...)
+	}
+
+	if err := k.subUnlockedCoins(ctx, from, total); err != nil {
+		return err
+	}
+
+	fromAddrString, err := k.addressCodec.BytesToString(from)
+	if err != nil {
+		return err
+	}
+
+	for _, to := range recipients {
+		amt := amounts[string(to)]
+		if err := k.addCoins(ctx, to, amt); err != nil {
+			return err
+		}
+
+		toAddrString, err := k.addressCodec.BytesToString(to)
+		if err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeTransfer,
+			event.NewAttribute(types.AttributeKeyRecipient, toAddrString),
+			event.NewAttribute(types.AttributeKeySender, fromAddrString),
+			event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).Emit(&types.EventSend{Sender: fromAddrString, Recipient: toAddrString, Amount: amt}); err != nil {
+			return err
+		}
+
+		if err := k.Hooks().AfterSend(ctx, from, to, amt); err != nil {
+			return err
+		}
+	}
+
+	return k.sweepDust(ctx, from, total)
+}
+
+// sweepDust moves the balances of the given denoms of an account which are below their dust
+// threshold to the dust collector, so that dust accounts don't bloat the state. Nothing is swept
+// if no dust collector is set. Only the spendable part of the balances is swept, and the sweep
+// is not subject to the send restrictions nor to the blocked addresses.
+func (k Keeper) sweepDust(ctx context.Context, addr []byte, amt sdk.Coins) error {
+	params := k.GetParams(ctx)
+	if params.DustCollector == "" || params.DustThresholds.Empty() {
+		return nil
+	}
+
+	collector, err := k.addressCodec.StringToBytes(params.DustCollector)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(addr, collector) {
+		return nil
+	}
+
+	locked, err := k.LockedCoins(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	dust := sdk.NewCoins()
+	for _, coin := range amt {
+		threshold := params.DustThresholds.AmountOf(coin.Denom)
+		if !threshold.IsPositive() {
+			continue
+		}
+
+		balance := k.GetBalance(ctx, addr, coin.Denom)
+		if balance.Amount.GTE(threshold) {
+			continue
+		}
+
+		dust = dust.Add(spendableCoin(balance, locked))
+	}
+
+	if dust.IsZero() {
+		return nil
+	}
+
+	if err := k.subUnlockedCoins(ctx, addr, dust); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, collector, dust); err != nil {
+		return err
+	}
+
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventSweepDust{Address: addrStr, Collector: params.DustCollector, Amount: dust}); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterSend(ctx, addr, collector, dust)
+}
+
+// Hooks gets the hooks for the bank/v2 keeper.
+func (k Keeper) Hooks() types.BankHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiBankHooks{}
+	}
+
+	return k.hooks
+}
+
+// SetHooks sets the hooks for the bank/v2 keeper. It is meant to be called when wiring the app.
+func (k *Keeper) SetHooks(bh types.BankHooks) {
+	if k.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks = bh
+}
+
+// SetLockedCoinsProvider sets the provider of the locked coins of the accounts, which are excluded
+// from their spendable balances. It is meant to be called when wiring the app.
+func (k *Keeper) SetLockedCoinsProvider(provider types.LockedCoinsProvider) {
+	k.lockedCoinsProvider = provider
+}
+
+// LockedCoins returns the coins of the given account which are locked and cannot be spent, i.e.
+// the coins locked by the locked coins provider and the coins on hold.
+func (k Keeper) LockedCoins(ctx context.Context, addr []byte) (sdk.Coins, error) {
+	locked, err := k.HeldCoins(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.lockedCoinsProvider == nil {
+		return locked, nil
+	}
+
+	providerLocked, err := k.lockedCoinsProvider.LockedCoins(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return locked.Add(providerLocked...), nil
+}
+
+// SpendableCoins returns the balances of the given account which can be spent, i.e. their
+// locked amount excluded.
+func (k Keeper) SpendableCoins(ctx context.Context, addr []byte) (sdk.Coins, error) {
+	locked, err := k.LockedCoins(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	spendable := sdk.NewCoins()
+	err = k.balances.Walk(ctx, collections.NewPrefixedPairRange[[]byte, string](addr), func(key collections.Pair[[]byte, string], amount math.Int) (bool, error) {
+		spendable = spendable.Add(spendableCoin(sdk.NewCoin(key.K2(), amount), locked))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return spendable, nil
+}
+
+// spendableCoin returns the amount of the balance which is not locked.
+func spendableCoin(balance sdk.Coin, locked sdk.Coins) sdk.Coin {
+	amount := balance.Amount.Sub(locked.AmountOf(balance.Denom))
+	if amount.IsNegative() {
+		return sdk.NewCoin(balance.Denom, math.ZeroInt())
+	}
+	return sdk.NewCoin(balance.Denom, amount)
 }
 
 // GetSupply retrieves the Supply from store
@@ -138,6 +509,34 @@ func (k Keeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
 	return sdk.NewCoin(denom, amt)
 }
 
+// AssertTotalSupply checks that the tracked supply of every denom equals the sum of its balances
+// and returns an error describing the mismatch otherwise.
+func (k Keeper) AssertTotalSupply(ctx context.Context) error {
+	balances := sdk.NewMapCoins(sdk.Coins{})
+	err := k.balances.Walk(ctx, nil, func(key collections.Pair[[]byte, string], amount math.Int) (bool, error) {
+		balances.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	supply := sdk.NewCoins()
+	err = k.supply.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		supply = supply.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if sum := balances.ToCoins(); !sum.Equal(supply) {
+		return fmt.Errorf("total supply %s doesn't match the sum of the balances %s", supply, sum)
+	}
+
+	return nil
+}
+
 // GetBalance returns the balance of a specific denomination for a given account
 // by address.
 func (k Keeper) GetBalance(ctx context.Context, addr []byte, denom string) sdk.Coin {
@@ -148,16 +547,105 @@ func (k Keeper) GetBalance(ctx context.Context, addr []byte, denom string) sdk.C
 	return sdk.NewCoin(denom, amt)
 }
 
+// IterateAllBalances iterates over the balances of all the accounts, ordered by address then denom,
+// and calls cb for each of them until it returns true.
+func (k Keeper) IterateAllBalances(ctx context.Context, cb func(addr []byte, balance sdk.Coin) (bool, error)) error {
+	return k.balances.Walk(ctx, nil, func(key collections.Pair[[]byte, string], amount math.Int) (bool, error) {
+		return cb(key.K1(), sdk.NewCoin(key.K2(), amount))
+	})
+}
+
+// ExportBalanceSnapshot streams the balances of all the accounts to w in the given format, see
+// types.NewBalanceSnapshotWriter, ordered by address then denom. Historical snapshots are exported
+// by calling it with a context reading the state at the snapshot height.
+func (k Keeper) ExportBalanceSnapshot(ctx context.Context, w io.Writer, format string) error {
+	sw, err := types.NewBalanceSnapshotWriter(w, format)
+	if err != nil {
+		return err
+	}
+
+	err = k.IterateAllBalances(ctx, func(addr []byte, balance sdk.Coin) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		return false, sw.Write(addrStr, balance)
+	})
+	if err != nil {
+		return err
+	}
+
+	return sw.Close()
+}
+
+// GetDenomMetadata retrieves the metadata of the given denom and returns false if there is none.
+func (k Keeper) GetDenomMetadata(ctx context.Context, denom string) (types.Metadata, bool) {
+	metadata, err := k.denomMetadata.Get(ctx, denom)
+	if err != nil {
+		return types.Metadata{}, false
+	}
+	return metadata, true
+}
+
+// SetDenomMetadata validates and sets the metadata of its base denom, replacing any existing metadata.
+func (k Keeper) SetDenomMetadata(ctx context.Context, metadata types.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.denomMetadata.Set(ctx, metadata.Base, metadata); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&types.EventSetDenomMetadata{Metadata: metadata})
+}
+
+// GetParams returns the parameters of the bank/v2 module, or the default parameters if none are set.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	params, err := k.params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+	return params
+}
+
+// IsSendEnabledDenom returns the current send enabled status of the given denom.
+func (k Keeper) IsSendEnabledDenom(ctx context.Context, denom string) bool {
+	return k.GetParams(ctx).IsSendEnabledDenom(denom)
+}
+
+// IsSendEnabledCoins returns an error if any of the coins are not enabled for transfers.
+func (k Keeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error {
+	if len(coins) == 0 {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	for _, coin := range coins {
+		if !params.IsSendEnabledDenom(coin.Denom) {
+			return types.ErrSendDisabled.Wrapf("%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
 // subUnlockedCoins removes the unlocked amt coins of the given account.
-// An error is returned if the resulting balance is negative.
+// An error is returned if the amount exceeds the spendable balance, i.e. the balance which is not
+// locked.
 //
 // CONTRACT: The provided amount (amt) must be valid, non-negative coins.
 //
 // A coin_spent event is emitted after the operation.
 func (k Keeper) subUnlockedCoins(ctx context.Context, addr []byte, amt sdk.Coins) error {
+	locked, err := k.LockedCoins(ctx, addr)
+	if err != nil {
+		return err
+	}
+
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
-		spendable := sdk.Coins{balance}
+		spendable := sdk.Coins{spendableCoin(balance, locked)}
 
 		_, hasNeg := spendable.SafeSub(coin)
 		if hasNeg {
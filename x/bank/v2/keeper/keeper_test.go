@@ -3,26 +3,34 @@ package keeper_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
+	"cosmossdk.io/schema"
 	storetypes "cosmossdk.io/store/types"
+	v1types "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/bank/v2/keeper"
 	banktestutil "cosmossdk.io/x/bank/v2/testutil"
 	banktypes "cosmossdk.io/x/bank/v2/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -236,3 +244,1117 @@ func (suite *KeeperTestSuite) TestSendCoins_WithRestriction() {
 	acc1BarBalance := suite.bankKeeper.GetBalance(ctx, accAddrs[1], barDenom)
 	require.Equal(acc1BarBalance.Amount, math.ZeroInt())
 }
+
+func (suite *KeeperTestSuite) TestMultiSendCoins() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2Str, err := suite.addressCodec.BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(10))),
+		banktypes.NewOutput(acc2Str, sdk.NewCoins(newFooCoin(20), newBarCoin(10))),
+		banktypes.NewOutput(acc1Str, sdk.NewCoins(newBarCoin(5))),
+	}
+
+	// Try multi send with empty balances
+	require.Error(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[0], outputs))
+
+	// Try multi send without outputs or with invalid outputs
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+	require.Error(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[0], nil))
+	require.Error(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[0], []banktypes.Output{banktypes.NewOutput(acc1Str, sdk.Coins{newFooCoin(0)})}))
+	require.Error(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[0], []banktypes.Output{banktypes.NewOutput("invalid", sdk.NewCoins(newFooCoin(10)))}))
+
+	// Try multi send exceeding the balance in total
+	require.Error(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[0], []banktypes.Output{
+		banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(60))),
+		banktypes.NewOutput(acc2Str, sdk.NewCoins(newFooCoin(60))),
+	}))
+
+	require.NoError(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[0], outputs))
+
+	// Check balances
+	require.Equal(math.NewInt(70), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).Amount)
+	require.Equal(math.NewInt(35), suite.bankKeeper.GetBalance(ctx, accAddrs[0], barDenom).Amount)
+	require.Equal(math.NewInt(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).Amount)
+	require.Equal(math.NewInt(5), suite.bankKeeper.GetBalance(ctx, accAddrs[1], barDenom).Amount)
+	require.Equal(math.NewInt(20), suite.bankKeeper.GetBalance(ctx, accAddrs[2], fooDenom).Amount)
+	require.Equal(math.NewInt(10), suite.bankKeeper.GetBalance(ctx, accAddrs[2], barDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestMultiSendCoins_WithRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2Str, err := suite.addressCodec.BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	// Redirect the coins sent to acc2 to acc3
+	suite.bankKeeper.AppendGlobalSendRestriction(func(ctx context.Context, from, to []byte, amount sdk.Coins) ([]byte, error) {
+		if bytes.Equal(to, accAddrs[2]) {
+			return accAddrs[3], nil
+		}
+		return to, nil
+	})
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+	require.NoError(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[0], []banktypes.Output{
+		banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(10))),
+		banktypes.NewOutput(acc2Str, sdk.NewCoins(newFooCoin(20))),
+	}))
+
+	require.Equal(math.NewInt(70), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).Amount)
+	require.Equal(math.NewInt(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).Amount)
+	require.Equal(math.ZeroInt(), suite.bankKeeper.GetBalance(ctx, accAddrs[2], fooDenom).Amount)
+	require.Equal(math.NewInt(20), suite.bankKeeper.GetBalance(ctx, accAddrs[3], fooDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestSendCoins_WithRestrictionBypass() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	suite.bankKeeper.AppendGlobalSendRestriction(func(ctx context.Context, from, to []byte, amount sdk.Coins) ([]byte, error) {
+		return nil, fmt.Errorf("sends are restricted")
+	})
+
+	err := suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt)
+	require.Error(err)
+	require.Contains(err.Error(), "sends are restricted")
+
+	// the restriction is bypassed in the context
+	bypassCtx := banktypes.WithSendRestrictionBypass(ctx)
+	require.True(banktypes.HasSendRestrictionBypass(bypassCtx))
+	require.False(banktypes.HasSendRestrictionBypass(ctx))
+	require.NoError(suite.bankKeeper.SendCoins(bypassCtx, accAddrs[0], accAddrs[1], sendAmt))
+
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+	require.NoError(suite.bankKeeper.MultiSendCoins(bypassCtx, accAddrs[0], []banktypes.Output{banktypes.NewOutput(acc1Str, sendAmt)}))
+
+	require.Equal(math.NewInt(80), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).Amount)
+	require.Equal(math.NewInt(20), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestDenomMetadata() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	metadata := banktypes.Metadata{
+		Description: "The native staking token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: fooDenom, Exponent: 0},
+			{Denom: "mfoo", Exponent: 3},
+		},
+		Base:    fooDenom,
+		Display: "mfoo",
+		Name:    "Foo",
+		Symbol:  "FOO",
+		URI:     "https://foo.example",
+	}
+
+	_, found := suite.bankKeeper.GetDenomMetadata(ctx, fooDenom)
+	require.False(found)
+	_, err := handlers.QueryDenomMetadata(ctx, banktypes.NewQueryDenomMetadataRequest(fooDenom))
+	require.Error(err)
+
+	// invalid metadata
+	invalid := metadata
+	invalid.Display = barDenom
+	require.Error(suite.bankKeeper.SetDenomMetadata(ctx, invalid))
+
+	// only the authority can set the metadata
+	authority, err := suite.addressCodec.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(err)
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	_, err = handlers.MsgSetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Authority: acc0Str, Metadata: metadata})
+	require.Error(err)
+	_, err = handlers.MsgSetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Authority: authority, Metadata: metadata})
+	require.NoError(err)
+
+	got, found := suite.bankKeeper.GetDenomMetadata(ctx, fooDenom)
+	require.True(found)
+	require.Equal(metadata, got)
+
+	res, err := handlers.QueryDenomMetadata(ctx, banktypes.NewQueryDenomMetadataRequest(fooDenom))
+	require.NoError(err)
+	require.Equal(metadata, res.Metadata)
+
+	barMetadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: barDenom, Exponent: 0}},
+		Base:       barDenom,
+		Display:    barDenom,
+		Name:       "Bar",
+		Symbol:     "BAR",
+	}
+	require.NoError(suite.bankKeeper.SetDenomMetadata(ctx, barMetadata))
+
+	allRes, err := handlers.QueryDenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{})
+	require.NoError(err)
+	require.Equal([]banktypes.Metadata{barMetadata, metadata}, allRes.Metadatas)
+
+	// the metadata is exported and imported with the genesis
+	_, err = handlers.MsgUpdateParams(ctx, &banktypes.MsgUpdateParams{Authority: authority, Params: banktypes.DefaultParams()})
+	require.NoError(err)
+	genState, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.NoError(genState.Validate())
+	require.Equal([]banktypes.Metadata{barMetadata, metadata}, genState.DenomMetadata)
+
+	suite.SetupTest()
+	require.NoError(suite.bankKeeper.InitGenesis(suite.ctx, genState))
+	got, found = suite.bankKeeper.GetDenomMetadata(suite.ctx, barDenom)
+	require.True(found)
+	require.Equal(barMetadata, got)
+}
+
+func (suite *KeeperTestSuite) TestSendCoins_ModuleAccounts() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	// Register the module accounts
+	_, err := suite.bankKeeper.RegisterModuleAccount(" ")
+	require.Error(err)
+	mintAddr, err := suite.bankKeeper.RegisterModuleAccount(banktypes.MintModuleName)
+	require.NoError(err)
+	require.Equal(mintAcc.GetAddress().Bytes(), mintAddr)
+	_, err = suite.bankKeeper.RegisterModuleAccount(banktypes.MintModuleName)
+	require.Error(err)
+	burnerAddr, err := suite.bankKeeper.RegisterModuleAccount(authtypes.Burner)
+	require.NoError(err)
+
+	addr, found := suite.bankKeeper.GetModuleAddress(authtypes.Burner)
+	require.True(found)
+	require.Equal(burnerAddr, addr)
+	_, found = suite.bankKeeper.GetModuleAddress("unknown")
+	require.False(found)
+
+	require.NoError(suite.bankKeeper.MintCoins(ctx, mintAddr, balances))
+
+	// Try send with an unknown module account
+	require.Error(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, "unknown", accAddrs[0], sendAmt))
+	require.Error(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], "unknown", sendAmt))
+	require.Error(suite.bankKeeper.SendCoinsFromModuleToModule(ctx, banktypes.MintModuleName, "unknown", sendAmt))
+
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[0], sdk.NewCoins(newFooCoin(30))))
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], authtypes.Burner, sendAmt))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToModule(ctx, banktypes.MintModuleName, authtypes.Burner, sendAmt))
+
+	// Check balances
+	require.Equal(math.NewInt(60), suite.bankKeeper.GetBalance(ctx, mintAddr, fooDenom).Amount)
+	require.Equal(math.NewInt(20), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).Amount)
+	require.Equal(math.NewInt(20), suite.bankKeeper.GetBalance(ctx, burnerAddr, fooDenom).Amount)
+}
+
+type mockLockedCoinsProvider map[string]sdk.Coins
+
+func (m mockLockedCoinsProvider) LockedCoins(_ context.Context, addr []byte) (sdk.Coins, error) {
+	return m[string(addr)], nil
+}
+
+func (suite *KeeperTestSuite) TestSpendableCoins() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	// Without provider, all the balances are spendable
+	spendable, err := suite.bankKeeper.SpendableCoins(ctx, accAddrs[0])
+	require.NoError(err)
+	require.Equal(balances, spendable)
+
+	suite.bankKeeper.SetLockedCoinsProvider(mockLockedCoinsProvider{
+		string(accAddrs[0]): sdk.NewCoins(newFooCoin(70), newBarCoin(80)),
+	})
+
+	spendable, err = suite.bankKeeper.SpendableCoins(ctx, accAddrs[0])
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(30)), spendable)
+
+	res, err := handlers.QuerySpendableBalances(ctx, banktypes.NewQuerySpendableBalancesRequest(acc0Str, nil))
+	require.NoError(err)
+	require.Equal(sdk.Coins{newBarCoin(0), newFooCoin(30)}, res.Balances)
+
+	// The locked coins cannot be sent
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(40)))
+	require.Error(err)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(30))))
+
+	require.Equal(math.NewInt(70), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).Amount)
+	require.Equal(math.NewInt(30), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestDenomOwners() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[2], sdk.NewCoins(newFooCoin(50))))
+
+	// the holders of a used up balance are removed from the index
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[3], sdk.NewCoins(newFooCoin(10))))
+
+	owner := func(addr sdk.AccAddress, coin sdk.Coin) *banktypes.DenomOwner {
+		addrStr, err := suite.addressCodec.BytesToString(addr)
+		require.NoError(err)
+		return &banktypes.DenomOwner{Address: addrStr, Balance: coin}
+	}
+
+	_, err := handlers.QueryDenomOwners(ctx, banktypes.NewQueryDenomOwnersRequest("1", math.ZeroInt(), nil))
+	require.Error(err)
+	_, err = handlers.QueryDenomOwners(ctx, banktypes.NewQueryDenomOwnersRequest(fooDenom, math.NewInt(-1), nil))
+	require.Error(err)
+
+	res, err := handlers.QueryDenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal([]*banktypes.DenomOwner{
+		owner(accAddrs[0], newFooCoin(100)),
+		owner(accAddrs[2], newFooCoin(50)),
+		owner(accAddrs[3], newFooCoin(10)),
+	}, res.DenomOwners)
+
+	res, err = handlers.QueryDenomOwners(ctx, banktypes.NewQueryDenomOwnersRequest(fooDenom, math.NewInt(50), nil))
+	require.NoError(err)
+	require.Equal([]*banktypes.DenomOwner{
+		owner(accAddrs[0], newFooCoin(100)),
+		owner(accAddrs[2], newFooCoin(50)),
+	}, res.DenomOwners)
+
+	res, err = handlers.QueryDenomOwners(ctx, banktypes.NewQueryDenomOwnersRequest(barDenom, math.ZeroInt(), nil))
+	require.NoError(err)
+	require.Equal([]*banktypes.DenomOwner{owner(accAddrs[0], newBarCoin(50))}, res.DenomOwners)
+}
+
+func (suite *KeeperTestSuite) TestSupply() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	require.NoError(suite.bankKeeper.MintCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	require.NoError(suite.bankKeeper.MintCoins(ctx, accAddrs[1], sdk.NewCoins(newFooCoin(20))))
+	require.NoError(suite.bankKeeper.AssertTotalSupply(ctx))
+	require.NoError(suite.bankKeeper.RegisterBurner(accAddrs[0]))
+	require.NoError(suite.bankKeeper.RegisterBurner(accAddrs[1]))
+
+	// Try burn more than the balance
+	require.Error(suite.bankKeeper.BurnCoins(ctx, accAddrs[1], sdk.NewCoins(newFooCoin(30))))
+
+	require.NoError(suite.bankKeeper.BurnCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(40), newBarCoin(50))))
+	require.NoError(suite.bankKeeper.AssertTotalSupply(ctx))
+
+	require.Equal(math.NewInt(60), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).Amount)
+	require.Equal(math.ZeroInt(), suite.bankKeeper.GetBalance(ctx, accAddrs[0], barDenom).Amount)
+	require.Equal(newFooCoin(80), suite.bankKeeper.GetSupply(ctx, fooDenom))
+	require.Equal(newBarCoin(0), suite.bankKeeper.GetSupply(ctx, barDenom))
+
+	// Typed events are emitted
+	var eventTypes []string
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Contains(eventTypes, "cosmos.bank.v2.EventMint")
+	require.Contains(eventTypes, "cosmos.bank.v2.EventBurn")
+
+	totalRes, err := handlers.QueryTotalSupply(ctx, &banktypes.QueryTotalSupplyRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(80)), totalRes.Supply)
+
+	supplyRes, err := handlers.QuerySupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(newFooCoin(80), supplyRes.Amount)
+	_, err = handlers.QuerySupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: "1"})
+	require.Error(err)
+
+	// The assertion fails once the supply doesn't match the balances
+	acc2Str, err := suite.addressCodec.BytesToString(accAddrs[2])
+	require.NoError(err)
+	require.NoError(suite.bankKeeper.InitGenesis(ctx, &banktypes.GenesisState{
+		Params:   banktypes.DefaultParams(),
+		Balances: []banktypes.Balance{{Address: acc2Str, Coins: sdk.NewCoins(newFooCoin(5))}},
+	}))
+	require.Error(suite.bankKeeper.AssertTotalSupply(ctx))
+}
+
+func (suite *KeeperTestSuite) TestBurnCoins_Permissions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100))
+	burnAmt := sdk.NewCoins(newFooCoin(10))
+
+	burnerAddr, err := suite.bankKeeper.RegisterModuleAccount(authtypes.Burner)
+	require.NoError(err)
+	_, err = suite.bankKeeper.RegisterModuleAccount(authtypes.Staking)
+	require.NoError(err)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, burnerAddr, balances))
+	require.NoError(suite.bankKeeper.MintCoins(ctx, accAddrs[0], balances))
+
+	// Try burn without permission
+	require.False(suite.bankKeeper.HasBurnPermission(burnerAddr))
+	require.Error(suite.bankKeeper.BurnCoinsFromModule(ctx, authtypes.Burner, burnAmt))
+	require.Error(suite.bankKeeper.BurnCoins(ctx, accAddrs[0], burnAmt))
+
+	// Grant the burn permissions
+	require.Error(suite.bankKeeper.RegisterBurner(nil))
+	require.NoError(suite.bankKeeper.RegisterBurner(burnerAddr))
+	require.NoError(suite.bankKeeper.RegisterBurner(accAddrs[0]))
+	require.True(suite.bankKeeper.HasBurnPermission(burnerAddr))
+
+	require.NoError(suite.bankKeeper.BurnCoinsFromModule(ctx, authtypes.Burner, burnAmt))
+	require.NoError(suite.bankKeeper.BurnCoins(ctx, accAddrs[0], burnAmt))
+	require.Error(suite.bankKeeper.BurnCoinsFromModule(ctx, authtypes.Staking, burnAmt))
+	require.Error(suite.bankKeeper.BurnCoinsFromModule(ctx, "unknown", burnAmt))
+
+	require.Equal(math.NewInt(90), suite.bankKeeper.GetBalance(ctx, burnerAddr, fooDenom).Amount)
+	require.Equal(math.NewInt(90), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).Amount)
+	require.Equal(newFooCoin(180), suite.bankKeeper.GetSupply(ctx, fooDenom))
+}
+
+func (suite *KeeperTestSuite) TestBlockedAddresses() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+	balances := sdk.NewCoins(newFooCoin(100))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	burnerAddr, err := suite.bankKeeper.RegisterModuleAccount(authtypes.Burner)
+	require.NoError(err)
+	mintAddr, err := suite.bankKeeper.RegisterModuleAccount(banktypes.MintModuleName)
+	require.NoError(err)
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, mintAddr, balances))
+
+	require.Error(suite.bankKeeper.BlockAddress(nil))
+	require.NoError(suite.bankKeeper.BlockAddress(burnerAddr))
+	require.NoError(suite.bankKeeper.BlockAddress(accAddrs[1]))
+	require.True(suite.bankKeeper.IsBlockedAddress(accAddrs[1]))
+	require.False(suite.bankKeeper.IsBlockedAddress(accAddrs[2]))
+
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2Str, err := suite.addressCodec.BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	// Try send to the blocked addresses
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt), banktypes.ErrBlockedAddress)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], burnerAddr, sendAmt), banktypes.ErrBlockedAddress)
+	require.ErrorIs(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[1], sendAmt), banktypes.ErrBlockedAddress)
+	require.ErrorIs(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[0], []banktypes.Output{
+		banktypes.NewOutput(acc2Str, sendAmt),
+		banktypes.NewOutput(acc1Str, sendAmt),
+	}), banktypes.ErrBlockedAddress)
+
+	// Blocked module accounts receive funds from the module transfers
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], authtypes.Burner, sendAmt))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToModule(ctx, banktypes.MintModuleName, authtypes.Burner, sendAmt))
+	require.Equal(math.NewInt(20), suite.bankKeeper.GetBalance(ctx, burnerAddr, fooDenom).Amount)
+	require.Equal(math.ZeroInt(), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).Amount)
+
+	burnerStr, err := suite.addressCodec.BytesToString(burnerAddr)
+	require.NoError(err)
+	res, err := handlers.QueryBlockedAddresses(ctx, &banktypes.QueryBlockedAddressesRequest{})
+	require.NoError(err)
+	require.ElementsMatch([]string{acc1Str, burnerStr}, res.Addresses)
+}
+
+type mockBankHooks struct {
+	sends, mints, burns []sdk.Coins
+	err                 error
+}
+
+func (h *mockBankHooks) AfterSend(_ context.Context, _, _ []byte, amt sdk.Coins) error {
+	h.sends = append(h.sends, amt)
+	return h.err
+}
+
+func (h *mockBankHooks) AfterMint(_ context.Context, _ []byte, amt sdk.Coins) error {
+	h.mints = append(h.mints, amt)
+	return h.err
+}
+
+func (h *mockBankHooks) AfterBurn(_ context.Context, _ []byte, amt sdk.Coins) error {
+	h.burns = append(h.burns, amt)
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	hooks := &mockBankHooks{}
+	suite.bankKeeper.SetHooks(banktypes.NewMultiBankHooks(hooks))
+	require.Panics(func() { suite.bankKeeper.SetHooks(hooks) })
+	require.NoError(suite.bankKeeper.RegisterBurner(accAddrs[0]))
+
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2Str, err := suite.addressCodec.BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	require.NoError(suite.bankKeeper.MintCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[0], []banktypes.Output{
+		banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(5))),
+		banktypes.NewOutput(acc2Str, sdk.NewCoins(newFooCoin(20))),
+		banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(5))),
+	}))
+	require.NoError(suite.bankKeeper.BurnCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(30))))
+
+	require.Equal([]sdk.Coins{sdk.NewCoins(newFooCoin(100))}, hooks.mints)
+	require.Equal([]sdk.Coins{sdk.NewCoins(newFooCoin(10)), sdk.NewCoins(newFooCoin(10)), sdk.NewCoins(newFooCoin(20))}, hooks.sends)
+	require.Equal([]sdk.Coins{sdk.NewCoins(newFooCoin(30))}, hooks.burns)
+
+	// a hook error fails the operation
+	hooks.err = errors.New("hook failure")
+	require.Error(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+}
+
+func (suite *KeeperTestSuite) TestSendEnabled() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	authority, err := suite.addressCodec.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(err)
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	// sends are enabled by default
+	require.True(suite.bankKeeper.IsSendEnabledDenom(ctx, fooDenom))
+	require.NoError(suite.bankKeeper.IsSendEnabledCoins(ctx, balances...))
+
+	// invalid params are rejected
+	_, err = handlers.MsgUpdateParams(ctx, &banktypes.MsgUpdateParams{
+		Authority: authority,
+		Params:    banktypes.NewParams(true, banktypes.NewSendEnabled(fooDenom, false), banktypes.NewSendEnabled(fooDenom, true)),
+	})
+	require.Error(err)
+
+	// disable sends of foo only
+	_, err = handlers.MsgUpdateParams(ctx, &banktypes.MsgUpdateParams{
+		Authority: authority,
+		Params:    banktypes.NewParams(true, banktypes.NewSendEnabled(fooDenom, false)),
+	})
+	require.NoError(err)
+	require.False(suite.bankKeeper.IsSendEnabledDenom(ctx, fooDenom))
+	require.True(suite.bankKeeper.IsSendEnabledDenom(ctx, barDenom))
+
+	_, err = handlers.MsgSend(ctx, &banktypes.MsgSend{FromAddress: acc0Str, ToAddress: acc1Str, Amount: sdk.NewCoins(newFooCoin(10))})
+	require.ErrorIs(err, banktypes.ErrSendDisabled)
+	_, err = handlers.MsgMultiSend(ctx, banktypes.NewMsgMultiSend(acc0Str, []banktypes.Output{
+		banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(10), newBarCoin(10))),
+	}))
+	require.ErrorIs(err, banktypes.ErrSendDisabled)
+	_, err = handlers.MsgSend(ctx, &banktypes.MsgSend{FromAddress: acc0Str, ToAddress: acc1Str, Amount: sdk.NewCoins(newBarCoin(10))})
+	require.NoError(err)
+
+	// disable all sends but foo
+	_, err = handlers.MsgUpdateParams(ctx, &banktypes.MsgUpdateParams{
+		Authority: authority,
+		Params:    banktypes.NewParams(false, banktypes.NewSendEnabled(fooDenom, true)),
+	})
+	require.NoError(err)
+
+	_, err = handlers.MsgSend(ctx, &banktypes.MsgSend{FromAddress: acc0Str, ToAddress: acc1Str, Amount: sdk.NewCoins(newBarCoin(10))})
+	require.ErrorIs(err, banktypes.ErrSendDisabled)
+	_, err = handlers.MsgSend(ctx, &banktypes.MsgSend{FromAddress: acc0Str, ToAddress: acc1Str, Amount: sdk.NewCoins(newFooCoin(10))})
+	require.NoError(err)
+
+	require.Equal(math.NewInt(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).Amount)
+	require.Equal(math.NewInt(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], barDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestFactoryDenoms() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	// Try create invalid denoms
+	_, err = handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, ""))
+	require.Error(err)
+	_, err = handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "in valid"))
+	require.Error(err)
+
+	res, err := handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "token"))
+	require.NoError(err)
+	denom := res.NewTokenDenom
+	require.Equal("factory/"+acc0Str+"/token", denom)
+	_, err = handlers.MsgCreateDenom(ctx, banktypes.NewMsgCreateDenom(acc0Str, "token"))
+	require.ErrorIs(err, banktypes.ErrDenomExists)
+
+	creatorRes, err := handlers.QueryDenomsFromCreator(ctx, &banktypes.QueryDenomsFromCreatorRequest{Creator: acc0Str})
+	require.NoError(err)
+	require.Equal([]string{denom}, creatorRes.Denoms)
+	creatorRes, err = handlers.QueryDenomsFromCreator(ctx, &banktypes.QueryDenomsFromCreatorRequest{Creator: acc1Str})
+	require.NoError(err)
+	require.Empty(creatorRes.Denoms)
+
+	// Only the admin can mint and burn
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	_, err = handlers.MsgMintDenom(ctx, banktypes.NewMsgMintDenom(acc1Str, acc1Str, amount))
+	require.ErrorIs(err, banktypes.ErrNotDenomAdmin)
+	_, err = handlers.MsgMintDenom(ctx, banktypes.NewMsgMintDenom(acc0Str, acc0Str, sdk.NewCoins(newFooCoin(100))))
+	require.ErrorIs(err, banktypes.ErrNotDenomAdmin)
+	_, err = handlers.MsgMintDenom(ctx, banktypes.NewMsgMintDenom(acc0Str, acc0Str, amount))
+	require.NoError(err)
+
+	_, err = handlers.MsgBurnDenom(ctx, banktypes.NewMsgBurnDenom(acc0Str, sdk.NewCoins(sdk.NewInt64Coin(denom, 30))))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 70), suite.bankKeeper.GetBalance(ctx, accAddrs[0], denom))
+	require.Equal(sdk.NewInt64Coin(denom, 70), suite.bankKeeper.GetSupply(ctx, denom))
+
+	// The admin can set the metadata
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       "Token",
+		Symbol:     "TKN",
+	}
+	_, err = handlers.MsgSetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Authority: acc1Str, Metadata: metadata})
+	require.Error(err)
+	_, err = handlers.MsgSetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Authority: acc0Str, Metadata: metadata})
+	require.NoError(err)
+
+	// Change the admin
+	_, err = handlers.MsgChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(acc1Str, denom, acc1Str))
+	require.ErrorIs(err, banktypes.ErrNotDenomAdmin)
+	_, err = handlers.MsgChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(acc0Str, denom, acc1Str))
+	require.NoError(err)
+	adminRes, err := handlers.QueryDenomAdmin(ctx, &banktypes.QueryDenomAdminRequest{Denom: denom})
+	require.NoError(err)
+	require.Equal(acc1Str, adminRes.Admin)
+
+	_, err = handlers.MsgMintDenom(ctx, banktypes.NewMsgMintDenom(acc0Str, acc0Str, amount))
+	require.ErrorIs(err, banktypes.ErrNotDenomAdmin)
+	_, err = handlers.MsgMintDenom(ctx, banktypes.NewMsgMintDenom(acc1Str, acc1Str, amount))
+	require.NoError(err)
+
+	// The factory denoms are exported and imported with the genesis
+	genState, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.NoError(genState.Validate())
+	require.Equal([]banktypes.FactoryDenom{{Denom: denom, Admin: acc1Str}}, genState.FactoryDenoms)
+
+	// Renounce the admin
+	_, err = handlers.MsgChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(acc1Str, denom, ""))
+	require.NoError(err)
+	adminRes, err = handlers.QueryDenomAdmin(ctx, &banktypes.QueryDenomAdminRequest{Denom: denom})
+	require.NoError(err)
+	require.Empty(adminRes.Admin)
+	_, err = handlers.MsgMintDenom(ctx, banktypes.NewMsgMintDenom(acc1Str, acc1Str, amount))
+	require.ErrorIs(err, banktypes.ErrNotDenomAdmin)
+
+	_, err = handlers.QueryDenomAdmin(ctx, &banktypes.QueryDenomAdminRequest{Denom: fooDenom})
+	require.Error(err)
+
+	suite.SetupTest()
+	require.NoError(suite.bankKeeper.InitGenesis(suite.ctx, genState))
+	admin, found := suite.bankKeeper.GetDenomAdmin(suite.ctx, denom)
+	require.True(found)
+	require.Equal([]byte(accAddrs[1]), admin)
+}
+
+func (suite *KeeperTestSuite) TestBalanceSnapshot() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	// the balances are ordered by address then denom
+	var csvOut bytes.Buffer
+	require.NoError(suite.bankKeeper.ExportBalanceSnapshot(ctx, &csvOut, banktypes.SnapshotFormatCSV))
+	require.Equal(fmt.Sprintf("address,denom,amount\n%[1]s,bar,50\n%[1]s,foo,100\n%[2]s,foo,10\n", acc0Str, acc1Str), csvOut.String())
+
+	var jsonOut bytes.Buffer
+	require.NoError(suite.bankKeeper.ExportBalanceSnapshot(ctx, &jsonOut, banktypes.SnapshotFormatJSON))
+	require.JSONEq(fmt.Sprintf(`[
+		{"address": %[1]q, "denom": "bar", "amount": "50"},
+		{"address": %[1]q, "denom": "foo", "amount": "100"},
+		{"address": %[2]q, "denom": "foo", "amount": "10"}
+	]`, acc0Str, acc1Str), jsonOut.String())
+
+	require.Error(suite.bankKeeper.ExportBalanceSnapshot(ctx, &bytes.Buffer{}, "xml"))
+
+	res, err := handlers.QueryAllBalances(ctx, &banktypes.QueryAllBalancesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(err)
+	require.Equal([]banktypes.DenomOwner{
+		{Address: acc0Str, Balance: newBarCoin(50)},
+		{Address: acc0Str, Balance: newFooCoin(100)},
+	}, res.Balances)
+
+	res, err = handlers.QueryAllBalances(ctx, &banktypes.QueryAllBalancesRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(err)
+	require.Equal([]banktypes.DenomOwner{{Address: acc1Str, Balance: newFooCoin(10)}}, res.Balances)
+}
+
+func (suite *KeeperTestSuite) TestAtomicSwap() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newBarCoin(50))))
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	msg := banktypes.NewMsgAtomicSwap([]banktypes.SwapTransfer{
+		banktypes.NewSwapTransfer(acc0Str, acc1Str, sdk.NewCoins(newFooCoin(100))),
+		banktypes.NewSwapTransfer(acc1Str, acc0Str, sdk.NewCoins(newBarCoin(50))),
+	})
+
+	// all the parties must sign the swap
+	signers, _, err := encCfg.Codec.GetMsgSigners(msg)
+	require.NoError(err)
+	require.Equal([][]byte{accAddrs[0], accAddrs[1]}, signers)
+
+	_, err = handlers.MsgAtomicSwap(ctx, msg)
+	require.NoError(err)
+	require.Equal(newBarCoin(50), suite.bankKeeper.GetBalance(ctx, accAddrs[0], barDenom))
+	require.True(suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).IsZero())
+	require.Equal(newFooCoin(100), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+	require.True(suite.bankKeeper.GetBalance(ctx, accAddrs[1], barDenom).IsZero())
+
+	// the swap fails if any transfer fails
+	_, err = handlers.MsgAtomicSwap(ctx, msg)
+	require.Error(err)
+
+	// the swap must have at least two parties
+	_, err = handlers.MsgAtomicSwap(ctx, banktypes.NewMsgAtomicSwap([]banktypes.SwapTransfer{
+		banktypes.NewSwapTransfer(acc1Str, acc0Str, sdk.NewCoins(newFooCoin(10))),
+		banktypes.NewSwapTransfer(acc1Str, acc0Str, sdk.NewCoins(newFooCoin(10))),
+	}))
+	require.ErrorContains(err, "at least two parties")
+}
+
+func (suite *KeeperTestSuite) TestModuleCodec() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	moduleCodec, err := suite.bankKeeper.ModuleCodec()
+	require.NoError(err)
+
+	balancesType, found := moduleCodec.Schema.LookupStateObjectType("balances")
+	require.True(found)
+	require.Len(balancesType.KeyFields, 2)
+	require.Equal("address", balancesType.KeyFields[0].Name)
+	require.Equal("denom", balancesType.KeyFields[1].Name)
+
+	supplyType, found := moduleCodec.Schema.LookupStateObjectType("supply")
+	require.True(found)
+	require.Equal("denom", supplyType.KeyFields[0].Name)
+
+	// the balances are decoded by the indexer
+	key, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, collections.PairKeyCodec(collections.BytesKey, collections.StringKey), collections.Join([]byte(accAddrs[0]), fooDenom))
+	require.NoError(err)
+	value, err := sdk.IntValue.Encode(math.NewInt(100))
+	require.NoError(err)
+	updates, err := moduleCodec.KVDecoder(schema.KVPairUpdate{Key: key, Value: value})
+	require.NoError(err)
+	require.Len(updates, 1)
+	require.Equal("balances", updates[0].TypeName)
+	require.Equal([]interface{}{[]byte(accAddrs[0]), fooDenom}, updates[0].Key)
+
+	// typed events are emitted for sends and denom metadata updates
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.SetDenomMetadata(ctx, banktypes.Metadata{
+		Base:       fooDenom,
+		Display:    fooDenom,
+		Name:       "Foo",
+		Symbol:     "FOO",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: fooDenom}},
+	}))
+
+	var eventTypes []string
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Contains(eventTypes, "cosmos.bank.v2.EventSend")
+	require.Contains(eventTypes, "cosmos.bank.v2.EventSetDenomMetadata")
+}
+
+func (suite *KeeperTestSuite) TestSuppliesOf() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	// the supplies are returned in the order of the request, unknown denoms have a zero supply
+	res, err := handlers.QuerySuppliesOf(ctx, &banktypes.QuerySuppliesOfRequest{Denoms: []string{fooDenom, "baz", barDenom}})
+	require.NoError(err)
+	require.Equal([]sdk.Coin{newFooCoin(100), sdk.NewInt64Coin("baz", 0), newBarCoin(50)}, res.Supplies)
+	require.Nil(res.Pagination.NextKey)
+
+	// the denoms are paginated
+	req := &banktypes.QuerySuppliesOfRequest{
+		Denoms:     []string{fooDenom, "baz", barDenom},
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	}
+	res, err = handlers.QuerySuppliesOf(ctx, req)
+	require.NoError(err)
+	require.Equal([]sdk.Coin{newFooCoin(100), sdk.NewInt64Coin("baz", 0)}, res.Supplies)
+	require.Equal([]byte(barDenom), res.Pagination.NextKey)
+	require.Equal(uint64(3), res.Pagination.Total)
+
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	res, err = handlers.QuerySuppliesOf(ctx, req)
+	require.NoError(err)
+	require.Equal([]sdk.Coin{newBarCoin(50)}, res.Supplies)
+	require.Nil(res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Offset: 1}
+	res, err = handlers.QuerySuppliesOf(ctx, req)
+	require.NoError(err)
+	require.Equal([]sdk.Coin{sdk.NewInt64Coin("baz", 0), newBarCoin(50)}, res.Supplies)
+
+	_, err = handlers.QuerySuppliesOf(ctx, &banktypes.QuerySuppliesOfRequest{Denoms: []string{"!"}})
+	require.Error(err)
+
+	req.Pagination = &query.PageRequest{Key: []byte("unknown")}
+	_, err = handlers.QuerySuppliesOf(ctx, req)
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestSweepDust() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	authority, err := suite.addressCodec.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(err)
+	collector := authtypes.NewModuleAddress("protocolpool")
+	collectorStr, err := suite.addressCodec.BytesToString(collector)
+	require.NoError(err)
+
+	params := banktypes.DefaultParams()
+	params.DustThresholds = sdk.NewCoins(newFooCoin(10))
+	params.DustCollector = collectorStr
+	_, err = handlers.MsgUpdateParams(ctx, &banktypes.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(err)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(5))))
+
+	// balances above the threshold are kept
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(80))))
+	require.Equal(newFooCoin(20), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+
+	// balances falling below the threshold are swept to the collector
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(15))))
+	require.True(suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).IsZero())
+	require.Equal(newFooCoin(5), suite.bankKeeper.GetBalance(ctx, collector, fooDenom))
+	require.Equal(newFooCoin(95), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+
+	// the denoms without a threshold are never swept
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(4))))
+	require.Equal(newBarCoin(1), suite.bankKeeper.GetBalance(ctx, accAddrs[0], barDenom))
+
+	// the sender of a multi send is swept too
+	acc2Str, err := suite.addressCodec.BytesToString(accAddrs[2])
+	require.NoError(err)
+	require.NoError(suite.bankKeeper.MultiSendCoins(ctx, accAddrs[1], []banktypes.Output{
+		banktypes.NewOutput(acc2Str, sdk.NewCoins(newFooCoin(90))),
+	}))
+	require.True(suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).IsZero())
+	require.Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, collector, fooDenom))
+
+	var eventTypes []string
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Contains(eventTypes, "cosmos.bank.v2.EventSweepDust")
+
+	// the dust collector must be a valid address
+	params.DustCollector = "invalid"
+	_, err = handlers.MsgUpdateParams(ctx, &banktypes.MsgUpdateParams{Authority: authority, Params: params})
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	authority, err := suite.addressCodec.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(err)
+	_, err = handlers.MsgUpdateParams(ctx, &banktypes.MsgUpdateParams{Authority: authority, Params: banktypes.DefaultParams()})
+	require.NoError(err)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	// the balances and supply are exported in a deterministic order
+	genState, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.NoError(genState.Validate())
+	require.Equal([]banktypes.Balance{
+		{Address: acc0Str, Coins: sdk.NewCoins(newBarCoin(50), newFooCoin(100))},
+		{Address: acc1Str, Coins: sdk.NewCoins(newFooCoin(10))},
+	}, genState.Balances)
+	require.Equal(sdk.NewCoins(newBarCoin(50), newFooCoin(110)), genState.Supply)
+
+	suite.SetupTest()
+	require.NoError(suite.bankKeeper.InitGenesis(suite.ctx, genState))
+	require.NoError(suite.bankKeeper.AssertTotalSupply(suite.ctx))
+	exported, err := suite.bankKeeper.ExportGenesis(suite.ctx)
+	require.NoError(err)
+	require.Equal(genState, exported)
+
+	// the imported supply must equal the sum of the balances
+	invalid := *genState
+	invalid.Supply = sdk.NewCoins(newBarCoin(50), newFooCoin(100))
+	expErr := "genesis supply is incorrect, expected " + genState.Supply.String() + ", got " + invalid.Supply.String()
+	require.EqualError(invalid.Validate(), expErr)
+	suite.SetupTest()
+	require.EqualError(suite.bankKeeper.InitGenesis(suite.ctx, &invalid), expErr)
+
+	invalid = *genState
+	invalid.Balances = append(invalid.Balances, banktypes.Balance{Address: acc0Str, Coins: sdk.NewCoins(newFooCoin(1))})
+	invalid.Supply = nil
+	require.ErrorContains(invalid.Validate(), "duplicate balance")
+}
+
+func (suite *KeeperTestSuite) TestSendMemo() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	_, err = handlers.MsgSend(ctx, banktypes.NewMsgSendWithMemo(acc0Str, acc1Str, sdk.NewCoins(newFooCoin(10)), "invoice-42"))
+	require.NoError(err)
+	require.Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+
+	// the memo is emitted in the transfer events
+	var transferMemo, sendMemo string
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().Events() {
+		switch event.Type {
+		case banktypes.EventTypeTransfer:
+			if attr, ok := event.GetAttribute(banktypes.AttributeKeyMemo); ok {
+				transferMemo = attr.Value
+			}
+		case "cosmos.bank.v2.EventSend":
+			if attr, ok := event.GetAttribute("memo"); ok {
+				sendMemo = attr.Value
+			}
+		}
+	}
+	require.Equal("invoice-42", transferMemo)
+	require.Equal(`"invoice-42"`, sendMemo)
+
+	// the memo length is limited
+	longMemo := strings.Repeat("a", banktypes.MaxTransferMemoLength+1)
+	_, err = handlers.MsgSend(ctx, banktypes.NewMsgSendWithMemo(acc0Str, acc1Str, sdk.NewCoins(newFooCoin(10)), longMemo))
+	require.Error(err)
+	require.Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+}
+
+func (suite *KeeperTestSuite) TestMigrateFromV1() {
+	require := suite.Require()
+
+	v1Key := storetypes.NewKVStoreKey(v1types.StoreKey)
+	v2Key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{v1types.StoreKey: v1Key, banktypes.StoreKey: v2Key},
+		map[string]*storetypes.TransientStoreKey{"transient_test": storetypes.NewTransientStoreKey("transient_test")},
+		nil,
+	)
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(v2Key), coretesting.NewNopLogger())
+	k := keeper.NewKeeper(authtypes.NewModuleAddress("gov"), suite.addressCodec, env, encCfg.Codec)
+	v1StoreService := runtime.NewKVStoreService(v1Key)
+
+	// write the legacy state
+	sb := collections.NewSchemaBuilder(v1StoreService)
+	v1Balances := collections.NewMap(sb, v1types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), v1types.BalanceValueCodec)
+	v1Supply := collections.NewMap(sb, v1types.SupplyKey, "supply", collections.StringKey, sdk.IntValue)
+	v1DenomMetadata := collections.NewMap(sb, v1types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[v1types.Metadata](encCfg.Codec))
+	_, err := sb.Build()
+	require.NoError(err)
+
+	require.NoError(v1Balances.Set(ctx, collections.Join(accAddrs[0], fooDenom), math.NewInt(100)))
+	require.NoError(v1Balances.Set(ctx, collections.Join(accAddrs[1], fooDenom), math.NewInt(10)))
+	require.NoError(v1Balances.Set(ctx, collections.Join(accAddrs[1], barDenom), math.NewInt(50)))
+	require.NoError(v1Supply.Set(ctx, fooDenom, math.NewInt(110)))
+	require.NoError(v1Supply.Set(ctx, barDenom, math.NewInt(50)))
+	v1Metadata := v1types.Metadata{
+		DenomUnits: []*v1types.DenomUnit{{Denom: fooDenom}},
+		Base:       fooDenom,
+		Display:    fooDenom,
+		Name:       "Foo",
+		Symbol:     "FOO",
+	}
+	require.NoError(v1DenomMetadata.Set(ctx, fooDenom, v1Metadata))
+
+	// the migration is rejected if the legacy supply doesn't match the legacy balances, before writing anything
+	require.NoError(v1Supply.Set(ctx, fooDenom, math.NewInt(111)))
+	require.Error(k.MigrateFromV1(ctx, v1StoreService))
+	require.True(k.GetBalance(ctx, accAddrs[0], fooDenom).IsZero())
+	require.True(k.GetSupply(ctx, fooDenom).IsZero())
+	_, found := k.GetDenomMetadata(ctx, fooDenom)
+	require.False(found)
+	require.NoError(v1Supply.Set(ctx, fooDenom, math.NewInt(110)))
+
+	// the existing bank/v2 state is kept
+	require.NoError(banktestutil.FundAccount(ctx, *k, accAddrs[0], sdk.NewCoins(newFooCoin(5))))
+
+	require.NoError(k.MigrateFromV1(ctx, v1StoreService))
+	require.Equal(newFooCoin(105), k.GetBalance(ctx, accAddrs[0], fooDenom))
+	require.Equal(newFooCoin(10), k.GetBalance(ctx, accAddrs[1], fooDenom))
+	require.Equal(newBarCoin(50), k.GetBalance(ctx, accAddrs[1], barDenom))
+	require.Equal(newFooCoin(115), k.GetSupply(ctx, fooDenom))
+	require.Equal(newBarCoin(50), k.GetSupply(ctx, barDenom))
+	require.NoError(k.AssertTotalSupply(ctx))
+
+	metadata, found := k.GetDenomMetadata(ctx, fooDenom)
+	require.True(found)
+	require.Equal("Foo", metadata.Name)
+	require.Equal(fooDenom, metadata.DenomUnits[0].Denom)
+
+	// the legacy state is deleted
+	iter, err := v1StoreService.OpenKVStore(ctx).Iterator(nil, nil)
+	require.NoError(err)
+	defer iter.Close()
+	require.False(iter.Valid())
+}
+
+func (suite *KeeperTestSuite) TestBatchMintCoins() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addressCodec.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	require.NoError(suite.bankKeeper.BatchMintCoins(ctx, []banktypes.Output{
+		banktypes.NewOutput(acc0Str, sdk.NewCoins(newFooCoin(100), newBarCoin(50))),
+		banktypes.NewOutput(acc1Str, sdk.NewCoins(newFooCoin(10))),
+	}))
+	require.Equal(newFooCoin(100), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+	require.Equal(newBarCoin(50), suite.bankKeeper.GetBalance(ctx, accAddrs[0], barDenom))
+	require.Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+	require.Equal(newFooCoin(110), suite.bankKeeper.GetSupply(ctx, fooDenom))
+
+	// nothing is minted if any output is invalid
+	require.Error(suite.bankKeeper.BatchMintCoins(ctx, []banktypes.Output{
+		banktypes.NewOutput(acc0Str, sdk.NewCoins(newFooCoin(100))),
+		banktypes.NewOutput("invalid", sdk.NewCoins(newFooCoin(10))),
+	}))
+	require.Equal(newFooCoin(110), suite.bankKeeper.GetSupply(ctx, fooDenom))
+	require.Error(suite.bankKeeper.BatchMintCoins(ctx, nil))
+
+	// the test helpers fund many accounts and module accounts
+	require.NoError(banktestutil.FundAccounts(ctx, suite.bankKeeper, [][]byte{accAddrs[2], accAddrs[3]}, sdk.NewCoins(newBarCoin(5))))
+	require.Equal(newBarCoin(5), suite.bankKeeper.GetBalance(ctx, accAddrs[2], barDenom))
+	require.Equal(newBarCoin(5), suite.bankKeeper.GetBalance(ctx, accAddrs[3], barDenom))
+
+	require.Error(banktestutil.FundModuleAccount(ctx, suite.bankKeeper, "faucet", sdk.NewCoins(newFooCoin(1))))
+	faucetAddr, err := suite.bankKeeper.RegisterModuleAccount("faucet")
+	require.NoError(err)
+	require.NoError(banktestutil.FundModuleAccount(ctx, suite.bankKeeper, "faucet", sdk.NewCoins(newFooCoin(1))))
+	require.Equal(newFooCoin(1), suite.bankKeeper.GetBalance(ctx, faucetAddr, fooDenom))
+}
+
+func (suite *KeeperTestSuite) TestHolds() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	acc0Str, err := suite.addressCodec.BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	require.Error(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "", sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "market", sdk.NewCoins(newFooCoin(40))))
+	require.NoError(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "market", sdk.NewCoins(newFooCoin(20), newBarCoin(10))))
+	require.NoError(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "escrow", sdk.NewCoins(newFooCoin(10))))
+
+	// the coins on hold stay in the balance but cannot be spent, nor held twice
+	require.Equal(newFooCoin(100), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+	spendable, err := suite.bankKeeper.SpendableCoins(ctx, accAddrs[0])
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(40)), spendable)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(31))), sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "escrow", sdk.NewCoins(newFooCoin(31))), sdkerrors.ErrInsufficientFunds)
+
+	res, err := handlers.QueryHolds(ctx, &banktypes.QueryHoldsRequest{Address: acc0Str})
+	require.NoError(err)
+	require.Equal([]banktypes.Hold{
+		{Address: acc0Str, Name: "escrow", Amount: sdk.NewCoins(newFooCoin(10))},
+		{Address: acc0Str, Name: "market", Amount: sdk.NewCoins(newBarCoin(10), newFooCoin(60))},
+	}, res.Holds)
+
+	// the holds are part of the genesis
+	genState, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.NoError(genState.Validate())
+	require.Equal(res.Holds, genState.Holds)
+
+	invalid := *genState
+	invalid.Holds = append(invalid.Holds, banktypes.Hold{Address: acc0Str, Name: "other", Amount: sdk.NewCoins(newFooCoin(31))})
+	require.ErrorContains(invalid.Validate(), "exceed the balance")
+
+	// releasing more than the coins on hold fails
+	require.ErrorIs(suite.bankKeeper.ReleaseCoins(ctx, accAddrs[0], "market", sdk.NewCoins(newFooCoin(61))), banktypes.ErrInsufficientHold)
+	require.ErrorIs(suite.bankKeeper.ReleaseCoins(ctx, accAddrs[0], "unknown", sdk.NewCoins(newFooCoin(1))), banktypes.ErrInsufficientHold)
+
+	require.NoError(suite.bankKeeper.ReleaseCoins(ctx, accAddrs[0], "market", sdk.NewCoins(newFooCoin(60), newBarCoin(10))))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(90))))
+
+	holds, err := suite.bankKeeper.GetHolds(ctx, accAddrs[0])
+	require.NoError(err)
+	require.Equal([]banktypes.Hold{{Address: acc0Str, Name: "escrow", Amount: sdk.NewCoins(newFooCoin(10))}}, holds)
+}
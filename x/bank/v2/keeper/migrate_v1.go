@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	v1types "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateFromV1 moves the balances, supply and denom metadata of the legacy x/bank module into the
+// bank/v2 state, e.g. in an upgrade handler. The legacy state is read from the store of x/bank given
+// by v1StoreService, and added to the existing bank/v2 state.
+// Before writing anything, it verifies that the legacy supply equals the sum of the legacy balances,
+// and returns an error otherwise, leaving both states untouched. Once migrated, it verifies that the
+// bank/v2 supply equals the sum of the bank/v2 balances before deleting the legacy state. If it
+// doesn't, an error is returned after the bank/v2 state has been written, so callers must discard
+// the state of ctx on error, as upgrade handlers do.
+func (k Keeper) MigrateFromV1(ctx context.Context, v1StoreService store.KVStoreService) error {
+	sb := collections.NewSchemaBuilder(v1StoreService)
+	v1Balances := collections.NewMap(sb, v1types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), v1types.BalanceValueCodec)
+	v1DenomAddressIndex := collections.NewMap(sb, v1types.DenomAddressPrefix, "address_by_denom_index", collections.PairKeyCodec(collections.StringKey, collections.BytesKey), collections.NoValue{})
+	v1Supply := collections.NewMap(sb, v1types.SupplyKey, "supply", collections.StringKey, sdk.IntValue)
+	// the legacy metadata is wire compatible with the bank/v2 metadata
+	v1DenomMetadata := collections.NewMap(sb, v1types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, k.denomMetadata.ValueCodec())
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	// verify the legacy totals before writing anything
+	balancesTotal := sdk.NewMapCoins(sdk.Coins{})
+	err := v1Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+		balancesTotal.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read legacy balances: %w", err)
+	}
+
+	supplyTotal := sdk.NewCoins()
+	err = v1Supply.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		supplyTotal = supplyTotal.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read legacy supply: %w", err)
+	}
+
+	if sum := balancesTotal.ToCoins(); !sum.Equal(supplyTotal) {
+		return fmt.Errorf("legacy total supply %s doesn't match the sum of the legacy balances %s", supplyTotal, sum)
+	}
+
+	err = v1Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+		balance := k.GetBalance(ctx, key.K1(), key.K2())
+		if err := k.setBalance(ctx, key.K1(), balance.AddAmount(amount)); err != nil {
+			return true, err
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate balances: %w", err)
+	}
+
+	err = v1Supply.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		k.setSupply(ctx, k.GetSupply(ctx, denom).AddAmount(amount))
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate supply: %w", err)
+	}
+
+	err = v1DenomMetadata.Walk(ctx, nil, func(denom string, metadata types.Metadata) (bool, error) {
+		if err := k.denomMetadata.Set(ctx, denom, metadata); err != nil {
+			return true, err
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate denom metadata: %w", err)
+	}
+
+	// verify the migrated state before deleting the legacy state
+	if err := k.AssertTotalSupply(ctx); err != nil {
+		return fmt.Errorf("migrated state is invalid: %w", err)
+	}
+
+	if err := v1Balances.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := v1DenomAddressIndex.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := v1Supply.Clear(ctx, nil); err != nil {
+		return err
+	}
+	return v1DenomMetadata.Clear(ctx, nil)
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RegisterModuleAccount registers the account of the given module, so that the module can send and
+// receive coins by name. It returns the address of the module account, derived from the module name.
+// It is meant to be called when wiring the app, an error is returned if the module is already registered.
+func (k Keeper) RegisterModuleAccount(moduleName string) ([]byte, error) {
+	if strings.TrimSpace(moduleName) == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "module name cannot be blank")
+	}
+	if _, ok := k.moduleAccounts[moduleName]; ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "module account %s is already registered", moduleName)
+	}
+
+	addr := address.Module(moduleName)
+	k.moduleAccounts[moduleName] = addr
+	return addr, nil
+}
+
+// GetModuleAddress returns the address of the account of the given module, and false if the module
+// account is not registered.
+func (k Keeper) GetModuleAddress(moduleName string) ([]byte, bool) {
+	addr, ok := k.moduleAccounts[moduleName]
+	return addr, ok
+}
+
+// RegisterBurner grants the given account the permission to burn its coins with BurnCoins.
+// It is meant to be called when wiring the app.
+func (k Keeper) RegisterBurner(addr []byte) error {
+	if len(addr) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "burner address cannot be empty")
+	}
+
+	k.burners[string(addr)] = struct{}{}
+	return nil
+}
+
+// HasBurnPermission returns true if the given account is allowed to burn its coins.
+func (k Keeper) HasBurnPermission(addr []byte) bool {
+	_, ok := k.burners[string(addr)]
+	return ok
+}
+
+// BurnCoinsFromModule burns coins from a module account.
+// An error is returned if the module account is not registered or has no burn permission.
+func (k Keeper) BurnCoinsFromModule(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	addr, err := k.moduleAddress(moduleName)
+	if err != nil {
+		return err
+	}
+
+	return k.BurnCoins(ctx, addr, amounts)
+}
+
+// BlockAddress prevents the given account from receiving funds, except from the module transfers
+// to module accounts. It is meant to be called when wiring the app.
+func (k Keeper) BlockAddress(addr []byte) error {
+	if len(addr) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "blocked address cannot be empty")
+	}
+
+	k.blockedAddrs[string(addr)] = struct{}{}
+	return nil
+}
+
+// IsBlockedAddress returns true if the given account is not allowed to receive funds.
+func (k Keeper) IsBlockedAddress(addr []byte) bool {
+	_, ok := k.blockedAddrs[string(addr)]
+	return ok
+}
+
+// GetBlockedAddresses returns the accounts which are not allowed to receive funds, sorted by address.
+func (k Keeper) GetBlockedAddresses() [][]byte {
+	addrs := make([][]byte, 0, len(k.blockedAddrs))
+	for addr := range k.blockedAddrs {
+		addrs = append(addrs, []byte(addr))
+	}
+	slices.SortFunc(addrs, bytes.Compare)
+	return addrs
+}
+
+// checkBlockedAddress returns an error if the given account is not allowed to receive funds.
+func (k Keeper) checkBlockedAddress(addr []byte) error {
+	if !k.IsBlockedAddress(addr) {
+		return nil
+	}
+
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+	return errorsmod.Wrapf(types.ErrBlockedAddress, "%s is not allowed to receive funds", addrStr)
+}
+
+// SendCoinsFromModuleToAccount transfers coins from a module account to an account.
+// An error is returned if the module account is not registered or the recipient is a blocked address.
+func (k Keeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr []byte, amt sdk.Coins) error {
+	senderAddr, err := k.moduleAddress(senderModule)
+	if err != nil {
+		return err
+	}
+
+	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+}
+
+// SendCoinsFromAccountToModule transfers coins from an account to a module account, which can be
+// a blocked address. An error is returned if the module account is not registered.
+func (k Keeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr []byte, recipientModule string, amt sdk.Coins) error {
+	recipientAddr, err := k.moduleAddress(recipientModule)
+	if err != nil {
+		return err
+	}
+
+	return k.sendCoins(ctx, senderAddr, recipientAddr, amt, "", true)
+}
+
+// SendCoinsFromModuleToModule transfers coins from a module account to another, which can be
+// a blocked address. An error is returned if any of the module accounts is not registered.
+func (k Keeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	senderAddr, err := k.moduleAddress(senderModule)
+	if err != nil {
+		return err
+	}
+
+	recipientAddr, err := k.moduleAddress(recipientModule)
+	if err != nil {
+		return err
+	}
+
+	return k.sendCoins(ctx, senderAddr, recipientAddr, amt, "", true)
+}
+
+// moduleAddress returns the address of a registered module account.
+func (k Keeper) moduleAddress(moduleName string) ([]byte, error) {
+	addr, ok := k.moduleAccounts[moduleName]
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName)
+	}
+	return addr, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/registry"
+	"cosmossdk.io/schema"
 	"cosmossdk.io/x/bank/v2/client/cli"
 	"cosmossdk.io/x/bank/v2/keeper"
 	"cosmossdk.io/x/bank/v2/types"
@@ -25,6 +26,7 @@ var (
 	_ appmodulev2.HasRegisterInterfaces = AppModule{}
 	_ appmodulev2.HasQueryHandlers      = AppModule{}
 	_ appmodulev2.HasMsgHandlers        = AppModule{}
+	_ schema.HasModuleCodec             = AppModule{}
 )
 
 // AppModule implements an application module for the bank module.
@@ -97,7 +99,14 @@ func (am AppModule) RegisterMsgHandlers(router appmodulev2.MsgRouter) {
 
 	appmodulev2.RegisterMsgHandler(router, handlers.MsgUpdateParams)
 	appmodulev2.RegisterMsgHandler(router, handlers.MsgSend)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgMultiSend)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgAtomicSwap)
 	appmodulev2.RegisterMsgHandler(router, handlers.MsgMint)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgSetDenomMetadata)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgCreateDenom)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgMintDenom)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgBurnDenom)
+	appmodulev2.RegisterMsgHandler(router, handlers.MsgChangeDenomAdmin)
 }
 
 // RegisterQueryHandlers registers the query handlers for the bank module.
@@ -106,6 +115,18 @@ func (am AppModule) RegisterQueryHandlers(router appmodulev2.QueryRouter) {
 
 	appmodulev2.RegisterMsgHandler(router, handlers.QueryParams)
 	appmodulev2.RegisterMsgHandler(router, handlers.QueryBalance)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryDenomMetadata)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryDenomsMetadata)
+	appmodulev2.RegisterMsgHandler(router, handlers.QuerySpendableBalances)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryDenomOwners)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryTotalSupply)
+	appmodulev2.RegisterMsgHandler(router, handlers.QuerySupplyOf)
+	appmodulev2.RegisterMsgHandler(router, handlers.QuerySuppliesOf)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryBlockedAddresses)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryDenomAdmin)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryDenomsFromCreator)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryAllBalances)
+	appmodulev2.RegisterMsgHandler(router, handlers.QueryHolds)
 }
 
 // GetTxCmd returns the root tx command for the bank/v2 module.
@@ -119,3 +140,9 @@ func (AppModule) GetTxCmd() *cobra.Command {
 func (AppModule) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
+
+// ModuleCodec implements `schema.HasModuleCodec` interface.
+// It allows the indexer to decode the module's KVPairUpdate.
+func (am AppModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return am.keeper.ModuleCodec()
+}
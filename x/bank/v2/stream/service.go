@@ -0,0 +1,268 @@
+package stream
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBufferSize is the number of blocks buffered for a subscriber before it is dropped for
+// being too slow.
+const DefaultBufferSize = 100
+
+var _ types.StreamServer = (*Service)(nil)
+
+// Service serves the Stream gRPC service, which streams the balance changes of the accounts to the
+// subscribers as blocks are committed. It is fed with the coin_spent and coin_received events of
+// the bank/v2 module by its listener, registered as an indexer of the node.
+type Service struct {
+	addressCodec address.Codec
+	bufferSize   int
+
+	mu          sync.Mutex
+	height      uint64
+	changes     map[string]*types.BalanceChange
+	subscribers map[*subscriber]struct{}
+}
+
+// subscriber is a client of the SubscribeBalances stream.
+type subscriber struct {
+	addresses map[string]struct{}
+	denoms    map[string]struct{}
+	updates   chan *types.SubscribeBalancesResponse
+}
+
+// NewService creates a new Service, buffering bufferSize blocks per subscriber.
+func NewService(addressCodec address.Codec, bufferSize int) *Service {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	return &Service{
+		addressCodec: addressCodec,
+		bufferSize:   bufferSize,
+		changes:      make(map[string]*types.BalanceChange),
+		subscribers:  make(map[*subscriber]struct{}),
+	}
+}
+
+// RegisterGRPCServer registers the Stream gRPC service on the given server, e.g. with the
+// WithExtraGRPCHandlers option of the gRPC server.
+func (s *Service) RegisterGRPCServer(srv *grpc.Server) error {
+	types.RegisterStreamServer(srv, s)
+	return nil
+}
+
+// IndexerInitializer returns the initializer of the indexer feeding the service with the events of
+// the committed blocks, to register with indexer.Register.
+func (s *Service) IndexerInitializer() indexer.Initializer {
+	return indexer.Initializer{
+		InitFunc: func(indexer.InitParams) (indexer.InitResult, error) {
+			return indexer.InitResult{Listener: s.Listener()}, nil
+		},
+	}
+}
+
+// Listener returns the listener collecting the balance changes of a block from its events, and
+// pushing them to the subscribers when the block is committed.
+func (s *Service) Listener() appdata.Listener {
+	return appdata.Listener{
+		StartBlock: s.onStartBlock,
+		OnEvent:    s.onEvent,
+		Commit:     s.onCommit,
+	}
+}
+
+func (s *Service) onStartBlock(data appdata.StartBlockData) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.height = data.Height
+	clear(s.changes)
+	return nil
+}
+
+func (s *Service) onEvent(data appdata.EventData) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, event := range data.Events {
+		var addrKey string
+		switch event.Type {
+		case types.EventTypeCoinSpent:
+			addrKey = types.AttributeKeySpender
+		case types.EventTypeCoinReceived:
+			addrKey = types.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		attrs, err := event.Attributes()
+		if err != nil {
+			return err
+		}
+
+		var addr, amount string
+		for _, attr := range attrs {
+			switch attr.Key {
+			case addrKey:
+				addr = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return err
+		}
+
+		change, ok := s.changes[addr]
+		if !ok {
+			change = &types.BalanceChange{Address: addr}
+			s.changes[addr] = change
+		}
+
+		if event.Type == types.EventTypeCoinSpent {
+			change.Spent = change.Spent.Add(coins...)
+		} else {
+			change.Received = change.Received.Add(coins...)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) onCommit(appdata.CommitData) (func() error, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.changes) == 0 {
+		return nil, nil
+	}
+
+	for sub := range s.subscribers {
+		res := &types.SubscribeBalancesResponse{Height: s.height}
+		for addr := range sub.addresses {
+			change, ok := s.changes[addr]
+			if !ok {
+				continue
+			}
+
+			filtered := types.BalanceChange{
+				Address:  addr,
+				Received: filterDenoms(change.Received, sub.denoms),
+				Spent:    filterDenoms(change.Spent, sub.denoms),
+			}
+			if filtered.Received.Empty() && filtered.Spent.Empty() {
+				continue
+			}
+			res.Changes = append(res.Changes, filtered)
+		}
+		if len(res.Changes) == 0 {
+			continue
+		}
+		slices.SortFunc(res.Changes, func(a, b types.BalanceChange) int {
+			return strings.Compare(a.Address, b.Address)
+		})
+
+		select {
+		case sub.updates <- res:
+		default:
+			// the subscriber is too slow to keep up with the blocks, it is dropped instead of
+			// blocking the node
+			delete(s.subscribers, sub)
+			close(sub.updates)
+		}
+	}
+
+	clear(s.changes)
+	return nil, nil
+}
+
+// filterDenoms returns the coins of the given denoms, or all the coins if no denoms are given.
+func filterDenoms(coins sdk.Coins, denoms map[string]struct{}) sdk.Coins {
+	if len(denoms) == 0 {
+		return coins
+	}
+
+	filtered := sdk.NewCoins()
+	for _, coin := range coins {
+		if _, ok := denoms[coin.Denom]; ok {
+			filtered = append(filtered, coin)
+		}
+	}
+	return filtered
+}
+
+// SubscribeBalances streams the balance changes of the requested accounts until the client cancels
+// the stream.
+func (s *Service) SubscribeBalances(req *types.SubscribeBalancesRequest, srv types.Stream_SubscribeBalancesServer) error {
+	if req == nil {
+		return errors.New("empty request")
+	}
+
+	if len(req.Addresses) == 0 {
+		return status.Error(codes.InvalidArgument, "addresses cannot be empty")
+	}
+
+	sub := &subscriber{
+		addresses: make(map[string]struct{}, len(req.Addresses)),
+		denoms:    make(map[string]struct{}, len(req.Denoms)),
+		updates:   make(chan *types.SubscribeBalancesResponse, s.bufferSize),
+	}
+	for _, addr := range req.Addresses {
+		if _, err := s.addressCodec.StringToBytes(addr); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid address %s: %s", addr, err)
+		}
+		sub.addresses[addr] = struct{}{}
+	}
+	for _, denom := range req.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		sub.denoms[denom] = struct{}{}
+	}
+
+	s.mu.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mu.Unlock()
+	defer s.unsubscribe(sub)
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case res, ok := <-sub.updates:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber is too slow, resubscribe and query the balances to catch up")
+			}
+
+			if err := srv.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Service) unsubscribe(sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscribers[sub]; ok {
+		delete(s.subscribers, sub)
+		close(sub.updates)
+	}
+}
@@ -0,0 +1,122 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/x/bank/v2/stream"
+	banktypes "cosmossdk.io/x/bank/v2/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	updates chan *banktypes.SubscribeBalancesResponse
+}
+
+func (m *mockStream) Context() context.Context { return m.ctx }
+
+func (m *mockStream) Send(res *banktypes.SubscribeBalancesResponse) error {
+	m.updates <- res
+	return nil
+}
+
+func coinEvent(typ, addrKey, addr, amount string) appdata.Event {
+	return appdata.Event{
+		Type: typ,
+		Attributes: func() ([]appdata.EventAttribute, error) {
+			return []appdata.EventAttribute{{Key: addrKey, Value: addr}, {Key: sdk.AttributeKeyAmount, Value: amount}}, nil
+		},
+	}
+}
+
+func commitBlock(t *testing.T, listener appdata.Listener, height uint64, events ...appdata.Event) {
+	t.Helper()
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: height}))
+	require.NoError(t, listener.OnEvent(appdata.EventData{Events: events}))
+	_, err := listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+}
+
+// nextUpdate returns the next streamed update, skipping the ones of the first block which is
+// committed until the subscription is registered.
+func nextUpdate(t *testing.T, srv *mockStream) *banktypes.SubscribeBalancesResponse {
+	t.Helper()
+	for {
+		select {
+		case res := <-srv.updates:
+			if res.Height > 1 {
+				return res
+			}
+		case <-time.After(time.Second):
+			t.Fatal("no balance changes streamed")
+		}
+	}
+}
+
+func TestSubscribeBalances(t *testing.T) {
+	addressCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	acc0, err := addressCodec.BytesToString([]byte("addr0_______________"))
+	require.NoError(t, err)
+	acc1, err := addressCodec.BytesToString([]byte("addr1_______________"))
+	require.NoError(t, err)
+	acc2, err := addressCodec.BytesToString([]byte("addr2_______________"))
+	require.NoError(t, err)
+
+	svc := stream.NewService(addressCodec, 10)
+	listener := svc.Listener()
+
+	// invalid requests are rejected
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := &mockStream{ctx: ctx, updates: make(chan *banktypes.SubscribeBalancesResponse, 10)}
+	require.Error(t, svc.SubscribeBalances(&banktypes.SubscribeBalancesRequest{}, srv))
+	require.Error(t, svc.SubscribeBalances(&banktypes.SubscribeBalancesRequest{Addresses: []string{"invalid"}}, srv))
+
+	done := make(chan error)
+	go func() {
+		done <- svc.SubscribeBalances(&banktypes.SubscribeBalancesRequest{Addresses: []string{acc1, acc0}, Denoms: []string{"foo"}}, srv)
+	}()
+
+	// wait for the subscription to be registered
+	require.Eventually(t, func() bool {
+		commitBlock(t, listener, 1, coinEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, acc0, "1foo"))
+		return len(srv.updates) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	// the changes of the block are aggregated per subscribed address, and filtered by denom
+	commitBlock(t, listener, 2,
+		coinEvent(banktypes.EventTypeCoinSpent, banktypes.AttributeKeySpender, acc0, "10foo,5bar"),
+		coinEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, acc1, "10foo"),
+		coinEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, acc2, "5bar"),
+		coinEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, acc1, "2foo"),
+		coinEvent("transfer", "recipient", acc1, "10foo"),
+	)
+	expected := []banktypes.BalanceChange{
+		{Address: acc0, Spent: sdk.NewCoins(sdk.NewInt64Coin("foo", 10))},
+		{Address: acc1, Received: sdk.NewCoins(sdk.NewInt64Coin("foo", 12))},
+	}
+	if acc1 < acc0 {
+		expected[0], expected[1] = expected[1], expected[0]
+	}
+	res := nextUpdate(t, srv)
+	require.Equal(t, uint64(2), res.Height)
+	require.Equal(t, expected, res.Changes)
+
+	// no update is sent for the blocks not changing the subscribed balances
+	commitBlock(t, listener, 3, coinEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, acc0, "5bar"))
+	commitBlock(t, listener, 4, coinEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, acc1, "1foo"))
+	require.Equal(t, uint64(4), nextUpdate(t, srv).Height)
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -2,6 +2,7 @@ package testutil
 
 import (
 	"context"
+	"fmt"
 
 	bankkeeper "cosmossdk.io/x/bank/v2/keeper"
 
@@ -14,3 +15,28 @@ import (
 func FundAccount(ctx context.Context, bankKeeper bankkeeper.Keeper, addr []byte, amounts sdk.Coins) error {
 	return bankKeeper.MintCoins(ctx, addr, amounts)
 }
+
+// FundAccounts is a utility function that funds many accounts with the same
+// amounts by minting the coins to each address. This should be used for testing
+// purposes only!
+func FundAccounts(ctx context.Context, bankKeeper bankkeeper.Keeper, addrs [][]byte, amounts sdk.Coins) error {
+	for _, addr := range addrs {
+		if err := bankKeeper.MintCoins(ctx, addr, amounts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FundModuleAccount is a utility function that funds a registered module account
+// by minting the coins to its address. This should be used for testing purposes
+// only!
+func FundModuleAccount(ctx context.Context, bankKeeper bankkeeper.Keeper, recipientMod string, amounts sdk.Coins) error {
+	addr, ok := bankKeeper.GetModuleAddress(recipientMod)
+	if !ok {
+		return fmt.Errorf("module account %s does not exist", recipientMod)
+	}
+
+	return bankKeeper.MintCoins(ctx, addr, amounts)
+}
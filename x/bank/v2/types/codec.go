@@ -9,5 +9,12 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*transaction.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgSend{},
+		&MsgMultiSend{},
+		&MsgAtomicSwap{},
+		&MsgSetDenomMetadata{},
+		&MsgCreateDenom{},
+		&MsgMintDenom{},
+		&MsgBurnDenom{},
+		&MsgChangeDenomAdmin{},
 	)
 }
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/bank/v2 module sentinel errors
+var (
+	ErrSendDisabled     = errors.Register(ModuleName, 2, "send transactions are disabled")
+	ErrBlockedAddress   = errors.Register(ModuleName, 3, "address is not allowed to receive funds")
+	ErrDenomExists      = errors.Register(ModuleName, 4, "denom already exists")
+	ErrNotDenomAdmin    = errors.Register(ModuleName, 5, "not the admin of the denom")
+	ErrInsufficientHold = errors.Register(ModuleName, 6, "insufficient coins on hold")
+)
@@ -10,6 +10,7 @@ const (
 
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = sdk.AttributeKeySender
+	AttributeKeyMemo      = "memo"
 
 	// supply and balance tracking events name and attributes
 	EventTypeCoinSpent    = "coin_spent"
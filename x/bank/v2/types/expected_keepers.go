@@ -1 +1,14 @@
 package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LockedCoinsProvider defines the expected interface of the modules holding funds of accounts
+// which cannot be spent, e.g. lockup or vesting accounts.
+type LockedCoinsProvider interface {
+	// LockedCoins returns the coins of the account which are locked and cannot be spent.
+	LockedCoins(ctx context.Context, addr []byte) (sdk.Coins, error)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of the denoms created by accounts.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory denom.
+	MaxSubdenomLength = 44
+)
+
+// GetFactoryDenom returns the factory/{creator}/{subdenom} denom created by the given creator.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) == 0 || len(subdenom) > MaxSubdenomLength {
+		return "", fmt.Errorf("subdenom length must be between 1 and %d", MaxSubdenomLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", fmt.Errorf("invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// DeconstructFactoryDenom returns the creator and the subdenom of a factory denom.
+func DeconstructFactoryDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", err
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("%s is not a factory denom, expected %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// FactoryDenomsPrefix returns the prefix of all the denoms created by the given creator.
+func FactoryDenomsPrefix(creator string) string {
+	return strings.Join([]string{FactoryDenomPrefix, creator, ""}, "/")
+}
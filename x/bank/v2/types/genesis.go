@@ -1,5 +1,12 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params) *GenesisState {
 	return &GenesisState{
@@ -12,6 +19,92 @@ func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(DefaultParams())
 }
 
+// Validate performs basic genesis state validation, checking that the supply, if set, equals the
+// sum of the balances and that the coins on hold don't exceed the balances.
 func (gs *GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenBalances := make(map[string]sdk.Coins)
+	totalSupply := sdk.NewCoins()
+	for _, balance := range gs.Balances {
+		if balance.Address == "" {
+			return errors.New("balance address cannot be empty")
+		}
+
+		if _, ok := seenBalances[balance.Address]; ok {
+			return fmt.Errorf("duplicate balance for address %s", balance.Address)
+		}
+
+		if err := balance.Coins.Validate(); err != nil {
+			return fmt.Errorf("invalid balance for address %s: %w", balance.Address, err)
+		}
+
+		seenBalances[balance.Address] = balance.Coins
+		totalSupply = totalSupply.Add(balance.Coins...)
+	}
+
+	if !gs.Supply.Empty() {
+		if err := gs.Supply.Validate(); err != nil {
+			return fmt.Errorf("invalid supply: %w", err)
+		}
+
+		if !gs.Supply.Equal(totalSupply) {
+			return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", totalSupply, gs.Supply)
+		}
+	}
+
+	seenMetadatas := make(map[string]bool)
+	for _, metadata := range gs.DenomMetadata {
+		if seenMetadatas[metadata.Base] {
+			return fmt.Errorf("duplicate client metadata for denom %s", metadata.Base)
+		}
+
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		seenMetadatas[metadata.Base] = true
+	}
+
+	seenFactoryDenoms := make(map[string]bool)
+	for _, factoryDenom := range gs.FactoryDenoms {
+		if seenFactoryDenoms[factoryDenom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", factoryDenom.Denom)
+		}
+
+		if _, _, err := DeconstructFactoryDenom(factoryDenom.Denom); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[factoryDenom.Denom] = true
+	}
+
+	seenHolds := make(map[string]bool)
+	heldCoins := make(map[string]sdk.Coins)
+	for _, hold := range gs.Holds {
+		if hold.Address == "" || hold.Name == "" {
+			return errors.New("hold address and name cannot be empty")
+		}
+
+		key := hold.Address + "/" + hold.Name
+		if seenHolds[key] {
+			return fmt.Errorf("duplicate hold %s for address %s", hold.Name, hold.Address)
+		}
+
+		if err := hold.Amount.Validate(); err != nil || hold.Amount.Empty() {
+			return fmt.Errorf("invalid hold %s for address %s: %v", hold.Name, hold.Address, err)
+		}
+
+		held := heldCoins[hold.Address].Add(hold.Amount...)
+		if !held.IsAllLTE(seenBalances[hold.Address]) {
+			return fmt.Errorf("coins on hold %s exceed the balance of address %s", held, hold.Address)
+		}
+
+		seenHolds[key] = true
+		heldCoins[hold.Address] = held
+	}
+
+	return nil
 }
@@ -36,6 +36,12 @@ type GenesisState struct {
 	// supply represents the total supply. If it is left empty, then supply will be calculated based on the provided
 	// balances. Otherwise, it will be used to validate that the sum of the balances equals this amount.
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
+	// denom_metadata defines the metadata of the different coins.
+	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// factory_denoms defines the denoms created by accounts, along with their admin.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,5,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// holds defines the coins of the accounts placed on hold.
+	Holds []Hold `protobuf:"bytes,6,rep,name=holds,proto3" json:"holds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +98,83 @@ func (m *GenesisState) GetSupply() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *GenesisState) GetDenomMetadata() []Metadata {
+	if m != nil {
+		return m.DenomMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+// FactoryDenom defines a denom created by an account and its admin, used in the bank module's
+// genesis state.
+type FactoryDenom struct {
+	// denom is the full name of the factory denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// admin is the admin of the denom, empty if the admin was renounced.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2b1daa12dfd4fc, []int{1}
+}
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
+}
+func (m *FactoryDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
+
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -105,7 +188,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc2b1daa12dfd4fc, []int{1}
+	return fileDescriptor_bc2b1daa12dfd4fc, []int{2}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -136,39 +219,46 @@ var xxx_messageInfo_Balance proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v2.GenesisState")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v2.FactoryDenom")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v2.Balance")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v2/genesis.proto", fileDescriptor_bc2b1daa12dfd4fc) }
 
 var fileDescriptor_bc2b1daa12dfd4fc = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0xd2, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x8c, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94,
-	0x24, 0x9a, 0x19, 0x60, 0xd5, 0x10, 0x29, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09,
-	0x15, 0x92, 0x83, 0xab, 0x2e, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0xce, 0xcf, 0xcc, 0x43, 0x35, 0x2d, 0x1e, 0x62, 0x0d, 0xd4, 0x01, 0x60, 0x8e, 0x52, 0x0b, 0x13,
-	0x17, 0x8f, 0x3b, 0xc4, 0x81, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x96, 0x5c, 0x6c, 0x05, 0x89,
-	0x45, 0x89, 0xb9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x62, 0x7a, 0xa8, 0x0e, 0xd6,
-	0x0b, 0x00, 0xcb, 0x3a, 0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20,
-	0xa8, 0x06, 0x21, 0x3b, 0x2e, 0x8e, 0xa4, 0xc4, 0x9c, 0xc4, 0xbc, 0xe4, 0xd4, 0x62, 0x09, 0x26,
-	0x05, 0x66, 0x0d, 0x6e, 0x23, 0x71, 0x74, 0xcd, 0x4e, 0x10, 0x79, 0x64, 0xdd, 0x70, 0x3d, 0x42,
-	0x95, 0x5c, 0x6c, 0xc5, 0xa5, 0x05, 0x05, 0x39, 0x95, 0x12, 0xcc, 0x60, 0xdd, 0x92, 0x08, 0xdd,
-	0xc5, 0xa9, 0x7a, 0x50, 0x7f, 0xe9, 0x39, 0xe7, 0x67, 0xe6, 0x39, 0xb9, 0x81, 0xf4, 0xaf, 0xba,
-	0x2f, 0xaf, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x0b, 0xf5, 0x17, 0x94,
-	0xd2, 0x2d, 0x4e, 0xc9, 0xd6, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x06, 0x6b, 0x28, 0x9e, 0xf5, 0x7c,
-	0x83, 0x16, 0x4f, 0x4e, 0x6a, 0x7a, 0x62, 0x72, 0x65, 0x3c, 0x28, 0x64, 0x8a, 0xa1, 0x4e, 0x87,
-	0x58, 0xa8, 0x74, 0x80, 0x91, 0x8b, 0x1d, 0xea, 0x36, 0x21, 0x23, 0x2e, 0xf6, 0xc4, 0x94, 0x94,
-	0xa2, 0xd4, 0x62, 0x48, 0x10, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0x75, 0x8a, 0x23,
-	0x44, 0x26, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa6, 0x50, 0xa8, 0x9c, 0x8b, 0x15, 0x6c,
-	0x2a, 0xd4, 0xdf, 0x74, 0x70, 0x39, 0xc4, 0x3e, 0x2b, 0x8e, 0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16,
-	0xc8, 0x33, 0x38, 0x99, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x34,
-	0x41, 0x16, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb, 0x57, 0xc0, 0x13, 0x15, 0xd8, 0x92, 0x24, 0x36,
-	0x70, 0x42, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x29, 0x0f, 0xca, 0xb7, 0x02, 0x00,
-	0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xb7, 0x1b, 0x9c, 0xb6, 0xd7, 0x10, 0x89, 0x53, 0x04, 0xd7, 0xaa, 0x72, 0xaa, 0x4c, 0x51,
+	0xa5, 0x9e, 0x55, 0x23, 0x90, 0x60, 0x40, 0xc2, 0xa0, 0x82, 0x90, 0x40, 0x28, 0x65, 0x62, 0x89,
+	0xce, 0xf6, 0xd5, 0xb5, 0x62, 0xfb, 0x2c, 0xdf, 0x35, 0xe0, 0x6f, 0xc0, 0xc8, 0xcc, 0xd4, 0x11,
+	0x31, 0x75, 0xe0, 0x03, 0x30, 0x76, 0xac, 0x58, 0x60, 0x02, 0x94, 0x0c, 0xe5, 0x63, 0x20, 0xdf,
+	0x5d, 0x83, 0xe3, 0x85, 0x8d, 0x25, 0xf1, 0xdd, 0xef, 0xcf, 0xfb, 0xbd, 0x7b, 0x7a, 0x60, 0x3b,
+	0x60, 0x3c, 0x65, 0xdc, 0xf1, 0x49, 0x36, 0x71, 0xa6, 0xae, 0x13, 0xd1, 0x8c, 0xf2, 0x98, 0xe3,
+	0xbc, 0x60, 0x82, 0xc1, 0xae, 0x42, 0x71, 0x85, 0xe2, 0xa9, 0xbb, 0xd5, 0x8b, 0x58, 0xc4, 0x24,
+	0xe4, 0x54, 0x5f, 0x8a, 0xb5, 0xb5, 0xd9, 0xf0, 0x90, 0x6c, 0x05, 0xdd, 0x20, 0x69, 0x9c, 0x31,
+	0x47, 0xfe, 0xea, 0x2b, 0x7b, 0xc1, 0xe6, 0xd4, 0x99, 0xee, 0xfb, 0x54, 0x90, 0x7d, 0x27, 0x60,
+	0x71, 0xb6, 0xec, 0x36, 0x56, 0x65, 0x74, 0x00, 0x79, 0x18, 0x7c, 0x6b, 0x81, 0xce, 0x13, 0x15,
+	0xf0, 0x50, 0x10, 0x41, 0xe1, 0x3d, 0xd0, 0xce, 0x49, 0x41, 0x52, 0x8e, 0xcc, 0x1d, 0x73, 0xb8,
+	0xe1, 0xde, 0xc4, 0xcb, 0x81, 0xf1, 0x4b, 0x89, 0x7a, 0xeb, 0xe7, 0x3f, 0xfa, 0xc6, 0xc7, 0xcb,
+	0xb3, 0x5d, 0x73, 0xa4, 0x05, 0xf0, 0x01, 0x58, 0xf3, 0x49, 0x42, 0xb2, 0x80, 0x72, 0xb4, 0xb2,
+	0xd3, 0x1a, 0x6e, 0xb8, 0xb7, 0x9a, 0x62, 0x4f, 0xe1, 0x75, 0xf5, 0x42, 0x03, 0x4b, 0xd0, 0xe6,
+	0x27, 0x79, 0x9e, 0x94, 0xa8, 0x25, 0xd5, 0x9b, 0x7f, 0xd5, 0x9c, 0x62, 0xdd, 0x17, 0x7e, 0xc4,
+	0xe2, 0xcc, 0x3b, 0xa8, 0xf4, 0x9f, 0x7e, 0xf6, 0x87, 0x51, 0x2c, 0x8e, 0x4f, 0x7c, 0x1c, 0xb0,
+	0x54, 0xf7, 0xa5, 0xff, 0xf6, 0x78, 0x38, 0x71, 0x44, 0x99, 0x53, 0x2e, 0x05, 0xfc, 0xc3, 0xe5,
+	0xd9, 0x6e, 0x27, 0xa1, 0x11, 0x09, 0xca, 0x71, 0xf5, 0x32, 0x5c, 0x47, 0x57, 0x05, 0xe1, 0x33,
+	0xd0, 0x0d, 0x69, 0xc6, 0xd2, 0x71, 0x4a, 0x05, 0x09, 0x89, 0x20, 0xe8, 0x9a, 0x8c, 0x80, 0x9a,
+	0x0d, 0x3c, 0xd7, 0x78, 0xbd, 0x83, 0xeb, 0x52, 0x7a, 0x85, 0xc0, 0x17, 0xa0, 0x7b, 0x44, 0x02,
+	0xc1, 0x8a, 0x72, 0x2c, 0x01, 0x8e, 0x2c, 0xe9, 0xb5, 0xdd, 0xf4, 0x3a, 0x50, 0xac, 0xc7, 0x15,
+	0x69, 0xc9, 0xef, 0xa8, 0x06, 0x70, 0x78, 0x07, 0x58, 0xc7, 0x2c, 0x09, 0x39, 0x6a, 0x4b, 0x9b,
+	0x5e, 0xd3, 0xe6, 0x29, 0x4b, 0xc2, 0xba, 0x5c, 0xb1, 0x07, 0xaf, 0x40, 0xa7, 0x5e, 0x00, 0xf6,
+	0x80, 0x25, 0xe3, 0xc8, 0xb9, 0xae, 0x8f, 0xd4, 0x01, 0x62, 0x60, 0x91, 0x30, 0x8d, 0x33, 0xb4,
+	0x52, 0xdd, 0x7a, 0xe8, 0xeb, 0xe7, 0xbd, 0x9e, 0xf6, 0x7f, 0x18, 0x86, 0x05, 0xe5, 0xfc, 0x50,
+	0x14, 0x71, 0x16, 0x8d, 0x14, 0x6d, 0xf0, 0xc5, 0x04, 0xab, 0x7a, 0x88, 0xd0, 0x05, 0xab, 0x44,
+	0x71, 0x90, 0xf9, 0x0f, 0xf5, 0x15, 0x11, 0xbe, 0x01, 0x96, 0x7c, 0x7e, 0xb4, 0xf2, 0xbf, 0x46,
+	0xac, 0xea, 0xdd, 0x5f, 0x7b, 0x77, 0xda, 0x37, 0x7e, 0x9f, 0xf6, 0x0d, 0xef, 0xee, 0xf9, 0xcc,
+	0x36, 0x2f, 0x66, 0xb6, 0xf9, 0x6b, 0x66, 0x9b, 0xef, 0xe7, 0xb6, 0x71, 0x31, 0xb7, 0x8d, 0xef,
+	0x73, 0xdb, 0x78, 0xad, 0x37, 0x97, 0x87, 0x13, 0x1c, 0x33, 0xe7, 0xed, 0x62, 0xfb, 0x64, 0x11,
+	0xbf, 0x2d, 0x37, 0xe6, 0xf6, 0x9f, 0x01, 0x00, 0x96, 0xaa, 0x12, 0x85, 0xe0, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -191,6 +281,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DenomMetadata) > 0 {
+		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Supply) > 0 {
 		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -232,6 +364,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Balance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -307,6 +476,41 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DenomMetadata) > 0 {
+		for _, e := range m.DenomMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -465,6 +669,222 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMetadata = append(m.DenomMetadata, Metadata{})
+			if err := m.DenomMetadata[len(m.DenomMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankHooks defines the hooks other modules can register to react to the transfers, mints and
+// burns of coins, e.g. for token gated features.
+type BankHooks interface {
+	AfterSend(ctx context.Context, from, to []byte, amt sdk.Coins) error // Must be called after coins are sent from an account to another
+	AfterMint(ctx context.Context, to []byte, amt sdk.Coins) error       // Must be called after coins are minted to an account
+	AfterBurn(ctx context.Context, from []byte, amt sdk.Coins) error     // Must be called after coins are burned from an account
+}
+
+var _ BankHooks = MultiBankHooks{}
+
+// MultiBankHooks combines multiple bank hooks, all hook functions are run in array sequence.
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+func (h MultiBankHooks) AfterSend(ctx context.Context, from, to []byte, amt sdk.Coins) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterSend(ctx, from, to, amt))
+	}
+	return errs
+}
+
+func (h MultiBankHooks) AfterMint(ctx context.Context, to []byte, amt sdk.Coins) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterMint(ctx, to, amt))
+	}
+	return errs
+}
+
+func (h MultiBankHooks) AfterBurn(ctx context.Context, from []byte, amt sdk.Coins) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterBurn(ctx, from, amt))
+	}
+	return errs
+}
+
+// BankHooksWrapper is a wrapper for modules to inject BankHooks using depinject.
+type BankHooksWrapper struct{ BankHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (BankHooksWrapper) IsOnePerModuleType() {}
@@ -31,4 +31,13 @@ var (
 	DenomAddressPrefix = collections.NewPrefix(4)
 
 	SupplyKey = collections.NewPrefix(5)
+
+	// DenomMetadataPrefix is the prefix for the denom metadata store.
+	DenomMetadataPrefix = collections.NewPrefix(6)
+
+	// DenomAdminPrefix is the prefix for the admins of the factory denoms.
+	DenomAdminPrefix = collections.NewPrefix(7)
+
+	// HoldsPrefix is the prefix for the coins of the accounts placed on hold.
+	HoldsPrefix = collections.NewPrefix(8)
 )
@@ -32,6 +32,11 @@ type Module struct {
 	// order is provided, then restrictions will be applied in alphabetical order
 	// of module names.
 	RestrictionsOrder []string `protobuf:"bytes,2,rep,name=restrictions_order,json=restrictionsOrder,proto3" json:"restrictions_order,omitempty"`
+	// burners specifies the accounts allowed to burn coins, as a list of module names or addresses.
+	Burners []string `protobuf:"bytes,3,rep,name=burners,proto3" json:"burners,omitempty"`
+	// blocked_addresses specifies the accounts which are not allowed to receive funds, as a list of
+	// module names or addresses.
+	BlockedAddresses []string `protobuf:"bytes,4,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
 }
 
 func (m *Module) Reset()         { *m = Module{} }
@@ -81,6 +86,20 @@ func (m *Module) GetRestrictionsOrder() []string {
 	return nil
 }
 
+func (m *Module) GetBurners() []string {
+	if m != nil {
+		return m.Burners
+	}
+	return nil
+}
+
+func (m *Module) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Module)(nil), "cosmos.bank.module.v2.Module")
 }
@@ -90,21 +109,24 @@ func init() {
 }
 
 var fileDescriptor_34a109a905e2a25b = []byte{
-	// 219 bytes of a gzipped FileDescriptorProto
+	// 265 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0xcc, 0xcb, 0xd6, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0xd5, 0x2f,
 	0x33, 0x82, 0xb2, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x21, 0x6a, 0xf4, 0x40, 0x6a,
 	0xf4, 0xa0, 0x32, 0x65, 0x46, 0x52, 0x0a, 0x50, 0xad, 0x89, 0x05, 0x05, 0xfa, 0x65, 0x86, 0x89,
-	0x39, 0x05, 0x19, 0x89, 0x86, 0x28, 0x1a, 0x95, 0x4a, 0xb9, 0xd8, 0x7c, 0xc1, 0x7c, 0x21, 0x19,
-	0x2e, 0xce, 0xc4, 0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0xce, 0x20, 0x84, 0x80, 0x90, 0x2e, 0x97, 0x50, 0x51, 0x6a, 0x71, 0x49, 0x51, 0x66, 0x72, 0x49,
-	0x66, 0x7e, 0x5e, 0x71, 0x7c, 0x7e, 0x51, 0x4a, 0x6a, 0x91, 0x04, 0x93, 0x02, 0xb3, 0x06, 0x67,
-	0x90, 0x20, 0xb2, 0x8c, 0x3f, 0x48, 0xc2, 0x4a, 0x6e, 0xd7, 0x81, 0x69, 0xb7, 0x18, 0x25, 0xb8,
-	0xc4, 0x20, 0x0e, 0x28, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7, 0xaf, 0x80, 0xf8, 0xa1, 0xcc, 0xc8,
-	0xc9, 0xf6, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x94, 0xb1, 0xeb, 0xd0,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x86, 0xba, 0x3d, 0x89, 0x0d, 0xec, 0x78, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x69, 0x6d, 0xb0, 0x10, 0x1b, 0x01, 0x00, 0x00,
+	0x39, 0x05, 0x19, 0x89, 0x86, 0x28, 0x1a, 0x95, 0xf6, 0x30, 0x72, 0xb1, 0xf9, 0x82, 0x05, 0x84,
+	0x64, 0xb8, 0x38, 0x13, 0x4b, 0x4b, 0x32, 0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18,
+	0x35, 0x38, 0x83, 0x10, 0x02, 0x42, 0xba, 0x5c, 0x42, 0x45, 0xa9, 0xc5, 0x25, 0x45, 0x99, 0xc9,
+	0x25, 0x99, 0xf9, 0x79, 0xc5, 0xf1, 0xf9, 0x45, 0x29, 0xa9, 0x45, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
+	0x9c, 0x41, 0x82, 0xc8, 0x32, 0xfe, 0x20, 0x09, 0x21, 0x09, 0x2e, 0xf6, 0xa4, 0xd2, 0xa2, 0xbc,
+	0xd4, 0xa2, 0x62, 0x09, 0x66, 0xb0, 0x1a, 0x18, 0x57, 0x48, 0x9b, 0x4b, 0x30, 0x29, 0x27, 0x3f,
+	0x39, 0x3b, 0x35, 0x25, 0x3e, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x05,
+	0xac, 0x46, 0x00, 0x2a, 0xe1, 0x08, 0x13, 0xb7, 0x92, 0xdb, 0x75, 0x60, 0xda, 0x2d, 0x46, 0x09,
+	0x2e, 0x31, 0x88, 0x47, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x20, 0x61, 0x51, 0x66,
+	0xe4, 0x64, 0x7b, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
+	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xca, 0xd8, 0x75,
+	0xe8, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x43, 0xc3, 0x20, 0x89, 0x0d, 0x1c, 0x08, 0xc6, 0x80, 0x01,
+	0x00, 0x15, 0x6f, 0xc0, 0x1c, 0x63, 0x01, 0x00, 0x00,
 }
 
 func (m *Module) Marshal() (dAtA []byte, err error) {
@@ -127,6 +149,24 @@ func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintModule(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Burners[iNdEx])
+			copy(dAtA[i:], m.Burners[iNdEx])
+			i = encodeVarintModule(dAtA, i, uint64(len(m.Burners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RestrictionsOrder) > 0 {
 		for iNdEx := len(m.RestrictionsOrder) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.RestrictionsOrder[iNdEx])
@@ -173,6 +213,18 @@ func (m *Module) Size() (n int) {
 			n += 1 + l + sovModule(uint64(l))
 		}
 	}
+	if len(m.Burners) > 0 {
+		for _, s := range m.Burners {
+			l = len(s)
+			n += 1 + l + sovModule(uint64(l))
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovModule(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -275,6 +327,70 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 			}
 			m.RestrictionsOrder = append(m.RestrictionsOrder, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModule(dAtA[iNdEx:])
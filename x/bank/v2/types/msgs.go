@@ -6,9 +6,66 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ coretransaction.Msg = &MsgSend{}
+// MaxTransferMemoLength is the maximum length of the memo of a transfer.
+const MaxTransferMemoLength = 256
+
+var (
+	_ coretransaction.Msg = &MsgSend{}
+	_ coretransaction.Msg = &MsgMultiSend{}
+	_ coretransaction.Msg = &MsgAtomicSwap{}
+	_ coretransaction.Msg = &MsgCreateDenom{}
+	_ coretransaction.Msg = &MsgMintDenom{}
+	_ coretransaction.Msg = &MsgBurnDenom{}
+	_ coretransaction.Msg = &MsgChangeDenomAdmin{}
+)
 
 // NewMsgSend constructs a msg to send coins from one account to another.
 func NewMsgSend(fromAddr, toAddr string, amount sdk.Coins) *MsgSend {
 	return &MsgSend{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount}
 }
+
+// NewMsgSendWithMemo constructs a msg to send coins from one account to another, with a memo
+// referencing the transfer.
+func NewMsgSendWithMemo(fromAddr, toAddr string, amount sdk.Coins, memo string) *MsgSend {
+	return &MsgSend{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount, Memo: memo}
+}
+
+// NewMsgMultiSend constructs a msg to send coins from one account to many accounts.
+func NewMsgMultiSend(fromAddr string, outputs []Output) *MsgMultiSend {
+	return &MsgMultiSend{FromAddress: fromAddr, Outputs: outputs}
+}
+
+// NewOutput creates a new Output instance.
+func NewOutput(addr string, coins sdk.Coins) Output {
+	return Output{Address: addr, Coins: coins}
+}
+
+// NewMsgAtomicSwap constructs a msg to execute the transfers of several parties atomically.
+func NewMsgAtomicSwap(transfers []SwapTransfer) *MsgAtomicSwap {
+	return &MsgAtomicSwap{Transfers: transfers}
+}
+
+// NewSwapTransfer creates a new SwapTransfer instance.
+func NewSwapTransfer(fromAddr, toAddr string, amount sdk.Coins) SwapTransfer {
+	return SwapTransfer{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount}
+}
+
+// NewMsgCreateDenom constructs a msg to create the factory/{sender}/{subdenom} denom.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgMintDenom constructs a msg to mint factory denoms.
+func NewMsgMintDenom(admin, toAddr string, amount sdk.Coins) *MsgMintDenom {
+	return &MsgMintDenom{Admin: admin, ToAddress: toAddr, Amount: amount}
+}
+
+// NewMsgBurnDenom constructs a msg to burn factory denoms from the balance of their admin.
+func NewMsgBurnDenom(admin string, amount sdk.Coins) *MsgBurnDenom {
+	return &MsgBurnDenom{Admin: admin, Amount: amount}
+}
+
+// NewMsgChangeDenomAdmin constructs a msg to change the admin of a factory denom.
+func NewMsgChangeDenomAdmin(admin, denom, newAdmin string) *MsgChangeDenomAdmin {
+	return &MsgChangeDenomAdmin{Admin: admin, Denom: denom, NewAdmin: newAdmin}
+}
@@ -1,16 +1,64 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultDefaultSendEnabled is the default value for the DefaultSendEnabled param.
+const DefaultDefaultSendEnabled = true
+
 // NewParams creates a new parameter configuration for the bank/v2 module
-func NewParams() Params {
-	return Params{}
+func NewParams(defaultSendEnabled bool, sendEnabled ...*SendEnabled) Params {
+	return Params{
+		SendEnabled:        sendEnabled,
+		DefaultSendEnabled: defaultSendEnabled,
+	}
 }
 
 // DefaultParams is the default parameter configuration for the bank/v2 module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultDefaultSendEnabled)
 }
 
 // Validate all bank/v2 module parameters
 func (p Params) Validate() error {
+	seenDenoms := make(map[string]bool, len(p.SendEnabled))
+	for _, sendEnabled := range p.SendEnabled {
+		if sendEnabled == nil {
+			return fmt.Errorf("send enabled entry cannot be nil")
+		}
+		if err := sdk.ValidateDenom(sendEnabled.Denom); err != nil {
+			return err
+		}
+		if seenDenoms[sendEnabled.Denom] {
+			return fmt.Errorf("duplicate send enabled entry for denom %s", sendEnabled.Denom)
+		}
+		seenDenoms[sendEnabled.Denom] = true
+	}
+
+	if err := p.DustThresholds.Validate(); err != nil {
+		return fmt.Errorf("invalid dust thresholds: %w", err)
+	}
+
 	return nil
 }
+
+// IsSendEnabledDenom returns the send enabled status of the given denom.
+func (p Params) IsSendEnabledDenom(denom string) bool {
+	for _, sendEnabled := range p.SendEnabled {
+		if sendEnabled.Denom == denom {
+			return sendEnabled.Enabled
+		}
+	}
+	return p.DefaultSendEnabled
+}
+
+// NewSendEnabled creates a new SendEnabled object.
+func NewSendEnabled(denom string, sendEnabled bool) *SendEnabled {
+	return &SendEnabled{
+		Denom:   denom,
+		Enabled: sendEnabled,
+	}
+}
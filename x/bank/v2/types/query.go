@@ -1,6 +1,27 @@
 package types
 
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
 // NewQueryBalanceRequest creates a new instance of QueryBalanceRequest.
 func NewQueryBalanceRequest(addr, denom string) *QueryBalanceRequest {
 	return &QueryBalanceRequest{Address: addr, Denom: denom}
 }
+
+// NewQueryDenomMetadataRequest creates a new instance of QueryDenomMetadataRequest.
+func NewQueryDenomMetadataRequest(denom string) *QueryDenomMetadataRequest {
+	return &QueryDenomMetadataRequest{Denom: denom}
+}
+
+// NewQuerySpendableBalancesRequest creates a new instance of QuerySpendableBalancesRequest.
+func NewQuerySpendableBalancesRequest(addr string, req *query.PageRequest) *QuerySpendableBalancesRequest {
+	return &QuerySpendableBalancesRequest{Address: addr, Pagination: req}
+}
+
+// NewQueryDenomOwnersRequest creates a new instance of QueryDenomOwnersRequest.
+func NewQueryDenomOwnersRequest(denom string, minBalance math.Int, req *query.PageRequest) *QueryDenomOwnersRequest {
+	return &QueryDenomOwnersRequest{Denom: denom, MinBalance: minBalance, Pagination: req}
+}
@@ -14,6 +14,21 @@ func (SendRestrictionFn) IsOnePerModuleType() {}
 
 var _ SendRestrictionFn = NoOpSendRestrictionFn
 
+// sendRestrictionBypassKey is the context key under which the bypass of the send restrictions is stored.
+type sendRestrictionBypassKey struct{}
+
+// WithSendRestrictionBypass returns a context in which the sends are not subject to the send restrictions.
+// It is meant for modules moving funds on behalf of the protocol, e.g. to return escrowed funds.
+func WithSendRestrictionBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sendRestrictionBypassKey{}, true)
+}
+
+// HasSendRestrictionBypass returns true if the send restrictions are bypassed in the provided context.
+func HasSendRestrictionBypass(ctx context.Context) bool {
+	bypass, ok := ctx.Value(sendRestrictionBypassKey{}).(bool)
+	return ok && bypass
+}
+
 // NoOpSendRestrictionFn is a no-op SendRestrictionFn.
 func NoOpSendRestrictionFn(_ context.Context, _, toAddr []byte, _ sdk.Coins) ([]byte, error) {
 	return toAddr, nil
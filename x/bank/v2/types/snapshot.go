@@ -0,0 +1,93 @@
+package types
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// SnapshotFormatCSV writes the balance snapshots as CSV, with an address,denom,amount header.
+	SnapshotFormatCSV = "csv"
+	// SnapshotFormatJSON writes the balance snapshots as a JSON array of {address, denom, amount} objects.
+	SnapshotFormatJSON = "json"
+)
+
+// BalanceSnapshotWriter streams balances to an io.Writer, one entry per account and denom.
+// Close must be called once all the balances are written.
+type BalanceSnapshotWriter interface {
+	Write(address string, balance sdk.Coin) error
+	Close() error
+}
+
+// NewBalanceSnapshotWriter returns a BalanceSnapshotWriter writing in the given format.
+func NewBalanceSnapshotWriter(w io.Writer, format string) (BalanceSnapshotWriter, error) {
+	switch format {
+	case SnapshotFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"address", "denom", "amount"}); err != nil {
+			return nil, err
+		}
+		return csvSnapshotWriter{w: cw}, nil
+
+	case SnapshotFormatJSON:
+		if _, err := io.WriteString(w, "["); err != nil {
+			return nil, err
+		}
+		return &jsonSnapshotWriter{w: w}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown snapshot format %s, expected %s or %s", format, SnapshotFormatCSV, SnapshotFormatJSON)
+	}
+}
+
+type csvSnapshotWriter struct {
+	w *csv.Writer
+}
+
+func (c csvSnapshotWriter) Write(address string, balance sdk.Coin) error {
+	return c.w.Write([]string{address, balance.Denom, balance.Amount.String()})
+}
+
+func (c csvSnapshotWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type jsonSnapshotEntry struct {
+	Address string `json:"address"`
+	Denom   string `json:"denom"`
+	Amount  string `json:"amount"`
+}
+
+type jsonSnapshotWriter struct {
+	w       io.Writer
+	written bool
+}
+
+func (j *jsonSnapshotWriter) Write(address string, balance sdk.Coin) error {
+	bz, err := json.Marshal(jsonSnapshotEntry{Address: address, Denom: balance.Denom, Amount: balance.Amount.String()})
+	if err != nil {
+		return err
+	}
+
+	sep := "\n"
+	if j.written {
+		sep = ",\n"
+	}
+	j.written = true
+
+	if _, err := io.WriteString(j.w, sep); err != nil {
+		return err
+	}
+	_, err = j.w.Write(bz)
+	return err
+}
+
+func (j *jsonSnapshotWriter) Close() error {
+	_, err := io.WriteString(j.w, "\n]\n")
+	return err
+}
@@ -0,0 +1,1036 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/bank/v2/stream.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeBalancesRequest is the request type for the Stream/SubscribeBalances RPC method.
+type SubscribeBalancesRequest struct {
+	// addresses are the addresses of the accounts to stream the balance changes of.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// denoms optionally restricts the streamed balance changes to the given denoms.
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *SubscribeBalancesRequest) Reset()         { *m = SubscribeBalancesRequest{} }
+func (m *SubscribeBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBalancesRequest) ProtoMessage()    {}
+func (*SubscribeBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05b3d18a56942cec, []int{0}
+}
+func (m *SubscribeBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBalancesRequest.Merge(m, src)
+}
+func (m *SubscribeBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBalancesRequest proto.InternalMessageInfo
+
+func (m *SubscribeBalancesRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *SubscribeBalancesRequest) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// SubscribeBalancesResponse is the response type for the Stream/SubscribeBalances RPC method, sent
+// for every committed block changing the balances of the subscribed accounts.
+type SubscribeBalancesResponse struct {
+	// height is the height of the committed block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// changes are the balance changes of the subscribed accounts in the block, one per account,
+	// ordered by address.
+	Changes []BalanceChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *SubscribeBalancesResponse) Reset()         { *m = SubscribeBalancesResponse{} }
+func (m *SubscribeBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBalancesResponse) ProtoMessage()    {}
+func (*SubscribeBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05b3d18a56942cec, []int{1}
+}
+func (m *SubscribeBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBalancesResponse.Merge(m, src)
+}
+func (m *SubscribeBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBalancesResponse proto.InternalMessageInfo
+
+func (m *SubscribeBalancesResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SubscribeBalancesResponse) GetChanges() []BalanceChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// BalanceChange defines the coins received and spent by an account in a block.
+type BalanceChange struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// received are the coins received by the account.
+	Received github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=received,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"received"`
+	// spent are the coins spent by the account.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *BalanceChange) Reset()         { *m = BalanceChange{} }
+func (m *BalanceChange) String() string { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()    {}
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05b3d18a56942cec, []int{2}
+}
+func (m *BalanceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceChange.Merge(m, src)
+}
+func (m *BalanceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceChange proto.InternalMessageInfo
+
+func (m *BalanceChange) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetReceived() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Received
+	}
+	return nil
+}
+
+func (m *BalanceChange) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeBalancesRequest)(nil), "cosmos.bank.v2.SubscribeBalancesRequest")
+	proto.RegisterType((*SubscribeBalancesResponse)(nil), "cosmos.bank.v2.SubscribeBalancesResponse")
+	proto.RegisterType((*BalanceChange)(nil), "cosmos.bank.v2.BalanceChange")
+}
+
+func init() { proto.RegisterFile("cosmos/bank/v2/stream.proto", fileDescriptor_05b3d18a56942cec) }
+
+var fileDescriptor_05b3d18a56942cec = []byte{
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x5b, 0x48, 0xc9, 0xf0, 0x90, 0x3a, 0xaa, 0x90, 0x13, 0xc0, 0xad, 0xb2, 0x0a, 0x95,
+	0x3a, 0x43, 0x8d, 0xd4, 0x3d, 0xae, 0xc4, 0x07, 0x38, 0x3b, 0x36, 0xd5, 0xd8, 0xbe, 0x72, 0xa6,
+	0xa9, 0x67, 0x82, 0xef, 0x24, 0xa5, 0x0b, 0xfe, 0x81, 0x35, 0x5f, 0x80, 0x58, 0x75, 0xc1, 0x8e,
+	0x1f, 0xe8, 0xb2, 0x62, 0xc5, 0x0a, 0x50, 0xb2, 0xe8, 0x6f, 0x20, 0xcf, 0x4c, 0x53, 0x95, 0x97,
+	0x58, 0x75, 0x93, 0xf8, 0xfa, 0x9c, 0x7b, 0xce, 0x7d, 0x99, 0x3c, 0xca, 0x35, 0x56, 0x1a, 0x79,
+	0x26, 0xd4, 0x98, 0xcf, 0x62, 0x8e, 0xa6, 0x06, 0x51, 0xb1, 0x49, 0xad, 0x8d, 0xa6, 0x0f, 0x1c,
+	0xc8, 0x1a, 0x90, 0xcd, 0xe2, 0xde, 0x46, 0xa9, 0x4b, 0x6d, 0x21, 0xde, 0x3c, 0x39, 0x56, 0x6f,
+	0x5d, 0x54, 0x52, 0x69, 0x6e, 0x7f, 0xfd, 0xab, 0x68, 0xa9, 0x8a, 0xc0, 0x67, 0xbb, 0x19, 0x18,
+	0xb1, 0xcb, 0x73, 0x2d, 0x95, 0xc7, 0xbb, 0x0e, 0x3f, 0x70, 0x5a, 0xde, 0xc5, 0x06, 0xfd, 0x43,
+	0x12, 0x0e, 0xa7, 0x19, 0xe6, 0xb5, 0xcc, 0x20, 0x11, 0x47, 0x42, 0xe5, 0x80, 0x29, 0xbc, 0x9e,
+	0x02, 0x1a, 0xba, 0x47, 0x3a, 0xa2, 0x28, 0x6a, 0x40, 0x04, 0x0c, 0x83, 0xad, 0xd5, 0x41, 0x27,
+	0x09, 0xbf, 0x7c, 0xda, 0xd9, 0xf0, 0x02, 0x2f, 0x1c, 0x36, 0x34, 0xb5, 0x54, 0x65, 0x7a, 0x45,
+	0xa5, 0x0f, 0x49, 0xbb, 0x00, 0xa5, 0x2b, 0x0c, 0x57, 0x9a, 0xa4, 0xd4, 0x47, 0xfd, 0x63, 0xd2,
+	0xfd, 0x83, 0x17, 0x4e, 0xb4, 0x42, 0x68, 0x92, 0x46, 0x20, 0xcb, 0x91, 0x09, 0x83, 0xad, 0x60,
+	0x70, 0x2b, 0xf5, 0x11, 0x4d, 0xc8, 0x5a, 0x3e, 0x12, 0xaa, 0x04, 0xa7, 0x76, 0x37, 0x7e, 0xc2,
+	0xae, 0x8f, 0x89, 0x79, 0xa9, 0x7d, 0xcb, 0x4a, 0x3a, 0x67, 0xdf, 0x36, 0x5b, 0x1f, 0x2e, 0x4e,
+	0xb7, 0x83, 0xf4, 0x32, 0xb1, 0xff, 0x79, 0x85, 0xdc, 0xbf, 0xc6, 0xa2, 0x31, 0x59, 0xf3, 0xf5,
+	0x5a, 0xbb, 0x7f, 0x35, 0x76, 0x49, 0xa4, 0x6f, 0xc9, 0x9d, 0x1a, 0x72, 0x90, 0x33, 0x28, 0x7c,
+	0x29, 0xdd, 0xab, 0x52, 0x10, 0x98, 0x1f, 0x3c, 0xdb, 0xd7, 0x52, 0x25, 0x2f, 0x9b, 0x32, 0x3e,
+	0x7e, 0xdf, 0x1c, 0x94, 0xd2, 0x8c, 0xa6, 0x19, 0xcb, 0x75, 0xe5, 0x07, 0xef, 0xff, 0x76, 0xb0,
+	0x18, 0x73, 0x73, 0x32, 0x01, 0xb4, 0x09, 0xf8, 0xfe, 0xe2, 0x74, 0xfb, 0xde, 0x11, 0x94, 0x22,
+	0x3f, 0x39, 0x68, 0x56, 0x87, 0xae, 0x87, 0xa5, 0x25, 0x3d, 0x26, 0xb7, 0x71, 0x02, 0xca, 0x84,
+	0xab, 0x37, 0xe5, 0xed, 0xfc, 0x62, 0x43, 0xda, 0x43, 0x7b, 0xa6, 0xf4, 0x90, 0xac, 0xff, 0xb6,
+	0x40, 0x3a, 0xf8, 0x75, 0x1f, 0x7f, 0xbb, 0xa7, 0xde, 0xd3, 0xff, 0x60, 0xba, 0x6b, 0x78, 0x16,
+	0x24, 0x7b, 0x67, 0xf3, 0x28, 0x38, 0x9f, 0x47, 0xc1, 0x8f, 0x79, 0x14, 0xbc, 0x5b, 0x44, 0xad,
+	0xf3, 0x45, 0xd4, 0xfa, 0xba, 0x88, 0x5a, 0xaf, 0x1e, 0x3b, 0x15, 0x2c, 0xc6, 0x4c, 0x6a, 0xfe,
+	0x66, 0xf9, 0x2d, 0xd9, 0x86, 0xb2, 0xb6, 0xbd, 0xeb, 0xe7, 0x3f, 0x07, 0x00, 0x3e, 0xaf, 0xb4,
+	0x50, 0x6a, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamClient interface {
+	// SubscribeBalances streams the balance changes of the given accounts, once per committed block
+	// changing them.
+	SubscribeBalances(ctx context.Context, in *SubscribeBalancesRequest, opts ...grpc.CallOption) (Stream_SubscribeBalancesClient, error)
+}
+
+type streamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamClient(cc grpc1.ClientConn) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) SubscribeBalances(ctx context.Context, in *SubscribeBalancesRequest, opts ...grpc.CallOption) (Stream_SubscribeBalancesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/cosmos.bank.v2.Stream/SubscribeBalances", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamSubscribeBalancesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stream_SubscribeBalancesClient interface {
+	Recv() (*SubscribeBalancesResponse, error)
+	grpc.ClientStream
+}
+
+type streamSubscribeBalancesClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamSubscribeBalancesClient) Recv() (*SubscribeBalancesResponse, error) {
+	m := new(SubscribeBalancesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+type StreamServer interface {
+	// SubscribeBalances streams the balance changes of the given accounts, once per committed block
+	// changing them.
+	SubscribeBalances(*SubscribeBalancesRequest, Stream_SubscribeBalancesServer) error
+}
+
+// UnimplementedStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (*UnimplementedStreamServer) SubscribeBalances(req *SubscribeBalancesRequest, srv Stream_SubscribeBalancesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBalances not implemented")
+}
+
+func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
+	s.RegisterService(&_Stream_serviceDesc, srv)
+}
+
+func _Stream_SubscribeBalances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBalancesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).SubscribeBalances(m, &streamSubscribeBalancesServer{stream})
+}
+
+type Stream_SubscribeBalancesServer interface {
+	Send(*SubscribeBalancesResponse) error
+	grpc.ServerStream
+}
+
+type streamSubscribeBalancesServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamSubscribeBalancesServer) Send(m *SubscribeBalancesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var Stream_serviceDesc = _Stream_serviceDesc
+var _Stream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.bank.v2.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBalances",
+			Handler:       _Stream_SubscribeBalances_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/bank/v2/stream.proto",
+}
+
+func (m *SubscribeBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintStream(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintStream(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Received) > 0 {
+		for iNdEx := len(m.Received) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Received[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BalanceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if len(m.Received) > 0 {
+		for _, e := range m.Received {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, BalanceChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Received = append(m.Received, types.Coin{})
+			if err := m.Received[len(m.Received)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,147 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                        = (*AllowedDenomAllowance)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*AllowedDenomAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedDenomAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewAllowedDenomAllowance creates a new fee allowance which only covers fees denominated
+// in one of the allowed denoms.
+func NewAllowedDenomAllowance(allowance FeeAllowanceI, allowedDenoms []string) (*AllowedDenomAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllowedDenomAllowance{
+		Allowance:     any,
+		AllowedDenoms: allowedDenoms,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *AllowedDenomAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *AllowedDenomAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	newAllowance, err := types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	a.Allowance = newAllowance
+
+	return nil
+}
+
+// Accept checks that all the fee coins are denominated in an allowed denom before delegating
+// to the wrapped allowance.
+func (a *AllowedDenomAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	for _, coin := range fee {
+		if !a.allowedDenom(coin.Denom) {
+			return false, errorsmod.Wrapf(ErrDenomNotAllowed, "fees cannot be paid in %s", coin.Denom)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+func (a *AllowedDenomAllowance) allowedDenom(denom string) bool {
+	for _, allowed := range a.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedDenomAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedDenoms) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed denoms shouldn't be empty")
+	}
+
+	seen := make(map[string]struct{}, len(a.AllowedDenoms))
+	for _, denom := range a.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if _, ok := seen[denom]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the AllowedDenomAllowance.
+func (a *AllowedDenomAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// UpdatePeriodReset update "PeriodReset" of the AllowedDenomAllowance.
+func (a *AllowedDenomAllowance) UpdatePeriodReset(validTime time.Time) error {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	if err := allowance.UpdatePeriodReset(validTime); err != nil {
+		return err
+	}
+	return a.SetAllowance(allowance)
+}
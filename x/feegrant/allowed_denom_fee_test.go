@@ -0,0 +1,133 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAllowedDenomFeeValidAllow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	now := time.Now()
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: now})
+
+	limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("eth", 1000))
+
+	cases := map[string]struct {
+		allowance *feegrant.BasicAllowance
+		fee       sdk.Coins
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"allowed denom": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: limit},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 250)),
+			accept:    true,
+			remains:   sdk.NewCoins(sdk.NewInt64Coin("atom", 750), sdk.NewInt64Coin("eth", 1000)),
+		},
+		"denom not allowed": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: limit},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("eth", 1)),
+			accept:    false,
+		},
+		"one of the denoms not allowed": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: limit},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("eth", 1)),
+			accept:    false,
+		},
+		"allowed denom but over spend limit": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: limit},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 2000)),
+			accept:    false,
+		},
+		"zero fee": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: limit},
+			accept:    true,
+			remains:   limit,
+		},
+		"wrapped allowance used up": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 250))},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 250)),
+			accept:    true,
+			remove:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedDenomAllowance(tc.allowance, []string{"atom"})
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+				HeaderService: mockHeaderService{},
+				GasService:    mockGasService{},
+			}), tc.fee, []sdk.Msg{&banktypes.MsgSend{}})
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.remove, removed)
+			if removed {
+				return
+			}
+
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tc.remains, inner.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func TestAllowedDenomFeeValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		allowedDenoms []string
+		valid         bool
+	}{
+		"valid": {
+			allowedDenoms: []string{"atom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+			valid:         true,
+		},
+		"empty": {
+			valid: false,
+		},
+		"invalid denom": {
+			allowedDenoms: []string{"1atom"},
+			valid:         false,
+		},
+		"duplicate denom": {
+			allowedDenoms: []string{"atom", "atom"},
+			valid:         false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedDenomAllowance(&feegrant.BasicAllowance{}, tc.allowedDenoms)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package feegrant
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/core/appmodule"
+	corecontext "cosmossdk.io/core/context"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*BudgetAllowance)(nil)
+
+// Accept checks that all messages have a sub-limit and that the fee fits within the sub-limit
+// of every message type in the transaction as well as within the total budget. The fee is then
+// deducted from the total budget and from the sub-limit of every message type in the transaction,
+// so a transaction mixing several message types counts against each of their sub-limits.
+//
+// The allowance is removed once the total budget is used up or it has expired.
+func (a *BudgetAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	environment, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
+	if !ok {
+		return false, errors.New("environment not set")
+	}
+	if a.Basic.Expiration != nil && a.Basic.Expiration.Before(environment.HeaderService.HeaderInfo(ctx).Time) {
+		return true, errorsmod.Wrap(ErrFeeLimitExpired, "budget allowance")
+	}
+
+	limits, err := a.msgSpendLimits(ctx, environment, msgs)
+	if err != nil {
+		return false, err
+	}
+
+	for _, i := range limits {
+		if _, isNeg := a.MsgSpendLimits[i].SpendLimit.SafeSub(fee...); isNeg {
+			return false, errorsmod.Wrapf(ErrFeeLimitExceeded, "%s spend limit", a.MsgSpendLimits[i].MsgTypeUrl)
+		}
+	}
+
+	left, isNeg := a.Basic.SpendLimit.SafeSub(fee...)
+	if isNeg {
+		return false, errorsmod.Wrap(ErrFeeLimitExceeded, "budget allowance")
+	}
+
+	a.Basic.SpendLimit = left
+	for _, i := range limits {
+		a.MsgSpendLimits[i].SpendLimit = a.MsgSpendLimits[i].SpendLimit.Sub(fee...)
+	}
+
+	return left.IsZero(), nil
+}
+
+// msgSpendLimits returns the indexes of the sub-limits of the distinct message types in msgs
+// and an error if any of the messages doesn't have a sub-limit.
+func (a *BudgetAllowance) msgSpendLimits(ctx context.Context, environment appmodule.Environment, msgs []sdk.Msg) ([]int, error) {
+	gasMeter := environment.GasService.GasMeter(ctx)
+
+	indexes := make(map[string]int, len(a.MsgSpendLimits))
+	for i, limit := range a.MsgSpendLimits {
+		if err := gasMeter.Consume(gasCostPerIteration, "check msg"); err != nil {
+			return nil, err
+		}
+		indexes[limit.MsgTypeUrl] = i
+	}
+
+	var limits []int
+	seen := make(map[string]struct{}, len(msgs))
+	for _, msg := range msgs {
+		if err := gasMeter.Consume(gasCostPerIteration, "check msg"); err != nil {
+			return nil, err
+		}
+		typeURL := sdk.MsgTypeURL(msg)
+		i, ok := indexes[typeURL]
+		if !ok {
+			return nil, errorsmod.Wrapf(ErrMessageNotAllowed, "message %s does not have a spend limit", typeURL)
+		}
+		if _, ok := seen[typeURL]; !ok {
+			seen[typeURL] = struct{}{}
+			limits = append(limits, i)
+		}
+	}
+
+	return limits, nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a BudgetAllowance) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+	if a.Basic.SpendLimit.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "budget allowance requires a spend limit")
+	}
+	if len(a.MsgSpendLimits) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg spend limits shouldn't be empty")
+	}
+
+	total := sdk.NewCoins()
+	seen := make(map[string]struct{}, len(a.MsgSpendLimits))
+	for _, limit := range a.MsgSpendLimits {
+		if limit.MsgTypeUrl == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type url shouldn't be empty")
+		}
+		if _, ok := seen[limit.MsgTypeUrl]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate spend limit for %s", limit.MsgTypeUrl)
+		}
+		seen[limit.MsgTypeUrl] = struct{}{}
+
+		if !limit.SpendLimit.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit of %s is invalid: %s", limit.MsgTypeUrl, limit.SpendLimit)
+		}
+		// We allow 0 for a used up sub-limit
+		if limit.SpendLimit.IsAnyNegative() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit of %s must not be negative", limit.MsgTypeUrl)
+		}
+		total = total.Add(limit.SpendLimit...)
+	}
+
+	if !total.IsAllLTE(a.Basic.SpendLimit) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "msg spend limits %s exceed the total budget %s", total, a.Basic.SpendLimit)
+	}
+
+	return nil
+}
+
+// ExpiresAt returns the expiry time of the BudgetAllowance.
+func (a BudgetAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Basic.ExpiresAt()
+}
+
+// UpdatePeriodReset BudgetAllowance does not update "PeriodReset"
+func (a BudgetAllowance) UpdatePeriodReset(_ time.Time) error { return nil }
@@ -0,0 +1,198 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBudgetFeeValidAllow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	now := time.Now()
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: now})
+	ctx = context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+		HeaderService: mockHeaderService{},
+		GasService:    mockGasService{},
+	})
+
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	multiSendURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+	past := now.Add(-time.Hour)
+
+	cases := map[string]struct {
+		expiration *time.Time
+		fee        sdk.Coins
+		msgs       []sdk.Msg
+		accept     bool
+		remove     bool
+		budget     sdk.Coins
+		sendLimit  sdk.Coins
+		multiLimit sdk.Coins
+	}{
+		"within sub-limit": {
+			fee:        atom(20),
+			msgs:       []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgSend{}},
+			accept:     true,
+			budget:     atom(80),
+			sendLimit:  atom(40),
+			multiLimit: atom(30),
+		},
+		"mixed messages count against both sub-limits": {
+			fee:        atom(20),
+			msgs:       []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}},
+			accept:     true,
+			budget:     atom(80),
+			sendLimit:  atom(40),
+			multiLimit: atom(10),
+		},
+		"over sub-limit": {
+			fee:    atom(40),
+			msgs:   []sdk.Msg{&banktypes.MsgMultiSend{}},
+			accept: false,
+		},
+		"message without sub-limit": {
+			fee:    atom(1),
+			msgs:   []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgUpdateParams{}},
+			accept: false,
+		},
+		"other denom": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("eth", 1)),
+			msgs:   []sdk.Msg{&banktypes.MsgSend{}},
+			accept: false,
+		},
+		"expired": {
+			expiration: &past,
+			fee:        atom(1),
+			msgs:       []sdk.Msg{&banktypes.MsgSend{}},
+			accept:     false,
+			remove:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance := &feegrant.BudgetAllowance{
+				Basic: feegrant.BasicAllowance{SpendLimit: atom(100), Expiration: tc.expiration},
+				MsgSpendLimits: []feegrant.MsgSpendLimit{
+					{MsgTypeUrl: sendURL, SpendLimit: atom(60)},
+					{MsgTypeUrl: multiSendURL, SpendLimit: atom(30)},
+				},
+			}
+			require.NoError(t, allowance.ValidateBasic())
+
+			remove, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			require.Equal(t, tc.remove, remove)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.budget, allowance.Basic.SpendLimit)
+			require.Equal(t, tc.sendLimit, allowance.MsgSpendLimits[0].SpendLimit)
+			require.Equal(t, tc.multiLimit, allowance.MsgSpendLimits[1].SpendLimit)
+			require.NoError(t, allowance.ValidateBasic())
+		})
+	}
+}
+
+func TestBudgetFeeUsedUp(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := context.WithValue(testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()}), corecontext.EnvironmentContextKey, appmodulev2.Environment{
+		HeaderService: mockHeaderService{},
+		GasService:    mockGasService{},
+	})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+	allowance := &feegrant.BudgetAllowance{
+		Basic:          feegrant.BasicAllowance{SpendLimit: atom},
+		MsgSpendLimits: []feegrant.MsgSpendLimit{{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), SpendLimit: atom}},
+	}
+
+	remove, err := allowance.Accept(ctx, atom, []sdk.Msg{&banktypes.MsgSend{}})
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestBudgetFeeValidateBasic(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	multiSendURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	halfAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+
+	cases := map[string]struct {
+		spendLimit sdk.Coins
+		limits     []feegrant.MsgSpendLimit
+		valid      bool
+	}{
+		"valid": {
+			spendLimit: atom,
+			limits:     []feegrant.MsgSpendLimit{{MsgTypeUrl: sendURL, SpendLimit: halfAtom}, {MsgTypeUrl: multiSendURL, SpendLimit: halfAtom}},
+			valid:      true,
+		},
+		"used up sub-limit": {
+			spendLimit: atom,
+			limits:     []feegrant.MsgSpendLimit{{MsgTypeUrl: sendURL}},
+			valid:      true,
+		},
+		"sub-limits exceed the total": {
+			spendLimit: halfAtom,
+			limits:     []feegrant.MsgSpendLimit{{MsgTypeUrl: sendURL, SpendLimit: halfAtom}, {MsgTypeUrl: multiSendURL, SpendLimit: halfAtom}},
+			valid:      false,
+		},
+		"sub-limit in another denom": {
+			spendLimit: atom,
+			limits:     []feegrant.MsgSpendLimit{{MsgTypeUrl: sendURL, SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("eth", 1))}},
+			valid:      false,
+		},
+		"no total": {
+			limits: []feegrant.MsgSpendLimit{{MsgTypeUrl: sendURL, SpendLimit: halfAtom}},
+			valid:  false,
+		},
+		"no sub-limits": {
+			spendLimit: atom,
+			valid:      false,
+		},
+		"empty type url": {
+			spendLimit: atom,
+			limits:     []feegrant.MsgSpendLimit{{SpendLimit: halfAtom}},
+			valid:      false,
+		},
+		"duplicate type url": {
+			spendLimit: atom,
+			limits:     []feegrant.MsgSpendLimit{{MsgTypeUrl: sendURL, SpendLimit: halfAtom}, {MsgTypeUrl: sendURL, SpendLimit: halfAtom}},
+			valid:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance := feegrant.BudgetAllowance{
+				Basic:          feegrant.BasicAllowance{SpendLimit: tc.spendLimit},
+				MsgSpendLimits: tc.limits,
+			}
+
+			err := allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -19,11 +20,26 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration     = "expiration"
+	FlagPeriod         = "period"
+	FlagPeriodLimit    = "period-limit"
+	FlagPeriodCalendar = "period-calendar"
+	FlagSpendLimit     = "spend-limit"
+	FlagAllowedMsgs    = "allowed-messages"
+
+	FlagAutoRenewCount = "auto-renew-count"
+	FlagAutoRenewUntil = "auto-renew-until"
+
+	FlagAllowedMsgCounts = "allowed-msg-counts"
+	FlagMsgCountPeriod   = "msg-count-period"
+
+	FlagMaxGasPrices = "max-gas-prices"
+
+	FlagMsgSpendLimits = "msg-spend-limits"
+
+	FlagAllowedDenoms = "allowed-denoms"
+
+	FlagAllowSubGrants = "allow-sub-grants"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -59,9 +75,18 @@ func NewCmdFeeGrant() *cobra.Command {
 Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 86400 --period-limit 10stake --auto-renew-count 30 or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period-calendar daily --period-limit 10stake or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --msg-count-period 86400
+	--allowed-msg-counts "/cosmos.bank.v1beta1.MsgSend=10" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --max-gas-prices 0.025stake or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake
+	--msg-spend-limits "/cosmos.bank.v1beta1.MsgSend=60stake,/cosmos.staking.v1beta1.MsgDelegate=40stake"
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -128,15 +153,29 @@ Examples:
 				return err
 			}
 
-			// check any of period or periodLimit flags are set,
+			periodCalendarVal, err := cmd.Flags().GetString(FlagPeriodCalendar)
+			if err != nil {
+				return err
+			}
+
+			// check any of period, periodLimit or periodCalendar flags are set,
 			// if set consider it as periodic fee allowance.
-			if periodClock > 0 || periodLimitVal != "" {
+			if periodClock > 0 || periodLimitVal != "" || periodCalendarVal != "" {
 				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
 				if err != nil {
 					return err
 				}
 
-				if periodClock <= 0 {
+				periodCalendar, err := getPeriodCalendar(periodCalendarVal)
+				if err != nil {
+					return err
+				}
+
+				if periodCalendar != feegrant.PeriodCalendarUnspecified && periodClock > 0 {
+					return fmt.Errorf("only one of --%s and --%s can be set", FlagPeriod, FlagPeriodCalendar)
+				}
+
+				if periodClock <= 0 && periodCalendar == feegrant.PeriodCalendarUnspecified {
 					return errors.New("period clock was not set")
 				}
 
@@ -144,26 +183,68 @@ Examples:
 					return errors.New("period limit was not set")
 				}
 
-				periodReset := getPeriodReset(periodClock)
-				if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
-					return fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
-				}
-
 				periodic := feegrant.PeriodicAllowance{
 					Basic:            basic,
 					Period:           getPeriod(periodClock),
 					PeriodSpendLimit: periodLimit,
 					PeriodCanSpend:   periodLimit,
+					Calendar:         periodCalendar,
+				}
+
+				if periodCalendar == feegrant.PeriodCalendarUnspecified {
+					periodReset := getPeriodReset(periodClock)
+					if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
+						return fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
+					}
+				}
+
+				autoRenewal, err := getAutoRenewal(cmd, limit)
+				if err != nil {
+					return err
 				}
+				periodic.AutoRenewal = autoRenewal
 
 				grant = &periodic
 			}
 
+			msgSpendLimits, err := cmd.Flags().GetStringToString(FlagMsgSpendLimits)
+			if err != nil {
+				return err
+			}
+
+			if len(msgSpendLimits) > 0 {
+				if _, ok := grant.(*feegrant.BasicAllowance); !ok {
+					return fmt.Errorf("--%s cannot be used with a periodic allowance", FlagMsgSpendLimits)
+				}
+				if limit.Empty() {
+					return errors.New("spend limit was not set, it is required for a budget allowance")
+				}
+
+				limits, err := getMsgSpendLimits(msgSpendLimits)
+				if err != nil {
+					return err
+				}
+
+				grant = &feegrant.BudgetAllowance{
+					Basic:          basic,
+					MsgSpendLimits: limits,
+				}
+			}
+
 			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
 			if err != nil {
 				return err
 			}
 
+			allowedMsgCounts, err := cmd.Flags().GetStringToInt64(FlagAllowedMsgCounts)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedMsgs) > 0 && len(allowedMsgCounts) > 0 {
+				return fmt.Errorf("only one of --%s and --%s can be set", FlagAllowedMsgs, FlagAllowedMsgCounts)
+			}
+
 			if len(allowedMsgs) > 0 {
 				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
 				if err != nil {
@@ -171,11 +252,66 @@ Examples:
 				}
 			}
 
+			if len(allowedMsgCounts) > 0 {
+				msgCountPeriod, err := cmd.Flags().GetInt64(FlagMsgCountPeriod)
+				if err != nil {
+					return err
+				}
+
+				if msgCountPeriod <= 0 {
+					return errors.New("message count period was not set")
+				}
+
+				limits, err := getMsgCountLimits(allowedMsgCounts)
+				if err != nil {
+					return err
+				}
+
+				grant, err = feegrant.NewAllowedMsgCountAllowance(grant, getPeriod(msgCountPeriod), limits)
+				if err != nil {
+					return err
+				}
+			}
+
+			maxGasPricesVal, err := cmd.Flags().GetString(FlagMaxGasPrices)
+			if err != nil {
+				return err
+			}
+
+			if maxGasPricesVal != "" {
+				maxGasPrices, err := sdk.ParseDecCoins(maxGasPricesVal)
+				if err != nil {
+					return err
+				}
+
+				grant, err = feegrant.NewGasPriceCapAllowance(grant, maxGasPrices)
+				if err != nil {
+					return err
+				}
+			}
+
+			allowedDenoms, err := cmd.Flags().GetStringSlice(FlagAllowedDenoms)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedDenoms) > 0 {
+				grant, err = feegrant.NewAllowedDenomAllowance(grant, allowedDenoms)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granterStr, args[1])
 			if err != nil {
 				return err
 			}
 
+			msg.AllowSubGrants, err = cmd.Flags().GetBool(FlagAllowSubGrants)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -185,15 +321,112 @@ Examples:
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
+	cmd.Flags().String(FlagPeriodCalendar, "", "period calendar resets the period limit at calendar boundaries in UTC instead of every period, one of daily, weekly or monthly")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().Uint64(FlagAutoRenewCount, 0, "auto renew count specifies how many times the spend limit of a periodic allowance is renewed at a period reset")
+	cmd.Flags().String(FlagAutoRenewUntil, "", "The RFC 3339 timestamp after which the spend limit of a periodic allowance is no longer renewed at a period reset")
+	cmd.Flags().StringToInt64(FlagAllowedMsgCounts, map[string]int64{}, "Allowed messages with the maximum number of uses per message count period (ex: /cosmos.bank.v1beta1.MsgSend=10)")
+	cmd.Flags().StringToString(FlagMsgSpendLimits, map[string]string{}, "Split the spend limit into sub-limits per message type, only transactions with these messages are covered (ex: /cosmos.bank.v1beta1.MsgSend=60stake)")
+	cmd.Flags().Int64(FlagMsgCountPeriod, 0, "msg count period specifies the time duration(in seconds) after which the allowed message counts are reset (ex: 86400)")
+	cmd.Flags().Bool(FlagAllowSubGrants, false, "Allow the grantee to carve out sub-grants of the allowance to other accounts")
+	cmd.Flags().String(FlagMaxGasPrices, "", "Maximum gas prices which can be paid for with the allowance, fees in other denoms are rejected (ex: 0.025stake)")
+	cmd.Flags().StringSlice(FlagAllowedDenoms, []string{}, "Denoms in which fees can be paid with the allowance, fees in other denoms are rejected (ex: stake,uatom)")
 
 	return cmd
 }
 
+// getAutoRenewal returns the auto renewal of a periodic allowance from the auto renew flags,
+// or nil if none of them are set.
+func getAutoRenewal(cmd *cobra.Command, spendLimit sdk.Coins) (*feegrant.AutoRenewal, error) {
+	count, err := cmd.Flags().GetUint64(FlagAutoRenewCount)
+	if err != nil {
+		return nil, err
+	}
+
+	until, err := cmd.Flags().GetString(FlagAutoRenewUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	if count == 0 && until == "" {
+		return nil, nil
+	}
+
+	if spendLimit.Empty() {
+		return nil, errors.New("spend limit was not set, it is required to auto renew")
+	}
+
+	autoRenewal := &feegrant.AutoRenewal{
+		SpendLimit:  spendLimit,
+		MaxRenewals: count,
+	}
+
+	if until != "" {
+		endTime, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return nil, err
+		}
+		autoRenewal.EndTime = &endTime
+	}
+
+	return autoRenewal, nil
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
 
+// getPeriodCalendar converts the period calendar flag value into a PeriodCalendar.
+func getPeriodCalendar(calendar string) (feegrant.PeriodCalendar, error) {
+	if calendar == "" {
+		return feegrant.PeriodCalendarUnspecified, nil
+	}
+
+	value, ok := feegrant.PeriodCalendar_value["PERIOD_CALENDAR_"+strings.ToUpper(calendar)]
+	if !ok || value == int32(feegrant.PeriodCalendarUnspecified) {
+		return feegrant.PeriodCalendarUnspecified, fmt.Errorf("invalid period calendar %s, expected one of daily, weekly or monthly", calendar)
+	}
+
+	return feegrant.PeriodCalendar(value), nil
+}
+
 func getPeriod(duration int64) time.Duration {
 	return time.Duration(duration) * time.Second
 }
+
+// getMsgCountLimits converts the allowed message counts flag value into message count limits
+// sorted by message type url.
+func getMsgCountLimits(counts map[string]int64) ([]feegrant.MsgCountLimit, error) {
+	limits := make([]feegrant.MsgCountLimit, 0, len(counts))
+	for typeURL, count := range counts {
+		if count <= 0 {
+			return nil, fmt.Errorf("max count for %s must be positive", typeURL)
+		}
+		limits = append(limits, feegrant.MsgCountLimit{MsgTypeUrl: typeURL, MaxCount: uint64(count)})
+	}
+
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].MsgTypeUrl < limits[j].MsgTypeUrl
+	})
+
+	return limits, nil
+}
+
+// getMsgSpendLimits converts the msg spend limits flag value into message spend limits
+// sorted by message type url.
+func getMsgSpendLimits(spendLimits map[string]string) ([]feegrant.MsgSpendLimit, error) {
+	limits := make([]feegrant.MsgSpendLimit, 0, len(spendLimits))
+	for typeURL, spendLimit := range spendLimits {
+		coins, err := sdk.ParseCoinsNormalized(spendLimit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spend limit for %s: %w", typeURL, err)
+		}
+		limits = append(limits, feegrant.MsgSpendLimit{MsgTypeUrl: typeURL, SpendLimit: coins})
+	}
+
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].MsgTypeUrl < limits[j].MsgTypeUrl
+	})
+
+	return limits, nil
+}
@@ -358,6 +358,38 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 			),
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid periodic fee grant with auto renewal",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1w55kgcf3ltaqdy4ww49nge3klxmrdavrr6frmp",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagAutoRenewCount, 3),
+					fmt.Sprintf("--%s=%s", cli.FlagAutoRenewUntil, getFormattedExpiration(tenHours)),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"invalid auto renewal without spend-limit",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1w55kgcf3ltaqdy4ww49nge3klxmrdavrr6frmp",
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagAutoRenewCount, 3),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
 		{
 			"valid periodic fee grant without spend-limit",
 			append(
@@ -402,6 +434,161 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 			),
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid calendar periodic fee grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos14cm33pvnrv2497tyt8sp9yavhmw83nwej3m0e8",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodCalendar, "daily"),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"invalid period calendar",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos14cm33pvnrv2497tyt8sp9yavhmw83nwej3m0e8",
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodCalendar, "hourly"),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"both period and period calendar",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos14cm33pvnrv2497tyt8sp9yavhmw83nwej3m0e8",
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodCalendar, "daily"),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"valid budget fee grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagMsgSpendLimits, "/cosmos.bank.v1beta1.MsgSend=60stake,/cosmos.staking.v1beta1.MsgDelegate=40stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"budget fee grant without spend limit",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagMsgSpendLimits, "/cosmos.bank.v1beta1.MsgSend=60stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"valid msg count fee grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgCounts, "/cosmos.bank.v1beta1.MsgSend=10"),
+					fmt.Sprintf("--%s=%d", cli.FlagMsgCountPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"msg count period omitted, invalid msg count fee grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgCounts, "/cosmos.bank.v1beta1.MsgSend=10"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"allowed messages and allowed msg counts both set",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, "/cosmos.bank.v1beta1.MsgSend"),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgCounts, "/cosmos.bank.v1beta1.MsgSend=10"),
+					fmt.Sprintf("--%s=%d", cli.FlagMsgCountPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"valid gas price cap fee grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagMaxGasPrices, "0.025stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"valid allowed denom fee grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedDenoms, "stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"invalid max gas prices",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagMaxGasPrices, "stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
 		{
 			"invalid expiration",
 			append(
@@ -12,19 +12,39 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
+	legacy.RegisterAminoMsg(registrar, &MsgGrantSubAllowance{}, "cosmos-sdk/MsgGrantSubAllowance")
 	legacy.RegisterAminoMsg(registrar, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(registrar, &MsgRevokeAllAllowances{}, "cosmos-sdk/MsgRevokeAllAllowances")
+	legacy.RegisterAminoMsg(registrar, &MsgGrantGroupAllowance{}, "cosmos-sdk/MsgGrantGroupAllowance")
+	legacy.RegisterAminoMsg(registrar, &MsgRevokeGroupAllowance{}, "cosmos-sdk/MsgRevokeGroupAllowance")
+	legacy.RegisterAminoMsg(registrar, &MsgGrantModuleAllowance{}, "cosmos-sdk/MsgGrantModuleAllowance")
+	legacy.RegisterAminoMsg(registrar, &MsgRevokeModuleAllowance{}, "cosmos-sdk/MsgRevokeModuleAllowance")
+	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/feegrant/MsgUpdateParams")
 
 	registrar.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	registrar.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance")
 	registrar.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance")
 	registrar.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance")
+	registrar.RegisterConcrete(&AllowedMsgCountAllowance{}, "cosmos-sdk/AllowedMsgCountAllowance")
+	registrar.RegisterConcrete(&GasPriceCapAllowance{}, "cosmos-sdk/GasPriceCapAllowance")
+	registrar.RegisterConcrete(&AllowedDenomAllowance{}, "cosmos-sdk/AllowedDenomAllowance")
+	registrar.RegisterConcrete(&BudgetAllowance{}, "cosmos-sdk/BudgetAllowance")
+	registrar.RegisterConcrete(&AndAllowance{}, "cosmos-sdk/AndAllowance")
+	registrar.RegisterConcrete(&OrAllowance{}, "cosmos-sdk/OrAllowance")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
 func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgGrantAllowance{},
+		&MsgGrantSubAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgRevokeAllAllowances{},
+		&MsgGrantGroupAllowance{},
+		&MsgRevokeGroupAllowance{},
+		&MsgGrantModuleAllowance{},
+		&MsgRevokeModuleAllowance{},
+		&MsgUpdateParams{},
 	)
 
 	registrar.RegisterInterface(
@@ -33,6 +53,12 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&BasicAllowance{},
 		&PeriodicAllowance{},
 		&AllowedMsgAllowance{},
+		&AllowedMsgCountAllowance{},
+		&GasPriceCapAllowance{},
+		&AllowedDenomAllowance{},
+		&BudgetAllowance{},
+		&AndAllowance{},
+		&OrAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
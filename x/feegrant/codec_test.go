@@ -0,0 +1,42 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRegisterLegacyAminoCodec(t *testing.T) {
+	// amino names longer than 39 characters make the registration panic, as they break Ledger signing
+	legacyAmino := codec.NewLegacyAmino()
+	require.NotPanics(t, func() { feegrant.RegisterLegacyAminoCodec(legacyAmino) })
+
+	testCases := []struct {
+		msg  sdk.Msg
+		json string
+	}{
+		{
+			msg:  &feegrant.MsgRevokeAllAllowances{Granter: "cosmos1abc"},
+			json: `{"type":"cosmos-sdk/MsgRevokeAllAllowances","value":{"granter":"cosmos1abc"}}`,
+		},
+		{
+			msg:  &feegrant.MsgRevokeGroupAllowance{Granter: "cosmos1abc", Name: "team"},
+			json: `{"type":"cosmos-sdk/MsgRevokeGroupAllowance","value":{"granter":"cosmos1abc","name":"team"}}`,
+		},
+		{
+			msg:  &feegrant.MsgRevokeModuleAllowance{Authority: "cosmos1gov", ModuleName: "community", Grantee: "cosmos1def"},
+			json: `{"type":"cosmos-sdk/MsgRevokeModuleAllowance","value":{"authority":"cosmos1gov","grantee":"cosmos1def","module_name":"community"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		bz, err := legacyAmino.MarshalJSON(tc.msg)
+		require.NoError(t, err)
+		require.JSONEq(t, tc.json, string(bz))
+	}
+}
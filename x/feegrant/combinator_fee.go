@@ -0,0 +1,302 @@
+package feegrant
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                        = (*AndAllowance)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*AndAllowance)(nil)
+	_ FeeAllowanceI                        = (*OrAllowance)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*OrAllowance)(nil)
+)
+
+// NewAndAllowance creates a new fee allowance which only covers fees accepted by all the allowances.
+func NewAndAllowance(allowances ...FeeAllowanceI) (*AndAllowance, error) {
+	anys, err := packAllowances(allowances)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AndAllowance{Allowances: anys}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AndAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return unpackAllowances(unpacker, a.Allowances)
+}
+
+// GetAllowances returns the combined fee allowances.
+func (a *AndAllowance) GetAllowances() ([]FeeAllowanceI, error) {
+	return getAllowances(a.Allowances)
+}
+
+// Accept covers the fee only if all the allowances accept it, in which case the fee is deducted
+// from all of them. The AndAllowance is removed as soon as any of the allowances is removed.
+func (a *AndAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	allowances, err := a.GetAllowances()
+	if err != nil {
+		return false, err
+	}
+
+	remove := false
+	for _, allowance := range allowances {
+		removeAllowance, err := allowance.Accept(ctx, fee, msgs)
+		if err != nil {
+			return removeAllowance, err
+		}
+		remove = remove || removeAllowance
+	}
+	if remove {
+		return true, nil
+	}
+
+	anys, err := packAllowances(allowances)
+	if err != nil {
+		return false, err
+	}
+	a.Allowances = anys
+
+	return false, nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AndAllowance) ValidateBasic() error {
+	return validateAllowances(a.Allowances)
+}
+
+// ExpiresAt returns the earliest expiry time of the combined allowances, as the AndAllowance
+// can no longer be used once any of them has expired.
+func (a *AndAllowance) ExpiresAt() (*time.Time, error) {
+	allowances, err := a.GetAllowances()
+	if err != nil {
+		return nil, err
+	}
+
+	var earliest *time.Time
+	for _, allowance := range allowances {
+		exp, err := allowance.ExpiresAt()
+		if err != nil {
+			return nil, err
+		}
+		if exp != nil && (earliest == nil || exp.Before(*earliest)) {
+			earliest = exp
+		}
+	}
+
+	return earliest, nil
+}
+
+// UpdatePeriodReset update "PeriodReset" of all the combined allowances.
+func (a *AndAllowance) UpdatePeriodReset(validTime time.Time) error {
+	anys, err := updatePeriodResets(a.Allowances, validTime)
+	if err != nil {
+		return err
+	}
+
+	a.Allowances = anys
+	return nil
+}
+
+// NewOrAllowance creates a new fee allowance which covers fees accepted by any of the allowances.
+func NewOrAllowance(allowances ...FeeAllowanceI) (*OrAllowance, error) {
+	anys, err := packAllowances(allowances)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrAllowance{Allowances: anys}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *OrAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return unpackAllowances(unpacker, a.Allowances)
+}
+
+// GetAllowances returns the combined fee allowances.
+func (a *OrAllowance) GetAllowances() ([]FeeAllowanceI, error) {
+	return getAllowances(a.Allowances)
+}
+
+// Accept tries the allowances in order and covers the fee with the first one accepting it,
+// only this allowance is updated. Allowances which are removed, e.g. because they have expired
+// or are used up, are dropped and the OrAllowance is removed once none is left.
+func (a *OrAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	allowances, err := a.GetAllowances()
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		kept     []*types.Any
+		accepted bool
+		errs     error
+	)
+	for i, allowance := range allowances {
+		if accepted {
+			kept = append(kept, a.Allowances[i])
+			continue
+		}
+
+		// the state of an allowance rejecting the fee is not repacked, so it is left untouched
+		remove, err := allowance.Accept(ctx, fee, msgs)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			if !remove {
+				kept = append(kept, a.Allowances[i])
+			}
+			continue
+		}
+
+		accepted = true
+		if remove {
+			continue
+		}
+
+		allowanceAny, err := types.NewAnyWithValue(allowance.(proto.Message))
+		if err != nil {
+			return false, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+		}
+		kept = append(kept, allowanceAny)
+	}
+
+	if !accepted {
+		return len(kept) == 0, errs
+	}
+
+	a.Allowances = kept
+	return len(kept) == 0, nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *OrAllowance) ValidateBasic() error {
+	return validateAllowances(a.Allowances)
+}
+
+// ExpiresAt returns the latest expiry time of the combined allowances, as the OrAllowance
+// can be used until all of them have expired. It doesn't expire if any of them doesn't.
+func (a *OrAllowance) ExpiresAt() (*time.Time, error) {
+	allowances, err := a.GetAllowances()
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *time.Time
+	for _, allowance := range allowances {
+		exp, err := allowance.ExpiresAt()
+		if err != nil {
+			return nil, err
+		}
+		if exp == nil {
+			return nil, nil
+		}
+		if latest == nil || exp.After(*latest) {
+			latest = exp
+		}
+	}
+
+	return latest, nil
+}
+
+// UpdatePeriodReset update "PeriodReset" of all the combined allowances.
+func (a *OrAllowance) UpdatePeriodReset(validTime time.Time) error {
+	anys, err := updatePeriodResets(a.Allowances, validTime)
+	if err != nil {
+		return err
+	}
+
+	a.Allowances = anys
+	return nil
+}
+
+func packAllowances(allowances []FeeAllowanceI) ([]*types.Any, error) {
+	anys := make([]*types.Any, len(allowances))
+	for i, allowance := range allowances {
+		msg, ok := allowance.(proto.Message)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+		}
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+func unpackAllowances(unpacker gogoprotoany.AnyUnpacker, anys []*types.Any) error {
+	for _, any := range anys {
+		var allowance FeeAllowanceI
+		if err := unpacker.UnpackAny(any, &allowance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getAllowances(anys []*types.Any) ([]FeeAllowanceI, error) {
+	allowances := make([]FeeAllowanceI, len(anys))
+	for i, any := range anys {
+		allowance, ok := any.GetCachedValue().(FeeAllowanceI)
+		if !ok {
+			return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+		}
+		allowances[i] = allowance
+	}
+
+	return allowances, nil
+}
+
+func validateAllowances(anys []*types.Any) error {
+	if len(anys) < 2 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least two allowances must be combined")
+	}
+
+	for _, any := range anys {
+		if any == nil {
+			return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+		}
+	}
+
+	allowances, err := getAllowances(anys)
+	if err != nil {
+		return err
+	}
+
+	for _, allowance := range allowances {
+		if err := allowance.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updatePeriodResets(anys []*types.Any, validTime time.Time) ([]*types.Any, error) {
+	allowances, err := getAllowances(anys)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, allowance := range allowances {
+		if err := allowance.UpdatePeriodReset(validTime); err != nil {
+			return nil, err
+		}
+	}
+
+	return packAllowances(allowances)
+}
@@ -0,0 +1,233 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newCombinatorContext(t *testing.T, now time.Time) context.Context {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	return context.WithValue(testCtx.Ctx.WithHeaderInfo(header.Info{Time: now}), corecontext.EnvironmentContextKey, appmodulev2.Environment{
+		HeaderService: mockHeaderService{},
+		GasService:    mockGasService{},
+	})
+}
+
+// storedBasicAt decodes the stored state of the combined BasicAllowance at index i.
+func storedBasicAt(t *testing.T, anys []*codectypes.Any, i int) feegrant.BasicAllowance {
+	t.Helper()
+	var basic feegrant.BasicAllowance
+	require.NoError(t, basic.Unmarshal(anys[i].Value))
+	return basic
+}
+
+func TestAndAllowance(t *testing.T) {
+	now := time.Now()
+	ctx := newCombinatorContext(t, now)
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+	future := now.Add(time.Hour)
+
+	allowance, err := feegrant.NewAndAllowance(
+		&feegrant.BasicAllowance{SpendLimit: atom(100), Expiration: &future},
+		&feegrant.BasicAllowance{SpendLimit: atom(50)},
+	)
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// the fee is deducted from all the allowances
+	remove, err := allowance.Accept(ctx, atom(20), nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, atom(80), storedBasicAt(t, allowance.Allowances, 0).SpendLimit)
+	require.Equal(t, atom(30), storedBasicAt(t, allowance.Allowances, 1).SpendLimit)
+
+	// the fee is rejected if any allowance rejects it, the allowances are left untouched
+	remove, err = allowance.Accept(ctx, atom(40), nil)
+	require.Error(t, err)
+	require.False(t, remove)
+	require.Equal(t, atom(80), storedBasicAt(t, allowance.Allowances, 0).SpendLimit)
+	require.Equal(t, atom(30), storedBasicAt(t, allowance.Allowances, 1).SpendLimit)
+
+	// the allowance is removed once any allowance is used up
+	remove, err = allowance.Accept(ctx, atom(30), nil)
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestOrAllowance(t *testing.T) {
+	now := time.Now()
+	ctx := newCombinatorContext(t, now)
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+	past := now.Add(-time.Hour)
+
+	periodic := &feegrant.PeriodicAllowance{
+		Period:           time.Hour,
+		PeriodSpendLimit: atom(10),
+		PeriodCanSpend:   atom(10),
+		PeriodReset:      now.Add(time.Hour),
+	}
+	allowance, err := feegrant.NewOrAllowance(periodic, &feegrant.BasicAllowance{SpendLimit: atom(100)})
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// the fee is covered by the first allowance
+	remove, err := allowance.Accept(ctx, atom(5), nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+	var stored feegrant.PeriodicAllowance
+	require.NoError(t, stored.Unmarshal(allowance.Allowances[0].Value))
+	require.Equal(t, atom(5), stored.PeriodCanSpend)
+	require.Equal(t, atom(100), storedBasicAt(t, allowance.Allowances, 1).SpendLimit)
+
+	// the fee is covered by the second allowance, the first one is left untouched
+	remove, err = allowance.Accept(ctx, atom(20), nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.NoError(t, stored.Unmarshal(allowance.Allowances[0].Value))
+	require.Equal(t, atom(5), stored.PeriodCanSpend)
+	require.Equal(t, atom(80), storedBasicAt(t, allowance.Allowances, 1).SpendLimit)
+
+	// the fee is rejected if no allowance accepts it
+	remove, err = allowance.Accept(ctx, atom(90), nil)
+	require.Error(t, err)
+	require.False(t, remove)
+	require.Len(t, allowance.Allowances, 2)
+
+	// expired allowances are dropped
+	allowance, err = feegrant.NewOrAllowance(
+		&feegrant.BasicAllowance{SpendLimit: atom(100), Expiration: &past},
+		&feegrant.BasicAllowance{SpendLimit: atom(100)},
+	)
+	require.NoError(t, err)
+	remove, err = allowance.Accept(ctx, atom(5), nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Len(t, allowance.Allowances, 1)
+	require.Equal(t, atom(95), storedBasicAt(t, allowance.Allowances, 0).SpendLimit)
+
+	// the allowance is removed once all the allowances have expired
+	allowance, err = feegrant.NewOrAllowance(
+		&feegrant.BasicAllowance{SpendLimit: atom(100), Expiration: &past},
+		&feegrant.BasicAllowance{Expiration: &past},
+	)
+	require.NoError(t, err)
+	remove, err = allowance.Accept(ctx, atom(5), nil)
+	require.Error(t, err)
+	require.True(t, remove)
+
+	// the allowance is removed once the last allowance is used up
+	allowance, err = feegrant.NewOrAllowance(
+		&feegrant.BasicAllowance{SpendLimit: atom(100), Expiration: &past},
+		&feegrant.BasicAllowance{SpendLimit: atom(5)},
+	)
+	require.NoError(t, err)
+	remove, err = allowance.Accept(ctx, atom(5), nil)
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestCombinatorValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	cases := map[string]struct {
+		allowances []feegrant.FeeAllowanceI
+		valid      bool
+	}{
+		"valid": {
+			allowances: []feegrant.FeeAllowanceI{&feegrant.BasicAllowance{SpendLimit: atom}, &feegrant.BasicAllowance{}},
+			valid:      true,
+		},
+		"invalid nested allowance": {
+			allowances: []feegrant.FeeAllowanceI{
+				&feegrant.BasicAllowance{SpendLimit: atom},
+				&feegrant.AndAllowance{},
+			},
+			valid: false,
+		},
+		"single allowance": {
+			allowances: []feegrant.FeeAllowanceI{&feegrant.BasicAllowance{SpendLimit: atom}},
+			valid:      false,
+		},
+		"no allowance": {
+			valid: false,
+		},
+		"invalid allowance": {
+			allowances: []feegrant.FeeAllowanceI{&feegrant.BasicAllowance{SpendLimit: atom}, &feegrant.PeriodicAllowance{}},
+			valid:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			and, err := feegrant.NewAndAllowance(tc.allowances...)
+			require.NoError(t, err)
+			or, err := feegrant.NewOrAllowance(tc.allowances...)
+			require.NoError(t, err)
+
+			if tc.valid {
+				require.NoError(t, and.ValidateBasic())
+				require.NoError(t, or.ValidateBasic())
+			} else {
+				require.Error(t, and.ValidateBasic())
+				require.Error(t, or.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestCombinatorExpiresAt(t *testing.T) {
+	now := time.Now()
+	early := now.Add(time.Hour)
+	late := now.Add(2 * time.Hour)
+
+	cases := map[string]struct {
+		allowances []feegrant.FeeAllowanceI
+		and        *time.Time
+		or         *time.Time
+	}{
+		"all expiring": {
+			allowances: []feegrant.FeeAllowanceI{&feegrant.BasicAllowance{Expiration: &late}, &feegrant.BasicAllowance{Expiration: &early}},
+			and:        &early,
+			or:         &late,
+		},
+		"one not expiring": {
+			allowances: []feegrant.FeeAllowanceI{&feegrant.BasicAllowance{Expiration: &early}, &feegrant.BasicAllowance{}},
+			and:        &early,
+		},
+		"none expiring": {
+			allowances: []feegrant.FeeAllowanceI{&feegrant.BasicAllowance{}, &feegrant.BasicAllowance{}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			and, err := feegrant.NewAndAllowance(tc.allowances...)
+			require.NoError(t, err)
+			or, err := feegrant.NewOrAllowance(tc.allowances...)
+			require.NoError(t, err)
+
+			exp, err := and.ExpiresAt()
+			require.NoError(t, err)
+			require.Equal(t, tc.and, exp)
+
+			exp, err = or.ExpiresAt()
+			require.NoError(t, err)
+			require.Equal(t, tc.or, exp)
+		})
+	}
+}
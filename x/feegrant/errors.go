@@ -20,4 +20,14 @@ var (
 	ErrNoMessages = errors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = errors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrMsgCountExceeded error if a message type has been used too often in the current period
+	ErrMsgCountExceeded = errors.Register(DefaultCodespace, 8, "message count limit exceeded")
+	// ErrInvalidSigner error if the signer of a proposal message is not the module authority
+	ErrInvalidSigner = errors.Register(DefaultCodespace, 9, "expected authority account as only signer for proposal message")
+	// ErrGasPriceExceeded error if the gas price of a fee exceeds the maximum gas price of the allowance
+	ErrGasPriceExceeded = errors.Register(DefaultCodespace, 10, "gas price exceeds the allowed maximum")
+	// ErrSubGrantNotAllowed error if the grantee of an allowance is not allowed to carve out sub-grants of it
+	ErrSubGrantNotAllowed = errors.Register(DefaultCodespace, 11, "sub-grants not allowed")
+	// ErrDenomNotAllowed error if a fee is denominated in a denom which is not allowed by the allowance
+	ErrDenomNotAllowed = errors.Register(DefaultCodespace, 12, "fee denom not allowed")
 )
@@ -33,6 +33,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PeriodCalendar defines the calendar boundaries a PeriodicAllowance can reset at.
+type PeriodCalendar int32
+
+const (
+	// PERIOD_CALENDAR_UNSPECIFIED defines a period of a rolling duration.
+	PeriodCalendarUnspecified PeriodCalendar = 0
+	// PERIOD_CALENDAR_DAILY defines a period which resets every day at midnight UTC.
+	PeriodCalendarDaily PeriodCalendar = 1
+	// PERIOD_CALENDAR_WEEKLY defines a period which resets every Monday at midnight UTC.
+	PeriodCalendarWeekly PeriodCalendar = 2
+	// PERIOD_CALENDAR_MONTHLY defines a period which resets on the first day of every month
+	// at midnight UTC.
+	PeriodCalendarMonthly PeriodCalendar = 3
+)
+
+var PeriodCalendar_name = map[int32]string{
+	0: "PERIOD_CALENDAR_UNSPECIFIED",
+	1: "PERIOD_CALENDAR_DAILY",
+	2: "PERIOD_CALENDAR_WEEKLY",
+	3: "PERIOD_CALENDAR_MONTHLY",
+}
+
+var PeriodCalendar_value = map[string]int32{
+	"PERIOD_CALENDAR_UNSPECIFIED": 0,
+	"PERIOD_CALENDAR_DAILY":       1,
+	"PERIOD_CALENDAR_WEEKLY":      2,
+	"PERIOD_CALENDAR_MONTHLY":     3,
+}
+
+func (x PeriodCalendar) String() string {
+	return proto.EnumName(PeriodCalendar_name, int32(x))
+}
+
+func (PeriodCalendar) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{0}
+}
+
 // BasicAllowance implements Allowance with a one-time grant of coins
 // that optionally expires. The grantee can use up to SpendLimit to cover fees.
 type BasicAllowance struct {
@@ -108,6 +145,12 @@ type PeriodicAllowance struct {
 	// it is calculated from the start time of the first transaction after the
 	// last period ended
 	PeriodReset time.Time `protobuf:"bytes,5,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+	// auto_renewal optionally refreshes the basic spend limit at every period reset,
+	// so the granter doesn't have to grant a new allowance once it is used up.
+	AutoRenewal *AutoRenewal `protobuf:"bytes,6,opt,name=auto_renewal,json=autoRenewal,proto3" json:"auto_renewal,omitempty"`
+	// calendar optionally anchors the period resets to calendar boundaries in UTC instead of
+	// rolling durations, e.g. every day at midnight. If it is set, period must be zero.
+	Calendar PeriodCalendar `protobuf:"varint,7,opt,name=calendar,proto3,enum=cosmos.feegrant.v1beta1.PeriodCalendar" json:"calendar,omitempty"`
 }
 
 func (m *PeriodicAllowance) Reset()         { *m = PeriodicAllowance{} }
@@ -178,6 +221,95 @@ func (m *PeriodicAllowance) GetPeriodReset() time.Time {
 	return time.Time{}
 }
 
+func (m *PeriodicAllowance) GetAutoRenewal() *AutoRenewal {
+	if m != nil {
+		return m.AutoRenewal
+	}
+	return nil
+}
+
+func (m *PeriodicAllowance) GetCalendar() PeriodCalendar {
+	if m != nil {
+		return m.Calendar
+	}
+	return PeriodCalendarUnspecified
+}
+
+// AutoRenewal defines how the basic spend limit of a PeriodicAllowance is renewed.
+// At least one of max_renewals and end_time must be set.
+type AutoRenewal struct {
+	// spend_limit is the basic spend limit which is restored at every period reset.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// max_renewals is the maximum number of times the spend limit is renewed,
+	// zero means there is no limit on the number of renewals.
+	MaxRenewals uint64 `protobuf:"varint,2,opt,name=max_renewals,json=maxRenewals,proto3" json:"max_renewals,omitempty"`
+	// end_time is an optional time after which the spend limit is no longer renewed.
+	EndTime *time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// renewals is the number of times the spend limit has been renewed so far.
+	Renewals uint64 `protobuf:"varint,4,opt,name=renewals,proto3" json:"renewals,omitempty"`
+}
+
+func (m *AutoRenewal) Reset()         { *m = AutoRenewal{} }
+func (m *AutoRenewal) String() string { return proto.CompactTextString(m) }
+func (*AutoRenewal) ProtoMessage()    {}
+func (*AutoRenewal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{2}
+}
+func (m *AutoRenewal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRenewal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRenewal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRenewal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRenewal.Merge(m, src)
+}
+func (m *AutoRenewal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRenewal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRenewal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRenewal proto.InternalMessageInfo
+
+func (m *AutoRenewal) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *AutoRenewal) GetMaxRenewals() uint64 {
+	if m != nil {
+		return m.MaxRenewals
+	}
+	return 0
+}
+
+func (m *AutoRenewal) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *AutoRenewal) GetRenewals() uint64 {
+	if m != nil {
+		return m.Renewals
+	}
+	return 0
+}
+
 // AllowedMsgAllowance creates allowance only for specified message types.
 type AllowedMsgAllowance struct {
 	// allowance can be any of basic and periodic fee allowance.
@@ -190,7 +322,7 @@ func (m *AllowedMsgAllowance) Reset()         { *m = AllowedMsgAllowance{} }
 func (m *AllowedMsgAllowance) String() string { return proto.CompactTextString(m) }
 func (*AllowedMsgAllowance) ProtoMessage()    {}
 func (*AllowedMsgAllowance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{2}
+	return fileDescriptor_7279582900c30aea, []int{3}
 }
 func (m *AllowedMsgAllowance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,13 +359,20 @@ type Grant struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic, periodic, allowed fee allowance.
 	Allowance *any.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// parent_grantee is set if this grant is a sub-grant carved out of the allowance
+	// granted by granter to parent_grantee. Fees paid with a sub-grant are also
+	// deducted from the allowance of the parent grant.
+	ParentGrantee string `protobuf:"bytes,4,opt,name=parent_grantee,json=parentGrantee,proto3" json:"parent_grantee,omitempty"`
+	// allow_sub_grants specifies if the grantee can carve out sub-grants of this
+	// allowance to other accounts.
+	AllowSubGrants bool `protobuf:"varint,5,opt,name=allow_sub_grants,json=allowSubGrants,proto3" json:"allow_sub_grants,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,384 +422,3843 @@ func (m *Grant) GetAllowance() *any.Any {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
-	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
-	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
-	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+func (m *Grant) GetParentGrantee() string {
+	if m != nil {
+		return m.ParentGrantee
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("cosmos/feegrant/v1beta1/feegrant.proto", fileDescriptor_7279582900c30aea)
+func (m *Grant) GetAllowSubGrants() bool {
+	if m != nil {
+		return m.AllowSubGrants
+	}
+	return false
 }
 
-var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+// GroupGrant is stored in the KVStore to record an allowance which is shared by a
+// group of grantees, each of them optionally limited by its own spend limit.
+type GroupGrant struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// name identifies the group grant among the group grants of the granter.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// allowance is the allowance shared by all the members, it can be any of basic,
+	// periodic, allowed fee allowance.
+	Allowance *any.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// members are the grantees of the group grant.
+	Members []GroupGrantMember `protobuf:"bytes,4,rep,name=members,proto3" json:"members"`
 }
 
-func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GroupGrant) Reset()         { *m = GroupGrant{} }
+func (m *GroupGrant) String() string { return proto.CompactTextString(m) }
+func (*GroupGrant) ProtoMessage()    {}
+func (*GroupGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *GroupGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GroupGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupGrant.Merge(m, src)
+}
+func (m *GroupGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupGrant.DiscardUnknown(m)
 }
 
-func (m *BasicAllowance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GroupGrant proto.InternalMessageInfo
+
+func (m *GroupGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
 }
 
-func (m *BasicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintFeegrant(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x12
+func (m *GroupGrant) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.SpendLimit) > 0 {
-		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFeegrant(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *GroupGrant) GetAllowance() *any.Any {
+	if m != nil {
+		return m.Allowance
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PeriodicAllowance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GroupGrant) GetMembers() []GroupGrantMember {
+	if m != nil {
+		return m.Members
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PeriodicAllowance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GroupGrantMember is a grantee of a GroupGrant.
+type GroupGrantMember struct {
+	// grantee is the address of the member.
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// spend_limit specifies the maximum amount of coins that the member can spend
+	// from the shared allowance. If it is empty, the member is only limited by the
+	// shared allowance.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// spent is the amount of coins that the member has spent from the shared allowance.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
 }
 
-func (m *PeriodicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintFeegrant(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x2a
-	if len(m.PeriodCanSpend) > 0 {
-		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFeegrant(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.PeriodSpendLimit) > 0 {
-		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFeegrant(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *GroupGrantMember) Reset()         { *m = GroupGrantMember{} }
+func (m *GroupGrantMember) String() string { return proto.CompactTextString(m) }
+func (*GroupGrantMember) ProtoMessage()    {}
+func (*GroupGrantMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{6}
+}
+func (m *GroupGrantMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupGrantMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupGrantMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
-	if err3 != nil {
-		return 0, err3
+}
+func (m *GroupGrantMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupGrantMember.Merge(m, src)
+}
+func (m *GroupGrantMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupGrantMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupGrantMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupGrantMember proto.InternalMessageInfo
+
+func (m *GroupGrantMember) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	i -= n3
-	i = encodeVarintFeegrant(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Basic.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintFeegrant(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *GroupGrantMember) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AllowedMsgAllowance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GroupGrantMember) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AllowedMsgAllowance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GrantUsage records how the allowance of a grant has been consumed.
+type GrantUsage struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// spent is the cumulative amount of fees paid with the allowance.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// use_count is the number of transactions whose fees have been paid with the allowance.
+	UseCount uint64 `protobuf:"varint,4,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
 }
 
-func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedMessages) > 0 {
-		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedMessages[iNdEx])
-			copy(dAtA[i:], m.AllowedMessages[iNdEx])
-			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *GrantUsage) Reset()         { *m = GrantUsage{} }
+func (m *GrantUsage) String() string { return proto.CompactTextString(m) }
+func (*GrantUsage) ProtoMessage()    {}
+func (*GrantUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{7}
+}
+func (m *GrantUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+}
+func (m *GrantUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantUsage.Merge(m, src)
+}
+func (m *GrantUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantUsage proto.InternalMessageInfo
+
+func (m *GrantUsage) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *GrantUsage) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *GrantUsage) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *GrantUsage) GetUseCount() uint64 {
+	if m != nil {
+		return m.UseCount
+	}
+	return 0
+}
+
+// MsgCountLimit limits the number of messages of a single type which can be paid for
+// by an AllowedMsgCountAllowance within a period.
+type MsgCountLimit struct {
+	// msg_type_url is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// max_count is the maximum number of messages of this type which can be paid for in a period.
+	MaxCount uint64 `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	// count is the number of messages of this type which have been paid for in the current period.
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MsgCountLimit) Reset()         { *m = MsgCountLimit{} }
+func (m *MsgCountLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgCountLimit) ProtoMessage()    {}
+func (*MsgCountLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{8}
+}
+func (m *MsgCountLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCountLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCountLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCountLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCountLimit.Merge(m, src)
+}
+func (m *MsgCountLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCountLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCountLimit.DiscardUnknown(m)
 }
 
-func (m *Grant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCountLimit proto.InternalMessageInfo
+
+func (m *MsgCountLimit) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCountLimit) GetMaxCount() uint64 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
 }
 
-func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCountLimit) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// AllowedMsgCountAllowance creates allowance only for specified message types and limits
+// the number of messages of each type which can be paid for within a period.
+type AllowedMsgCountAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// period specifies the time duration after which the message counts are reset.
+	Period time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+	// limits are the allowed message types together with their per period limits.
+	Limits []MsgCountLimit `protobuf:"bytes,3,rep,name=limits,proto3" json:"limits"`
+	// period_reset is the time at which the current period ends and the message counts are reset.
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *AllowedMsgCountAllowance) Reset()         { *m = AllowedMsgCountAllowance{} }
+func (m *AllowedMsgCountAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsgCountAllowance) ProtoMessage()    {}
+func (*AllowedMsgCountAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{9}
+}
+func (m *AllowedMsgCountAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsgCountAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsgCountAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsgCountAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsgCountAllowance.Merge(m, src)
+}
+func (m *AllowedMsgCountAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsgCountAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsgCountAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsgCountAllowance proto.InternalMessageInfo
+
+// GasPriceCapAllowance wraps another allowance and only covers fees which do not
+// exceed the configured maximum gas price of their denom.
+type GasPriceCapAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_gas_prices are the maximum gas prices which can be paid for with this allowance.
+	// Fees in a denom without a maximum gas price are rejected.
+	MaxGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=max_gas_prices,json=maxGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"max_gas_prices"`
+}
+
+func (m *GasPriceCapAllowance) Reset()         { *m = GasPriceCapAllowance{} }
+func (m *GasPriceCapAllowance) String() string { return proto.CompactTextString(m) }
+func (*GasPriceCapAllowance) ProtoMessage()    {}
+func (*GasPriceCapAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{10}
+}
+func (m *GasPriceCapAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceCapAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceCapAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceCapAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceCapAllowance.Merge(m, src)
+}
+func (m *GasPriceCapAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceCapAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceCapAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceCapAllowance proto.InternalMessageInfo
+
+// AllowedDenomAllowance wraps another allowance and only covers fees which are
+// denominated in one of the allowed denoms.
+type AllowedDenomAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_denoms are the denoms in which fees can be paid with the allowance.
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+}
+
+func (m *AllowedDenomAllowance) Reset()         { *m = AllowedDenomAllowance{} }
+func (m *AllowedDenomAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedDenomAllowance) ProtoMessage()    {}
+func (*AllowedDenomAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{11}
+}
+func (m *AllowedDenomAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedDenomAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedDenomAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedDenomAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedDenomAllowance.Merge(m, src)
+}
+func (m *AllowedDenomAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedDenomAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedDenomAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedDenomAllowance proto.InternalMessageInfo
+
+// MsgSpendLimit limits the fees which can be paid for transactions containing messages
+// of a single type by a BudgetAllowance.
+type MsgSpendLimit struct {
+	// msg_type_url is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// spend_limit is the amount of the budget which can still be spent on transactions
+	// containing messages of this type.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *MsgSpendLimit) Reset()         { *m = MsgSpendLimit{} }
+func (m *MsgSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSpendLimit) ProtoMessage()    {}
+func (*MsgSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{12}
+}
+func (m *MsgSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpendLimit.Merge(m, src)
+}
+func (m *MsgSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSpendLimit proto.InternalMessageInfo
+
+func (m *MsgSpendLimit) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgSpendLimit) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// BudgetAllowance tracks a single total budget, the spend limit of basic, and splits it
+// into sub-limits per message type. Only transactions whose messages all have a sub-limit
+// are covered.
+type BudgetAllowance struct {
+	// basic holds the total budget and the optional expiration of the allowance.
+	Basic BasicAllowance `protobuf:"bytes,1,opt,name=basic,proto3" json:"basic"`
+	// msg_spend_limits are the sub-limits of the budget per message type, their sum must
+	// not exceed the total budget.
+	MsgSpendLimits []MsgSpendLimit `protobuf:"bytes,2,rep,name=msg_spend_limits,json=msgSpendLimits,proto3" json:"msg_spend_limits"`
+}
+
+func (m *BudgetAllowance) Reset()         { *m = BudgetAllowance{} }
+func (m *BudgetAllowance) String() string { return proto.CompactTextString(m) }
+func (*BudgetAllowance) ProtoMessage()    {}
+func (*BudgetAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{13}
+}
+func (m *BudgetAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BudgetAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BudgetAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BudgetAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetAllowance.Merge(m, src)
+}
+func (m *BudgetAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BudgetAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BudgetAllowance proto.InternalMessageInfo
+
+func (m *BudgetAllowance) GetBasic() BasicAllowance {
+	if m != nil {
+		return m.Basic
+	}
+	return BasicAllowance{}
+}
+
+func (m *BudgetAllowance) GetMsgSpendLimits() []MsgSpendLimit {
+	if m != nil {
+		return m.MsgSpendLimits
+	}
+	return nil
+}
+
+// AndAllowance combines allowances which must all accept a fee for it to be covered.
+// The fee is deducted from all of them.
+type AndAllowance struct {
+	// allowances are the combined allowances, at least two are required.
+	Allowances []*any.Any `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+}
+
+func (m *AndAllowance) Reset()         { *m = AndAllowance{} }
+func (m *AndAllowance) String() string { return proto.CompactTextString(m) }
+func (*AndAllowance) ProtoMessage()    {}
+func (*AndAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{14}
+}
+func (m *AndAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AndAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AndAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AndAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AndAllowance.Merge(m, src)
+}
+func (m *AndAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AndAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AndAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AndAllowance proto.InternalMessageInfo
+
+// OrAllowance combines allowances of which any can accept a fee for it to be covered.
+// The fee is deducted from the first allowance, in order, which accepts it.
+type OrAllowance struct {
+	// allowances are the combined allowances, at least two are required.
+	Allowances []*any.Any `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+}
+
+func (m *OrAllowance) Reset()         { *m = OrAllowance{} }
+func (m *OrAllowance) String() string { return proto.CompactTextString(m) }
+func (*OrAllowance) ProtoMessage()    {}
+func (*OrAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{15}
+}
+func (m *OrAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrAllowance.Merge(m, src)
+}
+func (m *OrAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrAllowance proto.InternalMessageInfo
+
+// Params defines the parameters of the feegrant module.
+type Params struct {
+	// max_prune_per_block is the maximum number of expired allowances removed
+	// from the store at the end of every block.
+	MaxPrunePerBlock uint64 `protobuf:"varint,1,opt,name=max_prune_per_block,json=maxPrunePerBlock,proto3" json:"max_prune_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{16}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxPrunePerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunePerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.feegrant.v1beta1.PeriodCalendar", PeriodCalendar_name, PeriodCalendar_value)
+	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
+	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
+	proto.RegisterType((*AutoRenewal)(nil), "cosmos.feegrant.v1beta1.AutoRenewal")
+	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*GroupGrant)(nil), "cosmos.feegrant.v1beta1.GroupGrant")
+	proto.RegisterType((*GroupGrantMember)(nil), "cosmos.feegrant.v1beta1.GroupGrantMember")
+	proto.RegisterType((*GrantUsage)(nil), "cosmos.feegrant.v1beta1.GrantUsage")
+	proto.RegisterType((*MsgCountLimit)(nil), "cosmos.feegrant.v1beta1.MsgCountLimit")
+	proto.RegisterType((*AllowedMsgCountAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgCountAllowance")
+	proto.RegisterType((*GasPriceCapAllowance)(nil), "cosmos.feegrant.v1beta1.GasPriceCapAllowance")
+	proto.RegisterType((*AllowedDenomAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedDenomAllowance")
+	proto.RegisterType((*MsgSpendLimit)(nil), "cosmos.feegrant.v1beta1.MsgSpendLimit")
+	proto.RegisterType((*BudgetAllowance)(nil), "cosmos.feegrant.v1beta1.BudgetAllowance")
+	proto.RegisterType((*AndAllowance)(nil), "cosmos.feegrant.v1beta1.AndAllowance")
+	proto.RegisterType((*OrAllowance)(nil), "cosmos.feegrant.v1beta1.OrAllowance")
+	proto.RegisterType((*Params)(nil), "cosmos.feegrant.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feegrant/v1beta1/feegrant.proto", fileDescriptor_7279582900c30aea)
+}
+
+var fileDescriptor_7279582900c30aea = []byte{
+	// 1439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0xce, 0xdf, 0x38, 0x71, 0xdd, 0x6d, 0xda, 0x38, 0x6e, 0xb1, 0xdd, 0x85, 0x96,
+	0x34, 0x28, 0xb6, 0x1a, 0x10, 0x07, 0x23, 0x41, 0xfd, 0x93, 0x26, 0x86, 0x24, 0x35, 0x9b, 0x46,
+	0x55, 0x8b, 0xd0, 0x6a, 0xbc, 0x3b, 0xdd, 0xae, 0xe2, 0xdd, 0xb5, 0x76, 0x76, 0x69, 0x7c, 0x2d,
+	0x97, 0x2a, 0x08, 0xe8, 0x11, 0x21, 0x45, 0xaa, 0xc4, 0x05, 0xc1, 0xa5, 0x87, 0x0a, 0x01, 0x67,
+	0x0e, 0x15, 0xa7, 0x8a, 0x53, 0x4f, 0x14, 0xb5, 0x87, 0x9c, 0xb9, 0x71, 0x44, 0xf3, 0xb3, 0xf6,
+	0xda, 0x49, 0xda, 0x98, 0x50, 0xb7, 0x97, 0x64, 0x67, 0xe6, 0xbd, 0x6f, 0xde, 0xfb, 0xde, 0xcf,
+	0xcc, 0x18, 0x9c, 0x55, 0x6d, 0x6c, 0xda, 0x38, 0x7f, 0x1d, 0x21, 0xdd, 0x81, 0x96, 0x9b, 0xff,
+	0xec, 0x7c, 0x1d, 0xb9, 0xf0, 0x7c, 0x7b, 0x22, 0xd7, 0x74, 0x6c, 0xd7, 0x16, 0xa7, 0x98, 0x5c,
+	0xae, 0x3d, 0xcd, 0xe5, 0x52, 0x93, 0xba, 0xad, 0xdb, 0x54, 0x26, 0x4f, 0xbe, 0x98, 0x78, 0x6a,
+	0x5a, 0xb7, 0x6d, 0xbd, 0x81, 0xf2, 0x74, 0x54, 0xf7, 0xae, 0xe7, 0xa1, 0xd5, 0xf2, 0x97, 0x18,
+	0x92, 0xc2, 0x74, 0x38, 0x2c, 0x5b, 0x4a, 0x73, 0x63, 0xea, 0x10, 0xa3, 0xb6, 0x21, 0xaa, 0x6d,
+	0x58, 0x7c, 0xfd, 0x28, 0x34, 0x0d, 0xcb, 0xce, 0xd3, 0xbf, 0x7c, 0x2a, 0xd3, 0xbb, 0x91, 0x6b,
+	0x98, 0x08, 0xbb, 0xd0, 0x6c, 0xfa, 0x98, 0xbd, 0x02, 0x9a, 0xe7, 0x40, 0xd7, 0xb0, 0x39, 0xa6,
+	0x74, 0x37, 0x0c, 0xe2, 0x25, 0x88, 0x0d, 0xb5, 0xd8, 0x68, 0xd8, 0x37, 0xa1, 0xa5, 0x22, 0xf1,
+	0x96, 0x00, 0x62, 0xb8, 0x89, 0x2c, 0x4d, 0x69, 0x18, 0xa6, 0xe1, 0x26, 0x85, 0x6c, 0x64, 0x26,
+	0x36, 0x3f, 0x9d, 0xe3, 0xb6, 0x12, 0xeb, 0x7c, 0xf7, 0x73, 0x65, 0xdb, 0xb0, 0x4a, 0x17, 0x1f,
+	0xfc, 0x99, 0x09, 0xfd, 0xf0, 0x38, 0x33, 0xa3, 0x1b, 0xee, 0x0d, 0xaf, 0x9e, 0x53, 0x6d, 0x93,
+	0x3b, 0xc6, 0xff, 0xcd, 0x61, 0x6d, 0x23, 0xef, 0xb6, 0x9a, 0x08, 0x53, 0x05, 0xfc, 0xed, 0xce,
+	0xbd, 0xd9, 0xf1, 0x06, 0xd2, 0xa1, 0xda, 0x52, 0x88, 0x7f, 0xf8, 0xfb, 0x9d, 0x7b, 0xb3, 0x82,
+	0x0c, 0xe8, 0xae, 0xcb, 0x64, 0x53, 0xf1, 0x02, 0x00, 0x68, 0xb3, 0x69, 0x30, 0x5b, 0x93, 0xe1,
+	0xac, 0x30, 0x13, 0x9b, 0x4f, 0xe5, 0x98, 0x33, 0x39, 0xdf, 0x99, 0xdc, 0x65, 0xdf, 0xdb, 0x52,
+	0xf4, 0xce, 0xe3, 0x8c, 0x20, 0x07, 0x74, 0x0a, 0x8b, 0xbf, 0xdf, 0x9f, 0x3b, 0xb3, 0x4f, 0xd8,
+	0x72, 0x17, 0x11, 0x6a, 0x3b, 0x5c, 0xdd, 0xda, 0xb9, 0x37, 0x3b, 0x1d, 0xb0, 0xb4, 0x9b, 0x0f,
+	0x69, 0x67, 0x08, 0x1c, 0xad, 0x21, 0xc7, 0xb0, 0xb5, 0x20, 0x4b, 0x4b, 0x60, 0xa8, 0x4e, 0xe4,
+	0x92, 0x02, 0xb5, 0xed, 0xcd, 0xdc, 0x7e, 0x5b, 0x75, 0xa3, 0x95, 0xc6, 0x08, 0x59, 0xcc, 0x5f,
+	0x06, 0x20, 0x5e, 0x00, 0xc3, 0x4d, 0x0a, 0xcf, 0xdd, 0x9c, 0xde, 0xe5, 0x66, 0x85, 0xc7, 0xac,
+	0x34, 0x41, 0x94, 0xbf, 0x79, 0x9c, 0x11, 0x18, 0x00, 0xd7, 0x13, 0xbf, 0x16, 0x80, 0xc8, 0x3e,
+	0x95, 0x60, 0xe0, 0x22, 0x83, 0x0a, 0x5c, 0x82, 0x6d, 0xbe, 0xd6, 0x09, 0xdf, 0x17, 0x02, 0xe0,
+	0x93, 0x8a, 0x0a, 0x2d, 0x66, 0x55, 0x32, 0x3a, 0x28, 0x7b, 0xe2, 0x6c, 0xeb, 0x32, 0xb4, 0xa8,
+	0x49, 0xe2, 0x32, 0x18, 0xe7, 0xc6, 0x38, 0x08, 0x23, 0x37, 0x39, 0xf4, 0xdc, 0x74, 0xa2, 0x44,
+	0xdf, 0x69, 0x13, 0x1d, 0x63, 0xea, 0x32, 0xd1, 0x16, 0x17, 0xc1, 0x38, 0xf4, 0x5c, 0x5b, 0x71,
+	0x90, 0x85, 0x6e, 0xc2, 0x46, 0x72, 0x98, 0xa2, 0xbd, 0xb1, 0x6f, 0x02, 0x14, 0x3d, 0xd7, 0x96,
+	0x99, 0xac, 0x1c, 0x83, 0x9d, 0x81, 0x58, 0x06, 0xa3, 0x2a, 0x6c, 0x20, 0x4b, 0x83, 0x4e, 0x72,
+	0x24, 0x2b, 0xcc, 0xc4, 0x9f, 0x91, 0x45, 0x35, 0xee, 0x11, 0x13, 0x97, 0xdb, 0x8a, 0x85, 0x0f,
+	0xfb, 0x4a, 0xf3, 0x53, 0x01, 0x1e, 0x77, 0xe5, 0xb4, 0xf4, 0x55, 0x18, 0xc4, 0x02, 0xd6, 0xbe,
+	0x1a, 0x9d, 0xe0, 0x34, 0x18, 0x37, 0xe1, 0xa6, 0xcf, 0x36, 0xa6, 0x45, 0x12, 0x95, 0x63, 0x26,
+	0xdc, 0xe4, 0x66, 0x62, 0xf1, 0x3d, 0x30, 0x4a, 0x8c, 0x24, 0xbd, 0x2f, 0x19, 0x39, 0x60, 0xab,
+	0x18, 0x41, 0x96, 0x46, 0xe6, 0xc4, 0x14, 0x18, 0x6d, 0x63, 0x47, 0x29, 0x76, 0x7b, 0x2c, 0xfd,
+	0x2d, 0x80, 0x63, 0x94, 0x1e, 0xa4, 0xad, 0x60, 0xbd, 0x53, 0xfc, 0x9f, 0x82, 0x31, 0xe8, 0x0f,
+	0x78, 0x03, 0x98, 0xdc, 0xb5, 0x63, 0xd1, 0x6a, 0x95, 0xce, 0x1d, 0x38, 0x3a, 0x72, 0x07, 0x51,
+	0x3c, 0x07, 0x12, 0x90, 0xed, 0xaa, 0x98, 0x08, 0x63, 0xa8, 0x23, 0xe2, 0x76, 0x64, 0x66, 0x4c,
+	0x3e, 0xc2, 0xe7, 0x57, 0xf8, 0x74, 0xa1, 0x76, 0xfb, 0x6e, 0x26, 0xd4, 0x57, 0x0a, 0xa4, 0x03,
+	0x91, 0xd8, 0xc3, 0x37, 0xe9, 0x97, 0x30, 0x18, 0x5a, 0x24, 0x10, 0xe2, 0x3c, 0x18, 0xa1, 0x58,
+	0xc8, 0xa1, 0x3e, 0x8e, 0x95, 0x92, 0x7f, 0xdc, 0x9f, 0x9b, 0xe4, 0x1b, 0x15, 0x35, 0xcd, 0x41,
+	0x18, 0xaf, 0xb9, 0x8e, 0x61, 0xe9, 0xb2, 0x2f, 0xd8, 0xd1, 0x41, 0xc9, 0xf0, 0xc1, 0x74, 0x7a,
+	0xd8, 0x8c, 0xfc, 0xef, 0x6c, 0x7e, 0x00, 0xe2, 0x4d, 0xe8, 0x20, 0xcb, 0x55, 0x7c, 0xcb, 0xa2,
+	0xcf, 0xb1, 0x6c, 0x82, 0xc9, 0x2f, 0x72, 0xfb, 0x66, 0x78, 0x38, 0x14, 0xec, 0xd5, 0x19, 0x06,
+	0xa6, 0x2d, 0x64, 0x54, 0x8e, 0xd3, 0xf9, 0x35, 0xaf, 0x4e, 0x45, 0xb1, 0xf4, 0x79, 0x18, 0x80,
+	0x45, 0xc7, 0xf6, 0x9a, 0xff, 0x9d, 0x40, 0x11, 0x44, 0x2d, 0x68, 0x72, 0xf6, 0x64, 0xfa, 0xfd,
+	0xa2, 0x09, 0x5a, 0x05, 0x23, 0x26, 0x32, 0xeb, 0xc8, 0xc1, 0xbc, 0x45, 0x9f, 0xdb, 0xb7, 0x0d,
+	0x75, 0x9c, 0x5b, 0xa1, 0x1a, 0xc1, 0xe3, 0xcc, 0x07, 0x91, 0x7e, 0x0b, 0x83, 0x44, 0xaf, 0x60,
+	0x30, 0x31, 0x84, 0x83, 0x26, 0x46, 0x6f, 0xff, 0x09, 0xbf, 0x8c, 0xfe, 0x73, 0x13, 0x0c, 0x91,
+	0xd1, 0x00, 0x8f, 0x53, 0xb6, 0x9f, 0xb4, 0x45, 0x93, 0x09, 0x5a, 0xee, 0x3a, 0x29, 0xf5, 0x81,
+	0x55, 0xe3, 0xcb, 0xf2, 0x57, 0x3c, 0x09, 0xc6, 0x3c, 0x8c, 0x14, 0xd5, 0xf6, 0x2c, 0xd7, 0xef,
+	0xc4, 0x1e, 0x46, 0x65, 0x32, 0x96, 0x34, 0x30, 0xb1, 0x82, 0x75, 0xfa, 0xcd, 0xc2, 0x92, 0x05,
+	0xe3, 0x26, 0xd6, 0x15, 0x82, 0xae, 0x78, 0x4e, 0x83, 0x71, 0x22, 0x03, 0x13, 0xeb, 0x97, 0x5b,
+	0x4d, 0xb4, 0xee, 0x34, 0x08, 0x1e, 0x39, 0x38, 0x18, 0x1e, 0x3b, 0x35, 0x46, 0x4d, 0xb8, 0x49,
+	0x31, 0xc4, 0x49, 0x30, 0xc4, 0x16, 0x22, 0x74, 0x81, 0x0d, 0xa4, 0x1f, 0x23, 0x20, 0xd9, 0xe9,
+	0x89, 0x54, 0x72, 0x60, 0x4d, 0xff, 0xf0, 0xd7, 0xc0, 0x2a, 0x18, 0xa6, 0x75, 0x82, 0x79, 0xe8,
+	0xce, 0xee, 0x5b, 0xc6, 0x5d, 0x54, 0x06, 0x6b, 0x98, 0x03, 0xec, 0xba, 0x31, 0x45, 0x0f, 0x73,
+	0x63, 0x2a, 0xac, 0xf7, 0x7d, 0x48, 0xbd, 0xbe, 0xe7, 0x21, 0xd5, 0x1d, 0x10, 0xe9, 0x51, 0x18,
+	0x4c, 0x2e, 0x42, 0x5c, 0x73, 0x0c, 0x15, 0x95, 0x61, 0x73, 0x60, 0x91, 0xfa, 0x52, 0x00, 0x71,
+	0x92, 0x59, 0x3a, 0x24, 0xcf, 0x38, 0x43, 0xe5, 0xa7, 0x73, 0x6c, 0xfe, 0xd4, 0x9e, 0xb5, 0x52,
+	0x41, 0x2a, 0x2d, 0x97, 0x2a, 0x2f, 0x97, 0xb7, 0x0e, 0x50, 0x2e, 0x5c, 0x67, 0xbf, 0x8a, 0x21,
+	0x37, 0x22, 0xdf, 0x73, 0x5c, 0xf8, 0xb8, 0x6f, 0x7a, 0x33, 0x81, 0x0d, 0xf7, 0x62, 0x90, 0x5c,
+	0x7c, 0x8e, 0x73, 0xde, 0x2b, 0xc8, 0xb2, 0xcd, 0x81, 0x71, 0x7b, 0x06, 0xc4, 0xfd, 0xab, 0x8f,
+	0x46, 0x36, 0xf6, 0x2f, 0x3e, 0x13, 0x30, 0x60, 0x0d, 0x2e, 0xc8, 0x7d, 0xbb, 0x9c, 0xdd, 0x9d,
+	0x51, 0xdd, 0x9e, 0x49, 0x3f, 0x09, 0xb4, 0xc7, 0x04, 0x5e, 0x31, 0xcf, 0xef, 0x31, 0xaf, 0xc2,
+	0x09, 0x25, 0xdd, 0x0a, 0x83, 0x23, 0x25, 0x4f, 0xd3, 0x91, 0xfb, 0x22, 0x9e, 0xa7, 0x9f, 0x80,
+	0x04, 0x21, 0x21, 0xe0, 0xa5, 0x9f, 0xee, 0xcf, 0xec, 0x2f, 0x1d, 0x1a, 0x83, 0x98, 0x71, 0x33,
+	0xb8, 0x82, 0x0b, 0x4b, 0x7d, 0xc5, 0x30, 0x15, 0x7c, 0xa4, 0x77, 0x3b, 0x2c, 0xfd, 0x2a, 0x80,
+	0xf1, 0xa2, 0xa5, 0x75, 0x18, 0x50, 0x00, 0x68, 0xa7, 0x15, 0xe6, 0x4f, 0x97, 0x43, 0x67, 0x6a,
+	0x00, 0xb2, 0x50, 0xed, 0x3b, 0x07, 0xa7, 0x82, 0x39, 0x18, 0xb0, 0x55, 0xfa, 0x59, 0x00, 0xb1,
+	0x4b, 0xce, 0x00, 0x6d, 0x5f, 0xea, 0xdb, 0xf6, 0x13, 0x01, 0xdb, 0x03, 0xa6, 0x4a, 0xd7, 0xc0,
+	0x70, 0x0d, 0x3a, 0xd0, 0xc4, 0xe2, 0x1c, 0x38, 0x46, 0xba, 0x62, 0xd3, 0xf1, 0x2c, 0xa4, 0x34,
+	0x91, 0xa3, 0xd4, 0x1b, 0xb6, 0xba, 0x41, 0x13, 0x30, 0x2a, 0x27, 0x4c, 0xb8, 0x59, 0x23, 0x2b,
+	0x35, 0xe4, 0x94, 0xc8, 0x7c, 0xe1, 0x74, 0xef, 0x7b, 0x74, 0xb3, 0xf3, 0x2b, 0x1c, 0x43, 0x9c,
+	0xfd, 0x47, 0x00, 0xf1, 0xee, 0x87, 0xaf, 0xf8, 0x3e, 0x38, 0x59, 0x5b, 0x90, 0xab, 0x97, 0x2a,
+	0x4a, 0xb9, 0xb8, 0xbc, 0xb0, 0x5a, 0x29, 0xca, 0xca, 0xfa, 0xea, 0x5a, 0x6d, 0xa1, 0x5c, 0xbd,
+	0x58, 0x5d, 0xa8, 0x24, 0x42, 0xa9, 0xd7, 0xb6, 0xb6, 0xb3, 0xd3, 0xdd, 0x4a, 0xeb, 0x16, 0x6e,
+	0x22, 0xd5, 0xb8, 0x6e, 0x20, 0x4d, 0x9c, 0x07, 0xc7, 0x7b, 0xf5, 0x2b, 0xc5, 0xea, 0xf2, 0xd5,
+	0x84, 0x90, 0x9a, 0xda, 0xda, 0xce, 0x1e, 0xeb, 0xd6, 0xac, 0x40, 0xa3, 0xd1, 0x12, 0xdf, 0x01,
+	0x27, 0x7a, 0x75, 0xae, 0x2c, 0x2c, 0x7c, 0xb4, 0x7c, 0x35, 0x11, 0x4e, 0x25, 0xb7, 0xb6, 0xb3,
+	0x93, 0xdd, 0x4a, 0x57, 0x10, 0xda, 0x68, 0xb4, 0xc4, 0x77, 0xc1, 0x54, 0xaf, 0xd6, 0xca, 0xa5,
+	0xd5, 0xcb, 0x4b, 0xcb, 0x57, 0x13, 0x91, 0xd4, 0xf4, 0xd6, 0x76, 0xf6, 0x78, 0xb7, 0xda, 0x8a,
+	0x6d, 0xb9, 0x37, 0x1a, 0xad, 0x54, 0xf4, 0xf6, 0x77, 0xe9, 0x50, 0xe9, 0xfc, 0x83, 0x27, 0x69,
+	0xe1, 0xe1, 0x93, 0xb4, 0xf0, 0xd7, 0x93, 0xb4, 0x70, 0xe7, 0x69, 0x3a, 0xf4, 0xf0, 0x69, 0x3a,
+	0xf4, 0xe8, 0x69, 0x3a, 0x74, 0x8d, 0x27, 0x11, 0xd6, 0x36, 0x72, 0x86, 0x1d, 0x20, 0xad, 0x3e,
+	0x4c, 0x13, 0xe3, 0xed, 0x7f, 0x07, 0x00, 0xe2, 0x9c, 0xaa, 0x24, 0xd4, 0x14, 0x00, 0x00,
+}
+
+func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasicAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFeegrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodicAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Calendar != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Calendar))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoRenewal != nil {
+		{
+			size, err := m.AutoRenewal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFeegrant(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintFeegrant(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Basic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeegrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRenewal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRenewal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRenewal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Renewals != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Renewals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintFeegrant(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxRenewals != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxRenewals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedMsgAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsgAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowSubGrants {
+		i--
+		if m.AllowSubGrants {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ParentGrantee) > 0 {
+		i -= len(m.ParentGrantee)
+		copy(dAtA[i:], m.ParentGrantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.ParentGrantee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupGrantMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupGrantMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupGrantMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UseCount != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.UseCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCountLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCountLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCountLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxCount != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedMsgCountAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsgCountAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsgCountAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintFeegrant(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintFeegrant(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceCapAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceCapAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceCapAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxGasPrices) > 0 {
+		for iNdEx := len(m.MaxGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedDenomAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedDenomAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedDenomAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BudgetAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgSpendLimits) > 0 {
+		for iNdEx := len(m.MsgSpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgSpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Basic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeegrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AndAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AndAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AndAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrunePerBlock != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxPrunePerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BasicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Basic.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if m.AutoRenewal != nil {
+		l = m.AutoRenewal.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Calendar != 0 {
+		n += 1 + sovFeegrant(uint64(m.Calendar))
+	}
+	return n
+}
+
+func (m *AutoRenewal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.MaxRenewals != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxRenewals))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Renewals != 0 {
+		n += 1 + sovFeegrant(uint64(m.Renewals))
+	}
+	return n
+}
+
+func (m *AllowedMsgAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.ParentGrantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.AllowSubGrants {
+		n += 2
+	}
+	return n
+}
+
+func (m *GroupGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GroupGrantMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GrantUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.UseCount != 0 {
+		n += 1 + sovFeegrant(uint64(m.UseCount))
+	}
+	return n
+}
+
+func (m *MsgCountLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.MaxCount != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxCount))
+	}
+	if m.Count != 0 {
+		n += 1 + sovFeegrant(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *AllowedMsgCountAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
+func (m *GasPriceCapAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.MaxGasPrices) > 0 {
+		for _, e := range m.MaxGasPrices {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedDenomAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BudgetAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Basic.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.MsgSpendLimits) > 0 {
+		for _, e := range m.MsgSpendLimits {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AndAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPrunePerBlock != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxPrunePerBlock))
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenewal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoRenewal == nil {
+				m.AutoRenewal = &AutoRenewal{}
+			}
+			if err := m.AutoRenewal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+			}
+			m.Calendar = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calendar |= PeriodCalendar(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRenewal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRenewal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRenewal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRenewals", wireType)
+			}
+			m.MaxRenewals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRenewals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Renewals", wireType)
+			}
+			m.Renewals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Renewals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGrantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentGrantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSubGrants", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSubGrants = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, GroupGrantMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupGrantMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupGrantMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupGrantMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCount", wireType)
+			}
+			m.UseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCountLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCountLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCountLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+			}
+			m.MaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsgCountAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgCountAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgCountAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, MsgCountLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeegrant(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *BasicAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SpendLimit) > 0 {
-		for _, e := range m.SpendLimit {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
+func (m *GasPriceCapAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if m.Expiration != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	return n
-}
-
-func (m *PeriodicAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Basic.Size()
-	n += 1 + l + sovFeegrant(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
-	n += 1 + l + sovFeegrant(uint64(l))
-	if len(m.PeriodSpendLimit) > 0 {
-		for _, e := range m.PeriodSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceCapAllowance: wiretype end group for non-group")
 		}
-	}
-	if len(m.PeriodCanSpend) > 0 {
-		for _, e := range m.PeriodCanSpend {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceCapAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
-	n += 1 + l + sovFeegrant(uint64(l))
-	return n
-}
-
-func (m *AllowedMsgAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	if len(m.AllowedMessages) > 0 {
-		for _, s := range m.AllowedMessages {
-			l = len(s)
-			n += 1 + l + sovFeegrant(uint64(l))
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGasPrices = append(m.MaxGasPrices, types.DecCoin{})
+			if err := m.MaxGasPrices[len(m.MaxGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Grant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovFeegrant(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFeegrant(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeegrant(x uint64) (n int) {
-	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
+func (m *AllowedDenomAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -683,15 +4281,15 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedDenomAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedDenomAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -718,16 +4316,18 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendLimit = append(m.SpendLimit, types.Coin{})
-			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -737,27 +4337,23 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -780,7 +4376,7 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgSpendLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -803,17 +4399,17 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeriodicAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSpendLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeriodicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -823,28 +4419,27 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -871,47 +4466,64 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFeegrant
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeegrant
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BudgetAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
-			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BudgetAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BudgetAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -938,14 +4550,13 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
-			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSpendLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -972,7 +4583,8 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+			m.MsgSpendLimits = append(m.MsgSpendLimits, MsgSpendLimit{})
+			if err := m.MsgSpendLimits[len(m.MsgSpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -997,7 +4609,7 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
+func (m *AndAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1020,15 +4632,15 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedMsgAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: AndAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AndAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1055,45 +4667,11 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &any.Any{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allowances = append(m.Allowances, &any.Any{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFeegrant
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFeegrant
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeegrant
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
@@ -1115,7 +4693,7 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Grant) Unmarshal(dAtA []byte) error {
+func (m *OrAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1138,17 +4716,17 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -1158,61 +4736,81 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			m.Allowances = append(m.Allowances, &any.Any{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFeegrant
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeegrant
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunePerBlock", wireType)
+			}
+			m.MaxPrunePerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -1222,28 +4820,11 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPrunePerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFeegrant
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeegrant
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Allowance == nil {
-				m.Allowance = &any.Any{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
@@ -0,0 +1,175 @@
+package feegrant
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                        = (*GasPriceCapAllowance)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*GasPriceCapAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *GasPriceCapAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewGasPriceCapAllowance creates a new fee allowance which only covers fees whose gas price
+// does not exceed the maximum gas price of their denom.
+func NewGasPriceCapAllowance(allowance FeeAllowanceI, maxGasPrices sdk.DecCoins) (*GasPriceCapAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GasPriceCapAllowance{
+		Allowance:    any,
+		MaxGasPrices: maxGasPrices,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *GasPriceCapAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *GasPriceCapAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	newAllowance, err := types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	a.Allowance = newAllowance
+
+	return nil
+}
+
+// gasLimitContextKey is the context key of the gas limit of the transaction whose fee is passed to Accept.
+type gasLimitContextKey struct{}
+
+// ContextWithGasLimit returns a copy of ctx carrying the gas limit of the transaction whose fee is
+// passed to FeeAllowanceI.Accept. The gas limit must be the one set in the transaction rather than
+// the limit of the gas meter, which is not set when simulating transactions or in queries.
+func ContextWithGasLimit(ctx context.Context, gasLimit uint64) context.Context {
+	return context.WithValue(ctx, gasLimitContextKey{}, gasLimit)
+}
+
+// Accept checks that the gas price of the fee does not exceed the maximum gas price of its
+// denom before delegating to the wrapped allowance. The gas price is the fee divided by the
+// gas limit of the transaction, which must be set in the context with ContextWithGasLimit.
+func (a *GasPriceCapAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	gasLimit, ok := ctx.Value(gasLimitContextKey{}).(uint64)
+	if !ok {
+		return false, errors.New("gas limit not set")
+	}
+
+	if err := a.checkGasPrices(fee, gasLimit); err != nil {
+		return false, err
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// checkGasPrices returns an error if any of the fee coins exceeds the maximum gas price of
+// its denom for the given gas limit, or if the denom has no maximum gas price.
+func (a *GasPriceCapAllowance) checkGasPrices(fee sdk.Coins, gasLimit uint64) error {
+	if fee.IsZero() {
+		return nil
+	}
+	if gasLimit == 0 {
+		return errorsmod.Wrap(ErrGasPriceExceeded, "cannot compute the gas price of a transaction without gas limit")
+	}
+
+	limit := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasLimit))
+	for _, coin := range fee {
+		maxGasPrice := a.MaxGasPrices.AmountOf(coin.Denom)
+		if !maxGasPrice.IsPositive() {
+			return errorsmod.Wrapf(ErrGasPriceExceeded, "no maximum gas price for denom %s", coin.Denom)
+		}
+
+		if math.LegacyNewDecFromInt(coin.Amount).GT(maxGasPrice.Mul(limit)) {
+			return errorsmod.Wrapf(ErrGasPriceExceeded, "gas price %s%s exceeds the maximum of %s%s",
+				math.LegacyNewDecFromInt(coin.Amount).Quo(limit), coin.Denom, maxGasPrice, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *GasPriceCapAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.MaxGasPrices) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "max gas prices cannot be empty")
+	}
+	if err := a.MaxGasPrices.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !a.MaxGasPrices.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "max gas prices must be positive")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the GasPriceCapAllowance.
+func (a *GasPriceCapAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// UpdatePeriodReset update "PeriodReset" of the GasPriceCapAllowance.
+func (a *GasPriceCapAllowance) UpdatePeriodReset(validTime time.Time) error {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	if err := allowance.UpdatePeriodReset(validTime); err != nil {
+		return err
+	}
+	return a.SetAllowance(allowance)
+}
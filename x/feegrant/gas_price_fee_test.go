@@ -0,0 +1,158 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasPriceCapFeeValidAllow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	now := time.Now()
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: now})
+	envCtx := context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+		HeaderService: mockHeaderService{},
+		GasService:    mockGasService{},
+	})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	maxGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(25, 3)))
+
+	cases := map[string]struct {
+		allowance *feegrant.BasicAllowance
+		fee       sdk.Coins
+		gasLimit  uint64
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"below max gas price but over spend limit": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 2000)),
+			gasLimit:  100_000,
+			accept:    false,
+		},
+		"at max gas price": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 250)),
+			gasLimit:  10_000,
+			accept:    true,
+			remains:   sdk.NewCoins(sdk.NewInt64Coin("atom", 750)),
+		},
+		"above max gas price": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 251)),
+			gasLimit:  10_000,
+			accept:    false,
+		},
+		"denom without max gas price": {
+			allowance: &feegrant.BasicAllowance{},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("eth", 1)),
+			gasLimit:  10_000,
+			accept:    false,
+		},
+		"no gas limit": {
+			allowance: &feegrant.BasicAllowance{},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			accept:    false,
+		},
+		"zero fee": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			accept:    true,
+			remains:   atom,
+		},
+		"wrapped allowance used up": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 250))},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 250)),
+			gasLimit:  10_000,
+			accept:    true,
+			remove:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewGasPriceCapAllowance(tc.allowance, maxGasPrices)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(feegrant.ContextWithGasLimit(envCtx, tc.gasLimit), tc.fee, []sdk.Msg{&banktypes.MsgSend{}})
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.remove, removed)
+			if removed {
+				return
+			}
+
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tc.remains, inner.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func TestGasPriceCapFeeGasLimitNotSet(t *testing.T) {
+	allowance, err := feegrant.NewGasPriceCapAllowance(&feegrant.BasicAllowance{}, sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1)))
+	require.NoError(t, err)
+
+	_, err = allowance.Accept(context.Background(), sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), []sdk.Msg{&banktypes.MsgSend{}})
+	require.ErrorContains(t, err, "gas limit not set")
+}
+
+func TestGasPriceCapFeeValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		maxGasPrices sdk.DecCoins
+		valid        bool
+	}{
+		"valid": {
+			maxGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(25, 3))),
+			valid:        true,
+		},
+		"empty": {
+			valid: false,
+		},
+		"zero price": {
+			maxGasPrices: sdk.DecCoins{{Denom: "atom", Amount: math.LegacyZeroDec()}},
+			valid:        false,
+		},
+		"unsorted": {
+			maxGasPrices: sdk.DecCoins{
+				{Denom: "eth", Amount: math.LegacyOneDec()},
+				{Denom: "atom", Amount: math.LegacyOneDec()},
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewGasPriceCapAllowance(&feegrant.BasicAllowance{}, tc.maxGasPrices)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,20 +1,48 @@
 package feegrant
 
 import (
+	"fmt"
+
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 )
 
 var _ gogoprotoany.UnpackInterfacesMessage = GenesisState{}
 
 // NewGenesisState creates new GenesisState object
-func NewGenesisState(entries []Grant) *GenesisState {
+func NewGenesisState(entries []Grant, params Params, migratedAccs []string) *GenesisState {
 	return &GenesisState{
-		Allowances: entries,
+		Allowances:       entries,
+		Params:           params,
+		MigratedAccounts: migratedAccs,
 	}
 }
 
-// ValidateGenesis ensures all grants in the genesis state are valid
+// ValidateGenesis ensures the params, all grants, their usages, the group grants and the migrated accounts in the genesis state are valid
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, usage := range data.Usages {
+		if usage.Granter == "" || usage.Grantee == "" {
+			return fmt.Errorf("grant usage granter and grantee cannot be empty")
+		}
+		if err := usage.Spent.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]struct{}, len(data.MigratedAccounts))
+	for _, acc := range data.MigratedAccounts {
+		if acc == "" {
+			return fmt.Errorf("migrated account address cannot be empty")
+		}
+		if _, ok := seen[acc]; ok {
+			return fmt.Errorf("duplicate migrated account %s", acc)
+		}
+		seen[acc] = struct{}{}
+	}
+
 	for _, f := range data.Allowances {
 		grant, err := f.GetGrant()
 		if err != nil {
@@ -25,12 +53,20 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	for _, g := range data.GroupGrants {
+		if err := g.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // DefaultGenesisState returns default state for feegrant module.
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -42,5 +78,11 @@ func (data GenesisState) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) err
 		}
 	}
 
+	for _, g := range data.GroupGrants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
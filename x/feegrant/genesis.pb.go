@@ -5,6 +5,7 @@ package feegrant
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,6 +28,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState contains a set of fee allowances, persisted from the store
 type GenesisState struct {
 	Allowances []Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// migrated_accounts are the addresses of the accounts whose grants were
+	// migrated to the feegrant module from other modules.
+	MigratedAccounts []string `protobuf:"bytes,3,rep,name=migrated_accounts,json=migratedAccounts,proto3" json:"migrated_accounts,omitempty"`
+	// usages are the usage statistics of the grants.
+	Usages []GrantUsage `protobuf:"bytes,4,rep,name=usages,proto3" json:"usages"`
+	// group_grants are the allowances shared by groups of grantees.
+	GroupGrants []GroupGrant `protobuf:"bytes,5,rep,name=group_grants,json=groupGrants,proto3" json:"group_grants"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +79,34 @@ func (m *GenesisState) GetAllowances() []Grant {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetMigratedAccounts() []string {
+	if m != nil {
+		return m.MigratedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsages() []GrantUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroupGrants() []GroupGrant {
+	if m != nil {
+		return m.GroupGrants
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.feegrant.v1beta1.GenesisState")
 }
@@ -78,20 +116,30 @@ func init() {
 }
 
 var fileDescriptor_ac719d2d0954d1bf = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x99, 0x0a, 0xd7, 0x0f, 0x51,
-	0x27, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x91, 0x5c, 0x3c, 0xee,
-	0x10, 0xab, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x3c, 0xb9, 0xb8, 0x12, 0x73, 0x72, 0xf2, 0xcb,
-	0x13, 0xf3, 0x92, 0x53, 0x8b, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0x70, 0x38,
-	0x47, 0xcf, 0x1d, 0xc4, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x48, 0x9a, 0x9d, 0x0c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x0a,
-	0xea, 0xbd, 0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0xb8, 0x33, 0x93, 0xd8, 0xc0, 0x8e,
-	0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x32, 0xdb, 0x7e, 0x13, 0x27, 0x01, 0x00, 0x00,
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x13, 0xf5, 0x0a, 0x8e, 0x2e, 0xae, 0x41, 0xb8, 0xb9, 0x2e, 0x46, 0xb9, 0x97, 0x16,
+	0x29, 0x34, 0x41, 0xfb, 0x04, 0x06, 0x8a, 0x14, 0xba, 0x28, 0x4a, 0x37, 0xdd, 0xc8, 0x98, 0x4c,
+	0x87, 0x50, 0x93, 0x09, 0x73, 0x26, 0x6d, 0x1f, 0xa3, 0x8f, 0xd1, 0x65, 0x17, 0x7d, 0x08, 0x97,
+	0xd2, 0x55, 0x57, 0xa5, 0xe8, 0xa2, 0x4f, 0xd0, 0x7d, 0xc9, 0xcc, 0x28, 0x6e, 0x6c, 0x37, 0x21,
+	0x73, 0xf8, 0xfe, 0x2f, 0xe7, 0xe4, 0x0c, 0x3a, 0x08, 0x39, 0x24, 0x1c, 0xfc, 0x6b, 0x4a, 0x99,
+	0x20, 0xa9, 0xf4, 0x6f, 0xfb, 0x33, 0x2a, 0x49, 0xdf, 0x67, 0x34, 0xa5, 0x10, 0x83, 0x97, 0x09,
+	0x2e, 0xb9, 0xf3, 0x47, 0x63, 0xde, 0x06, 0xf3, 0x0c, 0xd6, 0x6e, 0x31, 0xce, 0xb8, 0x62, 0xfc,
+	0xe2, 0x4d, 0xe3, 0xed, 0xc3, 0x7d, 0xd6, 0x6d, 0x5e, 0x73, 0x4d, 0x92, 0xc4, 0x29, 0xf7, 0xd5,
+	0xd3, 0x94, 0xfe, 0xea, 0xe8, 0x54, 0x3b, 0xcd, 0x67, 0xd5, 0xe1, 0xdf, 0x67, 0x09, 0x35, 0x46,
+	0xba, 0xad, 0x89, 0x24, 0x92, 0x3a, 0x67, 0x08, 0x91, 0xf9, 0x9c, 0xdf, 0x91, 0x34, 0xa4, 0xe0,
+	0xda, 0xdd, 0x72, 0xaf, 0x3e, 0xc0, 0xde, 0x9e, 0x56, 0xbd, 0x51, 0x71, 0x0a, 0x6a, 0x8b, 0xb7,
+	0x8e, 0xf5, 0xf8, 0xf1, 0x74, 0x64, 0x8f, 0x77, 0xc2, 0x4e, 0x80, 0xaa, 0x19, 0x11, 0x24, 0x01,
+	0xb7, 0xd4, 0xb5, 0x7b, 0xf5, 0x41, 0x67, 0xaf, 0xe6, 0x42, 0x61, 0xbb, 0x1e, 0x93, 0x74, 0x4e,
+	0x51, 0x33, 0x89, 0x99, 0x20, 0x92, 0x46, 0x53, 0x12, 0x86, 0x3c, 0x4f, 0x25, 0xb8, 0xe5, 0x6e,
+	0xb9, 0x57, 0x0b, 0xdc, 0x97, 0xe7, 0xe3, 0x96, 0x31, 0x0e, 0xa3, 0x48, 0x50, 0x80, 0x89, 0x14,
+	0x71, 0xca, 0xc6, 0xbf, 0x37, 0x91, 0xa1, 0x49, 0x38, 0x43, 0x54, 0xcd, 0x81, 0x30, 0x0a, 0x6e,
+	0x45, 0x4d, 0xf4, 0xff, 0xfb, 0x89, 0x2e, 0x0b, 0x36, 0xa8, 0x14, 0xed, 0x8c, 0x4d, 0xd0, 0x39,
+	0x47, 0x0d, 0x26, 0x78, 0x9e, 0x4d, 0x15, 0x0f, 0xee, 0xaf, 0x1f, 0x45, 0x3c, 0xcf, 0xf4, 0xff,
+	0xd1, 0xa2, 0x3a, 0xdb, 0x56, 0x20, 0xe8, 0x2f, 0x56, 0xd8, 0x5e, 0xae, 0xb0, 0xfd, 0xbe, 0xc2,
+	0xf6, 0xc3, 0x1a, 0x5b, 0xcb, 0x35, 0xb6, 0x5e, 0xd7, 0xd8, 0xba, 0x32, 0xd7, 0x02, 0xa2, 0x1b,
+	0x2f, 0xe6, 0xfe, 0xfd, 0x76, 0xbd, 0xb3, 0xaa, 0xda, 0xd8, 0xc9, 0xd7, 0x00, 0x29, 0x2b, 0x09,
+	0xe6, 0x5f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +162,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupGrants) > 0 {
+		for iNdEx := len(m.GroupGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MigratedAccounts) > 0 {
+		for iNdEx := len(m.MigratedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MigratedAccounts[iNdEx])
+			copy(dAtA[i:], m.MigratedAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MigratedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Allowances) > 0 {
 		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +249,26 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MigratedAccounts) > 0 {
+		for _, s := range m.MigratedAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GroupGrants) > 0 {
+		for _, e := range m.GroupGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -226,6 +341,139 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratedAccounts = append(m.MigratedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, GrantUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupGrants = append(m.GroupGrants, GroupGrant{})
+			if err := m.GroupGrants[len(m.GroupGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -43,6 +43,9 @@ func (a Grant) ValidateBasic() error {
 	if a.Grantee == a.Granter {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
 	}
+	if a.ParentGrantee != "" && (a.ParentGrantee == a.Granter || a.ParentGrantee == a.Grantee) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "parent grantee must differ from granter and grantee")
+	}
 
 	f, err := a.GetGrant()
 	if err != nil {
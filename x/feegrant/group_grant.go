@@ -0,0 +1,130 @@
+package feegrant
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ gogoprotoany.UnpackInterfacesMessage = &GroupGrant{}
+
+// NewGroupGrant creates a new GroupGrant.
+func NewGroupGrant(granter, name string, feeAllowance FeeAllowanceI, members []GroupGrantMember) (GroupGrant, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return GroupGrant{}, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", feeAllowance)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return GroupGrant{}, err
+	}
+
+	return GroupGrant{
+		Granter:   granter,
+		Name:      name,
+		Allowance: any,
+		Members:   members,
+	}, nil
+}
+
+// ValidateBasic performs basic validation on GroupGrant
+func (g GroupGrant) ValidateBasic() error {
+	if g.Granter == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if g.Name == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "group grant name cannot be empty")
+	}
+	if len(g.Members) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "group grant must have at least one member")
+	}
+
+	seen := make(map[string]struct{}, len(g.Members))
+	for _, m := range g.Members {
+		if m.Grantee == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+		}
+		if m.Grantee == g.Granter {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+		}
+		if _, ok := seen[m.Grantee]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate member %s", m.Grantee)
+		}
+		seen[m.Grantee] = struct{}{}
+
+		if err := m.SpendLimit.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit of %s: %s", m.Grantee, err)
+		}
+		if err := m.Spent.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spent amount of %s: %s", m.Grantee, err)
+		}
+	}
+
+	f, err := g.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	return f.ValidateBasic()
+}
+
+// GetGrant unpacks the shared allowance
+func (g GroupGrant) GetGrant() (FeeAllowanceI, error) {
+	allowance, ok := g.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetGrant sets the shared allowance
+func (g *GroupGrant) SetGrant(feeAllowance FeeAllowanceI) error {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", feeAllowance)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	g.Allowance = any
+	return nil
+}
+
+// GetMember returns the index of the member with the given grantee address, or false if
+// the grantee isn't a member of the group grant.
+func (g GroupGrant) GetMember(grantee string) (int, bool) {
+	for i, m := range g.Members {
+		if m.Grantee == grantee {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupGrant) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(g.Allowance, &allowance)
+}
+
+// Spend adds fee to the amount spent by the member, it returns an error if the
+// spend limit of the member would be exceeded.
+func (m *GroupGrantMember) Spend(fee sdk.Coins) error {
+	spent := m.Spent.Add(fee...)
+	if !m.SpendLimit.Empty() && !m.SpendLimit.IsAllGTE(spent) {
+		return errorsmod.Wrapf(ErrFeeLimitExceeded, "spend limit of member %s exceeded", m.Grantee)
+	}
+
+	m.Spent = spent
+	return nil
+}
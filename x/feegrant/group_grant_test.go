@@ -0,0 +1,100 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGroupGrantValidateBasic(t *testing.T) {
+	granter := "cosmos1p9qh4ldfd6n0qehujsal4k7g0e37kel90rc4ts"
+	member1 := "cosmos1qk93t4j0yyzgqgt6k5qf8deh8fq6smpn3ntu3x"
+	member2 := "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+
+	cases := map[string]struct {
+		granter string
+		name    string
+		members []feegrant.GroupGrantMember
+		valid   bool
+	}{
+		"good": {
+			granter: granter,
+			name:    "team",
+			members: []feegrant.GroupGrantMember{{Grantee: member1, SpendLimit: atom}, {Grantee: member2}},
+			valid:   true,
+		},
+		"no granter": {
+			name:    "team",
+			members: []feegrant.GroupGrantMember{{Grantee: member1}},
+			valid:   false,
+		},
+		"no name": {
+			granter: granter,
+			members: []feegrant.GroupGrantMember{{Grantee: member1}},
+			valid:   false,
+		},
+		"no members": {
+			granter: granter,
+			name:    "team",
+			valid:   false,
+		},
+		"self grant": {
+			granter: granter,
+			name:    "team",
+			members: []feegrant.GroupGrantMember{{Grantee: granter}},
+			valid:   false,
+		},
+		"duplicate member": {
+			granter: granter,
+			name:    "team",
+			members: []feegrant.GroupGrantMember{{Grantee: member1}, {Grantee: member1}},
+			valid:   false,
+		},
+		"invalid spend limit": {
+			granter: granter,
+			name:    "team",
+			members: []feegrant.GroupGrantMember{{Grantee: member1, SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: atom[0].Amount.Neg()}}}},
+			valid:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			group, err := feegrant.NewGroupGrant(tc.granter, tc.name, &feegrant.BasicAllowance{SpendLimit: atom}, tc.members)
+			require.NoError(t, err)
+
+			err = group.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGroupGrantMemberSpend(t *testing.T) {
+	limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 4))
+
+	member := feegrant.GroupGrantMember{SpendLimit: limit}
+	require.NoError(t, member.Spend(fee))
+	require.NoError(t, member.Spend(fee))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 8)), member.Spent)
+
+	// the spend limit would be exceeded
+	require.ErrorIs(t, member.Spend(fee), feegrant.ErrFeeLimitExceeded)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 8)), member.Spent)
+
+	// fees in other denoms are not covered by the spend limit
+	require.ErrorIs(t, member.Spend(sdk.NewCoins(sdk.NewInt64Coin("eth", 1))), feegrant.ErrFeeLimitExceeded)
+
+	// a member without a spend limit is only limited by the shared allowance
+	unlimited := feegrant.GroupGrantMember{}
+	require.NoError(t, unlimited.Spend(limit.Add(fee...)))
+}
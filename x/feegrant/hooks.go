@@ -0,0 +1,55 @@
+package feegrant
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeegrantHooks defines the hooks other modules can register to react to the lifecycle
+// of fee grants, e.g. to reward sponsored transactions.
+type FeegrantHooks interface {
+	AfterGrantCreated(ctx context.Context, granter, grantee sdk.AccAddress) error               // Must be called after a grant is created
+	AfterFeeDeducted(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error // Must be called after a grant has paid the fee of a transaction
+	AfterGrantRevoked(ctx context.Context, granter, grantee sdk.AccAddress) error               // Must be called after a grant is revoked, used up or pruned
+}
+
+var _ FeegrantHooks = MultiFeegrantHooks{}
+
+// MultiFeegrantHooks combines multiple feegrant hooks, all hook functions are run in array sequence.
+type MultiFeegrantHooks []FeegrantHooks
+
+func NewMultiFeegrantHooks(hooks ...FeegrantHooks) MultiFeegrantHooks {
+	return hooks
+}
+
+func (h MultiFeegrantHooks) AfterGrantCreated(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterGrantCreated(ctx, granter, grantee))
+	}
+	return errs
+}
+
+func (h MultiFeegrantHooks) AfterFeeDeducted(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterFeeDeducted(ctx, granter, grantee, fee))
+	}
+	return errs
+}
+
+func (h MultiFeegrantHooks) AfterGrantRevoked(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterGrantRevoked(ctx, granter, grantee))
+	}
+	return errs
+}
+
+// FeegrantHooksWrapper is a wrapper for modules to inject FeegrantHooks using depinject.
+type FeegrantHooksWrapper struct{ FeegrantHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (FeegrantHooksWrapper) IsOnePerModuleType() {}
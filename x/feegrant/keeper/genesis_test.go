@@ -3,10 +3,12 @@ package keeper_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 
 	address "cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -23,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
@@ -45,10 +48,15 @@ func initFixture(t *testing.T) *genesisFixture {
 	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{})
 
 	addrCdc := addresscodec.NewBech32Codec(sdk.Bech32MainPrefix)
+	authority, err := addrCdc.BytesToString(authtypes.NewModuleAddress(feegrant.GovModuleName))
+	assert.NilError(t, err)
+
+	feegrantKeeper := keeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger()), encCfg.Codec, addrCdc, authority)
+	assert.NilError(t, feegrantKeeper.ParamsStore.Set(testCtx.Ctx, feegrant.DefaultParams()))
 
 	return &genesisFixture{
 		ctx:            testCtx.Ctx,
-		feegrantKeeper: keeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger()), encCfg.Codec, addrCdc),
+		feegrantKeeper: feegrantKeeper,
 		addrCdc:        addrCdc,
 	}
 }
@@ -65,8 +73,15 @@ func TestImportExportGenesis(t *testing.T) {
 	err := f.feegrantKeeper.GrantAllowance(f.ctx, granterAddr, granteeAddr, allowance)
 	assert.NilError(t, err)
 
+	fee := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(10)))
+	err = f.feegrantKeeper.UseGrantedFees(f.ctx, granterAddr, granteeAddr, fee, testGasLimit, nil)
+	assert.NilError(t, err)
+
 	genesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
 	assert.NilError(t, err)
+	assert.DeepEqual(t, feegrant.DefaultParams(), genesis.Params)
+	assert.Equal(t, len(genesis.Usages), 1)
+	assert.DeepEqual(t, fee, genesis.Usages[0].Spent)
 
 	granter, err := f.addrCdc.BytesToString(granterAddr.Bytes())
 	assert.NilError(t, err)
@@ -80,6 +95,9 @@ func TestImportExportGenesis(t *testing.T) {
 	})
 	assert.NilError(t, err)
 
+	// params and migrated accounts are imported as well
+	genesis.Params = feegrant.NewParams(10)
+	genesis.MigratedAccounts = []string{grantee}
 	err = f.feegrantKeeper.InitGenesis(f.ctx, genesis)
 	assert.NilError(t, err)
 
@@ -88,6 +106,45 @@ func TestImportExportGenesis(t *testing.T) {
 	assert.DeepEqual(t, genesis, newGenesis)
 }
 
+func TestImportExportGenesisAutoRenewal(t *testing.T) {
+	f := initFixture(t)
+
+	coins := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1_000)))
+	periodCoins := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(100)))
+	now := time.Now().UTC()
+	ctx := f.ctx.WithHeaderInfo(header.Info{Time: now})
+
+	allowance := &feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{SpendLimit: coins},
+		Period:           time.Hour,
+		PeriodSpendLimit: periodCoins,
+		PeriodCanSpend:   periodCoins,
+		AutoRenewal:      &feegrant.AutoRenewal{SpendLimit: coins, MaxRenewals: 5},
+	}
+	assert.NilError(t, f.feegrantKeeper.GrantAllowance(ctx, granterAddr, granteeAddr, allowance))
+
+	// the spend limit is renewed at the next period reset
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour)})
+	assert.NilError(t, f.feegrantKeeper.UseGrantedFees(ctx, granterAddr, granteeAddr, periodCoins, testGasLimit, nil))
+
+	genesis, err := f.feegrantKeeper.ExportGenesis(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(genesis.Allowances), 1)
+
+	// import into a new chain at genesis, where there is no block time yet
+	newFixture := initFixture(t)
+	genesisCtx := newFixture.ctx.WithHeaderInfo(header.Info{})
+	assert.NilError(t, newFixture.feegrantKeeper.InitGenesis(genesisCtx, genesis))
+
+	imported, err := newFixture.feegrantKeeper.GetAllowance(genesisCtx, granterAddr, granteeAddr)
+	assert.NilError(t, err)
+	periodic, ok := imported.(*feegrant.PeriodicAllowance)
+	assert.Assert(t, ok)
+	assert.Equal(t, periodic.AutoRenewal.Renewals, uint64(1))
+	assert.DeepEqual(t, periodic.Basic.SpendLimit, coins.Sub(periodCoins...))
+	assert.Assert(t, periodic.PeriodReset.Equal(now.Add(2*time.Hour)))
+}
+
 func TestInitGenesis(t *testing.T) {
 	any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
 	assert.NilError(t, err)
@@ -137,6 +194,12 @@ func TestInitGenesis(t *testing.T) {
 		},
 	}
 
+	t.Run("invalid migrated account", func(t *testing.T) {
+		f := initFixture(t)
+		err := f.feegrantKeeper.InitGenesis(f.ctx, &feegrant.GenesisState{MigratedAccounts: []string{"invalid account"}})
+		assert.ErrorContains(t, err, "decoding bech32 failed")
+	})
+
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			f := initFixture(t)
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
@@ -85,7 +87,8 @@ func (q Keeper) Allowances(c context.Context, req *feegrant.QueryAllowancesReque
 	return &feegrant.QueryAllowancesResponse{Allowances: grants, Pagination: pageRes}, nil
 }
 
-// AllowancesByGranter queries all the allowances granted by the given granter
+// AllowancesByGranter queries all the allowances granted by the given granter.
+// It iterates the granter index rather than scanning all the allowances in the store.
 func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowancesByGranterRequest) (*feegrant.QueryAllowancesByGranterResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -96,19 +99,14 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 		return nil, err
 	}
 
-	var grants []*feegrant.Grant
-	_, pageRes, err := query.CollectionFilteredPaginate(c, q.FeeAllowance, req.Pagination,
-		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], grant feegrant.Grant) (include bool, err error) {
-			if !sdk.AccAddress(granterAddr).Equals(key.K2()) {
-				return false, nil
+	grants, pageRes, err := query.CollectionPaginate(c, q.FeeAllowance.Indexes.Granter, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (*feegrant.Grant, error) {
+			grant, err := q.FeeAllowance.Get(c, collections.Join(key.K2(), key.K1()))
+			if err != nil {
+				return nil, err
 			}
-
-			grants = append(grants, &grant)
-			return true, nil
-		},
-		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], grant feegrant.Grant) (*feegrant.Grant, error) {
 			return &grant, nil
-		},
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](granterAddr),
 	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -116,3 +114,166 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// Params queries the parameters of the x/feegrant module.
+func (q Keeper) Params(ctx context.Context, _ *feegrant.QueryParamsRequest) (*feegrant.QueryParamsResponse, error) {
+	params, err := q.ParamsStore.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryParamsResponse{Params: params}, nil
+}
+
+// GrantUsage returns the usage statistics of the allowance granted to the grantee by the granter.
+func (q Keeper) GrantUsage(ctx context.Context, req *feegrant.QueryGrantUsageRequest) (*feegrant.QueryGrantUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.addrCdc.StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := q.addrCdc.StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(sdk.AccAddress(granteeAddr), sdk.AccAddress(granterAddr))
+	has, err := q.FeeAllowance.Has(ctx, key)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "fee allowance from %s to %s not found", req.Granter, req.Grantee)
+	}
+
+	usage, err := q.Usage.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// the grant has not been used yet
+		usage = feegrant.GrantUsage{Granter: req.Granter, Grantee: req.Grantee}
+	}
+
+	return &feegrant.QueryGrantUsageResponse{Usage: usage}, nil
+}
+
+// GroupAllowance returns a group allowance of the granter together with its members.
+func (q Keeper) GroupAllowance(ctx context.Context, req *feegrant.QueryGroupAllowanceRequest) (*feegrant.QueryGroupAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.addrCdc.StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "group grant name cannot be empty")
+	}
+
+	group, err := q.GroupGrants.Get(ctx, collections.Join(sdk.AccAddress(granterAddr), req.Name))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "group fee allowance %s of %s not found", req.Name, req.Granter)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryGroupAllowanceResponse{GroupGrant: &group}, nil
+}
+
+// ExpiringAllowances returns the grants expiring within the requested duration from the current
+// block time, ordered by expiration. It iterates the expiration queue, so grants without an
+// expiration are never returned.
+func (q Keeper) ExpiringAllowances(ctx context.Context, req *feegrant.QueryExpiringAllowancesRequest) (*feegrant.QueryExpiringAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Within <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "within must be positive")
+	}
+
+	var granterAddr, granteeAddr sdk.AccAddress
+	if req.Granter != "" {
+		addr, err := q.addrCdc.StringToBytes(req.Granter)
+		if err != nil {
+			return nil, err
+		}
+		granterAddr = addr
+	}
+	if req.Grantee != "" {
+		addr, err := q.addrCdc.StringToBytes(req.Grantee)
+		if err != nil {
+			return nil, err
+		}
+		granteeAddr = addr
+	}
+
+	now := q.HeaderService.HeaderInfo(ctx).Time
+	until := now.Add(req.Within)
+
+	allowances, pageRes, err := query.CollectionFilteredPaginate(ctx, q.FeeAllowanceQueue, req.Pagination,
+		func(key collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], _ bool) (include bool, err error) {
+			// grants which already expired are pruned and can no longer be used
+			if key.K1().Before(now) || key.K1().After(until) {
+				return false, nil
+			}
+			if granteeAddr != nil && !key.K2().Equals(granteeAddr) {
+				return false, nil
+			}
+			return granterAddr == nil || key.K3().Equals(granterAddr), nil
+		}, func(key collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], _ bool) (feegrant.ExpiringAllowance, error) {
+			grant, err := q.FeeAllowance.Get(ctx, collections.Join(key.K2(), key.K3()))
+			if err != nil {
+				return feegrant.ExpiringAllowance{}, err
+			}
+			return feegrant.ExpiringAllowance{Grant: grant, Expiration: key.K1()}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryExpiringAllowancesResponse{Allowances: allowances, Pagination: pageRes}, nil
+}
+
+// SimulateFeeGrant reports if the allowance granted to the grantee by the granter would cover the
+// fee of a transaction with the given messages and gas limit. The allowance is not updated.
+func (q Keeper) SimulateFeeGrant(ctx context.Context, req *feegrant.QuerySimulateFeeGrantRequest) (*feegrant.QuerySimulateFeeGrantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.addrCdc.StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := q.addrCdc.StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Fee.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	msgs, err := req.GetMessages()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := q.simulateUseGrantedFees(ctx, granterAddr, granteeAddr, req.Fee, req.GasLimit, msgs); err != nil {
+		return &feegrant.QuerySimulateFeeGrantResponse{Covered: false, Reason: err.Error()}, nil
+	}
+
+	return &feegrant.QuerySimulateFeeGrantResponse{Covered: true}, nil
+}
@@ -1,9 +1,16 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/feegrant"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 const (
@@ -217,6 +224,35 @@ func (suite *KeeperTestSuite) TestFeeAllowancesByGranter() {
 				suite.Require().Equal(resp.Pagination.Total, uint64(1))
 			},
 		},
+		{
+			"valid query: paginated",
+			&feegrant.QueryAllowancesByGranterRequest{
+				Granter:    suite.encodedAddrs[0],
+				Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			false,
+			func() {
+				suite.grantFeeAllowance(suite.addrs[0], suite.addrs[2])
+				suite.grantFeeAllowance(suite.addrs[0], suite.addrs[3])
+			},
+			func(resp *feegrant.QueryAllowancesByGranterResponse) {
+				suite.Require().Len(resp.Allowances, 2)
+				for _, grant := range resp.Allowances {
+					suite.Require().Equal(suite.encodedAddrs[0], grant.Granter)
+				}
+				suite.Require().Equal(uint64(3), resp.Pagination.Total)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+
+				next, err := suite.feegrantKeeper.AllowancesByGranter(suite.ctx, &feegrant.QueryAllowancesByGranterRequest{
+					Granter:    suite.encodedAddrs[0],
+					Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+				})
+				suite.Require().NoError(err)
+				suite.Require().Len(next.Allowances, 1)
+				suite.Require().Equal(suite.encodedAddrs[0], next.Allowances[0].Granter)
+				suite.Require().Nil(next.Pagination.NextKey)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -233,6 +269,295 @@ func (suite *KeeperTestSuite) TestFeeAllowancesByGranter() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestParams() {
+	resp, err := suite.feegrantKeeper.Params(suite.ctx, &feegrant.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(feegrant.DefaultParams(), resp.Params)
+
+	params := feegrant.NewParams(10)
+	suite.Require().NoError(suite.feegrantKeeper.ParamsStore.Set(suite.ctx, params))
+
+	resp, err = suite.feegrantKeeper.Params(suite.ctx, &feegrant.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, resp.Params)
+}
+
+func (suite *KeeperTestSuite) TestGrantUsage() {
+	suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	testCases := []struct {
+		name      string
+		req       *feegrant.QueryGrantUsageRequest
+		preRun    func()
+		expectErr bool
+		spent     sdk.Coins
+		useCount  uint64
+	}{
+		{
+			name:      "nil request",
+			preRun:    func() {},
+			expectErr: true,
+		},
+		{
+			name:      "fail: invalid granter",
+			req:       &feegrant.QueryGrantUsageRequest{Granter: invalidGranter, Grantee: suite.encodedAddrs[1]},
+			preRun:    func() {},
+			expectErr: true,
+		},
+		{
+			name:      "fail: no grant",
+			req:       &feegrant.QueryGrantUsageRequest{Granter: suite.encodedAddrs[1], Grantee: suite.encodedAddrs[0]},
+			preRun:    func() {},
+			expectErr: true,
+		},
+		{
+			name:   "valid: unused grant",
+			req:    &feegrant.QueryGrantUsageRequest{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[1]},
+			preRun: func() {},
+		},
+		{
+			name: "valid: used grant",
+			req:  &feegrant.QueryGrantUsageRequest{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[1]},
+			preRun: func() {
+				for i := 0; i < 2; i++ {
+					suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], fee, testGasLimit, nil))
+				}
+			},
+			spent:    fee.Add(fee...),
+			useCount: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.feegrantKeeper.GrantUsage(suite.ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.encodedAddrs[0], resp.Usage.Granter)
+			suite.Require().Equal(suite.encodedAddrs[1], resp.Usage.Grantee)
+			suite.Require().True(tc.spent.Equal(resp.Usage.Spent))
+			suite.Require().Equal(tc.useCount, resp.Usage.UseCount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestExpiringAllowances() {
+	now := suite.ctx.HeaderInfo().Time
+	grant := func(granter, grantee sdk.AccAddress, in time.Duration) {
+		allowance := &feegrant.BasicAllowance{SpendLimit: suite.atom}
+		if in > 0 {
+			exp := now.Add(in)
+			allowance.Expiration = &exp
+		}
+		suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, allowance))
+	}
+	grant(suite.addrs[0], suite.addrs[1], time.Hour)
+	grant(suite.addrs[0], suite.addrs[2], 10*24*time.Hour)
+	grant(suite.addrs[1], suite.addrs[2], 2*time.Hour)
+	grant(suite.addrs[1], suite.addrs[3], 0)
+
+	testCases := []struct {
+		name      string
+		req       *feegrant.QueryExpiringAllowancesRequest
+		expectErr bool
+		expected  [][2]string
+	}{
+		{
+			name:      "nil request",
+			expectErr: true,
+		},
+		{
+			name:      "fail: no duration",
+			req:       &feegrant.QueryExpiringAllowancesRequest{},
+			expectErr: true,
+		},
+		{
+			name:      "fail: invalid granter",
+			req:       &feegrant.QueryExpiringAllowancesRequest{Within: time.Hour, Granter: invalidGranter},
+			expectErr: true,
+		},
+		{
+			name:     "valid: ordered by expiration",
+			req:      &feegrant.QueryExpiringAllowancesRequest{Within: 3 * time.Hour},
+			expected: [][2]string{{suite.encodedAddrs[0], suite.encodedAddrs[1]}, {suite.encodedAddrs[1], suite.encodedAddrs[2]}},
+		},
+		{
+			name:     "valid: by granter",
+			req:      &feegrant.QueryExpiringAllowancesRequest{Within: 3 * time.Hour, Granter: suite.encodedAddrs[1]},
+			expected: [][2]string{{suite.encodedAddrs[1], suite.encodedAddrs[2]}},
+		},
+		{
+			name:     "valid: by grantee",
+			req:      &feegrant.QueryExpiringAllowancesRequest{Within: 30 * 24 * time.Hour, Grantee: suite.encodedAddrs[2]},
+			expected: [][2]string{{suite.encodedAddrs[1], suite.encodedAddrs[2]}, {suite.encodedAddrs[0], suite.encodedAddrs[2]}},
+		},
+		{
+			name: "valid: none expiring",
+			req:  &feegrant.QueryExpiringAllowancesRequest{Within: time.Minute},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp, err := suite.feegrantKeeper.ExpiringAllowances(suite.ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(resp.Allowances, len(tc.expected))
+			for i, allowance := range resp.Allowances {
+				suite.Require().Equal(tc.expected[i][0], allowance.Grant.Granter)
+				suite.Require().Equal(tc.expected[i][1], allowance.Grant.Grantee)
+				suite.Require().False(allowance.Expiration.Before(now))
+				suite.Require().False(allowance.Expiration.After(now.Add(tc.req.Within)))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateFeeGrant() {
+	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &exp,
+	}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], allowance))
+
+	send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{})
+	suite.Require().NoError(err)
+	multiSend, err := codectypes.NewAnyWithValue(&banktypes.MsgMultiSend{})
+	suite.Require().NoError(err)
+
+	capped, err := feegrant.NewGasPriceCapAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom},
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdkmath.LegacyNewDecWithPrec(25, 3))))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[2], suite.addrs[3], capped))
+
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	hugeAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+
+	testCases := []struct {
+		name      string
+		req       *feegrant.QuerySimulateFeeGrantRequest
+		expectErr bool
+		covered   bool
+		reason    string
+	}{
+		{
+			name:      "nil request",
+			expectErr: true,
+		},
+		{
+			name: "fail: invalid granter",
+			req: &feegrant.QuerySimulateFeeGrantRequest{
+				Granter: invalidGranter,
+				Grantee: suite.encodedAddrs[1],
+			},
+			expectErr: true,
+		},
+		{
+			name: "not covered: no grant",
+			req: &feegrant.QuerySimulateFeeGrantRequest{
+				Granter: suite.encodedAddrs[1],
+				Grantee: suite.encodedAddrs[0],
+				Fee:     smallAtom,
+				Msgs:    []*codectypes.Any{send},
+			},
+			reason: "fee allowance not found",
+		},
+		{
+			name: "not covered: fee too high",
+			req: &feegrant.QuerySimulateFeeGrantRequest{
+				Granter: suite.encodedAddrs[0],
+				Grantee: suite.encodedAddrs[1],
+				Fee:     hugeAtom,
+				Msgs:    []*codectypes.Any{send},
+			},
+			reason: "fee limit exceeded",
+		},
+		{
+			name: "not covered: message not allowed",
+			req: &feegrant.QuerySimulateFeeGrantRequest{
+				Granter: suite.encodedAddrs[0],
+				Grantee: suite.encodedAddrs[1],
+				Fee:     smallAtom,
+				Msgs:    []*codectypes.Any{multiSend},
+			},
+			reason: "message not allowed",
+		},
+		{
+			name: "covered",
+			req: &feegrant.QuerySimulateFeeGrantRequest{
+				Granter: suite.encodedAddrs[0],
+				Grantee: suite.encodedAddrs[1],
+				Fee:     smallAtom,
+				Msgs:    []*codectypes.Any{send},
+			},
+			covered: true,
+		},
+		{
+			name: "not covered: gas price too high",
+			req: &feegrant.QuerySimulateFeeGrantRequest{
+				Granter:  suite.encodedAddrs[2],
+				Grantee:  suite.encodedAddrs[3],
+				Fee:      smallAtom,
+				Msgs:     []*codectypes.Any{send},
+				GasLimit: 399,
+			},
+			reason: "exceeds the maximum",
+		},
+		{
+			name: "not covered: no gas limit",
+			req: &feegrant.QuerySimulateFeeGrantRequest{
+				Granter: suite.encodedAddrs[2],
+				Grantee: suite.encodedAddrs[3],
+				Fee:     smallAtom,
+				Msgs:    []*codectypes.Any{send},
+			},
+			reason: "without gas limit",
+		},
+		{
+			name: "covered: gas price at most the maximum",
+			req: &feegrant.QuerySimulateFeeGrantRequest{
+				Granter:  suite.encodedAddrs[2],
+				Grantee:  suite.encodedAddrs[3],
+				Fee:      smallAtom,
+				Msgs:     []*codectypes.Any{send},
+				GasLimit: 400,
+			},
+			covered: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// queries run with an infinite gas meter, the gas limit is taken from the request
+			resp, err := suite.feegrantKeeper.SimulateFeeGrant(suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.covered, resp.Covered)
+			suite.Require().Contains(resp.Reason, tc.reason)
+		})
+	}
+
+	// the allowance is not updated by the simulation
+	loaded, err := suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	basic, err := loaded.(*feegrant.AllowedMsgAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom, basic.(*feegrant.BasicAllowance).SpendLimit)
+}
+
 func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress) {
 	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
 	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{
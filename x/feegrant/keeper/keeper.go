@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	corecontext "cosmossdk.io/core/context"
@@ -13,11 +17,32 @@ import (
 	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
+// FeeAllowanceIndexes defines the secondary indexes of the FeeAllowance map.
+type FeeAllowanceIndexes struct {
+	// Granter key: granter+grantee | value: none
+	Granter *indexes.ReversePair[sdk.AccAddress, sdk.AccAddress, feegrant.Grant]
+}
+
+// IndexesList implements collections.Indexes.
+func (i FeeAllowanceIndexes) IndexesList() []collections.Index[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant] {
+	return []collections.Index[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]{i.Granter}
+}
+
+func newFeeAllowanceIndexes(sb *collections.SchemaBuilder) FeeAllowanceIndexes {
+	return FeeAllowanceIndexes{
+		Granter: indexes.NewReversePair[feegrant.Grant](
+			sb, feegrant.FeeAllowanceByGranterKeyPrefix, "allowances_by_granter",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		),
+	}
+}
+
 // Keeper manages state of all fee grants, as well as calculating approval.
 // It must have a codec with all available allowances registered.
 type Keeper struct {
@@ -25,29 +50,51 @@ type Keeper struct {
 
 	cdc     codec.BinaryCodec
 	addrCdc address.Codec
-	Schema  collections.Schema
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+	// hooks is shared by all the copies of the keeper, so that hooks set after the keeper
+	// has been passed to other modules (e.g. by depinject) are called by all of them.
+	hooks *feegrant.MultiFeegrantHooks
+
+	Schema collections.Schema
 	// FeeAllowance key: grantee+granter | value: Grant
-	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
+	FeeAllowance *collections.IndexedMap[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant, FeeAllowanceIndexes]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// ParamsStore key: ParamsKey | value: Params
+	ParamsStore collections.Item[feegrant.Params]
+	// MigratedAccounts key: address | value: none
+	MigratedAccounts collections.KeySet[sdk.AccAddress]
+	// Usage key: grantee+granter | value: GrantUsage
+	Usage collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.GrantUsage]
+	// GroupGrants key: granter+name | value: GroupGrant
+	GroupGrants collections.Map[collections.Pair[sdk.AccAddress, string], feegrant.GroupGrant]
+	// GroupGrantMembers key: grantee+granter | value: group grant name
+	GroupGrantMembers collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], string]
+	// GroupGrantQueue key: expiration time+granter+name | value: none
+	GroupGrantQueue collections.KeySet[collections.Triple[time.Time, sdk.AccAddress, string]]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a feegrant Keeper
-func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, addrCdc address.Codec) Keeper {
+func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, addrCdc address.Codec, authority string) Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
 	return Keeper{
 		Environment: env,
 		cdc:         cdc,
 		addrCdc:     addrCdc,
-		FeeAllowance: collections.NewMap(
+		authority:   authority,
+		hooks:       new(feegrant.MultiFeegrantHooks),
+		FeeAllowance: collections.NewIndexedMap(
 			sb,
 			feegrant.FeeAllowanceKeyPrefix,
 			"allowances",
 			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[feegrant.Grant](cdc),
+			newFeeAllowanceIndexes(sb),
 		),
 		FeeAllowanceQueue: collections.NewMap(
 			sb,
@@ -56,16 +103,127 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, addrCdc address
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		ParamsStore:      collections.NewItem(sb, feegrant.ParamsKey, "params", codec.CollValue[feegrant.Params](cdc)),
+		MigratedAccounts: collections.NewKeySet(sb, feegrant.MigratedAccountsKeyPrefix, "migrated_accounts", sdk.AccAddressKey),
+		Usage: collections.NewMap(
+			sb,
+			feegrant.GrantUsageKeyPrefix,
+			"usage",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+			codec.CollValue[feegrant.GrantUsage](cdc),
+		),
+		GroupGrants: collections.NewMap(
+			sb,
+			feegrant.GroupGrantKeyPrefix,
+			"group_grants",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), collections.StringKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+			codec.CollValue[feegrant.GroupGrant](cdc),
+		),
+		GroupGrantMembers: collections.NewMap(
+			sb,
+			feegrant.GroupGrantMemberKeyPrefix,
+			"group_grant_members",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+			collections.StringValue,
+		),
+		GroupGrantQueue: collections.NewKeySet(
+			sb,
+			feegrant.GroupGrantQueueKeyPrefix,
+			"group_grants_queue",
+			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), collections.StringKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		),
+	}
+}
+
+// GetAuthority returns the x/feegrant module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Hooks gets the hooks for feegrant Keeper
+func (k Keeper) Hooks() feegrant.FeegrantHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return feegrant.MultiFeegrantHooks{}
+	}
+
+	return *k.hooks
+}
+
+// SetHooks sets the hooks for feegrant
+func (k Keeper) SetHooks(fh feegrant.FeegrantHooks) Keeper {
+	if len(*k.hooks) > 0 {
+		panic("cannot set feegrant hooks twice")
 	}
+
+	*k.hooks = feegrant.MultiFeegrantHooks{fh}
+
+	return k
 }
 
 // GrantAllowance creates a new grant
 func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	return k.grantAllowance(ctx, granter, grantee, feeAllowance, nil, false)
+}
+
+// GrantSubAllowance creates a new grant from granter to grantee which is carved out of the
+// allowance granted by granter to parentGrantee. Fees paid with the sub-grant are also
+// deducted from the parent allowance. The parent grant must allow sub-grants and the
+// sub-grant cannot expire after the parent grant.
+func (k Keeper) GrantSubAllowance(ctx context.Context, granter, parentGrantee, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, allowSubGrants bool) error {
+	parent, err := k.FeeAllowance.Get(ctx, collections.Join(parentGrantee, granter))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(feegrant.ErrNoAllowance, "parent fee allowance not found")
+		}
+		return err
+	}
+
+	if !parent.AllowSubGrants {
+		return errorsmod.Wrapf(feegrant.ErrSubGrantNotAllowed, "%s cannot carve out sub-grants of its allowance", parent.Grantee)
+	}
+
+	// all the ancestors of the parent grant must still exist
+	if _, err := k.getParentGrantees(ctx, granter, parent); err != nil {
+		return err
+	}
+
+	parentAllowance, err := parent.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	parentExp, err := parentAllowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+
+	if parentExp != nil {
+		exp, err := feeAllowance.ExpiresAt()
+		if err != nil {
+			return err
+		}
+		if exp == nil || exp.After(*parentExp) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sub-allowance cannot expire after the parent allowance")
+		}
+	}
+
+	return k.grantAllowance(ctx, granter, grantee, feeAllowance, parentGrantee, allowSubGrants)
+}
+
+// grantAllowance creates a new grant, parentGrantee is nil unless the grant is a sub-grant.
+func (k Keeper) grantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, parentGrantee sdk.AccAddress, allowSubGrants bool) error {
 	// Checking for duplicate entry
 	if f, _ := k.GetAllowance(ctx, granter, grantee); f != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
 	}
 
+	if name, err := k.GroupGrantMembers.Get(ctx, collections.Join(grantee, granter)); err == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "grantee is already a member of the group allowance %s", name)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
 	exp, err := feeAllowance.ExpiresAt()
 	if err != nil {
 		return err
@@ -109,20 +267,41 @@ func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddr
 		return err
 	}
 
+	if parentGrantee != nil {
+		grant.ParentGrantee, err = k.addrCdc.BytesToString(parentGrantee)
+		if err != nil {
+			return err
+		}
+	}
+	grant.AllowSubGrants = allowSubGrants
+
 	if err := k.FeeAllowance.Set(ctx, collections.Join(grantee, granter), grant); err != nil {
 		return err
 	}
 
-	return k.EventService.EventManager(ctx).EmitKV(
+	if err := k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeSetFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, grant.Granter),
 		event.NewAttribute(feegrant.AttributeKeyGrantee, grant.Grantee),
-	)
+	); err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&feegrant.EventGrantAllowance{
+		Granter:       grant.Granter,
+		Grantee:       grant.Grantee,
+		ParentGrantee: grant.ParentGrantee,
+		Allowance:     grant.Allowance,
+	}); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterGrantCreated(ctx, granter, grantee)
 }
 
 // UpdateAllowance updates the existing grant.
 func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
-	_, err := k.GetAllowance(ctx, granter, grantee)
+	existing, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
 	if err != nil {
 		return err
 	}
@@ -140,16 +319,25 @@ func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 	if err != nil {
 		return err
 	}
+	grant.ParentGrantee, grant.AllowSubGrants = existing.ParentGrantee, existing.AllowSubGrants
 
 	if err := k.FeeAllowance.Set(ctx, collections.Join(grantee, granter), grant); err != nil {
 		return err
 	}
 
-	return k.EventService.EventManager(ctx).EmitKV(
+	if err := k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeUpdateFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, grant.Granter),
 		event.NewAttribute(feegrant.AttributeKeyGrantee, grant.Grantee),
-	)
+	); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&feegrant.EventUpdateAllowance{
+		Granter:   grant.Granter,
+		Grantee:   grant.Grantee,
+		Allowance: grant.Allowance,
+	})
 }
 
 // revokeAllowance removes an existing grant
@@ -163,6 +351,10 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
+	if err := k.Usage.Remove(ctx, collections.Join(grantee, granter)); err != nil {
+		return err
+	}
+
 	exp, err := grant.ExpiresAt()
 	if err != nil {
 		return err
@@ -183,11 +375,72 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
-	return k.EventService.EventManager(ctx).EmitKV(
+	if err := k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeRevokeFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, granterStr),
 		event.NewAttribute(feegrant.AttributeKeyGrantee, granteeStr),
-	)
+	); err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&feegrant.EventRevokeAllowance{
+		Granter: granterStr,
+		Grantee: granteeStr,
+	}); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterGrantRevoked(ctx, granter, grantee)
+}
+
+// RevokeAllAllowances revokes the allowances and then the group allowances granted by granter, up to
+// limit of them. It returns the number of revoked allowances and true if granter still has allowances
+// left to revoke.
+func (k Keeper) RevokeAllAllowances(ctx context.Context, granter sdk.AccAddress, limit int) (revoked int, hasMore bool, err error) {
+	var grantees []sdk.AccAddress
+	err = k.FeeAllowance.Indexes.Granter.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](granter), func(_, grantee sdk.AccAddress) (stop bool, err error) {
+		if len(grantees) == limit {
+			hasMore = true
+			return true, nil
+		}
+		grantees = append(grantees, grantee)
+		return false, nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, grantee := range grantees {
+		if err := k.revokeAllowance(ctx, granter, grantee); err != nil {
+			return 0, false, err
+		}
+	}
+	revoked = len(grantees)
+
+	if hasMore {
+		return revoked, true, nil
+	}
+
+	var names []string
+	err = k.GroupGrants.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](granter), func(key collections.Pair[sdk.AccAddress, string], _ feegrant.GroupGrant) (stop bool, err error) {
+		if revoked+len(names) == limit {
+			hasMore = true
+			return true, nil
+		}
+		names = append(names, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, name := range names {
+		if err := k.RevokeGroupAllowance(ctx, granter, name); err != nil {
+			return 0, false, err
+		}
+	}
+
+	return revoked + len(names), hasMore, nil
 }
 
 // GetAllowance returns the allowance between the granter and grantee.
@@ -211,8 +464,125 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 	})
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
-func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+// getParentGrantees returns the grantees of the ancestors of a grant, from its parent up to the
+// root grant. It returns an error if any of the ancestors doesn't exist anymore.
+func (k Keeper) getParentGrantees(ctx context.Context, granter sdk.AccAddress, grant feegrant.Grant) ([]sdk.AccAddress, error) {
+	var parents []sdk.AccAddress
+	seen := map[string]struct{}{grant.Grantee: {}}
+	for grant.ParentGrantee != "" {
+		parentStr := grant.ParentGrantee
+		if _, ok := seen[parentStr]; ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cyclic sub-grant of %s", parentStr)
+		}
+		seen[parentStr] = struct{}{}
+
+		parent, err := k.addrCdc.StringToBytes(parentStr)
+		if err != nil {
+			return nil, err
+		}
+
+		grant, err = k.FeeAllowance.Get(ctx, collections.Join(sdk.AccAddress(parent), granter))
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil, errorsmod.Wrapf(feegrant.ErrNoAllowance, "parent fee allowance of %s not found", parentStr)
+			}
+			return nil, err
+		}
+
+		parents = append(parents, parent)
+	}
+
+	return parents, nil
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// gasLimit is the gas limit set in the transaction, which allowances use to compute its gas price.
+// If the grant is a sub-grant, the fee is also deducted from all of its parent allowances.
+// If the grantee has no grant of its own, the fee is paid with the group allowance it is a member of.
+func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, gasLimit uint64, msgs []sdk.Msg) error {
+	grant, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		name, groupErr := k.GroupGrantMembers.Get(ctx, collections.Join(grantee, granter))
+		if groupErr != nil {
+			if errors.Is(groupErr, collections.ErrNotFound) {
+				return err
+			}
+			return groupErr
+		}
+
+		if err := k.useGroupGrantedFees(ctx, granter, grantee, name, fee, gasLimit, msgs); err != nil {
+			return err
+		}
+
+		return k.Hooks().AfterFeeDeducted(ctx, granter, grantee, fee)
+	}
+
+	parents, err := k.getParentGrantees(ctx, granter, grant)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range append([]sdk.AccAddress{grantee}, parents...) {
+		if err := k.useGrantedFees(ctx, granter, g, fee, gasLimit, msgs); err != nil {
+			return err
+		}
+	}
+
+	return k.Hooks().AfterFeeDeducted(ctx, granter, grantee, fee)
+}
+
+// simulateUseGrantedFees runs the Accept logic of the grant from granter to grantee, and of all of
+// its parent grants, against the given fee and messages without storing the updated allowances.
+func (k Keeper) simulateUseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, gasLimit uint64, msgs []sdk.Msg) error {
+	grant, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		name, err := k.GroupGrantMembers.Get(ctx, collections.Join(grantee, granter))
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return errorsmod.Wrap(feegrant.ErrNoAllowance, "fee allowance not found")
+			}
+			return err
+		}
+
+		_, _, err = k.acceptGroupGrantedFees(ctx, granter, grantee, name, fee, gasLimit, msgs)
+		return err
+	}
+
+	parents, err := k.getParentGrantees(ctx, granter, grant)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range append([]sdk.AccAddress{grantee}, parents...) {
+		allowance, err := k.GetAllowance(ctx, granter, g)
+		if err != nil {
+			return err
+		}
+
+		if _, err := allowance.Accept(k.acceptContext(ctx, gasLimit), fee, msgs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// acceptContext returns the context passed to the Accept method of allowances, which carries the
+// environment of the module and the gas limit of the transaction.
+func (k Keeper) acceptContext(ctx context.Context, gasLimit uint64) context.Context {
+	return feegrant.ContextWithGasLimit(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), gasLimit)
+}
+
+// useGrantedFees deducts the given fee from the allowance of a single grant.
+func (k Keeper) useGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, gasLimit uint64, msgs []sdk.Msg) error {
 	grant, err := k.GetAllowance(ctx, granter, grantee)
 	if err != nil {
 		return err
@@ -227,34 +597,357 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 		return err
 	}
 
-	remove, err := grant.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), fee, msgs)
+	remove, err := grant.Accept(k.acceptContext(ctx, gasLimit), fee, msgs)
 	if remove && err == nil {
 		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
 		_ = k.revokeAllowance(ctx, granter, grantee)
 
-		return k.emitUseGrantEvent(ctx, granterStr, granteeStr)
+		return k.emitUseGrantEvent(ctx, granterStr, granteeStr, fee, nil)
 	}
 	if err != nil {
 		return err
 	}
-	if err := k.emitUseGrantEvent(ctx, granterStr, granteeStr); err != nil {
+	if err := k.emitUseGrantEvent(ctx, granterStr, granteeStr, fee, grant); err != nil {
 		return err
 	}
 
 	// if fee allowance is accepted, store the updated state of the allowance
-	return k.UpdateAllowance(ctx, granter, grantee, grant)
+	if err := k.UpdateAllowance(ctx, granter, grantee, grant); err != nil {
+		return err
+	}
+
+	return k.addUsage(ctx, granter, grantee, fee)
+}
+
+// acceptGroupGrantedFees runs the Accept logic of the group allowance and checks the spend limit of the
+// member against the given fee. It returns the updated group grant, which is not stored, and true if the
+// group allowance has been used up.
+func (k Keeper) acceptGroupGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, name string, fee sdk.Coins, gasLimit uint64, msgs []sdk.Msg) (feegrant.GroupGrant, bool, error) {
+	group, err := k.GroupGrants.Get(ctx, collections.Join(granter, name))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return feegrant.GroupGrant{}, false, errorsmod.Wrapf(feegrant.ErrNoAllowance, "group fee allowance %s not found", name)
+		}
+		return feegrant.GroupGrant{}, false, err
+	}
+
+	granteeStr, err := k.addrCdc.BytesToString(grantee)
+	if err != nil {
+		return feegrant.GroupGrant{}, false, err
+	}
+
+	i, ok := group.GetMember(granteeStr)
+	if !ok {
+		return feegrant.GroupGrant{}, false, errorsmod.Wrapf(feegrant.ErrNoAllowance, "%s is not a member of the group fee allowance %s", granteeStr, name)
+	}
+
+	member := group.Members[i]
+	if err := member.Spend(fee); err != nil {
+		return feegrant.GroupGrant{}, false, err
+	}
+
+	allowance, err := group.GetGrant()
+	if err != nil {
+		return feegrant.GroupGrant{}, false, err
+	}
+
+	remove, err := allowance.Accept(k.acceptContext(ctx, gasLimit), fee, msgs)
+	if err != nil {
+		return feegrant.GroupGrant{}, false, err
+	}
+
+	if err := group.SetGrant(allowance); err != nil {
+		return feegrant.GroupGrant{}, false, err
+	}
+
+	members := make([]feegrant.GroupGrantMember, len(group.Members))
+	copy(members, group.Members)
+	members[i] = member
+	group.Members = members
+
+	return group, remove, nil
+}
+
+// useGroupGrantedFees deducts the given fee from the group allowance and from the spend limit of the member.
+func (k Keeper) useGroupGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, name string, fee sdk.Coins, gasLimit uint64, msgs []sdk.Msg) error {
+	group, remove, err := k.acceptGroupGrantedFees(ctx, granter, grantee, name, fee, gasLimit, msgs)
+	if err != nil {
+		return err
+	}
+
+	granteeStr, err := k.addrCdc.BytesToString(grantee)
+	if err != nil {
+		return err
+	}
+
+	if remove {
+		if err := k.RevokeGroupAllowance(ctx, granter, name); err != nil {
+			return err
+		}
+
+		return k.emitUseGrantEvent(ctx, group.Granter, granteeStr, fee, nil)
+	}
+
+	allowance, err := group.GetGrant()
+	if err != nil {
+		return err
+	}
+	if err := k.emitUseGrantEvent(ctx, group.Granter, granteeStr, fee, allowance); err != nil {
+		return err
+	}
+
+	return k.GroupGrants.Set(ctx, collections.Join(granter, name), group)
 }
 
-func (k *Keeper) emitUseGrantEvent(ctx context.Context, granter, grantee string) error {
-	return k.EventService.EventManager(ctx).EmitKV(
+// GrantGroupAllowance creates a new group grant, the allowance is shared by all the members
+// and each member can only spend up to its own spend limit, if any. A member cannot have a
+// grant of its own from the same granter, nor be a member of another group grant of it.
+func (k Keeper) GrantGroupAllowance(ctx context.Context, granter sdk.AccAddress, name string, feeAllowance feegrant.FeeAllowanceI, members []feegrant.GroupGrantMember) error {
+	key := collections.Join(granter, name)
+	has, err := k.GroupGrants.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "group fee allowance %s already exists", name)
+	}
+
+	exp, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	if exp != nil && exp.Before(now) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiration is before current block time")
+	}
+
+	for _, m := range members {
+		grantee, err := k.addrCdc.StringToBytes(m.Grantee)
+		if err != nil {
+			return err
+		}
+
+		if f, _ := k.GetAllowance(ctx, granter, grantee); f != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "fee allowance to %s already exists", m.Grantee)
+		}
+
+		memberKey := collections.Join(sdk.AccAddress(grantee), granter)
+		if other, err := k.GroupGrantMembers.Get(ctx, memberKey); err == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already a member of the group allowance %s", m.Grantee, other)
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if err := k.GroupGrantMembers.Set(ctx, memberKey, name); err != nil {
+			return err
+		}
+	}
+
+	// if block time is not zero, update the period reset
+	// if it is zero, it could be genesis initialization, so we don't need to update the period reset
+	if !now.IsZero() {
+		if err := feeAllowance.UpdatePeriodReset(now); err != nil {
+			return err
+		}
+	}
+
+	granterStr, err := k.addrCdc.BytesToString(granter)
+	if err != nil {
+		return err
+	}
+
+	group, err := feegrant.NewGroupGrant(granterStr, name, feeAllowance, members)
+	if err != nil {
+		return err
+	}
+
+	if err := k.GroupGrants.Set(ctx, key, group); err != nil {
+		return err
+	}
+
+	if exp != nil {
+		if err := k.GroupGrantQueue.Set(ctx, collections.Join3(*exp, granter, name)); err != nil {
+			return err
+		}
+	}
+
+	grantees := make([]string, len(members))
+	for i, m := range members {
+		grantees[i] = m.Grantee
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&feegrant.EventGrantGroupAllowance{
+		Granter:   granterStr,
+		Name:      name,
+		Members:   grantees,
+		Allowance: group.Allowance,
+	}); err != nil {
+		return err
+	}
+
+	for _, m := range members {
+		grantee, err := k.addrCdc.StringToBytes(m.Grantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Hooks().AfterGrantCreated(ctx, granter, grantee); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RevokeGroupAllowance removes an existing group grant together with its members.
+func (k Keeper) RevokeGroupAllowance(ctx context.Context, granter sdk.AccAddress, name string) error {
+	group, err := k.removeGroupAllowance(ctx, granter, name)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&feegrant.EventRevokeGroupAllowance{
+		Granter: group.Granter,
+		Name:    name,
+	})
+}
+
+// removeGroupAllowance removes an existing group grant together with its members and its entry in the
+// expiration queue, and returns it.
+func (k Keeper) removeGroupAllowance(ctx context.Context, granter sdk.AccAddress, name string) (feegrant.GroupGrant, error) {
+	key := collections.Join(granter, name)
+	group, err := k.GroupGrants.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return feegrant.GroupGrant{}, errorsmod.Wrapf(feegrant.ErrNoAllowance, "group fee allowance %s not found", name)
+		}
+		return feegrant.GroupGrant{}, err
+	}
+
+	for _, m := range group.Members {
+		grantee, err := k.addrCdc.StringToBytes(m.Grantee)
+		if err != nil {
+			return feegrant.GroupGrant{}, err
+		}
+
+		if err := k.GroupGrantMembers.Remove(ctx, collections.Join(sdk.AccAddress(grantee), granter)); err != nil {
+			return feegrant.GroupGrant{}, err
+		}
+
+		if err := k.Hooks().AfterGrantRevoked(ctx, granter, grantee); err != nil {
+			return feegrant.GroupGrant{}, err
+		}
+	}
+
+	allowance, err := group.GetGrant()
+	if err != nil {
+		return feegrant.GroupGrant{}, err
+	}
+	exp, err := allowance.ExpiresAt()
+	if err != nil {
+		return feegrant.GroupGrant{}, err
+	}
+	if exp != nil {
+		if err := k.GroupGrantQueue.Remove(ctx, collections.Join3(*exp, granter, name)); err != nil {
+			return feegrant.GroupGrant{}, err
+		}
+	}
+
+	return group, k.GroupGrants.Remove(ctx, key)
+}
+
+// addUsage adds a use paying the given fee to the usage statistics of the grant.
+func (k Keeper) addUsage(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	key := collections.Join(grantee, granter)
+	usage, err := k.Usage.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		usage.Granter, err = k.addrCdc.BytesToString(granter)
+		if err != nil {
+			return err
+		}
+		usage.Grantee, err = k.addrCdc.BytesToString(grantee)
+		if err != nil {
+			return err
+		}
+	}
+
+	usage.Spent = usage.Spent.Add(fee...)
+	usage.UseCount++
+
+	return k.Usage.Set(ctx, key, usage)
+}
+
+// emitUseGrantEvent emits the events of a fee allowance use, remaining is nil if the allowance
+// has been used up and removed.
+func (k *Keeper) emitUseGrantEvent(ctx context.Context, granter, grantee string, fee sdk.Coins, remaining feegrant.FeeAllowanceI) error {
+	if err := k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeUseFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, granter),
 		event.NewAttribute(feegrant.AttributeKeyGrantee, grantee),
-	)
+	); err != nil {
+		return err
+	}
+
+	var remainingAny *codectypes.Any
+	if remaining != nil {
+		msg, ok := remaining.(proto.Message)
+		if !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", remaining)
+		}
+
+		var err error
+		remainingAny, err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&feegrant.EventUseAllowance{
+		Granter:            granter,
+		Grantee:            grantee,
+		Consumed:           fee,
+		RemainingAllowance: remainingAny,
+		Removed:            remaining == nil,
+	})
 }
 
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
 func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) error {
+	if err := k.ParamsStore.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, usage := range data.Usages {
+		granter, err := k.addrCdc.StringToBytes(usage.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := k.addrCdc.StringToBytes(usage.Grantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Usage.Set(ctx, collections.Join(sdk.AccAddress(grantee), sdk.AccAddress(granter)), usage); err != nil {
+			return err
+		}
+	}
+
+	for _, acc := range data.MigratedAccounts {
+		addr, err := k.addrCdc.StringToBytes(acc)
+		if err != nil {
+			return err
+		}
+
+		if err := k.MigratedAccounts.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
 	for _, f := range data.Allowances {
 		granter, err := k.addrCdc.StringToBytes(f.Granter)
 		if err != nil {
@@ -265,16 +958,40 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 
+		var parentGrantee sdk.AccAddress
+		if f.ParentGrantee != "" {
+			parentGrantee, err = k.addrCdc.StringToBytes(f.ParentGrantee)
+			if err != nil {
+				return err
+			}
+		}
+
 		grant, err := f.GetGrant()
 		if err != nil {
 			return err
 		}
 
-		err = k.GrantAllowance(ctx, granter, grantee, grant)
+		err = k.grantAllowance(ctx, granter, grantee, grant, parentGrantee, f.AllowSubGrants)
 		if err != nil {
 			return err
 		}
 	}
+
+	for _, g := range data.GroupGrants {
+		granter, err := k.addrCdc.StringToBytes(g.Granter)
+		if err != nil {
+			return err
+		}
+
+		allowance, err := g.GetGrant()
+		if err != nil {
+			return err
+		}
+
+		if err := k.GrantGroupAllowance(ctx, granter, g.Name, allowance, g.Members); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -286,10 +1003,51 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var migratedAccs []string
+	err = k.MigratedAccounts.Walk(ctx, nil, func(addr sdk.AccAddress) (stop bool, err error) {
+		acc, err := k.addrCdc.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		migratedAccs = append(migratedAccs, acc)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []feegrant.GrantUsage
+	err = k.Usage.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.GrantUsage) (stop bool, err error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var groupGrants []feegrant.GroupGrant
+	err = k.GroupGrants.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], group feegrant.GroupGrant) (stop bool, err error) {
+		groupGrants = append(groupGrants, group)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := feegrant.NewGenesisState(grants, params, migratedAccs)
+	genesis.Usages = usages
+	genesis.GroupGrants = groupGrants
 
-	return &feegrant.GenesisState{
-		Allowances: grants,
-	}, err
+	return genesis, nil
 }
 
 // RemoveExpiredAllowances iterates grantsByExpiryQueue and deletes the expired grants.
@@ -306,6 +1064,31 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 			return true, err
 		}
 
+		if err := k.Usage.Remove(ctx, collections.Join(grantee, granter)); err != nil {
+			return true, err
+		}
+
+		granterStr, err := k.addrCdc.BytesToString(granter)
+		if err != nil {
+			return true, err
+		}
+		granteeStr, err := k.addrCdc.BytesToString(grantee)
+		if err != nil {
+			return true, err
+		}
+
+		if err := k.EventService.EventManager(ctx).Emit(&feegrant.EventPruneExpiredAllowance{
+			Granter:    granterStr,
+			Grantee:    granteeStr,
+			Expiration: key.K1(),
+		}); err != nil {
+			return true, err
+		}
+
+		if err := k.Hooks().AfterGrantRevoked(ctx, granter, grantee); err != nil {
+			return true, err
+		}
+
 		keysToRemove = append(keysToRemove, key)
 
 		// limit the amount of iterations to avoid taking too much time
@@ -326,5 +1109,39 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 		}
 	}
 
+	if limit > 0 && count == limit {
+		return nil
+	}
+
+	// the expired group grants are pruned too, within the same limit
+	groupRng := collections.NewPrefixUntilTripleRange[time.Time, sdk.AccAddress, string](exp)
+	groupKeysToRemove := []collections.Triple[time.Time, sdk.AccAddress, string]{}
+	err = k.GroupGrantQueue.Walk(ctx, groupRng, func(key collections.Triple[time.Time, sdk.AccAddress, string]) (stop bool, err error) {
+		groupKeysToRemove = append(groupKeysToRemove, key)
+
+		count++
+		return count == limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range groupKeysToRemove {
+		group, err := k.removeGroupAllowance(ctx, key.K2(), key.K3())
+		if err != nil {
+			return err
+		}
+
+		for _, m := range group.Members {
+			if err := k.EventService.EventManager(ctx).Emit(&feegrant.EventPruneExpiredAllowance{
+				Granter:    group.Granter,
+				Grantee:    m.Grantee,
+				Expiration: key.K1(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	sdkmath "cosmossdk.io/math"
@@ -20,8 +23,12 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// testGasLimit is the gas limit of the transactions whose fees are paid with grants in the tests.
+const testGasLimit uint64 = 200_000
+
 type KeeperTestSuite struct {
 	suite.Suite
 
@@ -30,6 +37,7 @@ type KeeperTestSuite struct {
 	encodedAddrs   []string
 	msgSrvr        feegrant.MsgServer
 	atom           sdk.Coins
+	authority      string
 	feegrantKeeper keeper.Keeper
 }
 
@@ -51,8 +59,13 @@ func (suite *KeeperTestSuite) SetupTest() {
 		suite.encodedAddrs = append(suite.encodedAddrs, str)
 	}
 
-	suite.feegrantKeeper = keeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger()), encCfg.Codec, ac)
+	authority, err := ac.BytesToString(authtypes.NewModuleAddress(feegrant.GovModuleName))
+	suite.Require().NoError(err)
+	suite.authority = authority
+
+	suite.feegrantKeeper = keeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger()), encCfg.Codec, ac, authority)
 	suite.ctx = testCtx.Ctx
+	suite.Require().NoError(suite.feegrantKeeper.ParamsStore.Set(suite.ctx, feegrant.DefaultParams()))
 	suite.msgSrvr = keeper.NewMsgServerImpl(suite.feegrantKeeper)
 	suite.atom = sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(555)))
 }
@@ -252,7 +265,7 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 			err := suite.feegrantKeeper.GrantAllowance(suite.ctx, tc.granter, tc.grantee, future)
 			suite.Require().NoError(err)
 
-			err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, tc.granter, tc.grantee, tc.fee, []sdk.Msg{})
+			err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, tc.granter, tc.grantee, tc.fee, testGasLimit, []sdk.Msg{})
 			if tc.allowed {
 				suite.NoError(err)
 			} else {
@@ -279,7 +292,7 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: oneYear})
 
 	// expect error: feegrant expired
-	err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[2], eth, []sdk.Msg{})
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[2], eth, testGasLimit, []sdk.Msg{})
 	suite.Error(err)
 	suite.Contains(err.Error(), "fee allowance expired")
 
@@ -413,3 +426,327 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSubGrants() {
+	granter, parent, grantee, other := suite.addrs[0], suite.addrs[1], suite.addrs[2], suite.addrs[3]
+	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+
+	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, other, &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+
+	// the parent grant doesn't allow sub-grants
+	err = suite.feegrantKeeper.GrantSubAllowance(suite.ctx, granter, other, grantee, &feegrant.BasicAllowance{SpendLimit: smallAtom}, false)
+	suite.Require().ErrorIs(err, feegrant.ErrSubGrantNotAllowed)
+
+	// there is no parent grant
+	err = suite.feegrantKeeper.GrantSubAllowance(suite.ctx, granter, parent, grantee, &feegrant.BasicAllowance{SpendLimit: smallAtom}, false)
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+
+	msg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp}, suite.encodedAddrs[0], suite.encodedAddrs[1])
+	suite.Require().NoError(err)
+	msg.AllowSubGrants = true
+	_, err = suite.msgSrvr.GrantAllowance(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// the sub-grant cannot outlive the parent grant
+	err = suite.feegrantKeeper.GrantSubAllowance(suite.ctx, granter, parent, grantee, &feegrant.BasicAllowance{SpendLimit: smallAtom}, false)
+	suite.Require().Error(err)
+
+	err = suite.feegrantKeeper.GrantSubAllowance(suite.ctx, granter, parent, grantee, &feegrant.BasicAllowance{SpendLimit: smallAtom, Expiration: &exp}, false)
+	suite.Require().NoError(err)
+
+	grant, err := suite.feegrantKeeper.FeeAllowance.Get(suite.ctx, collections.Join(grantee, granter))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.encodedAddrs[1], grant.ParentGrantee)
+	suite.Require().False(grant.AllowSubGrants)
+
+	// the grantee of a sub-grant which doesn't allow sub-grants cannot re-grant it
+	err = suite.feegrantKeeper.GrantSubAllowance(suite.ctx, granter, grantee, suite.addrs[4], &feegrant.BasicAllowance{SpendLimit: smallAtom, Expiration: &exp}, false)
+	suite.Require().ErrorIs(err, feegrant.ErrSubGrantNotAllowed)
+
+	// usage flows up to the parent allowance
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 2))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, fee, testGasLimit, []sdk.Msg{})
+	suite.Require().NoError(err)
+
+	allowance, err := suite.feegrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(smallAtom.Sub(fee...), allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	allowance, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, parent)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom.Sub(fee...), allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	grant, err = suite.feegrantKeeper.FeeAllowance.Get(suite.ctx, collections.Join(parent, granter))
+	suite.Require().NoError(err)
+	suite.Require().True(grant.AllowSubGrants)
+
+	// the sub-grant limit is enforced
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, smallAtom, testGasLimit, []sdk.Msg{})
+	suite.Require().Error(err)
+
+	// a sub-grant cannot be used once the parent grant is revoked
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[1]})
+	suite.Require().NoError(err)
+
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, fee, testGasLimit, []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+}
+
+func (suite *KeeperTestSuite) TestGroupGrants() {
+	granter, member1, member2, outsider := suite.addrs[0], suite.addrs[1], suite.addrs[2], suite.addrs[3]
+	shared := sdk.NewCoins(sdk.NewInt64Coin("atom", 20))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 6))
+
+	members := []feegrant.GroupGrantMember{
+		{Grantee: suite.encodedAddrs[1], SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))},
+		{Grantee: suite.encodedAddrs[2]},
+	}
+
+	// a member cannot also have a grant of its own from the granter
+	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, member2, &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.GrantGroupAllowance(suite.ctx, granter, "team", &feegrant.BasicAllowance{SpendLimit: shared}, members)
+	suite.Require().ErrorContains(err, "already exists")
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[2]})
+	suite.Require().NoError(err)
+
+	err = suite.feegrantKeeper.GrantGroupAllowance(suite.ctx, granter, "team", &feegrant.BasicAllowance{SpendLimit: shared}, members)
+	suite.Require().NoError(err)
+
+	// the group grant cannot be granted twice and its members cannot join another group grant of the granter
+	err = suite.feegrantKeeper.GrantGroupAllowance(suite.ctx, granter, "team", &feegrant.BasicAllowance{SpendLimit: shared}, members[1:])
+	suite.Require().ErrorContains(err, "already exists")
+	err = suite.feegrantKeeper.GrantGroupAllowance(suite.ctx, granter, "other", &feegrant.BasicAllowance{SpendLimit: shared}, members[1:])
+	suite.Require().ErrorContains(err, "already a member")
+
+	// nor a grant of its own
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, member1, &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().ErrorContains(err, "already a member")
+
+	// members share the allowance
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, member1, fee, testGasLimit, []sdk.Msg{}))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, member2, fee, testGasLimit, []sdk.Msg{}))
+
+	group, err := suite.feegrantKeeper.GroupGrants.Get(suite.ctx, collections.Join(granter, "team"))
+	suite.Require().NoError(err)
+	allowance, err := group.GetGrant()
+	suite.Require().NoError(err)
+	suite.Require().Equal(shared.Sub(fee...).Sub(fee...), allowance.(*feegrant.BasicAllowance).SpendLimit)
+	suite.Require().Equal(fee, group.Members[0].Spent)
+	suite.Require().Equal(fee, group.Members[1].Spent)
+
+	// the spend limit of a member is enforced
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, member1, fee, testGasLimit, []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	// accounts outside of the group are not covered
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, outsider, fee, testGasLimit, []sdk.Msg{})
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	// the group grant is removed once the shared allowance is used up
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, member2, sdk.NewCoins(sdk.NewInt64Coin("atom", 8)), testGasLimit, []sdk.Msg{}))
+	_, err = suite.feegrantKeeper.GroupGrants.Get(suite.ctx, collections.Join(granter, "team"))
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	_, err = suite.feegrantKeeper.GroupGrantMembers.Get(suite.ctx, collections.Join(member1, granter))
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, member2, fee, testGasLimit, []sdk.Msg{})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGroupGrantsExpiration() {
+	granter, member1, member2 := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	members := []feegrant.GroupGrantMember{{Grantee: suite.encodedAddrs[1]}, {Grantee: suite.encodedAddrs[2]}}
+
+	err := suite.feegrantKeeper.GrantGroupAllowance(suite.ctx, granter, "team", &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp}, members)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.GrantGroupAllowance(suite.ctx, granter, "forever", &feegrant.BasicAllowance{SpendLimit: suite.atom}, []feegrant.GroupGrantMember{{Grantee: suite.encodedAddrs[3]}})
+	suite.Require().NoError(err)
+
+	// the group grant is kept until it expires
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredAllowances(suite.ctx.WithHeaderInfo(header.Info{Time: exp.AddDate(0, 0, -1)}), 10))
+	_, err = suite.feegrantKeeper.GroupGrants.Get(suite.ctx, collections.Join(granter, "team"))
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: exp.AddDate(0, 0, 1)}).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredAllowances(ctx, 10))
+
+	// the expired group grant and its members are pruned
+	_, err = suite.feegrantKeeper.GroupGrants.Get(ctx, collections.Join(granter, "team"))
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	for _, member := range []sdk.AccAddress{member1, member2} {
+		_, err = suite.feegrantKeeper.GroupGrantMembers.Get(ctx, collections.Join(member, granter))
+		suite.Require().ErrorIs(err, collections.ErrNotFound)
+	}
+	has, err := suite.feegrantKeeper.GroupGrantQueue.Has(ctx, collections.Join3(exp, granter, "team"))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	var pruned []string
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		if event, err := sdk.ParseTypedEvent(e); err == nil {
+			pruneEvent, ok := event.(*feegrant.EventPruneExpiredAllowance)
+			suite.Require().True(ok)
+			pruned = append(pruned, pruneEvent.Grantee)
+		}
+	}
+	suite.Require().Equal(suite.encodedAddrs[1:3], pruned)
+
+	// group grants without expiration are kept
+	_, err = suite.feegrantKeeper.GroupGrants.Get(ctx, collections.Join(granter, "forever"))
+	suite.Require().NoError(err)
+
+	// revoking a group grant removes it from the expiration queue
+	err = suite.feegrantKeeper.GrantGroupAllowance(ctx, granter, "team", &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp}, members)
+	suite.Require().ErrorContains(err, "expiration is before current block time")
+	later := exp.AddDate(1, 0, 0)
+	err = suite.feegrantKeeper.GrantGroupAllowance(ctx, granter, "team", &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &later}, members)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.RevokeGroupAllowance(ctx, granter, "team"))
+	has, err = suite.feegrantKeeper.GroupGrantQueue.Has(ctx, collections.Join3(later, granter, "team"))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+type mockHooks struct {
+	created, deducted, revoked []string
+}
+
+func (h *mockHooks) AfterGrantCreated(_ context.Context, granter, grantee sdk.AccAddress) error {
+	h.created = append(h.created, granter.String()+grantee.String())
+	return nil
+}
+
+func (h *mockHooks) AfterFeeDeducted(_ context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	h.deducted = append(h.deducted, granter.String()+grantee.String()+fee.String())
+	return nil
+}
+
+func (h *mockHooks) AfterGrantRevoked(_ context.Context, granter, grantee sdk.AccAddress) error {
+	h.revoked = append(h.revoked, granter.String()+grantee.String())
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFeesGasPriceCap() {
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	maxGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdkmath.LegacyNewDecWithPrec(25, 3)))
+	allowance, err := feegrant.NewGasPriceCapAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom}, maxGasPrices)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, allowance))
+
+	// the gas price is computed with the gas limit of the transaction, not with the limit of the gas
+	// meter, which is infinite when simulating transactions
+	ctx := suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	suite.Require().ErrorIs(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 251)), 10_000, nil), feegrant.ErrGasPriceExceeded)
+	suite.Require().ErrorIs(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), 0, nil), feegrant.ErrGasPriceExceeded)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 250)), 10_000, nil))
+
+	loaded, err := suite.feegrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.Require().NoError(err)
+	inner, err := loaded.(*feegrant.GasPriceCapAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom.Sub(sdk.NewInt64Coin("atom", 250)), inner.(*feegrant.BasicAllowance).SpendLimit)
+}
+
+func (suite *KeeperTestSuite) TestHooks() {
+	hooks := &mockHooks{}
+	suite.feegrantKeeper.SetHooks(hooks)
+	suite.Require().Panics(func() { suite.feegrantKeeper.SetHooks(hooks) })
+
+	// the hooks are also called by the copies of the keeper made before they were set
+	msgSrvr := suite.msgSrvr
+
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+
+	msg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom}, suite.encodedAddrs[0], suite.encodedAddrs[1])
+	suite.Require().NoError(err)
+	_, err = msgSrvr.GrantAllowance(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{granter.String() + grantee.String()}, hooks.created)
+
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, fee, testGasLimit, []sdk.Msg{}))
+	suite.Require().Equal([]string{granter.String() + grantee.String() + fee.String()}, hooks.deducted)
+
+	// a rejected fee doesn't call the hooks
+	suite.Require().Error(suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, suite.atom, testGasLimit, []sdk.Msg{}))
+	suite.Require().Len(hooks.deducted, 1)
+
+	_, err = msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[1]})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{granter.String() + grantee.String()}, hooks.revoked)
+}
+
+func (suite *KeeperTestSuite) TestTypedEvents() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 55))
+	exp := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+
+	typedEvents := func() []proto.Message {
+		var events []proto.Message
+		for _, e := range ctx.EventManager().ABCIEvents() {
+			// skip the untyped events
+			if event, err := sdk.ParseTypedEvent(e); err == nil {
+				events = append(events, event)
+			}
+		}
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		return events
+	}
+
+	err := suite.feegrantKeeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp})
+	suite.Require().NoError(err)
+
+	events := typedEvents()
+	suite.Require().Len(events, 1)
+	grantEvent, ok := events[0].(*feegrant.EventGrantAllowance)
+	suite.Require().True(ok)
+	suite.Require().Equal(suite.encodedAddrs[0], grantEvent.Granter)
+	suite.Require().Equal(suite.encodedAddrs[1], grantEvent.Grantee)
+
+	// a use emits the consumed fee and the remaining allowance
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], fee, testGasLimit, nil)
+	suite.Require().NoError(err)
+
+	events = typedEvents()
+	suite.Require().Len(events, 2)
+	useEvent, ok := events[0].(*feegrant.EventUseAllowance)
+	suite.Require().True(ok)
+	suite.Require().Equal(fee, useEvent.Consumed)
+	suite.Require().False(useEvent.Removed)
+	var remaining feegrant.BasicAllowance
+	suite.Require().NoError(remaining.Unmarshal(useEvent.RemainingAllowance.Value))
+	suite.Require().Equal(suite.atom.Sub(fee...), remaining.SpendLimit)
+	_, ok = events[1].(*feegrant.EventUpdateAllowance)
+	suite.Require().True(ok)
+
+	// using up the allowance removes it
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], suite.atom.Sub(fee...), testGasLimit, nil)
+	suite.Require().NoError(err)
+
+	events = typedEvents()
+	suite.Require().Len(events, 2)
+	_, ok = events[0].(*feegrant.EventRevokeAllowance)
+	suite.Require().True(ok)
+	useEvent, ok = events[1].(*feegrant.EventUseAllowance)
+	suite.Require().True(ok)
+	suite.Require().True(useEvent.Removed)
+	suite.Require().Nil(useEvent.RemainingAllowance)
+
+	// expired allowances are pruned
+	err = suite.feegrantKeeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp})
+	suite.Require().NoError(err)
+	typedEvents()
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: exp.AddDate(0, 0, 1)})
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredAllowances(ctx, 10))
+
+	events = typedEvents()
+	suite.Require().Len(events, 1)
+	pruneEvent, ok := events[0].(*feegrant.EventPruneExpiredAllowance)
+	suite.Require().True(ok)
+	suite.Require().True(exp.Equal(pruneEvent.Expiration))
+}
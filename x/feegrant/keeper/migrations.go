@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/feegrant"
 	v2 "cosmossdk.io/x/feegrant/migrations/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +24,28 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx context.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.Environment, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3 by setting the default module parameters
+// and indexing the existing allowances by granter.
+func (m Migrator) Migrate2to3(ctx context.Context) error {
+	if err := m.keeper.ParamsStore.Set(ctx, feegrant.DefaultParams()); err != nil {
+		return err
+	}
+
+	var keys []collections.Pair[sdk.AccAddress, sdk.AccAddress]
+	err := m.keeper.FeeAllowance.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ feegrant.Grant) (stop bool, err error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := m.keeper.FeeAllowance.Indexes.Granter.Reference(ctx, key, feegrant.Grant{}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,9 +8,14 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/feegrant"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// revokeAllAllowancesLimit is the maximum number of allowances revoked by a single MsgRevokeAllAllowances.
+const revokeAllAllowancesLimit = 100
+
 type msgServer struct {
 	Keeper
 }
@@ -54,7 +59,7 @@ func (k msgServer) GrantAllowance(ctx context.Context, msg *feegrant.MsgGrantAll
 		return nil, err
 	}
 
-	err = k.Keeper.GrantAllowance(ctx, granter, grantee, allowance)
+	err = k.Keeper.grantAllowance(ctx, granter, grantee, allowance, nil, msg.AllowSubGrants)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +67,48 @@ func (k msgServer) GrantAllowance(ctx context.Context, msg *feegrant.MsgGrantAll
 	return &feegrant.MsgGrantAllowanceResponse{}, nil
 }
 
+// GrantSubAllowance grants a sub-allowance of the parent grantee's allowance to the grantee.
+func (k msgServer) GrantSubAllowance(ctx context.Context, msg *feegrant.MsgGrantSubAllowance) (*feegrant.MsgGrantSubAllowanceResponse, error) {
+	if strings.EqualFold(msg.Grantee, msg.Granter) || strings.EqualFold(msg.Grantee, msg.ParentGrantee) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	grantee, err := k.addrCdc.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	parentGrantee, err := k.addrCdc.StringToBytes(msg.ParentGrantee)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, err := k.addrCdc.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if f, _ := k.GetAllowance(ctx, granter, grantee); f != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.GrantSubAllowance(ctx, granter, parentGrantee, grantee, allowance, msg.AllowSubGrants)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantSubAllowanceResponse{}, nil
+}
+
 // RevokeAllowance revokes a fee allowance between a granter and grantee.
 func (k msgServer) RevokeAllowance(ctx context.Context, msg *feegrant.MsgRevokeAllowance) (*feegrant.MsgRevokeAllowanceResponse, error) {
 	if msg.Grantee == msg.Granter {
@@ -86,6 +133,72 @@ func (k msgServer) RevokeAllowance(ctx context.Context, msg *feegrant.MsgRevokeA
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
 
+// GrantGroupAllowance grants an allowance from the granter's funds which is shared by a group of grantees.
+func (k msgServer) GrantGroupAllowance(ctx context.Context, msg *feegrant.MsgGrantGroupAllowance) (*feegrant.MsgGrantGroupAllowanceResponse, error) {
+	granter, err := k.addrCdc.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range msg.Members {
+		if strings.EqualFold(m.Grantee, msg.Granter) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+		}
+		if !m.Spent.Empty() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "spent amount of member %s must be empty", m.Grantee)
+		}
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := feegrant.NewGroupGrant(msg.Granter, msg.Name, allowance, msg.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.GrantGroupAllowance(ctx, granter, msg.Name, allowance, msg.Members); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantGroupAllowanceResponse{}, nil
+}
+
+// RevokeGroupAllowance revokes a group allowance of the granter.
+func (k msgServer) RevokeGroupAllowance(ctx context.Context, msg *feegrant.MsgRevokeGroupAllowance) (*feegrant.MsgRevokeGroupAllowanceResponse, error) {
+	granter, err := k.addrCdc.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevokeGroupAllowance(ctx, granter, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeGroupAllowanceResponse{}, nil
+}
+
+// RevokeAllAllowances revokes all the fee allowances granted by the granter, up to revokeAllAllowancesLimit of them.
+func (k msgServer) RevokeAllAllowances(ctx context.Context, msg *feegrant.MsgRevokeAllAllowances) (*feegrant.MsgRevokeAllAllowancesResponse, error) {
+	granter, err := k.addrCdc.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, hasMore, err := k.Keeper.RevokeAllAllowances(ctx, granter, revokeAllAllowancesLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeAllAllowancesResponse{Revoked: uint64(revoked), HasMore: hasMore}, nil
+}
+
 // PruneAllowances removes expired allowances from the store.
 func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAllowances) (*feegrant.MsgPruneAllowancesResponse, error) {
 	// 75 is an arbitrary value, we can change it later if needed
@@ -103,3 +216,83 @@ func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAl
 
 	return &feegrant.MsgPruneAllowancesResponse{}, nil
 }
+
+// GrantModuleAllowance grants an allowance from a module account's funds to be used by the grantee.
+func (k msgServer) GrantModuleAllowance(ctx context.Context, msg *feegrant.MsgGrantModuleAllowance) (*feegrant.MsgGrantModuleAllowanceResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(feegrant.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if strings.TrimSpace(msg.ModuleName) == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "module name cannot be empty")
+	}
+
+	granter := authtypes.NewModuleAddress(msg.ModuleName)
+
+	grantee, err := k.addrCdc.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if granter.Equals(sdk.AccAddress(grantee)) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	if f, _ := k.GetAllowance(ctx, granter, grantee); f != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.GrantAllowance(ctx, granter, grantee, allowance); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantModuleAllowanceResponse{}, nil
+}
+
+// RevokeModuleAllowance revokes a fee allowance between a module account and a grantee.
+func (k msgServer) RevokeModuleAllowance(ctx context.Context, msg *feegrant.MsgRevokeModuleAllowance) (*feegrant.MsgRevokeModuleAllowanceResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(feegrant.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if strings.TrimSpace(msg.ModuleName) == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "module name cannot be empty")
+	}
+
+	grantee, err := k.addrCdc.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.revokeAllowance(ctx, authtypes.NewModuleAddress(msg.ModuleName), grantee); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeModuleAllowanceResponse{}, nil
+}
+
+// UpdateParams updates the x/feegrant module parameters.
+func (k msgServer) UpdateParams(ctx context.Context, msg *feegrant.MsgUpdateParams) (*feegrant.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(feegrant.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.ParamsStore.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgUpdateParamsResponse{}, nil
+}
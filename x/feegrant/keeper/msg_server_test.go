@@ -9,6 +9,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (suite *KeeperTestSuite) TestGrantAllowance() {
@@ -197,6 +198,96 @@ func (suite *KeeperTestSuite) TestGrantAllowance() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGrantSubAllowance() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	oneYear := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	smallAtom := types.NewCoins(types.NewInt64Coin("atom", 5))
+
+	parent, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneYear}, suite.encodedAddrs[0], suite.encodedAddrs[1])
+	suite.Require().NoError(err)
+	parent.AllowSubGrants = true
+	_, err = suite.msgSrvr.GrantAllowance(ctx, parent)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       func() *feegrant.MsgGrantSubAllowance
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name: "invalid parent grantee address",
+			req: func() *feegrant.MsgGrantSubAllowance {
+				msg, err := feegrant.NewMsgGrantSubAllowance(&feegrant.BasicAllowance{SpendLimit: smallAtom, Expiration: &oneYear}, suite.encodedAddrs[0], "invalid-parent", suite.encodedAddrs[2])
+				suite.Require().NoError(err)
+				return msg
+			},
+			expectErr: true,
+			errMsg:    "decoding bech32 failed",
+		},
+		{
+			name: "invalid: grant to parent grantee",
+			req: func() *feegrant.MsgGrantSubAllowance {
+				msg, err := feegrant.NewMsgGrantSubAllowance(&feegrant.BasicAllowance{SpendLimit: smallAtom, Expiration: &oneYear}, suite.encodedAddrs[0], suite.encodedAddrs[1], suite.encodedAddrs[1])
+				suite.Require().NoError(err)
+				return msg
+			},
+			expectErr: true,
+			errMsg:    "cannot self-grant fee authorization",
+		},
+		{
+			name: "invalid: no parent allowance",
+			req: func() *feegrant.MsgGrantSubAllowance {
+				msg, err := feegrant.NewMsgGrantSubAllowance(&feegrant.BasicAllowance{SpendLimit: smallAtom, Expiration: &oneYear}, suite.encodedAddrs[0], suite.encodedAddrs[3], suite.encodedAddrs[2])
+				suite.Require().NoError(err)
+				return msg
+			},
+			expectErr: true,
+			errMsg:    "parent fee allowance not found",
+		},
+		{
+			name: "invalid: expires after parent allowance",
+			req: func() *feegrant.MsgGrantSubAllowance {
+				msg, err := feegrant.NewMsgGrantSubAllowance(&feegrant.BasicAllowance{SpendLimit: smallAtom}, suite.encodedAddrs[0], suite.encodedAddrs[1], suite.encodedAddrs[2])
+				suite.Require().NoError(err)
+				return msg
+			},
+			expectErr: true,
+			errMsg:    "sub-allowance cannot expire after the parent allowance",
+		},
+		{
+			name: "valid: basic fee allowance",
+			req: func() *feegrant.MsgGrantSubAllowance {
+				msg, err := feegrant.NewMsgGrantSubAllowance(&feegrant.BasicAllowance{SpendLimit: smallAtom, Expiration: &oneYear}, suite.encodedAddrs[0], suite.encodedAddrs[1], suite.encodedAddrs[2])
+				suite.Require().NoError(err)
+				return msg
+			},
+			expectErr: false,
+		},
+		{
+			name: "fail: fee allowance exists",
+			req: func() *feegrant.MsgGrantSubAllowance {
+				msg, err := feegrant.NewMsgGrantSubAllowance(&feegrant.BasicAllowance{SpendLimit: smallAtom, Expiration: &oneYear}, suite.encodedAddrs[0], suite.encodedAddrs[1], suite.encodedAddrs[2])
+				suite.Require().NoError(err)
+				return msg
+			},
+			expectErr: true,
+			errMsg:    "fee allowance already exists",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.GrantSubAllowance(ctx, tc.req())
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestRevokeAllowance() {
 	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
 	oneYear := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
@@ -294,6 +385,45 @@ func (suite *KeeperTestSuite) TestRevokeAllowance() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRevokeAllAllowances() {
+	granter := suite.addrs[0]
+	for _, grantee := range suite.addrs[1:4] {
+		err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{SpendLimit: suite.atom})
+		suite.Require().NoError(err)
+	}
+	err := suite.feegrantKeeper.GrantGroupAllowance(suite.ctx, granter, "team", &feegrant.BasicAllowance{SpendLimit: suite.atom}, []feegrant.GroupGrantMember{{Grantee: suite.encodedAddrs[4]}})
+	suite.Require().NoError(err)
+
+	// the allowances of other granters are not revoked
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[1], suite.addrs[2], &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+
+	revoked, hasMore, err := suite.feegrantKeeper.RevokeAllAllowances(suite.ctx, granter, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, revoked)
+	suite.Require().True(hasMore)
+
+	revoked, hasMore, err = suite.feegrantKeeper.RevokeAllAllowances(suite.ctx, granter, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, revoked)
+	suite.Require().False(hasMore)
+
+	res, err := suite.msgSrvr.RevokeAllAllowances(suite.ctx, &feegrant.MsgRevokeAllAllowances{Granter: suite.encodedAddrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.Revoked)
+	suite.Require().False(res.HasMore)
+
+	for _, grantee := range suite.addrs[1:4] {
+		_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+		suite.Require().ErrorIs(err, collections.ErrNotFound)
+	}
+	_, err = suite.feegrantKeeper.GroupGrants.Get(suite.ctx, collections.Join(granter, "team"))
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[1], suite.addrs[2])
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestPruneAllowances() {
 	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
 	oneYear := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
@@ -357,3 +487,248 @@ func (suite *KeeperTestSuite) TestPruneAllowances() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(1, count)
 }
+
+func (suite *KeeperTestSuite) TestGrantModuleAllowance() {
+	moduleAddr := authtypes.NewModuleAddress("distribution")
+	newMsg := func(authority, moduleName, grantee string) *feegrant.MsgGrantModuleAllowance {
+		msg, err := feegrant.NewMsgGrantModuleAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom}, authority, moduleName, grantee)
+		suite.Require().NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		name   string
+		req    *feegrant.MsgGrantModuleAllowance
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "invalid authority",
+			req:    newMsg(suite.encodedAddrs[0], "distribution", suite.encodedAddrs[1]),
+			expErr: true,
+			errMsg: "invalid authority",
+		},
+		{
+			name:   "empty module name",
+			req:    newMsg(suite.authority, "", suite.encodedAddrs[1]),
+			expErr: true,
+			errMsg: "module name cannot be empty",
+		},
+		{
+			name:   "invalid grantee",
+			req:    newMsg(suite.authority, "distribution", invalidGrantee),
+			expErr: true,
+			errMsg: "decoding bech32 failed",
+		},
+		{
+			name:   "valid",
+			req:    newMsg(suite.authority, "distribution", suite.encodedAddrs[1]),
+			expErr: false,
+		},
+		{
+			name:   "fee allowance exists",
+			req:    newMsg(suite.authority, "distribution", suite.encodedAddrs[1]),
+			expErr: true,
+			errMsg: "fee allowance already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.GrantModuleAllowance(suite.ctx, tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the module account is the granter
+			allowance, err := suite.feegrantKeeper.GetAllowance(suite.ctx, moduleAddr, suite.addrs[1])
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.atom, allowance.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevokeModuleAllowance() {
+	moduleAddr := authtypes.NewModuleAddress("distribution")
+	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, moduleAddr, suite.addrs[1], &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *feegrant.MsgRevokeModuleAllowance
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &feegrant.MsgRevokeModuleAllowance{
+				Authority:  suite.encodedAddrs[0],
+				ModuleName: "distribution",
+				Grantee:    suite.encodedAddrs[1],
+			},
+			expErr: true,
+			errMsg: "invalid authority",
+		},
+		{
+			name: "no allowance",
+			req: &feegrant.MsgRevokeModuleAllowance{
+				Authority:  suite.authority,
+				ModuleName: "mint",
+				Grantee:    suite.encodedAddrs[1],
+			},
+			expErr: true,
+			errMsg: "not found",
+		},
+		{
+			name: "valid",
+			req: &feegrant.MsgRevokeModuleAllowance{
+				Authority:  suite.authority,
+				ModuleName: "distribution",
+				Grantee:    suite.encodedAddrs[1],
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.RevokeModuleAllowance(suite.ctx, tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, moduleAddr, suite.addrs[1])
+			suite.Require().ErrorIs(err, collections.ErrNotFound)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGrantGroupAllowance() {
+	members := []feegrant.GroupGrantMember{
+		{Grantee: suite.encodedAddrs[1], SpendLimit: suite.atom},
+		{Grantee: suite.encodedAddrs[2]},
+	}
+
+	testCases := []struct {
+		name    string
+		members []feegrant.GroupGrantMember
+		expErr  bool
+		errMsg  string
+	}{
+		{
+			name:    "self grant",
+			members: []feegrant.GroupGrantMember{{Grantee: suite.encodedAddrs[0]}},
+			expErr:  true,
+			errMsg:  "cannot self-grant",
+		},
+		{
+			name:    "spent amount set",
+			members: []feegrant.GroupGrantMember{{Grantee: suite.encodedAddrs[1], Spent: suite.atom}},
+			expErr:  true,
+			errMsg:  "must be empty",
+		},
+		{
+			name:   "no members",
+			expErr: true,
+			errMsg: "at least one member",
+		},
+		{
+			name:    "valid",
+			members: members,
+			expErr:  false,
+		},
+		{
+			name:    "group grant already exists",
+			members: members,
+			expErr:  true,
+			errMsg:  "already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg, err := feegrant.NewMsgGrantGroupAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom}, suite.encodedAddrs[0], "team", tc.members)
+			suite.Require().NoError(err)
+
+			_, err = suite.msgSrvr.GrantGroupAllowance(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			resp, err := suite.feegrantKeeper.GroupAllowance(suite.ctx, &feegrant.QueryGroupAllowanceRequest{Granter: suite.encodedAddrs[0], Name: "team"})
+			suite.Require().NoError(err)
+			suite.Require().Equal(members, resp.GroupGrant.Members)
+		})
+	}
+
+	// revoking the group grant removes it together with its members
+	_, err := suite.msgSrvr.RevokeGroupAllowance(suite.ctx, &feegrant.MsgRevokeGroupAllowance{Granter: suite.encodedAddrs[0], Name: "team"})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.RevokeGroupAllowance(suite.ctx, &feegrant.MsgRevokeGroupAllowance{Granter: suite.encodedAddrs[0], Name: "team"})
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name   string
+		req    *feegrant.MsgUpdateParams
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &feegrant.MsgUpdateParams{
+				Authority: suite.encodedAddrs[0],
+				Params:    feegrant.NewParams(10),
+			},
+			expErr: true,
+			errMsg: "invalid authority",
+		},
+		{
+			name: "invalid params",
+			req: &feegrant.MsgUpdateParams{
+				Authority: suite.authority,
+				Params:    feegrant.NewParams(0),
+			},
+			expErr: true,
+			errMsg: "max prune per block must be positive",
+		},
+		{
+			name: "valid params",
+			req: &feegrant.MsgUpdateParams{
+				Authority: suite.authority,
+				Params:    feegrant.NewParams(10),
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UpdateParams(suite.ctx, tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			params, err := suite.feegrantKeeper.ParamsStore.Get(suite.ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.req.Params, params)
+		})
+	}
+}
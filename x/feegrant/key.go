@@ -10,6 +10,11 @@ const (
 
 	// StoreKey is the store key string for supply
 	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
+	GovModuleName = "gov"
 )
 
 var (
@@ -20,4 +25,32 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// ParamsKey is the key of the feegrant module parameters
+	// - 0x02: params
+	ParamsKey = collections.NewPrefix(2)
+
+	// FeeAllowanceByGranterKeyPrefix is the set of the kvstore for the granter to grantee index
+	// - 0x03<granter_addr_len (1 byte)><granter_addr_bytes><grantee_addr_len (1 byte)><grantee_addr_bytes>: <empty value>
+	FeeAllowanceByGranterKeyPrefix = collections.NewPrefix(3)
+
+	// MigratedAccountsKeyPrefix is the set of the kvstore for the accounts whose grants were migrated from other modules
+	// - 0x04<addr_bytes>: <empty value>
+	MigratedAccountsKeyPrefix = collections.NewPrefix(4)
+
+	// GrantUsageKeyPrefix is the set of the kvstore for the usage statistics of the grants
+	// - 0x05<grantee_addr_len (1 byte)><grantee_addr_bytes><granter_addr_len (1 byte)><granter_addr_bytes>: GrantUsage
+	GrantUsageKeyPrefix = collections.NewPrefix(5)
+
+	// GroupGrantKeyPrefix is the set of the kvstore for group grants
+	// - 0x06<granter_addr_len (1 byte)><granter_addr_bytes><name>: GroupGrant
+	GroupGrantKeyPrefix = collections.NewPrefix(6)
+
+	// GroupGrantMemberKeyPrefix is the set of the kvstore for the member to group grant index
+	// - 0x07<grantee_addr_len (1 byte)><grantee_addr_bytes><granter_addr_len (1 byte)><granter_addr_bytes>: <group grant name>
+	GroupGrantMemberKeyPrefix = collections.NewPrefix(7)
+
+	// GroupGrantQueueKeyPrefix is the set of the kvstore for the expiration queue of group grants
+	// - 0x08<expiration_time><granter_addr_len (1 byte)><granter_addr_bytes><name>: <empty value>
+	GroupGrantQueueKeyPrefix = collections.NewPrefix(8)
 )
@@ -6,7 +6,13 @@ import (
 	"cosmossdk.io/x/feegrant/keeper"
 )
 
+// EndBlocker removes up to MaxPrunePerBlock expired allowances, oldest expiration first.
+// Remaining expired allowances are pruned in the following blocks.
 func EndBlocker(ctx context.Context, k keeper.Keeper) error {
-	// 200 is an arbitrary value, we can change it later if needed
-	return k.RemoveExpiredAllowances(ctx, 200)
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.RemoveExpiredAllowances(ctx, int(params.MaxPrunePerBlock))
 }
@@ -41,7 +41,8 @@ func TestFeegrantPruning(t *testing.T) {
 
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
 
-	feegrantKeeper := keeper.NewKeeper(env, encCfg.Codec, ac)
+	feegrantKeeper := keeper.NewKeeper(env, encCfg.Codec, ac, "")
+	require.NoError(t, feegrantKeeper.ParamsStore.Set(testCtx.Ctx, feegrant.DefaultParams()))
 
 	err := feegrantKeeper.GrantAllowance(
 		testCtx.Ctx,
@@ -103,3 +104,43 @@ func TestFeegrantPruning(t *testing.T) {
 	require.NotNil(t, res)
 	require.Len(t, res.Allowances, 1)
 }
+
+func TestFeegrantPruningIsBounded(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{})
+
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+	grantee := addrs[3]
+	now := testCtx.Ctx.HeaderInfo().Time
+	oneDay := now.AddDate(0, 0, 1)
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+	feegrantKeeper := keeper.NewKeeper(env, encCfg.Codec, address.NewBech32Codec("cosmos"), "")
+	require.NoError(t, feegrantKeeper.ParamsStore.Set(testCtx.Ctx, feegrant.NewParams(2)))
+
+	for _, granter := range addrs[:3] {
+		err := feegrantKeeper.GrantAllowance(testCtx.Ctx, granter, grantee, &feegrant.BasicAllowance{
+			Expiration: &oneDay,
+		})
+		require.NoError(t, err)
+	}
+
+	countGrants := func() int {
+		count := 0
+		err := feegrantKeeper.IterateAllFeeAllowances(testCtx.Ctx, func(feegrant.Grant) bool {
+			count++
+			return false
+		})
+		require.NoError(t, err)
+		return count
+	}
+
+	// all grants expired, but only MaxPrunePerBlock of them are removed per block
+	testCtx.Ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: now.AddDate(0, 0, 2)})
+	require.NoError(t, module.EndBlocker(testCtx.Ctx, feegrantKeeper))
+	require.Equal(t, 1, countGrants())
+
+	require.NoError(t, module.EndBlocker(testCtx.Ctx, feegrantKeeper))
+	require.Equal(t, 0, countGrants())
+}
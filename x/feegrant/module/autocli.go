@@ -44,6 +44,55 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current feegrant parameters",
+				},
+				{
+					RpcMethod: "GrantUsage",
+					Use:       "usage <granter> <grantee>",
+					Short:     "Query the usage statistics of a grant",
+					Long:      "Query the cumulative amount of fees paid with a grant and the number of times it has been used.",
+					Example:   fmt.Sprintf(`$ %s query feegrant usage [granter] [grantee]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "grantee"},
+					},
+				},
+				{
+					RpcMethod: "GroupAllowance",
+					Use:       "group-grant <granter> <name>",
+					Short:     "Query details of a group grant",
+					Long:      "Query the allowance shared by the members of a group grant, together with the spend limit and the spent amount of every member.",
+					Example:   fmt.Sprintf(`$ %s query feegrant group-grant [granter] [name]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "name"},
+					},
+				},
+				{
+					RpcMethod: "ExpiringAllowances",
+					Use:       "expiring-grants <within>",
+					Short:     "Query the grants expiring soon",
+					Long:      "Query the grants expiring within the given duration from the current block time, ordered by expiration. The grants can be filtered by granter and grantee.",
+					Example:   fmt.Sprintf(`$ %s query feegrant expiring-grants 24h --granter [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "within"},
+					},
+				},
+				{
+					RpcMethod: "SimulateFeeGrant",
+					Use:       "simulate <granter> <grantee> <msg>...",
+					Short:     "Query if a grant would cover the fee of a transaction",
+					Long:      "Runs the allowance granted to the grantee by the granter against the given fee, gas limit and messages, without using it, and reports if it would cover the fee of such a transaction.",
+					Example:   fmt.Sprintf(`$ %s query feegrant simulate [granter] [grantee] '{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"cosmos1..","to_address":"cosmos1..","amount":[{"denom":"stake","amount":"10"}]}' --fee 100stake --gas-limit 200000`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "grantee"},
+						{ProtoField: "msgs", Varargs: true},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -60,6 +109,53 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "RevokeAllAllowances",
+					Use:       "revoke-all <granter>",
+					Short:     "Revoke all the fee grants of a granter",
+					Long:      "Revoke all the fee grants, including the group grants, of a granter, e.g. when its key has been compromised. At most 100 grants are revoked per transaction, send it again while the response reports has_more. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant revoke-all [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
+				{
+					RpcMethod: "GrantSubAllowance",
+					Use:       "sub-grant <granter> <parent-grantee> <grantee> <allowance>",
+					Short:     "Grant a sub-allowance of a fee grant to another account",
+					Long:      "Grant a part of the fee allowance granted by granter to parent-grantee to another account. Fees paid with the sub-allowance are also deducted from the parent allowance. Note, the '--from' flag is ignored as it is implied from [parent-grantee]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant sub-grant [granter] [parent-grantee] [grantee] '{"@type":"/cosmos.feegrant.v1beta1.BasicAllowance","spend_limit":[{"denom":"stake","amount":"10"}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "parent_grantee"},
+						{ProtoField: "grantee"},
+						{ProtoField: "allowance"},
+					},
+				},
+				{
+					RpcMethod: "GrantGroupAllowance",
+					Use:       "group-grant <granter> <name> <allowance> <members>...",
+					Short:     "Grant a fee allowance shared by a group of grantees",
+					Long:      "Grant a fee allowance which is shared by all the members. Every member can optionally be limited by its own spend limit. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant group-grant [granter] team '{"@type":"/cosmos.feegrant.v1beta1.BasicAllowance","spend_limit":[{"denom":"stake","amount":"1000"}]}' '{"grantee":"cosmos1..","spend_limit":[{"denom":"stake","amount":"300"}]}' '{"grantee":"cosmos1.."}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "name"},
+						{ProtoField: "allowance"},
+						{ProtoField: "members", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "RevokeGroupAllowance",
+					Use:       "revoke-group-grant <granter> <name>",
+					Short:     "Revoke a group fee grant",
+					Long:      "Revoke a fee grant shared by a group of grantees. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant revoke-group-grant [granter] team`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "name"},
+					},
+				},
 				{
 					RpcMethod: "PruneAllowances",
 					Use:       "prune",
@@ -67,6 +163,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:      "Prune up to 75 expired allowances in order to reduce the size of the store when the number of expired allowances is large.",
 					Example:   fmt.Sprintf(`$ %s tx feegrant prune --from [mykey]`, version.AppName),
 				},
+				{
+					RpcMethod:      "GrantModuleAllowance",
+					Use:            "grant-module-proposal <module-name> <grantee> <allowance>",
+					Short:          "Submit a proposal to grant a fee allowance on the funds of a module account",
+					Example:        fmt.Sprintf(`%s tx feegrant grant-module-proposal distribution cosmos1... '{"@type":"/cosmos.feegrant.v1beta1.AllowedMsgAllowance","allowance":{"@type":"/cosmos.feegrant.v1beta1.BasicAllowance","spend_limit":[{"denom":"stake","amount":"1000"}]},"allowed_messages":["/cosmos.gov.v1.MsgVote"]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module_name"}, {ProtoField: "grantee"}, {ProtoField: "allowance"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "RevokeModuleAllowance",
+					Use:            "revoke-module-proposal <module-name> <grantee>",
+					Short:          "Submit a proposal to revoke a fee allowance granted on the funds of a module account",
+					Example:        fmt.Sprintf(`%s tx feegrant revoke-module-proposal distribution cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module_name"}, {ProtoField: "grantee"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal <params>",
+					Short:          "Submit a proposal to update feegrant module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update feegrant module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query feegrant params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx feegrant update-params-proposal '{ "max_prune_per_block": "200" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -1,6 +1,10 @@
 package module
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
 	modulev1 "cosmossdk.io/api/cosmos/feegrant/module/v1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -15,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simsx"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -25,12 +30,14 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetHooks),
 	)
 }
 
 type FeegrantInputs struct {
 	depinject.In
 
+	Config       *modulev1.Module
 	Environment  appmodule.Environment
 	Cdc          codec.Codec
 	AddressCodec address.Codec
@@ -39,11 +46,44 @@ type FeegrantInputs struct {
 }
 
 func ProvideModule(in FeegrantInputs) (keeper.Keeper, appmodule.AppModule) {
-	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AddressCodec)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(feegrant.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	authStr, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AddressCodec, authStr)
 	m := NewAppModule(in.Cdc, k, in.Registry)
 	return k, m
 }
 
+// InvokeSetHooks sets the feegrant hooks registered by other modules.
+func InvokeSetHooks(keeper keeper.Keeper, feegrantHooks map[string]feegrant.FeegrantHooksWrapper) error {
+	if feegrantHooks == nil {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	modNames := slices.Sorted(maps.Keys(feegrantHooks))
+	var multiHooks feegrant.MultiFeegrantHooks
+	for _, modName := range modNames {
+		hook, ok := feegrantHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find feegrant hooks for module %s", modName)
+		}
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the feegrant module.
@@ -98,6 +98,10 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 		return fmt.Errorf("failed to migrate x/feegrant from version 1 to 2: %w", err)
 	}
 
+	if err := mr.Register(feegrant.ModuleName, 2, m.Migrate2to3); err != nil {
+		return fmt.Errorf("failed to migrate x/feegrant from version 2 to 3: %w", err)
+	}
+
 	return nil
 }
 
@@ -141,7 +145,7 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 }
 
 // ConsensusVersion implements HasConsensusVersion
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // EndBlock returns the end blocker for the feegrant module.
 func (am AppModule) EndBlock(ctx context.Context) error {
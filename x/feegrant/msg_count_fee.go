@@ -0,0 +1,220 @@
+package feegrant
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	"cosmossdk.io/core/appmodule"
+	corecontext "cosmossdk.io/core/context"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                        = (*AllowedMsgCountAllowance)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*AllowedMsgCountAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedMsgCountAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewAllowedMsgCountAllowance creates a new fee allowance which can only be used for the message
+// types in limits, at most MaxCount times per message type within each period.
+func NewAllowedMsgCountAllowance(allowance FeeAllowanceI, period time.Duration, limits []MsgCountLimit) (*AllowedMsgCountAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllowedMsgCountAllowance{
+		Allowance: any,
+		Period:    period,
+		Limits:    limits,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *AllowedMsgCountAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *AllowedMsgCountAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	newAllowance, err := types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	a.Allowance = newAllowance
+
+	return nil
+}
+
+// Accept checks that all messages are of an allowed type and that none of the message type
+// limits of the current period are exceeded before delegating to the wrapped allowance.
+// The message counts are only increased if the wrapped allowance accepts the fee.
+func (a *AllowedMsgCountAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	environment, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
+	if !ok {
+		return false, errors.New("environment not set")
+	}
+
+	a.tryResetPeriod(environment.HeaderService.HeaderInfo(ctx).Time)
+
+	counts, err := a.countMsgs(ctx, environment, msgs)
+	if err != nil {
+		return false, err
+	}
+
+	for _, limit := range a.Limits {
+		var remaining uint64
+		if limit.Count < limit.MaxCount {
+			remaining = limit.MaxCount - limit.Count
+		}
+		if counts[limit.MsgTypeUrl] > remaining {
+			return false, errorsmod.Wrapf(ErrMsgCountExceeded, "%s can be used %d more times in the current period", limit.MsgTypeUrl, remaining)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		for i := range a.Limits {
+			a.Limits[i].Count += counts[a.Limits[i].MsgTypeUrl]
+		}
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// countMsgs returns the number of messages of each type and an error if any of the messages
+// is not of an allowed type.
+func (a *AllowedMsgCountAllowance) countMsgs(ctx context.Context, environment appmodule.Environment, msgs []sdk.Msg) (map[string]uint64, error) {
+	gasMeter := environment.GasService.GasMeter(ctx)
+
+	counts := make(map[string]uint64, len(a.Limits))
+	for _, limit := range a.Limits {
+		if err := gasMeter.Consume(gasCostPerIteration, "check msg"); err != nil {
+			return nil, err
+		}
+		counts[limit.MsgTypeUrl] = 0
+	}
+
+	for _, msg := range msgs {
+		if err := gasMeter.Consume(gasCostPerIteration, "check msg"); err != nil {
+			return nil, err
+		}
+		typeURL := sdk.MsgTypeURL(msg)
+		count, allowed := counts[typeURL]
+		if !allowed {
+			return nil, errorsmod.Wrapf(ErrMessageNotAllowed, "message %s does not exist in allowed messages", typeURL)
+		}
+		counts[typeURL] = count + 1
+	}
+
+	return counts, nil
+}
+
+// tryResetPeriod resets the message counts if the PeriodReset has been hit. If we are within one
+// Period of the last PeriodReset, the next reset is one Period from it, otherwise it is one Period
+// from blockTime, following the same rules as PeriodicAllowance.
+func (a *AllowedMsgCountAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	for i := range a.Limits {
+		a.Limits[i].Count = 0
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedMsgCountAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.Limits) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "message limits shouldn't be empty")
+	}
+	if a.Period <= 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "period must be positive")
+	}
+
+	seen := make(map[string]struct{}, len(a.Limits))
+	for _, limit := range a.Limits {
+		if limit.MsgTypeUrl == "" {
+			return errorsmod.Wrap(ErrNoMessages, "message type url cannot be empty")
+		}
+		if _, ok := seen[limit.MsgTypeUrl]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate limit for message %s", limit.MsgTypeUrl)
+		}
+		seen[limit.MsgTypeUrl] = struct{}{}
+
+		if limit.MaxCount == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max count for message %s must be positive", limit.MsgTypeUrl)
+		}
+		if limit.Count > limit.MaxCount {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "count for message %s exceeds max count", limit.MsgTypeUrl)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the AllowedMsgCountAllowance.
+func (a *AllowedMsgCountAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// UpdatePeriodReset update "PeriodReset" of the AllowedMsgCountAllowance and of the wrapped allowance.
+func (a *AllowedMsgCountAllowance) UpdatePeriodReset(validTime time.Time) error {
+	a.PeriodReset = validTime.Add(a.Period)
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	if err := allowance.UpdatePeriodReset(validTime); err != nil {
+		return err
+	}
+	return a.SetAllowance(allowance)
+}
@@ -0,0 +1,255 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/module"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMsgCountFeeValidAllow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	now := time.Now()
+	oneDay := 24 * time.Hour
+
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	send := &banktypes.MsgSend{}
+	multiSend := &banktypes.MsgMultiSend{}
+	sendURL := sdk.MsgTypeURL(send)
+	multiSendURL := sdk.MsgTypeURL(multiSend)
+
+	cases := map[string]struct {
+		allowance   *feegrant.BasicAllowance
+		limits      []feegrant.MsgCountLimit
+		periodReset time.Time
+		msgs        []sdk.Msg
+		blockTime   time.Time
+		accept      bool
+		remove      bool
+		counts      []uint64
+		resetAt     time.Time
+	}{
+		"first use": {
+			allowance:   &feegrant.BasicAllowance{},
+			limits:      []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 2}},
+			periodReset: now.Add(oneDay),
+			msgs:        []sdk.Msg{send},
+			blockTime:   now,
+			accept:      true,
+			counts:      []uint64{1},
+			resetAt:     now.Add(oneDay),
+		},
+		"counts per message type": {
+			allowance: &feegrant.BasicAllowance{},
+			limits: []feegrant.MsgCountLimit{
+				{MsgTypeUrl: sendURL, MaxCount: 2, Count: 1},
+				{MsgTypeUrl: multiSendURL, MaxCount: 3},
+			},
+			periodReset: now.Add(oneDay),
+			msgs:        []sdk.Msg{send, multiSend, multiSend},
+			blockTime:   now,
+			accept:      true,
+			counts:      []uint64{2, 2},
+			resetAt:     now.Add(oneDay),
+		},
+		"limit reached": {
+			allowance:   &feegrant.BasicAllowance{},
+			limits:      []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 2, Count: 2}},
+			periodReset: now.Add(oneDay),
+			msgs:        []sdk.Msg{send},
+			blockTime:   now,
+			accept:      false,
+		},
+		"limit exceeded within a tx": {
+			allowance:   &feegrant.BasicAllowance{},
+			limits:      []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 2, Count: 1}},
+			periodReset: now.Add(oneDay),
+			msgs:        []sdk.Msg{send, send},
+			blockTime:   now,
+			accept:      false,
+		},
+		"msg not contained": {
+			allowance:   &feegrant.BasicAllowance{},
+			limits:      []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 2}},
+			periodReset: now.Add(oneDay),
+			msgs:        []sdk.Msg{multiSend},
+			blockTime:   now,
+			accept:      false,
+		},
+		"counts reset in next period": {
+			allowance:   &feegrant.BasicAllowance{},
+			limits:      []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 2, Count: 2}},
+			periodReset: now,
+			msgs:        []sdk.Msg{send},
+			blockTime:   now.Add(time.Hour),
+			accept:      true,
+			counts:      []uint64{1},
+			resetAt:     now.Add(oneDay),
+		},
+		"reset from block time after inactivity": {
+			allowance:   &feegrant.BasicAllowance{},
+			limits:      []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 2, Count: 2}},
+			periodReset: now,
+			msgs:        []sdk.Msg{send},
+			blockTime:   now.Add(3 * oneDay),
+			accept:      true,
+			counts:      []uint64{1},
+			resetAt:     now.Add(4 * oneDay),
+		},
+		"wrapped allowance used up": {
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit: smallAtom,
+			},
+			limits:      []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 2}},
+			periodReset: now.Add(oneDay),
+			msgs:        []sdk.Msg{send},
+			blockTime:   now,
+			accept:      true,
+			remove:      true,
+		},
+		"wrapped allowance expired": {
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit: atom,
+				Expiration: &now,
+			},
+			limits:      []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 2}},
+			periodReset: now.Add(oneDay),
+			msgs:        []sdk.Msg{send},
+			blockTime:   now.Add(time.Hour),
+			accept:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: tc.blockTime})
+
+			granter, grantee := sdk.AccAddress("granter"), sdk.AccAddress("grantee")
+			allowance, err := feegrant.NewAllowedMsgCountAllowance(tc.allowance, oneDay, tc.limits)
+			require.NoError(t, err)
+			allowance.PeriodReset = tc.periodReset
+			require.NoError(t, allowance.ValidateBasic())
+
+			granterStr, err := ac.BytesToString(granter)
+			require.NoError(t, err)
+			granteeStr, err := ac.BytesToString(grantee)
+			require.NoError(t, err)
+
+			removed, err := allowance.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+				HeaderService: mockHeaderService{},
+				GasService:    mockGasService{},
+			}), smallAtom, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, removed)
+			if removed {
+				return
+			}
+
+			// mimic save & load process
+			newGrant, err := feegrant.NewGrant(granterStr, granteeStr, allowance)
+			require.NoError(t, err)
+
+			bz, err := encCfg.Codec.Marshal(&newGrant)
+			require.NoError(t, err)
+
+			var loadedGrant feegrant.Grant
+			err = encCfg.Codec.Unmarshal(bz, &loadedGrant)
+			require.NoError(t, err)
+
+			newAllowance, err := loadedGrant.GetGrant()
+			require.NoError(t, err)
+			loaded := newAllowance.(*feegrant.AllowedMsgCountAllowance)
+
+			require.Len(t, loaded.Limits, len(tc.counts))
+			for i, count := range tc.counts {
+				require.Equal(t, count, loaded.Limits[i].Count)
+			}
+			require.True(t, tc.resetAt.Equal(loaded.PeriodReset), "expected reset at %s, got %s", tc.resetAt, loaded.PeriodReset)
+		})
+	}
+}
+
+func TestMsgCountFeeValidateBasic(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	oneDay := 24 * time.Hour
+
+	cases := map[string]struct {
+		period time.Duration
+		limits []feegrant.MsgCountLimit
+		valid  bool
+	}{
+		"valid": {
+			period: oneDay,
+			limits: []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 10}},
+			valid:  true,
+		},
+		"no limits": {
+			period: oneDay,
+			valid:  false,
+		},
+		"no period": {
+			limits: []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 10}},
+			valid:  false,
+		},
+		"empty type url": {
+			period: oneDay,
+			limits: []feegrant.MsgCountLimit{{MaxCount: 10}},
+			valid:  false,
+		},
+		"duplicate type url": {
+			period: oneDay,
+			limits: []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 10}, {MsgTypeUrl: sendURL, MaxCount: 5}},
+			valid:  false,
+		},
+		"zero max count": {
+			period: oneDay,
+			limits: []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL}},
+			valid:  false,
+		},
+		"count exceeds max count": {
+			period: oneDay,
+			limits: []feegrant.MsgCountLimit{{MsgTypeUrl: sendURL, MaxCount: 10, Count: 11}},
+			valid:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedMsgCountAllowance(&feegrant.BasicAllowance{}, tc.period, tc.limits)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
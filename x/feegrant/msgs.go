@@ -12,8 +12,11 @@ import (
 )
 
 var (
-	_, _ sdk.Msg                              = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
-	_    gogoprotoany.UnpackInterfacesMessage = &MsgGrantAllowance{}
+	_, _, _, _ sdk.Msg = &MsgGrantAllowance{}, &MsgGrantSubAllowance{}, &MsgRevokeAllowance{}, &MsgUpdateParams{}
+	_, _       sdk.Msg = &MsgGrantModuleAllowance{}, &MsgRevokeModuleAllowance{}
+	_, _, _    sdk.Msg = &MsgGrantGroupAllowance{}, &MsgRevokeGroupAllowance{}, &MsgRevokeAllAllowances{}
+
+	_, _, _, _ gogoprotoany.UnpackInterfacesMessage = &MsgGrantAllowance{}, &MsgGrantSubAllowance{}, &MsgGrantModuleAllowance{}, &MsgGrantGroupAllowance{}
 )
 
 // NewMsgGrantAllowance creates a new MsgGrantAllowance.
@@ -50,6 +53,111 @@ func (msg MsgGrantAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker)
 	return unpacker.UnpackAny(msg.Allowance, &allowance)
 }
 
+// NewMsgGrantSubAllowance creates a new MsgGrantSubAllowance.
+func NewMsgGrantSubAllowance(feeAllowance FeeAllowanceI, granter, parentGrantee, grantee string) (*MsgGrantSubAllowance, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantSubAllowance{
+		Granter:       granter,
+		ParentGrantee: parentGrantee,
+		Grantee:       grantee,
+		Allowance:     any,
+	}, nil
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantSubAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantSubAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgGrantModuleAllowance creates a new MsgGrantModuleAllowance.
+func NewMsgGrantModuleAllowance(feeAllowance FeeAllowanceI, authority, moduleName, grantee string) (*MsgGrantModuleAllowance, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantModuleAllowance{
+		Authority:  authority,
+		ModuleName: moduleName,
+		Grantee:    grantee,
+		Allowance:  any,
+	}, nil
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantModuleAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantModuleAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgGrantGroupAllowance creates a new MsgGrantGroupAllowance.
+func NewMsgGrantGroupAllowance(feeAllowance FeeAllowanceI, granter, name string, members []GroupGrantMember) (*MsgGrantGroupAllowance, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantGroupAllowance{
+		Granter:   granter,
+		Name:      name,
+		Allowance: any,
+		Members:   members,
+	}, nil
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantGroupAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantGroupAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
 // NewMsgRevokeAllowance returns a message to revoke a fee allowance for a given
 // granter and grantee
 func NewMsgRevokeAllowance(granter, grantee string) MsgRevokeAllowance {
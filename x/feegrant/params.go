@@ -0,0 +1,30 @@
+package feegrant
+
+import (
+	"errors"
+)
+
+// DefaultMaxPrunePerBlock is the default maximum number of expired allowances
+// removed at the end of every block.
+const DefaultMaxPrunePerBlock uint64 = 200
+
+// NewParams creates a new Params instance.
+func NewParams(maxPrunePerBlock uint64) Params {
+	return Params{
+		MaxPrunePerBlock: maxPrunePerBlock,
+	}
+}
+
+// DefaultParams returns the default x/feegrant module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxPrunePerBlock)
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.MaxPrunePerBlock == 0 {
+		return errors.New("max prune per block must be positive")
+	}
+
+	return nil
+}
@@ -24,6 +24,8 @@ var _ FeeAllowanceI = (*PeriodicAllowance)(nil)
 //
 // If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
 // (eg. when it is used up). (See call to RevokeAllowance in Keeper.UseGrantedFees)
+// An allowance with AutoRenewal is only removed once it is used up and will not be
+// renewed at the next period reset.
 func (a *PeriodicAllowance) Accept(ctx context.Context, fee sdk.Coins, _ []sdk.Msg) (bool, error) {
 	environment, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
 	if !ok {
@@ -36,6 +38,11 @@ func (a *PeriodicAllowance) Accept(ctx context.Context, fee sdk.Coins, _ []sdk.M
 
 	a.tryResetPeriod(blockTime)
 
+	if a.AutoRenewal != nil && a.Basic.SpendLimit.IsZero() {
+		// used up, wait for the next renewal if there is one
+		return !a.AutoRenewal.canRenew(a.PeriodReset), errorsmod.Wrap(ErrFeeLimitExceeded, "absolute limit")
+	}
+
 	// deduct from both the current period and the max amount
 	var isNeg bool
 	a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(fee...)
@@ -49,6 +56,10 @@ func (a *PeriodicAllowance) Accept(ctx context.Context, fee sdk.Coins, _ []sdk.M
 			return false, errorsmod.Wrap(ErrFeeLimitExceeded, "absolute limit")
 		}
 
+		if a.AutoRenewal != nil {
+			return a.Basic.SpendLimit.IsZero() && !a.AutoRenewal.canRenew(a.PeriodReset), nil
+		}
+
 		return a.Basic.SpendLimit.IsZero(), nil
 	}
 
@@ -61,11 +72,18 @@ func (a *PeriodicAllowance) Accept(ctx context.Context, fee sdk.Coins, _ []sdk.M
 // It will also update the PeriodReset. If we are within one Period, it will update from the
 // last PeriodReset (eg. if you always do one tx per day, it will always reset the same time)
 // If we are more then one period out (eg. no activity in a week), reset is one Period from the execution of this method
+// If the allowance has a Calendar, the PeriodReset is the next calendar boundary after the block time.
+// If the allowance has an AutoRenewal which can still be renewed, Basic.SpendLimit is restored first.
 func (a *PeriodicAllowance) tryResetPeriod(blockTime time.Time) {
 	if blockTime.Before(a.PeriodReset) {
 		return
 	}
 
+	if a.AutoRenewal != nil && a.AutoRenewal.canRenew(blockTime) {
+		a.Basic.SpendLimit = sdk.NewCoins(a.AutoRenewal.SpendLimit...)
+		a.AutoRenewal.Renewals++
+	}
+
 	// set PeriodCanSpend to the lesser of Basic.SpendLimit and PeriodSpendLimit
 	if _, isNeg := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit...); isNeg && !a.Basic.SpendLimit.Empty() {
 		a.PeriodCanSpend = a.Basic.SpendLimit
@@ -73,6 +91,12 @@ func (a *PeriodicAllowance) tryResetPeriod(blockTime time.Time) {
 		a.PeriodCanSpend = a.PeriodSpendLimit
 	}
 
+	// Calendar periods always reset at the next boundary after this time, so they never drift
+	if a.Calendar != PeriodCalendarUnspecified {
+		_ = a.UpdatePeriodReset(blockTime)
+		return
+	}
+
 	// If we are within the period, step from expiration (eg. if you always do one tx per day, it will always reset the same time)
 	// If we are more then one period out (eg. no activity in a week), reset is one period from this time
 	_ = a.UpdatePeriodReset(a.PeriodReset)
@@ -111,6 +135,30 @@ func (a PeriodicAllowance) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidDuration, "negative clock step")
 	}
 
+	if a.Calendar != PeriodCalendarUnspecified {
+		if _, ok := PeriodCalendar_name[int32(a.Calendar)]; !ok {
+			return errorsmod.Wrapf(ErrInvalidDuration, "unknown period calendar %d", a.Calendar)
+		}
+		if a.Period != 0 {
+			return errorsmod.Wrap(ErrInvalidDuration, "period must be zero when a period calendar is set")
+		}
+	}
+
+	if a.AutoRenewal != nil {
+		if a.Period <= 0 && a.Calendar == PeriodCalendarUnspecified {
+			return errorsmod.Wrap(ErrInvalidDuration, "auto renewal requires a positive period")
+		}
+		if a.Basic.SpendLimit.Empty() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "auto renewal requires a basic spend limit")
+		}
+		if err := a.AutoRenewal.ValidateBasic(); err != nil {
+			return err
+		}
+		if !a.PeriodSpendLimit.DenomsSubsetOf(a.AutoRenewal.SpendLimit) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "period spend limit has different currency than auto renewal spend limit")
+		}
+	}
+
 	return nil
 }
 
@@ -121,6 +169,59 @@ func (a PeriodicAllowance) ExpiresAt() (*time.Time, error) {
 
 // UpdatePeriodReset update "PeriodReset" of the PeriodicAllowance.
 func (a *PeriodicAllowance) UpdatePeriodReset(validTime time.Time) error {
+	if a.Calendar != PeriodCalendarUnspecified {
+		a.PeriodReset = a.Calendar.nextReset(validTime)
+		return nil
+	}
+
 	a.PeriodReset = validTime.Add(a.Period)
 	return nil
 }
+
+// nextReset returns the first calendar boundary in UTC strictly after t.
+func (c PeriodCalendar) nextReset(t time.Time) time.Time {
+	t = t.UTC()
+	year, month, day := t.Date()
+	switch c {
+	case PeriodCalendarDaily:
+		return time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+	case PeriodCalendarWeekly:
+		// days until the next Monday, a full week if it is Monday already
+		days := (8 - int(t.Weekday())) % 7
+		if days == 0 {
+			days = 7
+		}
+		return time.Date(year, month, day+days, 0, 0, 0, 0, time.UTC)
+	case PeriodCalendarMonthly:
+		return time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return t
+	}
+}
+
+// canRenew returns true if the spend limit can be renewed at the given time.
+func (r *AutoRenewal) canRenew(t time.Time) bool {
+	if r.MaxRenewals > 0 && r.Renewals >= r.MaxRenewals {
+		return false
+	}
+
+	return r.EndTime == nil || t.Before(*r.EndTime)
+}
+
+// ValidateBasic enforces basic sanity checks on the AutoRenewal.
+func (r AutoRenewal) ValidateBasic() error {
+	if !r.SpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "renewal spend limit is invalid: %s", r.SpendLimit)
+	}
+	if !r.SpendLimit.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "renewal spend limit must be positive")
+	}
+	if r.MaxRenewals == 0 && r.EndTime == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "auto renewal requires max renewals or an end time")
+	}
+	if r.MaxRenewals > 0 && r.Renewals > r.MaxRenewals {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "renewals exceed max renewals")
+	}
+
+	return nil
+}
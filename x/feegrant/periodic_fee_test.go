@@ -242,3 +242,270 @@ func TestPeriodicFeeValidAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestPeriodicFeeAutoRenewal(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	periodAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 60))
+	fortyAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+
+	now := time.Now()
+	period := time.Hour
+	endTime := now.Add(150 * time.Minute)
+
+	accept := func(allow *feegrant.PeriodicAllowance, blockTime time.Time, fee sdk.Coins) (bool, error) {
+		ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: blockTime})
+		return allow.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+			HeaderService: mockHeaderService{},
+			GasService:    mockGasService{},
+		}), fee, []sdk.Msg{})
+	}
+
+	cases := map[string]struct {
+		renewal *feegrant.AutoRenewal
+	}{
+		"max renewals": {
+			renewal: &feegrant.AutoRenewal{SpendLimit: atom, MaxRenewals: 1},
+		},
+		"end time": {
+			renewal: &feegrant.AutoRenewal{SpendLimit: atom, EndTime: &endTime},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allow := &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				Period:           period,
+				PeriodSpendLimit: periodAtom,
+				PeriodCanSpend:   periodAtom,
+				PeriodReset:      now.Add(period),
+				AutoRenewal:      tc.renewal,
+			}
+			require.NoError(t, allow.ValidateBasic())
+
+			// first period, spend up to the period limit
+			remove, err := accept(allow, now, periodAtom)
+			require.NoError(t, err)
+			require.False(t, remove)
+			require.Equal(t, fortyAtom, allow.Basic.SpendLimit)
+
+			// second period, use up the basic spend limit, which is renewed first
+			remove, err = accept(allow, now.Add(period), periodAtom)
+			require.NoError(t, err)
+			require.False(t, remove)
+			require.Equal(t, fortyAtom, allow.Basic.SpendLimit)
+			require.Equal(t, uint64(1), allow.AutoRenewal.Renewals)
+
+			remove, err = accept(allow, now.Add(period), fortyAtom)
+			require.Error(t, err)
+			require.False(t, remove)
+
+			// third period, no renewal is left and the rest of the basic spend limit is used up
+			remove, err = accept(allow, now.Add(3*period), fortyAtom)
+			require.NoError(t, err)
+			require.True(t, remove)
+			require.Equal(t, uint64(1), allow.AutoRenewal.Renewals)
+		})
+	}
+}
+
+func TestPeriodicFeeAutoRenewalValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 100))
+	now := time.Now()
+
+	cases := map[string]struct {
+		allow feegrant.PeriodicAllowance
+		valid bool
+	}{
+		"valid": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				Period:           time.Hour,
+				PeriodSpendLimit: atom,
+				AutoRenewal:      &feegrant.AutoRenewal{SpendLimit: atom, MaxRenewals: 3},
+			},
+			valid: true,
+		},
+		"no renewal bound": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				Period:           time.Hour,
+				PeriodSpendLimit: atom,
+				AutoRenewal:      &feegrant.AutoRenewal{SpendLimit: atom},
+			},
+			valid: false,
+		},
+		"no basic spend limit": {
+			allow: feegrant.PeriodicAllowance{
+				Period:           time.Hour,
+				PeriodSpendLimit: atom,
+				AutoRenewal:      &feegrant.AutoRenewal{SpendLimit: atom, EndTime: &now},
+			},
+			valid: false,
+		},
+		"no period": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				PeriodSpendLimit: atom,
+				AutoRenewal:      &feegrant.AutoRenewal{SpendLimit: atom, MaxRenewals: 3},
+			},
+			valid: false,
+		},
+		"empty renewal spend limit": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				Period:           time.Hour,
+				PeriodSpendLimit: atom,
+				AutoRenewal:      &feegrant.AutoRenewal{MaxRenewals: 3},
+			},
+			valid: false,
+		},
+		"mismatched renewal currency": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				Period:           time.Hour,
+				PeriodSpendLimit: atom,
+				AutoRenewal:      &feegrant.AutoRenewal{SpendLimit: eth, MaxRenewals: 3},
+			},
+			valid: false,
+		},
+		"renewals exceed max renewals": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				Period:           time.Hour,
+				PeriodSpendLimit: atom,
+				AutoRenewal:      &feegrant.AutoRenewal{SpendLimit: atom, MaxRenewals: 3, Renewals: 4},
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPeriodicFeeCalendar(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	periodAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	// a Wednesday afternoon in a non UTC timezone
+	granted := time.Date(2024, time.January, 31, 15, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+
+	cases := map[string]struct {
+		calendar  feegrant.PeriodCalendar
+		reset     time.Time
+		nextReset time.Time
+	}{
+		"daily": {
+			calendar:  feegrant.PeriodCalendarDaily,
+			reset:     time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			nextReset: time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC),
+		},
+		"weekly": {
+			calendar:  feegrant.PeriodCalendarWeekly,
+			reset:     time.Date(2024, time.February, 5, 0, 0, 0, 0, time.UTC),
+			nextReset: time.Date(2024, time.February, 12, 0, 0, 0, 0, time.UTC),
+		},
+		"monthly": {
+			calendar:  feegrant.PeriodCalendarMonthly,
+			reset:     time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			nextReset: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allow := &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				PeriodSpendLimit: periodAtom,
+				PeriodCanSpend:   periodAtom,
+				Calendar:         tc.calendar,
+			}
+			require.NoError(t, allow.ValidateBasic())
+			require.NoError(t, allow.UpdatePeriodReset(granted))
+			require.True(t, tc.reset.Equal(allow.PeriodReset), allow.PeriodReset)
+
+			// the period is reset at the boundary, however late in the period the block is
+			blockTime := tc.nextReset.Add(-time.Second)
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: blockTime})
+			remove, err := allow.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+				HeaderService: mockHeaderService{},
+				GasService:    mockGasService{},
+			}), periodAtom, []sdk.Msg{})
+			require.NoError(t, err)
+			require.False(t, remove)
+			require.True(t, tc.nextReset.Equal(allow.PeriodReset), allow.PeriodReset)
+			require.True(t, allow.PeriodCanSpend.IsZero())
+		})
+	}
+}
+
+func TestPeriodicFeeCalendarValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	cases := map[string]struct {
+		allow feegrant.PeriodicAllowance
+		valid bool
+	}{
+		"valid": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				PeriodSpendLimit: atom,
+				Calendar:         feegrant.PeriodCalendarDaily,
+			},
+			valid: true,
+		},
+		"valid with auto renewal": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				PeriodSpendLimit: atom,
+				Calendar:         feegrant.PeriodCalendarMonthly,
+				AutoRenewal:      &feegrant.AutoRenewal{SpendLimit: atom, MaxRenewals: 12},
+			},
+			valid: true,
+		},
+		"period and calendar": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				Period:           time.Hour,
+				PeriodSpendLimit: atom,
+				Calendar:         feegrant.PeriodCalendarDaily,
+			},
+			valid: false,
+		},
+		"unknown calendar": {
+			allow: feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+				PeriodSpendLimit: atom,
+				Calendar:         feegrant.PeriodCalendar(42),
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
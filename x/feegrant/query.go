@@ -0,0 +1,36 @@
+package feegrant
+
+import (
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ gogoprotoany.UnpackInterfacesMessage = &QuerySimulateFeeGrantRequest{}
+
+// GetMessages returns the cache values from the QuerySimulateFeeGrantRequest.Msgs if present.
+func (req QuerySimulateFeeGrantRequest) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(req.Msgs))
+	for i, msgAny := range req.Msgs {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (req QuerySimulateFeeGrantRequest) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for _, x := range req.Msgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(x, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
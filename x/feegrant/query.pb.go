@@ -7,22 +7,32 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	any "github.com/cosmos/gogoproto/types/any"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -349,590 +359,3285 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
-	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
-	proto.RegisterType((*QueryAllowancesRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesRequest")
-	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
-	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
-	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("cosmos/feegrant/v1beta1/query.proto", fileDescriptor_59efc303945de53f)
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
 }
-
-var fileDescriptor_59efc303945de53f = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0xfd, 0xfd, 0x54, 0xf2, 0xf4, 0x20, 0x4c, 0xd5, 0xc6, 0x45, 0xd6, 0xb0, 0x42,
-	0xfd, 0x47, 0x66, 0x9a, 0xa8, 0xa5, 0x8a, 0x14, 0x1a, 0xc4, 0x5c, 0x35, 0x82, 0x07, 0x2f, 0x32,
-	0xe9, 0x3e, 0x2e, 0x4b, 0xe3, 0x4e, 0xba, 0xb3, 0x51, 0x8b, 0x14, 0xc1, 0x57, 0x20, 0xe8, 0x2b,
-	0xf0, 0xe0, 0xc9, 0x53, 0xe9, 0x0b, 0xf0, 0x28, 0x9e, 0x42, 0xbd, 0x78, 0x94, 0xc4, 0x17, 0x22,
-	0x99, 0x9d, 0xdd, 0x8d, 0x49, 0x96, 0x2e, 0xea, 0xc1, 0x5b, 0x26, 0xfb, 0xfd, 0x3e, 0xfb, 0xf9,
-	0x3e, 0xcf, 0xcc, 0x2c, 0x5c, 0xd8, 0x92, 0xea, 0xa9, 0x54, 0xfc, 0x09, 0xa2, 0x17, 0x8a, 0x20,
-	0xe2, 0xcf, 0xea, 0x1d, 0x8c, 0x44, 0x9d, 0xef, 0xf4, 0x31, 0xdc, 0x65, 0xbd, 0x50, 0x46, 0x92,
-	0x2e, 0xc7, 0x22, 0x96, 0x88, 0x98, 0x11, 0x59, 0x2b, 0x79, 0xee, 0x54, 0xa9, 0x0b, 0x58, 0x57,
-	0x8c, 0xae, 0x23, 0x14, 0xc6, 0x95, 0x53, 0x65, 0x4f, 0x78, 0x7e, 0x20, 0x22, 0x5f, 0x06, 0x46,
-	0x7b, 0xce, 0x93, 0xd2, 0xeb, 0x22, 0x17, 0x3d, 0x9f, 0x8b, 0x20, 0x90, 0x91, 0x7e, 0xa8, 0xcc,
-	0xd3, 0xb3, 0x71, 0xa5, 0xc7, 0x7a, 0xc5, 0x0d, 0x97, 0x5e, 0x38, 0xaf, 0xe0, 0xf4, 0xfd, 0x71,
-	0xe9, 0xcd, 0x6e, 0x57, 0x3e, 0x17, 0xc1, 0x16, 0xb6, 0x71, 0xa7, 0x8f, 0x2a, 0xa2, 0x0d, 0x38,
-	0xa1, 0x61, 0x30, 0xac, 0x90, 0x2a, 0xb9, 0x54, 0x6e, 0x56, 0x0e, 0x0f, 0x6a, 0xa7, 0x8c, 0x77,
-	0xd3, 0x75, 0x43, 0x54, 0xea, 0x41, 0x14, 0xfa, 0x81, 0xd7, 0x4e, 0x84, 0x99, 0x07, 0x2b, 0x0b,
-	0xc5, 0x3c, 0xe8, 0x3c, 0x84, 0x33, 0xd3, 0x00, 0xaa, 0x27, 0x03, 0x85, 0xf4, 0x36, 0x94, 0x45,
-	0xf2, 0xa7, 0x66, 0x58, 0x6c, 0xd8, 0x2c, 0xa7, 0xa9, 0xac, 0x35, 0x5e, 0xb5, 0x33, 0x83, 0xf3,
-	0x8e, 0x4c, 0x17, 0x56, 0x33, 0xd1, 0xb0, 0x68, 0x34, 0xa4, 0x77, 0x01, 0xb2, 0xa6, 0xeb, 0x74,
-	0x8b, 0x8d, 0x95, 0x84, 0x66, 0x3c, 0x21, 0x16, 0xcf, 0x3e, 0xe1, 0xb9, 0x27, 0xbc, 0xa4, 0x95,
-	0xed, 0x09, 0xa7, 0xf3, 0x9e, 0xc0, 0xf2, 0x0c, 0x96, 0x09, 0xbc, 0x01, 0x90, 0xf2, 0xab, 0x0a,
-	0xa9, 0xfe, 0x57, 0x20, 0xf1, 0x84, 0x83, 0xb6, 0xe6, 0x30, 0x5e, 0x3c, 0x92, 0x31, 0x7e, 0xf9,
-	0x2f, 0x90, 0xfb, 0x04, 0xce, 0x4f, 0x41, 0x36, 0x77, 0x5b, 0xf1, 0x90, 0xff, 0x64, 0x7f, 0xfc,
-	0xa5, 0x26, 0xde, 0x5a, 0x3a, 0x3c, 0xa8, 0x9d, 0x8c, 0x6d, 0x35, 0xe5, 0x6e, 0x57, 0x57, 0xd9,
-	0xf5, 0x35, 0xe7, 0x13, 0x81, 0x6a, 0x3e, 0xf4, 0x3f, 0xd6, 0xe2, 0xb9, 0x11, 0x1a, 0xfb, 0xff,
-	0xc3, 0x31, 0x1d, 0x81, 0x7e, 0x24, 0x50, 0x4e, 0x73, 0x50, 0x96, 0x4b, 0x38, 0xf7, 0xec, 0x5a,
-	0xbc, 0xb0, 0x3e, 0x26, 0x73, 0x36, 0x5e, 0x7f, 0xfd, 0xf1, 0x76, 0x61, 0x9d, 0xae, 0xf1, 0xbc,
-	0xbb, 0x29, 0xed, 0x01, 0x7f, 0x69, 0xa6, 0xb9, 0x97, 0xfc, 0xc2, 0x3d, 0xfa, 0x81, 0x00, 0x64,
-	0x6d, 0xa7, 0x45, 0xdf, 0x9f, 0x9c, 0x48, 0x6b, 0xb5, 0xb8, 0xc1, 0x10, 0xdf, 0xd0, 0xc4, 0x9c,
-	0xd6, 0x8e, 0x26, 0x56, 0x13, 0xa0, 0x03, 0x02, 0x4b, 0x73, 0xf6, 0x07, 0x5d, 0x2f, 0x0a, 0x30,
-	0x7d, 0x0e, 0xac, 0x9b, 0xbf, 0xe1, 0x34, 0x19, 0xee, 0x7c, 0x99, 0xdd, 0x03, 0x3a, 0xd6, 0x55,
-	0x7a, 0x39, 0x37, 0x96, 0xaf, 0x54, 0x1f, 0xdd, 0x6c, 0x0a, 0xcd, 0xfa, 0xe7, 0xa1, 0x4d, 0x06,
-	0x43, 0x9b, 0x7c, 0x1f, 0xda, 0xe4, 0xcd, 0xc8, 0x2e, 0x0d, 0x46, 0x76, 0xe9, 0xdb, 0xc8, 0x2e,
-	0x3d, 0x32, 0x5f, 0x20, 0xe5, 0x6e, 0x33, 0x5f, 0xf2, 0x17, 0x69, 0xad, 0xce, 0x71, 0x7d, 0xf7,
-	0x5f, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff, 0x84, 0x03, 0x50, 0xd8, 0xc8, 0x06, 0x00, 0x00,
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Allowance returns granted allowance to the grantee by the granter.
-	Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error)
-	// Allowances returns all the grants for the given grantee address.
-	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
-	// AllowancesByGranter returns all the grants given by an address
-	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryGrantUsageRequest is the request type for the Query/GrantUsage RPC method.
+type QueryGrantUsageRequest struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func (c *queryClient) Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error) {
-	out := new(QueryAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/Allowance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGrantUsageRequest) Reset()         { *m = QueryGrantUsageRequest{} }
+func (m *QueryGrantUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantUsageRequest) ProtoMessage()    {}
+func (*QueryGrantUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{8}
+}
+func (m *QueryGrantUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGrantUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantUsageRequest.Merge(m, src)
+}
+func (m *QueryGrantUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantUsageRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error) {
-	out := new(QueryAllowancesResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/Allowances", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGrantUsageRequest proto.InternalMessageInfo
+
+func (m *QueryGrantUsageRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error) {
-	out := new(QueryAllowancesByGranterResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGrantUsageRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Allowance returns granted allowance to the grantee by the granter.
-	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
-	// Allowances returns all the grants for the given grantee address.
-	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
-	// AllowancesByGranter returns all the grants given by an address
-	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+// QueryGrantUsageResponse is the response type for the Query/GrantUsage RPC method.
+type QueryGrantUsageResponse struct {
+	// usage is the usage statistics of the grant.
+	Usage GrantUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGrantUsageResponse) Reset()         { *m = QueryGrantUsageResponse{} }
+func (m *QueryGrantUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantUsageResponse) ProtoMessage()    {}
+func (*QueryGrantUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{9}
 }
-
-func (*UnimplementedQueryServer) Allowance(ctx context.Context, req *QueryAllowanceRequest) (*QueryAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Allowance not implemented")
+func (m *QueryGrantUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllowancesRequest) (*QueryAllowancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Allowances not implemented")
+func (m *QueryGrantUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
+func (m *QueryGrantUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantUsageResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGrantUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantUsageResponse.DiscardUnknown(m)
 }
 
-func _Query_Allowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Allowance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.feegrant.v1beta1.Query/Allowance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Allowance(ctx, req.(*QueryAllowanceRequest))
+var xxx_messageInfo_QueryGrantUsageResponse proto.InternalMessageInfo
+
+func (m *QueryGrantUsageResponse) GetUsage() GrantUsage {
+	if m != nil {
+		return m.Usage
 	}
-	return interceptor(ctx, in, info, handler)
+	return GrantUsage{}
 }
 
-func _Query_Allowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Allowances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.feegrant.v1beta1.Query/Allowances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Allowances(ctx, req.(*QueryAllowancesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGroupAllowanceRequest is the request type for the Query/GroupAllowance RPC method.
+type QueryGroupAllowanceRequest struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// name identifies the group grant among the group grants of the granter.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowancesByGranterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllowancesByGranter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowancesByGranter(ctx, req.(*QueryAllowancesByGranterRequest))
+func (m *QueryGroupAllowanceRequest) Reset()         { *m = QueryGroupAllowanceRequest{} }
+func (m *QueryGroupAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAllowanceRequest) ProtoMessage()    {}
+func (*QueryGroupAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{10}
+}
+func (m *QueryGroupAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGroupAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAllowanceRequest.Merge(m, src)
+}
+func (m *QueryGroupAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAllowanceRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.feegrant.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Allowance",
-			Handler:    _Query_Allowance_Handler,
-		},
-		{
-			MethodName: "Allowances",
-			Handler:    _Query_Allowances_Handler,
-		},
-		{
-			MethodName: "AllowancesByGranter",
-			Handler:    _Query_AllowancesByGranter_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/feegrant/v1beta1/query.proto",
+var xxx_messageInfo_QueryGroupAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryGroupAllowanceRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
 }
 
-func (m *QueryAllowanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGroupAllowanceRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGroupAllowanceResponse is the response type for the Query/GroupAllowance RPC method.
+type QueryGroupAllowanceResponse struct {
+	// group_grant is the group allowance together with its members.
+	GroupGrant *GroupGrant `protobuf:"bytes,1,opt,name=group_grant,json=groupGrant,proto3" json:"group_grant,omitempty"`
 }
 
-func (m *QueryAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGroupAllowanceResponse) Reset()         { *m = QueryGroupAllowanceResponse{} }
+func (m *QueryGroupAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAllowanceResponse) ProtoMessage()    {}
+func (*QueryGroupAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{11}
+}
+func (m *QueryGroupAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGroupAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAllowanceResponse.Merge(m, src)
+}
+func (m *QueryGroupAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGroupAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryGroupAllowanceResponse) GetGroupGrant() *GroupGrant {
+	if m != nil {
+		return m.GroupGrant
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySimulateFeeGrantRequest is the request type for the Query/SimulateFeeGrant RPC method.
+type QuerySimulateFeeGrantRequest struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// fee is the fee of the transaction.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// msgs are the messages of the transaction.
+	Msgs []*any.Any `protobuf:"bytes,4,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// gas_limit is the gas limit of the transaction, which is used to compute its gas price.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (m *QueryAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySimulateFeeGrantRequest) Reset()         { *m = QuerySimulateFeeGrantRequest{} }
+func (m *QuerySimulateFeeGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateFeeGrantRequest) ProtoMessage()    {}
+func (*QuerySimulateFeeGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{12}
+}
+func (m *QuerySimulateFeeGrantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateFeeGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateFeeGrantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateFeeGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateFeeGrantRequest.Merge(m, src)
+}
+func (m *QuerySimulateFeeGrantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateFeeGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateFeeGrantRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllowancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateFeeGrantRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateFeeGrantRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateFeeGrantRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
 }
 
-func (m *QueryAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QuerySimulateFeeGrantRequest) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QuerySimulateFeeGrantRequest) GetMsgs() []*any.Any {
+	if m != nil {
+		return m.Msgs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllowancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateFeeGrantRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySimulateFeeGrantResponse is the response type for the Query/SimulateFeeGrant RPC method.
+type QuerySimulateFeeGrantResponse struct {
+	// covered is true if the allowance would cover the fee of the transaction.
+	Covered bool `protobuf:"varint,1,opt,name=covered,proto3" json:"covered,omitempty"`
+	// reason is the reason why the allowance would not cover the fee, it is empty if covered is true.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *QueryAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySimulateFeeGrantResponse) Reset()         { *m = QuerySimulateFeeGrantResponse{} }
+func (m *QuerySimulateFeeGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateFeeGrantResponse) ProtoMessage()    {}
+func (*QuerySimulateFeeGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{13}
+}
+func (m *QuerySimulateFeeGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateFeeGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateFeeGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Allowances) > 0 {
-		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QuerySimulateFeeGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateFeeGrantResponse.Merge(m, src)
+}
+func (m *QuerySimulateFeeGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateFeeGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateFeeGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateFeeGrantResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateFeeGrantResponse) GetCovered() bool {
+	if m != nil {
+		return m.Covered
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryAllowancesByGranterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateFeeGrantResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllowancesByGranterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExpiringAllowancesRequest is the request type for the Query/ExpiringAllowances RPC method.
+type QueryExpiringAllowancesRequest struct {
+	// within is the duration from the current block time in which the grants expire.
+	Within time.Duration `protobuf:"bytes,1,opt,name=within,proto3,stdduration" json:"within"`
+	// granter optionally only returns the grants given by this address.
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee optionally only returns the grants given to this address.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllowancesByGranterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryExpiringAllowancesRequest) Reset()         { *m = QueryExpiringAllowancesRequest{} }
+func (m *QueryExpiringAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringAllowancesRequest) ProtoMessage()    {}
+func (*QueryExpiringAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{14}
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringAllowancesRequest.Merge(m, src)
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringAllowancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringAllowancesRequest proto.InternalMessageInfo
+
+func (m *QueryExpiringAllowancesRequest) GetWithin() time.Duration {
+	if m != nil {
+		return m.Within
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllowancesByGranterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryExpiringAllowancesRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllowancesByGranterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExpiringAllowancesRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
 }
 
-func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryExpiringAllowancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.Allowances) > 0 {
-		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+// QueryExpiringAllowancesResponse is the response type for the Query/ExpiringAllowances RPC method.
+type QueryExpiringAllowancesResponse struct {
+	// allowances are the grants expiring within the requested duration.
+	Allowances []ExpiringAllowance `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringAllowancesResponse) Reset()         { *m = QueryExpiringAllowancesResponse{} }
+func (m *QueryExpiringAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringAllowancesResponse) ProtoMessage()    {}
+func (*QueryExpiringAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{15}
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringAllowancesResponse.Merge(m, src)
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringAllowancesResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryExpiringAllowancesResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringAllowancesResponse) GetAllowances() []ExpiringAllowance {
+	if m != nil {
+		return m.Allowances
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryExpiringAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+// ExpiringAllowance is a grant together with its expiration time.
+type ExpiringAllowance struct {
+	// grant is the expiring grant.
+	Grant Grant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant"`
+	// expiration is the time at which the grant expires.
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *ExpiringAllowance) Reset()         { *m = ExpiringAllowance{} }
+func (m *ExpiringAllowance) String() string { return proto.CompactTextString(m) }
+func (*ExpiringAllowance) ProtoMessage()    {}
+func (*ExpiringAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{16}
+}
+func (m *ExpiringAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ExpiringAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringAllowance.Merge(m, src)
+}
+func (m *ExpiringAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringAllowance.DiscardUnknown(m)
 }
 
-func (m *QueryAllowancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ExpiringAllowance proto.InternalMessageInfo
+
+func (m *ExpiringAllowance) GetGrant() Grant {
+	if m != nil {
+		return m.Grant
 	}
-	var l int
-	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return Grant{}
+}
+
+func (m *ExpiringAllowance) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
+	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
+	proto.RegisterType((*QueryAllowancesRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesRequest")
+	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
+	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
+	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.feegrant.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.feegrant.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryGrantUsageRequest)(nil), "cosmos.feegrant.v1beta1.QueryGrantUsageRequest")
+	proto.RegisterType((*QueryGrantUsageResponse)(nil), "cosmos.feegrant.v1beta1.QueryGrantUsageResponse")
+	proto.RegisterType((*QueryGroupAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryGroupAllowanceRequest")
+	proto.RegisterType((*QueryGroupAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryGroupAllowanceResponse")
+	proto.RegisterType((*QuerySimulateFeeGrantRequest)(nil), "cosmos.feegrant.v1beta1.QuerySimulateFeeGrantRequest")
+	proto.RegisterType((*QuerySimulateFeeGrantResponse)(nil), "cosmos.feegrant.v1beta1.QuerySimulateFeeGrantResponse")
+	proto.RegisterType((*QueryExpiringAllowancesRequest)(nil), "cosmos.feegrant.v1beta1.QueryExpiringAllowancesRequest")
+	proto.RegisterType((*QueryExpiringAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryExpiringAllowancesResponse")
+	proto.RegisterType((*ExpiringAllowance)(nil), "cosmos.feegrant.v1beta1.ExpiringAllowance")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feegrant/v1beta1/query.proto", fileDescriptor_59efc303945de53f)
+}
+
+var fileDescriptor_59efc303945de53f = []byte{
+	// 1192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xd1, 0x4f, 0x1c, 0x45,
+	0x18, 0x67, 0xe0, 0xa0, 0xbd, 0x0f, 0xa3, 0x76, 0xc0, 0x72, 0x2c, 0xf5, 0x8e, 0x6e, 0x13, 0x8a,
+	0x14, 0x76, 0x81, 0x52, 0xa4, 0xd4, 0x34, 0xe1, 0xa4, 0xf0, 0xa2, 0x09, 0xdd, 0xaa, 0x89, 0xbe,
+	0x5c, 0x86, 0xbb, 0x61, 0xbb, 0xe9, 0xed, 0xee, 0xf5, 0x66, 0xaf, 0xed, 0xc5, 0x10, 0x8d, 0xcf,
+	0x9a, 0x34, 0x51, 0x13, 0xe3, 0x63, 0x1f, 0x8c, 0x51, 0x13, 0x1b, 0xc3, 0xa3, 0x1a, 0x1f, 0x9b,
+	0x3e, 0x91, 0xfa, 0xe2, 0x93, 0x18, 0x30, 0xe9, 0xbf, 0x61, 0x76, 0x66, 0xf6, 0x6e, 0xb9, 0xdd,
+	0x85, 0x05, 0x6b, 0xe3, 0xcb, 0xe5, 0x66, 0xe7, 0xfb, 0xcd, 0xf7, 0xfb, 0x7d, 0xdf, 0x37, 0xf3,
+	0x7d, 0x70, 0xae, 0xec, 0x32, 0xdb, 0x65, 0xfa, 0x06, 0xa5, 0x66, 0x9d, 0x38, 0x9e, 0x7e, 0x67,
+	0x66, 0x9d, 0x7a, 0x64, 0x46, 0xbf, 0xdd, 0xa0, 0xf5, 0xa6, 0x56, 0xab, 0xbb, 0x9e, 0x8b, 0x87,
+	0x84, 0x91, 0x16, 0x18, 0x69, 0xd2, 0x48, 0x19, 0x34, 0x5d, 0xd3, 0xe5, 0x36, 0xba, 0xff, 0x4f,
+	0x98, 0x2b, 0xa7, 0x88, 0x6d, 0x39, 0xae, 0xce, 0x7f, 0xe5, 0xa7, 0xb1, 0x24, 0x37, 0xad, 0x23,
+	0x85, 0xdd, 0x84, 0xb4, 0x5b, 0x27, 0x8c, 0x0a, 0x0a, 0x2d, 0xcb, 0x1a, 0x31, 0x2d, 0x87, 0x78,
+	0x96, 0xeb, 0x48, 0xdb, 0x7c, 0xd8, 0x36, 0xb0, 0x2a, 0xbb, 0x56, 0xb0, 0x3f, 0x6c, 0xba, 0xae,
+	0x59, 0xa5, 0x3a, 0x5f, 0xad, 0x37, 0x36, 0x74, 0xe2, 0x34, 0x03, 0x68, 0xe7, 0x56, 0xa5, 0x51,
+	0x0f, 0x1f, 0x5d, 0xe8, 0xdc, 0xf7, 0x2c, 0x9b, 0x32, 0x8f, 0xd8, 0x35, 0x69, 0x70, 0x46, 0x1a,
+	0x90, 0x9a, 0xa5, 0x13, 0xc7, 0x71, 0x3d, 0x8e, 0x66, 0x81, 0x67, 0xc1, 0xac, 0x24, 0x22, 0x23,
+	0x16, 0x62, 0x4b, 0xfd, 0x08, 0x5e, 0xb9, 0xee, 0xcb, 0x5a, 0xaa, 0x56, 0xdd, 0xbb, 0xc4, 0x29,
+	0x53, 0x83, 0xde, 0x6e, 0x50, 0xe6, 0xe1, 0x59, 0x38, 0xc1, 0x03, 0x41, 0xeb, 0x39, 0x34, 0x8a,
+	0xc6, 0xb3, 0xc5, 0xdc, 0x93, 0xad, 0xa9, 0x41, 0x89, 0x5d, 0xaa, 0x54, 0xea, 0x94, 0xb1, 0x1b,
+	0x5e, 0xdd, 0x72, 0x4c, 0x23, 0x30, 0x6c, 0x63, 0x68, 0xae, 0x3b, 0x1d, 0x86, 0xaa, 0xef, 0xc1,
+	0xe9, 0x4e, 0x02, 0xac, 0xe6, 0x3a, 0x8c, 0xe2, 0x37, 0x20, 0x4b, 0x82, 0x8f, 0x9c, 0x43, 0xff,
+	0x6c, 0x5e, 0x4b, 0xc8, 0xbc, 0xb6, 0xea, 0xaf, 0x8c, 0x36, 0x40, 0xfd, 0x02, 0x75, 0x1e, 0xcc,
+	0x22, 0xd2, 0x68, 0x5a, 0x69, 0x14, 0xaf, 0x00, 0xb4, 0x13, 0xce, 0xd5, 0xf5, 0xcf, 0x8e, 0x05,
+	0x6c, 0xfc, 0x8c, 0x6b, 0xa2, 0x40, 0x03, 0x3e, 0x6b, 0xc4, 0x0c, 0x42, 0x69, 0x84, 0x90, 0xea,
+	0x03, 0x04, 0x43, 0x11, 0x5a, 0x52, 0xf0, 0x55, 0x80, 0x16, 0x7f, 0x96, 0x43, 0xa3, 0x3d, 0x29,
+	0x14, 0x87, 0x10, 0x78, 0x35, 0x86, 0xe3, 0xf9, 0x43, 0x39, 0x0a, 0xe7, 0xfb, 0x48, 0xfe, 0x84,
+	0xa0, 0xd0, 0x41, 0xb2, 0xd8, 0x5c, 0x15, 0x49, 0xfe, 0x37, 0xf5, 0xf1, 0x8c, 0x82, 0xb8, 0x38,
+	0xf0, 0x64, 0x6b, 0xea, 0x25, 0x01, 0x9b, 0x62, 0x95, 0x5b, 0xa3, 0xd3, 0xda, 0xdc, 0xbc, 0xfa,
+	0x1b, 0x82, 0xd1, 0x64, 0xd2, 0xff, 0xb3, 0x10, 0xc7, 0x4b, 0x18, 0x04, 0xcc, 0x15, 0xac, 0x91,
+	0x3a, 0xb1, 0x83, 0x72, 0x55, 0xdf, 0x87, 0x81, 0x7d, 0x5f, 0xa5, 0x94, 0x22, 0xf4, 0xd5, 0xf8,
+	0x17, 0x79, 0x37, 0x0a, 0x89, 0x32, 0x04, 0xb0, 0x98, 0x7d, 0xf4, 0x67, 0xa1, 0xeb, 0xdb, 0xa7,
+	0x0f, 0x27, 0x90, 0x21, 0x91, 0xea, 0xc7, 0xc1, 0x25, 0xe1, 0x4a, 0xdf, 0x65, 0xed, 0x78, 0x3f,
+	0xb7, 0xfb, 0x5f, 0x82, 0xa1, 0x08, 0x03, 0xa9, 0x70, 0x19, 0x7a, 0x1b, 0xfe, 0x07, 0x29, 0xf0,
+	0xdc, 0xc1, 0x79, 0xe2, 0xd8, 0xb0, 0x48, 0x01, 0x56, 0x2b, 0xa0, 0x48, 0x07, 0x6e, 0xa3, 0xf6,
+	0x4c, 0x9e, 0x39, 0x0c, 0x19, 0x87, 0xd8, 0x52, 0xa3, 0xc1, 0xff, 0xab, 0x65, 0x18, 0x89, 0xf5,
+	0xd2, 0x92, 0xd2, 0x6f, 0xfa, 0x3b, 0x25, 0x7e, 0x46, 0x0a, 0x41, 0x6e, 0xa3, 0x26, 0xab, 0xcf,
+	0x6c, 0xfd, 0x57, 0x77, 0xba, 0xe1, 0x0c, 0xf7, 0x72, 0xc3, 0xb2, 0x1b, 0x55, 0xe2, 0xd1, 0x15,
+	0x4a, 0x85, 0xd5, 0xf3, 0x4d, 0x1a, 0x66, 0xd0, 0xb3, 0x41, 0x69, 0xae, 0x87, 0xdf, 0x9f, 0xe1,
+	0x7d, 0xf5, 0x1f, 0x48, 0x78, 0xd3, 0xb5, 0x9c, 0xe2, 0x8a, 0x9f, 0x8d, 0xef, 0x76, 0x0a, 0xe3,
+	0xa6, 0xe5, 0xdd, 0x6c, 0xac, 0x6b, 0x65, 0xd7, 0x96, 0xed, 0x47, 0x6f, 0xd7, 0xbe, 0xee, 0x35,
+	0x6b, 0x94, 0x71, 0x00, 0xfb, 0xfa, 0xe9, 0xc3, 0x89, 0x17, 0xaa, 0xd4, 0x24, 0xe5, 0x66, 0xc9,
+	0x6f, 0x9d, 0x4c, 0xa4, 0xd2, 0xf7, 0x86, 0xaf, 0x41, 0xc6, 0x66, 0x26, 0xcb, 0x65, 0xb8, 0xd7,
+	0x41, 0x4d, 0xb4, 0x3c, 0x2d, 0xe8, 0x89, 0xda, 0x92, 0xd3, 0x2c, 0x8e, 0x3c, 0xde, 0x9a, 0x1a,
+	0x8a, 0xa3, 0xf3, 0x36, 0x33, 0x0d, 0x0e, 0xc7, 0x23, 0x90, 0x35, 0x09, 0x2b, 0x55, 0x2d, 0xdb,
+	0xf2, 0x72, 0xbd, 0xa3, 0x68, 0x3c, 0x63, 0x9c, 0x34, 0x09, 0x7b, 0xcb, 0x5f, 0xab, 0xd7, 0xe1,
+	0xd5, 0x84, 0x00, 0xcb, 0x44, 0xe6, 0xe0, 0x44, 0xd9, 0xbd, 0x43, 0xeb, 0xb4, 0xc2, 0x23, 0x7c,
+	0xd2, 0x08, 0x96, 0xf8, 0x34, 0xf4, 0xd5, 0x29, 0x61, 0xf2, 0x59, 0xc8, 0x1a, 0x72, 0xa5, 0x7e,
+	0xd6, 0x0d, 0x79, 0x7e, 0xe6, 0xb5, 0x7b, 0x35, 0xcb, 0x0f, 0x63, 0xb4, 0x21, 0x5d, 0x81, 0xbe,
+	0xbb, 0x96, 0x77, 0xd3, 0x72, 0x64, 0x61, 0x0c, 0x47, 0xb4, 0x2d, 0xcb, 0x79, 0xa0, 0x78, 0xd2,
+	0x8f, 0xe8, 0x57, 0x3b, 0x05, 0x64, 0x48, 0x48, 0x38, 0xe7, 0xdd, 0xc7, 0xc8, 0x79, 0xcf, 0xf1,
+	0x3a, 0x60, 0xe6, 0xd8, 0x1d, 0xf0, 0xe7, 0xa0, 0xb9, 0xc4, 0xc5, 0x43, 0x46, 0x79, 0x2d, 0xe6,
+	0x99, 0x9e, 0x48, 0xbc, 0x2d, 0x91, 0x83, 0x8a, 0x19, 0x3f, 0x4a, 0xff, 0x4d, 0x6f, 0xfc, 0x12,
+	0xc1, 0xa9, 0x88, 0x43, 0xbc, 0x08, 0xbd, 0xe1, 0x9b, 0x7d, 0x48, 0x4b, 0x91, 0xfc, 0x04, 0x04,
+	0x2f, 0x03, 0x50, 0xff, 0xc0, 0x30, 0x35, 0x25, 0x52, 0x01, 0xef, 0x04, 0x13, 0x9f, 0x28, 0x81,
+	0xfb, 0x7e, 0x09, 0x84, 0x70, 0xb3, 0x0f, 0xfa, 0xa1, 0x97, 0x87, 0x15, 0xff, 0x80, 0x20, 0xdb,
+	0x66, 0xa6, 0x25, 0x52, 0x89, 0x9d, 0xfb, 0x14, 0x3d, 0xb5, 0xbd, 0x08, 0x8e, 0x7a, 0xf5, 0x93,
+	0xdf, 0xff, 0xfe, 0xbc, 0x7b, 0x01, 0xcf, 0xeb, 0x49, 0x33, 0x75, 0x2b, 0x0d, 0xfa, 0x87, 0xb2,
+	0x00, 0x37, 0x83, 0x7f, 0x74, 0x13, 0x7f, 0x83, 0x00, 0xda, 0x25, 0x80, 0xd3, 0xfa, 0x0f, 0x2e,
+	0x8f, 0x32, 0x9d, 0x1e, 0x20, 0x19, 0x5f, 0xe2, 0x8c, 0x75, 0x3c, 0x75, 0x38, 0x63, 0x16, 0x22,
+	0xba, 0x8d, 0x60, 0x20, 0x66, 0xb6, 0xc0, 0x0b, 0x69, 0x09, 0x74, 0xce, 0x50, 0xca, 0xe5, 0x63,
+	0x20, 0xa5, 0x86, 0xe5, 0xc7, 0xd1, 0xf9, 0x81, 0xcb, 0xba, 0x80, 0x5f, 0x4b, 0x94, 0x65, 0x31,
+	0xd6, 0xa0, 0x95, 0x76, 0x16, 0xf0, 0xa7, 0x08, 0xfa, 0xc4, 0x74, 0x80, 0x2f, 0x1c, 0xcc, 0x65,
+	0xdf, 0x48, 0xa2, 0x4c, 0xa6, 0x33, 0x96, 0x5c, 0xcf, 0x73, 0x62, 0x67, 0x71, 0x21, 0x91, 0x98,
+	0x18, 0x47, 0xf0, 0xf7, 0x08, 0xa0, 0xdd, 0xcb, 0x0f, 0x2b, 0x85, 0xc8, 0xcc, 0xa2, 0x4c, 0xa7,
+	0x07, 0x48, 0x6a, 0x8b, 0x9c, 0xda, 0x1c, 0x9e, 0x4d, 0xa4, 0xc6, 0x87, 0x88, 0xd8, 0xc2, 0xfd,
+	0x15, 0xc1, 0x8b, 0xfb, 0xdb, 0x3d, 0xbe, 0x78, 0x18, 0x81, 0x98, 0x11, 0x44, 0x99, 0x3b, 0x1a,
+	0x48, 0x32, 0x5f, 0xe2, 0xcc, 0xaf, 0xe0, 0xcb, 0x89, 0xcc, 0xc5, 0xc0, 0x11, 0x7b, 0xf9, 0x1c,
+	0x62, 0xd3, 0x4d, 0xfc, 0x0b, 0x02, 0x1c, 0x7d, 0x84, 0xf1, 0xeb, 0x07, 0xf3, 0x49, 0x6c, 0x63,
+	0xca, 0xc2, 0xd1, 0x81, 0x52, 0xcc, 0x1c, 0x17, 0xa3, 0xe1, 0xc9, 0x44, 0x31, 0x54, 0x82, 0x4b,
+	0xa1, 0x37, 0xfd, 0x47, 0x04, 0x2f, 0x77, 0x36, 0x6a, 0x7c, 0xe9, 0x60, 0x12, 0x09, 0x93, 0x93,
+	0x32, 0x7f, 0x54, 0x98, 0x64, 0x3e, 0xc9, 0x99, 0x8f, 0xa9, 0x67, 0x13, 0x99, 0x33, 0x09, 0x5d,
+	0x44, 0x13, 0xc5, 0x99, 0x47, 0xbb, 0x79, 0xb4, 0xbd, 0x9b, 0x47, 0x7f, 0xed, 0xe6, 0xd1, 0xfd,
+	0xbd, 0x7c, 0xd7, 0xf6, 0x5e, 0xbe, 0xeb, 0x8f, 0xbd, 0x7c, 0xd7, 0x07, 0x72, 0x68, 0x61, 0x95,
+	0x5b, 0x9a, 0xe5, 0xea, 0xf7, 0x5a, 0xc7, 0xac, 0xf7, 0xf1, 0x0e, 0x70, 0xf1, 0x9f, 0x01, 0x00,
+	0x35, 0x83, 0x5c, 0x58, 0x19, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Allowance returns granted allowance to the grantee by the granter.
+	Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error)
+	// Allowances returns all the grants for the given grantee address.
+	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
+	// AllowancesByGranter returns all the grants given by an address
+	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// Params queries the parameters of the x/feegrant module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GrantUsage returns the usage statistics of the allowance granted to the grantee by the granter.
+	GrantUsage(ctx context.Context, in *QueryGrantUsageRequest, opts ...grpc.CallOption) (*QueryGrantUsageResponse, error)
+	// GroupAllowance returns a group allowance of the granter.
+	GroupAllowance(ctx context.Context, in *QueryGroupAllowanceRequest, opts ...grpc.CallOption) (*QueryGroupAllowanceResponse, error)
+	// ExpiringAllowances returns the grants which expire within the given duration from the
+	// current block time, ordered by expiration.
+	ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error)
+	// SimulateFeeGrant runs the Accept logic of the allowance granted to the grantee by the
+	// granter against the given fee and messages, without updating the allowance, and reports
+	// if the allowance would cover the fee of such a transaction.
+	SimulateFeeGrant(ctx context.Context, in *QuerySimulateFeeGrantRequest, opts ...grpc.CallOption) (*QuerySimulateFeeGrantResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error) {
+	out := new(QueryAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/Allowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllowancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error) {
+	out := new(QueryAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/Allowances", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error) {
+	out := new(QueryAllowancesByGranterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllowancesByGranterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GrantUsage(ctx context.Context, in *QueryGrantUsageRequest, opts ...grpc.CallOption) (*QueryGrantUsageResponse, error) {
+	out := new(QueryGrantUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/GrantUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GroupAllowance(ctx context.Context, in *QueryGroupAllowanceRequest, opts ...grpc.CallOption) (*QueryGroupAllowanceResponse, error) {
+	out := new(QueryGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/GroupAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error) {
+	out := new(QueryExpiringAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/ExpiringAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllowancesByGranterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SimulateFeeGrant(ctx context.Context, in *QuerySimulateFeeGrantRequest, opts ...grpc.CallOption) (*QuerySimulateFeeGrantResponse, error) {
+	out := new(QuerySimulateFeeGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/SimulateFeeGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Allowance returns granted allowance to the grantee by the granter.
+	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
+	// Allowances returns all the grants for the given grantee address.
+	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
+	// AllowancesByGranter returns all the grants given by an address
+	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// Params queries the parameters of the x/feegrant module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GrantUsage returns the usage statistics of the allowance granted to the grantee by the granter.
+	GrantUsage(context.Context, *QueryGrantUsageRequest) (*QueryGrantUsageResponse, error)
+	// GroupAllowance returns a group allowance of the granter.
+	GroupAllowance(context.Context, *QueryGroupAllowanceRequest) (*QueryGroupAllowanceResponse, error)
+	// ExpiringAllowances returns the grants which expire within the given duration from the
+	// current block time, ordered by expiration.
+	ExpiringAllowances(context.Context, *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error)
+	// SimulateFeeGrant runs the Accept logic of the allowance granted to the grantee by the
+	// granter against the given fee and messages, without updating the allowance, and reports
+	// if the allowance would cover the fee of such a transaction.
+	SimulateFeeGrant(context.Context, *QuerySimulateFeeGrantRequest) (*QuerySimulateFeeGrantResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Allowance(ctx context.Context, req *QueryAllowanceRequest) (*QueryAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowance not implemented")
+}
+func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllowancesRequest) (*QueryAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowances not implemented")
+}
+func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GrantUsage(ctx context.Context, req *QueryGrantUsageRequest) (*QueryGrantUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantUsage not implemented")
+}
+func (*UnimplementedQueryServer) GroupAllowance(ctx context.Context, req *QueryGroupAllowanceRequest) (*QueryGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAllowance not implemented")
+}
+func (*UnimplementedQueryServer) ExpiringAllowances(ctx context.Context, req *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringAllowances not implemented")
+}
+func (*UnimplementedQueryServer) SimulateFeeGrant(ctx context.Context, req *QuerySimulateFeeGrantRequest) (*QuerySimulateFeeGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateFeeGrant not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Allowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/Allowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowance(ctx, req.(*QueryAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/Allowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowances(ctx, req.(*QueryAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowancesByGranterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowancesByGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowancesByGranter(ctx, req.(*QueryAllowancesByGranterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GrantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/GrantUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantUsage(ctx, req.(*QueryGrantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/GroupAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAllowance(ctx, req.(*QueryGroupAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpiringAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/ExpiringAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringAllowances(ctx, req.(*QueryExpiringAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateFeeGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateFeeGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateFeeGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/SimulateFeeGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateFeeGrant(ctx, req.(*QuerySimulateFeeGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.feegrant.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Allowance",
+			Handler:    _Query_Allowance_Handler,
+		},
+		{
+			MethodName: "Allowances",
+			Handler:    _Query_Allowances_Handler,
+		},
+		{
+			MethodName: "AllowancesByGranter",
+			Handler:    _Query_AllowancesByGranter_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GrantUsage",
+			Handler:    _Query_GrantUsage_Handler,
+		},
+		{
+			MethodName: "GroupAllowance",
+			Handler:    _Query_GroupAllowance_Handler,
+		},
+		{
+			MethodName: "ExpiringAllowances",
+			Handler:    _Query_ExpiringAllowances_Handler,
+		},
+		{
+			MethodName: "SimulateFeeGrant",
+			Handler:    _Query_SimulateFeeGrant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/feegrant/v1beta1/query.proto",
+}
+
+func (m *QueryAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesByGranterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesByGranterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesByGranterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesByGranterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesByGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupGrant != nil {
+		{
+			size, err := m.GroupGrant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateFeeGrantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateFeeGrantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateFeeGrantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateFeeGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateFeeGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateFeeGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Covered {
+		i--
+		if m.Covered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Within, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Within):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpiringAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiringAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesByGranterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesByGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGrantUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGroupAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupGrant != nil {
+		l = m.GroupGrant.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateFeeGrantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QuerySimulateFeeGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Covered {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Within)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExpiringAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Grant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &Grant{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGrantUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGrantUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupGrant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupGrant == nil {
+				m.GroupGrant = &GroupGrant{}
+			}
+			if err := m.GroupGrant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateFeeGrantRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -955,10 +3660,10 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateFeeGrantRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateFeeGrantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1025,59 +3730,43 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1104,13 +3793,30 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &Grant{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1132,7 +3838,7 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateFeeGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1155,17 +3861,17 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateFeeGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateFeeGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Covered", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1175,29 +3881,17 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Covered = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1207,27 +3901,23 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1250,7 +3940,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExpiringAllowancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1273,15 +3963,15 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpiringAllowancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpiringAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Within", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1308,12 +3998,75 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Within, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1343,7 +4096,7 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1370,7 +4123,7 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExpiringAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1393,17 +4146,17 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpiringAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpiringAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1413,23 +4166,25 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Allowances = append(m.Allowances, ExpiringAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1461,7 +4216,7 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1488,7 +4243,7 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+func (m *ExpiringAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1511,15 +4266,15 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpiringAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExpiringAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1546,14 +4301,13 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1580,10 +4334,7 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13,6 +13,11 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
+// Simulation parameter constants
+const (
+	MaxPrunePerBlock = "max_prune_per_block"
+)
+
 // genFeeGrants returns a slice of randomly generated allowances.
 func genFeeGrants(r *rand.Rand, accounts []simtypes.Account, addressCodec address.Codec) ([]feegrant.Grant, error) {
 	allowances := make([]feegrant.Grant, len(accounts)-1)
@@ -67,6 +72,11 @@ func generateRandomAllowances(granter, grantee string, r *rand.Rand) feegrant.Gr
 	return allowances[r.Intn(len(allowances))]
 }
 
+// genMaxPrunePerBlock returns a randomized MaxPrunePerBlock parameter.
+func genMaxPrunePerBlock(r *rand.Rand) uint64 {
+	return uint64(r.Intn(int(feegrant.DefaultMaxPrunePerBlock)) + 1)
+}
+
 // RandomizedGenState generates a random GenesisState for feegrant
 func RandomizedGenState(simState *module.SimulationState) {
 	var feegrants []feegrant.Grant
@@ -80,7 +90,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 		panic(err)
 	}
 
-	feegrantGenesis := feegrant.NewGenesisState(feegrants)
+	var maxPrunePerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		MaxPrunePerBlock, &maxPrunePerBlock, simState.Rand,
+		func(r *rand.Rand) { maxPrunePerBlock = genMaxPrunePerBlock(r) },
+	)
+
+	feegrantGenesis := feegrant.NewGenesisState(feegrants, feegrant.NewParams(maxPrunePerBlock), nil)
 	bz, err := simState.Cdc.MarshalJSON(feegrantGenesis)
 	if err != nil {
 		panic(err)
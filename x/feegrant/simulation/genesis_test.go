@@ -42,4 +42,5 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[feegrant.ModuleName], &feegrantGenesis)
 
 	require.Len(t, feegrantGenesis.Allowances, len(accounts)-1)
+	require.NoError(t, feegrantGenesis.Params.Validate())
 }
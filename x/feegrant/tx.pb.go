@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -40,6 +41,9 @@ type MsgGrantAllowance struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic, periodic, allowed fee allowance.
 	Allowance *any.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allow_sub_grants specifies if the grantee can carve out sub-grants of the
+	// allowance to other accounts.
+	AllowSubGrants bool `protobuf:"varint,4,opt,name=allow_sub_grants,json=allowSubGrants,proto3" json:"allow_sub_grants,omitempty"`
 }
 
 func (m *MsgGrantAllowance) Reset()         { *m = MsgGrantAllowance{} }
@@ -96,6 +100,13 @@ func (m *MsgGrantAllowance) GetAllowance() *any.Any {
 	return nil
 }
 
+func (m *MsgGrantAllowance) GetAllowSubGrants() bool {
+	if m != nil {
+		return m.AllowSubGrants
+	}
+	return false
+}
+
 // MsgGrantAllowanceResponse defines the Msg/GrantAllowanceResponse response type.
 type MsgGrantAllowanceResponse struct {
 }
@@ -133,6 +144,128 @@ func (m *MsgGrantAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgGrantAllowanceResponse proto.InternalMessageInfo
 
+// MsgGrantSubAllowance adds permission for Grantee to spend up to Allowance of
+// fees from the account of Granter, bounded by the allowance granted by Granter
+// to ParentGrantee.
+type MsgGrantSubAllowance struct {
+	// granter is the address of the user who granted the parent allowance and pays the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// parent_grantee is the address of the grantee of the parent allowance.
+	ParentGrantee string `protobuf:"bytes,2,opt,name=parent_grantee,json=parentGrantee,proto3" json:"parent_grantee,omitempty"`
+	// grantee is the address of the user being granted the sub-allowance.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// allowance can be any of basic, periodic, allowed fee allowance.
+	Allowance *any.Any `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allow_sub_grants specifies if the grantee can carve out sub-grants of the
+	// sub-allowance to other accounts.
+	AllowSubGrants bool `protobuf:"varint,5,opt,name=allow_sub_grants,json=allowSubGrants,proto3" json:"allow_sub_grants,omitempty"`
+}
+
+func (m *MsgGrantSubAllowance) Reset()         { *m = MsgGrantSubAllowance{} }
+func (m *MsgGrantSubAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantSubAllowance) ProtoMessage()    {}
+func (*MsgGrantSubAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{2}
+}
+func (m *MsgGrantSubAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantSubAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantSubAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantSubAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantSubAllowance.Merge(m, src)
+}
+func (m *MsgGrantSubAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantSubAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantSubAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantSubAllowance proto.InternalMessageInfo
+
+func (m *MsgGrantSubAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgGrantSubAllowance) GetParentGrantee() string {
+	if m != nil {
+		return m.ParentGrantee
+	}
+	return ""
+}
+
+func (m *MsgGrantSubAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgGrantSubAllowance) GetAllowance() *any.Any {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+func (m *MsgGrantSubAllowance) GetAllowSubGrants() bool {
+	if m != nil {
+		return m.AllowSubGrants
+	}
+	return false
+}
+
+// MsgGrantSubAllowanceResponse defines the Msg/GrantSubAllowance response type.
+type MsgGrantSubAllowanceResponse struct {
+}
+
+func (m *MsgGrantSubAllowanceResponse) Reset()         { *m = MsgGrantSubAllowanceResponse{} }
+func (m *MsgGrantSubAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantSubAllowanceResponse) ProtoMessage()    {}
+func (*MsgGrantSubAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{3}
+}
+func (m *MsgGrantSubAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantSubAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantSubAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantSubAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantSubAllowanceResponse.Merge(m, src)
+}
+func (m *MsgGrantSubAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantSubAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantSubAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantSubAllowanceResponse proto.InternalMessageInfo
+
 // MsgRevokeAllowance removes any existing Allowance from Granter to Grantee.
 type MsgRevokeAllowance struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -145,7 +278,7 @@ func (m *MsgRevokeAllowance) Reset()         { *m = MsgRevokeAllowance{} }
 func (m *MsgRevokeAllowance) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeAllowance) ProtoMessage()    {}
 func (*MsgRevokeAllowance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd44ad7946dad783, []int{2}
+	return fileDescriptor_dd44ad7946dad783, []int{4}
 }
 func (m *MsgRevokeAllowance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,7 +329,7 @@ func (m *MsgRevokeAllowanceResponse) Reset()         { *m = MsgRevokeAllowanceRe
 func (m *MsgRevokeAllowanceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeAllowanceResponse) ProtoMessage()    {}
 func (*MsgRevokeAllowanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd44ad7946dad783, []int{3}
+	return fileDescriptor_dd44ad7946dad783, []int{5}
 }
 func (m *MsgRevokeAllowanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,6 +358,108 @@ func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
 
+// MsgRevokeAllAllowances removes all the existing allowances granted by Granter.
+type MsgRevokeAllAllowances struct {
+	// granter is the address of the user whose allowances are revoked.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *MsgRevokeAllAllowances) Reset()         { *m = MsgRevokeAllAllowances{} }
+func (m *MsgRevokeAllAllowances) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllAllowances) ProtoMessage()    {}
+func (*MsgRevokeAllAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{6}
+}
+func (m *MsgRevokeAllAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllAllowances.Merge(m, src)
+}
+func (m *MsgRevokeAllAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllAllowances proto.InternalMessageInfo
+
+func (m *MsgRevokeAllAllowances) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+// MsgRevokeAllAllowancesResponse defines the Msg/RevokeAllAllowances response type.
+type MsgRevokeAllAllowancesResponse struct {
+	// revoked is the number of allowances revoked by the message.
+	Revoked uint64 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// has_more is true if the granter still has allowances which must be revoked
+	// by sending the message again.
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (m *MsgRevokeAllAllowancesResponse) Reset()         { *m = MsgRevokeAllAllowancesResponse{} }
+func (m *MsgRevokeAllAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllAllowancesResponse) ProtoMessage()    {}
+func (*MsgRevokeAllAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{7}
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllAllowancesResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllAllowancesResponse proto.InternalMessageInfo
+
+func (m *MsgRevokeAllAllowancesResponse) GetRevoked() uint64 {
+	if m != nil {
+		return m.Revoked
+	}
+	return 0
+}
+
+func (m *MsgRevokeAllAllowancesResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
 // MsgPruneAllowances prunes expired fee allowances.
 type MsgPruneAllowances struct {
 	// pruner is the address of the user pruning expired allowances.
@@ -235,7 +470,7 @@ func (m *MsgPruneAllowances) Reset()         { *m = MsgPruneAllowances{} }
 func (m *MsgPruneAllowances) String() string { return proto.CompactTextString(m) }
 func (*MsgPruneAllowances) ProtoMessage()    {}
 func (*MsgPruneAllowances) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd44ad7946dad783, []int{4}
+	return fileDescriptor_dd44ad7946dad783, []int{8}
 }
 func (m *MsgPruneAllowances) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,7 +514,7 @@ func (m *MsgPruneAllowancesResponse) Reset()         { *m = MsgPruneAllowancesRe
 func (m *MsgPruneAllowancesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPruneAllowancesResponse) ProtoMessage()    {}
 func (*MsgPruneAllowancesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd44ad7946dad783, []int{5}
+	return fileDescriptor_dd44ad7946dad783, []int{9}
 }
 func (m *MsgPruneAllowancesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,496 +543,3416 @@ func (m *MsgPruneAllowancesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPruneAllowancesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
-	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
-	proto.RegisterType((*MsgRevokeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowance")
-	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
-	proto.RegisterType((*MsgPruneAllowances)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowances")
-	proto.RegisterType((*MsgPruneAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowancesResponse")
+// MsgGrantGroupAllowance adds permission for all Members to spend up to Allowance
+// of fees from the account of Granter, all together.
+type MsgGrantGroupAllowance struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// name identifies the group grant among the group grants of the granter.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// allowance is the allowance shared by all the members, it can be any of basic,
+	// periodic, allowed fee allowance.
+	Allowance *any.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// members are the grantees of the allowance together with their spend limits.
+	Members []GroupGrantMember `protobuf:"bytes,4,rep,name=members,proto3" json:"members"`
 }
 
-func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
-
-var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0xa8, 0x84, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08, 0x29, 0x75, 0x32,
-	0x71, 0x09, 0xfa, 0x16, 0xa7, 0xbb, 0x83, 0xac, 0x75, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0xcc, 0x4b,
-	0x4e, 0x15, 0x32, 0xe2, 0x62, 0x07, 0x3b, 0x24, 0xb5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3,
-	0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x25, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1,
-	0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x30, 0x85, 0x08, 0x3d, 0xa9, 0x12, 0x4c, 0xc4, 0xe9, 0x49,
-	0x15, 0x8a, 0xe5, 0xe2, 0x4c, 0x84, 0x59, 0x2a, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2,
-	0x07, 0xf1, 0xb3, 0x1e, 0xcc, 0xcf, 0x7a, 0x8e, 0x79, 0x95, 0x4e, 0x9a, 0xa7, 0xb6, 0xe8, 0xaa,
-	0xe2, 0x08, 0x25, 0x3d, 0xb7, 0xd4, 0x54, 0xb8, 0xd3, 0x3d, 0x83, 0x10, 0x26, 0x5a, 0xe9, 0x36,
-	0x3d, 0xdf, 0xa0, 0x05, 0x73, 0x60, 0xd7, 0xf3, 0x0d, 0x5a, 0x32, 0x10, 0x23, 0x74, 0x8b, 0x53,
-	0xb2, 0xf5, 0x31, 0x7c, 0xad, 0x24, 0xcd, 0x25, 0x89, 0x21, 0x18, 0x94, 0x5a, 0x5c, 0x90, 0x9f,
-	0x57, 0x9c, 0xaa, 0xb4, 0x86, 0x91, 0x4b, 0xc8, 0xb7, 0x38, 0x3d, 0x28, 0xb5, 0x2c, 0x3f, 0x3b,
-	0x95, 0xee, 0x21, 0x65, 0xa5, 0x87, 0xee, 0x15, 0x59, 0x54, 0xaf, 0xa0, 0xb9, 0x4b, 0x49, 0x86,
-	0x4b, 0x0a, 0x53, 0x14, 0xee, 0x99, 0x34, 0xb0, 0x5f, 0x02, 0x8a, 0x4a, 0xf3, 0x10, 0x92, 0xc5,
-	0x42, 0x06, 0x5c, 0x6c, 0x05, 0x20, 0x21, 0xc2, 0x5e, 0x81, 0xaa, 0xb3, 0x92, 0xbb, 0xb4, 0x45,
-	0x97, 0x1f, 0xe1, 0x10, 0x05, 0x03, 0x3d, 0x53, 0x03, 0x90, 0x43, 0xa1, 0xf2, 0x4a, 0x86, 0x60,
-	0x57, 0xa0, 0xd9, 0x03, 0x73, 0x85, 0x95, 0x30, 0x16, 0xdd, 0x46, 0xff, 0x98, 0xb8, 0x98, 0x7d,
-	0x8b, 0xd3, 0x85, 0x0a, 0xb8, 0xf8, 0xd0, 0x12, 0xa5, 0x96, 0x1e, 0xae, 0x04, 0x80, 0x11, 0x6b,
-	0x52, 0x46, 0xc4, 0xab, 0x85, 0x39, 0x47, 0xa8, 0x98, 0x8b, 0x1f, 0x3d, 0x76, 0xb5, 0xf1, 0x19,
-	0x83, 0xa6, 0x58, 0xca, 0x98, 0x04, 0xc5, 0x70, 0x4b, 0x3b, 0x18, 0xb9, 0xf8, 0xd1, 0xe3, 0x01,
-	0xaf, 0xad, 0x68, 0x8a, 0xf1, 0xdb, 0x8a, 0x23, 0xe4, 0x95, 0x84, 0x4f, 0x61, 0x86, 0xbc, 0x14,
-	0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0x86, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x05, 0x2d, 0x57, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0xe0, 0x05, 0x5a,
-	0x12, 0x1b, 0x38, 0xaf, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x32, 0x6b, 0x8d, 0xea,
-	0x04, 0x00, 0x00,
+func (m *MsgGrantGroupAllowance) Reset()         { *m = MsgGrantGroupAllowance{} }
+func (m *MsgGrantGroupAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantGroupAllowance) ProtoMessage()    {}
+func (*MsgGrantGroupAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// GrantAllowance grants fee allowance to the grantee on the granter's
-	// account with the provided expiration time.
-	GrantAllowance(ctx context.Context, in *MsgGrantAllowance, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
-	// RevokeAllowance revokes any fee allowance of granter's account that
-	// has been granted to the grantee.
-	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
-	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
-	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+func (m *MsgGrantGroupAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgGrantGroupAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantGroupAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgGrantGroupAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantGroupAllowance.Merge(m, src)
+}
+func (m *MsgGrantGroupAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantGroupAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantGroupAllowance.DiscardUnknown(m)
 }
 
-func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowance, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
-	out := new(MsgGrantAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/GrantAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGrantGroupAllowance proto.InternalMessageInfo
+
+func (m *MsgGrantGroupAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error) {
-	out := new(MsgRevokeAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGrantGroupAllowance) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error) {
-	out := new(MsgPruneAllowancesResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/PruneAllowances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGrantGroupAllowance) GetAllowance() *any.Any {
+	if m != nil {
+		return m.Allowance
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// GrantAllowance grants fee allowance to the grantee on the granter's
-	// account with the provided expiration time.
-	GrantAllowance(context.Context, *MsgGrantAllowance) (*MsgGrantAllowanceResponse, error)
-	// RevokeAllowance revokes any fee allowance of granter's account that
-	// has been granted to the grantee.
-	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
-	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
-	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+func (m *MsgGrantGroupAllowance) GetMembers() []GroupGrantMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgGrantGroupAllowanceResponse defines the Msg/GrantGroupAllowance response type.
+type MsgGrantGroupAllowanceResponse struct {
 }
 
-func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowance) (*MsgGrantAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
+func (m *MsgGrantGroupAllowanceResponse) Reset()         { *m = MsgGrantGroupAllowanceResponse{} }
+func (m *MsgGrantGroupAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantGroupAllowanceResponse) ProtoMessage()    {}
+func (*MsgGrantGroupAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{11}
 }
-func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
+func (m *MsgGrantGroupAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) PruneAllowances(ctx context.Context, req *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
+func (m *MsgGrantGroupAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantGroupAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgGrantGroupAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantGroupAllowanceResponse.Merge(m, src)
 }
-
-func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrantAllowance)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GrantAllowance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.feegrant.v1beta1.Msg/GrantAllowance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowance))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgGrantGroupAllowanceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeAllowance)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeAllowance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeAllowance(ctx, req.(*MsgRevokeAllowance))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgGrantGroupAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantGroupAllowanceResponse.DiscardUnknown(m)
 }
 
-func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPruneAllowances)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PruneAllowances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.feegrant.v1beta1.Msg/PruneAllowances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PruneAllowances(ctx, req.(*MsgPruneAllowances))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgGrantGroupAllowanceResponse proto.InternalMessageInfo
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.feegrant.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GrantAllowance",
-			Handler:    _Msg_GrantAllowance_Handler,
-		},
-		{
-			MethodName: "RevokeAllowance",
-			Handler:    _Msg_RevokeAllowance_Handler,
-		},
-		{
-			MethodName: "PruneAllowances",
-			Handler:    _Msg_PruneAllowances_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
+// MsgRevokeGroupAllowance removes an existing group allowance of Granter.
+type MsgRevokeGroupAllowance struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// name identifies the group grant among the group grants of the granter.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *MsgGrantAllowance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeGroupAllowance) Reset()         { *m = MsgRevokeGroupAllowance{} }
+func (m *MsgRevokeGroupAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeGroupAllowance) ProtoMessage()    {}
+func (*MsgRevokeGroupAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{12}
 }
-
-func (m *MsgGrantAllowance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeGroupAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgGrantAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRevokeGroupAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeGroupAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRevokeGroupAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeGroupAllowance.Merge(m, src)
+}
+func (m *MsgRevokeGroupAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeGroupAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeGroupAllowance.DiscardUnknown(m)
 }
 
-func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeGroupAllowance proto.InternalMessageInfo
+
+func (m *MsgRevokeGroupAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeGroupAllowance) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRevokeGroupAllowanceResponse defines the Msg/RevokeGroupAllowance response type.
+type MsgRevokeGroupAllowanceResponse struct {
 }
 
-func (m *MsgRevokeAllowance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeGroupAllowanceResponse) Reset()         { *m = MsgRevokeGroupAllowanceResponse{} }
+func (m *MsgRevokeGroupAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeGroupAllowanceResponse) ProtoMessage()    {}
+func (*MsgRevokeGroupAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{13}
+}
+func (m *MsgRevokeGroupAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeGroupAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeGroupAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokeGroupAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeGroupAllowanceResponse.Merge(m, src)
+}
+func (m *MsgRevokeGroupAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeGroupAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeGroupAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeAllowance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeGroupAllowanceResponse proto.InternalMessageInfo
+
+// MsgGrantModuleAllowance adds permission for Grantee to spend up to Allowance
+// of fees from the account of the module ModuleName.
+type MsgGrantModuleAllowance struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// module_name is the name of the module account granting the allowance of its funds.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// grantee is the address of the user being granted an allowance of the module account's funds.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// allowance can be any of basic, periodic, allowed fee allowance.
+	Allowance *any.Any `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty"`
 }
 
-func (m *MsgRevokeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Granter) > 0 {
-		i -= len(m.Granter)
-		copy(dAtA[i:], m.Granter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgGrantModuleAllowance) Reset()         { *m = MsgGrantModuleAllowance{} }
+func (m *MsgGrantModuleAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantModuleAllowance) ProtoMessage()    {}
+func (*MsgGrantModuleAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{14}
+}
+func (m *MsgGrantModuleAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantModuleAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantModuleAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgGrantModuleAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantModuleAllowance.Merge(m, src)
+}
+func (m *MsgGrantModuleAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantModuleAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantModuleAllowance.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGrantModuleAllowance proto.InternalMessageInfo
+
+func (m *MsgGrantModuleAllowance) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRevokeAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgGrantModuleAllowance) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
 }
 
-func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgGrantModuleAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
 }
 
-func (m *MsgPruneAllowances) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantModuleAllowance) GetAllowance() *any.Any {
+	if m != nil {
+		return m.Allowance
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgPruneAllowances) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgGrantModuleAllowanceResponse defines the Msg/GrantModuleAllowance response type.
+type MsgGrantModuleAllowanceResponse struct {
 }
 
-func (m *MsgPruneAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pruner) > 0 {
-		i -= len(m.Pruner)
-		copy(dAtA[i:], m.Pruner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Pruner)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgGrantModuleAllowanceResponse) Reset()         { *m = MsgGrantModuleAllowanceResponse{} }
+func (m *MsgGrantModuleAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantModuleAllowanceResponse) ProtoMessage()    {}
+func (*MsgGrantModuleAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{15}
+}
+func (m *MsgGrantModuleAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantModuleAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantModuleAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgGrantModuleAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantModuleAllowanceResponse.Merge(m, src)
+}
+func (m *MsgGrantModuleAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantModuleAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantModuleAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPruneAllowancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGrantModuleAllowanceResponse proto.InternalMessageInfo
+
+// MsgRevokeModuleAllowance removes any existing Allowance from the module
+// account ModuleName to Grantee.
+type MsgRevokeModuleAllowance struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// module_name is the name of the module account which granted the allowance.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// grantee is the address of the user being granted an allowance of the module account's funds.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *MsgRevokeModuleAllowance) Reset()         { *m = MsgRevokeModuleAllowance{} }
+func (m *MsgRevokeModuleAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeModuleAllowance) ProtoMessage()    {}
+func (*MsgRevokeModuleAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{16}
+}
+func (m *MsgRevokeModuleAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeModuleAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeModuleAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokeModuleAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeModuleAllowance.Merge(m, src)
+}
+func (m *MsgRevokeModuleAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeModuleAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeModuleAllowance.DiscardUnknown(m)
 }
 
-func (m *MsgPruneAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeModuleAllowance proto.InternalMessageInfo
+
+func (m *MsgRevokeModuleAllowance) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgPruneAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeModuleAllowance) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRevokeModuleAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgGrantAllowance) Size() (n int) {
-	if m == nil {
-		return 0
+
+// MsgRevokeModuleAllowanceResponse defines the Msg/RevokeModuleAllowance response type.
+type MsgRevokeModuleAllowanceResponse struct {
+}
+
+func (m *MsgRevokeModuleAllowanceResponse) Reset()         { *m = MsgRevokeModuleAllowanceResponse{} }
+func (m *MsgRevokeModuleAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeModuleAllowanceResponse) ProtoMessage()    {}
+func (*MsgRevokeModuleAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{17}
+}
+func (m *MsgRevokeModuleAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeModuleAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeModuleAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRevokeModuleAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeModuleAllowanceResponse.Merge(m, src)
+}
+func (m *MsgRevokeModuleAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeModuleAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeModuleAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeModuleAllowanceResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/feegrant parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{18}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return n
+	return Params{}
 }
 
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{19}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeAllowance) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
+	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
+	proto.RegisterType((*MsgGrantSubAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantSubAllowance")
+	proto.RegisterType((*MsgGrantSubAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantSubAllowanceResponse")
+	proto.RegisterType((*MsgRevokeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowance")
+	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
+	proto.RegisterType((*MsgRevokeAllAllowances)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllAllowances")
+	proto.RegisterType((*MsgRevokeAllAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllAllowancesResponse")
+	proto.RegisterType((*MsgPruneAllowances)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowances")
+	proto.RegisterType((*MsgPruneAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowancesResponse")
+	proto.RegisterType((*MsgGrantGroupAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantGroupAllowance")
+	proto.RegisterType((*MsgGrantGroupAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantGroupAllowanceResponse")
+	proto.RegisterType((*MsgRevokeGroupAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeGroupAllowance")
+	proto.RegisterType((*MsgRevokeGroupAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeGroupAllowanceResponse")
+	proto.RegisterType((*MsgGrantModuleAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantModuleAllowance")
+	proto.RegisterType((*MsgGrantModuleAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantModuleAllowanceResponse")
+	proto.RegisterType((*MsgRevokeModuleAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeModuleAllowance")
+	proto.RegisterType((*MsgRevokeModuleAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeModuleAllowanceResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.feegrant.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.feegrant.v1beta1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
+
+var fileDescriptor_dd44ad7946dad783 = []byte{
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x36, 0x2d, 0xc7, 0xb6, 0x9e, 0x5b, 0xbb, 0xa6, 0xd5, 0x58, 0x66, 0x5d, 0x4a, 0x61, 0x91,
+	0x42, 0x71, 0x21, 0x52, 0x92, 0xe1, 0xd8, 0xe5, 0x52, 0x58, 0x43, 0x8d, 0x0e, 0x0a, 0x02, 0x1a,
+	0x59, 0x0a, 0x14, 0x02, 0x15, 0x9d, 0x69, 0xd7, 0x26, 0x4f, 0xe0, 0x51, 0x6e, 0x3c, 0x35, 0x0d,
+	0x50, 0xa0, 0xe8, 0xd4, 0xa9, 0xbf, 0xa1, 0x43, 0x07, 0x03, 0xf5, 0xd8, 0xad, 0x43, 0x83, 0x4c,
+	0x41, 0xa6, 0x4e, 0x6d, 0x61, 0x0f, 0xfe, 0x0d, 0xdd, 0x0a, 0x1e, 0xc9, 0x13, 0x45, 0x9e, 0x2c,
+	0xc9, 0x41, 0x02, 0x2f, 0x89, 0x78, 0xef, 0x7b, 0xef, 0xbe, 0xef, 0x7b, 0x0f, 0x77, 0x07, 0x43,
+	0xf1, 0x31, 0x26, 0x36, 0x26, 0xda, 0x1e, 0x42, 0x96, 0x6b, 0x3a, 0x9e, 0x76, 0x5c, 0x6d, 0x21,
+	0xcf, 0xac, 0x6a, 0xde, 0x13, 0xb5, 0xe3, 0x62, 0x0f, 0x8b, 0xcb, 0x01, 0x42, 0x8d, 0x10, 0x6a,
+	0x88, 0x90, 0x72, 0x16, 0xb6, 0x30, 0xc5, 0x68, 0xfe, 0xaf, 0x00, 0x2e, 0xad, 0x58, 0x18, 0x5b,
+	0x47, 0x48, 0xa3, 0x5f, 0xad, 0xee, 0x9e, 0x66, 0x3a, 0x27, 0x51, 0x28, 0xa8, 0xd4, 0x0c, 0x72,
+	0xc2, 0xb2, 0x41, 0x28, 0xdc, 0x44, 0xb3, 0x89, 0xa5, 0x1d, 0x57, 0xfd, 0xff, 0xc2, 0xc0, 0xa2,
+	0x69, 0x1f, 0x38, 0x58, 0xa3, 0xff, 0x86, 0x4b, 0x1f, 0x0f, 0xa2, 0xcc, 0x18, 0x52, 0x9c, 0xf2,
+	0xdb, 0x24, 0x2c, 0x36, 0x88, 0xb5, 0xe3, 0x2f, 0x6d, 0x1f, 0x1d, 0xe1, 0x6f, 0x4c, 0xe7, 0x31,
+	0x12, 0x6b, 0x30, 0x43, 0x41, 0xc8, 0xcd, 0x0b, 0x45, 0xa1, 0x94, 0xad, 0xe7, 0x5f, 0x9d, 0x95,
+	0x73, 0x21, 0x99, 0xed, 0x76, 0xdb, 0x45, 0x84, 0xec, 0x7a, 0xee, 0x81, 0x63, 0x19, 0x11, 0xb0,
+	0x97, 0x83, 0xf2, 0x93, 0xa3, 0xe5, 0x20, 0xf1, 0x2b, 0xc8, 0x9a, 0xd1, 0xa6, 0xf9, 0x4c, 0x51,
+	0x28, 0xcd, 0xd5, 0x72, 0x6a, 0xe0, 0x8d, 0x1a, 0x79, 0xa3, 0x6e, 0x3b, 0x27, 0xf5, 0x7b, 0x2f,
+	0xce, 0xca, 0x77, 0x07, 0x78, 0xac, 0x7e, 0x8e, 0x10, 0xa3, 0xfe, 0x85, 0xd1, 0xab, 0x28, 0x96,
+	0xe0, 0x3d, 0xfa, 0xd1, 0x24, 0xdd, 0x56, 0x93, 0xe6, 0x90, 0xfc, 0x54, 0x51, 0x28, 0xcd, 0x1a,
+	0xf3, 0x74, 0x7d, 0xb7, 0xdb, 0xa2, 0xc2, 0x89, 0x5e, 0x7e, 0x76, 0x79, 0xba, 0x16, 0x49, 0xf9,
+	0xf1, 0xf2, 0x74, 0x6d, 0x35, 0xd8, 0xac, 0x4c, 0xda, 0x87, 0x5a, 0xca, 0x1f, 0xe5, 0x03, 0x58,
+	0x49, 0x2d, 0x1a, 0x88, 0x74, 0xb0, 0x43, 0x90, 0xf2, 0x5d, 0x06, 0x72, 0x51, 0x74, 0xb7, 0xdb,
+	0x7a, 0x3d, 0x57, 0x3f, 0x83, 0xf9, 0x8e, 0xe9, 0x22, 0xc7, 0x6b, 0x8e, 0x6a, 0xee, 0xbb, 0x01,
+	0x7e, 0x27, 0xb4, 0x38, 0xd6, 0x96, 0xcc, 0xb5, 0xda, 0x32, 0xf5, 0x56, 0xda, 0x72, 0x8b, 0xdb,
+	0x96, 0x4d, 0xbf, 0x2d, 0x09, 0x03, 0xfc, 0xee, 0x14, 0x38, 0xdd, 0x89, 0x5b, 0xad, 0xc8, 0xb0,
+	0xca, 0x5b, 0x67, 0x3d, 0xfa, 0x55, 0x00, 0xb1, 0x41, 0x2c, 0x03, 0x1d, 0xe3, 0x43, 0xf4, 0xd6,
+	0xe7, 0x5e, 0x57, 0x93, 0xe3, 0xf6, 0x61, 0xbf, 0xa0, 0x04, 0x2f, 0x65, 0x15, 0xa4, 0xf4, 0x2a,
+	0x13, 0xf3, 0x54, 0x80, 0xdb, 0xf1, 0x30, 0x43, 0x90, 0xeb, 0x08, 0xd2, 0x6b, 0x49, 0x72, 0x77,
+	0x06, 0x90, 0xeb, 0xed, 0xa3, 0x3c, 0x02, 0x99, 0x1f, 0x89, 0x48, 0x8a, 0x79, 0x98, 0x71, 0x69,
+	0xb8, 0x4d, 0x99, 0x4c, 0x19, 0xd1, 0xa7, 0xb8, 0x02, 0xb3, 0xfb, 0x26, 0x69, 0xda, 0xd8, 0x0d,
+	0x1c, 0x9c, 0x35, 0x66, 0xf6, 0x4d, 0xd2, 0xc0, 0x2e, 0x52, 0xf6, 0x68, 0x97, 0x1e, 0xba, 0x5d,
+	0x07, 0xc5, 0x44, 0x55, 0x60, 0xba, 0xe3, 0x2f, 0x0d, 0xd7, 0x14, 0xe2, 0x74, 0xf9, 0xd5, 0x59,
+	0x79, 0xa1, 0xa7, 0xa2, 0x58, 0x51, 0x37, 0x2a, 0xbe, 0xca, 0x30, 0xae, 0x54, 0x41, 0x4a, 0xef,
+	0x13, 0x51, 0xd7, 0x97, 0x38, 0xd9, 0xca, 0x1f, 0x93, 0x70, 0x3b, 0x1a, 0xb1, 0x1d, 0x17, 0x77,
+	0x3b, 0xaf, 0x37, 0x45, 0x22, 0x4c, 0x39, 0xa6, 0x1d, 0x8e, 0x90, 0x41, 0x7f, 0xbf, 0xe9, 0xd3,
+	0xf1, 0x01, 0xcc, 0xd8, 0xc8, 0x6e, 0x21, 0xd7, 0x3f, 0x14, 0x33, 0xa5, 0xb9, 0xda, 0x3d, 0x75,
+	0x50, 0x0d, 0x2a, 0x90, 0x4a, 0x6d, 0xd0, 0x8c, 0x7a, 0xf6, 0xf9, 0xdf, 0x85, 0x89, 0x5f, 0x2e,
+	0x4f, 0xd7, 0x04, 0x23, 0x2a, 0x32, 0x74, 0x6e, 0x38, 0x56, 0x29, 0x45, 0x90, 0xf9, 0x11, 0x36,
+	0xdc, 0x3f, 0x0b, 0xb0, 0xcc, 0x46, 0xeb, 0xcd, 0x18, 0xad, 0xaf, 0x27, 0x99, 0x2b, 0xbc, 0x89,
+	0x4f, 0x50, 0xbf, 0x03, 0x85, 0x01, 0x21, 0xc6, 0xfd, 0xf7, 0x49, 0x58, 0x8e, 0xe4, 0x35, 0x70,
+	0xbb, 0x7b, 0x14, 0x3b, 0x6a, 0xee, 0x43, 0xd6, 0xec, 0x7a, 0xfb, 0xd8, 0x3d, 0xf0, 0x4e, 0x86,
+	0xb2, 0xef, 0x41, 0xc5, 0x02, 0xcc, 0xd9, 0xb4, 0x54, 0x33, 0x26, 0x03, 0x82, 0xa5, 0x07, 0xa6,
+	0x1d, 0x33, 0xe5, 0xc6, 0x1c, 0xf8, 0xfa, 0x86, 0xef, 0x6f, 0x4f, 0x03, 0xc7, 0x61, 0x9e, 0x45,
+	0xa1, 0xc3, 0xbc, 0x10, 0x73, 0xf8, 0x1f, 0x01, 0xf2, 0xac, 0x0b, 0x37, 0xd9, 0x62, 0xfd, 0x7e,
+	0xda, 0x83, 0x8f, 0x78, 0x53, 0x96, 0x34, 0x41, 0x81, 0xe2, 0xa0, 0x18, 0x73, 0xe1, 0x4f, 0x01,
+	0x16, 0x1a, 0xc4, 0x7a, 0xd4, 0x69, 0x9b, 0x1e, 0x7a, 0x68, 0xba, 0xa6, 0x4d, 0xae, 0x2d, 0xbe,
+	0x0e, 0xd3, 0x1d, 0x5a, 0x81, 0xea, 0x9e, 0xab, 0x15, 0x06, 0x1e, 0x0a, 0xc1, 0x46, 0xf1, 0xa3,
+	0x20, 0xcc, 0xd4, 0xb7, 0xd2, 0x5a, 0xef, 0xc6, 0xb4, 0x3e, 0xe9, 0xbd, 0x4a, 0x13, 0xac, 0x95,
+	0x15, 0x58, 0x4e, 0x2c, 0x45, 0x22, 0x6b, 0xff, 0x65, 0x21, 0xd3, 0x20, 0x96, 0xd8, 0x81, 0xf9,
+	0xc4, 0x6b, 0x75, 0x6d, 0x20, 0xc5, 0xd4, 0x23, 0x4d, 0xaa, 0x8d, 0x8e, 0x65, 0x57, 0x17, 0x81,
+	0x85, 0xe4, 0x43, 0xe1, 0x93, 0xab, 0xca, 0x24, 0xc0, 0xd2, 0xfa, 0x18, 0x60, 0xb6, 0xe9, 0xb7,
+	0xb0, 0xc4, 0xbb, 0xd0, 0xb5, 0x91, 0x6a, 0xf5, 0x12, 0xa4, 0xcd, 0x31, 0x13, 0x18, 0x81, 0x1f,
+	0x04, 0x58, 0x48, 0xde, 0xbc, 0x57, 0xca, 0x4e, 0x80, 0xa5, 0xf5, 0x31, 0xc0, 0x6c, 0x94, 0x97,
+	0x5e, 0xa4, 0xef, 0x5a, 0xf1, 0x04, 0x16, 0xd3, 0xaf, 0xe9, 0xf2, 0xd0, 0x4e, 0xc6, 0xe1, 0xd2,
+	0xc6, 0x58, 0xf0, 0x78, 0x1b, 0x78, 0x57, 0xbc, 0x36, 0xb4, 0x5a, 0x7f, 0x82, 0xb4, 0x39, 0x66,
+	0x02, 0x23, 0xf0, 0x4c, 0x80, 0x1c, 0xf7, 0xf2, 0xab, 0x0c, 0x6f, 0x6c, 0x82, 0xc3, 0xd6, 0xb8,
+	0x19, 0x7d, 0x24, 0xb8, 0xb7, 0x58, 0x65, 0xa8, 0xac, 0x44, 0x86, 0xb4, 0x35, 0x6e, 0x06, 0x23,
+	0xf1, 0xbd, 0x00, 0xef, 0xf3, 0x0f, 0xfa, 0xea, 0x70, 0x61, 0x49, 0x1a, 0x9f, 0x8e, 0x9d, 0xc2,
+	0x78, 0x7c, 0x0d, 0xef, 0xf4, 0x9d, 0xb4, 0xa5, 0xab, 0x4a, 0xc5, 0x91, 0x52, 0x65, 0x54, 0x64,
+	0xb4, 0x97, 0x74, 0xeb, 0xa9, 0x7f, 0xb0, 0xd6, 0xab, 0xcf, 0xcf, 0x65, 0xe1, 0xe5, 0xb9, 0x2c,
+	0xfc, 0x7b, 0x2e, 0x0b, 0x3f, 0x5d, 0xc8, 0x13, 0x2f, 0x2f, 0xe4, 0x89, 0xbf, 0x2e, 0xe4, 0x89,
+	0x2f, 0xc3, 0xbf, 0x09, 0x90, 0xf6, 0xa1, 0x7a, 0x80, 0x63, 0x27, 0x6b, 0x6b, 0x9a, 0xde, 0xdb,
+	0xeb, 0xff, 0x0f, 0x00, 0x26, 0x11, 0xc4, 0x7a, 0xbc, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// GrantAllowance grants fee allowance to the grantee on the granter's
+	// account with the provided expiration time.
+	GrantAllowance(ctx context.Context, in *MsgGrantAllowance, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
+	// RevokeAllowance revokes any fee allowance of granter's account that
+	// has been granted to the grantee.
+	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// RevokeAllAllowances revokes all the fee allowances granted by the granter,
+	// including its group allowances. At most 100 allowances are revoked per
+	// message, the response reports if the message must be sent again to revoke
+	// the remaining ones.
+	RevokeAllAllowances(ctx context.Context, in *MsgRevokeAllAllowances, opts ...grpc.CallOption) (*MsgRevokeAllAllowancesResponse, error)
+	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
+	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// GrantSubAllowance grants a sub-allowance of the allowance granted to the
+	// parent grantee to another account. Fees paid with the sub-allowance are paid
+	// by the granter and also deducted from the parent allowance.
+	GrantSubAllowance(ctx context.Context, in *MsgGrantSubAllowance, opts ...grpc.CallOption) (*MsgGrantSubAllowanceResponse, error)
+	// GrantGroupAllowance grants an allowance which is shared by a group of grantees
+	// on the granter's account, with an optional spend limit per member.
+	GrantGroupAllowance(ctx context.Context, in *MsgGrantGroupAllowance, opts ...grpc.CallOption) (*MsgGrantGroupAllowanceResponse, error)
+	// RevokeGroupAllowance revokes a group allowance of the granter's account.
+	RevokeGroupAllowance(ctx context.Context, in *MsgRevokeGroupAllowance, opts ...grpc.CallOption) (*MsgRevokeGroupAllowanceResponse, error)
+	// GrantModuleAllowance defines a governance operation for granting a fee
+	// allowance on the funds of a module account, e.g. the community pool.
+	GrantModuleAllowance(ctx context.Context, in *MsgGrantModuleAllowance, opts ...grpc.CallOption) (*MsgGrantModuleAllowanceResponse, error)
+	// RevokeModuleAllowance defines a governance operation for revoking a fee
+	// allowance granted on the funds of a module account.
+	RevokeModuleAllowance(ctx context.Context, in *MsgRevokeModuleAllowance, opts ...grpc.CallOption) (*MsgRevokeModuleAllowanceResponse, error)
+	// UpdateParams defines a governance operation for updating the x/feegrant module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowance, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
+	out := new(MsgGrantAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/GrantAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error) {
+	out := new(MsgRevokeAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RevokeAllAllowances(ctx context.Context, in *MsgRevokeAllAllowances, opts ...grpc.CallOption) (*MsgRevokeAllAllowancesResponse, error) {
+	out := new(MsgRevokeAllAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeAllAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error) {
+	out := new(MsgPruneAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/PruneAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantSubAllowance(ctx context.Context, in *MsgGrantSubAllowance, opts ...grpc.CallOption) (*MsgGrantSubAllowanceResponse, error) {
+	out := new(MsgGrantSubAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/GrantSubAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantGroupAllowance(ctx context.Context, in *MsgGrantGroupAllowance, opts ...grpc.CallOption) (*MsgGrantGroupAllowanceResponse, error) {
+	out := new(MsgGrantGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/GrantGroupAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeGroupAllowance(ctx context.Context, in *MsgRevokeGroupAllowance, opts ...grpc.CallOption) (*MsgRevokeGroupAllowanceResponse, error) {
+	out := new(MsgRevokeGroupAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeGroupAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantModuleAllowance(ctx context.Context, in *MsgGrantModuleAllowance, opts ...grpc.CallOption) (*MsgGrantModuleAllowanceResponse, error) {
+	out := new(MsgGrantModuleAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/GrantModuleAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeModuleAllowance(ctx context.Context, in *MsgRevokeModuleAllowance, opts ...grpc.CallOption) (*MsgRevokeModuleAllowanceResponse, error) {
+	out := new(MsgRevokeModuleAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeModuleAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// GrantAllowance grants fee allowance to the grantee on the granter's
+	// account with the provided expiration time.
+	GrantAllowance(context.Context, *MsgGrantAllowance) (*MsgGrantAllowanceResponse, error)
+	// RevokeAllowance revokes any fee allowance of granter's account that
+	// has been granted to the grantee.
+	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// RevokeAllAllowances revokes all the fee allowances granted by the granter,
+	// including its group allowances. At most 100 allowances are revoked per
+	// message, the response reports if the message must be sent again to revoke
+	// the remaining ones.
+	RevokeAllAllowances(context.Context, *MsgRevokeAllAllowances) (*MsgRevokeAllAllowancesResponse, error)
+	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
+	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// GrantSubAllowance grants a sub-allowance of the allowance granted to the
+	// parent grantee to another account. Fees paid with the sub-allowance are paid
+	// by the granter and also deducted from the parent allowance.
+	GrantSubAllowance(context.Context, *MsgGrantSubAllowance) (*MsgGrantSubAllowanceResponse, error)
+	// GrantGroupAllowance grants an allowance which is shared by a group of grantees
+	// on the granter's account, with an optional spend limit per member.
+	GrantGroupAllowance(context.Context, *MsgGrantGroupAllowance) (*MsgGrantGroupAllowanceResponse, error)
+	// RevokeGroupAllowance revokes a group allowance of the granter's account.
+	RevokeGroupAllowance(context.Context, *MsgRevokeGroupAllowance) (*MsgRevokeGroupAllowanceResponse, error)
+	// GrantModuleAllowance defines a governance operation for granting a fee
+	// allowance on the funds of a module account, e.g. the community pool.
+	GrantModuleAllowance(context.Context, *MsgGrantModuleAllowance) (*MsgGrantModuleAllowanceResponse, error)
+	// RevokeModuleAllowance defines a governance operation for revoking a fee
+	// allowance granted on the funds of a module account.
+	RevokeModuleAllowance(context.Context, *MsgRevokeModuleAllowance) (*MsgRevokeModuleAllowanceResponse, error)
+	// UpdateParams defines a governance operation for updating the x/feegrant module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowance) (*MsgGrantAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAllAllowances(ctx context.Context, req *MsgRevokeAllAllowances) (*MsgRevokeAllAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllAllowances not implemented")
+}
+func (*UnimplementedMsgServer) PruneAllowances(ctx context.Context, req *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
+}
+func (*UnimplementedMsgServer) GrantSubAllowance(ctx context.Context, req *MsgGrantSubAllowance) (*MsgGrantSubAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSubAllowance not implemented")
+}
+func (*UnimplementedMsgServer) GrantGroupAllowance(ctx context.Context, req *MsgGrantGroupAllowance) (*MsgGrantGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantGroupAllowance not implemented")
+}
+func (*UnimplementedMsgServer) RevokeGroupAllowance(ctx context.Context, req *MsgRevokeGroupAllowance) (*MsgRevokeGroupAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGroupAllowance not implemented")
+}
+func (*UnimplementedMsgServer) GrantModuleAllowance(ctx context.Context, req *MsgGrantModuleAllowance) (*MsgGrantModuleAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantModuleAllowance not implemented")
+}
+func (*UnimplementedMsgServer) RevokeModuleAllowance(ctx context.Context, req *MsgRevokeModuleAllowance) (*MsgRevokeModuleAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeModuleAllowance not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/GrantAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAllowance(ctx, req.(*MsgRevokeAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAllAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAllAllowances)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAllAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeAllAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAllAllowances(ctx, req.(*MsgRevokeAllAllowances))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneAllowances)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/PruneAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneAllowances(ctx, req.(*MsgPruneAllowances))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantSubAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantSubAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantSubAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/GrantSubAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantSubAllowance(ctx, req.(*MsgGrantSubAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantGroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantGroupAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantGroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/GrantGroupAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantGroupAllowance(ctx, req.(*MsgGrantGroupAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeGroupAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeGroupAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeGroupAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeGroupAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeGroupAllowance(ctx, req.(*MsgRevokeGroupAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantModuleAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantModuleAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantModuleAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/GrantModuleAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantModuleAllowance(ctx, req.(*MsgGrantModuleAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeModuleAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeModuleAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeModuleAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeModuleAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeModuleAllowance(ctx, req.(*MsgRevokeModuleAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.feegrant.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GrantAllowance",
+			Handler:    _Msg_GrantAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeAllowance",
+			Handler:    _Msg_RevokeAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeAllAllowances",
+			Handler:    _Msg_RevokeAllAllowances_Handler,
+		},
+		{
+			MethodName: "PruneAllowances",
+			Handler:    _Msg_PruneAllowances_Handler,
+		},
+		{
+			MethodName: "GrantSubAllowance",
+			Handler:    _Msg_GrantSubAllowance_Handler,
+		},
+		{
+			MethodName: "GrantGroupAllowance",
+			Handler:    _Msg_GrantGroupAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeGroupAllowance",
+			Handler:    _Msg_RevokeGroupAllowance_Handler,
+		},
+		{
+			MethodName: "GrantModuleAllowance",
+			Handler:    _Msg_GrantModuleAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeModuleAllowance",
+			Handler:    _Msg_RevokeModuleAllowance_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
+}
+
+func (m *MsgGrantAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowSubGrants {
+		i--
+		if m.AllowSubGrants {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantSubAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantSubAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantSubAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowSubGrants {
+		i--
+		if m.AllowSubGrants {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParentGrantee) > 0 {
+		i -= len(m.ParentGrantee)
+		copy(dAtA[i:], m.ParentGrantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentGrantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantSubAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantSubAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantSubAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Revoked != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Revoked))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pruner) > 0 {
+		i -= len(m.Pruner)
+		copy(dAtA[i:], m.Pruner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pruner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantGroupAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantGroupAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantGroupAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantGroupAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantGroupAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantGroupAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeGroupAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeGroupAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeGroupAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeGroupAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeGroupAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeGroupAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantModuleAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantModuleAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantModuleAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantModuleAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantModuleAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantModuleAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeModuleAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeModuleAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeModuleAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeModuleAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeModuleAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeModuleAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllowSubGrants {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantSubAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParentGrantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllowSubGrants {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgGrantSubAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAllAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		n += 1 + sovTx(uint64(m.Revoked))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgPruneAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pruner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantGroupAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantGroupAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeGroupAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeGroupAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantModuleAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantModuleAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeModuleAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeModuleAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSubGrants", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSubGrants = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantSubAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantSubAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantSubAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGrantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentGrantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSubGrants", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSubGrants = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantSubAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantSubAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantSubAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllAllowances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllAllowances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			m.Revoked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revoked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAllowances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pruner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantGroupAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantGroupAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantGroupAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, GroupGrantMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantGroupAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantGroupAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantGroupAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeGroupAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeGroupAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeGroupAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRevokeAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgPruneAllowances) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pruner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRevokeGroupAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeGroupAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeGroupAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgPruneAllowancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantModuleAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -820,15 +3975,15 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantModuleAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantModuleAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -856,9 +4011,41 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
@@ -890,7 +4077,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
@@ -947,7 +4134,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantModuleAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -970,10 +4157,10 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantModuleAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantModuleAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -997,7 +4184,7 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeModuleAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1020,15 +4207,15 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeModuleAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeModuleAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1056,9 +4243,41 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
@@ -1111,7 +4330,7 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeModuleAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1134,10 +4353,10 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeModuleAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeModuleAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1161,7 +4380,7 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1184,15 +4403,15 @@ func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPruneAllowances: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPruneAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pruner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1220,7 +4439,40 @@ func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pruner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1243,7 +4495,7 @@ func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1266,10 +4518,10 @@ func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPruneAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPruneAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: